@@ -0,0 +1,485 @@
+// Package accounting builds a ledger of the events that change the balance of
+// the node, for reporting purposes. It joins the settled htlcs of invoices and
+// payments, the fees earned by forwarding and the on-chain transactions of the
+// wallet into a single list of entries in a normalized format.
+//
+// All amounts are in millisatoshis. The amount of an entry is the change in
+// balance excluding the fees paid by the node, which are reported separately.
+// The change in balance of an entry is therefore its amount minus its fee.
+package accounting
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwallet"
+	"github.com/Actinium-project/lnd/lnwire"
+)
+
+// ErrInvalidRange is returned when a ledger is requested for a time range
+// that ends before it starts.
+var ErrInvalidRange = errors.New("end time before start time")
+
+// EntryType describes the kind of event a ledger entry records.
+type EntryType uint8
+
+const (
+	// EntryReceipt is an htlc that was settled to one of our invoices.
+	EntryReceipt EntryType = iota
+
+	// EntryPayment is an htlc of one of our payments that was settled.
+	EntryPayment
+
+	// EntryForward is an htlc we forwarded. Its amount is the fee we
+	// earned. For a payment we forwarded on behalf of a trampoline onion,
+	// the routing fees we paid to forward it are reported as its fee.
+	EntryForward
+
+	// EntryOnChain is an on-chain transaction of the wallet.
+	EntryOnChain
+
+	// EntryChannelOpen is an on-chain transaction that funded one of our
+	// channels. It moves funds from the wallet into a channel.
+	EntryChannelOpen
+
+	// EntryChannelClose is an on-chain transaction that closed one of our
+	// channels, or swept the outputs of a force closed channel. It moves
+	// funds from a channel into the wallet.
+	EntryChannelClose
+)
+
+// String returns a human readable identifier for the EntryType type.
+func (e EntryType) String() string {
+	switch e {
+	case EntryReceipt:
+		return "Receipt"
+	case EntryPayment:
+		return "Payment"
+	case EntryForward:
+		return "Forward"
+	case EntryOnChain:
+		return "OnChain"
+	case EntryChannelOpen:
+		return "ChannelOpen"
+	case EntryChannelClose:
+		return "ChannelClose"
+	}
+
+	return "Unknown"
+}
+
+// isTransfer returns true if entries of this type move funds between the
+// wallet and the channels of the node, rather than in or out of the node.
+func (e EntryType) isTransfer() bool {
+	return e == EntryChannelOpen || e == EntryChannelClose
+}
+
+// Entry is a single balance changing event in the ledger.
+type Entry struct {
+	// Timestamp is the time at which the event took place. For htlcs it
+	// is the time they were settled, for on-chain transactions the time
+	// they were confirmed.
+	Timestamp time.Time
+
+	// Type is the kind of event.
+	Type EntryType
+
+	// Amount is the change in balance excluding fees paid, in
+	// millisatoshis. It is negative for debits.
+	Amount int64
+
+	// Fee is the fee paid by the node for this event, in millisatoshis.
+	Fee int64
+
+	// ChannelID is the short channel id of the channel the htlc of the
+	// event was settled on. For forwards it is the incoming channel. It is
+	// zero for on-chain events.
+	ChannelID uint64
+
+	// OutgoingChannelID is the outgoing channel of a forward. It is zero
+	// for all other events.
+	OutgoingChannelID uint64
+
+	// Reference identifies the event: the payment hash for receipts and
+	// payments, and the transaction id for on-chain events.
+	Reference string
+
+	// Note is the memo of the invoice of a receipt, or the label of an
+	// on-chain transaction.
+	Note string
+}
+
+// Totals summarizes the entries of a ledger. All amounts are in
+// millisatoshis.
+type Totals struct {
+	// Received is the total amount received by invoices.
+	Received int64
+
+	// Sent is the total amount paid to the receivers of payments,
+	// excluding fees.
+	Sent int64
+
+	// PaymentFees is the total of the routing fees paid for payments and
+	// trampoline forwards.
+	PaymentFees int64
+
+	// ForwardFees is the total of the fees earned by forwarding, before
+	// the routing fees paid for trampoline forwards.
+	ForwardFees int64
+
+	// OnChainReceived is the total amount received by the wallet,
+	// excluding transfers from channels.
+	OnChainReceived int64
+
+	// OnChainSent is the total amount sent by the wallet, excluding fees
+	// and transfers into channels.
+	OnChainSent int64
+
+	// OnChainFees is the total of the on-chain fees paid by the wallet,
+	// including those for opening channels.
+	OnChainFees int64
+
+	// Net is the net change in the balance of the node. Transfers between
+	// the wallet and the channels don't change it, apart from their fees.
+	Net int64
+}
+
+// Ledger is a list of balance changing events in a time range, ordered by
+// time.
+type Ledger struct {
+	// Entries are the events of the ledger, ordered by time.
+	Entries []Entry
+
+	// Totals summarizes the entries.
+	Totals Totals
+}
+
+// Config contains the sources of the events of a ledger.
+type Config struct {
+	// FetchInvoices returns all invoices of the node.
+	FetchInvoices func() ([]channeldb.InvoiceWithPaymentHash, error)
+
+	// FetchPayments returns all payments of the node, including those
+	// that forwarded trampoline htlcs.
+	FetchPayments func() ([]*channeldb.MPPayment, error)
+
+	// FetchTrampolineForwards returns the incoming htlcs of the payments
+	// that forwarded trampoline htlcs, keyed by their payment hash.
+	FetchTrampolineForwards func() (
+		map[lntypes.Hash]channeldb.TrampolineForward, error)
+
+	// QueryForwards returns the forwarding events in the given time
+	// range.
+	QueryForwards func(startTime, endTime time.Time) (
+		[]channeldb.ForwardingEvent, error)
+
+	// FetchTransactions returns all on-chain transactions of the wallet.
+	FetchTransactions func() ([]*lnwallet.TransactionDetail, error)
+
+	// FetchChannelTxids returns the ids of the transactions that funded
+	// and closed the channels of the node.
+	FetchChannelTxids func() (funding, closing map[chainhash.Hash]struct{},
+		err error)
+
+	// IsSweepTx returns whether the transaction with the given id was
+	// created by the sweeper, which sweeps the outputs of force closed
+	// channels into the wallet.
+	IsSweepTx func(chainhash.Hash) (bool, error)
+}
+
+// NewLedger builds the ledger of all balance changing events in the time
+// range [startTime, endTime).
+func NewLedger(cfg *Config, startTime, endTime time.Time) (*Ledger, error) {
+	if endTime.Before(startTime) {
+		return nil, ErrInvalidRange
+	}
+
+	inRange := func(t time.Time) bool {
+		return !t.Before(startTime) && t.Before(endTime)
+	}
+
+	var entries []Entry
+
+	receipts, err := receiptEntries(cfg, inRange)
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, receipts...)
+
+	payments, err := paymentEntries(cfg, inRange)
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, payments...)
+
+	forwards, err := cfg.QueryForwards(startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	for _, forward := range forwards {
+		if !inRange(forward.Timestamp) {
+			continue
+		}
+
+		entries = append(entries, Entry{
+			Timestamp:         forward.Timestamp,
+			Type:              EntryForward,
+			Amount:            int64(forward.AmtIn - forward.AmtOut),
+			ChannelID:         forward.IncomingChanID.ToUint64(),
+			OutgoingChannelID: forward.OutgoingChanID.ToUint64(),
+		})
+	}
+
+	onChain, err := onChainEntries(cfg, inRange)
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, onChain...)
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+
+	return &Ledger{
+		Entries: entries,
+		Totals:  totals(entries),
+	}, nil
+}
+
+// receiptEntries returns an entry for every htlc that was settled to one of
+// our invoices within the time range.
+func receiptEntries(cfg *Config, inRange func(time.Time) bool) ([]Entry,
+	error) {
+
+	invoices, err := cfg.FetchInvoices()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, invoice := range invoices {
+		for key, htlc := range invoice.Invoice.Htlcs {
+			if htlc.State != channeldb.HtlcStateSettled ||
+				!inRange(htlc.ResolveTime) {
+
+				continue
+			}
+
+			entries = append(entries, Entry{
+				Timestamp: htlc.ResolveTime,
+				Type:      EntryReceipt,
+				Amount:    int64(htlc.Amt),
+				ChannelID: key.ChanID.ToUint64(),
+				Reference: invoice.PaymentHash.String(),
+				Note:      string(invoice.Invoice.Memo),
+			})
+		}
+	}
+
+	return entries, nil
+}
+
+// paymentEntries returns an entry for every htlc of our payments that was
+// settled within the time range. Payments that forwarded a trampoline htlc are
+// returned as a single forward entry instead.
+func paymentEntries(cfg *Config, inRange func(time.Time) bool) ([]Entry,
+	error) {
+
+	payments, err := cfg.FetchPayments()
+	if err != nil {
+		return nil, err
+	}
+
+	trampolineForwards, err := cfg.FetchTrampolineForwards()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, payment := range payments {
+		fwd, ok := trampolineForwards[payment.Info.PaymentHash]
+		if ok {
+			entry := trampolineEntry(payment, &fwd)
+			if entry != nil && inRange(entry.Timestamp) {
+				entries = append(entries, *entry)
+			}
+
+			continue
+		}
+
+		for _, htlc := range payment.HTLCs {
+			if htlc.Settle == nil || !inRange(htlc.Settle.SettleTime) {
+				continue
+			}
+
+			route := htlc.Route
+			fee := route.TotalFees()
+
+			var chanID uint64
+			if len(route.Hops) > 0 {
+				chanID = route.Hops[0].ChannelID
+			}
+
+			entries = append(entries, Entry{
+				Timestamp: htlc.Settle.SettleTime,
+				Type:      EntryPayment,
+				Amount:    -int64(route.TotalAmount - fee),
+				Fee:       int64(fee),
+				ChannelID: chanID,
+				Reference: payment.Info.PaymentHash.String(),
+			})
+		}
+	}
+
+	return entries, nil
+}
+
+// trampolineEntry returns the forward entry of a payment that forwarded a
+// trampoline htlc, or nil if none of its htlcs were settled. The fee we earned
+// is the amount of the incoming htlc minus the amount the payment delivered,
+// and the routing fees of the payment are the fee we paid.
+func trampolineEntry(payment *channeldb.MPPayment,
+	fwd *channeldb.TrampolineForward) *Entry {
+
+	var (
+		settleTime time.Time
+		delivered  lnwire.MilliSatoshi
+		fee        lnwire.MilliSatoshi
+		outChanID  uint64
+	)
+	for _, htlc := range payment.HTLCs {
+		if htlc.Settle == nil {
+			continue
+		}
+
+		route := htlc.Route
+		delivered += route.TotalAmount - route.TotalFees()
+		fee += route.TotalFees()
+
+		if len(route.Hops) > 0 {
+			outChanID = route.Hops[0].ChannelID
+		}
+
+		// The incoming htlc is settled once the last htlc of the
+		// payment was settled.
+		if htlc.Settle.SettleTime.After(settleTime) {
+			settleTime = htlc.Settle.SettleTime
+		}
+	}
+	if settleTime.IsZero() {
+		return nil
+	}
+
+	return &Entry{
+		Timestamp:         settleTime,
+		Type:              EntryForward,
+		Amount:            int64(fwd.AmtIn) - int64(delivered),
+		Fee:               int64(fee),
+		ChannelID:         fwd.IncomingChanID.ToUint64(),
+		OutgoingChannelID: outChanID,
+		Reference:         payment.Info.PaymentHash.String(),
+	}
+}
+
+// onChainEntries returns an entry for every confirmed on-chain transaction of
+// the wallet within the time range.
+func onChainEntries(cfg *Config, inRange func(time.Time) bool) ([]Entry,
+	error) {
+
+	txns, err := cfg.FetchTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	funding, closing, err := cfg.FetchChannelTxids()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, tx := range txns {
+		// Unconfirmed transactions don't change our balance yet.
+		if tx.NumConfirmations < 1 {
+			continue
+		}
+
+		timestamp := time.Unix(tx.Timestamp, 0)
+		if !inRange(timestamp) {
+			continue
+		}
+
+		entryType := EntryOnChain
+		if _, ok := funding[tx.Hash]; ok {
+			entryType = EntryChannelOpen
+		} else if _, ok := closing[tx.Hash]; ok {
+			entryType = EntryChannelClose
+		} else {
+			// The outputs of force closed channels are swept into
+			// the wallet by separate transactions, which move
+			// funds from the channel just like the closing
+			// transaction itself.
+			isSweep, err := cfg.IsSweepTx(tx.Hash)
+			if err != nil {
+				return nil, err
+			}
+			if isSweep {
+				entryType = EntryChannelClose
+			}
+		}
+
+		// The value of a transaction is the net change of the wallet
+		// balance, so it includes the fee if we paid it.
+		fee := tx.TotalFees * 1000
+		entries = append(entries, Entry{
+			Timestamp: timestamp,
+			Type:      entryType,
+			Amount:    int64(tx.Value)*1000 + fee,
+			Fee:       fee,
+			Reference: tx.Hash.String(),
+			Note:      tx.Label,
+		})
+	}
+
+	return entries, nil
+}
+
+// totals summarizes the given entries.
+func totals(entries []Entry) Totals {
+	var t Totals
+	for _, entry := range entries {
+		switch entry.Type {
+		case EntryReceipt:
+			t.Received += entry.Amount
+
+		case EntryPayment:
+			t.Sent -= entry.Amount
+			t.PaymentFees += entry.Fee
+
+		case EntryForward:
+			t.ForwardFees += entry.Amount
+			t.PaymentFees += entry.Fee
+
+		case EntryOnChain:
+			if entry.Amount > 0 {
+				t.OnChainReceived += entry.Amount
+			} else {
+				t.OnChainSent -= entry.Amount
+			}
+			t.OnChainFees += entry.Fee
+
+		case EntryChannelOpen, EntryChannelClose:
+			t.OnChainFees += entry.Fee
+		}
+
+		t.Net -= entry.Fee
+		if !entry.Type.isTransfer() {
+			t.Net += entry.Amount
+		}
+	}
+
+	return t
+}
@@ -0,0 +1,331 @@
+package accounting
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwallet"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/routing/route"
+)
+
+var (
+	testTime = time.Unix(1500000000, 0)
+
+	testHash = lntypes.Hash{1}
+
+	testChanID = lnwire.NewShortChanIDFromInt(10)
+
+	testOutgoingChanID = lnwire.NewShortChanIDFromInt(20)
+
+	testFundingTxid = chainhash.Hash{2}
+
+	testClosingTxid = chainhash.Hash{3}
+
+	testOnChainTxid = chainhash.Hash{4}
+
+	testSweepTxid = chainhash.Hash{7}
+
+	testTrampolineHash = lntypes.Hash{2}
+
+	testTrampolineChanID = lnwire.NewShortChanIDFromInt(30)
+)
+
+// testConfig returns a ledger config with one event of every kind, an hour
+// apart from each other, and one event outside of the test time range. A
+// trampoline forward and a sweep of a force closed channel follow half an hour
+// after the forward and the closing transaction.
+func testConfig() *Config {
+	hour := func(h int) time.Time {
+		return testTime.Add(time.Duration(h) * time.Hour)
+	}
+
+	invoice := channeldb.Invoice{
+		Memo: []byte("coffee"),
+		Htlcs: map[channeldb.CircuitKey]*channeldb.InvoiceHTLC{
+			{ChanID: testChanID, HtlcID: 1}: {
+				Amt:         5000,
+				State:       channeldb.HtlcStateSettled,
+				ResolveTime: hour(1),
+			},
+			{ChanID: testChanID, HtlcID: 2}: {
+				Amt:         7000,
+				State:       channeldb.HtlcStateCanceled,
+				ResolveTime: hour(1),
+			},
+		},
+	}
+
+	payment := &channeldb.MPPayment{
+		Info: &channeldb.MPPaymentCreationInfo{
+			PaymentHash: testHash,
+		},
+		HTLCs: []channeldb.HTLCAttempt{
+			{
+				Route: route.Route{
+					TotalAmount: 3100,
+					Hops: []*route.Hop{
+						{
+							ChannelID:    testOutgoingChanID.ToUint64(),
+							AmtToForward: 3000,
+						},
+						{
+							AmtToForward: 3000,
+						},
+					},
+				},
+				Settle: &channeldb.HTLCSettleInfo{
+					SettleTime: hour(2),
+				},
+			},
+			{
+				Route: route.Route{
+					TotalAmount: 3100,
+				},
+				Failure: &channeldb.HTLCFailInfo{
+					FailTime: hour(2),
+				},
+			},
+		},
+	}
+
+	trampolinePayment := &channeldb.MPPayment{
+		Info: &channeldb.MPPaymentCreationInfo{
+			PaymentHash: testTrampolineHash,
+		},
+		HTLCs: []channeldb.HTLCAttempt{
+			{
+				Route: route.Route{
+					TotalAmount: 11050,
+					Hops: []*route.Hop{
+						{
+							ChannelID:    testOutgoingChanID.ToUint64(),
+							AmtToForward: 11000,
+						},
+						{
+							AmtToForward: 11000,
+						},
+					},
+				},
+				Settle: &channeldb.HTLCSettleInfo{
+					SettleTime: hour(3).Add(30 * time.Minute),
+				},
+			},
+		},
+	}
+	trampolineForward := channeldb.TrampolineForward{
+		IncomingChanID: testTrampolineChanID,
+		AmtIn:          12000,
+	}
+
+	forward := channeldb.ForwardingEvent{
+		Timestamp:      hour(3),
+		IncomingChanID: testChanID,
+		OutgoingChanID: testOutgoingChanID,
+		AmtIn:          10010,
+		AmtOut:         10000,
+	}
+
+	txns := []*lnwallet.TransactionDetail{
+		{
+			Hash:             testFundingTxid,
+			Value:            -100005,
+			TotalFees:        5,
+			NumConfirmations: 1,
+			Timestamp:        hour(4).Unix(),
+		},
+		{
+			Hash:             testClosingTxid,
+			Value:            50000,
+			NumConfirmations: 1,
+			Timestamp:        hour(5).Unix(),
+		},
+		{
+			Hash:             testSweepTxid,
+			Value:            30000,
+			NumConfirmations: 1,
+			Timestamp:        hour(5).Add(30 * time.Minute).Unix(),
+		},
+		{
+			Hash:             testOnChainTxid,
+			Value:            20000,
+			NumConfirmations: 3,
+			Timestamp:        hour(6).Unix(),
+			Label:            "deposit",
+		},
+
+		// Unconfirmed transactions and transactions outside of the
+		// time range are left out.
+		{
+			Hash:      chainhash.Hash{5},
+			Value:     1000,
+			Timestamp: hour(6).Unix(),
+		},
+		{
+			Hash:             chainhash.Hash{6},
+			Value:            1000,
+			NumConfirmations: 1,
+			Timestamp:        hour(-1).Unix(),
+		},
+	}
+
+	return &Config{
+		FetchInvoices: func() ([]channeldb.InvoiceWithPaymentHash,
+			error) {
+
+			return []channeldb.InvoiceWithPaymentHash{{
+				Invoice:     invoice,
+				PaymentHash: testHash,
+			}}, nil
+		},
+		FetchPayments: func() ([]*channeldb.MPPayment, error) {
+			return []*channeldb.MPPayment{
+				payment, trampolinePayment,
+			}, nil
+		},
+		FetchTrampolineForwards: func() (
+			map[lntypes.Hash]channeldb.TrampolineForward, error) {
+
+			return map[lntypes.Hash]channeldb.TrampolineForward{
+				testTrampolineHash: trampolineForward,
+			}, nil
+		},
+		QueryForwards: func(_, _ time.Time) ([]channeldb.ForwardingEvent,
+			error) {
+
+			return []channeldb.ForwardingEvent{forward}, nil
+		},
+		FetchTransactions: func() ([]*lnwallet.TransactionDetail,
+			error) {
+
+			return txns, nil
+		},
+		FetchChannelTxids: func() (map[chainhash.Hash]struct{},
+			map[chainhash.Hash]struct{}, error) {
+
+			return map[chainhash.Hash]struct{}{
+				testFundingTxid: {},
+			}, map[chainhash.Hash]struct{}{
+				testClosingTxid: {},
+			}, nil
+		},
+		IsSweepTx: func(hash chainhash.Hash) (bool, error) {
+			return hash == testSweepTxid, nil
+		},
+	}
+}
+
+// TestNewLedger tests that the ledger joins the events of all sources in
+// order, and summarizes them.
+func TestNewLedger(t *testing.T) {
+	t.Parallel()
+
+	ledger, err := NewLedger(
+		testConfig(), testTime, testTime.Add(24*time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("unable to create ledger: %v", err)
+	}
+
+	hour := func(h int) time.Time {
+		return testTime.Add(time.Duration(h) * time.Hour)
+	}
+	expectedEntries := []Entry{
+		{
+			Timestamp: hour(1),
+			Type:      EntryReceipt,
+			Amount:    5000,
+			ChannelID: testChanID.ToUint64(),
+			Reference: testHash.String(),
+			Note:      "coffee",
+		},
+		{
+			Timestamp: hour(2),
+			Type:      EntryPayment,
+			Amount:    -3000,
+			Fee:       100,
+			ChannelID: testOutgoingChanID.ToUint64(),
+			Reference: testHash.String(),
+		},
+		{
+			Timestamp:         hour(3),
+			Type:              EntryForward,
+			Amount:            10,
+			ChannelID:         testChanID.ToUint64(),
+			OutgoingChannelID: testOutgoingChanID.ToUint64(),
+		},
+		{
+			Timestamp:         hour(3).Add(30 * time.Minute),
+			Type:              EntryForward,
+			Amount:            1000,
+			Fee:               50,
+			ChannelID:         testTrampolineChanID.ToUint64(),
+			OutgoingChannelID: testOutgoingChanID.ToUint64(),
+			Reference:         testTrampolineHash.String(),
+		},
+		{
+			Timestamp: hour(4),
+			Type:      EntryChannelOpen,
+			Amount:    -100000000,
+			Fee:       5000,
+			Reference: testFundingTxid.String(),
+		},
+		{
+			Timestamp: hour(5),
+			Type:      EntryChannelClose,
+			Amount:    50000000,
+			Reference: testClosingTxid.String(),
+		},
+		{
+			Timestamp: hour(5).Add(30 * time.Minute),
+			Type:      EntryChannelClose,
+			Amount:    30000000,
+			Reference: testSweepTxid.String(),
+		},
+		{
+			Timestamp: hour(6),
+			Type:      EntryOnChain,
+			Amount:    20000000,
+			Reference: testOnChainTxid.String(),
+			Note:      "deposit",
+		},
+	}
+	if !reflect.DeepEqual(ledger.Entries, expectedEntries) {
+		t.Fatalf("unexpected entries:\n%+v\nexpected:\n%+v",
+			ledger.Entries, expectedEntries)
+	}
+
+	expectedTotals := Totals{
+		Received:        5000,
+		Sent:            3000,
+		PaymentFees:     100 + 50,
+		ForwardFees:     10 + 1000,
+		OnChainReceived: 20000000,
+		OnChainFees:     5000,
+		Net: 5000 - 3000 - 100 + 10 + 1000 - 50 - 5000 +
+			20000000,
+	}
+	if ledger.Totals != expectedTotals {
+		t.Fatalf("unexpected totals %+v, expected %+v",
+			ledger.Totals, expectedTotals)
+	}
+
+	// An empty time range results in an empty ledger, while a range that
+	// ends before it starts is rejected.
+	ledger, err = NewLedger(testConfig(), hour(7), hour(7))
+	if err != nil {
+		t.Fatalf("unable to create ledger: %v", err)
+	}
+	if len(ledger.Entries) != 0 {
+		t.Fatalf("expected no entries, got %v", ledger.Entries)
+	}
+
+	_, err = NewLedger(testConfig(), hour(7), hour(6))
+	if err != ErrInvalidRange {
+		t.Fatalf("expected ErrInvalidRange, got %v", err)
+	}
+}
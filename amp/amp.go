@@ -0,0 +1,135 @@
+package amp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/record"
+)
+
+const (
+	// MaxChildren is the maximum number of shards an AMP payment can be
+	// split into, as the child index is encoded as an uint16 in the
+	// onion.
+	MaxChildren = 1 << 16
+)
+
+var (
+	// ErrNoShares is returned when a root is to be split into or
+	// reconstructed from zero shares.
+	ErrNoShares = errors.New("at least one share is required")
+)
+
+// Child contains the details of a single shard of an AMP payment.
+type Child struct {
+	// Record is the AMP record to be delivered to the receiver along with
+	// the shard.
+	Record *record.AMP
+
+	// Preimage is the preimage of the shard, which the receiver can only
+	// derive once it has received the shares of all shards.
+	Preimage lntypes.Preimage
+
+	// Hash is the payment hash of the shard.
+	Hash lntypes.Hash
+}
+
+// SplitRoot splits the root into the given number of random shares, such that
+// the root is the XOR of all of them. Any subset of the shares reveals nothing
+// about the root.
+func SplitRoot(root [32]byte, numShares int) ([][32]byte, error) {
+	if numShares < 1 {
+		return nil, ErrNoShares
+	}
+	if numShares > MaxChildren {
+		return nil, fmt.Errorf("cannot split root into more than %v "+
+			"shares", MaxChildren)
+	}
+
+	shares := make([][32]byte, numShares)
+	last := root
+	for i := 0; i < numShares-1; i++ {
+		if _, err := rand.Read(shares[i][:]); err != nil {
+			return nil, err
+		}
+
+		xor(&last, &shares[i])
+	}
+	shares[numShares-1] = last
+
+	return shares, nil
+}
+
+// ReconstructRoot reconstructs the root from the full set of shares.
+func ReconstructRoot(shares [][32]byte) ([32]byte, error) {
+	var root [32]byte
+	if len(shares) == 0 {
+		return root, ErrNoShares
+	}
+
+	for i := range shares {
+		xor(&root, &shares[i])
+	}
+
+	return root, nil
+}
+
+// SetID returns the set id of the payment using the given root, which all of
+// its shards carry.
+func SetID(root [32]byte) [32]byte {
+	return sha256.Sum256(root[:])
+}
+
+// ChildPreimage derives the preimage of the shard with the given child index
+// from the root.
+func ChildPreimage(root [32]byte, childIndex uint16) lntypes.Preimage {
+	var index [4]byte
+	binary.BigEndian.PutUint32(index[:], uint32(childIndex))
+
+	h := sha256.New()
+	h.Write(root[:])
+	h.Write(index[:])
+
+	var preimage lntypes.Preimage
+	copy(preimage[:], h.Sum(nil))
+
+	return preimage
+}
+
+// NewChildren creates the given number of shards for a new AMP payment using a
+// random root.
+func NewChildren(numChildren int) ([]*Child, error) {
+	var root [32]byte
+	if _, err := rand.Read(root[:]); err != nil {
+		return nil, err
+	}
+
+	shares, err := SplitRoot(root, numChildren)
+	if err != nil {
+		return nil, err
+	}
+
+	setID := SetID(root)
+	children := make([]*Child, numChildren)
+	for i, share := range shares {
+		preimage := ChildPreimage(root, uint16(i))
+		children[i] = &Child{
+			Record:   record.NewAMP(share, setID, uint16(i)),
+			Preimage: preimage,
+			Hash:     preimage.Hash(),
+		}
+	}
+
+	return children, nil
+}
+
+// xor sets a to the XOR of a and b.
+func xor(a, b *[32]byte) {
+	for i := range a {
+		a[i] ^= b[i]
+	}
+}
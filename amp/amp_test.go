@@ -0,0 +1,88 @@
+package amp
+
+import (
+	"testing"
+)
+
+// TestSplitReconstructRoot asserts that a root split into shares can only be
+// reconstructed from the full set of shares.
+func TestSplitReconstructRoot(t *testing.T) {
+	t.Parallel()
+
+	root := [32]byte{1, 2, 3}
+	for _, numShares := range []int{1, 2, 5} {
+		shares, err := SplitRoot(root, numShares)
+		if err != nil {
+			t.Fatalf("unable to split root: %v", err)
+		}
+		if len(shares) != numShares {
+			t.Fatalf("expected %v shares, got %v", numShares,
+				len(shares))
+		}
+
+		reconstructed, err := ReconstructRoot(shares)
+		if err != nil {
+			t.Fatalf("unable to reconstruct root: %v", err)
+		}
+		if reconstructed != root {
+			t.Fatalf("expected root %x, got %x", root,
+				reconstructed)
+		}
+
+		if numShares == 1 {
+			continue
+		}
+
+		partial, _ := ReconstructRoot(shares[1:])
+		if partial == root {
+			t.Fatalf("root reconstructed from partial set")
+		}
+	}
+
+	if _, err := SplitRoot(root, 0); err != ErrNoShares {
+		t.Fatalf("expected ErrNoShares, got %v", err)
+	}
+}
+
+// TestNewChildren asserts that the receiver can derive the preimages of all
+// children once it has received the full set.
+func TestNewChildren(t *testing.T) {
+	t.Parallel()
+
+	children, err := NewChildren(3)
+	if err != nil {
+		t.Fatalf("unable to create children: %v", err)
+	}
+
+	var shares [][32]byte
+	for i, child := range children {
+		if child.Record.ChildIndex() != uint16(i) {
+			t.Fatalf("expected child index %v, got %v", i,
+				child.Record.ChildIndex())
+		}
+		if child.Record.SetID() != children[0].Record.SetID() {
+			t.Fatalf("set id mismatch")
+		}
+		if child.Preimage.Hash() != child.Hash {
+			t.Fatalf("preimage doesn't match hash")
+		}
+
+		shares = append(shares, child.Record.RootShare())
+	}
+
+	root, err := ReconstructRoot(shares)
+	if err != nil {
+		t.Fatalf("unable to reconstruct root: %v", err)
+	}
+	if SetID(root) != children[0].Record.SetID() {
+		t.Fatalf("set id doesn't commit to root")
+	}
+
+	for i, child := range children {
+		preimage := ChildPreimage(root, uint16(i))
+		if preimage != child.Preimage {
+			t.Fatalf("child %v: expected preimage %v, got %v", i,
+				child.Preimage, preimage)
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"golang.org/x/crypto/hkdf"
 
 	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/lnd/keychain"
 )
 
 const (
@@ -289,7 +290,7 @@ type handshakeState struct {
 
 	initiator bool
 
-	localStatic    *btcec.PrivateKey
+	localStatic    keychain.SingleKeyECDH
 	localEphemeral *btcec.PrivateKey
 
 	remoteStatic    *btcec.PublicKey
@@ -300,11 +301,12 @@ type handshakeState struct {
 // with the prologue and protocol name. If this is the responder's handshake
 // state, then the remotePub can be nil.
 func newHandshakeState(initiator bool, prologue []byte,
-	localPub *btcec.PrivateKey, remotePub *btcec.PublicKey) handshakeState {
+	localKey keychain.SingleKeyECDH,
+	remotePub *btcec.PublicKey) handshakeState {
 
 	h := handshakeState{
 		initiator:    initiator,
-		localStatic:  localPub,
+		localStatic:  localKey,
 		remoteStatic: remotePub,
 	}
 
@@ -322,7 +324,7 @@ func newHandshakeState(initiator bool, prologue []byte,
 	if initiator {
 		h.mixHash(remotePub.SerializeCompressed())
 	} else {
-		h.mixHash(localPub.PubKey().SerializeCompressed())
+		h.mixHash(localKey.PubKey().SerializeCompressed())
 	}
 
 	return h
@@ -393,11 +395,11 @@ type Machine struct {
 // string "lightning" as the prologue. The last parameter is a set of variadic
 // arguments for adding additional options to the brontide Machine
 // initialization.
-func NewBrontideMachine(initiator bool, localPub *btcec.PrivateKey,
+func NewBrontideMachine(initiator bool, localKey keychain.SingleKeyECDH,
 	remotePub *btcec.PublicKey, options ...func(*Machine)) *Machine {
 
 	handshake := newHandshakeState(
-		initiator, lightningPrologue, localPub, remotePub,
+		initiator, lightningPrologue, localKey, remotePub,
 	)
 
 	m := &Machine{
@@ -509,8 +511,11 @@ func (b *Machine) RecvActOne(actOne [ActOneSize]byte) error {
 	b.mixHash(b.remoteEphemeral.SerializeCompressed())
 
 	// es
-	s := ecdh(b.remoteEphemeral, b.localStatic)
-	b.mixKey(s)
+	s, err := b.localStatic.ECDH(b.remoteEphemeral)
+	if err != nil {
+		return err
+	}
+	b.mixKey(s[:])
 
 	// If the initiator doesn't know our static key, then this operation
 	// will fail.
@@ -601,8 +606,11 @@ func (b *Machine) GenActThree() ([ActThreeSize]byte, error) {
 	ourPubkey := b.localStatic.PubKey().SerializeCompressed()
 	ciphertext := b.EncryptAndHash(ourPubkey)
 
-	s := ecdh(b.remoteEphemeral, b.localStatic)
-	b.mixKey(s)
+	s, err := b.localStatic.ECDH(b.remoteEphemeral)
+	if err != nil {
+		return actThree, err
+	}
+	b.mixKey(s[:])
 
 	authPayload := b.EncryptAndHash([]byte{})
 
@@ -875,10 +883,6 @@ func (b *Machine) ReadBody(r io.Reader, buf []byte) ([]byte, error) {
 // SetCurveToNil sets the 'Curve' parameter to nil on the handshakeState keys.
 // This allows us to log the Machine object without spammy log messages.
 func (b *Machine) SetCurveToNil() {
-	if b.localStatic != nil {
-		b.localStatic.Curve = nil
-	}
-
 	if b.localEphemeral != nil {
 		b.localEphemeral.Curve = nil
 	}
@@ -11,6 +11,7 @@ import (
 	"testing/iotest"
 
 	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/lnwire"
 )
 
@@ -31,7 +32,9 @@ func makeListener() (*Listener, *lnwire.NetAddress, error) {
 	addr := "localhost:0"
 
 	// Our listener will be local, and the connection remote.
-	listener, err := NewListener(localPriv, addr)
+	listener, err := NewListener(
+		&keychain.PrivKeyECDH{PrivKey: localPriv}, addr,
+	)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -63,7 +66,10 @@ func establishTestConnection() (net.Conn, net.Conn, func(), error) {
 	// successful.
 	remoteConnChan := make(chan maybeNetConn, 1)
 	go func() {
-		remoteConn, err := Dial(remotePriv, netAddr, net.Dial)
+		remoteConn, err := Dial(
+			&keychain.PrivKeyECDH{PrivKey: remotePriv}, netAddr,
+			net.Dial,
+		)
 		remoteConnChan <- maybeNetConn{remoteConn, err}
 	}()
 
@@ -192,7 +198,10 @@ func TestConcurrentHandshakes(t *testing.T) {
 	}
 
 	go func() {
-		remoteConn, err := Dial(remotePriv, netAddr, net.Dial)
+		remoteConn, err := Dial(
+			&keychain.PrivKeyECDH{PrivKey: remotePriv}, netAddr,
+			net.Dial,
+		)
 		connChan <- maybeNetConn{remoteConn, err}
 	}()
 
@@ -355,10 +364,14 @@ func TestBolt0008TestVectors(t *testing.T) {
 
 	// Finally, we'll create both brontide state machines, so we can begin
 	// our test.
-	initiator := NewBrontideMachine(true, initiatorPriv, responderPub,
-		initiatorEphemeral)
-	responder := NewBrontideMachine(false, responderPriv, nil,
-		responderEphemeral)
+	initiator := NewBrontideMachine(
+		true, &keychain.PrivKeyECDH{PrivKey: initiatorPriv},
+		responderPub, initiatorEphemeral,
+	)
+	responder := NewBrontideMachine(
+		false, &keychain.PrivKeyECDH{PrivKey: responderPriv}, nil,
+		responderEphemeral,
+	)
 
 	// We'll start with the initiator generating the initial payload for
 	// act one. This should consist of exactly 50 bytes. We'll assert that
@@ -31,6 +31,7 @@ import (
 	"github.com/Actinium-project/lnd/lnwallet"
 	"github.com/Actinium-project/lnd/lnwallet/btcwallet"
 	"github.com/Actinium-project/lnd/lnwallet/chainfee"
+	"github.com/Actinium-project/lnd/lnwallet/rpcwallet"
 	"github.com/Actinium-project/lnd/lnwire"
 	"github.com/Actinium-project/lnd/routing/chainview"
 )
@@ -497,6 +498,30 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			homeChainConfig.Node)
 	}
 
+	// If remote signing is enabled, our wallet is a watch-only copy of the
+	// wallet of the remote signer, which we'll forward all signing
+	// operations to.
+	var remoteSigner *rpcwallet.RPCKeyRing
+	if cfg.RemoteSigner.Enable {
+		if wallet == nil {
+			return nil, fmt.Errorf("remote signing requires the " +
+				"wallet to be unlocked through the wallet " +
+				"unlocker")
+		}
+
+		remoteSigner, err = rpcwallet.NewRPCKeyRing(
+			wallet, activeNetParams.CoinType,
+			activeNetParams.Params, cfg.RemoteSigner,
+		)
+		if err != nil {
+			return nil, err
+		}
+		walletConfig.RemoteSigner = remoteSigner
+
+		ltndLog.Infof("Using remote signer at %v",
+			cfg.RemoteSigner.RPCHost)
+	}
+
 	wc, err := btcwallet.New(*walletConfig)
 	if err != nil {
 		fmt.Printf("unable to create wallet controller: %v\n", err)
@@ -514,9 +539,13 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		channelConstraints = defaultAcmChannelConstraints
 	}
 
-	keyRing := keychain.NewBtcWalletKeyRing(
+	var keyRing keychain.SecretKeyRing = keychain.NewBtcWalletKeyRing(
 		wc.InternalWallet(), activeNetParams.CoinType,
 	)
+	if remoteSigner != nil {
+		keyRing = remoteSigner
+		cc.signer = remoteSigner
+	}
 	cc.keyRing = keyRing
 
 	// Create, and start the lnwallet, which handles the core payment
@@ -531,6 +560,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		ChainIO:            cc.chainIO,
 		DefaultConstraints: channelConstraints,
 		NetParams:          *activeNetParams.Params,
+		RemoteSigner:       remoteSigner != nil,
 	}
 	lnWallet, err := lnwallet.NewLightningWallet(walletCfg)
 	if err != nil {
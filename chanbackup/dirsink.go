@@ -0,0 +1,139 @@
+package chanbackup
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// DirSink is a BackupSink that stores versioned backups within a directory on
+// the local file system, such as a mounted NAS or removable drive. Next to
+// the versioned backups, the directory always contains an up to date
+// channel.backup file which can be used directly for recovery.
+type DirSink struct {
+	// dir is the target directory of the sink.
+	dir string
+
+	// maxVersions is the number of versioned backups to retain.
+	maxVersions int
+
+	// latest is the backup file within the directory that always holds
+	// the latest backup.
+	latest *MultiFile
+}
+
+// A compile-time constraint to ensure DirSink implements the BackupSink
+// interface.
+var _ BackupSink = (*DirSink)(nil)
+
+// NewDirSink creates a new directory backup sink that retains at most
+// maxVersions versioned backups.
+func NewDirSink(dir string, maxVersions int) *DirSink {
+	return &DirSink{
+		dir:         dir,
+		maxVersions: maxVersions,
+		latest: NewMultiFile(
+			filepath.Join(dir, DefaultBackupFileName),
+		),
+	}
+}
+
+// Name returns a human readable identifier of the sink.
+//
+// NOTE: This is part of the BackupSink interface.
+func (d *DirSink) Name() string {
+	return fmt.Sprintf("dir:%v", d.dir)
+}
+
+// Push writes the backup to a new versioned file within the directory,
+// refreshes the latest backup file and prunes any versions that exceed the
+// retention limit.
+//
+// NOTE: This is part of the BackupSink interface.
+func (d *DirSink) Push(version string, newBackup PackedMulti) error {
+	if err := os.MkdirAll(d.dir, 0700); err != nil {
+		return fmt.Errorf("unable to create sink dir: %v", err)
+	}
+
+	// We'll first stage the new version in a temporary file, so a
+	// partially written version is never mistaken for a complete one.
+	versionPath := filepath.Join(d.dir, version)
+	tempPath := versionPath + ".tmp"
+	if err := writeFileSync(tempPath, newBackup); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	if err := os.Rename(tempPath, versionPath); err != nil {
+		return fmt.Errorf("unable to rename version file: %v", err)
+	}
+
+	if err := d.latest.UpdateAndSwap(newBackup); err != nil {
+		return fmt.Errorf("unable to update latest backup: %v", err)
+	}
+
+	return d.prune()
+}
+
+// Versions returns the versioned backups currently stored within the sink,
+// oldest first.
+func (d *DirSink) Versions() ([]string, error) {
+	files, err := ioutil.ReadDir(d.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	for _, file := range files {
+		if file.IsDir() || !isSinkVersionName(file.Name()) {
+			continue
+		}
+
+		versions = append(versions, file.Name())
+	}
+	sort.Strings(versions)
+
+	return versions, nil
+}
+
+// prune removes the oldest versioned backups until at most maxVersions
+// remain.
+func (d *DirSink) prune() error {
+	versions, err := d.Versions()
+	if err != nil {
+		return fmt.Errorf("unable to list versions: %v", err)
+	}
+
+	for len(versions) > d.maxVersions {
+		log.Debugf("Pruning backup version %v from sink %v",
+			versions[0], d.Name())
+
+		err := os.Remove(filepath.Join(d.dir, versions[0]))
+		if err != nil {
+			return fmt.Errorf("unable to prune version: %v", err)
+		}
+		versions = versions[1:]
+	}
+
+	return nil
+}
+
+// writeFileSync writes the data to a new file at the target path and syncs it
+// to disk before closing it.
+func writeFileSync(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to create file: %v", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("unable to write file: %v", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("unable to sync file: %v", err)
+	}
+
+	return f.Close()
+}
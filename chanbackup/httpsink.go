@@ -0,0 +1,164 @@
+package chanbackup
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultHTTPSinkTimeout is the default timeout of a single request made by
+// the HTTPSink.
+const DefaultHTTPSinkTimeout = 30 * time.Second
+
+// HTTPSink is a BackupSink that uploads each versioned backup with an HTTP PUT
+// request to <baseURL>/<version>, and the latest backup to
+// <baseURL>/channel.backup. Versions exceeding the retention limit are removed
+// with an HTTP DELETE request. As the sink is unable to list the versions
+// stored remotely, only versions pushed since startup are pruned.
+type HTTPSink struct {
+	// baseURL is the URL that all backups are uploaded below.
+	baseURL string
+
+	// displayURL is the base URL without any user credentials, which is
+	// safe to log and to return over RPC.
+	displayURL string
+
+	// maxVersions is the number of versioned backups to retain.
+	maxVersions int
+
+	client *http.Client
+
+	// versions is the set of versions pushed by this instance, oldest
+	// first.
+	versions []string
+	mtx      sync.Mutex
+}
+
+// A compile-time constraint to ensure HTTPSink implements the BackupSink
+// interface.
+var _ BackupSink = (*HTTPSink)(nil)
+
+// NewHTTPSink creates a new HTTP backup sink that uploads below the given
+// base URL and retains at most maxVersions versioned backups.
+func NewHTTPSink(baseURL string, maxVersions int,
+	timeout time.Duration) *HTTPSink {
+
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	return &HTTPSink{
+		baseURL:     baseURL,
+		displayURL:  stripURLUser(baseURL),
+		maxVersions: maxVersions,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// Name returns a human readable identifier of the sink.
+//
+// NOTE: This is part of the BackupSink interface.
+func (h *HTTPSink) Name() string {
+	return fmt.Sprintf("http:%v", h.displayURL)
+}
+
+// stripURLUser removes the user information, such as basic auth credentials,
+// from the given URL. If the URL can't be parsed, a placeholder is returned so
+// that no credentials are leaked.
+func stripURLUser(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "<invalid url>"
+	}
+	u.User = nil
+
+	return u.String()
+}
+
+// Push uploads the backup as a new version, replaces the latest backup and
+// deletes any versions that exceed the retention limit. Failing to delete an
+// old version doesn't fail the push, the deletion is retried on the next push
+// instead.
+//
+// NOTE: This is part of the BackupSink interface.
+func (h *HTTPSink) Push(version string, newBackup PackedMulti) error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	err := h.do(http.MethodPut, version, newBackup)
+	if err != nil {
+		return err
+	}
+
+	err = h.do(http.MethodPut, DefaultBackupFileName, newBackup)
+	if err != nil {
+		return err
+	}
+
+	// Only once both uploads succeeded, we'll track the version for
+	// pruning.
+	h.versions = append(h.versions, version)
+
+	for len(h.versions) > h.maxVersions {
+		log.Debugf("Pruning backup version %v from sink %v",
+			h.versions[0], h.Name())
+
+		if err := h.do(http.MethodDelete, h.versions[0], nil); err != nil {
+			log.Warnf("Unable to prune backup version %v from "+
+				"sink %v: %v", h.versions[0], h.Name(), err)
+			break
+		}
+		h.versions = h.versions[1:]
+	}
+
+	return nil
+}
+
+// do executes a single request against the object with the given name below
+// the base URL, and returns an error if the server doesn't respond with a
+// success status code. A not found status is accepted for deletions.
+func (h *HTTPSink) do(method, name string, body []byte) error {
+	reqURL := h.baseURL + "/" + name
+	displayURL := h.displayURL + "/" + name
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, reqURL, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to %v %v: %v", method, displayURL, err)
+	}
+	defer resp.Body.Close()
+
+	// We drain the body so the underlying connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	// A version that is already gone doesn't need to be deleted anymore.
+	if method == http.MethodDelete &&
+		resp.StatusCode == http.StatusNotFound {
+
+		return nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unable to %v %v: %v", method, displayURL,
+			resp.Status)
+	}
+
+	return nil
+}
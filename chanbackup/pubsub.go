@@ -6,6 +6,7 @@ import (
 	"net"
 	"sync"
 
+	"github.com/Actinium-project/acmd/btcec"
 	"github.com/Actinium-project/acmd/wire"
 	"github.com/Actinium-project/lnd/channeldb"
 	"github.com/Actinium-project/lnd/keychain"
@@ -32,6 +33,16 @@ type ChannelWithAddrs struct {
 	Addrs []net.Addr
 }
 
+// NodeAddrs bundles a node's public key along with its latest advertised
+// set of addresses.
+type NodeAddrs struct {
+	// IdentityPub is the public key of the node.
+	IdentityPub *btcec.PublicKey
+
+	// Addrs is the latest set of addresses the node can be reached at.
+	Addrs []net.Addr
+}
+
 // ChannelEvent packages a new update of new channels since subscription, and
 // channels that have been opened since prior channel event.
 type ChannelEvent struct {
@@ -42,6 +53,10 @@ type ChannelEvent struct {
 	// NewChans is the set of channels that have been opened since the last
 	// event.
 	NewChans []ChannelWithAddrs
+
+	// UpdatedAddrs is the set of nodes that have advertised a new set of
+	// addresses since the last event.
+	UpdatedAddrs []NodeAddrs
 }
 
 // ChannelSubscription represents an intent to be notified of any updates to
@@ -184,6 +199,31 @@ func (s *SubSwapper) updateBackupFile() error {
 	return nil
 }
 
+// refreshAddrs refreshes the addresses of all backups of channels with the
+// given nodes. True is returned if any of the backups has been modified.
+func (s *SubSwapper) refreshAddrs(nodeAddrs []NodeAddrs) bool {
+	var refreshed bool
+	for _, node := range nodeAddrs {
+		for chanPoint, backup := range s.backupState {
+			if !backup.RemoteNodePub.IsEqual(node.IdentityPub) {
+				continue
+			}
+
+			if !backup.RefreshAddrs(node.Addrs) {
+				continue
+			}
+
+			log.Debugf("Refreshed addresses of channel %v in "+
+				"backup state: %v", chanPoint, backup.Addresses)
+
+			s.backupState[chanPoint] = backup
+			refreshed = true
+		}
+	}
+
+	return refreshed
+}
+
 // backupFileUpdater is the primary goroutine of the SubSwapper which is
 // responsible for listening for changes to the channel, and updating the
 // persistent multi backup state with a new packed multi of the latest channel
@@ -232,6 +272,21 @@ func (s *SubSwapper) backupUpdater() {
 				delete(s.backupState, closedChan)
 			}
 
+			// For all nodes that advertised new addresses, we'll
+			// refresh the addresses of the backups of our
+			// channels with them.
+			addrsRefreshed := s.refreshAddrs(chanUpdate.UpdatedAddrs)
+
+			// If the event only carried address updates that
+			// didn't modify any of our backups, then there's no
+			// need to rewrite the backup.
+			if len(chanUpdate.NewChans) == 0 &&
+				len(chanUpdate.ClosedChans) == 0 &&
+				!addrsRefreshed {
+
+				continue
+			}
+
 			newStateSize := len(s.backupState)
 
 			log.Infof("Updating on-disk multi SCB backup: "+
@@ -245,9 +300,6 @@ func (s *SubSwapper) backupUpdater() {
 					err)
 			}
 
-		// TODO(roasbeef): refresh periodically on a time basis due to
-		// possible addr changes from node
-
 		// Exit at once if a quit signal is detected.
 		case <-s.quit:
 			return
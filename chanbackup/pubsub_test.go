@@ -2,6 +2,7 @@ package chanbackup
 
 import (
 	"fmt"
+	"net"
 	"reflect"
 	"testing"
 	"time"
@@ -241,4 +242,49 @@ func TestSubSwapperUpdater(t *testing.T) {
 	// Verify that the new set of backups, now has one less after the
 	// sub-swapper switches the new set with the old.
 	assertExpectedBackupSwap(t, swapper, subSwapper, keyRing, backupSet)
+
+	// Next, we'll notify the sub-swapper of a new address of the peer of
+	// one of the remaining channels.
+	chanToRefresh := initialChanSet[1]
+	select {
+	case chanNotifier.chanEvents <- ChannelEvent{
+		UpdatedAddrs: []NodeAddrs{
+			{
+				IdentityPub: chanToRefresh.RemoteNodePub,
+				Addrs:       []net.Addr{addr1},
+			},
+		},
+	}:
+
+	case <-time.After(time.Second * 5):
+		t.Fatalf("update swapper didn't read addr update")
+	}
+
+	chanToRefresh.RefreshAddrs([]net.Addr{addr1})
+	backupSet[chanToRefresh.FundingOutpoint] = chanToRefresh
+
+	// The backup should now be rewritten with the refreshed address.
+	assertExpectedBackupSwap(t, swapper, subSwapper, keyRing, backupSet)
+
+	// Sending the same address again shouldn't modify the backup state, so
+	// no new backup should be written.
+	select {
+	case chanNotifier.chanEvents <- ChannelEvent{
+		UpdatedAddrs: []NodeAddrs{
+			{
+				IdentityPub: chanToRefresh.RemoteNodePub,
+				Addrs:       []net.Addr{addr1},
+			},
+		},
+	}:
+
+	case <-time.After(time.Second * 5):
+		t.Fatalf("update swapper didn't read addr update")
+	}
+
+	select {
+	case <-swapper.swaps:
+		t.Fatalf("backup swapped without modification")
+	case <-time.After(time.Millisecond * 100):
+	}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/Actinium-project/lnd/channeldb"
 	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/tlv"
 )
 
 // SingleBackupVersion denotes the version of the single static channel backup.
@@ -31,6 +32,41 @@ const (
 	// implicitly denotes that this channel uses the new tweakless commit
 	// format.
 	TweaklessCommitVersion = 1
+
+	// ChanTypeTLVVersion is the third SCB version. Rather than implying the
+	// commitment type through the version, this version appends a TLV
+	// stream to the base SCB format that records the exact channel type,
+	// the details of any funding shim used to open the channel, and hints
+	// on the state of the channel's shachain.
+	ChanTypeTLVVersion = 2
+
+	// ShaChainECDHVersion is the fourth SCB version. It uses the same
+	// format as ChanTypeTLVVersion, but denotes that the shachain root of
+	// the channel is the ECDH of the revocation root key with our
+	// multi-sig key, as used by nodes with a remote signer. Older nodes
+	// reject this version rather than deriving the wrong shachain root.
+	ShaChainECDHVersion = 3
+)
+
+const (
+	// MaxSingleAddrs is the maximum number of addresses a Single retains
+	// when its set of addresses is refreshed.
+	MaxSingleAddrs = 10
+
+	// chanTypeType is the TLV type of the channel type record.
+	chanTypeType tlv.Type = 0
+
+	// shimMultiSigKeyType is the TLV type of the record that carries our
+	// full multi-sig key for channels funded through a funding shim.
+	shimMultiSigKeyType tlv.Type = 1
+
+	// localCommitHeightType is the TLV type of the record that carries
+	// the height of our latest commitment at backup time.
+	localCommitHeightType tlv.Type = 3
+
+	// remoteCommitHeightType is the TLV type of the record that carries
+	// the height of the remote party's latest commitment at backup time.
+	remoteCommitHeightType tlv.Type = 5
 )
 
 // Single is a static description of an existing channel that can be used for
@@ -105,8 +141,30 @@ type Single struct {
 	RemoteChanCfg channeldb.ChannelConfig
 
 	// ShaChainRootDesc describes how to derive the private key that was
-	// used as the shachain root for this channel.
+	// used as the shachain root for this channel. As of
+	// ShaChainECDHVersion, its public key is unset and the shachain root
+	// is instead the ECDH of the private key of its key locator with our
+	// multi-sig key.
 	ShaChainRootDesc keychain.KeyDescriptor
+
+	// ChanType is the exact type of the channel. It's only serialized as
+	// of ChanTypeTLVVersion, for older versions it's derived from the
+	// version when deserializing.
+	ChanType channeldb.ChannelType
+
+	// ShimMultiSigKey is our full multi-sig public key for channels that
+	// were funded through a funding shim, as a key handed to us by an
+	// external funding flow may not be re-derivable from its locator
+	// alone. It's nil for regular channels.
+	ShimMultiSigKey *btcec.PublicKey
+
+	// LocalCommitHeight and RemoteCommitHeight are the heights of the
+	// latest local and remote commitments at the time the backup was
+	// created. As one shachain secret is revealed per revoked state, they
+	// hint at how far the shachain has been walked, which is useful when
+	// diagnosing a data loss recovery.
+	LocalCommitHeight  uint64
+	RemoteCommitHeight uint64
 }
 
 // NewSingle creates a new static channel backup based on an existing open
@@ -115,19 +173,36 @@ type Single struct {
 func NewSingle(channel *channeldb.OpenChannel,
 	nodeAddrs []net.Addr) Single {
 
-	// TODO(roasbeef): update after we start to store the KeyLoc for
-	// shachain root
-
-	// We'll need to obtain the shachain root which is derived directly
-	// from a private key in our keychain.
-	var b bytes.Buffer
-	channel.RevocationProducer.Encode(&b) // Can't return an error.
+	// If the shachain root of the channel is derived through ECDH, then
+	// the key locator of the revocation root key is all we need to
+	// re-derive it. We signal this through the version of the backup.
+	version := SingleBackupVersion(ShaChainECDHVersion)
+	shaChainRootDesc := keychain.KeyDescriptor{
+		KeyLocator: channel.RevocationKeyLocator,
+	}
+	if channel.RevocationKeyLocator.Family !=
+		keychain.KeyFamilyRevocationRoot {
 
-	// Once we have the root, we'll make a public key from it, such that
-	// the backups plaintext don't carry any private information. When we
-	// go to recover, we'll present this in order to derive the private
-	// key.
-	_, shaChainPoint := btcec.PrivKeyFromBytes(btcec.S256(), b.Bytes())
+		// Otherwise, we'll need to obtain the shachain root which is
+		// derived directly from a private key in our keychain.
+		var b bytes.Buffer
+		channel.RevocationProducer.Encode(&b) // Can't return an error.
+
+		// Once we have the root, we'll make a public key from it, such
+		// that the backups plaintext don't carry any private
+		// information. When we go to recover, we'll present this in
+		// order to derive the private key.
+		_, shaChainPoint := btcec.PrivKeyFromBytes(
+			btcec.S256(), b.Bytes(),
+		)
+		shaChainRootDesc = keychain.KeyDescriptor{
+			PubKey: shaChainPoint,
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamilyRevocationRoot,
+			},
+		}
+		version = ChanTypeTLVVersion
+	}
 
 	// If a channel is unconfirmed, the block height of the ShortChannelID
 	// is zero. This will lead to problems when trying to restore that
@@ -140,30 +215,96 @@ func NewSingle(channel *channeldb.OpenChannel,
 	}
 
 	single := Single{
-		IsInitiator:     channel.IsInitiator,
-		ChainHash:       channel.ChainHash,
-		FundingOutpoint: channel.FundingOutpoint,
-		ShortChannelID:  chanID,
-		RemoteNodePub:   channel.IdentityPub,
-		Addresses:       nodeAddrs,
-		Capacity:        channel.Capacity,
-		LocalChanCfg:    channel.LocalChanCfg,
-		RemoteChanCfg:   channel.RemoteChanCfg,
-		ShaChainRootDesc: keychain.KeyDescriptor{
-			PubKey: shaChainPoint,
-			KeyLocator: keychain.KeyLocator{
-				Family: keychain.KeyFamilyRevocationRoot,
-			},
-		},
+		Version:            version,
+		IsInitiator:        channel.IsInitiator,
+		ChainHash:          channel.ChainHash,
+		FundingOutpoint:    channel.FundingOutpoint,
+		ShortChannelID:     chanID,
+		RemoteNodePub:      channel.IdentityPub,
+		Addresses:          nodeAddrs,
+		Capacity:           channel.Capacity,
+		LocalChanCfg:       channel.LocalChanCfg,
+		RemoteChanCfg:      channel.RemoteChanCfg,
+		ShaChainRootDesc:   shaChainRootDesc,
+		ChanType:           channel.ChanType,
+		LocalCommitHeight:  channel.LocalCommitment.CommitHeight,
+		RemoteCommitHeight: channel.RemoteCommitment.CommitHeight,
+	}
+
+	// If the funding transaction was crafted externally through a funding
+	// shim, then we'll also store our full multi-sig key.
+	if !channel.ChanType.HasFundingTx() {
+		single.ShimMultiSigKey = channel.LocalChanCfg.MultiSigKey.PubKey
 	}
 
-	if channel.ChanType.IsTweakless() {
-		single.Version = TweaklessCommitVersion
-	} else {
-		single.Version = DefaultSingleVersion
+	return single
+}
+
+// RefreshAddrs updates the set of addresses of the backup with the latest set
+// of addresses of the remote node. Newly learned addresses are placed first,
+// and previously known addresses are retained after them as the node may
+// still be reachable over them, up to a total of MaxSingleAddrs. True is
+// returned if the set of addresses has been modified.
+func (s *Single) RefreshAddrs(newAddrs []net.Addr) bool {
+	seen := make(map[string]struct{})
+	addrs := make([]net.Addr, 0, len(newAddrs)+len(s.Addresses))
+	for _, addrSet := range [][]net.Addr{newAddrs, s.Addresses} {
+		for _, addr := range addrSet {
+			if _, ok := seen[addr.String()]; ok {
+				continue
+			}
+			if len(addrs) == MaxSingleAddrs {
+				break
+			}
+
+			seen[addr.String()] = struct{}{}
+			addrs = append(addrs, addr)
+		}
 	}
 
-	return single
+	// If the resulting set is identical to the existing one, there's
+	// nothing to update.
+	if len(addrs) == len(s.Addresses) {
+		changed := false
+		for i := range addrs {
+			if addrs[i].String() != s.Addresses[i].String() {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return false
+		}
+	}
+
+	s.Addresses = addrs
+	return true
+}
+
+// tlvStream returns the TLV stream used to encode and decode the extension
+// fields of ChanTypeTLVVersion backups. The shim key record is only included
+// if withShimKey is true, as it's optional.
+func (s *Single) tlvStream(chanType *uint8,
+	withShimKey bool) (*tlv.Stream, error) {
+
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(chanTypeType, chanType),
+	}
+	if withShimKey {
+		records = append(records, tlv.MakePrimitiveRecord(
+			shimMultiSigKeyType, &s.ShimMultiSigKey,
+		))
+	}
+	records = append(records,
+		tlv.MakePrimitiveRecord(
+			localCommitHeightType, &s.LocalCommitHeight,
+		),
+		tlv.MakePrimitiveRecord(
+			remoteCommitHeightType, &s.RemoteCommitHeight,
+		),
+	)
+
+	return tlv.NewStream(records...)
 }
 
 // Serialize attempts to write out the serialized version of the target
@@ -174,6 +315,8 @@ func (s *Single) Serialize(w io.Writer) error {
 	switch s.Version {
 	case DefaultSingleVersion:
 	case TweaklessCommitVersion:
+	case ChanTypeTLVVersion:
+	case ShaChainECDHVersion:
 	default:
 		return fmt.Errorf("unable to serialize w/ unknown "+
 			"version: %v", s.Version)
@@ -236,6 +379,21 @@ func (s *Single) Serialize(w io.Writer) error {
 		return err
 	}
 
+	// As of ChanTypeTLVVersion, the base SCB is followed by a TLV stream
+	// carrying the extension fields.
+	if s.Version >= ChanTypeTLVVersion {
+		chanType := uint8(s.ChanType)
+		tlvStream, err := s.tlvStream(
+			&chanType, s.ShimMultiSigKey != nil,
+		)
+		if err != nil {
+			return err
+		}
+		if err := tlvStream.Encode(&singleBytes); err != nil {
+			return err
+		}
+	}
+
 	return lnwire.WriteElements(
 		w,
 		byte(s.Version),
@@ -331,7 +489,11 @@ func (s *Single) Deserialize(r io.Reader) error {
 
 	switch s.Version {
 	case DefaultSingleVersion:
+		s.ChanType = channeldb.SingleFunderBit
 	case TweaklessCommitVersion:
+		s.ChanType = channeldb.SingleFunderTweaklessBit
+	case ChanTypeTLVVersion:
+	case ShaChainECDHVersion:
 	default:
 		return fmt.Errorf("unable to de-serialize w/ unknown "+
 			"version: %v", s.Version)
@@ -342,6 +504,14 @@ func (s *Single) Deserialize(r io.Reader) error {
 		return err
 	}
 
+	// We'll read the entire SCB up front, so we know where the TLV
+	// extension of newer versions ends.
+	singleBytes := make([]byte, length)
+	if _, err := io.ReadFull(r, singleBytes); err != nil {
+		return err
+	}
+	r = bytes.NewReader(singleBytes)
+
 	err = lnwire.ReadElements(
 		r, &s.IsInitiator, s.ChainHash[:], &s.FundingOutpoint,
 		&s.ShortChannelID, &s.RemoteNodePub, &s.Addresses, &s.Capacity,
@@ -426,7 +596,32 @@ func (s *Single) Deserialize(r io.Reader) error {
 	}
 	s.ShaChainRootDesc.KeyLocator.Family = keychain.KeyFamily(shaKeyFam)
 
-	return lnwire.ReadElements(r, &s.ShaChainRootDesc.KeyLocator.Index)
+	err = lnwire.ReadElements(r, &s.ShaChainRootDesc.KeyLocator.Index)
+	if err != nil {
+		return err
+	}
+
+	// Older versions end here, newer ones carry the remaining fields
+	// within a TLV stream.
+	if s.Version < ChanTypeTLVVersion {
+		return nil
+	}
+
+	var chanType uint8
+	tlvStream, err := s.tlvStream(&chanType, true)
+	if err != nil {
+		return err
+	}
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return err
+	}
+	if _, ok := parsedTypes[chanTypeType]; !ok {
+		return fmt.Errorf("channel type missing from SCB")
+	}
+	s.ChanType = channeldb.ChannelType(chanType)
+
+	return nil
 }
 
 // UnpackFromReader is similar to Deserialize method, but it expects the passed
@@ -2,6 +2,7 @@ package chanbackup
 
 import (
 	"bytes"
+	"fmt"
 	"math"
 	"math/rand"
 	"net"
@@ -83,6 +84,32 @@ func assertSingleEqual(t *testing.T, a, b Single) {
 			spew.Sdump(b.ShaChainRootDesc))
 	}
 
+	// The remaining fields are only serialized as of ChanTypeTLVVersion.
+	if a.Version >= ChanTypeTLVVersion {
+		if a.ChanType != b.ChanType {
+			t.Fatalf("chan type doesn't match: %v vs %v",
+				a.ChanType, b.ChanType)
+		}
+		switch {
+		case a.ShimMultiSigKey == nil && b.ShimMultiSigKey == nil:
+		case a.ShimMultiSigKey == nil || b.ShimMultiSigKey == nil:
+			t.Fatalf("shim keys don't match: %v vs %v",
+				a.ShimMultiSigKey, b.ShimMultiSigKey)
+		case !a.ShimMultiSigKey.IsEqual(b.ShimMultiSigKey):
+			t.Fatalf("shim keys don't match: %x vs %x",
+				a.ShimMultiSigKey.SerializeCompressed(),
+				b.ShimMultiSigKey.SerializeCompressed())
+		}
+		if a.LocalCommitHeight != b.LocalCommitHeight {
+			t.Fatalf("local commit height doesn't match: %v vs %v",
+				a.LocalCommitHeight, b.LocalCommitHeight)
+		}
+		if a.RemoteCommitHeight != b.RemoteCommitHeight {
+			t.Fatalf("remote commit height doesn't match: %v vs %v",
+				a.RemoteCommitHeight, b.RemoteCommitHeight)
+		}
+	}
+
 	if len(a.Addresses) != len(b.Addresses) {
 		t.Fatalf("expected %v addrs got %v", len(a.Addresses),
 			len(b.Addresses))
@@ -193,6 +220,12 @@ func genRandomOpenChannelShell() (*channeldb.OpenChannel, error) {
 				PubKey: pub,
 			},
 		},
+		LocalCommitment: channeldb.ChannelCommitment{
+			CommitHeight: uint64(rand.Int63()),
+		},
+		RemoteCommitment: channeldb.ChannelCommitment{
+			CommitHeight: uint64(rand.Int63()),
+		},
 		RevocationProducer: shaChainProducer,
 	}, nil
 }
@@ -235,6 +268,19 @@ func TestSinglePackUnpack(t *testing.T) {
 			valid:   true,
 		},
 
+		// The TLV version, should pack/unpack with no problem.
+		{
+			version: ChanTypeTLVVersion,
+			valid:   true,
+		},
+
+		// The ECDH shachain version, should pack/unpack with no
+		// problem.
+		{
+			version: ShaChainECDHVersion,
+			valid:   true,
+		},
+
 		// A non-default version, atm this should result in a failure.
 		{
 			version: 99,
@@ -450,4 +496,131 @@ func TestSingleUnconfirmedChannel(t *testing.T) {
 	}
 }
 
+// TestSingleShimMultiSigKey tests that the full multi-sig key of channels
+// funded through a funding shim is retained within the backup.
+func TestSingleShimMultiSigKey(t *testing.T) {
+	t.Parallel()
+
+	channel, err := genRandomOpenChannelShell()
+	if err != nil {
+		t.Fatalf("unable to gen open channel: %v", err)
+	}
+	channel.ChanType |= channeldb.NoFundingTxBit
+	channel.LocalChanCfg.MultiSigKey.PubKey = channel.IdentityPub
+
+	singleChanBackup := NewSingle(channel, []net.Addr{addr1})
+	if singleChanBackup.ShimMultiSigKey == nil {
+		t.Fatalf("shim multi-sig key not set")
+	}
+
+	// Only the locator of our local keys is serialized, so we'll clear
+	// the key before comparing the backups.
+	singleChanBackup.LocalChanCfg.MultiSigKey.PubKey = nil
+
+	var b bytes.Buffer
+	if err := singleChanBackup.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize single: %v", err)
+	}
+	var unpackedSingle Single
+	if err := unpackedSingle.Deserialize(&b); err != nil {
+		t.Fatalf("unable to deserialize single: %v", err)
+	}
+	unpackedSingle.RemoteNodePub.Curve = nil
+
+	assertSingleEqual(t, singleChanBackup, unpackedSingle)
+}
+
+// TestSingleRevocationKeyLocator tests that backups of channels whose shachain
+// root is derived through ECDH carry the key locator of the revocation root
+// key instead of the public key of the shachain root.
+func TestSingleRevocationKeyLocator(t *testing.T) {
+	t.Parallel()
+
+	channel, err := genRandomOpenChannelShell()
+	if err != nil {
+		t.Fatalf("unable to gen open channel: %v", err)
+	}
+	channel.RevocationKeyLocator = keychain.KeyLocator{
+		Family: keychain.KeyFamilyRevocationRoot,
+		Index:  5,
+	}
+
+	singleChanBackup := NewSingle(channel, []net.Addr{addr1})
+	if singleChanBackup.Version != ShaChainECDHVersion {
+		t.Fatalf("expected version %v, got %v", ShaChainECDHVersion,
+			singleChanBackup.Version)
+	}
+	if singleChanBackup.ShaChainRootDesc.PubKey != nil {
+		t.Fatalf("shachain root public key set")
+	}
+	if singleChanBackup.ShaChainRootDesc.KeyLocator !=
+		channel.RevocationKeyLocator {
+
+		t.Fatalf("expected key locator %v, got %v",
+			channel.RevocationKeyLocator,
+			singleChanBackup.ShaChainRootDesc.KeyLocator)
+	}
+
+	var b bytes.Buffer
+	if err := singleChanBackup.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize single: %v", err)
+	}
+	var unpackedSingle Single
+	if err := unpackedSingle.Deserialize(&b); err != nil {
+		t.Fatalf("unable to deserialize single: %v", err)
+	}
+	unpackedSingle.RemoteNodePub.Curve = nil
+
+	assertSingleEqual(t, singleChanBackup, unpackedSingle)
+}
+
+// TestSingleRefreshAddrs tests that refreshing the addresses of a backup
+// places new addresses first, retains the old ones, and reports whether
+// anything changed.
+func TestSingleRefreshAddrs(t *testing.T) {
+	t.Parallel()
+
+	addr3, _ := net.ResolveTCPAddr("tcp", "10.0.0.4:9000")
+
+	single := Single{
+		Addresses: []net.Addr{addr1, addr2},
+	}
+
+	// Refreshing with a subset of the known addresses in the same order
+	// shouldn't modify the backup.
+	if single.RefreshAddrs([]net.Addr{addr1}) {
+		t.Fatalf("addresses shouldn't have changed")
+	}
+
+	// A new address should be placed first, followed by the known ones.
+	if !single.RefreshAddrs([]net.Addr{addr3}) {
+		t.Fatalf("addresses should have changed")
+	}
+	expected := []net.Addr{addr3, addr1, addr2}
+	if len(single.Addresses) != len(expected) {
+		t.Fatalf("expected %v addrs, got %v", len(expected),
+			len(single.Addresses))
+	}
+	for i, addr := range expected {
+		if single.Addresses[i].String() != addr.String() {
+			t.Fatalf("addr mismatch: %v vs %v", addr,
+				single.Addresses[i])
+		}
+	}
+
+	// Finally, the number of retained addresses should be capped.
+	var manyAddrs []net.Addr
+	for i := 0; i < MaxSingleAddrs*2; i++ {
+		addr, _ := net.ResolveTCPAddr(
+			"tcp", fmt.Sprintf("10.0.1.%d:9000", i),
+		)
+		manyAddrs = append(manyAddrs, addr)
+	}
+	single.RefreshAddrs(manyAddrs)
+	if len(single.Addresses) != MaxSingleAddrs {
+		t.Fatalf("expected %v addrs, got %v", MaxSingleAddrs,
+			len(single.Addresses))
+	}
+}
+
 // TODO(roasbsef): fuzz parsing
@@ -0,0 +1,300 @@
+package chanbackup
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Actinium-project/lnd/clock"
+)
+
+const (
+	// DefaultSinkVersions is the default number of backup versions that
+	// a backup sink will retain before pruning the oldest ones.
+	DefaultSinkVersions = 10
+
+	// DefaultSinkRetryInterval is the default interval after which a
+	// failed push to a backup sink will be re-attempted if no newer backup
+	// has been produced in the meantime.
+	DefaultSinkRetryInterval = time.Minute
+
+	// sinkVersionPrefix and sinkVersionSuffix are the prefix and suffix
+	// of each versioned backup stored within a sink.
+	sinkVersionPrefix = "channel-"
+	sinkVersionSuffix = ".backup"
+)
+
+// BackupSink is a secondary location that the latest packed multi backup is
+// shipped to each time the main backup file is updated. Because a packed
+// multi is already encrypted with a key derived from the wallet seed, sinks
+// are free to store it on untrusted media.
+type BackupSink interface {
+	// Name returns a human readable identifier of the sink which is used
+	// in logs and status reports.
+	Name() string
+
+	// Push stores the packed multi backup under the given version
+	// identifier, pruning older versions according to the retention
+	// policy of the sink.
+	Push(version string, newBackup PackedMulti) error
+}
+
+// SinkStatus summarizes the health of a single backup sink.
+type SinkStatus struct {
+	// Name is the name of the sink, as returned by BackupSink.Name.
+	Name string
+
+	// LastAttempt is the time of the latest push attempt.
+	LastAttempt time.Time
+
+	// LastSuccess is the time of the latest successful push.
+	LastSuccess time.Time
+
+	// LastVersion is the version identifier of the latest backup that was
+	// successfully pushed to the sink.
+	LastVersion string
+
+	// LastErr is the error of the latest push attempt, or nil if it
+	// succeeded.
+	LastErr error
+
+	// NumPushes is the total number of successful pushes.
+	NumPushes uint64
+
+	// NumFailures is the total number of failed pushes.
+	NumFailures uint64
+}
+
+// sinkVersionName returns the version identifier of a backup created at the
+// given time. Identifiers sort lexicographically in creation order.
+func sinkVersionName(t time.Time) string {
+	return fmt.Sprintf("%s%020d%s", sinkVersionPrefix, t.UnixNano(),
+		sinkVersionSuffix)
+}
+
+// isSinkVersionName returns true if the passed name is a version identifier
+// created by sinkVersionName.
+func isSinkVersionName(name string) bool {
+	prefixLen := len(sinkVersionPrefix)
+	suffixLen := len(sinkVersionSuffix)
+	if len(name) != prefixLen+20+suffixLen {
+		return false
+	}
+	if name[:prefixLen] != sinkVersionPrefix ||
+		name[len(name)-suffixLen:] != sinkVersionSuffix {
+
+		return false
+	}
+	for _, c := range name[prefixLen : len(name)-suffixLen] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sinkUpdate is a single backup queued for delivery to a sink.
+type sinkUpdate struct {
+	version string
+	backup  PackedMulti
+}
+
+// sinkWorker delivers backups to a single sink. Only the latest pending
+// backup is retained, as every packed multi supersedes all prior ones.
+type sinkWorker struct {
+	sink BackupSink
+
+	pending chan sinkUpdate
+
+	statusMtx sync.Mutex
+	status    SinkStatus
+}
+
+// queue replaces any pending backup with the passed one. It must only be
+// called from a single goroutine.
+func (w *sinkWorker) queue(update sinkUpdate) {
+	select {
+	case <-w.pending:
+	default:
+	}
+
+	w.pending <- update
+}
+
+// push attempts to deliver the update to the sink and records the outcome.
+func (w *sinkWorker) push(update sinkUpdate, now time.Time) error {
+	err := w.sink.Push(update.version, update.backup)
+
+	w.statusMtx.Lock()
+	defer w.statusMtx.Unlock()
+
+	w.status.LastAttempt = now
+	w.status.LastErr = err
+	if err != nil {
+		w.status.NumFailures++
+		return err
+	}
+
+	w.status.LastSuccess = now
+	w.status.LastVersion = update.version
+	w.status.NumPushes++
+
+	return nil
+}
+
+// SinkSwapper is a Swapper that wraps the primary backup location, and ships
+// every new packed multi to a set of BackupSinks once the primary location
+// has been updated. When used as the Swapper of the SubSwapper, every channel
+// open or close is therefore shipped off-box automatically. Sinks are served
+// by independent goroutines so a slow or unreachable sink never delays the
+// primary backup or the other sinks.
+type SinkSwapper struct {
+	started sync.Once
+	stopped sync.Once
+
+	// Swapper is the primary backup location, usually the on-disk
+	// MultiFile.
+	Swapper
+
+	clock clock.Clock
+
+	retryInterval time.Duration
+
+	workers []*sinkWorker
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// A compile-time constraint to ensure SinkSwapper implements the Swapper
+// interface.
+var _ Swapper = (*SinkSwapper)(nil)
+
+// NewSinkSwapper creates a new SinkSwapper which updates the primary Swapper,
+// then forwards each new backup to the set of passed sinks.
+func NewSinkSwapper(primary Swapper, clock clock.Clock,
+	retryInterval time.Duration, sinks ...BackupSink) *SinkSwapper {
+
+	workers := make([]*sinkWorker, 0, len(sinks))
+	for _, sink := range sinks {
+		workers = append(workers, &sinkWorker{
+			sink:    sink,
+			pending: make(chan sinkUpdate, 1),
+			status: SinkStatus{
+				Name: sink.Name(),
+			},
+		})
+	}
+
+	return &SinkSwapper{
+		Swapper:       primary,
+		clock:         clock,
+		retryInterval: retryInterval,
+		workers:       workers,
+		quit:          make(chan struct{}),
+	}
+}
+
+// Start launches a delivery goroutine for each of the backup sinks.
+func (s *SinkSwapper) Start() error {
+	s.started.Do(func() {
+		log.Infof("Starting chanbackup.SinkSwapper with %v sinks",
+			len(s.workers))
+
+		for _, worker := range s.workers {
+			s.wg.Add(1)
+			go s.sinkPusher(worker)
+		}
+	})
+	return nil
+}
+
+// Stop signals all delivery goroutines to exit and waits for them to do so.
+// Backups that are still pending will not be delivered.
+func (s *SinkSwapper) Stop() error {
+	s.stopped.Do(func() {
+		log.Infof("Stopping chanbackup.SinkSwapper")
+
+		close(s.quit)
+		s.wg.Wait()
+	})
+	return nil
+}
+
+// UpdateAndSwap atomically updates the primary backup location, then queues
+// the new backup for delivery to all sinks. An error is only returned if the
+// primary location could not be updated.
+//
+// NOTE: This is part of the Swapper interface.
+func (s *SinkSwapper) UpdateAndSwap(newBackup PackedMulti) error {
+	if err := s.Swapper.UpdateAndSwap(newBackup); err != nil {
+		return err
+	}
+
+	update := sinkUpdate{
+		version: sinkVersionName(s.clock.Now()),
+		backup:  newBackup,
+	}
+	for _, worker := range s.workers {
+		worker.queue(update)
+	}
+
+	return nil
+}
+
+// SinkStatuses returns a snapshot of the status of every backup sink.
+func (s *SinkSwapper) SinkStatuses() []SinkStatus {
+	statuses := make([]SinkStatus, 0, len(s.workers))
+	for _, worker := range s.workers {
+		worker.statusMtx.Lock()
+		statuses = append(statuses, worker.status)
+		worker.statusMtx.Unlock()
+	}
+
+	return statuses
+}
+
+// sinkPusher is the goroutine responsible for delivering backups to a single
+// sink. A failed delivery is retried after the retry interval unless a newer
+// backup arrives first.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *SinkSwapper) sinkPusher(worker *sinkWorker) {
+	defer s.wg.Done()
+
+	var (
+		failed *sinkUpdate
+		retry  <-chan time.Time
+	)
+	for {
+		var update sinkUpdate
+		select {
+		case update = <-worker.pending:
+
+		case <-retry:
+			update = *failed
+
+		case <-s.quit:
+			return
+		}
+
+		failed, retry = nil, nil
+
+		log.Debugf("Pushing backup %v to sink %v", update.version,
+			worker.sink.Name())
+
+		err := worker.push(update, s.clock.Now())
+		if err != nil {
+			log.Errorf("Unable to push backup %v to sink %v: %v",
+				update.version, worker.sink.Name(), err)
+
+			failed = &update
+			retry = s.clock.TickAfter(s.retryInterval)
+			continue
+		}
+
+		log.Infof("Pushed backup %v to sink %v", update.version,
+			worker.sink.Name())
+	}
+}
@@ -0,0 +1,412 @@
+package chanbackup
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Actinium-project/lnd/clock"
+)
+
+// mockSink is a BackupSink that can be instructed to fail, and hands out all
+// pushed backups over a channel.
+type mockSink struct {
+	sync.Mutex
+	fail bool
+
+	pushes chan PackedMulti
+}
+
+func newMockSink() *mockSink {
+	return &mockSink{
+		pushes: make(chan PackedMulti, 10),
+	}
+}
+
+func (m *mockSink) Name() string {
+	return "mock"
+}
+
+func (m *mockSink) setFail(fail bool) {
+	m.Lock()
+	m.fail = fail
+	m.Unlock()
+}
+
+func (m *mockSink) Push(version string, newBackup PackedMulti) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.fail {
+		return fmt.Errorf("fail")
+	}
+
+	m.pushes <- newBackup
+
+	return nil
+}
+
+// nopSwapper is a Swapper that accepts every backup.
+type nopSwapper struct{}
+
+func (n *nopSwapper) UpdateAndSwap(newBackup PackedMulti) error {
+	return nil
+}
+
+func assertSinkPush(t *testing.T, sink *mockSink, expected PackedMulti) {
+	t.Helper()
+
+	select {
+	case backup := <-sink.pushes:
+		if !bytes.Equal(backup, expected) {
+			t.Fatalf("wrong backup pushed: expected %x, got %x",
+				expected, backup)
+		}
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("backup not pushed to sink")
+	}
+}
+
+// TestDirSinkRetention tests that the directory sink keeps the latest backup
+// up to date, and only retains the configured number of versions.
+func TestDirSinkRetention(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "dirsink")
+	if err != nil {
+		t.Fatalf("unable to make temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	const maxVersions = 3
+	sinkDir := filepath.Join(tempDir, "nas")
+	sink := NewDirSink(sinkDir, maxVersions)
+
+	startTime := time.Unix(1000, 0)
+	var versions []string
+	for i := 0; i < 5; i++ {
+		backup, err := makeFakePackedMulti()
+		if err != nil {
+			t.Fatalf("unable to make backup: %v", err)
+		}
+
+		version := sinkVersionName(
+			startTime.Add(time.Duration(i) * time.Second),
+		)
+		versions = append(versions, version)
+		if err := sink.Push(version, backup); err != nil {
+			t.Fatalf("unable to push backup: %v", err)
+		}
+
+		assertBackupMatches(
+			t, filepath.Join(sinkDir, DefaultBackupFileName),
+			backup,
+		)
+		assertBackupMatches(t, filepath.Join(sinkDir, version), backup)
+	}
+
+	storedVersions, err := sink.Versions()
+	if err != nil {
+		t.Fatalf("unable to list versions: %v", err)
+	}
+	expected := versions[len(versions)-maxVersions:]
+	if strings.Join(storedVersions, ",") != strings.Join(expected, ",") {
+		t.Fatalf("wrong versions retained: expected %v, got %v",
+			expected, storedVersions)
+	}
+}
+
+// TestHTTPSink tests that the HTTP sink uploads each version along with the
+// latest backup, and deletes versions that exceed the retention limit.
+func TestHTTPSink(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mtx     sync.Mutex
+		objects = make(map[string][]byte)
+	)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mtx.Lock()
+			defer mtx.Unlock()
+
+			switch r.Method {
+			case http.MethodPut:
+				body, _ := ioutil.ReadAll(r.Body)
+				objects[r.URL.Path] = body
+
+			case http.MethodDelete:
+				delete(objects, r.URL.Path)
+
+			default:
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		},
+	))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL+"/backups/", 2, time.Second*5)
+
+	var versions []string
+	var latest PackedMulti
+	for i := 0; i < 3; i++ {
+		backup, err := makeFakePackedMulti()
+		if err != nil {
+			t.Fatalf("unable to make backup: %v", err)
+		}
+		latest = backup
+
+		version := sinkVersionName(time.Unix(int64(i), 0))
+		versions = append(versions, version)
+		if err := sink.Push(version, backup); err != nil {
+			t.Fatalf("unable to push backup: %v", err)
+		}
+	}
+
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	if len(objects) != 3 {
+		t.Fatalf("expected 3 objects, got %v", len(objects))
+	}
+	if _, ok := objects["/backups/"+versions[0]]; ok {
+		t.Fatalf("oldest version wasn't pruned")
+	}
+	if !bytes.Equal(objects["/backups/"+DefaultBackupFileName], latest) {
+		t.Fatalf("latest backup not uploaded")
+	}
+}
+
+// TestHTTPSinkError tests that a non-success status code is reported as an
+// error.
+func TestHTTPSinkError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		},
+	))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL, 2, time.Second*5)
+	err := sink.Push(sinkVersionName(time.Unix(0, 0)), PackedMulti{1})
+	if err == nil {
+		t.Fatalf("expected push to fail")
+	}
+}
+
+// TestHTTPSinkPrune tests that the HTTP sink only tracks versions whose
+// uploads succeeded, and that failing to prune an old version doesn't fail the
+// push, while a version that is already gone counts as pruned.
+func TestHTTPSinkPrune(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mtx          sync.Mutex
+		failLatest   bool
+		deleteStatus = http.StatusInternalServerError
+	)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mtx.Lock()
+			defer mtx.Unlock()
+
+			switch {
+			case r.Method == http.MethodDelete:
+				w.WriteHeader(deleteStatus)
+
+			case failLatest && strings.HasSuffix(
+				r.URL.Path, DefaultBackupFileName,
+			):
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		},
+	))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL, 1, time.Second*5)
+	push := func(i int) error {
+		return sink.Push(
+			sinkVersionName(time.Unix(int64(i), 0)), PackedMulti{1},
+		)
+	}
+	assertVersions := func(num int) {
+		t.Helper()
+
+		sink.mtx.Lock()
+		defer sink.mtx.Unlock()
+
+		if len(sink.versions) != num {
+			t.Fatalf("expected %v versions, got %v", num,
+				len(sink.versions))
+		}
+	}
+
+	// A version whose latest backup failed to upload shouldn't be
+	// tracked.
+	mtx.Lock()
+	failLatest = true
+	mtx.Unlock()
+	if err := push(0); err == nil {
+		t.Fatalf("expected push to fail")
+	}
+	assertVersions(0)
+
+	mtx.Lock()
+	failLatest = false
+	mtx.Unlock()
+	if err := push(1); err != nil {
+		t.Fatalf("unable to push backup: %v", err)
+	}
+	assertVersions(1)
+
+	// Failing to prune the oldest version shouldn't fail the push, and
+	// the version should be kept for the next attempt.
+	if err := push(2); err != nil {
+		t.Fatalf("unable to push backup: %v", err)
+	}
+	assertVersions(2)
+
+	// Once the server reports the old versions as gone, they should be
+	// considered pruned.
+	mtx.Lock()
+	deleteStatus = http.StatusNotFound
+	mtx.Unlock()
+	if err := push(3); err != nil {
+		t.Fatalf("unable to push backup: %v", err)
+	}
+	assertVersions(1)
+}
+
+// TestHTTPSinkRedactsCredentials tests that credentials embedded in the base
+// URL of the HTTP sink don't leak through its name or errors.
+func TestHTTPSinkRedactsCredentials(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		},
+	))
+	defer server.Close()
+
+	baseURL := strings.Replace(
+		server.URL, "http://", "http://user:secret@", 1,
+	)
+	sink := NewHTTPSink(baseURL, 2, time.Second*5)
+
+	name := sink.Name()
+	if strings.Contains(name, "user") || strings.Contains(name, "secret") {
+		t.Fatalf("sink name leaks credentials: %v", name)
+	}
+	if name != "http:"+server.URL {
+		t.Fatalf("unexpected sink name: %v", name)
+	}
+
+	err := sink.Push(sinkVersionName(time.Unix(0, 0)), PackedMulti{1})
+	if err == nil {
+		t.Fatalf("expected push to fail")
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Fatalf("push error leaks credentials: %v", err)
+	}
+}
+
+// TestSinkSwapperRetry tests that the SinkSwapper pushes each new backup to
+// its sinks, records the sink status, and retries failed pushes.
+func TestSinkSwapperRetry(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Unix(1000, 0)
+	testClock := clock.NewTestClock(startTime)
+
+	sink := newMockSink()
+	sinkSwapper := NewSinkSwapper(
+		&nopSwapper{}, testClock, time.Minute, sink,
+	)
+	if err := sinkSwapper.Start(); err != nil {
+		t.Fatalf("unable to start sink swapper: %v", err)
+	}
+	defer sinkSwapper.Stop()
+
+	backup, err := makeFakePackedMulti()
+	if err != nil {
+		t.Fatalf("unable to make backup: %v", err)
+	}
+	if err := sinkSwapper.UpdateAndSwap(backup); err != nil {
+		t.Fatalf("unable to swap: %v", err)
+	}
+	assertSinkPush(t, sink, backup)
+
+	// The status should be updated once the push returned, so we'll
+	// poll for it.
+	waitStatus := func(pred func(SinkStatus) bool) SinkStatus {
+		t.Helper()
+
+		var status SinkStatus
+		for i := 0; i < 100; i++ {
+			status = sinkSwapper.SinkStatuses()[0]
+			if pred(status) {
+				return status
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("unexpected sink status: %v", status)
+		return status
+	}
+	status := waitStatus(func(s SinkStatus) bool {
+		return s.NumPushes == 1
+	})
+	if status.LastVersion != sinkVersionName(startTime) {
+		t.Fatalf("wrong version: %v", status.LastVersion)
+	}
+
+	// Next, we'll make the sink fail, and ensure the failure is reported.
+	sink.setFail(true)
+	backup, err = makeFakePackedMulti()
+	if err != nil {
+		t.Fatalf("unable to make backup: %v", err)
+	}
+	if err := sinkSwapper.UpdateAndSwap(backup); err != nil {
+		t.Fatalf("unable to swap: %v", err)
+	}
+	waitStatus(func(s SinkStatus) bool {
+		return s.NumFailures == 1 && s.LastErr != nil
+	})
+
+	// Once the sink recovers and the retry interval passes, the failed
+	// backup should be pushed. As the retry timer is armed right after
+	// the status is updated, we'll keep advancing the clock until the
+	// retry is observed.
+	sink.setFail(false)
+	var retried bool
+	for i := 1; i <= 100 && !retried; i++ {
+		testClock.SetTime(startTime.Add(time.Duration(i) * time.Minute))
+
+		select {
+		case retryBackup := <-sink.pushes:
+			if !bytes.Equal(retryBackup, backup) {
+				t.Fatalf("wrong backup retried")
+			}
+			retried = true
+
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	if !retried {
+		t.Fatalf("failed backup wasn't retried")
+	}
+	waitStatus(func(s SinkStatus) bool {
+		return s.NumPushes == 2 && s.LastErr == nil
+	})
+}
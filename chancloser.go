@@ -9,6 +9,7 @@ import (
 	"github.com/Actinium-project/acmutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/Actinium-project/lnd/htlcswitch"
+	"github.com/Actinium-project/lnd/labels"
 	"github.com/Actinium-project/lnd/lnwallet"
 	"github.com/Actinium-project/lnd/lnwallet/chainfee"
 	"github.com/Actinium-project/lnd/lnwire"
@@ -83,8 +84,9 @@ type chanCloseCfg struct {
 	// further HTLC's should be routed through the channel.
 	unregisterChannel func(lnwire.ChannelID)
 
-	// broadcastTx broadcasts the passed transaction to the network.
-	broadcastTx func(*wire.MsgTx) error
+	// broadcastTx broadcasts the passed transaction to the network,
+	// attaching the given label to it.
+	broadcastTx func(*wire.MsgTx, string) error
 
 	// disableChannel disables a channel, resulting in it not being able to
 	// forward payments.
@@ -526,7 +528,11 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 			newLogClosure(func() string {
 				return spew.Sdump(closeTx)
 			}))
-		if err := c.cfg.broadcastTx(closeTx); err != nil {
+		shortChanID := c.cfg.channel.ShortChanID()
+		label := labels.MakeLabel(
+			labels.LabelTypeChannelClose, &shortChanID,
+		)
+		if err := c.cfg.broadcastTx(closeTx, label); err != nil {
 			return nil, false, err
 		}
 
@@ -3,11 +3,13 @@ package lnd
 import (
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/Actinium-project/acmd/btcec"
 	"github.com/Actinium-project/acmd/wire"
 	"github.com/Actinium-project/lnd/chanbackup"
 	"github.com/Actinium-project/lnd/channelnotifier"
+	"github.com/Actinium-project/lnd/routing"
 )
 
 // addrSource is an interface that allow us to get the addresses for a target
@@ -32,8 +34,18 @@ type channelNotifier struct {
 	// us to get the latest set of addresses for a given node. We'll need
 	// this to be able to create an SCB for new channels.
 	addrs addrSource
+
+	// subscribeTopology allows us to subscribe to graph topology changes,
+	// so the SCBs of existing channels can be refreshed once a peer
+	// advertises a new set of addresses.
+	subscribeTopology func() (*routing.TopologyClient, error)
 }
 
+// topologyRetryInterval is the interval at which we retry subscribing to
+// topology changes, as the router may not yet be active when the channel
+// subscription is created.
+const topologyRetryInterval = time.Second
+
 // SubscribeChans requests a new channel subscription relative to the initial
 // set of known channels. We use the knownChans as a synchronization point to
 // ensure that the chanbackup.SubSwapper does not miss any channel open or
@@ -132,6 +144,12 @@ func (c *channelNotifier) SubscribeChans(startingChans map[wire.OutPoint]struct{
 		}
 	}()
 
+	// Additionally, we'll forward any address updates of nodes to the
+	// sub-swapper.
+	if c.subscribeTopology != nil {
+		go c.forwardAddrUpdates(chanUpdates, quit)
+	}
+
 	return &chanbackup.ChannelSubscription{
 		ChanUpdates: chanUpdates,
 		Cancel: func() {
@@ -140,6 +158,71 @@ func (c *channelNotifier) SubscribeChans(startingChans map[wire.OutPoint]struct{
 	}, nil
 }
 
+// forwardAddrUpdates subscribes to graph topology changes, and sends the new
+// set of addresses of every updated node to the sub-swapper. It must be run as
+// a goroutine.
+func (c *channelNotifier) forwardAddrUpdates(
+	chanUpdates chan chanbackup.ChannelEvent, quit chan struct{}) {
+
+	// The router is started after the sub-swapper has subscribed, so
+	// we'll retry until we're able to subscribe.
+	var (
+		topologyClient *routing.TopologyClient
+		err            error
+	)
+	for {
+		topologyClient, err = c.subscribeTopology()
+		if err == nil {
+			break
+		}
+
+		ltndLog.Debugf("Unable to subscribe to topology for channel "+
+			"backups, retrying: %v", err)
+
+		select {
+		case <-time.After(topologyRetryInterval):
+		case <-quit:
+			return
+		}
+	}
+	defer topologyClient.Cancel()
+
+	for {
+		select {
+		case topChange, ok := <-topologyClient.TopologyChanges:
+			if !ok {
+				return
+			}
+
+			var updatedAddrs []chanbackup.NodeAddrs
+			for _, nodeUpdate := range topChange.NodeUpdates {
+				updatedAddrs = append(
+					updatedAddrs, chanbackup.NodeAddrs{
+						IdentityPub: nodeUpdate.IdentityKey,
+						Addrs:       nodeUpdate.Addresses,
+					},
+				)
+			}
+			if len(updatedAddrs) == 0 {
+				continue
+			}
+
+			chanEvent := chanbackup.ChannelEvent{
+				UpdatedAddrs: updatedAddrs,
+			}
+
+			select {
+			case chanUpdates <- chanEvent:
+			case <-quit:
+				return
+			}
+
+		case <-quit:
+			return
+		}
+	}
+}
+
 // A compile-time constraint to ensure channelNotifier implements
 // chanbackup.ChannelNotifier.
 var _ chanbackup.ChannelNotifier = (*channelNotifier)(nil)
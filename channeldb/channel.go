@@ -58,6 +58,11 @@ var (
 	// shutdown script for the remote peer.
 	remoteUpfrontShutdownKey = []byte("remote-upfront-shutdown-key")
 
+	// revocationKeyLocatorKey can be accessed within the bucket for a
+	// channel (identified by its chanPoint). This key stores the optional
+	// key locator of the revocation root key of the channel.
+	revocationKeyLocatorKey = []byte("revocation-key-locator-key")
+
 	// chanCommitmentKey can be accessed within the sub-bucket for a
 	// particular channel. This key stores the up to date commitment state
 	// for a particular channel party. Appending a 0 to the end of this key
@@ -582,6 +587,14 @@ type OpenChannel struct {
 	// secret producer is shachain producer.
 	RevocationProducer shachain.Producer
 
+	// RevocationKeyLocator is the key locator of the revocation root key.
+	// The ECDH of its private key with our multi-sig key seeds the
+	// RevocationProducer, so that the private key never has to leave the
+	// key ring. It's only set for channels opened with a remote signer,
+	// the producer of other channels is seeded by the revocation root
+	// private key itself.
+	RevocationKeyLocator keychain.KeyLocator
+
 	// RevocationStore is used to efficiently store the revocations for
 	// previous channels states sent to us by remote side. Current
 	// implementation of secret store is shachain store.
@@ -2814,11 +2827,56 @@ func putChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	return putOptionalUpfrontShutdownScript(
+	if err := putOptionalUpfrontShutdownScript(
 		chanBucket, remoteUpfrontShutdownKey, channel.RemoteShutdownScript,
+	); err != nil {
+		return err
+	}
+
+	return putOptionalRevocationKeyLocator(
+		chanBucket, channel.RevocationKeyLocator,
 	)
 }
 
+// putOptionalRevocationKeyLocator adds the key locator of the revocation root
+// key if it's set. Channels with an unset key locator derive their revocation
+// producer from the revocation root private key.
+func putOptionalRevocationKeyLocator(chanBucket *bbolt.Bucket,
+	keyLoc keychain.KeyLocator) error {
+
+	if keyLoc.Family != keychain.KeyFamilyRevocationRoot {
+		return nil
+	}
+
+	var w bytes.Buffer
+	err := WriteElements(&w, uint32(keyLoc.Family), keyLoc.Index)
+	if err != nil {
+		return err
+	}
+
+	return chanBucket.Put(revocationKeyLocatorKey, w.Bytes())
+}
+
+// getOptionalRevocationKeyLocator reads the key locator of the revocation root
+// key if it is present.
+func getOptionalRevocationKeyLocator(chanBucket *bbolt.Bucket,
+	keyLoc *keychain.KeyLocator) error {
+
+	locBytes := chanBucket.Get(revocationKeyLocatorKey)
+	if locBytes == nil {
+		return nil
+	}
+
+	var family uint32
+	r := bytes.NewReader(locBytes)
+	if err := ReadElements(r, &family, &keyLoc.Index); err != nil {
+		return err
+	}
+	keyLoc.Family = keychain.KeyFamily(family)
+
+	return nil
+}
+
 // putOptionalUpfrontShutdownScript adds a shutdown script under the key
 // provided if it has a non-zero length.
 func putOptionalUpfrontShutdownScript(chanBucket *bbolt.Bucket, key []byte,
@@ -2985,8 +3043,14 @@ func fetchChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	return getOptionalUpfrontShutdownScript(
+	if err := getOptionalUpfrontShutdownScript(
 		chanBucket, remoteUpfrontShutdownKey, &channel.RemoteShutdownScript,
+	); err != nil {
+		return err
+	}
+
+	return getOptionalRevocationKeyLocator(
+		chanBucket, &channel.RevocationKeyLocator,
 	)
 }
 
@@ -366,6 +366,10 @@ func createTestChannelState(t *testing.T, cdb *DB) *OpenChannel {
 		Db:                      cdb,
 		Packager:                NewChannelPackager(chanID),
 		FundingTxn:              testTx,
+		RevocationKeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamilyRevocationRoot,
+			Index:  3,
+		},
 	}
 }
 
@@ -0,0 +1,37 @@
+package channeldb
+
+import "github.com/coreos/bbolt"
+
+var (
+	// deliveryIndexBucket is the name of the bucket that stores the next
+	// unused derivation index of each extended public key that delivery
+	// addresses are derived from.
+	deliveryIndexBucket = []byte("delivery-index")
+)
+
+// NextDeliveryIndex returns the next unused derivation index of the extended
+// public key identified by the given key, and marks it as used. Every index is
+// handed out only once, even across restarts.
+func (d *DB) NextDeliveryIndex(key []byte) (uint32, error) {
+	var index uint32
+	err := d.Update(func(tx *bbolt.Tx) error {
+		indexes, err := tx.CreateBucketIfNotExists(deliveryIndexBucket)
+		if err != nil {
+			return err
+		}
+
+		if v := indexes.Get(key); v != nil {
+			index = byteOrder.Uint32(v)
+		}
+
+		var next [4]byte
+		byteOrder.PutUint32(next[:], index+1)
+
+		return indexes.Put(key, next[:])
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return index, nil
+}
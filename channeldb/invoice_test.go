@@ -1,6 +1,7 @@
 package channeldb
 
 import (
+	"bytes"
 	"crypto/rand"
 	"math"
 	"reflect"
@@ -110,6 +111,7 @@ func TestInvoiceWorkflow(t *testing.T) {
 	copy(fakeInvoice.Terms.PaymentPreimage[:], rev[:])
 	fakeInvoice.Terms.Value = lnwire.NewMSatFromSatoshis(10000)
 	fakeInvoice.Terms.Features = emptyFeatures
+	fakeInvoice.Terms.HoldDuration = time.Hour
 
 	paymentHash := fakeInvoice.Terms.PaymentPreimage.Hash()
 
@@ -981,3 +983,217 @@ func TestCustomRecords(t *testing.T) {
 		t.Fatalf("invalid custom records")
 	}
 }
+
+// TestInvoiceHtlcAMP asserts that invoices can be looked up by their payment
+// address, and that the AMP details of their htlcs are stored along with the
+// preimages they are settled with.
+func TestInvoiceHtlcAMP(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	testInvoice, err := randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	testInvoice.Terms.PaymentAddr = [32]byte{1}
+
+	paymentHash := testInvoice.Terms.PaymentPreimage.Hash()
+	if _, err := db.AddInvoice(testInvoice, paymentHash); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	hash, err := db.LookupInvoiceHashByPayAddr([32]byte{1})
+	if err != nil {
+		t.Fatalf("unable to lookup invoice by payment addr: %v", err)
+	}
+	if hash != paymentHash {
+		t.Fatalf("expected hash %v, got %v", paymentHash, hash)
+	}
+
+	// A second invoice with the same payment address must be refused.
+	dupInvoice, err := randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	dupInvoice.Terms.PaymentAddr = [32]byte{1}
+	_, err = db.AddInvoice(
+		dupInvoice, dupInvoice.Terms.PaymentPreimage.Hash(),
+	)
+	if err != ErrDuplicatePayAddr {
+		t.Fatalf("expected ErrDuplicatePayAddr, got %v", err)
+	}
+
+	// Accept an AMP htlc, and settle the invoice with its preimage.
+	key := CircuitKey{ChanID: lnwire.NewShortChanIDFromInt(1), HtlcID: 4}
+	htlcPreimage := lntypes.Preimage{2}
+	ampData := &InvoiceHtlcAMPData{
+		Record: *record.NewAMP([32]byte{3}, [32]byte{4}, 5),
+		Hash:   htlcPreimage.Hash(),
+	}
+
+	_, err = db.UpdateInvoice(paymentHash,
+		func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+			return &InvoiceUpdateDesc{
+				AddHtlcs: map[CircuitKey]*HtlcAcceptDesc{
+					key: {
+						Amt:           1000,
+						MppTotalAmt:   1000,
+						CustomRecords: make(record.CustomSet),
+						AMP:           ampData,
+					},
+				},
+				State: &InvoiceStateUpdateDesc{
+					NewState: ContractSettled,
+					Preimage: testInvoice.Terms.PaymentPreimage,
+				},
+				HTLCPreimages: map[CircuitKey]lntypes.Preimage{
+					key: htlcPreimage,
+				},
+			}, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+
+	dbInvoice, err := db.LookupInvoice(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to lookup invoice: %v", err)
+	}
+
+	htlc := dbInvoice.Htlcs[key]
+	if htlc.State != HtlcStateSettled {
+		t.Fatalf("expected htlc to be settled, got %v", htlc.State)
+	}
+
+	ampData.Preimage = &htlcPreimage
+	if !reflect.DeepEqual(ampData, htlc.AMP) {
+		t.Fatalf("expected amp data %v, got %v", spew.Sdump(ampData),
+			spew.Sdump(htlc.AMP))
+	}
+}
+
+// TestReusableInvoicePayments asserts that the payments to a reusable invoice
+// settle their htlcs while the invoice stays open, are stored along with their
+// custom records, and survive the cancelation of the invoice.
+func TestReusableInvoicePayments(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	testInvoice, err := randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	testInvoice.Reusable = true
+
+	paymentHash := testInvoice.Terms.PaymentPreimage.Hash()
+	if _, err := db.AddInvoice(testInvoice, paymentHash); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	pay := func(htlcID uint64, customRecords record.CustomSet) *Invoice {
+		key := CircuitKey{HtlcID: htlcID}
+		invoice, err := db.UpdateInvoice(paymentHash,
+			func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+				return &InvoiceUpdateDesc{
+					AddHtlcs: map[CircuitKey]*HtlcAcceptDesc{
+						key: {
+							Amt:           1000,
+							CustomRecords: customRecords,
+						},
+					},
+					Payment: &InvoicePaymentDesc{
+						Htlcs: map[CircuitKey]struct{}{
+							key: {},
+						},
+					},
+				}, nil
+			},
+		)
+		if err != nil {
+			t.Fatalf("unable to pay invoice: %v", err)
+		}
+
+		if invoice.State != ContractOpen {
+			t.Fatalf("expected open invoice, got %v", invoice.State)
+		}
+		if invoice.Htlcs[key].State != HtlcStateSettled {
+			t.Fatalf("expected settled htlc, got %v",
+				invoice.Htlcs[key].State)
+		}
+
+		return invoice
+	}
+
+	pay(1, record.CustomSet{})
+	pay(2, record.CustomSet{100000: []byte{1, 2, 3}})
+
+	// Canceling the invoice leaves the settled htlcs untouched.
+	_, err = db.UpdateInvoice(paymentHash,
+		func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+			return &InvoiceUpdateDesc{
+				State: &InvoiceStateUpdateDesc{
+					NewState: ContractCanceled,
+				},
+			}, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to cancel invoice: %v", err)
+	}
+
+	dbInvoice, err := db.LookupInvoice(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to lookup invoice: %v", err)
+	}
+	if !dbInvoice.Reusable {
+		t.Fatalf("expected reusable invoice")
+	}
+	if dbInvoice.AmtPaid != 2000 {
+		t.Fatalf("expected amount paid 2000, got %v", dbInvoice.AmtPaid)
+	}
+	if len(dbInvoice.Payments) != 2 {
+		t.Fatalf("expected 2 payments, got %v", len(dbInvoice.Payments))
+	}
+	for i, payment := range dbInvoice.Payments {
+		if payment.Index != uint64(i+1) || payment.Amt != 1000 ||
+			payment.NumHtlcs != 1 {
+
+			t.Fatalf("unexpected payment %v: %+v", i, payment)
+		}
+	}
+	customRecords := dbInvoice.Payments[1].CustomRecords
+	if !bytes.Equal(customRecords[100000], []byte{1, 2, 3}) {
+		t.Fatalf("unexpected custom records: %v", customRecords)
+	}
+
+	// Payments to invoices that aren't reusable must be refused.
+	otherInvoice, err := randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	otherHash := otherInvoice.Terms.PaymentPreimage.Hash()
+	if _, err := db.AddInvoice(otherInvoice, otherHash); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+	_, err = db.UpdateInvoice(otherHash,
+		func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+			return &InvoiceUpdateDesc{
+				Payment: &InvoicePaymentDesc{},
+			}, nil
+		},
+	)
+	if err != ErrInvoiceNotReusable {
+		t.Fatalf("expected ErrInvoiceNotReusable, got %v", err)
+	}
+}
@@ -64,6 +64,32 @@ var (
 	//   settleIndexNo => invoiceKey
 	settleIndexBucket = []byte("invoice-settle-index")
 
+	// payAddrIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which indexes invoices by their payment address. This
+	// index is used to look up the invoice an atomic multi-path payment
+	// pays to, as its htlcs don't carry the payment hash of the invoice.
+	//
+	// maps: payAddr => payHash
+	payAddrIndexBucket = []byte("invoice-payaddr-index")
+
+	// invoicePaymentsBucket is the name of the sub-bucket within the
+	// invoiceBucket which stores the payments received by reusable
+	// invoices. Within it, every reusable invoice that received a payment
+	// has a sub-bucket of its own, keyed by its invoice ID, in which the
+	// payments are keyed by their index.
+	//
+	// maps: invoiceKey => paymentIndex => payment
+	invoicePaymentsBucket = []byte("invoice-payments")
+
+	// ErrInvoiceNotReusable is returned when a payment is recorded for an
+	// invoice that doesn't accept multiple payments.
+	ErrInvoiceNotReusable = errors.New("invoice is not reusable")
+
+	// ErrDuplicatePayAddr is returned when an invoice with the payment
+	// address of a new invoice already exists.
+	ErrDuplicatePayAddr = errors.New("invoice with payment addr " +
+		"already exists")
+
 	// ErrInvoiceAlreadySettled is returned when the invoice is already
 	// settled.
 	ErrInvoiceAlreadySettled = errors.New("invoice already settled")
@@ -119,6 +145,9 @@ const (
 	expiryHeightType tlv.Type = 13
 	htlcStateType    tlv.Type = 15
 	mppTotalAmtType  tlv.Type = 17
+	ampRecordType    tlv.Type = 19
+	ampHashType      tlv.Type = 21
+	ampPreimageType  tlv.Type = 23
 
 	// A set of tlv type definitions used to serialize invoice bodiees.
 	//
@@ -140,6 +169,25 @@ const (
 	featuresType    tlv.Type = 11
 	invStateType    tlv.Type = 12
 	amtPaidType     tlv.Type = 13
+	reusableType    tlv.Type = 14
+
+	keySendSenderType   tlv.Type = 15
+	keySendVerifiedType tlv.Type = 16
+	keySendReplyToType  tlv.Type = 17
+
+	holdDurationType tlv.Type = 18
+	cancelReasonType tlv.Type = 19
+
+	// A set of tlv type definitions used to serialize the payments of
+	// reusable invoices.
+	//
+	// NOTE: A migration should be added whenever this list changes. This
+	// prevents against the database being rolled back to an older
+	// format where the surrounding logic might assume a different set of
+	// fields are known.
+	invPaymentAmtType        tlv.Type = 1
+	invPaymentSettleTimeType tlv.Type = 3
+	invPaymentNumHtlcsType   tlv.Type = 5
 )
 
 // ContractState describes the state the invoice is in.
@@ -175,6 +223,48 @@ func (c ContractState) String() string {
 	return "Unknown"
 }
 
+// CancelReason describes why an invoice was canceled.
+type CancelReason uint8
+
+const (
+	// CancelReasonNone is the reason of invoices that aren't canceled, or
+	// that were canceled before reasons were recorded.
+	CancelReasonNone CancelReason = 0
+
+	// CancelReasonUser means the invoice was canceled on request of the
+	// user.
+	CancelReasonUser CancelReason = 1
+
+	// CancelReasonExpired means the invoice expired before it was paid.
+	CancelReasonExpired CancelReason = 2
+
+	// CancelReasonHoldTimeout means the accepted hold invoice was neither
+	// settled nor canceled within its maximum hold duration.
+	CancelReasonHoldTimeout CancelReason = 3
+
+	// CancelReasonHtlcExpiry means the accepted hold invoice was canceled
+	// because one of its htlcs came too close to its expiry height.
+	CancelReasonHtlcExpiry CancelReason = 4
+)
+
+// String returns a human readable identifier for the CancelReason type.
+func (c CancelReason) String() string {
+	switch c {
+	case CancelReasonNone:
+		return "None"
+	case CancelReasonUser:
+		return "User"
+	case CancelReasonExpired:
+		return "Expired"
+	case CancelReasonHoldTimeout:
+		return "HoldTimeout"
+	case CancelReasonHtlcExpiry:
+		return "HtlcExpiry"
+	}
+
+	return "Unknown"
+}
+
 // ContractTerm is a companion struct to the Invoice struct. This struct houses
 // the necessary conditions required before the invoice can be considered fully
 // settled by the payee.
@@ -201,6 +291,11 @@ type ContractTerm struct {
 
 	// Features is the feature vectors advertised on the payment request.
 	Features *lnwire.FeatureVector
+
+	// HoldDuration is the maximum time a hold invoice is kept in the
+	// accepted state before it is canceled automatically. If zero, only
+	// the global limit of the invoice registry applies.
+	HoldDuration time.Duration
 }
 
 // Invoice is a payment invoice generated by a payee in order to request
@@ -267,6 +362,57 @@ type Invoice struct {
 	// Htlcs records all htlcs that paid to this invoice. Some of these
 	// htlcs may have been marked as canceled.
 	Htlcs map[CircuitKey]*InvoiceHTLC
+
+	// Reusable indicates that the invoice accepts any number of payments.
+	// A reusable invoice stays open after a payment has been received, and
+	// records every payment separately in Payments. Its htlcs are settled
+	// per payment rather than along with the invoice.
+	Reusable bool
+
+	// Payments are the payments received by a reusable invoice, in the
+	// order they were received.
+	Payments []InvoicePayment
+
+	// KeySendSender is the compressed public key the sender of a
+	// spontaneous (keysend) payment identified itself with, if any. The
+	// message of the sender is stored as the memo of the invoice.
+	KeySendSender []byte
+
+	// KeySendSenderVerified indicates whether the signature of the sender
+	// of a keysend payment over the payment was valid.
+	KeySendSenderVerified bool
+
+	// KeySendReplyTo is a payment request the sender of a keysend payment
+	// supplied to be replied to, if any.
+	KeySendReplyTo []byte
+
+	// CancelReason describes why the invoice was canceled. It is only set
+	// for canceled invoices.
+	CancelReason CancelReason
+}
+
+// InvoicePayment describes a single payment received by a reusable invoice,
+// which consists of a complete set of htlcs.
+type InvoicePayment struct {
+	// Index is the index of the payment among the payments to the
+	// invoice.
+	//
+	// NOTE: This index starts at 1.
+	Index uint64
+
+	// Amt is the total amount of the htlcs of the payment.
+	Amt lnwire.MilliSatoshi
+
+	// SettleTime is the time at which the htlcs of the payment were
+	// settled.
+	SettleTime time.Time
+
+	// NumHtlcs is the number of htlcs the payment consisted of.
+	NumHtlcs uint32
+
+	// CustomRecords contains the custom key/value pairs that accompanied
+	// the htlcs of the payment.
+	CustomRecords record.CustomSet
 }
 
 // HtlcState defines the states an htlc paying to an invoice can be in.
@@ -317,6 +463,25 @@ type InvoiceHTLC struct {
 	// CustomRecords contains the custom key/value pairs that accompanied
 	// the htlc.
 	CustomRecords record.CustomSet
+
+	// AMP contains the AMP details of the htlc, if it is a shard of an
+	// atomic multi-path payment.
+	AMP *InvoiceHtlcAMPData
+}
+
+// InvoiceHtlcAMPData contains the details of an htlc that is a shard of an
+// atomic multi-path payment.
+type InvoiceHtlcAMPData struct {
+	// Record is the AMP record that accompanied the htlc.
+	Record record.AMP
+
+	// Hash is the payment hash of the htlc, which differs from the hash of
+	// the invoice.
+	Hash lntypes.Hash
+
+	// Preimage is the preimage of the htlc. It is only known once the
+	// full set of htlcs of the payment has been received.
+	Preimage *lntypes.Preimage
 }
 
 // HtlcAcceptDesc describes the details of a newly accepted htlc.
@@ -337,6 +502,10 @@ type HtlcAcceptDesc struct {
 	// CustomRecords contains the custom key/value pairs that accompanied
 	// the htlc.
 	CustomRecords record.CustomSet
+
+	// AMP contains the AMP details of the htlc, if it is a shard of an
+	// atomic multi-path payment.
+	AMP *InvoiceHtlcAMPData
 }
 
 // InvoiceUpdateDesc describes the changes that should be applied to the
@@ -352,6 +521,23 @@ type InvoiceUpdateDesc struct {
 	// AddHtlcs describes the newly accepted htlcs that need to be added to
 	// the invoice.
 	AddHtlcs map[CircuitKey]*HtlcAcceptDesc
+
+	// Payment describes a complete set of htlcs that is settled as a
+	// payment to a reusable invoice, which stays open. If nil, no payment
+	// is recorded.
+	Payment *InvoicePaymentDesc
+
+	// HTLCPreimages contains the preimages of the AMP htlcs that are
+	// settled by this update, which differ from the preimage of the
+	// invoice.
+	HTLCPreimages map[CircuitKey]lntypes.Preimage
+}
+
+// InvoicePaymentDesc describes a payment to a reusable invoice.
+type InvoicePaymentDesc struct {
+	// Htlcs are the accepted htlcs that make up the payment. They may
+	// include htlcs that are added by the same update.
+	Htlcs map[CircuitKey]struct{}
 }
 
 // InvoiceStateUpdateDesc describes an invoice-level state transition.
@@ -361,6 +547,9 @@ type InvoiceStateUpdateDesc struct {
 
 	// Preimage must be set to the preimage when NewState is settled.
 	Preimage lntypes.Preimage
+
+	// CancelReason is recorded on the invoice when NewState is canceled.
+	CancelReason CancelReason
 }
 
 // InvoiceUpdateCallback is a callback used in the db transaction to update the
@@ -380,6 +569,15 @@ func validateInvoice(i *Invoice) error {
 	if i.Terms.Features == nil {
 		return errors.New("invoice must have a feature vector")
 	}
+	if len(i.KeySendSender) != 0 && len(i.KeySendSender) != 33 {
+		return fmt.Errorf("keysend sender must be 33 bytes, length "+
+			"provided was %v", len(i.KeySendSender))
+	}
+	if len(i.KeySendReplyTo) > MaxPaymentRequestSize {
+		return fmt.Errorf("max length of keysend reply-to is %v, "+
+			"length provided was %v", MaxPaymentRequestSize,
+			len(i.KeySendReplyTo))
+	}
 	return nil
 }
 
@@ -419,6 +617,12 @@ func (d *DB) AddInvoice(newInvoice *Invoice, paymentHash lntypes.Hash) (
 		if err != nil {
 			return err
 		}
+		payAddrIndex, err := invoices.CreateBucketIfNotExists(
+			payAddrIndexBucket,
+		)
+		if err != nil {
+			return err
+		}
 
 		// Ensure that an invoice an identical payment hash doesn't
 		// already exist within the index.
@@ -426,6 +630,20 @@ func (d *DB) AddInvoice(newInvoice *Invoice, paymentHash lntypes.Hash) (
 			return ErrDuplicateInvoice
 		}
 
+		// Index the invoice by its payment address, if it has one, so
+		// that atomic multi-path payments can find it.
+		payAddr := newInvoice.Terms.PaymentAddr
+		if payAddr != ([32]byte{}) {
+			if payAddrIndex.Get(payAddr[:]) != nil {
+				return ErrDuplicatePayAddr
+			}
+
+			err := payAddrIndex.Put(payAddr[:], paymentHash[:])
+			if err != nil {
+				return err
+			}
+		}
+
 		// If the current running payment ID counter hasn't yet been
 		// created, then create it now.
 		var invoiceNum uint32
@@ -568,6 +786,35 @@ func (d *DB) LookupInvoice(paymentHash [32]byte) (Invoice, error) {
 	return invoice, nil
 }
 
+// LookupInvoiceHashByPayAddr returns the payment hash of the invoice with the
+// given payment address. Only invoices that were added since the payment
+// address index was introduced can be found.
+func (d *DB) LookupInvoiceHashByPayAddr(payAddr [32]byte) (lntypes.Hash,
+	error) {
+
+	var hash lntypes.Hash
+	err := d.View(func(tx *bbolt.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return ErrNoInvoicesCreated
+		}
+		payAddrIndex := invoices.Bucket(payAddrIndexBucket)
+		if payAddrIndex == nil {
+			return ErrInvoiceNotFound
+		}
+
+		hashBytes := payAddrIndex.Get(payAddr[:])
+		if hashBytes == nil {
+			return ErrInvoiceNotFound
+		}
+		copy(hash[:], hashBytes)
+
+		return nil
+	})
+
+	return hash, err
+}
+
 // InvoiceWithPaymentHash is used to store an invoice and its corresponding
 // payment hash. This struct is only used to store results of
 // ChannelDB.FetchAllInvoicesWithPaymentHash() call.
@@ -1004,9 +1251,21 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 	value := uint64(i.Terms.Value)
 	cltvDelta := uint32(i.Terms.FinalCltvDelta)
 	expiry := uint64(i.Terms.Expiry)
+	holdDuration := uint64(i.Terms.HoldDuration)
 
 	amtPaid := uint64(i.AmtPaid)
 	state := uint8(i.State)
+	cancelReason := uint8(i.CancelReason)
+
+	var reusable uint8
+	if i.Reusable {
+		reusable = 1
+	}
+
+	var keySendVerified uint8
+	if i.KeySendSenderVerified {
+		keySendVerified = 1
+	}
 
 	tlvStream, err := tlv.NewStream(
 		// Memo and payreq.
@@ -1030,6 +1289,16 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 		// Invoice state.
 		tlv.MakePrimitiveRecord(invStateType, &state),
 		tlv.MakePrimitiveRecord(amtPaidType, &amtPaid),
+		tlv.MakePrimitiveRecord(reusableType, &reusable),
+
+		// Keysend details.
+		tlv.MakePrimitiveRecord(keySendSenderType, &i.KeySendSender),
+		tlv.MakePrimitiveRecord(keySendVerifiedType, &keySendVerified),
+		tlv.MakePrimitiveRecord(keySendReplyToType, &i.KeySendReplyTo),
+
+		// Hold policy.
+		tlv.MakePrimitiveRecord(holdDurationType, &holdDuration),
+		tlv.MakePrimitiveRecord(cancelReasonType, &cancelReason),
 	)
 	if err != nil {
 		return err
@@ -1079,6 +1348,28 @@ func serializeHtlcs(w io.Writer, htlcs map[CircuitKey]*InvoiceHTLC) error {
 			tlv.MakePrimitiveRecord(mppTotalAmtType, &mppTotalAmt),
 		)
 
+		// Add the AMP details of the htlc, if it is a shard of an
+		// atomic multi-path payment.
+		if htlc.AMP != nil {
+			ampRecord := htlc.AMP.Record
+			ampHash := [32]byte(htlc.AMP.Hash)
+			records = append(records,
+				tlv.MakeDynamicRecord(
+					ampRecordType, &ampRecord,
+					ampRecord.PayloadSize,
+					record.AMPEncoder, record.AMPDecoder,
+				),
+				tlv.MakePrimitiveRecord(ampHashType, &ampHash),
+			)
+
+			if htlc.AMP.Preimage != nil {
+				preimage := [32]byte(*htlc.AMP.Preimage)
+				records = append(records, tlv.MakePrimitiveRecord(
+					ampPreimageType, &preimage,
+				))
+			}
+		}
+
 		// Convert the custom records to tlv.Record types that are ready
 		// for serialization.
 		customRecords := tlv.MapToRecords(htlc.CustomRecords)
@@ -1120,7 +1411,148 @@ func fetchInvoice(invoiceNum []byte, invoices *bbolt.Bucket) (Invoice, error) {
 
 	invoiceReader := bytes.NewReader(invoiceBytes)
 
-	return deserializeInvoice(invoiceReader)
+	invoice, err := deserializeInvoice(invoiceReader)
+	if err != nil {
+		return Invoice{}, err
+	}
+
+	// Reusable invoices record their payments in a sub-bucket of their
+	// own, so we'll load them as well.
+	if invoice.Reusable {
+		invoice.Payments, err = fetchInvoicePayments(
+			invoiceNum, invoices,
+		)
+		if err != nil {
+			return Invoice{}, err
+		}
+	}
+
+	return invoice, nil
+}
+
+// fetchInvoicePayments fetches the payments received by the reusable invoice
+// with the given invoice number, ordered by their index.
+func fetchInvoicePayments(invoiceNum []byte,
+	invoices *bbolt.Bucket) ([]InvoicePayment, error) {
+
+	paymentsBucket := invoices.Bucket(invoicePaymentsBucket)
+	if paymentsBucket == nil {
+		return nil, nil
+	}
+
+	invoicePayments := paymentsBucket.Bucket(invoiceNum)
+	if invoicePayments == nil {
+		return nil, nil
+	}
+
+	var payments []InvoicePayment
+	err := invoicePayments.ForEach(func(k, v []byte) error {
+		payment, err := deserializeInvoicePayment(bytes.NewReader(v))
+		if err != nil {
+			return err
+		}
+		payment.Index = byteOrder.Uint64(k)
+
+		payments = append(payments, payment)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+// putInvoicePayment stores the payment received by the reusable invoice with
+// the given invoice number, and sets its index.
+func putInvoicePayment(invoices *bbolt.Bucket, invoiceNum []byte,
+	payment *InvoicePayment) error {
+
+	paymentsBucket, err := invoices.CreateBucketIfNotExists(
+		invoicePaymentsBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	invoicePayments, err := paymentsBucket.CreateBucketIfNotExists(
+		invoiceNum,
+	)
+	if err != nil {
+		return err
+	}
+
+	paymentIndex, err := invoicePayments.NextSequence()
+	if err != nil {
+		return err
+	}
+	payment.Index = paymentIndex
+
+	var b bytes.Buffer
+	if err := serializeInvoicePayment(&b, payment); err != nil {
+		return err
+	}
+
+	var paymentKey [8]byte
+	byteOrder.PutUint64(paymentKey[:], paymentIndex)
+
+	return invoicePayments.Put(paymentKey[:], b.Bytes())
+}
+
+// serializeInvoicePayment serializes a payment to a reusable invoice to a
+// writer.
+func serializeInvoicePayment(w io.Writer, payment *InvoicePayment) error {
+	amt := uint64(payment.Amt)
+	settleTime := uint64(payment.SettleTime.UnixNano())
+
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(invPaymentAmtType, &amt),
+		tlv.MakePrimitiveRecord(invPaymentSettleTimeType, &settleTime),
+		tlv.MakePrimitiveRecord(
+			invPaymentNumHtlcsType, &payment.NumHtlcs,
+		),
+	}
+
+	// Append the custom records. Their ids are in the experimental range
+	// and sorted, so there is no need to sort again.
+	records = append(records, tlv.MapToRecords(payment.CustomRecords)...)
+
+	tlvStream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// deserializeInvoicePayment reads a payment to a reusable invoice from a
+// reader.
+func deserializeInvoicePayment(r io.Reader) (InvoicePayment, error) {
+	var (
+		payment         InvoicePayment
+		amt, settleTime uint64
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(invPaymentAmtType, &amt),
+		tlv.MakePrimitiveRecord(invPaymentSettleTimeType, &settleTime),
+		tlv.MakePrimitiveRecord(
+			invPaymentNumHtlcsType, &payment.NumHtlcs,
+		),
+	)
+	if err != nil {
+		return payment, err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return payment, err
+	}
+
+	payment.Amt = lnwire.MilliSatoshi(amt)
+	payment.SettleTime = time.Unix(0, int64(settleTime))
+	payment.CustomRecords = hop.NewCustomRecords(parsedTypes)
+
+	return payment, nil
 }
 
 func deserializeInvoice(r io.Reader) (Invoice, error) {
@@ -1131,6 +1563,12 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 		expiry    uint64
 		amtPaid   uint64
 		state     uint8
+		reusable  uint8
+
+		keySendVerified uint8
+
+		holdDuration uint64
+		cancelReason uint8
 
 		creationDateBytes []byte
 		settleDateBytes   []byte
@@ -1160,6 +1598,16 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 		// Invoice state.
 		tlv.MakePrimitiveRecord(invStateType, &state),
 		tlv.MakePrimitiveRecord(amtPaidType, &amtPaid),
+		tlv.MakePrimitiveRecord(reusableType, &reusable),
+
+		// Keysend details.
+		tlv.MakePrimitiveRecord(keySendSenderType, &i.KeySendSender),
+		tlv.MakePrimitiveRecord(keySendVerifiedType, &keySendVerified),
+		tlv.MakePrimitiveRecord(keySendReplyToType, &i.KeySendReplyTo),
+
+		// Hold policy.
+		tlv.MakePrimitiveRecord(holdDurationType, &holdDuration),
+		tlv.MakePrimitiveRecord(cancelReasonType, &cancelReason),
 	)
 	if err != nil {
 		return i, err
@@ -1180,8 +1628,21 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 	i.Terms.Value = lnwire.MilliSatoshi(value)
 	i.Terms.FinalCltvDelta = int32(cltvDelta)
 	i.Terms.Expiry = time.Duration(expiry)
+	i.Terms.HoldDuration = time.Duration(holdDuration)
 	i.AmtPaid = lnwire.MilliSatoshi(amtPaid)
 	i.State = ContractState(state)
+	i.CancelReason = CancelReason(cancelReason)
+	i.Reusable = reusable == 1
+	i.KeySendSenderVerified = keySendVerified == 1
+
+	// Invoices that weren't paid by keysend, or whose sender didn't attach
+	// these records, have no keysend details.
+	if len(i.KeySendSender) == 0 {
+		i.KeySendSender = nil
+	}
+	if len(i.KeySendReplyTo) == 0 {
+		i.KeySendReplyTo = nil
+	}
 
 	err = i.CreationDate.UnmarshalBinary(creationDateBytes)
 	if err != nil {
@@ -1237,6 +1698,8 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 			state                   uint8
 			acceptTime, resolveTime uint64
 			amt, mppTotalAmt        uint64
+			ampRecord               record.AMP
+			ampHash, ampPreimage    [32]byte
 		)
 		tlvStream, err := tlv.NewStream(
 			tlv.MakePrimitiveRecord(chanIDType, &chanID),
@@ -1250,6 +1713,13 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 			tlv.MakePrimitiveRecord(expiryHeightType, &htlc.Expiry),
 			tlv.MakePrimitiveRecord(htlcStateType, &state),
 			tlv.MakePrimitiveRecord(mppTotalAmtType, &mppTotalAmt),
+			tlv.MakeDynamicRecord(
+				ampRecordType, &ampRecord,
+				ampRecord.PayloadSize,
+				record.AMPEncoder, record.AMPDecoder,
+			),
+			tlv.MakePrimitiveRecord(ampHashType, &ampHash),
+			tlv.MakePrimitiveRecord(ampPreimageType, &ampPreimage),
 		)
 		if err != nil {
 			return nil, err
@@ -1267,6 +1737,20 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 		htlc.Amt = lnwire.MilliSatoshi(amt)
 		htlc.MppTotalAmt = lnwire.MilliSatoshi(mppTotalAmt)
 
+		// Restore the AMP details if the htlc is a shard of an atomic
+		// multi-path payment.
+		if _, ok := parsedTypes[ampRecordType]; ok {
+			htlc.AMP = &InvoiceHtlcAMPData{
+				Record: ampRecord,
+				Hash:   lntypes.Hash(ampHash),
+			}
+
+			if _, ok := parsedTypes[ampPreimageType]; ok {
+				preimage := lntypes.Preimage(ampPreimage)
+				htlc.AMP.Preimage = &preimage
+			}
+		}
+
 		// Reconstruct the custom records fields from the parsed types
 		// map return from the tlv parser.
 		htlc.CustomRecords = hop.NewCustomRecords(parsedTypes)
@@ -1294,6 +1778,16 @@ func copyInvoiceHTLC(src *InvoiceHTLC) *InvoiceHTLC {
 		result.CustomRecords[k] = v
 	}
 
+	// Make a copy of the AMP details.
+	if src.AMP != nil {
+		amp := *src.AMP
+		if src.AMP.Preimage != nil {
+			preimage := *src.AMP.Preimage
+			amp.Preimage = &preimage
+		}
+		result.AMP = &amp
+	}
+
 	return &result
 }
 
@@ -1312,6 +1806,10 @@ func copyInvoice(src *Invoice) *Invoice {
 		Htlcs: make(
 			map[CircuitKey]*InvoiceHTLC, len(src.Htlcs),
 		),
+		Reusable:              src.Reusable,
+		KeySendSender:         copySlice(src.KeySendSender),
+		KeySendSenderVerified: src.KeySendSenderVerified,
+		KeySendReplyTo:        copySlice(src.KeySendReplyTo),
 	}
 
 	dest.Terms.Features = src.Terms.Features.Clone()
@@ -1320,6 +1818,16 @@ func copyInvoice(src *Invoice) *Invoice {
 		dest.Htlcs[k] = copyInvoiceHTLC(v)
 	}
 
+	for _, payment := range src.Payments {
+		customRecords := make(record.CustomSet)
+		for k, v := range payment.CustomRecords {
+			customRecords[k] = v
+		}
+		payment.CustomRecords = customRecords
+
+		dest.Payments = append(dest.Payments, payment)
+	}
+
 	return &dest
 }
 
@@ -1388,11 +1896,40 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bbolt.Bucke
 			AcceptTime:    now,
 			State:         HtlcStateAccepted,
 			CustomRecords: htlcUpdate.CustomRecords,
+			AMP:           htlcUpdate.AMP,
 		}
 
 		invoice.Htlcs[key] = htlc
 	}
 
+	// Set the preimages of the AMP htlcs that are settled by this update,
+	// now that the newly accepted htlcs have been added.
+	for key, preimage := range update.HTLCPreimages {
+		htlc, ok := invoice.Htlcs[key]
+		if !ok {
+			return nil, fmt.Errorf("preimage for non-existent "+
+				"htlc %v", key)
+		}
+
+		if htlc.AMP == nil || preimage.Hash() != htlc.AMP.Hash {
+			return nil, ErrInvoicePreimageMismatch
+		}
+
+		preimage := preimage
+		htlc.AMP.Preimage = &preimage
+	}
+
+	// Settle the htlcs of a payment to a reusable invoice, and record the
+	// payment.
+	if update.Payment != nil {
+		err := settleInvoicePayment(
+			invoices, invoiceNum, &invoice, update.Payment, now,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Align htlc states with invoice state and recalculate amount paid.
 	var (
 		amtPaid     lnwire.MilliSatoshi
@@ -1422,6 +1959,14 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bbolt.Bucke
 			continue
 		}
 
+		// The htlcs of the payments to a reusable invoice are settled
+		// independently of the invoice state, and remain settled if
+		// the invoice is canceled later on.
+		if invoice.Reusable && htlc.State == HtlcStateSettled {
+			amtPaid += htlc.Amt
+			continue
+		}
+
 		// The invoice state may have changed and this could have
 		// implications for the states of the individual htlcs. Align
 		// the htlc state with the current invoice state.
@@ -1460,6 +2005,57 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bbolt.Bucke
 	return &invoice, nil
 }
 
+// settleInvoicePayment settles the htlcs of a payment to a reusable invoice,
+// and stores the payment.
+func settleInvoicePayment(invoices *bbolt.Bucket, invoiceNum []byte,
+	invoice *Invoice, desc *InvoicePaymentDesc, now time.Time) error {
+
+	if !invoice.Reusable {
+		return ErrInvoiceNotReusable
+	}
+
+	// Payments are only received while the invoice is open.
+	if invoice.State != ContractOpen {
+		return fmt.Errorf("payment to invoice in state %v",
+			invoice.State)
+	}
+
+	payment := InvoicePayment{
+		SettleTime:    now,
+		CustomRecords: make(record.CustomSet),
+	}
+	for key := range desc.Htlcs {
+		htlc, ok := invoice.Htlcs[key]
+		if !ok {
+			return fmt.Errorf("payment with non-existent htlc %v",
+				key)
+		}
+
+		if htlc.State != HtlcStateAccepted {
+			return fmt.Errorf("payment with htlc %v in state %v",
+				key, htlc.State)
+		}
+
+		htlc.State = HtlcStateSettled
+		htlc.ResolveTime = now
+
+		payment.Amt += htlc.Amt
+		payment.NumHtlcs++
+		for k, v := range htlc.CustomRecords {
+			payment.CustomRecords[k] = v
+		}
+	}
+
+	err := putInvoicePayment(invoices, invoiceNum, &payment)
+	if err != nil {
+		return err
+	}
+
+	invoice.Payments = append(invoice.Payments, payment)
+
+	return nil
+}
+
 // updateInvoiceState validates and processes an invoice state update.
 func updateInvoiceState(invoice *Invoice, hash lntypes.Hash,
 	update InvoiceStateUpdateDesc) error {
@@ -1494,6 +2090,10 @@ func updateInvoiceState(invoice *Invoice, hash lntypes.Hash,
 			invoice.Terms.PaymentPreimage = update.Preimage
 		}
 
+		if update.NewState == ContractCanceled {
+			invoice.CancelReason = update.CancelReason
+		}
+
 	// Once settled, we are in a terminal state.
 	case ContractSettled:
 		return ErrInvoiceAlreadySettled
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Actinium-project/acmd/btcec"
 	bitcoinCfg "github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
 	"github.com/coreos/bbolt"
 	"github.com/Actinium-project/lnd/zpay32"
 	litecoinCfg "github.com/ltcsuite/ltcd/chaincfg"
@@ -144,13 +145,14 @@ func TestMigrateInvoicesHodl(t *testing.T) {
 		true)
 }
 
-// signDigestCompact generates a test signature to be used in the generation of
-// test payment requests.
-func signDigestCompact(hash []byte) ([]byte, error) {
+// signMessageCompact generates a test signature to be used in the generation
+// of test payment requests.
+func signMessageCompact(msg []byte) ([]byte, error) {
 	// Should the signature reference a compressed public key or not.
 	isCompressedKey := true
 
 	privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), testPrivKeyBytes)
+	hash := chainhash.HashB(msg)
 
 	// btcec.SignCompact returns a pubkey-recoverable signature
 	sig, err := btcec.SignCompact(
@@ -178,7 +180,7 @@ func getPayReq(net *bitcoinCfg.Params) (string, error) {
 	}
 	return payReq.Encode(
 		zpay32.MessageSigner{
-			SignCompact: signDigestCompact,
+			SignCompact: signMessageCompact,
 		},
 	)
 }
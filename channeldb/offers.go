@@ -0,0 +1,83 @@
+package channeldb
+
+import (
+	"fmt"
+
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// offerBucket is the name of the bucket that stores the offers this
+	// node issued, keyed by their id.
+	offerBucket = []byte("offers")
+
+	// ErrOfferNotFound is returned when an offer isn't found in the
+	// database.
+	ErrOfferNotFound = fmt.Errorf("unable to locate offer")
+
+	// ErrDuplicateOffer is returned when an offer is added with an id
+	// that's already known.
+	ErrDuplicateOffer = fmt.Errorf("offer with id already exists")
+)
+
+// AddOffer stores an offer this node issued under its id. The offer is stored
+// in its encoded form.
+func (d *DB) AddOffer(offerID [32]byte, offer string) error {
+	return d.Update(func(tx *bbolt.Tx) error {
+		offers, err := tx.CreateBucketIfNotExists(offerBucket)
+		if err != nil {
+			return err
+		}
+
+		if offers.Get(offerID[:]) != nil {
+			return ErrDuplicateOffer
+		}
+
+		return offers.Put(offerID[:], []byte(offer))
+	})
+}
+
+// LookupOffer returns the encoded offer with the given id.
+func (d *DB) LookupOffer(offerID [32]byte) (string, error) {
+	var offer string
+	err := d.View(func(tx *bbolt.Tx) error {
+		offers := tx.Bucket(offerBucket)
+		if offers == nil {
+			return ErrOfferNotFound
+		}
+
+		offerBytes := offers.Get(offerID[:])
+		if offerBytes == nil {
+			return ErrOfferNotFound
+		}
+		offer = string(offerBytes)
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return offer, nil
+}
+
+// FetchOffers returns all encoded offers this node issued.
+func (d *DB) FetchOffers() ([]string, error) {
+	var offerList []string
+	err := d.View(func(tx *bbolt.Tx) error {
+		offers := tx.Bucket(offerBucket)
+		if offers == nil {
+			return nil
+		}
+
+		return offers.ForEach(func(_, v []byte) error {
+			offerList = append(offerList, string(v))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return offerList, nil
+}
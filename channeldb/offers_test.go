@@ -0,0 +1,59 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestOffers tests that offers can be added to and fetched from the database.
+func TestOffers(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// Before any offer is added, lookups fail and no offers are listed.
+	_, err = cdb.LookupOffer([32]byte{1})
+	if err != ErrOfferNotFound {
+		t.Fatalf("expected ErrOfferNotFound, got %v", err)
+	}
+	offers, err := cdb.FetchOffers()
+	if err != nil {
+		t.Fatalf("unable to fetch offers: %v", err)
+	}
+	if len(offers) != 0 {
+		t.Fatalf("expected no offers, got %v", offers)
+	}
+
+	if err := cdb.AddOffer([32]byte{1}, "lno1a"); err != nil {
+		t.Fatalf("unable to add offer: %v", err)
+	}
+	if err := cdb.AddOffer([32]byte{2}, "lno1b"); err != nil {
+		t.Fatalf("unable to add offer: %v", err)
+	}
+
+	// An offer can't be added twice.
+	err = cdb.AddOffer([32]byte{1}, "lno1c")
+	if err != ErrDuplicateOffer {
+		t.Fatalf("expected ErrDuplicateOffer, got %v", err)
+	}
+
+	offer, err := cdb.LookupOffer([32]byte{2})
+	if err != nil {
+		t.Fatalf("unable to look up offer: %v", err)
+	}
+	if offer != "lno1b" {
+		t.Fatalf("unexpected offer %v", offer)
+	}
+
+	offers, err = cdb.FetchOffers()
+	if err != nil {
+		t.Fatalf("unable to fetch offers: %v", err)
+	}
+	if !reflect.DeepEqual(offers, []string{"lno1a", "lno1b"}) {
+		t.Fatalf("unexpected offers %v", offers)
+	}
+}
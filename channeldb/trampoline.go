@@ -0,0 +1,90 @@
+package channeldb
+
+import (
+	"bytes"
+
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// trampolineForwardBucket is the name of the bucket that stores the
+	// incoming htlcs of the payments this node forwarded on behalf of a
+	// trampoline onion, keyed by their payment hash.
+	trampolineForwardBucket = []byte("trampoline-forwards")
+)
+
+// TrampolineForward describes the incoming htlc of a payment that was
+// forwarded on behalf of a trampoline onion. The outgoing side is stored as a
+// regular payment under the same payment hash. Subtracting the amount of that
+// payment, including its routing fees, from the incoming amount gives the fee
+// earned by forwarding it.
+type TrampolineForward struct {
+	// IncomingChanID is the channel the incoming htlc was received on.
+	IncomingChanID lnwire.ShortChannelID
+
+	// AmtIn is the amount of the incoming htlc.
+	AmtIn lnwire.MilliSatoshi
+}
+
+// AddTrampolineForward records the incoming htlc of a payment that is
+// forwarded on behalf of a trampoline onion. Recording the same payment hash
+// again overwrites the previous record.
+func (d *DB) AddTrampolineForward(hash lntypes.Hash,
+	fwd *TrampolineForward) error {
+
+	var b bytes.Buffer
+	if err := WriteElements(&b, fwd.IncomingChanID, fwd.AmtIn); err != nil {
+		return err
+	}
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		forwards, err := tx.CreateBucketIfNotExists(
+			trampolineForwardBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		return forwards.Put(hash[:], b.Bytes())
+	})
+}
+
+// FetchTrampolineForwards returns the incoming htlcs of all payments this node
+// forwarded on behalf of a trampoline onion, keyed by their payment hash.
+func (d *DB) FetchTrampolineForwards() (map[lntypes.Hash]TrampolineForward,
+	error) {
+
+	fwds := make(map[lntypes.Hash]TrampolineForward)
+	err := d.View(func(tx *bbolt.Tx) error {
+		forwards := tx.Bucket(trampolineForwardBucket)
+		if forwards == nil {
+			return nil
+		}
+
+		return forwards.ForEach(func(k, v []byte) error {
+			hash, err := lntypes.MakeHash(k)
+			if err != nil {
+				return err
+			}
+
+			var fwd TrampolineForward
+			err = ReadElements(
+				bytes.NewReader(v), &fwd.IncomingChanID,
+				&fwd.AmtIn,
+			)
+			if err != nil {
+				return err
+			}
+			fwds[hash] = fwd
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fwds, nil
+}
@@ -0,0 +1,62 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwire"
+)
+
+// TestTrampolineForwards tests that trampoline forwards can be added to and
+// fetched from the database.
+func TestTrampolineForwards(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	fwds, err := cdb.FetchTrampolineForwards()
+	if err != nil {
+		t.Fatalf("unable to fetch trampoline forwards: %v", err)
+	}
+	if len(fwds) != 0 {
+		t.Fatalf("expected no trampoline forwards, got %v", fwds)
+	}
+
+	fwd1 := &TrampolineForward{
+		IncomingChanID: lnwire.NewShortChanIDFromInt(1),
+		AmtIn:          1000,
+	}
+	fwd2 := &TrampolineForward{
+		IncomingChanID: lnwire.NewShortChanIDFromInt(2),
+		AmtIn:          2000,
+	}
+	if err := cdb.AddTrampolineForward(lntypes.Hash{1}, fwd1); err != nil {
+		t.Fatalf("unable to add trampoline forward: %v", err)
+	}
+	if err := cdb.AddTrampolineForward(lntypes.Hash{2}, fwd1); err != nil {
+		t.Fatalf("unable to add trampoline forward: %v", err)
+	}
+
+	// Recording a forward again overwrites the previous record.
+	if err := cdb.AddTrampolineForward(lntypes.Hash{2}, fwd2); err != nil {
+		t.Fatalf("unable to add trampoline forward: %v", err)
+	}
+
+	fwds, err = cdb.FetchTrampolineForwards()
+	if err != nil {
+		t.Fatalf("unable to fetch trampoline forwards: %v", err)
+	}
+	expected := map[lntypes.Hash]TrampolineForward{
+		{1}: *fwd1,
+		{2}: *fwd2,
+	}
+	if !reflect.DeepEqual(fwds, expected) {
+		t.Fatalf("expected trampoline forwards %v, got %v", expected,
+			fwds)
+	}
+}
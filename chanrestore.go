@@ -48,30 +48,43 @@ type chanDBRestorer struct {
 func (c *chanDBRestorer) openChannelShell(backup chanbackup.Single) (
 	*channeldb.ChannelShell, error) {
 
-	// First, we'll also need to obtain the private key for the shachain
-	// root from the encoded public key.
-	//
-	// TODO(roasbeef): now adds req for hardware signers to impl
-	// shachain...
-	privKey, err := c.secretKeys.DerivePrivKey(backup.ShaChainRootDesc)
-	if err != nil {
-		return nil, fmt.Errorf("unable to derive shachain root key: %v", err)
-	}
-	revRoot, err := chainhash.NewHash(privKey.Serialize())
-	if err != nil {
-		return nil, err
-	}
-	shaChainProducer := shachain.NewRevocationProducer(*revRoot)
-
 	// Each of the keys in our local channel config only have their
 	// locators populate, so we'll re-derive the raw key now as we'll need
 	// it in order to carry out the DLP protocol.
+	var err error
 	backup.LocalChanCfg.MultiSigKey, err = c.secretKeys.DeriveKey(
 		backup.LocalChanCfg.MultiSigKey.KeyLocator,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to derive multi sig key: %v", err)
 	}
+
+	// If the channel was funded through a funding shim, then the multi
+	// sig key may not be derivable from its locator, so we'll use the
+	// full key stored within the backup instead.
+	shimKey := backup.ShimMultiSigKey
+	if shimKey != nil &&
+		!shimKey.IsEqual(backup.LocalChanCfg.MultiSigKey.PubKey) {
+
+		ltndLog.Infof("Using multi sig key of funding shim for "+
+			"ChannelPoint(%v)", backup.FundingOutpoint)
+
+		backup.LocalChanCfg.MultiSigKey.PubKey = shimKey
+	}
+
+	// With our multi-sig key known, we can now obtain the shachain root.
+	shaChainProducer, err := c.shaChainProducer(&backup)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the shachain root is derived through ECDH, we'll also store the
+	// key locator of the revocation root key, so that later backups of
+	// the channel can be restored the same way.
+	var revocationKeyLoc keychain.KeyLocator
+	if backup.Version == chanbackup.ShaChainECDHVersion {
+		revocationKeyLoc = backup.ShaChainRootDesc.KeyLocator
+	}
 	backup.LocalChanCfg.RevocationBasePoint, err = c.secretKeys.DeriveKey(
 		backup.LocalChanCfg.RevocationBasePoint.KeyLocator,
 	)
@@ -106,8 +119,21 @@ func (c *chanDBRestorer) openChannelShell(backup chanbackup.Single) (
 	case chanbackup.TweaklessCommitVersion:
 		chanType = channeldb.SingleFunderTweaklessBit
 
+	// As of this version, the exact channel type is stored within the
+	// backup itself. We'll also log the commitment heights, as they hint
+	// at how many shachain secrets the remote party should be able to
+	// present.
+	case chanbackup.ChanTypeTLVVersion:
+		chanType = backup.ChanType
+
+		ltndLog.Debugf("Restoring ChannelPoint(%v) with chan_type=%v, "+
+			"local_commit_height=%v, remote_commit_height=%v",
+			backup.FundingOutpoint, chanType,
+			backup.LocalCommitHeight, backup.RemoteCommitHeight)
+
 	default:
-		return nil, fmt.Errorf("unknown Single version: %v", err)
+		return nil, fmt.Errorf("unknown Single version: %v",
+			backup.Version)
 	}
 
 	chanShell := channeldb.ChannelShell{
@@ -126,12 +152,53 @@ func (c *chanDBRestorer) openChannelShell(backup chanbackup.Single) (
 			RemoteCurrentRevocation: backup.RemoteNodePub,
 			RevocationStore:         shachain.NewRevocationStore(),
 			RevocationProducer:      shaChainProducer,
+			RevocationKeyLocator:    revocationKeyLoc,
 		},
 	}
 
 	return &chanShell, nil
 }
 
+// shaChainProducer re-derives the shachain producer of the channel of the
+// backup. The multi-sig key of the local channel config must have been derived
+// already.
+func (c *chanDBRestorer) shaChainProducer(
+	backup *chanbackup.Single) (shachain.Producer, error) {
+
+	// As of ShaChainECDHVersion, the root is the ECDH of the revocation
+	// root key with our multi-sig key. This way, the private key never
+	// leaves the key ring of a remote signer.
+	if backup.Version == chanbackup.ShaChainECDHVersion {
+		sharedSecret, err := c.secretKeys.ScalarMult(
+			backup.ShaChainRootDesc,
+			backup.LocalChanCfg.MultiSigKey.PubKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to derive shachain "+
+				"root: %v", err)
+		}
+		revRoot, err := chainhash.NewHash(sharedSecret)
+		if err != nil {
+			return nil, err
+		}
+
+		return shachain.NewRevocationProducer(*revRoot), nil
+	}
+
+	// Otherwise, we'll need to obtain the private key for the shachain
+	// root from the encoded public key.
+	privKey, err := c.secretKeys.DerivePrivKey(backup.ShaChainRootDesc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive shachain root key: %v", err)
+	}
+	revRoot, err := chainhash.NewHash(privKey.Serialize())
+	if err != nil {
+		return nil, err
+	}
+
+	return shachain.NewRevocationProducer(*revRoot), nil
+}
+
 // RestoreChansFromSingles attempts to map the set of single channel backups to
 // channel shells that will be stored persistently. Once these shells have been
 // stored on disk, we'll be able to connect to the channel peer an execute the
@@ -235,7 +302,8 @@ func (c *chanDBRestorer) RestoreChansFromSingles(backups ...chanbackup.Single) e
 var _ chanbackup.ChannelRestorer = (*chanDBRestorer)(nil)
 
 // ConnectPeer attempts to connect to the target node at the set of available
-// addresses. Once this method returns with a non-nil error, the connector
+// addresses, along with any addresses of the node we know of through the
+// channel graph. Once this method returns with a non-nil error, the connector
 // should attempt to persistently connect to the target peer in the background
 // as a persistent attempt.
 //
@@ -249,10 +317,30 @@ func (s *server) ConnectPeer(nodePub *btcec.PublicKey, addrs []net.Addr) error {
 			"with chan restore", nodePub.SerializeCompressed())
 	}
 
+	// The addresses stored within the backup may be stale, so we'll also
+	// resolve the peer's latest addresses through the graph and our set
+	// of link nodes.
+	knownAddrs, err := s.chanDB.AddrsForNode(nodePub)
+	if err != nil {
+		ltndLog.Warnf("Unable to fetch known addrs for %x: %v",
+			nodePub.SerializeCompressed(), err)
+	}
+
+	seenAddrs := make(map[string]struct{})
+	var allAddrs []net.Addr
+	for _, addr := range append(addrs, knownAddrs...) {
+		if _, ok := seenAddrs[addr.String()]; ok {
+			continue
+		}
+		seenAddrs[addr.String()] = struct{}{}
+		allAddrs = append(allAddrs, addr)
+	}
+
 	// For each of the known addresses, we'll attempt to launch a
 	// persistent connection to the (pub, addr) pair. In the event that any
 	// of them connect, all the other stale requests will be canceled.
-	for _, addr := range addrs {
+	var numScheduled int
+	for _, addr := range allAddrs {
 		netAddr := &lnwire.NetAddress{
 			IdentityKey: nodePub,
 			Address:     addr,
@@ -261,9 +349,10 @@ func (s *server) ConnectPeer(nodePub *btcec.PublicKey, addrs []net.Addr) error {
 		ltndLog.Infof("Attempting to connect to %v for SCB restore "+
 			"DLP", netAddr)
 
-		// Attempt to connect to the peer using this full address. If
-		// we're unable to connect to them, then we'll try the next
-		// address in place of it.
+		// Attempt to connect to the peer using this full address. As
+		// the connection is persistent, this only schedules the
+		// attempt, so we'll continue with the next address to ensure
+		// every address is tried.
 		err := s.ConnectToPeer(netAddr, true)
 
 		// If we're already connected to this peer, then we don't
@@ -279,11 +368,13 @@ func (s *server) ConnectPeer(nodePub *btcec.PublicKey, addrs []net.Addr) error {
 			continue
 		}
 
-		// If we connected no problem, then we can exit early as our
-		// job here is done.
-		return nil
+		numScheduled++
 	}
 
-	return fmt.Errorf("unable to connect to peer %x for SCB restore",
-		nodePub.SerializeCompressed())
+	if numScheduled == 0 {
+		return fmt.Errorf("unable to connect to peer %x for SCB "+
+			"restore", nodePub.SerializeCompressed())
+	}
+
+	return nil
 }
@@ -1,15 +1,20 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net"
+	"strconv"
 	"strings"
 
+	"github.com/Actinium-project/acmutil"
 	"github.com/Actinium-project/lnd/lnrpc"
+	"github.com/Actinium-project/lnd/lnwire"
 	"github.com/Actinium-project/lnd/macaroons"
+	"github.com/Actinium-project/lnd/routing/route"
 	"github.com/urfave/cli"
 	"gopkg.in/macaroon.v2"
 )
@@ -19,10 +24,13 @@ var bakeMacaroonCommand = cli.Command{
 	Category: "Macaroons",
 	Usage: "Bakes a new macaroon with the provided list of permissions " +
 		"and restrictions",
-	ArgsUsage: "[--save_to=] [--timeout=] [--ip_address=] permissions...",
+	ArgsUsage: "[--save_to=] [--timeout=] [--ip_address=] " +
+		"[--root_key_id=] [--max_payment_amt=] [--budget=] " +
+		"[--budget_window=] [--allowed_dest=] permissions...",
 	Description: `
 	Bake a new macaroon that grants the provided permissions and
-	optionally adds restrictions (timeout, IP address) to it.
+	optionally adds restrictions (timeout, IP address, spending limits) to
+	it.
 
 	The new macaroon can either be shown on command line in hex serialized
 	format or it can be saved directly to a file using the --save_to
@@ -32,6 +40,38 @@ var bakeMacaroonCommand = cli.Command{
 	colon. Multiple operations can be added as arguments, for example:
 
 	lncli bakemacaroon info:read invoices:write foo:bar
+
+	For even more fine-grained permission control, it is also possible to
+	specify single RPC method URIs that are allowed to be accessed by a
+	macaroon. This can be achieved by specifying "uri:<methodURI>" pairs,
+	for example:
+
+	lncli bakemacaroon uri:/lnrpc.Lightning/GetInfo uri:/lnrpc.Lightning/AddInvoice
+
+	The macaroon created by this command would only be allowed to use the
+	"lncli getinfo" and "lncli addinvoice" commands. To get a list of all
+	available URIs and permissions, use the "lncli listpermissions" command.
+
+	It is also possible to specify the root key ID the macaroon is derived from. All macaroons
+	derived from the same root key ID can be revoked at once by deleting
+	the root key ID with the deletemacaroonid command.
+
+	The payments a macaroon can send can be restricted by a maximum amount
+	per payment, a total budget per time window and a list of allowed
+	destinations. For example, a macaroon that can spend at most 10000
+	satoshis per day, in payments of at most 1000 satoshis each:
+
+	lncli bakemacaroon --max_payment_amt=1000 --budget=10000 \
+		--budget_window=24h offchain:read offchain:write
+
+	The amounts include routing fees: a payment counts with its amount
+	plus its fee limit, and a payment along a route built by the caller
+	counts with the total amount of the route. A payment is deducted from
+	the budget as soon as it is sent, regardless of whether it succeeds.
+	The budget is shared by all macaroons derived from the baked macaroon.
+
+	If allowed destinations are set, payments along a route built by the
+	caller can only pass through nodes that are allowed destinations.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -48,6 +88,32 @@ var bakeMacaroonCommand = cli.Command{
 			Name:  "ip_address",
 			Usage: "the IP address the macaroon will be bound to",
 		},
+		cli.Uint64Flag{
+			Name:  "root_key_id",
+			Usage: "the numerical root key ID used to create the macaroon",
+		},
+		cli.Int64Flag{
+			Name: "max_payment_amt",
+			Usage: "the maximum amount in satoshis a single payment " +
+				"may send, including routing fees",
+		},
+		cli.Int64Flag{
+			Name: "budget",
+			Usage: "the total amount in satoshis all payments may " +
+				"send within each budget window, including " +
+				"routing fees",
+		},
+		cli.DurationFlag{
+			Name: "budget_window",
+			Usage: "the duration after which the budget resets, " +
+				"for example 24h; if not set, the budget " +
+				"never resets",
+		},
+		cli.StringSliceFlag{
+			Name: "allowed_dest",
+			Usage: "the hex encoded public key of a node payments " +
+				"may be sent to; can be specified multiple times",
+		},
 	},
 	Action: actionDecorator(bakeMacaroon),
 }
@@ -66,6 +132,7 @@ func bakeMacaroon(ctx *cli.Context) error {
 		savePath          string
 		timeout           int64
 		ipAddress         net.IP
+		rootKeyID         uint64
 		parsedPermissions []*lnrpc.MacaroonPermission
 		err               error
 	)
@@ -89,6 +156,15 @@ func bakeMacaroon(ctx *cli.Context) error {
 		}
 	}
 
+	if ctx.IsSet("root_key_id") {
+		rootKeyID = ctx.Uint64("root_key_id")
+	}
+
+	spendingConstraints, err := parseSpendingConstraints(ctx)
+	if err != nil {
+		return err
+	}
+
 	// A command line argument can't be an empty string. So we'll check each
 	// entry if it's a valid entity:action tuple. The content itself is
 	// validated server side. We just make sure we can parse it correctly.
@@ -122,6 +198,7 @@ func bakeMacaroon(ctx *cli.Context) error {
 	// RPC call.
 	req := &lnrpc.BakeMacaroonRequest{
 		Permissions: parsedPermissions,
+		RootKeyId:   rootKeyID,
 	}
 	resp, err := client.BakeMacaroon(context.Background(), req)
 	if err != nil {
@@ -153,6 +230,7 @@ func bakeMacaroon(ctx *cli.Context) error {
 			macaroons.IPLockConstraint(ipAddress.String()),
 		)
 	}
+	macConstraints = append(macConstraints, spendingConstraints...)
 	constrainedMac, err := macaroons.AddConstraints(
 		unmarshalMac, macConstraints...,
 	)
@@ -180,3 +258,168 @@ func bakeMacaroon(ctx *cli.Context) error {
 
 	return nil
 }
+
+// parseSpendingConstraints parses the spending limits of the bakemacaroon
+// command into macaroon constraints.
+func parseSpendingConstraints(ctx *cli.Context) ([]macaroons.Constraint,
+	error) {
+
+	var constraints []macaroons.Constraint
+
+	if ctx.IsSet("max_payment_amt") {
+		maxAmt := ctx.Int64("max_payment_amt")
+		if maxAmt < 0 {
+			return nil, fmt.Errorf("max_payment_amt must not be " +
+				"negative")
+		}
+		constraints = append(constraints,
+			macaroons.MaxPaymentAmtConstraint(
+				lnwire.NewMSatFromSatoshis(
+					acmutil.Amount(maxAmt),
+				),
+			),
+		)
+	}
+
+	switch {
+	case ctx.IsSet("budget"):
+		budget := ctx.Int64("budget")
+		if budget < 0 {
+			return nil, fmt.Errorf("budget must not be negative")
+		}
+		window := ctx.Duration("budget_window")
+		if window < 0 {
+			return nil, fmt.Errorf("budget_window must not be " +
+				"negative")
+		}
+		constraints = append(constraints, macaroons.BudgetConstraint(
+			lnwire.NewMSatFromSatoshis(acmutil.Amount(budget)),
+			window,
+		))
+
+	case ctx.IsSet("budget_window"):
+		return nil, fmt.Errorf("budget_window requires a budget")
+	}
+
+	if ctx.IsSet("allowed_dest") {
+		var dests []route.Vertex
+		for _, dest := range ctx.StringSlice("allowed_dest") {
+			destBytes, err := hex.DecodeString(dest)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode "+
+					"allowed_dest %v: %v", dest, err)
+			}
+			vertex, err := route.NewVertexFromBytes(destBytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed_dest "+
+					"%v: %v", dest, err)
+			}
+			dests = append(dests, vertex)
+		}
+		constraints = append(
+			constraints, macaroons.AllowedDestConstraint(dests...),
+		)
+	}
+
+	return constraints, nil
+}
+
+var listMacaroonIDsCommand = cli.Command{
+	Name:     "listmacaroonids",
+	Category: "Macaroons",
+	Usage:    "List all macaroons root key IDs in use.",
+	Action:   actionDecorator(listMacaroonIDs),
+}
+
+func listMacaroonIDs(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListMacaroonIDsRequest{}
+	resp, err := client.ListMacaroonIDs(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var deleteMacaroonIDCommand = cli.Command{
+	Name:      "deletemacaroonid",
+	Category:  "Macaroons",
+	Usage:     "Delete a specific macaroon ID.",
+	ArgsUsage: "root_key_id",
+	Description: `
+	Remove a macaroon ID using the specified root key ID. For example:
+
+	lncli deletemacaroonid 1
+
+	WARNING
+	When the ID is deleted, all macaroons created from that root key will
+	be invalidated.
+
+	Note that the default root key ID 0 cannot be deleted.
+	`,
+	Action: actionDecorator(deleteMacaroonID),
+}
+
+func deleteMacaroonID(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Validate args length. Only one argument is allowed.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "deletemacaroonid")
+	}
+
+	rootKeyIDString := ctx.Args().First()
+
+	// Convert string into uint64.
+	rootKeyID, err := strconv.ParseUint(rootKeyIDString, 10, 64)
+	if err != nil {
+		return fmt.Errorf("root key ID must be a positive integer")
+	}
+
+	// Check that the value is not equal to DefaultRootKeyID. Note that the
+	// server also validates the root key ID when removing it. However, we
+	// check it here too so that we can give users a nice warning.
+	if bytes.Equal([]byte(rootKeyIDString), macaroons.DefaultRootKeyID) {
+		return fmt.Errorf("deleting the default root key is not allowed")
+	}
+
+	// Make the actual RPC call.
+	req := &lnrpc.DeleteMacaroonIDRequest{
+		RootKeyId: rootKeyID,
+	}
+	resp, err := client.DeleteMacaroonID(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listPermissionsCommand = cli.Command{
+	Name:     "listpermissions",
+	Category: "Macaroons",
+	Usage: "Lists all RPC method URIs and the macaroon permissions they " +
+		"require to be invoked.",
+	Action: actionDecorator(listPermissions),
+}
+
+func listPermissions(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	request := &lnrpc.ListPermissionsRequest{}
+	response, err := client.ListPermissions(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(response)
+
+	return nil
+}
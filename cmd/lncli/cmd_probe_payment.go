@@ -0,0 +1,104 @@
+// +build routerrpc
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/Actinium-project/lnd/lnrpc"
+	"github.com/Actinium-project/lnd/lnrpc/routerrpc"
+	"github.com/lightninglabs/protobuf-hex-display/jsonpb"
+	"github.com/urfave/cli"
+)
+
+var probePaymentCommand = cli.Command{
+	Name:      "probepayment",
+	Category:  "Payments",
+	Usage:     "Probe the liquidity of routes without risking funds.",
+	ArgsUsage: "[routes]",
+	Description: `
+	Probe one or more candidate routes by sending an htlc along each of
+	them that pays to a payment hash nobody knows the preimage of. The
+	probes can't be settled, so no funds are at risk. The failures they
+	return with are reported to mission control, and the maximum amount
+	that reached every hop of the routes is printed.
+
+	The routes are specified in the format of the response of queryroutes
+	or buildroute, either using the --routes parameter, as a positional
+	argument, or by reading them from stdin:
+	    (lncli queryroutes --args.. | lncli probepayment -)
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "routes, r",
+			Usage: "a json array string in the format of the response " +
+				"of queryroutes that denotes which routes to probe",
+		},
+	},
+	Action: actionDecorator(probePayment),
+}
+
+func probePayment(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	var jsonRoutes string
+	switch {
+	case ctx.IsSet("routes"):
+		jsonRoutes = ctx.String("routes")
+
+	case args.Present() && args.First() != "-":
+		jsonRoutes = args.First()
+
+	case args.Present() && args.First() == "-":
+		b, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		jsonRoutes = string(b)
+
+	default:
+		return cli.ShowCommandHelp(ctx, "probepayment")
+	}
+
+	// Try to parse the provided json both in the QueryRoutes format that
+	// contains a list of routes and the single route BuildRoute format.
+	var routes []*lnrpc.Route
+	queryRoutes := &lnrpc.QueryRoutesResponse{}
+	err := jsonpb.UnmarshalString(jsonRoutes, queryRoutes)
+	if err == nil {
+		routes = queryRoutes.Routes
+	} else {
+		buildRoute := &routerrpc.BuildRouteResponse{}
+		err = jsonpb.UnmarshalString(jsonRoutes, buildRoute)
+		if err != nil {
+			return fmt.Errorf("unable to unmarshal json string "+
+				"from incoming array of routes: %v", err)
+		}
+
+		routes = []*lnrpc.Route{buildRoute.Route}
+	}
+	if len(routes) == 0 {
+		return errors.New("no routes provided")
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.ProbePaymentRequest{
+		Routes: routes,
+	}
+	resp, err := client.ProbePayment(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
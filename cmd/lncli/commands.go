@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"encoding/csv"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -26,9 +27,11 @@ import (
 	"github.com/Actinium-project/lnd/lnrpc"
 	"github.com/Actinium-project/lnd/lnrpc/routerrpc"
 	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwire"
 	"github.com/Actinium-project/lnd/record"
 	"github.com/Actinium-project/lnd/routing/route"
 	"github.com/Actinium-project/lnd/walletunlocker"
+	"github.com/tv42/zbase32"
 	"github.com/urfave/cli"
 	"golang.org/x/crypto/ssh/terminal"
 	"google.golang.org/grpc/codes"
@@ -42,6 +45,11 @@ import (
 
 const defaultRecoveryWindow int32 = 2500
 
+// routerPaymentTimeout is the time in seconds a payment that is sent through
+// the router sub-server, like an AMP or trampoline payment, is given to
+// complete.
+const routerPaymentTimeout = 60
+
 func printJSON(resp interface{}) {
 	b, err := json.Marshal(resp)
 	if err != nil {
@@ -239,6 +247,25 @@ var sendCoinsCommand = cli.Command{
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "(optional) a label for the transaction",
+		},
+		cli.Uint64Flag{
+			Name: "min_confs",
+			Usage: "(optional) the minimum number of confirmations " +
+				"each one of your outputs used for the " +
+				"transaction must satisfy",
+			Value: 1,
+		},
+		cli.StringSliceFlag{
+			Name: "utxo",
+			Usage: "(optional) a utxo specified as outpoint " +
+				"(txid:index) that the transaction should " +
+				"spend. If set, exactly the given utxos are " +
+				"spent. This flag can be repeated to spend " +
+				"several utxos",
+		},
 	},
 	Action: actionDecorator(sendCoins),
 }
@@ -288,16 +315,26 @@ func sendCoins(ctx *cli.Context) error {
 			"sweep all coins out of the wallet")
 	}
 
+	outpoints, err := NewProtoOutPoints(ctx.StringSlice("utxo"))
+	if err != nil {
+		return err
+	}
+
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
+	minConfs := int32(ctx.Uint64("min_confs"))
 	req := &lnrpc.SendCoinsRequest{
-		Addr:       addr,
-		Amount:     amt,
-		TargetConf: int32(ctx.Int64("conf_target")),
-		SatPerByte: ctx.Int64("sat_per_byte"),
-		SendAll:    ctx.Bool("sweepall"),
+		Addr:             addr,
+		Amount:           amt,
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		SatPerByte:       ctx.Int64("sat_per_byte"),
+		SendAll:          ctx.Bool("sweepall"),
+		Label:            ctx.String("label"),
+		MinConfs:         minConfs,
+		SpendUnconfirmed: minConfs == 0,
+		Outpoints:        outpoints,
 	}
 	txid, err := client.SendCoins(ctxb, req)
 	if err != nil {
@@ -453,6 +490,25 @@ var sendManyCommand = cli.Command{
 			Usage: "(optional) a manual fee expressed in sat/byte that should be " +
 				"used when crafting the transaction",
 		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "(optional) a label for the transaction",
+		},
+		cli.Uint64Flag{
+			Name: "min_confs",
+			Usage: "(optional) the minimum number of confirmations " +
+				"each one of your outputs used for the " +
+				"transaction must satisfy",
+			Value: 1,
+		},
+		cli.StringSliceFlag{
+			Name: "utxo",
+			Usage: "(optional) a utxo specified as outpoint " +
+				"(txid:index) that the transaction should " +
+				"spend. If set, exactly the given utxos are " +
+				"spent. This flag can be repeated to spend " +
+				"several utxos",
+		},
 	},
 	Action: actionDecorator(sendMany),
 }
@@ -470,14 +526,24 @@ func sendMany(ctx *cli.Context) error {
 			"set, but not both")
 	}
 
+	outpoints, err := NewProtoOutPoints(ctx.StringSlice("utxo"))
+	if err != nil {
+		return err
+	}
+
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
+	minConfs := int32(ctx.Uint64("min_confs"))
 	txid, err := client.SendMany(ctxb, &lnrpc.SendManyRequest{
-		AddrToAmount: amountToAddr,
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		SatPerByte:   ctx.Int64("sat_per_byte"),
+		AddrToAmount:     amountToAddr,
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		SatPerByte:       ctx.Int64("sat_per_byte"),
+		Label:            ctx.String("label"),
+		MinConfs:         minConfs,
+		SpendUnconfirmed: minConfs == 0,
+		Outpoints:        outpoints,
 	})
 	if err != nil {
 		return err
@@ -676,6 +742,19 @@ var openChannelCommand = cli.Command{
 				"value is set on channel open, you will *not* be " +
 				"able to cooperatively close to a different address.",
 		},
+		cli.StringFlag{
+			Name: "label",
+			Usage: "(optional) a label for the funding " +
+				"transaction",
+		},
+		cli.StringSliceFlag{
+			Name: "utxo",
+			Usage: "(optional) a utxo specified as outpoint " +
+				"(txid:index) that may be used to fund the " +
+				"channel. If set, only the given utxos are " +
+				"considered for funding. This flag can be " +
+				"repeated to provide several utxos",
+		},
 	},
 	Action: actionDecorator(openChannel),
 }
@@ -695,6 +774,11 @@ func openChannel(ctx *cli.Context) error {
 		return nil
 	}
 
+	outpoints, err := NewProtoOutPoints(ctx.StringSlice("utxo"))
+	if err != nil {
+		return err
+	}
+
 	minConfs := int32(ctx.Uint64("min_confs"))
 	req := &lnrpc.OpenChannelRequest{
 		TargetConf:       int32(ctx.Int64("conf_target")),
@@ -704,6 +788,8 @@ func openChannel(ctx *cli.Context) error {
 		MinConfs:         minConfs,
 		SpendUnconfirmed: minConfs == 0,
 		CloseAddress:     ctx.String("close_address"),
+		Label:            ctx.String("label"),
+		Outpoints:        outpoints,
 	}
 
 	switch {
@@ -1380,8 +1466,15 @@ var createCommand = cli.Command{
 	Channel Backups. Only one of the three parameters will be accepted. See
 	the restorechanbackup command for further details w.r.t the format
 	accepted.
+
+	If the --stateless_init flag is set, no macaroon files are created by
+	the daemon. Instead, the admin macaroon is returned by this command and
+	either printed in hex or written to the file given by --save_to. It
+	MUST be stored safely, as otherwise all access to the daemon is lost.
 	`,
 	Flags: []cli.Flag{
+		statelessInitFlag,
+		saveToFlag,
 		cli.StringFlag{
 			Name: "single_backup",
 			Usage: "a hex encoded single channel backup obtained " +
@@ -1688,13 +1781,19 @@ mnemonicCheck:
 		AezeedPassphrase:   aezeedPass,
 		RecoveryWindow:     recoveryWindow,
 		ChannelBackups:     chanBackups,
+		StatelessInit:      ctx.Bool(statelessInitFlag.Name),
 	}
-	if _, err := client.InitWallet(ctxb, req); err != nil {
+	response, err := client.InitWallet(ctxb, req)
+	if err != nil {
 		return err
 	}
 
 	fmt.Println("\nlnd successfully initialized!")
 
+	if ctx.Bool(statelessInitFlag.Name) {
+		return storeOrPrintAdminMac(ctx, response.AdminMacaroon)
+	}
+
 	return nil
 }
 
@@ -1766,6 +1865,7 @@ var unlockCommand = cli.Command{
 				"maximum number of consecutive, unused " +
 				"addresses ever generated by the wallet.",
 		},
+		statelessInitFlag,
 	},
 	Action: actionDecorator(unlock),
 }
@@ -1802,6 +1902,7 @@ func unlock(ctx *cli.Context) error {
 	req := &lnrpc.UnlockWalletRequest{
 		WalletPassword: pw,
 		RecoveryWindow: recoveryWindow,
+		StatelessInit:  ctx.Bool(statelessInitFlag.Name),
 	}
 	_, err = client.UnlockWallet(ctxb, req)
 	if err != nil {
@@ -1828,7 +1929,24 @@ var changePasswordCommand = cli.Command{
 	--noseedbackup), one must restart their daemon without
 	--noseedbackup and use this command. The "current password" field
 	should be left empty.
+
+	If the --new_mac_root_key flag is set, the macaroon root key is rotated
+	as well, invalidating all previously created macaroons. If the
+	--stateless_init flag is set, the existing macaroon files are deleted
+	and no new ones are created. Instead, the admin macaroon is returned by
+	this command and either printed in hex or written to the file given by
+	--save_to.
 	`,
+	Flags: []cli.Flag{
+		statelessInitFlag,
+		saveToFlag,
+		cli.BoolFlag{
+			Name: "new_mac_root_key",
+			Usage: "rotate the macaroon root key resulting in " +
+				"all previously created macaroons to be " +
+				"invalidated",
+		},
+	},
 	Action: actionDecorator(changePassword),
 }
 
@@ -1863,15 +1981,64 @@ func changePassword(ctx *cli.Context) error {
 	}
 
 	req := &lnrpc.ChangePasswordRequest{
-		CurrentPassword: currentPw,
-		NewPassword:     newPw,
+		CurrentPassword:    currentPw,
+		NewPassword:        newPw,
+		StatelessInit:      ctx.Bool(statelessInitFlag.Name),
+		NewMacaroonRootKey: ctx.Bool("new_mac_root_key"),
 	}
 
-	_, err = client.ChangePassword(ctxb, req)
+	response, err := client.ChangePassword(ctxb, req)
 	if err != nil {
 		return err
 	}
 
+	if ctx.Bool(statelessInitFlag.Name) {
+		return storeOrPrintAdminMac(ctx, response.AdminMacaroon)
+	}
+
+	return nil
+}
+
+var (
+	statelessInitFlag = cli.BoolFlag{
+		Name: "stateless_init",
+		Usage: "do not create any macaroon files in the file " +
+			"system of the daemon",
+	}
+	saveToFlag = cli.StringFlag{
+		Name: "save_to",
+		Usage: "save returned admin macaroon to this file, only " +
+			"used with --stateless_init",
+	}
+)
+
+// storeOrPrintAdminMac either stores the admin macaroon to a file specified
+// by the --save_to flag or prints it to the terminal in hex.
+func storeOrPrintAdminMac(ctx *cli.Context, adminMac []byte) error {
+	// The daemon doesn't return a macaroon if macaroons are disabled.
+	if len(adminMac) == 0 {
+		fmt.Println("No admin macaroon returned, macaroons are " +
+			"disabled")
+		return nil
+	}
+
+	// The user specified the optional --save_to parameter. We'll save the
+	// macaroon to that file.
+	if ctx.IsSet(saveToFlag.Name) {
+		macSavePath := cleanAndExpandPath(ctx.String(saveToFlag.Name))
+		err := ioutil.WriteFile(macSavePath, adminMac, 0600)
+		if err != nil {
+			_ = os.Remove(macSavePath)
+			return err
+		}
+		fmt.Printf("Admin macaroon saved to %s\n", macSavePath)
+		return nil
+	}
+
+	// Otherwise we just print it. The user MUST store this macaroon
+	// somewhere so we either save it to a provided file path or just print
+	// it to standard output.
+	fmt.Printf("Admin macaroon: %s\n", hex.EncodeToString(adminMac))
 	return nil
 }
 
@@ -1946,6 +2113,31 @@ func getInfo(ctx *cli.Context) error {
 	return nil
 }
 
+var getRecoveryInfoCommand = cli.Command{
+	Name:  "getrecoveryinfo",
+	Usage: "Display information about an ongoing recovery attempt.",
+	Description: `
+	Display the progress of the rescan of a wallet that was restored from
+	its seed, the number of keys found for each key family, and the
+	commitment outputs of closed channels that were found and swept.`,
+	Action: actionDecorator(getRecoveryInfo),
+}
+
+func getRecoveryInfo(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetRecoveryInfoRequest{}
+	resp, err := client.GetRecoveryInfo(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var pendingChannelsCommand = cli.Command{
 	Name:     "pendingchannels",
 	Category: "Channels",
@@ -2137,6 +2329,24 @@ func paymentFlags() []cli.Flag {
 			Usage: "allow sending a circular payment to self",
 		},
 		dataFlag,
+		cli.BoolFlag{
+			Name: "amp",
+			Usage: "send the payment as an atomic multi-path " +
+				"payment, each shard of which appears as a " +
+				"separate payment [experimental]",
+		},
+		cli.UintFlag{
+			Name: "amp_shards",
+			Usage: "the number of shards an amp payment is split " +
+				"into",
+			Value: 2,
+		},
+		cli.BoolFlag{
+			Name: "trampoline",
+			Usage: "route the payment through the trampoline node " +
+				"configured with trampoline.node, which finds " +
+				"the route to the destination [experimental]",
+		},
 	}
 }
 
@@ -2182,6 +2392,23 @@ var sendPaymentCommand = cli.Command{
 			Name:  "keysend",
 			Usage: "will generate a pre-image and encode it in the sphinx packet, a dest must be set [experimental]",
 		},
+		cli.StringFlag{
+			Name: "message",
+			Usage: "a message to the receiver of a keysend " +
+				"payment, which is shown as the memo of the " +
+				"receiver's invoice",
+		},
+		cli.StringFlag{
+			Name: "reply_to",
+			Usage: "a payment request the receiver of a keysend " +
+				"payment can use to reply",
+		},
+		cli.BoolFlag{
+			Name: "sign",
+			Usage: "identify this node to the receiver of a " +
+				"keysend payment by attaching its public key " +
+				"and a signature over the payment",
+		},
 	),
 	Action: sendPayment,
 }
@@ -2287,6 +2514,23 @@ func sendPayment(ctx *cli.Context) error {
 
 	var rHash []byte
 
+	if ctx.Bool("amp") {
+		if ctx.Bool("keysend") || ctx.IsSet("payment_hash") {
+			return errors.New("cannot set payment hash or use " +
+				"keysend when using amp")
+		}
+
+		// The shards of an AMP payment carry their own payment hashes,
+		// so the payment hash argument is skipped entirely.
+		if ctx.IsSet("final_cltv_delta") {
+			req.FinalCltvDelta = int32(
+				ctx.Int64("final_cltv_delta"),
+			)
+		}
+
+		return sendPaymentRequest(ctx, req)
+	}
+
 	if ctx.Bool("keysend") {
 		if ctx.IsSet("payment_hash") {
 			return errors.New("cannot set payment hash when using " +
@@ -2304,7 +2548,30 @@ func sendPayment(ctx *cli.Context) error {
 
 		hash := preimage.Hash()
 		rHash = hash[:]
+
+		if ctx.IsSet("message") {
+			req.DestCustomRecords[record.KeySendMessageType] =
+				[]byte(ctx.String("message"))
+		}
+		if ctx.IsSet("reply_to") {
+			req.DestCustomRecords[record.KeySendReplyToType] =
+				[]byte(ctx.String("reply_to"))
+		}
+		if ctx.Bool("sign") {
+			err := signKeySend(ctx, req, hash)
+			if err != nil {
+				return err
+			}
+		}
 	} else {
+		if ctx.IsSet("message") || ctx.IsSet("reply_to") ||
+			ctx.Bool("sign") {
+
+			return errors.New("message, reply_to and sign can " +
+				"only be used with keysend")
+		}
+
+
 		switch {
 		case ctx.IsSet("payment_hash"):
 			rHash, err = hex.DecodeString(ctx.String("payment_hash"))
@@ -2339,6 +2606,49 @@ func sendPayment(ctx *cli.Context) error {
 	return sendPaymentRequest(ctx, req)
 }
 
+// signKeySend attaches the public key of our node to a keysend payment, along
+// with a signature over the payment that lets the receiver verify it.
+func signKeySend(ctx *cli.Context, req *lnrpc.SendRequest,
+	hash lntypes.Hash) error {
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	ctxb := context.Background()
+	info, err := client.GetInfo(ctxb, &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return err
+	}
+
+	var sender, receiver [33]byte
+	senderBytes, err := hex.DecodeString(info.IdentityPubkey)
+	if err != nil {
+		return err
+	}
+	copy(sender[:], senderBytes)
+	copy(receiver[:], req.Dest)
+
+	data := record.KeySendSignedData(
+		sender, receiver, hash, req.DestCustomRecords,
+	)
+	resp, err := client.SignMessage(ctxb, &lnrpc.SignMessageRequest{
+		Msg: data,
+	})
+	if err != nil {
+		return err
+	}
+
+	sig, err := zbase32.DecodeString(resp.Signature)
+	if err != nil {
+		return err
+	}
+
+	req.DestCustomRecords[record.KeySendSenderType] = sender[:]
+	req.DestCustomRecords[record.KeySendSignatureType] = sig
+
+	return nil
+}
+
 func sendPaymentRequest(ctx *cli.Context, req *lnrpc.SendRequest) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
@@ -2416,6 +2726,10 @@ func sendPaymentRequest(ctx *cli.Context, req *lnrpc.SendRequest) error {
 		}
 	}
 
+	if ctx.Bool("amp") || ctx.Bool("trampoline") {
+		return sendRouterPayment(ctx, req, amt)
+	}
+
 	paymentStream, err := client.SendPayment(context.Background())
 	if err != nil {
 		return err
@@ -2444,6 +2758,63 @@ func sendPaymentRequest(ctx *cli.Context, req *lnrpc.SendRequest) error {
 	return nil
 }
 
+// sendRouterPayment sends the payment described by the given request through
+// the router sub-server, as an atomic multi-path payment or through a
+// trampoline node, and prints its final status.
+func sendRouterPayment(ctx *cli.Context, req *lnrpc.SendRequest,
+	amt int64) error {
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	feeLimit := lnrpc.CalculateFeeLimit(
+		req.FeeLimit, lnwire.MilliSatoshi(amt*1000),
+	)
+
+	stream, err := client.SendPayment(
+		context.Background(), &routerrpc.SendPaymentRequest{
+			Dest:              req.Dest,
+			Amt:               req.Amt,
+			PaymentRequest:    req.PaymentRequest,
+			FinalCltvDelta:    req.FinalCltvDelta,
+			TimeoutSeconds:    routerPaymentTimeout,
+			FeeLimitMsat:      int64(feeLimit),
+			OutgoingChanId:    req.OutgoingChanId,
+			LastHopPubkey:     req.LastHopPubkey,
+			CltvLimit:         int32(req.CltvLimit),
+			DestCustomRecords: req.DestCustomRecords,
+			AllowSelfPayment:  req.AllowSelfPayment,
+			Amp:               ctx.Bool("amp"),
+			AmpShards:         uint32(ctx.Uint("amp_shards")),
+			Trampoline:        ctx.Bool("trampoline"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		status, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		if status.State == routerrpc.PaymentState_IN_FLIGHT {
+			continue
+		}
+
+		printRespJSON(status)
+
+		if status.State != routerrpc.PaymentState_SUCCEEDED {
+			return fmt.Errorf("payment failed: %v", status.State)
+		}
+
+		return nil
+	}
+}
+
 var payInvoiceCommand = cli.Command{
 	Name:      "payinvoice",
 	Category:  "Payments",
@@ -2693,6 +3064,19 @@ var addInvoiceCommand = cli.Command{
 				"private channels in order to assist the " +
 				"payer in reaching you",
 		},
+		cli.BoolFlag{
+			Name: "amp",
+			Usage: "require the invoice to be paid with an " +
+				"atomic multi-path payment [experimental]",
+		},
+		cli.BoolFlag{
+			Name: "reusable",
+			Usage: "accept any number of payments to the " +
+				"invoice until it expires or is canceled, " +
+				"paid with atomic multi-path payments " +
+				"[experimental]",
+		},
+		hintPolicyFlag,
 	},
 	Action: actionDecorator(addInvoice),
 }
@@ -2737,6 +3121,11 @@ func addInvoice(ctx *cli.Context) error {
 		return fmt.Errorf("unable to parse description_hash: %v", err)
 	}
 
+	hintPolicy, err := parseHintPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
 	invoice := &lnrpc.Invoice{
 		Memo:            ctx.String("memo"),
 		RPreimage:       preimage,
@@ -2745,6 +3134,9 @@ func addInvoice(ctx *cli.Context) error {
 		FallbackAddr:    ctx.String("fallback_addr"),
 		Expiry:          ctx.Int64("expiry"),
 		Private:         ctx.Bool("private"),
+		IsAmp:           ctx.Bool("amp"),
+		Reusable:        ctx.Bool("reusable"),
+		HintPolicy:      hintPolicy,
 	}
 
 	resp, err := client.AddInvoice(context.Background(), invoice)
@@ -2757,6 +3149,33 @@ func addInvoice(ctx *cli.Context) error {
 	return nil
 }
 
+// hintPolicyFlag is the flag that selects the policy used to pick the
+// routing hints for private channels.
+var hintPolicyFlag = cli.StringFlag{
+	Name: "hint_policy",
+	Usage: "the policy used to select the routing hints for private " +
+		"channels, either 'ranked' to rank them by inbound capacity " +
+		"and peer uptime and add enough hints to receive the amount " +
+		"through a multi-path payment, or 'legacy' to add every " +
+		"channel that can receive the full amount",
+	Value: "ranked",
+}
+
+// parseHintPolicy parses the hint policy flag.
+func parseHintPolicy(ctx *cli.Context) (lnrpc.HintPolicy, error) {
+	switch ctx.String("hint_policy") {
+	case "ranked":
+		return lnrpc.HintPolicy_RANKED_HINTS, nil
+
+	case "legacy":
+		return lnrpc.HintPolicy_LEGACY_HINTS, nil
+
+	default:
+		return 0, fmt.Errorf("unknown hint policy %v",
+			ctx.String("hint_policy"))
+	}
+}
+
 var lookupInvoiceCommand = cli.Command{
 	Name:      "lookupinvoice",
 	Category:  "Payments",
@@ -3246,6 +3665,181 @@ func decodePayReq(ctx *cli.Context) error {
 	return nil
 }
 
+var addOfferCommand = cli.Command{
+	Name:     "addoffer",
+	Category: "Invoices",
+	Usage:    "Add a new offer.",
+	Description: `
+	Add a new offer, a static payment code that payers can fetch fresh
+	invoices for over onion messages. If no amount is set, the payer
+	chooses the amount of every invoice.`,
+	ArgsUsage: "description [amt_msat]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "description",
+			Usage: "a description of what the offer is for",
+		},
+		cli.Int64Flag{
+			Name:  "amt_msat",
+			Usage: "the amount of every invoice of the offer in millisatoshis",
+		},
+		cli.Int64Flag{
+			Name: "expiry",
+			Usage: "the number of seconds the offer is valid for, " +
+				"if not set the offer never expires",
+		},
+	},
+	Action: actionDecorator(addOffer),
+}
+
+func addOffer(ctx *cli.Context) error {
+	var (
+		description string
+		amt         int64
+		err         error
+	)
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("description"):
+		description = ctx.String("description")
+	case args.Present():
+		description = args.First()
+		args = args.Tail()
+	}
+
+	switch {
+	case ctx.IsSet("amt_msat"):
+		amt = ctx.Int64("amt_msat")
+	case args.Present():
+		amt, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amt_msat argument: "+
+				"%v", err)
+		}
+	}
+
+	resp, err := client.AddOffer(context.Background(),
+		&lnrpc.AddOfferRequest{
+			Description: description,
+			AmtMsat:     amt,
+			Expiry:      ctx.Int64("expiry"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listOffersCommand = cli.Command{
+	Name:     "listoffers",
+	Category: "Invoices",
+	Usage:    "List all offers of this node.",
+	Action:   actionDecorator(listOffers),
+}
+
+func listOffers(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListOffers(
+		context.Background(), &lnrpc.ListOffersRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var fetchInvoiceCommand = cli.Command{
+	Name:     "fetchinvoice",
+	Category: "Payments",
+	Usage:    "Fetch an invoice for an offer.",
+	Description: `
+	Request an invoice for an offer from its issuer over onion messages.
+	The returned payment request can be paid with payinvoice.`,
+	ArgsUsage: "offer [amt_msat]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "offer",
+			Usage: "the bech32 encoded offer",
+		},
+		cli.Int64Flag{
+			Name: "amt_msat",
+			Usage: "the amount to request in millisatoshis, only " +
+				"for offers without amount",
+		},
+		cli.StringFlag{
+			Name:  "payer_note",
+			Usage: "an optional note for the issuer of the offer",
+		},
+		cli.IntFlag{
+			Name: "timeout",
+			Usage: "the number of seconds to wait for the invoice, " +
+				"defaults to 60",
+		},
+	},
+	Action: actionDecorator(fetchInvoice),
+}
+
+func fetchInvoice(ctx *cli.Context) error {
+	var (
+		offer string
+		amt   int64
+		err   error
+	)
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("offer"):
+		offer = ctx.String("offer")
+	case args.Present():
+		offer = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("offer argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("amt_msat"):
+		amt = ctx.Int64("amt_msat")
+	case args.Present():
+		amt, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amt_msat argument: "+
+				"%v", err)
+		}
+	}
+
+	resp, err := client.FetchInvoice(context.Background(),
+		&lnrpc.FetchInvoiceRequest{
+			Offer:          offer,
+			AmtMsat:        amt,
+			PayerNote:      ctx.String("payer_note"),
+			TimeoutSeconds: int32(ctx.Int("timeout")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listChainTxnsCommand = cli.Command{
 	Name:        "listchaintxns",
 	Category:    "On-chain",
@@ -3727,6 +4321,108 @@ func forwardingHistory(ctx *cli.Context) error {
 	return nil
 }
 
+var accountingReportCommand = cli.Command{
+	Name:     "accountingreport",
+	Category: "Payments",
+	Usage: "Obtain a ledger of all events that changed the balance of " +
+		"the node.",
+	Description: `
+	Produce a ledger of all balance changing events of the node over a
+	particular time range (--start_time and --end_time): settled invoices,
+	payments, forwarding fees and on-chain transactions of the wallet,
+	including channel opens and closes. The start and end times are meant
+	to be expressed in seconds since the Unix epoch. If --start_time isn't
+	provided, then 24 hours ago is used. If --end_time isn't provided, then
+	the current time is used.
+
+	All amounts are in millisatoshis. The amount of an entry is the change
+	in balance excluding the fees paid by the node, which are reported
+	separately.
+
+	The ledger is printed as JSON, or as CSV when --csv is set.
+	`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "the starting time for the report, expressed in " +
+				"seconds since the unix epoch",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "the end time for the report, expressed in " +
+				"seconds since the unix epoch",
+		},
+		cli.BoolFlag{
+			Name:  "csv",
+			Usage: "print the entries of the ledger as CSV",
+		},
+	},
+	Action: actionDecorator(accountingReport),
+}
+
+func accountingReport(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	startTime := uint64(time.Now().Add(-time.Hour * 24).Unix())
+	if ctx.IsSet("start_time") {
+		startTime = uint64(ctx.Int64("start_time"))
+	}
+
+	req := &lnrpc.AccountingReportRequest{
+		StartTime: startTime,
+		EndTime:   uint64(ctx.Int64("end_time")),
+	}
+	resp, err := client.AccountingReport(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Bool("csv") {
+		printRespJSON(resp)
+		return nil
+	}
+
+	w := csv.NewWriter(os.Stdout)
+	err = w.Write([]string{
+		"timestamp", "type", "amount_msat", "fee_msat", "chan_id",
+		"outgoing_chan_id", "reference", "note",
+	})
+	if err != nil {
+		return err
+	}
+	for _, entry := range resp.Entries {
+		err := w.Write([]string{
+			strconv.FormatInt(entry.Timestamp, 10),
+			entry.Type.String(),
+			strconv.FormatInt(entry.AmountMsat, 10),
+			strconv.FormatInt(entry.FeeMsat, 10),
+			strconv.FormatUint(entry.ChanId, 10),
+			strconv.FormatUint(entry.OutgoingChanId, 10),
+			csvText(entry.Reference),
+			csvText(entry.Note),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+
+	return w.Error()
+}
+
+// csvText neutralizes a free text CSV cell, such as the memo of an invoice set
+// by its payer, that a spreadsheet would otherwise evaluate as a formula. Such
+// cells are prefixed with a single quote, which makes them plain text.
+func csvText(s string) string {
+	if s != "" && strings.ContainsAny(s[:1], "=+-@\t\r") {
+		return "'" + s
+	}
+
+	return s
+}
+
 var exportChanBackupCommand = cli.Command{
 	Name:     "exportchanbackup",
 	Category: "Channels",
@@ -4094,3 +4790,31 @@ func restoreChanBackup(ctx *cli.Context) error {
 
 	return nil
 }
+
+var listBackupSinksCommand = cli.Command{
+	Name:     "listbackupsinks",
+	Category: "Channels",
+	Usage:    "List the status of the configured channel backup sinks",
+	Description: `
+	Returns the status of every sink that the encrypted multi-channel
+	backup is shipped to each time it is updated, such as a directory
+	configured with backupsink.dir or an HTTP server configured with
+	backupsink.url.
+	`,
+	Action: actionDecorator(listBackupSinks),
+}
+
+func listBackupSinks(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListBackupSinksRequest{}
+	resp, err := client.ListBackupSinks(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -189,6 +189,14 @@ var addHoldInvoiceCommand = cli.Command{
 				"private channels in order to assist the " +
 				"payer in reaching you",
 		},
+		hintPolicyFlag,
+		cli.Uint64Flag{
+			Name: "hold_duration",
+			Usage: "the maximum number of seconds the invoice is " +
+				"kept accepted before it is canceled " +
+				"automatically. If not specified, only the " +
+				"limit of the node applies.",
+		},
 	},
 	Action: actionDecorator(addHoldInvoice),
 }
@@ -234,6 +242,11 @@ func addHoldInvoice(ctx *cli.Context) error {
 		return fmt.Errorf("unable to parse description_hash: %v", err)
 	}
 
+	hintPolicy, err := parseHintPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
 	invoice := &invoicesrpc.AddHoldInvoiceRequest{
 		Memo:            ctx.String("memo"),
 		Hash:            hash,
@@ -243,6 +256,8 @@ func addHoldInvoice(ctx *cli.Context) error {
 		FallbackAddr:    ctx.String("fallback_addr"),
 		Expiry:          ctx.Int64("expiry"),
 		Private:         ctx.Bool("private"),
+		HintPolicy:      hintPolicy,
+		HoldDuration:    ctx.Uint64("hold_duration"),
 	}
 
 	resp, err := client.AddHoldInvoice(context.Background(), invoice)
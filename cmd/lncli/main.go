@@ -271,6 +271,7 @@ func main() {
 		walletBalanceCommand,
 		channelBalanceCommand,
 		getInfoCommand,
+		getRecoveryInfoCommand,
 		pendingChannelsCommand,
 		sendPaymentCommand,
 		payInvoiceCommand,
@@ -288,6 +289,9 @@ func main() {
 		getNetworkInfoCommand,
 		debugLevelCommand,
 		decodePayReqCommand,
+		addOfferCommand,
+		listOffersCommand,
+		fetchInvoiceCommand,
 		listChainTxnsCommand,
 		stopCommand,
 		signMessageCommand,
@@ -295,10 +299,15 @@ func main() {
 		feeReportCommand,
 		updateChannelPolicyCommand,
 		forwardingHistoryCommand,
+		accountingReportCommand,
 		exportChanBackupCommand,
 		verifyChanBackupCommand,
 		restoreChanBackupCommand,
+		listBackupSinksCommand,
 		bakeMacaroonCommand,
+		listMacaroonIDsCommand,
+		deleteMacaroonIDCommand,
+		listPermissionsCommand,
 	}
 
 	// Add any extra commands determined by build flags.
@@ -42,6 +42,21 @@ func NewProtoOutPoint(op string) (*lnrpc.OutPoint, error) {
 	}, nil
 }
 
+// NewProtoOutPoints parses a list of OutPoints into their corresponding
+// lnrpc.OutPoint types.
+func NewProtoOutPoints(ops []string) ([]*lnrpc.OutPoint, error) {
+	protoOutPoints := make([]*lnrpc.OutPoint, 0, len(ops))
+	for _, op := range ops {
+		protoOutPoint, err := NewProtoOutPoint(op)
+		if err != nil {
+			return nil, err
+		}
+		protoOutPoints = append(protoOutPoints, protoOutPoint)
+	}
+
+	return protoOutPoints, nil
+}
+
 // Utxo displays information about an unspent output, including its address,
 // amount, pkscript, and confirmations.
 type Utxo struct {
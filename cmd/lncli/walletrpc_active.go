@@ -4,8 +4,15 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"sort"
 
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
 	"github.com/Actinium-project/lnd/lnrpc/walletrpc"
 	"github.com/urfave/cli"
 )
@@ -22,6 +29,13 @@ func walletCommands() []cli.Command {
 			Subcommands: []cli.Command{
 				pendingSweepsCommand,
 				bumpFeeCommand,
+				leaseOutputCommand,
+				releaseOutputCommand,
+				listLeasesCommand,
+				labelTxCommand,
+				exportWatchOnlyCommand,
+				psbtCommand,
+				accountsCommand,
 			},
 		},
 	}
@@ -134,6 +148,10 @@ var bumpFeeCommand = cli.Command{
 			Name:  "force",
 			Usage: "sweep even if the yield is negative",
 		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "(optional) a label for the sweep transaction",
+		},
 	},
 	Action: actionDecorator(bumpFee),
 }
@@ -159,7 +177,490 @@ func bumpFee(ctx *cli.Context) error {
 		TargetConf: uint32(ctx.Uint64("conf_target")),
 		SatPerByte: uint32(ctx.Uint64("sat_per_byte")),
 		Force:      ctx.Bool("force"),
+		Label:      ctx.String("label"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var leaseOutputCommand = cli.Command{
+	Name:      "leaseoutput",
+	Usage:     "Lease an output of the wallet to exclude it from coin selection.",
+	ArgsUsage: "outpoint",
+	Description: `
+	Lock an output of the wallet under the given lease ID, preventing it
+	from being selected by any of lnd's coin selections, including the ones
+	of the funding manager and the sweeper. Leases are persisted, and
+	expire after the given number of seconds. Leasing an output again with
+	the same ID extends its lease.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "id",
+			Usage: "the hex encoded 32 byte ID the output should " +
+				"be leased with",
+		},
+		cli.Uint64Flag{
+			Name: "expiry",
+			Usage: "the number of seconds the output should be " +
+				"leased for, defaults to 600",
+		},
+	},
+	Action: actionDecorator(leaseOutput),
+}
+
+func leaseOutput(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "leaseoutput")
+	}
+
+	protoOutPoint, err := NewProtoOutPoint(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	leaseID, err := parseLeaseID(ctx.String("id"))
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.LeaseOutputRequest{
+		Id:                leaseID,
+		Outpoint:          protoOutPoint,
+		ExpirationSeconds: ctx.Uint64("expiry"),
+	}
+	resp, err := client.LeaseOutput(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var releaseOutputCommand = cli.Command{
+	Name:      "releaseoutput",
+	Usage:     "Release a previously leased output.",
+	ArgsUsage: "outpoint",
+	Description: `
+	Release the lease of an output, making it available for coin selection
+	again. The ID must match the one the output was leased with.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "id",
+			Usage: "the hex encoded 32 byte ID the output was " +
+				"leased with",
+		},
+	},
+	Action: actionDecorator(releaseOutput),
+}
+
+func releaseOutput(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "releaseoutput")
+	}
+
+	protoOutPoint, err := NewProtoOutPoint(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	leaseID, err := parseLeaseID(ctx.String("id"))
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.ReleaseOutputRequest{
+		Id:       leaseID,
+		Outpoint: protoOutPoint,
+	}
+	resp, err := client.ReleaseOutput(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var listLeasesCommand = cli.Command{
+	Name:   "listleases",
+	Usage:  "List all currently leased outputs.",
+	Action: actionDecorator(listLeases),
+}
+
+func listLeases(ctx *cli.Context) error {
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.ListLeasesRequest{}
+	resp, err := client.ListLeases(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var labelTxCommand = cli.Command{
+	Name:      "labeltx",
+	Usage:     "Adds a label to a transaction.",
+	ArgsUsage: "txid label",
+	Description: `
+	Add a label to a transaction. If the transaction already has a label,
+	this call will fail unless the overwrite option is set. The label is
+	limited to 500 characters. Note that multi word labels must be contained
+	in quotation marks ("").
+	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "overwrite",
+			Usage: "set to overwrite existing labels",
+		},
+	},
+	Action: actionDecorator(labelTransaction),
+}
+
+func labelTransaction(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "labeltx")
+	}
+
+	// Get the transaction id and check that it is a valid hash.
+	txid, err := chainhash.NewHashFromStr(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.LabelTransactionRequest{
+		Txid:      txid[:],
+		Label:     ctx.Args().Get(1),
+		Overwrite: ctx.Bool("overwrite"),
+	}
+	resp, err := client.LabelTransaction(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var exportWatchOnlyCommand = cli.Command{
+	Name:      "exportwatchonly",
+	Usage:     "Export a watch-only copy of the wallet.",
+	ArgsUsage: "output_file",
+	Description: `
+	Export a watch-only copy of the wallet database to the given file. The
+	copy holds the account extended public keys of the wallet, but none of
+	its private keys. A node that uses this node as its remote signer can be
+	started with the copy as its wallet.db, and unlocked with the password
+	of this wallet.
+	`,
+	Action: actionDecorator(exportWatchOnly),
+}
+
+func exportWatchOnly(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "exportwatchonly")
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.ExportWatchOnlyWalletRequest{}
+	resp, err := client.ExportWatchOnlyWallet(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	outputFile := ctx.Args().First()
+	err = ioutil.WriteFile(outputFile, resp.WalletDb, 0600)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Watch-only wallet written to %v\n", outputFile)
+
+	return nil
+}
+
+var psbtCommand = cli.Command{
+	Name:  "psbt",
+	Usage: "Interact with partially signed bitcoin transactions (PSBTs).",
+	Subcommands: []cli.Command{
+		fundPsbtCommand,
+		signPsbtCommand,
+		finalizePsbtCommand,
+	},
+}
+
+var fundPsbtCommand = cli.Command{
+	Name:  "fund",
+	Usage: "Fund a PSBT with inputs and change of the wallet.",
+	Description: `
+	Create a PSBT that contains enough inputs of the wallet to pay for the
+	outputs of the given template, at the given fee rate. The template is
+	either a base64 encoded PSBT, or a JSON map of addresses to amounts in
+	satoshis, such as '{"ExampleAddr": 20000}'.
+
+	If the template has no inputs, coin selection is performed. Otherwise,
+	the inputs of the template are used as is and must all belong to the
+	wallet. A change output is added if any value is left over.
+
+	All inputs of the funded PSBT are leased for 10 minutes. They can be
+	released early through 'lncli wallet releaseoutput' with the lease ID
+	that is returned.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "template_psbt",
+			Usage: "the base64 encoded PSBT to use as the template",
+		},
+		cli.StringFlag{
+			Name: "outputs",
+			Usage: "a JSON map of addresses to the amounts to send " +
+				"to them, to use as the template",
+		},
+		cli.StringSliceFlag{
+			Name: "inputs",
+			Usage: "an outpoint in the format txid:index to spend " +
+				"with the outputs template, can be specified " +
+				"multiple times",
+		},
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the number of blocks the transaction should " +
+				"confirm in, used to estimate the fee rate",
+			Value: 6,
+		},
+		cli.Uint64Flag{
+			Name:  "sat_per_vbyte",
+			Usage: "a manual fee rate in sat/vbyte",
+		},
+		cli.IntFlag{
+			Name: "min_confs",
+			Usage: "the minimum number of confirmations the " +
+				"selected inputs must have",
+			Value: 1,
+		},
+	},
+	Action: actionDecorator(fundPsbt),
+}
+
+func fundPsbt(ctx *cli.Context) error {
+	req := &walletrpc.FundPsbtRequest{
+		MinConfs: int32(ctx.Int("min_confs")),
+	}
+
+	switch {
+	case ctx.IsSet("template_psbt") && ctx.IsSet("outputs"):
+		return errors.New("only one of template_psbt and outputs can " +
+			"be set")
+
+	case ctx.IsSet("template_psbt"):
+		if ctx.IsSet("inputs") {
+			return errors.New("inputs can only be set with outputs")
+		}
+
+		template, err := base64.StdEncoding.DecodeString(
+			ctx.String("template_psbt"),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode template PSBT: %v",
+				err)
+		}
+		req.Template = &walletrpc.FundPsbtRequest_Psbt{
+			Psbt: template,
+		}
+
+	case ctx.IsSet("outputs"):
+		outputs := make(map[string]uint64)
+		err := json.Unmarshal([]byte(ctx.String("outputs")), &outputs)
+		if err != nil {
+			return fmt.Errorf("unable to decode outputs: %v", err)
+		}
+
+		inputs, err := NewProtoOutPoints(ctx.StringSlice("inputs"))
+		if err != nil {
+			return err
+		}
+
+		req.Template = &walletrpc.FundPsbtRequest_Raw{
+			Raw: &walletrpc.TxTemplate{
+				Inputs:  inputs,
+				Outputs: outputs,
+			},
+		}
+
+	default:
+		return cli.ShowCommandHelp(ctx, "fund")
+	}
+
+	if ctx.IsSet("sat_per_vbyte") {
+		req.Fees = &walletrpc.FundPsbtRequest_SatPerVbyte{
+			SatPerVbyte: ctx.Uint64("sat_per_vbyte"),
+		}
+	} else {
+		req.Fees = &walletrpc.FundPsbtRequest_TargetConf{
+			TargetConf: uint32(ctx.Uint64("conf_target")),
+		}
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.FundPsbt(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var signPsbtCommand = cli.Command{
+	Name:      "sign",
+	Usage:     "Sign the inputs of a PSBT that belong to the wallet.",
+	ArgsUsage: "funded_psbt",
+	Description: `
+	Add signatures of the wallet to all inputs of the base64 encoded PSBT
+	that spend outputs of the wallet. Inputs that belong to other signers,
+	such as a hardware wallet, are left untouched. The PSBT is not
+	finalized.
+	`,
+	Action: actionDecorator(signPsbt),
+}
+
+func signPsbt(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "sign")
+	}
+
+	fundedPsbt, err := base64.StdEncoding.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("unable to decode PSBT: %v", err)
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.SignPsbtRequest{
+		FundedPsbt: fundedPsbt,
+	}
+	resp, err := client.SignPsbt(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var finalizePsbtCommand = cli.Command{
+	Name:      "finalize",
+	Usage:     "Sign and finalize a PSBT.",
+	ArgsUsage: "funded_psbt",
+	Description: `
+	Sign all inputs of the base64 encoded PSBT that belong to the wallet,
+	and finalize all of its inputs. The inputs of other signers must
+	already carry their signatures. The final transaction is printed in
+	both the PSBT and the raw hex encoded format, but not published.
+	`,
+	Action: actionDecorator(finalizePsbt),
+}
+
+func finalizePsbt(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "finalize")
+	}
+
+	fundedPsbt, err := base64.StdEncoding.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("unable to decode PSBT: %v", err)
+	}
+
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.FinalizePsbtRequest{
+		FundedPsbt: fundedPsbt,
+	}
+	resp, err := client.FinalizePsbt(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		SignedPsbt string `json:"signed_psbt"`
+		FinalTx    string `json:"final_tx"`
+	}{
+		SignedPsbt: base64.StdEncoding.EncodeToString(resp.SignedPsbt),
+		FinalTx:    hex.EncodeToString(resp.RawFinalTx),
 	})
+
+	return nil
+}
+
+var accountsCommand = cli.Command{
+	Name:  "accounts",
+	Usage: "Interact with wallet accounts.",
+	Subcommands: []cli.Command{
+		listAccountsCommand,
+	},
+}
+
+var listAccountsCommand = cli.Command{
+	Name:  "list",
+	Usage: "Retrieve information of existing on-chain wallet accounts.",
+	Description: `
+	List all accounts of the wallet that addresses are derived from, along
+	with their address type and balances. Leased outputs are excluded from
+	the balances.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "only accounts matching this name are returned",
+		},
+	},
+	Action: actionDecorator(listAccounts),
+}
+
+func listAccounts(ctx *cli.Context) error {
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.ListAccountsRequest{
+		Name: ctx.String("name"),
+	}
+	resp, err := client.ListAccounts(context.Background(), req)
 	if err != nil {
 		return err
 	}
@@ -168,3 +669,17 @@ func bumpFee(ctx *cli.Context) error {
 
 	return nil
 }
+
+// parseLeaseID parses a hex encoded 32 byte lease ID.
+func parseLeaseID(leaseIDStr string) ([]byte, error) {
+	leaseID, err := hex.DecodeString(leaseIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode lease id: %v", err)
+	}
+	if len(leaseID) != 32 {
+		return nil, fmt.Errorf("lease id must be 32 bytes, got %v",
+			len(leaseID))
+	}
+
+	return leaseID, nil
+}
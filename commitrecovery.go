@@ -0,0 +1,407 @@
+package lnd
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Actinium-project/acmd/txscript"
+	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/acmutil"
+	"github.com/Actinium-project/lnd/input"
+	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/lnwallet"
+	"github.com/Actinium-project/lnd/sweep"
+)
+
+const (
+	// commitRecoveryConfTarget is the confirmation target used when
+	// sweeping the commitment outputs found during a recovery.
+	commitRecoveryConfTarget = 6
+)
+
+// recoveredCommitOutput is an unspent commitment output paying to one of our
+// payment base points that's been found during a recovery.
+type recoveredCommitOutput struct {
+	// OutPoint is the outpoint of the commitment output.
+	OutPoint wire.OutPoint
+
+	// Value is the value of the commitment output.
+	Value acmutil.Amount
+
+	// PkScript is the script of the commitment output.
+	PkScript []byte
+
+	// KeyDesc is the payment base point the output pays to.
+	KeyDesc keychain.KeyDescriptor
+
+	// ConfHeight is the height of the block that confirmed the output.
+	ConfHeight uint32
+
+	// Swept is true once the output has been handed to the sweeper.
+	Swept bool
+}
+
+// commitRecoveryStatus describes the progress of the rescan for commitment
+// outputs of closed channels.
+type commitRecoveryStatus struct {
+	// Finished is true once the rescan has caught up with the best block
+	// of the chain.
+	Finished bool
+
+	// Progress is the share of the blocks that have been rescanned,
+	// between 0 and 1.
+	Progress float64
+
+	// Outputs are the unspent commitment outputs found so far.
+	Outputs []recoveredCommitOutput
+}
+
+// commitRecoveryConfig houses the items required by the commitRecovery.
+type commitRecoveryConfig struct {
+	// ChainIO is used to fetch the blocks to be rescanned.
+	ChainIO lnwallet.BlockChainIO
+
+	// KeyRing is used to derive the payment base points of our channels.
+	KeyRing keychain.KeyRing
+
+	// SweepInput hands a found commitment output to the sweeper.
+	SweepInput func(input.Input, sweep.Params) (chan sweep.Result, error)
+
+	// StartHeight returns the height of the first block to be rescanned,
+	// usually the birthday of the wallet. It's only queried once the
+	// rescan starts, as the birthday block is only known once the wallet
+	// has synced.
+	StartHeight func() (int32, error)
+
+	// LookAhead is the number of unused payment base points to scan for
+	// beyond the last one found.
+	LookAhead uint32
+}
+
+// commitRecovery rescans the chain for the funds of channels that were closed
+// before the node was restored from its seed alone. Commitment outputs that
+// pay to the remote party of a channel using the static remote key commitment
+// format pay directly to the payment base point of that party, which is
+// derived from the seed. The wallet itself is unaware of these keys, so the
+// outputs would be lost without this rescan. Every unspent output found is
+// swept back into the wallet once the rescan has caught up with the chain.
+//
+// The outputs to ourselves of commitments we broadcast are encumbered by the
+// revocation key of the remote party, and can therefore only be recovered
+// from a static channel backup.
+type commitRecovery struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *commitRecoveryConfig
+
+	// scripts maps the pkScripts being scanned for to the payment base
+	// point they pay to. It's only accessed by the scanning goroutine.
+	scripts map[string]keychain.KeyDescriptor
+
+	// nextIndex is the index of the next payment base point to be added
+	// to the scanned scripts.
+	nextIndex uint32
+
+	// startHeight is the height of the first block to be rescanned.
+	startHeight int32
+
+	mu     sync.Mutex
+	status commitRecoveryStatus
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// newCommitRecovery creates a new commitRecovery from the given config.
+func newCommitRecovery(cfg *commitRecoveryConfig) *commitRecovery {
+	return &commitRecovery{
+		cfg:     cfg,
+		scripts: make(map[string]keychain.KeyDescriptor),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Start launches the rescan.
+func (r *commitRecovery) Start() error {
+	if !atomic.CompareAndSwapUint32(&r.started, 0, 1) {
+		return nil
+	}
+
+	r.wg.Add(1)
+	go r.scan()
+
+	return nil
+}
+
+// Stop stops the rescan.
+func (r *commitRecovery) Stop() error {
+	if !atomic.CompareAndSwapUint32(&r.stopped, 0, 1) {
+		return nil
+	}
+
+	close(r.quit)
+	r.wg.Wait()
+
+	return nil
+}
+
+// Status returns the progress of the rescan.
+func (r *commitRecovery) Status() commitRecoveryStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	status := r.status
+	status.Outputs = append(
+		[]recoveredCommitOutput(nil), r.status.Outputs...,
+	)
+
+	return status
+}
+
+// extendScripts adds the scripts of all payment base points up to the given
+// index to the scanned scripts.
+func (r *commitRecovery) extendScripts(index uint32) error {
+	for ; r.nextIndex < index; r.nextIndex++ {
+		keyDesc, err := r.cfg.KeyRing.DeriveKey(keychain.KeyLocator{
+			Family: keychain.KeyFamilyPaymentBase,
+			Index:  r.nextIndex,
+		})
+		if err != nil {
+			return err
+		}
+
+		pkScript, err := input.CommitScriptUnencumbered(keyDesc.PubKey)
+		if err != nil {
+			return err
+		}
+
+		r.scripts[string(pkScript)] = keyDesc
+	}
+
+	return nil
+}
+
+// scan rescans all blocks from the start height up to the best block, and
+// sweeps the unspent commitment outputs found.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *commitRecovery) scan() {
+	defer r.wg.Done()
+
+	startHeight, err := r.cfg.StartHeight()
+	if err != nil {
+		srvrLog.Errorf("Unable to determine rescan start height: %v",
+			err)
+		return
+	}
+	r.startHeight = startHeight
+
+	srvrLog.Infof("Rescanning for commitment outputs of closed channels "+
+		"from height %v", startHeight)
+
+	if err := r.extendScripts(r.cfg.LookAhead); err != nil {
+		srvrLog.Errorf("Unable to derive payment base points: %v", err)
+		return
+	}
+
+	var (
+		found  = make(map[wire.OutPoint]*recoveredCommitOutput)
+		height = startHeight
+	)
+	for {
+		_, bestHeight, err := r.cfg.ChainIO.GetBestBlock()
+		if err != nil {
+			srvrLog.Errorf("Unable to fetch best block: %v", err)
+			return
+		}
+
+		// Once we've caught up with the chain, the outputs that
+		// remain unspent can be swept.
+		if height > bestHeight {
+			break
+		}
+
+		for ; height <= bestHeight; height++ {
+			select {
+			case <-r.quit:
+				return
+			default:
+			}
+
+			hash, err := r.cfg.ChainIO.GetBlockHash(int64(height))
+			if err != nil {
+				srvrLog.Errorf("Unable to fetch block hash at "+
+					"height %v: %v", height, err)
+				return
+			}
+			block, err := r.cfg.ChainIO.GetBlock(hash)
+			if err != nil {
+				srvrLog.Errorf("Unable to fetch block %v: %v",
+					hash, err)
+				return
+			}
+
+			err = r.scanBlock(block, uint32(height), found)
+			if err != nil {
+				srvrLog.Errorf("Unable to scan block %v: %v",
+					hash, err)
+				return
+			}
+
+			r.updateStatus(height, bestHeight, found)
+		}
+	}
+
+	r.mu.Lock()
+	r.status.Finished = true
+	r.status.Progress = 1
+	r.mu.Unlock()
+
+	// The payment base points found were derived from the seed again
+	// after the restore, so we'll make sure the key ring won't hand them
+	// out again for new channels.
+	if err := r.skipUsedKeys(found); err != nil {
+		srvrLog.Errorf("Unable to skip used payment base points: %v",
+			err)
+	}
+
+	for op, output := range found {
+		op := op
+		signDesc := &input.SignDescriptor{
+			KeyDesc:       output.KeyDesc,
+			WitnessScript: output.PkScript,
+			Output: &wire.TxOut{
+				Value:    int64(output.Value),
+				PkScript: output.PkScript,
+			},
+			HashType: txscript.SigHashAll,
+		}
+		inp := input.NewBaseInput(
+			&op, input.CommitSpendNoDelayTweakless, signDesc,
+			output.ConfHeight,
+		)
+
+		_, err := r.cfg.SweepInput(inp, sweep.Params{
+			Fee: sweep.FeePreference{
+				ConfTarget: commitRecoveryConfTarget,
+			},
+		})
+		if err != nil {
+			srvrLog.Errorf("Unable to sweep commitment output "+
+				"%v: %v", op, err)
+			continue
+		}
+
+		srvrLog.Infof("Sweeping recovered commitment output %v of %v",
+			op, output.Value)
+
+		r.mu.Lock()
+		for i := range r.status.Outputs {
+			if r.status.Outputs[i].OutPoint == op {
+				r.status.Outputs[i].Swept = true
+			}
+		}
+		r.mu.Unlock()
+	}
+}
+
+// scanBlock looks for outputs paying to our payment base points within the
+// given block, and removes the outputs found earlier that are spent by it.
+func (r *commitRecovery) scanBlock(block *wire.MsgBlock, height uint32,
+	found map[wire.OutPoint]*recoveredCommitOutput) error {
+
+	for _, tx := range block.Transactions {
+		for _, txIn := range tx.TxIn {
+			delete(found, txIn.PreviousOutPoint)
+		}
+
+		txHash := tx.TxHash()
+		for i, txOut := range tx.TxOut {
+			keyDesc, ok := r.scripts[string(txOut.PkScript)]
+			if !ok {
+				continue
+			}
+
+			op := wire.OutPoint{
+				Hash:  txHash,
+				Index: uint32(i),
+			}
+			found[op] = &recoveredCommitOutput{
+				OutPoint:   op,
+				Value:      acmutil.Amount(txOut.Value),
+				PkScript:   txOut.PkScript,
+				KeyDesc:    keyDesc,
+				ConfHeight: height,
+			}
+
+			srvrLog.Infof("Found commitment output %v paying to "+
+				"payment base point %v", op, keyDesc.Index)
+
+			// Make sure we keep looking ahead of the last payment
+			// base point used.
+			err := r.extendScripts(
+				keyDesc.Index + 1 + r.cfg.LookAhead,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateStatus records the progress of the rescan after the block at the
+// given height has been scanned.
+func (r *commitRecovery) updateStatus(height, bestHeight int32,
+	found map[wire.OutPoint]*recoveredCommitOutput) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.status.Progress = 1
+	if bestHeight > r.startHeight {
+		r.status.Progress = float64(height-r.startHeight) /
+			float64(bestHeight-r.startHeight)
+	}
+
+	r.status.Outputs = r.status.Outputs[:0]
+	for _, output := range found {
+		r.status.Outputs = append(r.status.Outputs, *output)
+	}
+	sort.Slice(r.status.Outputs, func(i, j int) bool {
+		return r.status.Outputs[i].ConfHeight <
+			r.status.Outputs[j].ConfHeight
+	})
+}
+
+// skipUsedKeys advances the key ring past the highest payment base point that
+// any of the found outputs pays to.
+func (r *commitRecovery) skipUsedKeys(
+	found map[wire.OutPoint]*recoveredCommitOutput) error {
+
+	if len(found) == 0 {
+		return nil
+	}
+
+	var maxIndex uint32
+	for _, output := range found {
+		if output.KeyDesc.Index > maxIndex {
+			maxIndex = output.KeyDesc.Index
+		}
+	}
+
+	for {
+		keyDesc, err := r.cfg.KeyRing.DeriveNextKey(
+			keychain.KeyFamilyPaymentBase,
+		)
+		if err != nil {
+			return err
+		}
+		if keyDesc.Index >= maxIndex {
+			return nil
+		}
+	}
+}
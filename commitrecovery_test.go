@@ -0,0 +1,181 @@
+package lnd
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/lnd/input"
+	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/sweep"
+)
+
+// recoveryKeyRing is a deterministic key ring deriving a distinct key for
+// every key locator.
+type recoveryKeyRing struct {
+	mu        sync.Mutex
+	nextIndex uint32
+}
+
+func (k *recoveryKeyRing) DeriveNextKey(
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	k.mu.Lock()
+	index := k.nextIndex
+	k.nextIndex++
+	k.mu.Unlock()
+
+	return k.DeriveKey(keychain.KeyLocator{Family: keyFam, Index: index})
+}
+
+func (k *recoveryKeyRing) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	seed := bytes.Repeat([]byte{byte(keyLoc.Index + 1)}, 32)
+	_, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), seed)
+
+	return keychain.KeyDescriptor{
+		KeyLocator: keyLoc,
+		PubKey:     pubKey,
+	}, nil
+}
+
+// recoveryChain is a chain of blocks to be rescanned, where the hash of each
+// block is its height.
+type recoveryChain struct {
+	mockChainIO
+
+	blocks []*wire.MsgBlock
+}
+
+func (c *recoveryChain) GetBestBlock() (*chainhash.Hash, int32, error) {
+	height := int32(len(c.blocks) - 1)
+	hash, _ := c.GetBlockHash(int64(height))
+
+	return hash, height, nil
+}
+
+func (c *recoveryChain) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	var hash chainhash.Hash
+	byteOrder.PutUint64(hash[:], uint64(height))
+
+	return &hash, nil
+}
+
+func (c *recoveryChain) GetBlock(hash *chainhash.Hash) (*wire.MsgBlock,
+	error) {
+
+	return c.blocks[byteOrder.Uint64(hash[:])], nil
+}
+
+// TestCommitRecovery asserts that the rescan finds the commitment outputs
+// paying to our payment base points beyond the initial look-ahead, ignores
+// the ones that have been spent, sweeps the remaining ones and advances the
+// key ring past the highest index found.
+func TestCommitRecovery(t *testing.T) {
+	t.Parallel()
+
+	keyRing := &recoveryKeyRing{}
+	commitOutput := func(index uint32) *wire.TxOut {
+		keyDesc, _ := keyRing.DeriveKey(keychain.KeyLocator{
+			Family: keychain.KeyFamilyPaymentBase,
+			Index:  index,
+		})
+		pkScript, err := input.CommitScriptUnencumbered(keyDesc.PubKey)
+		if err != nil {
+			t.Fatalf("unable to create script: %v", err)
+		}
+
+		return &wire.TxOut{
+			Value:    int64(1000 * (index + 1)),
+			PkScript: pkScript,
+		}
+	}
+
+	// With a look-ahead of two, the output to index 3 can only be found
+	// once the output to index 1 has been found, and the output to index 5
+	// once the output to index 3 has been found.
+	tx1 := wire.NewMsgTx(2)
+	tx1.AddTxOut(commitOutput(1))
+	tx2 := wire.NewMsgTx(2)
+	tx2.AddTxOut(commitOutput(3))
+	tx3 := wire.NewMsgTx(2)
+	tx3.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: tx1.TxHash()},
+	})
+	tx3.AddTxOut(commitOutput(5))
+
+	chain := &recoveryChain{
+		blocks: []*wire.MsgBlock{
+			{},
+			{Transactions: []*wire.MsgTx{tx1}},
+			{Transactions: []*wire.MsgTx{tx2}},
+			{Transactions: []*wire.MsgTx{tx3}},
+		},
+	}
+
+	swept := make(chan input.Input, 3)
+	r := newCommitRecovery(&commitRecoveryConfig{
+		ChainIO: chain,
+		KeyRing: keyRing,
+		SweepInput: func(inp input.Input,
+			_ sweep.Params) (chan sweep.Result, error) {
+
+			swept <- inp
+			return nil, nil
+		},
+		StartHeight: func() (int32, error) {
+			return 1, nil
+		},
+		LookAhead: 2,
+	})
+	if err := r.Start(); err != nil {
+		t.Fatalf("unable to start recovery: %v", err)
+	}
+	defer r.Stop()
+
+	expected := map[wire.OutPoint]uint32{
+		{Hash: tx2.TxHash()}: 3,
+		{Hash: tx3.TxHash()}: 5,
+	}
+	for i := 0; i < len(expected); i++ {
+		select {
+		case inp := <-swept:
+			index, ok := expected[*inp.OutPoint()]
+			if !ok {
+				t.Fatalf("unexpected output %v swept",
+					inp.OutPoint())
+			}
+			if inp.SignDesc().KeyDesc.Index != index {
+				t.Fatalf("expected key index %v, got %v", index,
+					inp.SignDesc().KeyDesc.Index)
+			}
+			if inp.WitnessType() != input.CommitSpendNoDelayTweakless {
+				t.Fatalf("unexpected witness type %v",
+					inp.WitnessType())
+			}
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("output not swept")
+		}
+	}
+
+	status := r.Status()
+	if !status.Finished || status.Progress != 1 {
+		t.Fatalf("expected finished rescan, got %+v", status)
+	}
+	if len(status.Outputs) != len(expected) {
+		t.Fatalf("expected %v outputs, got %v", len(expected),
+			len(status.Outputs))
+	}
+
+	// The key ring must not hand out any of the found indexes anymore.
+	keyDesc, _ := keyRing.DeriveNextKey(keychain.KeyFamilyPaymentBase)
+	if keyDesc.Index <= 5 {
+		t.Fatalf("expected key index beyond 5, got %v", keyDesc.Index)
+	}
+}
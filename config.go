@@ -100,6 +100,12 @@ const (
 	// push us in the broadcast window.
 	defaultFinalCltvRejectDelta = DefaultIncomingBroadcastDelta + 3
 
+	// defaultHoldExpiryDelta defines the number of blocks before the
+	// expiry of an htlc of an accepted hold invoice at which we cancel the
+	// invoice automatically. It leaves a few blocks before we would enter
+	// the incoming broadcast window and force close the channel.
+	defaultHoldExpiryDelta = DefaultIncomingBroadcastDelta + 2
+
 	// DefaultOutgoingBroadcastDelta defines the number of blocks before the
 	// expiry of an outgoing htlc at which we force close the channel. We
 	// are not in a hurry to force close, because there is nothing to claim
@@ -281,6 +287,16 @@ type config struct {
 	MaxPendingChannels int    `long:"maxpendingchannels" description:"The maximum number of incoming pending channels permitted per peer."`
 	BackupFilePath     string `long:"backupfilepath" description:"The target location of the channel backup file"`
 
+	BackupSinks *lncfg.BackupSinks `group:"backupsink" namespace:"backupsink"`
+
+	RemoteSigner *lncfg.RemoteSigner `group:"remotesigner" namespace:"remotesigner"`
+
+	Delivery *lncfg.Delivery `group:"delivery" namespace:"delivery"`
+
+	WalletUnlock *lncfg.WalletUnlock `group:"walletunlock" namespace:"walletunlock"`
+
+	Trampoline *lncfg.Trampoline `group:"trampoline" namespace:"trampoline"`
+
 	Bitcoin      *chainConfig    `group:"Bitcoin" namespace:"bitcoin"`
 	BtcdMode     *btcdConfig     `group:"btcd" namespace:"btcd"`
 	BitcoindMode *bitcoindConfig `group:"bitcoind" namespace:"bitcoind"`
@@ -333,6 +349,12 @@ type config struct {
 
 	AcceptKeySend bool `long:"accept-keysend" description:"If true, spontaneous payments through keysend will be accepted. [experimental]"`
 
+	AcceptAMP bool `long:"accept-amp" description:"If true, spontaneous atomic multi-path payments will be accepted. [experimental]"`
+
+	MaxHoldDuration time.Duration `long:"max-hold-duration" description:"The maximum time a hold invoice is kept in the accepted state before it is canceled automatically. Hold invoices may set a lower limit for themselves. If zero, only the limits of the invoices apply."`
+
+	HoldExpiryDelta uint32 `long:"hold-expiry-delta" description:"The number of blocks before the expiry of the earliest htlc of an accepted hold invoice at which the invoice is canceled automatically. Htlcs held by the htlc acceptor are failed at the same point. Must exceed the incoming broadcast delta. Set to zero to keep hold invoices accepted and htlcs held until the channel is force closed."`
+
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
 
 	Workers *lncfg.Workers `group:"workers" namespace:"workers"`
@@ -448,12 +470,30 @@ func loadConfig() (*config, error) {
 			RejectCacheSize:  channeldb.DefaultRejectCacheSize,
 			ChannelCacheSize: channeldb.DefaultChannelCacheSize,
 		},
+		BackupSinks: &lncfg.BackupSinks{
+			MaxVersions:   chanbackup.DefaultSinkVersions,
+			Timeout:       chanbackup.DefaultHTTPSinkTimeout,
+			RetryInterval: chanbackup.DefaultSinkRetryInterval,
+		},
+		RemoteSigner: &lncfg.RemoteSigner{
+			Timeout: lncfg.DefaultRemoteSignerRPCTimeout,
+		},
+		Delivery: &lncfg.Delivery{},
+		WalletUnlock: &lncfg.WalletUnlock{
+			CommandTimeout: lncfg.DefaultWalletUnlockCommandTimeout,
+		},
+		Trampoline: &lncfg.Trampoline{
+			FeeBaseMSat: lncfg.DefaultTrampolineFeeBaseMSat,
+			FeeRate:     lncfg.DefaultTrampolineFeeRate,
+			CltvDelta:   lncfg.DefaultTrampolineCltvDelta,
+		},
 		Prometheus: lncfg.DefaultPrometheus(),
 		Watchtower: &lncfg.Watchtower{
 			TowerDir: defaultTowerDir,
 		},
 		MaxOutgoingCltvExpiry:   htlcswitch.DefaultMaxOutgoingCltvExpiry,
 		MaxChannelFeeAllocation: htlcswitch.DefaultMaxLinkFeeAllocation,
+		HoldExpiryDelta:         defaultHoldExpiryDelta,
 	}
 
 	// Pre-parse the command line options to pick up an alternative config
@@ -559,6 +599,18 @@ func loadConfig() (*config, error) {
 	cfg.ActiniumdMode.Dir = cleanAndExpandPath(cfg.ActiniumdMode.Dir)
 	cfg.Tor.PrivateKeyPath = cleanAndExpandPath(cfg.Tor.PrivateKeyPath)
 	cfg.Watchtower.TowerDir = cleanAndExpandPath(cfg.Watchtower.TowerDir)
+	for i, dir := range cfg.BackupSinks.Dirs {
+		cfg.BackupSinks.Dirs[i] = cleanAndExpandPath(dir)
+	}
+	cfg.RemoteSigner.MacaroonPath = cleanAndExpandPath(
+		cfg.RemoteSigner.MacaroonPath,
+	)
+	cfg.RemoteSigner.TLSCertPath = cleanAndExpandPath(
+		cfg.RemoteSigner.TLSCertPath,
+	)
+	cfg.WalletUnlock.PasswordFile = cleanAndExpandPath(
+		cfg.WalletUnlock.PasswordFile,
+	)
 
 	// Ensure that the user didn't attempt to specify negative values for
 	// any of the autopilot params.
@@ -619,6 +671,16 @@ func loadConfig() (*config, error) {
 			cfg.MaxChannelFeeAllocation)
 	}
 
+	// Ensure that hold invoices are canceled before the channel would be
+	// force closed, if a hold expiry delta is set.
+	if cfg.HoldExpiryDelta != 0 &&
+		cfg.HoldExpiryDelta <= DefaultIncomingBroadcastDelta {
+
+		return nil, fmt.Errorf("hold-expiry-delta must be greater "+
+			"than the incoming broadcast delta of %v blocks",
+			DefaultIncomingBroadcastDelta)
+	}
+
 	// Validate the Tor config parameters.
 	socks, err := lncfg.ParseAddressString(
 		cfg.Tor.SOCKS, strconv.Itoa(defaultTorSOCKSPort),
@@ -1106,16 +1168,37 @@ func loadConfig() (*config, error) {
 			"minbackoff")
 	}
 
-	// Validate the subconfigs for workers, caches, and the tower client.
+	// Validate the subconfigs for workers, caches, the tower client, the
+	// backup sinks, the remote signer, the delivery destination, the
+	// wallet unlock password sources and trampoline routing.
 	err = lncfg.Validate(
 		cfg.Workers,
 		cfg.Caches,
 		cfg.WtClient,
+		cfg.BackupSinks,
+		cfg.RemoteSigner,
+		cfg.Delivery,
+		cfg.WalletUnlock,
+		cfg.Trampoline,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// A watch-only wallet must be opened through the wallet unlocker, so
+	// remote signing can't be used without a seed backup.
+	if cfg.RemoteSigner.Enable && cfg.NoSeedBackup {
+		return nil, fmt.Errorf("remote signing cannot be used with " +
+			"noseedbackup")
+	}
+
+	// With noseedbackup the wallet is always unlocked with the default
+	// password, so a configured password source would be ignored.
+	if cfg.WalletUnlock.Enabled() && cfg.NoSeedBackup {
+		return nil, fmt.Errorf("walletunlock cannot be used with " +
+			"noseedbackup")
+	}
+
 	// Finally, ensure that the user's color is correctly formatted,
 	// otherwise the server will not be able to start after the unlocking
 	// the wallet.
@@ -0,0 +1,218 @@
+package lnd
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/acmd/txscript"
+	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/acmutil"
+	"github.com/Actinium-project/acmutil/hdkeychain"
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/lncfg"
+	"github.com/Actinium-project/lnd/lnwallet"
+	"github.com/Actinium-project/lnd/lnwire"
+)
+
+// deliveryScriptSource hands out the scripts that the funds of closed channels
+// are sent to, either by a cooperative close or by sweeping the channel outputs
+// after a force close or breach. Unless an external destination is configured,
+// either for all channels or for the channel at hand, these are fresh addresses
+// of the wallet. The addresses of single channels only apply to cooperative
+// closes, as sweeps batch the outputs of several channels.
+type deliveryScriptSource struct {
+	// addrScript is the script of the configured delivery address, if
+	// any.
+	addrScript []byte
+
+	// channelScripts holds the scripts of the delivery addresses that are
+	// configured for single channels, by their channel point.
+	channelScripts map[wire.OutPoint][]byte
+
+	// externalBranch is the external branch of the configured extended
+	// public key, if any. A fresh address is derived from it for every
+	// script that's handed out.
+	externalBranch *hdkeychain.ExtendedKey
+
+	// indexKey identifies the configured extended public key within the
+	// database, which keeps track of the derivation indexes used.
+	indexKey []byte
+
+	netParams *chaincfg.Params
+
+	db *channeldb.DB
+
+	wallet lnwallet.WalletController
+}
+
+// newDeliveryScriptSource creates a delivery script source from the delivery
+// configuration, validating the configured destination for the given network.
+func newDeliveryScriptSource(cfg *lncfg.Delivery, netParams *chaincfg.Params,
+	db *channeldb.DB,
+	wallet lnwallet.WalletController) (*deliveryScriptSource, error) {
+
+	d := &deliveryScriptSource{
+		channelScripts: make(map[wire.OutPoint][]byte),
+		netParams:      netParams,
+		db:             db,
+		wallet:         wallet,
+	}
+
+	channelAddrs, err := cfg.ChannelAddresses()
+	if err != nil {
+		return nil, err
+	}
+	for chanPoint, address := range channelAddrs {
+		script, err := parseDeliveryAddress(address, netParams)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delivery address for "+
+				"channel %v: %v", chanPoint, err)
+		}
+
+		d.channelScripts[chanPoint] = script
+	}
+
+	switch {
+	case cfg.Address != "":
+		d.addrScript, err = parseDeliveryAddress(cfg.Address, netParams)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delivery address: %v",
+				err)
+		}
+
+	case cfg.XPub != "":
+		xpub, err := hdkeychain.NewKeyFromString(cfg.XPub)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delivery xpub: %v", err)
+		}
+		if xpub.IsPrivate() {
+			return nil, fmt.Errorf("delivery xpub must be an " +
+				"extended public key")
+		}
+		if !xpub.IsForNet(netParams) {
+			return nil, fmt.Errorf("delivery xpub is not for "+
+				"network %v", netParams.Name)
+		}
+
+		d.externalBranch, err = xpub.Child(0)
+		if err != nil {
+			return nil, err
+		}
+
+		indexKey := sha256.Sum256([]byte(cfg.XPub))
+		d.indexKey = indexKey[:]
+	}
+
+	return d, nil
+}
+
+// parseDeliveryAddress parses a delivery address for the given network, and
+// returns its script. Only addresses whose scripts are valid in a shutdown
+// message are accepted, as otherwise cooperative closes would fail.
+func parseDeliveryAddress(address string,
+	netParams *chaincfg.Params) ([]byte, error) {
+
+	addr, err := acmutil.DecodeAddress(address, netParams)
+	if err != nil {
+		return nil, err
+	}
+	if !addr.IsForNet(netParams) {
+		return nil, fmt.Errorf("address %v is not for network %v",
+			addr, netParams.Name)
+	}
+
+	switch addr.(type) {
+	case *acmutil.AddressPubKeyHash, *acmutil.AddressScriptHash,
+		*acmutil.AddressWitnessPubKeyHash,
+		*acmutil.AddressWitnessScriptHash:
+
+	default:
+		return nil, fmt.Errorf("address %v must be a P2PKH, P2SH, "+
+			"P2WKH or P2WSH address", addr)
+	}
+
+	return txscript.PayToAddrScript(addr)
+}
+
+// channelScript returns the script that the funds of the channel with the
+// given channel point should be sent to when it's cooperatively closed.
+func (d *deliveryScriptSource) channelScript(
+	chanPoint wire.OutPoint) ([]byte, error) {
+
+	if script, ok := d.channelScripts[chanPoint]; ok {
+		return script, nil
+	}
+
+	return d.nextScript()
+}
+
+// nextScript returns the script that the funds of the next closed channel or
+// swept channel output should be sent to, if no delivery address is configured
+// for the channel itself.
+func (d *deliveryScriptSource) nextScript() ([]byte, error) {
+	switch {
+	case d.addrScript != nil:
+		return d.addrScript, nil
+
+	case d.externalBranch != nil:
+		return d.deriveScript()
+
+	default:
+		addr, err := d.wallet.NewAddress(lnwallet.WitnessPubKey, false)
+		if err != nil {
+			return nil, err
+		}
+
+		return txscript.PayToAddrScript(addr)
+	}
+}
+
+// nextDeliveryAddress returns the script that the funds of the next
+// cooperatively closed channel should be sent to, as a delivery address. It's
+// used for upfront shutdown scripts, as the channel point of a channel isn't
+// known yet when it's opened.
+func (d *deliveryScriptSource) nextDeliveryAddress() (lnwire.DeliveryAddress,
+	error) {
+
+	return d.nextScript()
+}
+
+// deriveScript derives the P2WKH script of the next unused index of the
+// configured extended public key.
+func (d *deliveryScriptSource) deriveScript() ([]byte, error) {
+	for {
+		index, err := d.db.NextDeliveryIndex(d.indexKey)
+		if err != nil {
+			return nil, err
+		}
+
+		// A small number of indexes don't produce a valid key, in
+		// which case BIP32 mandates to skip to the next one.
+		child, err := d.externalBranch.Child(index)
+		if err == hdkeychain.ErrInvalidChild {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		pubKey, err := child.ECPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		addr, err := acmutil.NewAddressWitnessPubKeyHash(
+			acmutil.Hash160(pubKey.SerializeCompressed()),
+			d.netParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		ltndLog.Infof("Derived delivery address %v at index %v", addr,
+			index)
+
+		return txscript.PayToAddrScript(addr)
+	}
+}
@@ -0,0 +1,247 @@
+package lnd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/acmd/txscript"
+	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/acmutil"
+	"github.com/Actinium-project/acmutil/hdkeychain"
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/lncfg"
+)
+
+// TestDeliveryScriptSourceAddress asserts that only addresses that are valid
+// in a shutdown message are accepted as the delivery address, and that the
+// configured address is always handed out.
+func TestDeliveryScriptSourceAddress(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	pubKeyHash := bytes.Repeat([]byte{0x01}, 20)
+	p2wkh, err := acmutil.NewAddressWitnessPubKeyHash(pubKeyHash, params)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	p2wkhScript, err := txscript.PayToAddrScript(p2wkh)
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+
+	source, err := newDeliveryScriptSource(
+		&lncfg.Delivery{Address: p2wkh.String()}, params, nil, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to create delivery script source: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		script, err := source.nextScript()
+		if err != nil {
+			t.Fatalf("unable to get script: %v", err)
+		}
+		if !bytes.Equal(script, p2wkhScript) {
+			t.Fatalf("expected script %x, got %x", p2wkhScript,
+				script)
+		}
+	}
+
+	// A pay-to-pubkey address can't be used in a shutdown message.
+	p2pk, err := acmutil.NewAddressPubKey(
+		append([]byte{0x02}, bytes.Repeat([]byte{0x01}, 32)...),
+		params,
+	)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	_, err = newDeliveryScriptSource(
+		&lncfg.Delivery{Address: p2pk.String()}, params, nil, nil,
+	)
+	if err == nil {
+		t.Fatalf("expected pay-to-pubkey address to be rejected")
+	}
+
+	// An address of a different network must be rejected as well.
+	_, err = newDeliveryScriptSource(
+		&lncfg.Delivery{Address: p2wkh.String()},
+		&chaincfg.TestNet4Params, nil, nil,
+	)
+	if err == nil {
+		t.Fatalf("expected address of other network to be rejected")
+	}
+}
+
+// TestDeliveryScriptSourceXPub asserts that a fresh address is derived from
+// the external branch of the configured extended public key for every script,
+// and that used indexes aren't handed out again after a restart.
+func TestDeliveryScriptSourceXPub(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	tempDir, err := ioutil.TempDir("", "delivery")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := channeldb.Open(tempDir)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+	defer db.Close()
+
+	master, err := hdkeychain.NewMaster(
+		bytes.Repeat([]byte{0x02}, hdkeychain.RecommendedSeedLen),
+		params,
+	)
+	if err != nil {
+		t.Fatalf("unable to create master key: %v", err)
+	}
+	xpub, err := master.Neuter()
+	if err != nil {
+		t.Fatalf("unable to neuter key: %v", err)
+	}
+
+	// The private key must never be accepted.
+	_, err = newDeliveryScriptSource(
+		&lncfg.Delivery{XPub: master.String()}, params, db, nil,
+	)
+	if err == nil {
+		t.Fatalf("expected extended private key to be rejected")
+	}
+
+	expectedScript := func(index uint32) []byte {
+		branch, err := xpub.Child(0)
+		if err != nil {
+			t.Fatalf("unable to derive branch: %v", err)
+		}
+		child, err := branch.Child(index)
+		if err != nil {
+			t.Fatalf("unable to derive child: %v", err)
+		}
+		pubKey, err := child.ECPubKey()
+		if err != nil {
+			t.Fatalf("unable to get pubkey: %v", err)
+		}
+		addr, err := acmutil.NewAddressWitnessPubKeyHash(
+			acmutil.Hash160(pubKey.SerializeCompressed()), params,
+		)
+		if err != nil {
+			t.Fatalf("unable to create address: %v", err)
+		}
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatalf("unable to create script: %v", err)
+		}
+
+		return script
+	}
+
+	cfg := &lncfg.Delivery{XPub: xpub.String()}
+	for i := uint32(0); i < 4; i++ {
+		// Recreate the source halfway through, to make sure the used
+		// indexes are persisted.
+		source, err := newDeliveryScriptSource(cfg, params, db, nil)
+		if err != nil {
+			t.Fatalf("unable to create delivery script source: %v",
+				err)
+		}
+
+		for j := uint32(0); j < 2; j++ {
+			index := 2*i + j
+			script, err := source.nextScript()
+			if err != nil {
+				t.Fatalf("unable to get script: %v", err)
+			}
+			if !bytes.Equal(script, expectedScript(index)) {
+				t.Fatalf("unexpected script at index %v", index)
+			}
+		}
+	}
+}
+
+// TestDeliveryScriptSourceChannel asserts that the delivery address configured
+// for a single channel is used for that channel only, and that other channels
+// fall back to the global delivery address.
+func TestDeliveryScriptSourceChannel(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	newAddr := func(b byte) (acmutil.Address, []byte) {
+		addr, err := acmutil.NewAddressWitnessPubKeyHash(
+			bytes.Repeat([]byte{b}, 20), params,
+		)
+		if err != nil {
+			t.Fatalf("unable to create address: %v", err)
+		}
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatalf("unable to create script: %v", err)
+		}
+
+		return addr, script
+	}
+	globalAddr, globalScript := newAddr(0x01)
+	chanAddr, chanScript := newAddr(0x02)
+
+	chanPoint := wire.OutPoint{
+		Hash:  chainhash.Hash{0x03},
+		Index: 1,
+	}
+	otherChanPoint := wire.OutPoint{
+		Hash:  chainhash.Hash{0x03},
+		Index: 2,
+	}
+
+	source, err := newDeliveryScriptSource(&lncfg.Delivery{
+		Address:  globalAddr.String(),
+		Channels: []string{chanPoint.String() + "," + chanAddr.String()},
+	}, params, nil, nil)
+	if err != nil {
+		t.Fatalf("unable to create delivery script source: %v", err)
+	}
+
+	script, err := source.channelScript(chanPoint)
+	if err != nil {
+		t.Fatalf("unable to get script: %v", err)
+	}
+	if !bytes.Equal(script, chanScript) {
+		t.Fatalf("expected channel script %x, got %x", chanScript,
+			script)
+	}
+
+	script, err = source.channelScript(otherChanPoint)
+	if err != nil {
+		t.Fatalf("unable to get script: %v", err)
+	}
+	if !bytes.Equal(script, globalScript) {
+		t.Fatalf("expected global script %x, got %x", globalScript,
+			script)
+	}
+
+	// The address of a channel must be valid in a shutdown message as
+	// well.
+	p2pk, err := acmutil.NewAddressPubKey(
+		append([]byte{0x02}, bytes.Repeat([]byte{0x01}, 32)...),
+		params,
+	)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	_, err = newDeliveryScriptSource(&lncfg.Delivery{
+		Channels: []string{chanPoint.String() + "," + p2pk.String()},
+	}, params, nil, nil)
+	if err == nil {
+		t.Fatalf("expected pay-to-pubkey address to be rejected")
+	}
+
+	// Malformed channel points are rejected when the configuration is
+	// validated.
+	cfg := &lncfg.Delivery{
+		Channels: []string{"03:1," + chanAddr.String()},
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected malformed channel point to be rejected")
+	}
+}
@@ -55,6 +55,9 @@ var deps = depDesc{
 	lnwire.MPPOptional: {
 		lnwire.PaymentAddrOptional: {},
 	},
+	lnwire.AMPOptional: {
+		lnwire.MPPOptional: {},
+	},
 }
 
 // ValidateDeps asserts that a feature vector sets all features and their
@@ -17,6 +17,10 @@ type Config struct {
 	// NoStaticRemoteKey unsets any optional or required StaticRemoteKey
 	// bits from all feature sets.
 	NoStaticRemoteKey bool
+
+	// NoAMP unsets any optional or required AMP bits from all feature
+	// sets, signaling that spontaneous AMP payments aren't accepted.
+	NoAMP bool
 }
 
 // Manager is responsible for generating feature vectors for different requested
@@ -71,11 +75,17 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 			raw.Unset(lnwire.PaymentAddrRequired)
 			raw.Unset(lnwire.MPPOptional)
 			raw.Unset(lnwire.MPPRequired)
+			raw.Unset(lnwire.AMPOptional)
+			raw.Unset(lnwire.AMPRequired)
 		}
 		if cfg.NoStaticRemoteKey {
 			raw.Unset(lnwire.StaticRemoteKeyOptional)
 			raw.Unset(lnwire.StaticRemoteKeyRequired)
 		}
+		if cfg.NoAMP {
+			raw.Unset(lnwire.AMPOptional)
+			raw.Unset(lnwire.AMPRequired)
+		}
 
 		// Ensure that all of our feature sets properly set any
 		// dependent features.
@@ -9,7 +9,6 @@ import (
 
 	"github.com/Actinium-project/acmd/btcec"
 	"github.com/Actinium-project/acmd/chaincfg/chainhash"
-	"github.com/Actinium-project/acmd/txscript"
 	"github.com/Actinium-project/acmd/wire"
 	"github.com/Actinium-project/acmutil"
 	"github.com/coreos/bbolt"
@@ -22,6 +21,7 @@ import (
 	"github.com/Actinium-project/lnd/htlcswitch"
 	"github.com/Actinium-project/lnd/input"
 	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/labels"
 	"github.com/Actinium-project/lnd/lnpeer"
 	"github.com/Actinium-project/lnd/lnrpc"
 	"github.com/Actinium-project/lnd/lnwallet"
@@ -129,6 +129,10 @@ type reservationWithCtx struct {
 
 	updates chan *lnrpc.OpenStatusUpdate
 	err     chan error
+
+	// label is an optional label attached to the funding transaction of
+	// channels we initiated.
+	label string
 }
 
 // isLocked checks the reservation's timestamp to determine whether it is locked.
@@ -235,9 +239,14 @@ type fundingConfig struct {
 	// funds from on-chain transaction outputs into Lightning channels.
 	Wallet *lnwallet.LightningWallet
 
+	// GenDeliveryScript generates the script that the funds of a channel
+	// should be sent to in a cooperative close. It's used as the upfront
+	// shutdown script of channels if upfront shutdown is enabled.
+	GenDeliveryScript func() (lnwire.DeliveryAddress, error)
+
 	// PublishTransaction facilitates the process of broadcasting a
-	// transaction to the network.
-	PublishTransaction func(*wire.MsgTx) error
+	// transaction to the network, attaching the given label to it.
+	PublishTransaction func(*wire.MsgTx, string) error
 
 	// FeeEstimator calculates appropriate fee rates based on historical
 	// transaction information.
@@ -544,8 +553,14 @@ func (f *fundingManager) start() error {
 			if chanType.IsSingleFunder() && chanType.HasFundingTx() &&
 				channel.IsInitiator {
 
+				// The funding transaction has been labelled
+				// when we first broadcast it, so this label
+				// won't replace a label set by the user.
+				label := labels.MakeLabel(
+					labels.LabelTypeChannelOpen, nil,
+				)
 				err := f.cfg.PublishTransaction(
-					channel.FundingTxn,
+					channel.FundingTxn, label,
 				)
 				if err != nil {
 					fndgLog.Errorf("Unable to rebroadcast "+
@@ -1290,14 +1305,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// A nil address is set in place of user input, because this channel open
 	// was not initiated by the user.
 	shutdown, err := getUpfrontShutdownScript(
-		fmsg.peer, nil,
-		func() (lnwire.DeliveryAddress, error) {
-			addr, err := f.cfg.Wallet.NewAddress(lnwallet.WitnessPubKey, false)
-			if err != nil {
-				return nil, err
-			}
-			return txscript.PayToAddrScript(addr)
-		},
+		fmsg.peer, nil, f.cfg.GenDeliveryScript,
 	)
 	if err != nil {
 		f.failFundingFlow(
@@ -1807,7 +1815,16 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		fndgLog.Infof("Broadcasting funding tx for ChannelPoint(%v): %v",
 			completeChan.FundingOutpoint, spew.Sdump(fundingTx))
 
-		err = f.cfg.PublishTransaction(fundingTx)
+		// If the user didn't label the channel's funding transaction,
+		// then we'll label it ourselves.
+		label := resCtx.label
+		if label == "" {
+			label = labels.MakeLabel(
+				labels.LabelTypeChannelOpen, nil,
+			)
+		}
+
+		err = f.cfg.PublishTransaction(fundingTx, label)
 		if err != nil {
 			fndgLog.Errorf("Unable to broadcast funding tx for "+
 				"ChannelPoint(%v): %v",
@@ -2926,6 +2943,7 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		PushMSat:         msg.pushAmt,
 		Flags:            channelFlags,
 		MinConfs:         msg.minConfs,
+		Outpoints:        msg.outpoints,
 		Tweakless:        tweaklessCommitment,
 		ChanFunder:       msg.chanFunder,
 	}
@@ -2941,13 +2959,7 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	// wallet if our node is configured to set shutdown address by default).
 	shutdown, err := getUpfrontShutdownScript(
 		msg.peer, msg.openChanReq.shutdownScript,
-		func() (lnwire.DeliveryAddress, error) {
-			addr, err := f.cfg.Wallet.NewAddress(lnwallet.WitnessPubKey, false)
-			if err != nil {
-				return nil, err
-			}
-			return txscript.PayToAddrScript(addr)
-		},
+		f.cfg.GenDeliveryScript,
 	)
 	if err != nil {
 		msg.err <- err
@@ -2995,6 +3007,7 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		peer:           msg.peer,
 		updates:        msg.updates,
 		err:            msg.err,
+		label:          msg.label,
 	}
 	f.activeReservations[peerIDKey][chanID] = resCtx
 	f.resMtx.Unlock()
@@ -29,6 +29,7 @@ import (
 	"github.com/Actinium-project/lnd/htlcswitch"
 	"github.com/Actinium-project/lnd/input"
 	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/lncfg"
 	"github.com/Actinium-project/lnd/lnpeer"
 	"github.com/Actinium-project/lnd/lnrpc"
 	"github.com/Actinium-project/lnd/lnwallet"
@@ -292,15 +293,25 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		t.Fatalf("unable to create test ln wallet: %v", err)
 	}
 
+	// Without a configured delivery destination, the funds of closed
+	// channels are sent to fresh addresses of the wallet.
+	deliveryScripts, err := newDeliveryScriptSource(
+		&lncfg.Delivery{}, netParams, cdb, lnw,
+	)
+	if err != nil {
+		t.Fatalf("unable to create delivery script source: %v", err)
+	}
+
 	var chanIDSeed [32]byte
 
 	chainedAcceptor := chanacceptor.NewChainedAcceptor()
 
 	fundingCfg := fundingConfig{
-		IDKey:        privKey.PubKey(),
-		Wallet:       lnw,
-		Notifier:     chainNotifier,
-		FeeEstimator: estimator,
+		IDKey:             privKey.PubKey(),
+		Wallet:            lnw,
+		GenDeliveryScript: deliveryScripts.nextDeliveryAddress,
+		Notifier:          chainNotifier,
+		FeeEstimator:      estimator,
 		SignMessage: func(pubKey *btcec.PublicKey, msg []byte) (*btcec.Signature, error) {
 			return testSig, nil
 		},
@@ -372,7 +383,7 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		ReportShortChanID: func(wire.OutPoint) error {
 			return nil
 		},
-		PublishTransaction: func(txn *wire.MsgTx) error {
+		PublishTransaction: func(txn *wire.MsgTx, _ string) error {
 			publTxChan <- txn
 			return nil
 		},
@@ -435,10 +446,11 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 	chainedAcceptor := chanacceptor.NewChainedAcceptor()
 
 	f, err := newFundingManager(fundingConfig{
-		IDKey:        oldCfg.IDKey,
-		Wallet:       oldCfg.Wallet,
-		Notifier:     oldCfg.Notifier,
-		FeeEstimator: oldCfg.FeeEstimator,
+		IDKey:             oldCfg.IDKey,
+		Wallet:            oldCfg.Wallet,
+		GenDeliveryScript: oldCfg.GenDeliveryScript,
+		Notifier:          oldCfg.Notifier,
+		FeeEstimator:      oldCfg.FeeEstimator,
 		SignMessage: func(pubKey *btcec.PublicKey,
 			msg []byte) (*btcec.Signature, error) {
 			return testSig, nil
@@ -473,7 +485,7 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 		},
 		DefaultMinHtlcIn:       5,
 		RequiredRemoteMaxValue: oldCfg.RequiredRemoteMaxValue,
-		PublishTransaction: func(txn *wire.MsgTx) error {
+		PublishTransaction: func(txn *wire.MsgTx, _ string) error {
 			publishChan <- txn
 			return nil
 		},
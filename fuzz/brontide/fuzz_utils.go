@@ -9,6 +9,7 @@ import (
 	"github.com/Actinium-project/acmd/btcec"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/Actinium-project/lnd/brontide"
+	"github.com/Actinium-project/lnd/keychain"
 )
 
 var (
@@ -114,8 +115,12 @@ func getBrontideMachines() (*brontide.Machine, *brontide.Machine) {
 	respPriv, _ := btcec.NewPrivateKey(btcec.S256())
 	respPub := (*btcec.PublicKey)(&respPriv.PublicKey)
 
-	initiator := brontide.NewBrontideMachine(true, initPriv, respPub)
-	responder := brontide.NewBrontideMachine(false, respPriv, nil)
+	initiator := brontide.NewBrontideMachine(
+		true, &keychain.PrivKeyECDH{PrivKey: initPriv}, respPub,
+	)
+	responder := brontide.NewBrontideMachine(
+		false, &keychain.PrivKeyECDH{PrivKey: respPriv}, nil,
+	)
 
 	return initiator, responder
 }
@@ -127,10 +132,12 @@ func getStaticBrontideMachines() (*brontide.Machine, *brontide.Machine) {
 	respPriv, respPub := btcec.PrivKeyFromBytes(btcec.S256(), respBytes)
 
 	initiator := brontide.NewBrontideMachine(
-		true, initPriv, respPub, initEphemeral,
+		true, &keychain.PrivKeyECDH{PrivKey: initPriv}, respPub,
+		initEphemeral,
 	)
 	responder := brontide.NewBrontideMachine(
-		false, respPriv, nil, respEphemeral,
+		false, &keychain.PrivKeyECDH{PrivKey: respPriv}, nil,
+		respEphemeral,
 	)
 
 	return initiator, responder
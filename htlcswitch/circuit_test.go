@@ -7,12 +7,12 @@ import (
 	"testing"
 
 	"github.com/Actinium-project/acmd/btcec"
-	bitcoinCfg "github.com/Actinium-project/acmd/chaincfg"
 	"github.com/Actinium-project/acmutil"
 	sphinx "github.com/Actinium-project/lightning-onion"
 	"github.com/Actinium-project/lnd/channeldb"
 	"github.com/Actinium-project/lnd/htlcswitch"
 	"github.com/Actinium-project/lnd/htlcswitch/hop"
+	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/lnwire"
 )
 
@@ -83,8 +83,9 @@ func initTestExtracter() {
 // newOnionProcessor creates starts a new htlcswitch.OnionProcessor using a temp
 // db and no garbage collection.
 func newOnionProcessor(t *testing.T) *hop.OnionProcessor {
-	sphinxRouter := sphinx.NewRouter(
-		sphinxPrivKey, &bitcoinCfg.SimNetParams, sphinx.NewMemoryReplayLog(),
+	sphinxRouter := hop.NewOnionRouter(
+		&keychain.PrivKeyECDH{PrivKey: sphinxPrivKey},
+		sphinx.NewMemoryReplayLog(),
 	)
 
 	if err := sphinxRouter.Start(); err != nil {
@@ -121,11 +121,11 @@ func (r *sphinxHopIterator) ExtractErrorEncrypter(
 // the hop iterator should contain sphinx router which makes their creations in
 // tests dependent from the sphinx internal parts.
 type OnionProcessor struct {
-	router *sphinx.Router
+	router *OnionRouter
 }
 
 // NewOnionProcessor creates new instance of decoder.
-func NewOnionProcessor(router *sphinx.Router) *OnionProcessor {
+func NewOnionProcessor(router *OnionRouter) *OnionProcessor {
 	return &OnionProcessor{router}
 }
 
@@ -369,9 +369,7 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 func (p *OnionProcessor) ExtractErrorEncrypter(ephemeralKey *btcec.PublicKey) (
 	ErrorEncrypter, lnwire.FailCode) {
 
-	onionObfuscator, err := sphinx.NewOnionErrorEncrypter(
-		p.router, ephemeralKey,
-	)
+	onionObfuscator, err := p.router.NewOnionErrorEncrypter(ephemeralKey)
 	if err != nil {
 		switch err {
 		case sphinx.ErrInvalidOnionVersion:
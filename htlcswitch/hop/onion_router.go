@@ -0,0 +1,344 @@
+package hop
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+
+	"github.com/Actinium-project/acmd/btcec"
+	sphinx "github.com/Actinium-project/lightning-onion"
+	"github.com/Actinium-project/lnd/keychain"
+	"golang.org/x/crypto/chacha20"
+)
+
+const (
+	// routingInfoSize is the size of the routing info of a sphinx onion
+	// packet.
+	routingInfoSize = sphinx.MaxPayloadSize
+
+	// numStreamBytes is the number of key stream bytes needed to decrypt
+	// the routing info of a sphinx onion packet, padded with zeroes to
+	// keep its size for the next hop.
+	numStreamBytes = 2 * routingInfoSize
+)
+
+// zeroHMAC is the hmac of the payload of the final hop of a sphinx onion
+// packet, which signals that there are no more hops.
+var zeroHMAC [sphinx.HMACSize]byte
+
+// OnionRouter peels the sphinx onion packets this node receives, like
+// sphinx.Router does. Unlike sphinx.Router, it never handles the node key
+// itself, but derives the shared secrets of the onion packets through a
+// keychain.SingleKeyECDH, so that the node key can be held by a remote
+// signer.
+type OnionRouter struct {
+	onionKey keychain.SingleKeyECDH
+
+	log sphinx.ReplayLog
+}
+
+// NewOnionRouter creates a new onion router that peels onion packets with the
+// given onion key, and uses the replay log to reject replayed packets.
+func NewOnionRouter(onionKey keychain.SingleKeyECDH,
+	log sphinx.ReplayLog) *OnionRouter {
+
+	return &OnionRouter{
+		onionKey: onionKey,
+		log:      log,
+	}
+}
+
+// Start starts the replay log of the onion router.
+func (r *OnionRouter) Start() error {
+	return r.log.Start()
+}
+
+// Stop stops the replay log of the onion router.
+func (r *OnionRouter) Stop() {
+	r.log.Stop()
+}
+
+// ProcessOnionPacket peels the layer of the onion packet that is encrypted for
+// this node. The packet is rejected if its ephemeral key isn't on the curve,
+// its hmac doesn't match or its shared secret has been seen before.
+func (r *OnionRouter) ProcessOnionPacket(onionPkt *sphinx.OnionPacket,
+	assocData []byte, incomingCltv uint32) (*sphinx.ProcessedPacket, error) {
+
+	sharedSecret, err := r.generateSharedSecret(onionPkt.EphemeralKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Process the packet first, so that the replay log is only written
+	// for valid packets.
+	packet, err := processOnionPacket(onionPkt, &sharedSecret, assocData)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.log.Put(hashSharedSecret(&sharedSecret), incomingCltv)
+	if err != nil {
+		return nil, err
+	}
+
+	return packet, nil
+}
+
+// ReconstructOnionPacket peels the layer of the onion packet that is encrypted
+// for this node again.
+//
+// NOTE: This method doesn't check the replay log, and should only be used to
+// reconstruct packets that were successfully processed before.
+func (r *OnionRouter) ReconstructOnionPacket(onionPkt *sphinx.OnionPacket,
+	assocData []byte) (*sphinx.ProcessedPacket, error) {
+
+	sharedSecret, err := r.generateSharedSecret(onionPkt.EphemeralKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return processOnionPacket(onionPkt, &sharedSecret, assocData)
+}
+
+// NewOnionErrorEncrypter creates an error encrypter for the onion packet with
+// the given ephemeral key, which encrypts failures back to its sender.
+func (r *OnionRouter) NewOnionErrorEncrypter(
+	ephemeralKey *btcec.PublicKey) (*sphinx.OnionErrorEncrypter, error) {
+
+	sharedSecret, err := r.generateSharedSecret(ephemeralKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// The error encrypter only consists of the shared secret, which it
+	// restores when decoded.
+	encrypter := &sphinx.OnionErrorEncrypter{}
+	err = encrypter.Decode(bytes.NewReader(sharedSecret[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return encrypter, nil
+}
+
+// BeginTxn creates a new transaction, which processes a batch of onion packets
+// and commits them to the replay log atomically.
+//
+// NOTE: The nels parameter is the maximum number of packets that can be added
+// to the batch. Sequence numbers must be below it.
+func (r *OnionRouter) BeginTxn(id []byte, nels int) *OnionTx {
+	return &OnionTx{
+		batch:   sphinx.NewBatch(id),
+		router:  r,
+		packets: make([]sphinx.ProcessedPacket, nels),
+	}
+}
+
+// generateSharedSecret derives the shared secret with the sender of an onion
+// packet from its ephemeral key.
+func (r *OnionRouter) generateSharedSecret(
+	dhKey *btcec.PublicKey) (sphinx.Hash256, error) {
+
+	var sharedSecret sphinx.Hash256
+
+	// Ensure that the public key is on our curve.
+	if !btcec.S256().IsOnCurve(dhKey.X, dhKey.Y) {
+		return sharedSecret, sphinx.ErrInvalidOnionKey
+	}
+
+	secret, err := r.onionKey.ECDH(dhKey)
+	if err != nil {
+		return sharedSecret, err
+	}
+
+	return sphinx.Hash256(secret), nil
+}
+
+// OnionTx is a transaction of an OnionRouter, which processes a batch of onion
+// packets and commits their shared secrets to the replay log atomically.
+type OnionTx struct {
+	// batch is the set of shared secrets to commit to the replay log.
+	batch *sphinx.Batch
+
+	// router is the onion router that created the transaction.
+	router *OnionRouter
+
+	// packets holds the processed packets of the batch. A packet must
+	// only be used if its sequence number isn't in the replay set, and
+	// it didn't fail processing.
+	packets []sphinx.ProcessedPacket
+}
+
+// ProcessOnionPacket peels the layer of the onion packet that is encrypted for
+// this node, and adds its shared secret to the batch of the transaction.
+func (t *OnionTx) ProcessOnionPacket(seqNum uint16,
+	onionPkt *sphinx.OnionPacket, assocData []byte,
+	incomingCltv uint32) error {
+
+	sharedSecret, err := t.router.generateSharedSecret(
+		onionPkt.EphemeralKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	packet, err := processOnionPacket(onionPkt, &sharedSecret, assocData)
+	if err != nil {
+		return err
+	}
+
+	err = t.batch.Put(seqNum, hashSharedSecret(&sharedSecret), incomingCltv)
+	if err != nil {
+		return err
+	}
+
+	t.packets[seqNum] = *packet
+
+	return nil
+}
+
+// Commit writes the shared secrets of the batch to the replay log. It returns
+// the processed packets, along with the set of sequence numbers that were
+// found to be replays.
+func (t *OnionTx) Commit() ([]sphinx.ProcessedPacket, *sphinx.ReplaySet,
+	error) {
+
+	if t.batch.IsCommitted {
+		return t.packets, t.batch.ReplaySet, nil
+	}
+
+	rs, err := t.router.log.PutBatch(t.batch)
+
+	return t.packets, rs, err
+}
+
+// processOnionPacket peels a layer of the onion packet with the given shared
+// secret, and returns the payload for this node along with the packet for the
+// next hop.
+func processOnionPacket(onionPkt *sphinx.OnionPacket,
+	sharedSecret *sphinx.Hash256,
+	assocData []byte) (*sphinx.ProcessedPacket, error) {
+
+	// Make sure the routing info wasn't tampered with, and is bound to
+	// the associated data.
+	expectedMac := calcMac(
+		generateKey("mu", sharedSecret[:]), onionPkt.RoutingInfo[:],
+		assocData,
+	)
+	if !hmac.Equal(onionPkt.HeaderMAC[:], expectedMac[:]) {
+		return nil, sphinx.ErrInvalidOnionHMAC
+	}
+
+	// Decrypt the routing info. It is padded with zeroes, so that the
+	// routing info of the next hop keeps the same size.
+	hopInfo := make([]byte, numStreamBytes)
+	copy(hopInfo, onionPkt.RoutingInfo[:])
+	stream := generateCipherStream(
+		generateKey("rho", sharedSecret[:]), numStreamBytes,
+	)
+	xorBytes(hopInfo, hopInfo, stream)
+
+	var hopPayload sphinx.HopPayload
+	err := hopPayload.Decode(bytes.NewReader(hopInfo))
+	if err != nil {
+		return nil, err
+	}
+
+	hopData, err := hopPayload.HopData()
+	if err != nil {
+		return nil, err
+	}
+
+	// Blind the ephemeral key for the next hop, and pass on the remainder
+	// of the routing info.
+	blindingFactor := computeBlindingFactor(
+		onionPkt.EphemeralKey, sharedSecret[:],
+	)
+	nextKey := &btcec.PublicKey{Curve: btcec.S256()}
+	nextKey.X, nextKey.Y = btcec.S256().ScalarMult(
+		onionPkt.EphemeralKey.X, onionPkt.EphemeralKey.Y,
+		blindingFactor[:],
+	)
+
+	nextPacket := &sphinx.OnionPacket{
+		Version:      onionPkt.Version,
+		EphemeralKey: nextKey,
+		HeaderMAC:    hopPayload.HMAC,
+	}
+	copy(nextPacket.RoutingInfo[:], hopInfo[hopPayload.NumBytes():])
+
+	// A zero hmac signals that we are the final hop.
+	var action sphinx.ProcessCode = sphinx.MoreHops
+	if hopPayload.HMAC == zeroHMAC {
+		action = sphinx.ExitNode
+	}
+
+	return &sphinx.ProcessedPacket{
+		Action:                 action,
+		ForwardingInstructions: hopData,
+		Payload:                hopPayload,
+		NextPacket:             nextPacket,
+	}, nil
+}
+
+// hashSharedSecret returns the prefix of the sha256 of the shared secret, which
+// identifies the onion packet in the replay log.
+func hashSharedSecret(sharedSecret *sphinx.Hash256) *sphinx.HashPrefix {
+	h := sha256.Sum256(sharedSecret[:])
+
+	var prefix sphinx.HashPrefix
+	copy(prefix[:], h[:])
+
+	return &prefix
+}
+
+// computeBlindingFactor computes the factor the ephemeral key is blinded
+// with for the next hop as sha256(ephemeral key || shared secret).
+func computeBlindingFactor(ephemeralKey *btcec.PublicKey,
+	secret []byte) [32]byte {
+
+	h := sha256.New()
+	h.Write(ephemeralKey.SerializeCompressed())
+	h.Write(secret)
+
+	var factor [32]byte
+	copy(factor[:], h.Sum(nil))
+
+	return factor
+}
+
+// generateKey derives the key of the given type from a shared secret.
+func generateKey(keyType string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(keyType))
+	mac.Write(secret)
+
+	return mac.Sum(nil)
+}
+
+// generateCipherStream generates numBytes of ChaCha20 key stream with the
+// given key and a zero nonce.
+func generateCipherStream(key []byte, numBytes int) []byte {
+	var nonce [chacha20.NonceSize]byte
+	cipher, err := chacha20.NewUnauthenticatedCipher(key, nonce[:])
+	if err != nil {
+		panic(err)
+	}
+
+	stream := make([]byte, numBytes)
+	cipher.XORKeyStream(stream, stream)
+
+	return stream
+}
+
+// calcMac computes the hmac over the routing info and associated data of an
+// onion.
+func calcMac(key, routingInfo, assocData []byte) [sphinx.HMACSize]byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(routingInfo)
+	mac.Write(assocData)
+
+	var sum [sphinx.HMACSize]byte
+	copy(sum[:], mac.Sum(nil))
+
+	return sum
+}
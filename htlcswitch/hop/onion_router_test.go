@@ -0,0 +1,209 @@
+package hop
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/chaincfg"
+	sphinx "github.com/Actinium-project/lightning-onion"
+	"github.com/Actinium-project/lnd/keychain"
+)
+
+// newTestOnion creates an onion packet for a route through the given nodes,
+// with a tlv payload for every hop.
+func newTestOnion(t *testing.T, nodeKeys []*btcec.PrivateKey,
+	assocData []byte) *sphinx.OnionPacket {
+
+	t.Helper()
+
+	var path sphinx.PaymentPath
+	for i, nodeKey := range nodeKeys {
+		payload, err := sphinx.NewHopPayload(
+			nil, bytes.Repeat([]byte{byte(i + 1)}, 10),
+		)
+		if err != nil {
+			t.Fatalf("unable to create hop payload: %v", err)
+		}
+
+		path[i] = sphinx.OnionHop{
+			NodePub:    *nodeKey.PubKey(),
+			HopPayload: payload,
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate session key: %v", err)
+	}
+
+	onion, err := sphinx.NewOnionPacket(
+		&path, sessionKey, assocData, sphinx.BlankPacketFiller,
+	)
+	if err != nil {
+		t.Fatalf("unable to create onion: %v", err)
+	}
+
+	return onion
+}
+
+// TestOnionRouter asserts that the onion router peels onion packets exactly
+// like the sphinx router does with the private key of the node.
+func TestOnionRouter(t *testing.T) {
+	t.Parallel()
+
+	const numHops = 3
+
+	var nodeKeys []*btcec.PrivateKey
+	for i := 0; i < numHops; i++ {
+		nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatalf("unable to generate key: %v", err)
+		}
+		nodeKeys = append(nodeKeys, nodeKey)
+	}
+
+	assocData := bytes.Repeat([]byte{0x42}, 32)
+	onion := newTestOnion(t, nodeKeys, assocData)
+
+	for i, nodeKey := range nodeKeys {
+		sphinxRouter := sphinx.NewRouter(
+			nodeKey, &chaincfg.SimNetParams,
+			sphinx.NewMemoryReplayLog(),
+		)
+		onionRouter := NewOnionRouter(
+			&keychain.PrivKeyECDH{PrivKey: nodeKey},
+			sphinx.NewMemoryReplayLog(),
+		)
+		if err := sphinxRouter.Start(); err != nil {
+			t.Fatalf("unable to start sphinx router: %v", err)
+		}
+		if err := onionRouter.Start(); err != nil {
+			t.Fatalf("unable to start onion router: %v", err)
+		}
+
+		expected, err := sphinxRouter.ProcessOnionPacket(
+			onion, assocData, 100,
+		)
+		if err != nil {
+			t.Fatalf("hop %v: sphinx router unable to process "+
+				"onion: %v", i, err)
+		}
+		packet, err := onionRouter.ProcessOnionPacket(
+			onion, assocData, 100,
+		)
+		if err != nil {
+			t.Fatalf("hop %v: unable to process onion: %v", i, err)
+		}
+		if !reflect.DeepEqual(expected, packet) {
+			t.Fatalf("hop %v: processed packets mismatched", i)
+		}
+
+		isFinal := i == numHops-1
+		if isFinal != (packet.Action == sphinx.ExitNode) {
+			t.Fatalf("hop %v: expected final=%v, got action %v",
+				i, isFinal, packet.Action)
+		}
+
+		// A replayed packet is rejected, but can still be
+		// reconstructed.
+		_, err = onionRouter.ProcessOnionPacket(onion, assocData, 100)
+		if err != sphinx.ErrReplayedPacket {
+			t.Fatalf("hop %v: expected replay error, got %v", i,
+				err)
+		}
+		reconstructed, err := onionRouter.ReconstructOnionPacket(
+			onion, assocData,
+		)
+		if err != nil {
+			t.Fatalf("hop %v: unable to reconstruct onion: %v",
+				i, err)
+		}
+		if !reflect.DeepEqual(packet, reconstructed) {
+			t.Fatalf("hop %v: reconstructed packet mismatched", i)
+		}
+
+		// Both routers encrypt errors with the same shared secret.
+		expectedEncrypter, err := sphinx.NewOnionErrorEncrypter(
+			sphinxRouter, onion.EphemeralKey,
+		)
+		if err != nil {
+			t.Fatalf("hop %v: unable to create error encrypter: "+
+				"%v", i, err)
+		}
+		encrypter, err := onionRouter.NewOnionErrorEncrypter(
+			onion.EphemeralKey,
+		)
+		if err != nil {
+			t.Fatalf("hop %v: unable to create error encrypter: "+
+				"%v", i, err)
+		}
+		if !reflect.DeepEqual(expectedEncrypter, encrypter) {
+			t.Fatalf("hop %v: error encrypters mismatched", i)
+		}
+
+		sphinxRouter.Stop()
+		onionRouter.Stop()
+
+		onion = packet.NextPacket
+	}
+}
+
+// TestOnionRouterBatch asserts that batches of onion packets are checked for
+// replays when committed, and that invalid packets are rejected.
+func TestOnionRouterBatch(t *testing.T) {
+	t.Parallel()
+
+	nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	router := NewOnionRouter(
+		&keychain.PrivKeyECDH{PrivKey: nodeKey},
+		sphinx.NewMemoryReplayLog(),
+	)
+	if err := router.Start(); err != nil {
+		t.Fatalf("unable to start onion router: %v", err)
+	}
+	defer router.Stop()
+
+	assocData := bytes.Repeat([]byte{0x42}, 32)
+	onion := newTestOnion(t, []*btcec.PrivateKey{nodeKey}, assocData)
+	replayedOnion := newTestOnion(t, []*btcec.PrivateKey{nodeKey}, assocData)
+
+	if _, err := router.ProcessOnionPacket(
+		replayedOnion, assocData, 100,
+	); err != nil {
+		t.Fatalf("unable to process onion: %v", err)
+	}
+
+	tx := router.BeginTxn([]byte("batch"), 3)
+	if err := tx.ProcessOnionPacket(0, onion, assocData, 100); err != nil {
+		t.Fatalf("unable to process onion: %v", err)
+	}
+	if err := tx.ProcessOnionPacket(
+		1, replayedOnion, assocData, 100,
+	); err != nil {
+		t.Fatalf("unable to process onion: %v", err)
+	}
+
+	// An onion that is bound to other associated data fails its hmac
+	// check.
+	err = tx.ProcessOnionPacket(2, onion, nil, 100)
+	if err != sphinx.ErrInvalidOnionHMAC {
+		t.Fatalf("expected hmac error, got %v", err)
+	}
+
+	packets, replays, err := tx.Commit()
+	if err != nil {
+		t.Fatalf("unable to commit batch: %v", err)
+	}
+	if replays.Contains(0) || !replays.Contains(1) {
+		t.Fatalf("unexpected replay set")
+	}
+	if packets[0].Action != sphinx.ExitNode {
+		t.Fatalf("expected exit node, got %v", packets[0].Action)
+	}
+}
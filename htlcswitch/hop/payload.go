@@ -86,6 +86,14 @@ type Payload struct {
 	// a TLV onion payload.
 	MPP *record.MPP
 
+	// AMP holds the info provided in an option_amp record when parsed from
+	// a TLV onion payload.
+	AMP *record.AMP
+
+	// trampolineOnion is the trampoline onion carried in the final hop
+	// payload, if the sender uses us as a trampoline node.
+	trampolineOnion []byte
+
 	// customRecords are user-defined records in the custom type range that
 	// were included in the payload.
 	customRecords record.CustomSet
@@ -115,6 +123,9 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		amt  uint64
 		cltv uint32
 		mpp  = &record.MPP{}
+		amp  = &record.AMP{}
+
+		trampolineOnion []byte
 	)
 
 	tlvStream, err := tlv.NewStream(
@@ -122,6 +133,8 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		record.NewLockTimeRecord(&cltv),
 		record.NewNextHopIDRecord(&cid),
 		mpp.Record(),
+		amp.Record(),
+		record.NewTrampolineOnionRecord(&trampolineOnion),
 	)
 	if err != nil {
 		return nil, err
@@ -156,6 +169,19 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		mpp = nil
 	}
 
+	// If no AMP field was parsed, set the AMP field on the resulting
+	// payload to nil.
+	if _, ok := parsedTypes[record.AMPOnionType]; !ok {
+		amp = nil
+	}
+
+	// The trampoline onion must have the size of a trampoline onion, so
+	// that it doesn't leak the position of the trampoline node.
+	_, hasTrampolineOnion := parsedTypes[record.TrampolineOnionOnionType]
+	if hasTrampolineOnion && len(trampolineOnion) != TrampolineOnionSize {
+		return nil, ErrInvalidTrampolineOnion
+	}
+
 	// Filter out the custom records.
 	customRecords := NewCustomRecords(parsedTypes)
 
@@ -166,8 +192,10 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 			AmountToForward: lnwire.MilliSatoshi(amt),
 			OutgoingCTLV:    cltv,
 		},
-		MPP:           mpp,
-		customRecords: customRecords,
+		MPP:             mpp,
+		AMP:             amp,
+		trampolineOnion: trampolineOnion,
+		customRecords:   customRecords,
 	}, nil
 }
 
@@ -203,6 +231,8 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 	_, hasLockTime := parsedTypes[record.LockTimeOnionType]
 	_, hasNextHop := parsedTypes[record.NextHopOnionType]
 	_, hasMPP := parsedTypes[record.MPPOnionType]
+	_, hasAMP := parsedTypes[record.AMPOnionType]
+	_, hasTrampolineOnion := parsedTypes[record.TrampolineOnionOnionType]
 
 	switch {
 
@@ -239,6 +269,31 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 			Violation: IncludedViolation,
 			FinalHop:  isFinalHop,
 		}
+
+	// Intermediate nodes should never receive AMP fields.
+	case !isFinalHop && hasAMP:
+		return ErrInvalidPayload{
+			Type:      record.AMPOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// Intermediate nodes should never receive a trampoline onion.
+	case !isFinalHop && hasTrampolineOnion:
+		return ErrInvalidPayload{
+			Type:      record.TrampolineOnionOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// The AMP fields are only valid as part of a multi-path payment, so
+	// the exit hop must receive the MPP fields along with them.
+	case hasAMP && !hasMPP:
+		return ErrInvalidPayload{
+			Type:      record.MPPOnionType,
+			Violation: OmittedViolation,
+			FinalHop:  true,
+		}
 	}
 
 	return nil
@@ -250,6 +305,18 @@ func (h *Payload) MultiPath() *record.MPP {
 	return h.MPP
 }
 
+// AMPRecord returns the record corresponding to option_amp parsed from the
+// onion payload.
+func (h *Payload) AMPRecord() *record.AMP {
+	return h.AMP
+}
+
+// TrampolineOnion returns the trampoline onion parsed from the payload, or nil
+// if the payload doesn't carry one.
+func (h *Payload) TrampolineOnion() []byte {
+	return h.trampolineOnion
+}
+
 // CustomRecords returns the custom tlv type records that were parsed from the
 // payload.
 func (h *Payload) CustomRecords() record.CustomSet {
@@ -16,6 +16,7 @@ type decodePayloadTest struct {
 	expErr           error
 	expCustomRecords map[uint64][]byte
 	shouldHaveMPP    bool
+	shouldHaveAMP    bool
 }
 
 var decodePayloadTests = []decodePayloadTest{
@@ -82,9 +83,9 @@ var decodePayloadTests = []decodePayloadTest{
 	},
 	{
 		name:    "required type after omitted hop id",
-		payload: []byte{0x02, 0x00, 0x04, 0x00, 0x0a, 0x00},
+		payload: []byte{0x02, 0x00, 0x04, 0x00, 0x0c, 0x00},
 		expErr: hop.ErrInvalidPayload{
-			Type:      10,
+			Type:      12,
 			Violation: hop.RequiredViolation,
 			FinalHop:  true,
 		},
@@ -92,10 +93,10 @@ var decodePayloadTests = []decodePayloadTest{
 	{
 		name: "required type after included hop id",
 		payload: []byte{0x02, 0x00, 0x04, 0x00, 0x06, 0x08, 0x01, 0x00,
-			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0c, 0x00,
 		},
 		expErr: hop.ErrInvalidPayload{
-			Type:      10,
+			Type:      12,
 			Violation: hop.RequiredViolation,
 			FinalHop:  false,
 		},
@@ -194,6 +195,120 @@ var decodePayloadTests = []decodePayloadTest{
 		expErr:        nil,
 		shouldHaveMPP: true,
 	},
+	{
+		name: "intermediate hop with amp",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// next hop id
+			0x06, 0x08,
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			// amp
+			0x0a, 0x41,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x01,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.AMPOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  false,
+		},
+	},
+	{
+		name: "final hop with amp without mpp",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// amp
+			0x0a, 0x41,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x01,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.MPPOnionType,
+			Violation: hop.OmittedViolation,
+			FinalHop:  true,
+		},
+	},
+	{
+		name: "final hop with mpp and amp",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// mpp
+			0x08, 0x21,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x08,
+			// amp
+			0x0a, 0x41,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x01,
+		},
+		expErr:        nil,
+		shouldHaveMPP: true,
+		shouldHaveAMP: true,
+	},
+	{
+		name: "intermediate hop with trampoline onion",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// next hop id
+			0x06, 0x08,
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			// trampoline onion
+			0xfe, 0x00, 0x01, 0x02, 0x34, 0x01, 0x00,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.TrampolineOnionOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  false,
+		},
+	},
+	{
+		name: "final hop with truncated trampoline onion",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// trampoline onion
+			0xfe, 0x00, 0x01, 0x02, 0x34, 0x01, 0x00,
+		},
+		expErr: hop.ErrInvalidTrampolineOnion,
+	},
 }
 
 // TestDecodeHopPayloadRecordValidation asserts that parsing the payloads in the
@@ -216,6 +331,18 @@ func testDecodeHopPayloadValidation(t *testing.T, test decodePayloadTest) {
 			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
 			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
 		}
+		testRootShare = [32]byte{
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+			0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+		}
+		testSetID = [32]byte{
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+		}
 	)
 
 	p, err := hop.NewPayloadFromReader(bytes.NewReader(test.payload))
@@ -242,6 +369,24 @@ func testDecodeHopPayloadValidation(t *testing.T, test decodePayloadTest) {
 		t.Fatalf("unexpected MPP payload")
 	}
 
+	// Assert AMP fields if we expect them.
+	if test.shouldHaveAMP {
+		if p.AMP == nil {
+			t.Fatalf("payload should have AMP record")
+		}
+		if p.AMP.RootShare() != testRootShare {
+			t.Fatalf("invalid root share")
+		}
+		if p.AMP.SetID() != testSetID {
+			t.Fatalf("invalid set id")
+		}
+		if p.AMP.ChildIndex() != 1 {
+			t.Fatalf("invalid child index")
+		}
+	} else if p.AMP != nil {
+		t.Fatalf("unexpected AMP payload")
+	}
+
 	// Convert expected nil map to empty map, because we always expect an
 	// initiated map from the payload.
 	expCustomRecords := make(record.CustomSet)
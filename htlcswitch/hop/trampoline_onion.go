@@ -0,0 +1,445 @@
+package hop
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/record"
+	"github.com/Actinium-project/lnd/tlv"
+)
+
+const (
+	// TrampolineOnionSize is the size of a serialized trampoline onion.
+	// The trampoline onion travels inside the final hop payload of the
+	// regular onion, so it is a lot smaller: 1 byte version, 33 byte
+	// ephemeral key, 400 bytes routing info and a 32 byte hmac.
+	TrampolineOnionSize = 1 + 33 + trampolineRoutingInfoSize +
+		trampolineHMACSize
+
+	// trampolineRoutingInfoSize is the size of the routing info of a
+	// trampoline onion, which holds the payloads of all trampoline hops.
+	trampolineRoutingInfoSize = 400
+
+	// trampolineHMACSize is the size of the hmacs that protect the
+	// integrity of the trampoline onion.
+	trampolineHMACSize = 32
+
+	// trampolineOnionVersion is the version of the trampoline onion
+	// format.
+	trampolineOnionVersion byte = 0
+)
+
+var (
+	// ErrTrampolineOnionTooLarge is returned when the payloads of the
+	// trampoline hops don't fit in the routing info of a trampoline onion.
+	ErrTrampolineOnionTooLarge = errors.New("trampoline payloads exceed " +
+		"routing info size")
+
+	// ErrInvalidTrampolineOnion is returned when a trampoline onion
+	// can't be parsed.
+	ErrInvalidTrampolineOnion = errors.New("invalid trampoline onion")
+
+	// ErrInvalidTrampolineOnionHMAC is returned when the hmac of a
+	// trampoline onion doesn't match its contents.
+	ErrInvalidTrampolineOnionHMAC = errors.New("invalid trampoline onion " +
+		"hmac")
+
+	// zeroTrampolineHMAC is the hmac of the payload of the final
+	// trampoline hop, which signals that there are no more hops.
+	zeroTrampolineHMAC [trampolineHMACSize]byte
+)
+
+// TrampolineHop is a hop of a trampoline route.
+type TrampolineHop struct {
+	// NodeID is the public key of the trampoline node.
+	NodeID *btcec.PublicKey
+
+	// Payload is the serialized TrampolinePayload for the node.
+	Payload []byte
+}
+
+// numBytes returns the number of bytes the payload of the hop takes up in the
+// routing info of a trampoline onion.
+func (h *TrampolineHop) numBytes() int {
+	return int(tlv.VarIntSize(uint64(len(h.Payload)))) + len(h.Payload) +
+		trampolineHMACSize
+}
+
+// PeeledTrampolineOnion is the content of a trampoline onion after a node
+// removed its layer of encryption.
+type PeeledTrampolineOnion struct {
+	// Payload is the serialized TrampolinePayload for the node.
+	Payload []byte
+
+	// NextOnion is the trampoline onion to pass to the next trampoline
+	// node. It is nil if the node is the final trampoline hop.
+	NextOnion []byte
+}
+
+// NewTrampolineOnion creates a trampoline onion for the given hops. The
+// construction follows the regular sphinx onion, with a smaller routing info
+// that fits into an onion payload. The associated data should be the payment
+// hash, so that the trampoline onion can't be used for another payment.
+func NewTrampolineOnion(hops []TrampolineHop, sessionKey *btcec.PrivateKey,
+	assocData []byte) ([]byte, error) {
+
+	numHops := len(hops)
+	if numHops == 0 {
+		return nil, errors.New("trampoline route of length zero")
+	}
+
+	totalSize := 0
+	for i := range hops {
+		totalSize += hops[i].numBytes()
+	}
+	if totalSize > trampolineRoutingInfoSize {
+		return nil, ErrTrampolineOnionTooLarge
+	}
+
+	nodeKeys := make([]*btcec.PublicKey, numHops)
+	for i, hop := range hops {
+		nodeKeys[i] = hop.NodeID
+	}
+	secrets := trampolineSharedSecrets(nodeKeys, sessionKey)
+
+	// The filler makes sure that the routing info looks the same to every
+	// hop, no matter how many hops came before it.
+	fillerSize := totalSize - hops[numHops-1].numBytes()
+	filler := make([]byte, fillerSize)
+	for i := 0; i < numHops-1; i++ {
+		fillerStart := trampolineRoutingInfoSize
+		for j := 0; j < i; j++ {
+			fillerStart -= hops[j].numBytes()
+		}
+		fillerEnd := trampolineRoutingInfoSize + hops[i].numBytes()
+
+		stream := generateCipherStream(
+			generateKey("rho", secrets[i]),
+			2*trampolineRoutingInfoSize,
+		)
+		xorBytes(filler, filler, stream[fillerStart:fillerEnd])
+	}
+
+	// The unused part of the routing info is filled with pseudo-random
+	// bytes derived from the session key.
+	mixHeader := generateCipherStream(
+		generateKey("pad", sessionKey.Serialize()),
+		trampolineRoutingInfoSize,
+	)
+
+	var nextHMAC [trampolineHMACSize]byte
+	for i := numHops - 1; i >= 0; i-- {
+		var b bytes.Buffer
+		var buf [8]byte
+		err := tlv.WriteVarInt(&b, uint64(len(hops[i].Payload)), &buf)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(hops[i].Payload)
+		b.Write(nextHMAC[:])
+
+		// Make room for the payload of this hop and encrypt the routing
+		// info with the key of the hop.
+		shiftSize := b.Len()
+		copy(mixHeader[shiftSize:], mixHeader)
+		copy(mixHeader, b.Bytes())
+
+		stream := generateCipherStream(
+			generateKey("rho", secrets[i]),
+			trampolineRoutingInfoSize,
+		)
+		xorBytes(mixHeader, mixHeader, stream)
+
+		if i == numHops-1 {
+			copy(mixHeader[len(mixHeader)-len(filler):], filler)
+		}
+
+		nextHMAC = calcMac(
+			generateKey("mu", secrets[i]), mixHeader, assocData,
+		)
+	}
+
+	var onion bytes.Buffer
+	onion.WriteByte(trampolineOnionVersion)
+	onion.Write(sessionKey.PubKey().SerializeCompressed())
+	onion.Write(mixHeader)
+	onion.Write(nextHMAC[:])
+
+	return onion.Bytes(), nil
+}
+
+// PeelTrampolineOnion removes the layer of encryption of the given node from a
+// trampoline onion. It returns the payload for the node, and the onion to pass
+// to the next trampoline node if there is one.
+func PeelTrampolineOnion(onion []byte, nodeKey keychain.SingleKeyECDH,
+	assocData []byte) (*PeeledTrampolineOnion, error) {
+
+	if len(onion) != TrampolineOnionSize {
+		return nil, ErrInvalidTrampolineOnion
+	}
+	if onion[0] != trampolineOnionVersion {
+		return nil, fmt.Errorf("unknown trampoline onion version %v",
+			onion[0])
+	}
+
+	ephemeralKey, err := btcec.ParsePubKey(onion[1:34], btcec.S256())
+	if err != nil {
+		return nil, ErrInvalidTrampolineOnion
+	}
+	routingInfo := onion[34 : 34+trampolineRoutingInfoSize]
+	headerHMAC := onion[34+trampolineRoutingInfoSize:]
+
+	// Derive the shared secret with the sender and make sure the onion
+	// wasn't tampered with.
+	secret, err := nodeKey.ECDH(ephemeralKey)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedHMAC := calcMac(
+		generateKey("mu", secret[:]), routingInfo, assocData,
+	)
+	if !hmac.Equal(headerHMAC, expectedHMAC[:]) {
+		return nil, ErrInvalidTrampolineOnionHMAC
+	}
+
+	// Decrypt the routing info. It is padded with zeroes, so that the
+	// routing info of the next hop keeps the same size.
+	hopInfo := make([]byte, 2*trampolineRoutingInfoSize)
+	copy(hopInfo, routingInfo)
+	stream := generateCipherStream(
+		generateKey("rho", secret[:]), 2*trampolineRoutingInfoSize,
+	)
+	xorBytes(hopInfo, hopInfo, stream)
+
+	r := bytes.NewReader(hopInfo)
+	var buf [8]byte
+	payloadSize, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return nil, err
+	}
+	if payloadSize > trampolineRoutingInfoSize {
+		return nil, ErrInvalidTrampolineOnion
+	}
+
+	payload := make([]byte, payloadSize)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, err
+	}
+
+	var nextHMAC [trampolineHMACSize]byte
+	if _, err := io.ReadFull(r, nextHMAC[:]); err != nil {
+		return nil, err
+	}
+
+	peeled := &PeeledTrampolineOnion{
+		Payload: payload,
+	}
+
+	// A zero hmac signals that we are the final trampoline hop.
+	if nextHMAC == zeroTrampolineHMAC {
+		return peeled, nil
+	}
+
+	// Blind the ephemeral key for the next hop, and pass on the remainder
+	// of the routing info.
+	blindingFactor := computeBlindingFactor(ephemeralKey, secret[:])
+	nextKey := &btcec.PublicKey{Curve: btcec.S256()}
+	nextKey.X, nextKey.Y = btcec.S256().ScalarMult(
+		ephemeralKey.X, ephemeralKey.Y, blindingFactor[:],
+	)
+
+	numBytes := len(hopInfo) - r.Len()
+
+	var next bytes.Buffer
+	next.WriteByte(trampolineOnionVersion)
+	next.Write(nextKey.SerializeCompressed())
+	next.Write(hopInfo[numBytes : numBytes+trampolineRoutingInfoSize])
+	next.Write(nextHMAC[:])
+
+	peeled.NextOnion = next.Bytes()
+
+	return peeled, nil
+}
+
+// trampolineSharedSecrets derives the shared secret of the sender with every
+// hop of the trampoline route. The ephemeral key is blinded at every hop, so
+// that the hops can't correlate the onion they receive.
+func trampolineSharedSecrets(nodeKeys []*btcec.PublicKey,
+	sessionKey *btcec.PrivateKey) [][]byte {
+
+	secrets := make([][]byte, len(nodeKeys))
+
+	ephemeralKey := sessionKey.PubKey()
+
+	var cachedBlindingFactor big.Int
+	cachedBlindingFactor.SetBytes(sessionKey.D.Bytes())
+
+	for i, nodeKey := range nodeKeys {
+		// e_i = Y_i ^ (x * b_0 * ... * b_{i-1})
+		dh := &btcec.PublicKey{Curve: btcec.S256()}
+		dh.X, dh.Y = btcec.S256().ScalarMult(
+			nodeKey.X, nodeKey.Y, cachedBlindingFactor.Bytes(),
+		)
+		secret := sha256.Sum256(dh.SerializeCompressed())
+		secrets[i] = secret[:]
+
+		// b_i = sha256(a_i || s_i)
+		blindingFactor := computeBlindingFactor(
+			ephemeralKey, secrets[i],
+		)
+
+		var nextBlindingFactor big.Int
+		nextBlindingFactor.SetBytes(blindingFactor[:])
+		cachedBlindingFactor.Mul(
+			&cachedBlindingFactor, &nextBlindingFactor,
+		)
+		cachedBlindingFactor.Mod(
+			&cachedBlindingFactor, btcec.S256().Params().N,
+		)
+
+		// a_{i+1} = g ^ (x * b_0 * ... * b_i)
+		ephemeralKey = &btcec.PublicKey{Curve: btcec.S256()}
+		ephemeralKey.X, ephemeralKey.Y = btcec.S256().ScalarBaseMult(
+			cachedBlindingFactor.Bytes(),
+		)
+	}
+
+	return secrets
+}
+
+// xorBytes computes the byte wise xor of a and b and stores the result in dst.
+func xorBytes(dst, a, b []byte) {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		dst[i] = a[i] ^ b[i]
+	}
+}
+
+// TrampolinePayload is the payload a trampoline node finds in its layer of a
+// trampoline onion. It instructs the node where to forward the payment to.
+type TrampolinePayload struct {
+	// AmtToForward is the amount the payment is forwarded with.
+	AmtToForward lnwire.MilliSatoshi
+
+	// OutgoingCltv is the expiry height of the htlc the next node should
+	// receive.
+	OutgoingCltv uint32
+
+	// OutgoingNodeID is the node the payment is forwarded to. It is nil if
+	// the trampoline node is the recipient of the payment.
+	OutgoingNodeID *[33]byte
+
+	// MPP is the mpp record to deliver to the recipient, if the next node
+	// is the recipient of the payment and doesn't support trampoline
+	// onions.
+	MPP *record.MPP
+}
+
+// Encode serializes the trampoline payload as a tlv stream.
+func (p *TrampolinePayload) Encode() ([]byte, error) {
+	amt := uint64(p.AmtToForward)
+	cltv := p.OutgoingCltv
+
+	records := []tlv.Record{
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+	}
+	if p.MPP != nil {
+		records = append(records, p.MPP.Record())
+	}
+	if p.OutgoingNodeID != nil {
+		nodeIDRecord := record.NewOutgoingNodeIDRecord(p.OutgoingNodeID)
+		records = append(records, nodeIDRecord)
+	}
+
+	tlvStream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := tlvStream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeTrampolinePayload parses a serialized trampoline payload.
+func DecodeTrampolinePayload(payload []byte) (*TrampolinePayload, error) {
+	var (
+		amt    uint64
+		cltv   uint32
+		nodeID [33]byte
+		mpp    = &record.MPP{}
+	)
+
+	tlvStream, err := tlv.NewStream(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+		mpp.Record(),
+		record.NewOutgoingNodeIDRecord(&nodeID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, hasOutgoingNode := parsedTypes[record.OutgoingNodeIDOnionType]
+	isFinalHop := !hasOutgoingNode
+
+	if _, ok := parsedTypes[record.AmtOnionType]; !ok {
+		return nil, ErrInvalidPayload{
+			Type:      record.AmtOnionType,
+			Violation: OmittedViolation,
+			FinalHop:  isFinalHop,
+		}
+	}
+	if _, ok := parsedTypes[record.LockTimeOnionType]; !ok {
+		return nil, ErrInvalidPayload{
+			Type:      record.LockTimeOnionType,
+			Violation: OmittedViolation,
+			FinalHop:  isFinalHop,
+		}
+	}
+
+	violatingType := getMinRequiredViolation(parsedTypes)
+	if violatingType != nil {
+		return nil, ErrInvalidPayload{
+			Type:      *violatingType,
+			Violation: RequiredViolation,
+			FinalHop:  isFinalHop,
+		}
+	}
+
+	p := &TrampolinePayload{
+		AmtToForward: lnwire.MilliSatoshi(amt),
+		OutgoingCltv: cltv,
+	}
+	if hasOutgoingNode {
+		p.OutgoingNodeID = &nodeID
+	}
+	if _, ok := parsedTypes[record.MPPOnionType]; ok {
+		p.MPP = mpp
+	}
+
+	return p, nil
+}
@@ -0,0 +1,193 @@
+package hop
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/record"
+)
+
+// TestTrampolineOnion asserts that every trampoline node can peel its layer of
+// a trampoline onion, and that the final node learns that it is the last
+// trampoline hop.
+func TestTrampolineOnion(t *testing.T) {
+	t.Parallel()
+
+	const numHops = 3
+
+	var (
+		nodeKeys  []*keychain.PrivKeyECDH
+		hops      []TrampolineHop
+		assocData = bytes.Repeat([]byte{0x01}, 32)
+	)
+	for i := 0; i < numHops; i++ {
+		privKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodeKey := &keychain.PrivKeyECDH{PrivKey: privKey}
+		nodeKeys = append(nodeKeys, nodeKey)
+
+		var nextNode [33]byte
+		copy(nextNode[:], bytes.Repeat([]byte{byte(i)}, 33))
+
+		payload := &TrampolinePayload{
+			AmtToForward:   lnwire.MilliSatoshi(1000 * (numHops - i)),
+			OutgoingCltv:   uint32(100 + i),
+			OutgoingNodeID: &nextNode,
+		}
+		if i == numHops-1 {
+			payload.MPP = record.NewMPP(1000, [32]byte{0x02})
+		}
+
+		b, err := payload.Encode()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		hops = append(hops, TrampolineHop{
+			NodeID:  nodeKey.PubKey(),
+			Payload: b,
+		})
+	}
+
+	sessionKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	onion, err := NewTrampolineOnion(hops, sessionKey, assocData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The onion is bound to the associated data.
+	_, err = PeelTrampolineOnion(onion, nodeKeys[0], nil)
+	if err != ErrInvalidTrampolineOnionHMAC {
+		t.Fatalf("expected hmac error, got %v", err)
+	}
+
+	// Only the node the layer is encrypted for can peel it.
+	_, err = PeelTrampolineOnion(onion, nodeKeys[1], assocData)
+	if err != ErrInvalidTrampolineOnionHMAC {
+		t.Fatalf("expected hmac error, got %v", err)
+	}
+
+	for i := 0; i < numHops; i++ {
+		if len(onion) != TrampolineOnionSize {
+			t.Fatalf("hop %v: unexpected onion size %v", i,
+				len(onion))
+		}
+
+		peeled, err := PeelTrampolineOnion(onion, nodeKeys[i], assocData)
+		if err != nil {
+			t.Fatalf("hop %v: unable to peel onion: %v", i, err)
+		}
+
+		if !bytes.Equal(peeled.Payload, hops[i].Payload) {
+			t.Fatalf("hop %v: payload mismatch", i)
+		}
+
+		isFinal := i == numHops-1
+		if isFinal != (peeled.NextOnion == nil) {
+			t.Fatalf("hop %v: expected final=%v", i, isFinal)
+		}
+
+		onion = peeled.NextOnion
+	}
+}
+
+// TestTrampolineOnionTooLarge asserts that payloads that exceed the routing
+// info of a trampoline onion are rejected.
+func TestTrampolineOnionTooLarge(t *testing.T) {
+	t.Parallel()
+
+	nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hops := []TrampolineHop{{
+		NodeID:  nodeKey.PubKey(),
+		Payload: make([]byte, trampolineRoutingInfoSize),
+	}}
+
+	_, err = NewTrampolineOnion(hops, nodeKey, nil)
+	if err != ErrTrampolineOnionTooLarge {
+		t.Fatalf("expected too large error, got %v", err)
+	}
+}
+
+// TestTrampolinePayload asserts that trampoline payloads are decoded to the
+// payload that was encoded, and that the required records are enforced.
+func TestTrampolinePayload(t *testing.T) {
+	t.Parallel()
+
+	nodeID := [33]byte{0x02, 0x03}
+
+	tests := []struct {
+		name    string
+		payload *TrampolinePayload
+	}{
+		{
+			name: "final hop",
+			payload: &TrampolinePayload{
+				AmtToForward: 1000,
+				OutgoingCltv: 500,
+			},
+		},
+		{
+			name: "outgoing node",
+			payload: &TrampolinePayload{
+				AmtToForward:   1000,
+				OutgoingCltv:   500,
+				OutgoingNodeID: &nodeID,
+			},
+		},
+		{
+			name: "outgoing node with mpp",
+			payload: &TrampolinePayload{
+				AmtToForward:   1000,
+				OutgoingCltv:   500,
+				OutgoingNodeID: &nodeID,
+				MPP:            record.NewMPP(2000, [32]byte{0x01}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			b, err := test.payload.Encode()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			decoded, err := DecodeTrampolinePayload(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(test.payload, decoded) {
+				t.Fatalf("payload mismatch: want %v, got %v",
+					test.payload, decoded)
+			}
+		})
+	}
+
+	// A payload without an expiry is invalid.
+	_, err := DecodeTrampolinePayload([]byte{0x02, 0x00})
+	expErr := ErrInvalidPayload{
+		Type:      record.LockTimeOnionType,
+		Violation: OmittedViolation,
+		FinalHop:  true,
+	}
+	if err != expErr {
+		t.Fatalf("expected %v, got %v", expErr, err)
+	}
+}
@@ -1238,6 +1238,25 @@ func (l *channelLink) processHtlcResolution(resolution invoices.HtlcResolution,
 func getResolutionFailure(resolution *invoices.HtlcFailResolution,
 	amount lnwire.MilliSatoshi) *LinkError {
 
+	// If the htlc acceptor chose the failure, we'll fail the htlc with
+	// it.
+	switch resolution.FailureCode {
+	case lnwire.CodeTemporaryNodeFailure:
+		return NewDetailedLinkError(
+			&lnwire.FailTemporaryNodeFailure{}, resolution.Outcome,
+		)
+
+	case lnwire.CodePermanentNodeFailure:
+		return NewDetailedLinkError(
+			&lnwire.FailPermanentNodeFailure{}, resolution.Outcome,
+		)
+
+	case lnwire.CodeMPPTimeout:
+		return NewDetailedLinkError(
+			&lnwire.FailMPPTimeout{}, resolution.Outcome,
+		)
+	}
+
 	// If the resolution has been resolved as part of a MPP timeout,
 	// we need to fail the htlc with lnwire.FailMppTimeout.
 	if resolution.Outcome == invoices.ResultMppTimeout {
@@ -0,0 +1,195 @@
+package invoices
+
+import (
+	"time"
+
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/queue"
+)
+
+// heldInvoice describes an accepted hold invoice that is canceled
+// automatically when it is held for too long, or when one of its htlcs comes
+// too close to its expiry height.
+type heldInvoice struct {
+	// hash is the payment hash of the invoice.
+	hash lntypes.Hash
+
+	// cancelTime is the time at which the hold duration of the invoice
+	// ends. It is zero if the hold duration of the invoice isn't limited.
+	cancelTime time.Time
+
+	// expiry is the lowest expiry height of the accepted htlcs of the
+	// invoice.
+	expiry uint32
+}
+
+// Less is used to order PriorityQueueItem's by their cancel time such that
+// items with the older cancel time are at the top of the queue.
+//
+// NOTE: Part of the queue.PriorityQueueItem interface.
+func (h *heldInvoice) Less(other queue.PriorityQueueItem) bool {
+	return h.cancelTime.Before(other.(*heldInvoice).cancelTime)
+}
+
+// heldInvoiceSet tracks the accepted hold invoices the hold policy of the
+// registry applies to. It is only accessed from the invoice event loop.
+type heldInvoiceSet struct {
+	// invoices holds the latest description of every tracked invoice.
+	invoices map[lntypes.Hash]*heldInvoice
+
+	// timeouts orders the tracked invoices with a limited hold duration by
+	// their cancel time. It may contain stale entries of invoices that
+	// are no longer tracked, or that were tracked again since.
+	timeouts queue.PriorityQueue
+}
+
+// newHeldInvoiceSet creates an empty set of held invoices.
+func newHeldInvoiceSet() *heldInvoiceSet {
+	return &heldInvoiceSet{
+		invoices: make(map[lntypes.Hash]*heldInvoice),
+	}
+}
+
+// add starts tracking a held invoice, replacing any earlier description of
+// the same invoice.
+func (h *heldInvoiceSet) add(held *heldInvoice) {
+	h.invoices[held.hash] = held
+
+	if !held.cancelTime.IsZero() {
+		h.timeouts.Push(held)
+	}
+}
+
+// remove stops tracking the invoice with the given hash.
+func (h *heldInvoiceSet) remove(hash lntypes.Hash) {
+	delete(h.invoices, hash)
+}
+
+// nextTimeout returns the earliest cancel time of the tracked invoices. The
+// boolean is false if none of them has a limited hold duration.
+func (h *heldInvoiceSet) nextTimeout() (time.Time, bool) {
+	for h.timeouts.Len() > 0 {
+		top := h.timeouts.Top().(*heldInvoice)
+		if h.invoices[top.hash] == top {
+			return top.cancelTime, true
+		}
+
+		// Drop stale entries as we come across them.
+		h.timeouts.Pop()
+	}
+
+	return time.Time{}, false
+}
+
+// popTimeout stops tracking the invoice with the earliest cancel time and
+// returns its hash. It must only be called after nextTimeout reported a
+// timeout.
+func (h *heldInvoiceSet) popTimeout() lntypes.Hash {
+	held := h.timeouts.Pop().(*heldInvoice)
+	delete(h.invoices, held.hash)
+
+	return held.hash
+}
+
+// popNearExpiry stops tracking the invoices that have an htlc expiring within
+// delta blocks of the given height, and returns their hashes.
+func (h *heldInvoiceSet) popNearExpiry(height,
+	delta uint32) []lntypes.Hash {
+
+	var hashes []lntypes.Hash
+	for hash, held := range h.invoices {
+		if held.expiry == 0 || height+delta < held.expiry {
+			continue
+		}
+
+		delete(h.invoices, hash)
+		hashes = append(hashes, hash)
+	}
+
+	return hashes
+}
+
+// holdDuration returns the maximum time the given invoice may be held in the
+// accepted state. It is the lower of the limits of the invoice and the
+// registry, or zero if neither limits the hold duration.
+func (i *InvoiceRegistry) holdDuration(invoice *channeldb.Invoice) time.Duration {
+	holdDuration := invoice.Terms.HoldDuration
+	if holdDuration == 0 || (i.cfg.MaxHoldDuration != 0 &&
+		i.cfg.MaxHoldDuration < holdDuration) {
+
+		holdDuration = i.cfg.MaxHoldDuration
+	}
+
+	return holdDuration
+}
+
+// newHeldInvoice describes an accepted hold invoice for the hold policy. The
+// hold duration starts when the last htlc that completed the payment was
+// accepted.
+func (i *InvoiceRegistry) newHeldInvoice(hash lntypes.Hash,
+	invoice *channeldb.Invoice) *heldInvoice {
+
+	var (
+		acceptTime time.Time
+		expiry     uint32
+	)
+	for _, htlc := range invoice.Htlcs {
+		if htlc.State != channeldb.HtlcStateAccepted {
+			continue
+		}
+
+		if htlc.AcceptTime.After(acceptTime) {
+			acceptTime = htlc.AcceptTime
+		}
+		if expiry == 0 || htlc.Expiry < expiry {
+			expiry = htlc.Expiry
+		}
+	}
+
+	held := &heldInvoice{
+		hash:   hash,
+		expiry: expiry,
+	}
+	if holdDuration := i.holdDuration(invoice); holdDuration != 0 {
+		held.cancelTime = acceptTime.Add(holdDuration)
+	}
+
+	return held
+}
+
+// trackHeldInvoice hands an accepted hold invoice to the event loop, which
+// enforces the hold policy on it.
+func (i *InvoiceRegistry) trackHeldInvoice(held *heldInvoice) error {
+	select {
+	case i.heldInvoiceChan <- held:
+		return nil
+
+	case <-i.quit:
+		return ErrShuttingDown
+	}
+}
+
+// cancelHeldInvoice cancels an accepted hold invoice that violates the hold
+// policy. It is executed in its own goroutine, because canceling the invoice
+// notifies the event loop.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (i *InvoiceRegistry) cancelHeldInvoice(hash lntypes.Hash,
+	reason channeldb.CancelReason) {
+
+	defer i.wg.Done()
+
+	log.Infof("Invoice(%v): canceling hold invoice, reason: %v", hash,
+		reason)
+
+	err := i.cancelInvoiceImpl(hash, true, reason)
+	switch {
+	// The invoice may have been settled in the mean time.
+	case err == channeldb.ErrInvoiceAlreadySettled:
+		log.Debugf("Invoice(%v): already settled", hash)
+
+	case err != nil:
+		log.Errorf("Unable to cancel hold invoice %v: %v", hash, err)
+	}
+}
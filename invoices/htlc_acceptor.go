@@ -0,0 +1,491 @@
+package invoices
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/record"
+)
+
+const (
+	// DefaultHtlcAcceptorTimeout is the default time the htlc acceptor is
+	// given to decide on an htlc, before the htlc is processed as if there
+	// were no acceptor.
+	DefaultHtlcAcceptorTimeout = 10 * time.Second
+)
+
+var (
+	// ErrHtlcAcceptorActive is returned when an htlc acceptor is
+	// registered while another one is still active.
+	ErrHtlcAcceptorActive = errors.New("htlc acceptor already registered")
+
+	// ErrHtlcNotPending is returned when a decision is made for an htlc
+	// that isn't waiting for the htlc acceptor.
+	ErrHtlcNotPending = errors.New("htlc not pending at acceptor")
+
+	// ErrHtlcAcceptorPreimage is returned when the htlc acceptor settles an
+	// htlc with a preimage that doesn't match its payment hash.
+	ErrHtlcAcceptorPreimage = errors.New("preimage doesn't match htlc " +
+		"payment hash")
+
+	// ErrHtlcAcceptorCanceled is returned by an htlc acceptor when the
+	// hand-off of an htlc is canceled before the acceptor took it.
+	ErrHtlcAcceptorCanceled = errors.New("htlc acceptor hand-off canceled")
+)
+
+// HtlcAcceptAction is the action the htlc acceptor takes on an htlc.
+type HtlcAcceptAction uint8
+
+const (
+	// HtlcAcceptResume processes the htlc as if there were no acceptor.
+	HtlcAcceptResume HtlcAcceptAction = iota
+
+	// HtlcAcceptSettle settles the htlc with the preimage supplied by the
+	// acceptor. The htlc isn't recorded on any invoice.
+	HtlcAcceptSettle
+
+	// HtlcAcceptFail fails the htlc with the failure code chosen by the
+	// acceptor.
+	HtlcAcceptFail
+
+	// HtlcAcceptHold holds the htlc until the acceptor makes another
+	// decision for it. If a hold expiry delta is configured, the htlc is
+	// failed once it gets that close to its expiry.
+	HtlcAcceptHold
+)
+
+// String returns a human readable representation of the action.
+func (a HtlcAcceptAction) String() string {
+	switch a {
+	case HtlcAcceptResume:
+		return "resume"
+
+	case HtlcAcceptSettle:
+		return "settle"
+
+	case HtlcAcceptFail:
+		return "fail"
+
+	case HtlcAcceptHold:
+		return "hold"
+
+	default:
+		return "unknown"
+	}
+}
+
+// HtlcAcceptRequest describes an htlc that pays to our node and is passed to
+// the htlc acceptor.
+type HtlcAcceptRequest struct {
+	// Hash is the payment hash of the htlc.
+	Hash lntypes.Hash
+
+	// CircuitKey identifies the htlc.
+	CircuitKey channeldb.CircuitKey
+
+	// Amount is the amount of the htlc.
+	Amount lnwire.MilliSatoshi
+
+	// Expiry is the absolute expiry height of the htlc.
+	Expiry uint32
+
+	// CurrentHeight is the block height at which the htlc was received.
+	CurrentHeight int32
+
+	// CustomRecords are the custom tlv records of the final hop payload.
+	CustomRecords record.CustomSet
+
+	// MPP is the mpp record of the final hop payload, if any.
+	MPP *record.MPP
+
+	// AMP is the amp record of the final hop payload, if any.
+	AMP *record.AMP
+}
+
+// HtlcAcceptResponse is the decision of the htlc acceptor for an htlc.
+type HtlcAcceptResponse struct {
+	// Action is the action to take on the htlc.
+	Action HtlcAcceptAction
+
+	// Preimage is the preimage to settle the htlc with, if the action is
+	// HtlcAcceptSettle.
+	Preimage lntypes.Preimage
+
+	// FailureCode is the failure to fail the htlc with, if the action is
+	// HtlcAcceptFail.
+	FailureCode lnwire.FailCode
+}
+
+// HtlcAcceptor is consulted for every htlc that pays to our node, including
+// keysend htlcs and htlcs for unknown payment hashes. The acceptor makes its
+// decision by calling ResolveHtlc on the registry.
+type HtlcAcceptor interface {
+	// AcceptHtlc passes an htlc to the acceptor. If the acceptor can't
+	// take the htlc before the cancel channel is closed, it must return
+	// ErrHtlcAcceptorCanceled.
+	AcceptHtlc(req *HtlcAcceptRequest, cancel <-chan struct{}) error
+}
+
+// acceptorHtlc is an htlc that waits for the decision of the htlc acceptor,
+// or is held on its request.
+type acceptorHtlc struct {
+	// ctx is the update context of the htlc, which is used to process the
+	// htlc if the acceptor resumes it.
+	ctx invoiceUpdateCtx
+
+	// hodlChan is the channel the resolution of the htlc is delivered
+	// on.
+	hodlChan chan<- interface{}
+
+	// held indicates that the acceptor decided to hold the htlc. Held
+	// htlcs aren't processed when the acceptor times out.
+	held bool
+
+	// decided is closed once the acceptor made its first decision for the
+	// htlc, or the htlc was resolved otherwise.
+	decided chan struct{}
+}
+
+// RegisterHtlcAcceptor registers the acceptor that is consulted for every htlc
+// that pays to our node. Only a single acceptor can be registered at a time.
+func (i *InvoiceRegistry) RegisterHtlcAcceptor(acceptor HtlcAcceptor) error {
+	i.acceptorMtx.Lock()
+	defer i.acceptorMtx.Unlock()
+
+	if i.htlcAcceptor != nil {
+		return ErrHtlcAcceptorActive
+	}
+	i.htlcAcceptor = acceptor
+
+	return nil
+}
+
+// DeregisterHtlcAcceptor removes the htlc acceptor. The htlcs that wait for a
+// decision or are held by the acceptor are processed as if there were no
+// acceptor.
+func (i *InvoiceRegistry) DeregisterHtlcAcceptor(acceptor HtlcAcceptor) {
+	i.acceptorMtx.Lock()
+	if i.htlcAcceptor != acceptor {
+		i.acceptorMtx.Unlock()
+		return
+	}
+	i.htlcAcceptor = nil
+
+	htlcs := i.acceptorHtlcs
+	i.acceptorHtlcs = make(map[channeldb.CircuitKey]*acceptorHtlc)
+	for _, htlc := range htlcs {
+		if !htlc.held {
+			close(htlc.decided)
+		}
+	}
+	i.acceptorMtx.Unlock()
+
+	resume := &HtlcAcceptResponse{Action: HtlcAcceptResume}
+	for key, htlc := range htlcs {
+		if err := i.resolveAcceptorHtlc(htlc, resume); err != nil {
+			log.Errorf("Unable to resume htlc %v: %v", key, err)
+		}
+	}
+}
+
+// ResolveHtlc applies the decision of the htlc acceptor to an htlc that waits
+// for it or is held by the acceptor. The resolution of the htlc is delivered
+// to its hodl subscribers.
+func (i *InvoiceRegistry) ResolveHtlc(key channeldb.CircuitKey,
+	resp *HtlcAcceptResponse) error {
+
+	i.acceptorMtx.Lock()
+	htlc, ok := i.acceptorHtlcs[key]
+	if !ok {
+		i.acceptorMtx.Unlock()
+		return ErrHtlcNotPending
+	}
+
+	// Settling with a wrong preimage would leave the htlc unresolved, so
+	// we'll reject such decisions.
+	if resp.Action == HtlcAcceptSettle &&
+		resp.Preimage.Hash() != htlc.ctx.htlcHash {
+
+		i.acceptorMtx.Unlock()
+		return ErrHtlcAcceptorPreimage
+	}
+
+	// A held htlc is no longer subject to the acceptor timeout. Holding an
+	// htlc that is already held is a no-op. An htlc that is already too
+	// close to its expiry can't be held though, and is failed instead.
+	if resp.Action == HtlcAcceptHold {
+		if !htlc.held {
+			htlc.held = true
+			close(htlc.decided)
+		}
+
+		expired := i.acceptorHoldExpired(htlc)
+		if expired {
+			delete(i.acceptorHtlcs, key)
+		}
+		i.acceptorMtx.Unlock()
+
+		if expired {
+			i.expireAcceptorHtlc(htlc)
+		}
+
+		return nil
+	}
+
+	delete(i.acceptorHtlcs, key)
+	if !htlc.held {
+		close(htlc.decided)
+	}
+	i.acceptorMtx.Unlock()
+
+	return i.resolveAcceptorHtlc(htlc, resp)
+}
+
+// resolveAcceptorHtlc applies the decision of the htlc acceptor to an htlc
+// that waited for it or was held by it, and notifies the subscribers of the
+// htlc of its resolution.
+func (i *InvoiceRegistry) resolveAcceptorHtlc(htlc *acceptorHtlc,
+	resp *HtlcAcceptResponse) error {
+
+	ctx := htlc.ctx
+
+	var resolution HtlcResolution
+	switch resp.Action {
+	case HtlcAcceptResume:
+		var err error
+		resolution, err = i.notifyExitHopHtlcDefault(ctx, htlc.hodlChan)
+		if err != nil {
+			return err
+		}
+
+		// If the htlc was accepted, its subscribers will be notified
+		// once it is resolved.
+		if resolution == nil {
+			return nil
+		}
+
+	default:
+		resolution = acceptorResolution(&ctx, resp)
+	}
+
+	ctx.log(fmt.Sprintf("htlc acceptor decision: %v", resp.Action))
+
+	i.Lock()
+	i.notifyHodlSubscribers(resolution)
+	i.Unlock()
+
+	return nil
+}
+
+// acceptorHoldExpired returns true if an htlc held by the htlc acceptor is
+// within the hold expiry delta of its expiry, and thus can't be held any
+// longer.
+//
+// NOTE: The acceptorMtx MUST be held when calling this method.
+func (i *InvoiceRegistry) acceptorHoldExpired(htlc *acceptorHtlc) bool {
+	if i.cfg.HoldExpiryDelta == 0 || i.acceptorHeight == 0 {
+		return false
+	}
+
+	return i.acceptorHeight+i.cfg.HoldExpiryDelta >= htlc.ctx.expiry
+}
+
+// expireAcceptorHtlcs records the height of the chain tip, and fails the
+// htlcs held by the htlc acceptor that are now within the hold expiry delta of
+// their expiry. Like the htlcs of hold invoices, they are canceled back before
+// their channel would be force closed.
+func (i *InvoiceRegistry) expireAcceptorHtlcs(height uint32) {
+	i.acceptorMtx.Lock()
+	i.acceptorHeight = height
+
+	var expired []*acceptorHtlc
+	for key, htlc := range i.acceptorHtlcs {
+		if !htlc.held || !i.acceptorHoldExpired(htlc) {
+			continue
+		}
+
+		delete(i.acceptorHtlcs, key)
+		expired = append(expired, htlc)
+	}
+	i.acceptorMtx.Unlock()
+
+	for _, htlc := range expired {
+		i.expireAcceptorHtlc(htlc)
+	}
+}
+
+// expireAcceptorHtlc fails an htlc held by the htlc acceptor that got too close
+// to its expiry, and notifies the subscribers of the htlc of its resolution.
+func (i *InvoiceRegistry) expireAcceptorHtlc(htlc *acceptorHtlc) {
+	ctx := &htlc.ctx
+	ctx.log("htlc acceptor hold too close to expiry")
+
+	resolution := NewFailResolution(
+		ctx.circuitKey, ctx.currentHeight, ResultHoldExpiry,
+	)
+
+	i.Lock()
+	i.notifyHodlSubscribers(resolution)
+	i.Unlock()
+}
+
+// consultHtlcAcceptor passes an htlc to the htlc acceptor, if one is
+// registered. The returned boolean indicates whether the acceptor handles the
+// htlc. In that case, the htlc is held until the acceptor decides on it or
+// times out, and its resolution is delivered on the hodl channel.
+func (i *InvoiceRegistry) consultHtlcAcceptor(ctx *invoiceUpdateCtx,
+	hodlChan chan<- interface{}) bool {
+
+	key := ctx.circuitKey
+
+	i.acceptorMtx.Lock()
+	defer i.acceptorMtx.Unlock()
+
+	acceptor := i.htlcAcceptor
+	if acceptor == nil {
+		return false
+	}
+
+	// If the htlc is replayed while the acceptor handles it, we'll only
+	// deliver its resolution to the new subscriber as well.
+	if htlc, ok := i.acceptorHtlcs[key]; ok {
+		htlc.hodlChan = hodlChan
+
+		i.Lock()
+		i.hodlSubscribe(hodlChan, key)
+		i.Unlock()
+
+		return true
+	}
+
+	htlc := &acceptorHtlc{
+		ctx:      *ctx,
+		hodlChan: hodlChan,
+		decided:  make(chan struct{}),
+	}
+	i.acceptorHtlcs[key] = htlc
+
+	i.Lock()
+	i.hodlSubscribe(hodlChan, key)
+	i.Unlock()
+
+	i.wg.Add(1)
+	go i.awaitHtlcAcceptor(acceptor, htlc)
+
+	return true
+}
+
+// awaitHtlcAcceptor passes an htlc to the htlc acceptor, and processes the
+// htlc as if there were no acceptor if the acceptor fails or doesn't decide
+// on the htlc in time.
+//
+// NOTE: This MUST be run as a goroutine.
+func (i *InvoiceRegistry) awaitHtlcAcceptor(acceptor HtlcAcceptor,
+	htlc *acceptorHtlc) {
+
+	defer i.wg.Done()
+
+	ctx := &htlc.ctx
+	if !i.waitForAcceptor(acceptor, htlc) {
+		return
+	}
+
+	// Only resume the htlc if the acceptor didn't decide on it in the
+	// meantime.
+	i.acceptorMtx.Lock()
+	if i.acceptorHtlcs[ctx.circuitKey] != htlc || htlc.held {
+		i.acceptorMtx.Unlock()
+		return
+	}
+	delete(i.acceptorHtlcs, ctx.circuitKey)
+	close(htlc.decided)
+	i.acceptorMtx.Unlock()
+
+	resume := &HtlcAcceptResponse{Action: HtlcAcceptResume}
+	if err := i.resolveAcceptorHtlc(htlc, resume); err != nil {
+		ctx.log(fmt.Sprintf("unable to resume htlc: %v", err))
+	}
+}
+
+// waitForAcceptor hands an htlc to the htlc acceptor, and waits for the first
+// decision of the acceptor. The acceptor timeout starts before the hand-off,
+// so that it also covers an acceptor that doesn't take the htlc in time. It
+// returns true if the htlc should be processed as if there were no acceptor,
+// because the acceptor failed or timed out.
+func (i *InvoiceRegistry) waitForAcceptor(acceptor HtlcAcceptor,
+	htlc *acceptorHtlc) bool {
+
+	ctx := &htlc.ctx
+	timeout := i.cfg.Clock.TickAfter(i.cfg.HtlcAcceptorTimeout)
+
+	// The hand-off runs in the background, and is canceled once we stop
+	// waiting for the acceptor.
+	cancel := make(chan struct{})
+	defer close(cancel)
+
+	errChan := make(chan error, 1)
+	i.wg.Add(1)
+	go func() {
+		defer i.wg.Done()
+
+		errChan <- acceptor.AcceptHtlc(&HtlcAcceptRequest{
+			Hash:          ctx.htlcHash,
+			CircuitKey:    ctx.circuitKey,
+			Amount:        ctx.amtPaid,
+			Expiry:        ctx.expiry,
+			CurrentHeight: ctx.currentHeight,
+			CustomRecords: ctx.customRecords,
+			MPP:           ctx.mpp,
+			AMP:           ctx.amp,
+		}, cancel)
+	}()
+
+	for {
+		select {
+		case err := <-errChan:
+			if err != nil {
+				ctx.log(fmt.Sprintf("htlc acceptor error: %v",
+					err))
+				return true
+			}
+
+			// The acceptor took the htlc, so we'll wait for its
+			// decision.
+			errChan = nil
+
+		case <-htlc.decided:
+			return false
+
+		case <-timeout:
+			ctx.log("htlc acceptor timed out")
+			return true
+
+		case <-i.quit:
+			return false
+		}
+	}
+}
+
+// acceptorResolution returns the resolution of an htlc that the htlc acceptor
+// settled or failed.
+func acceptorResolution(ctx *invoiceUpdateCtx,
+	resp *HtlcAcceptResponse) HtlcResolution {
+
+	if resp.Action == HtlcAcceptSettle {
+		return NewSettleResolution(
+			resp.Preimage, ctx.circuitKey, ctx.currentHeight,
+			ResultSettledByAcceptor,
+		)
+	}
+
+	resolution := NewFailResolution(
+		ctx.circuitKey, ctx.currentHeight, ResultRejectedByAcceptor,
+	)
+	resolution.FailureCode = resp.FailureCode
+
+	return resolution
+}
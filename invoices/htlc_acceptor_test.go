@@ -0,0 +1,393 @@
+package invoices
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Actinium-project/lnd/chainntnfs"
+	"github.com/Actinium-project/lnd/clock"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/record"
+)
+
+// mockHtlcAcceptor passes the htlcs it receives on to the test.
+type mockHtlcAcceptor struct {
+	requests chan *HtlcAcceptRequest
+}
+
+func (a *mockHtlcAcceptor) AcceptHtlc(req *HtlcAcceptRequest,
+	cancel <-chan struct{}) error {
+
+	select {
+	case a.requests <- req:
+		return nil
+
+	case <-cancel:
+		return ErrHtlcAcceptorCanceled
+	}
+}
+
+// stalledHtlcAcceptor signals every htlc it's passed, but never takes it.
+type stalledHtlcAcceptor struct {
+	calls chan struct{}
+}
+
+func (a *stalledHtlcAcceptor) AcceptHtlc(req *HtlcAcceptRequest,
+	cancel <-chan struct{}) error {
+
+	a.calls <- struct{}{}
+	<-cancel
+
+	return ErrHtlcAcceptorCanceled
+}
+
+// TestHtlcAcceptor tests that the htlc acceptor is consulted for htlcs to
+// unknown payment hashes, and that its decisions are applied.
+func TestHtlcAcceptor(t *testing.T) {
+	defer timeout()()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	acceptorTimeout := time.Minute
+	ctx.registry.cfg.HtlcAcceptorTimeout = acceptorTimeout
+
+	acceptor := &mockHtlcAcceptor{
+		requests: make(chan *HtlcAcceptRequest, 1),
+	}
+	if err := ctx.registry.RegisterHtlcAcceptor(acceptor); err != nil {
+		t.Fatalf("unable to register acceptor: %v", err)
+	}
+
+	// Only a single acceptor can be registered.
+	err := ctx.registry.RegisterHtlcAcceptor(&mockHtlcAcceptor{})
+	if err != ErrHtlcAcceptorActive {
+		t.Fatalf("expected ErrHtlcAcceptorActive, got %v", err)
+	}
+
+	hodlChan := make(chan interface{}, 1)
+	payload := &mockPayload{
+		customRecords: record.CustomSet{
+			record.CustomTypeStart: []byte{1, 2, 3},
+		},
+	}
+
+	// notify notifies the registry of an htlc, and waits until it is
+	// passed to the acceptor. The htlc is held while the acceptor decides
+	// on it, so it must not be resolved directly.
+	notify := func(htlcID uint64) {
+		resolution, err := ctx.registry.NotifyExitHopHtlc(
+			testInvoicePaymentHash, testInvoiceAmount,
+			testHtlcExpiry, testCurrentHeight,
+			getCircuitKey(htlcID), hodlChan, payload,
+		)
+		if err != nil {
+			t.Fatalf("unable to notify htlc: %v", err)
+		}
+		if resolution != nil {
+			t.Fatalf("expected htlc to be held, got: %v",
+				resolution)
+		}
+
+		req := <-acceptor.requests
+		if req.CircuitKey != getCircuitKey(htlcID) {
+			t.Fatalf("unexpected htlc %v", req.CircuitKey)
+		}
+		if len(req.CustomRecords) != 1 {
+			t.Fatalf("expected custom records to be passed")
+		}
+	}
+
+	resolve := func(htlcID uint64, resp *HtlcAcceptResponse) {
+		err := ctx.registry.ResolveHtlc(getCircuitKey(htlcID), resp)
+		if err != nil {
+			t.Fatalf("unable to resolve htlc: %v", err)
+		}
+	}
+
+	// nextResolution returns the next resolution that is delivered on the
+	// hodl channel, and checks that it is for the expected htlc.
+	nextResolution := func(htlcID uint64) HtlcResolution {
+		select {
+		case resolution := <-hodlChan:
+			htlcResolution := resolution.(HtlcResolution)
+			if htlcResolution.CircuitKey() != getCircuitKey(htlcID) {
+				t.Fatalf("unexpected resolution for %v",
+					htlcResolution.CircuitKey())
+			}
+
+			return htlcResolution
+
+		case <-time.After(testTimeout):
+			t.Fatalf("no resolution received")
+		}
+
+		return nil
+	}
+
+	// Settling with a preimage that doesn't match the payment hash is
+	// rejected. A valid preimage settles the htlc, even though there's no
+	// invoice for it.
+	notify(0)
+	err = ctx.registry.ResolveHtlc(getCircuitKey(0), &HtlcAcceptResponse{
+		Action:   HtlcAcceptSettle,
+		Preimage: lntypes.Preimage{2},
+	})
+	if err != ErrHtlcAcceptorPreimage {
+		t.Fatalf("expected ErrHtlcAcceptorPreimage, got %v", err)
+	}
+	resolve(0, &HtlcAcceptResponse{
+		Action:   HtlcAcceptSettle,
+		Preimage: testInvoicePreimage,
+	})
+
+	settleResolution, ok := nextResolution(0).(*HtlcSettleResolution)
+	if !ok {
+		t.Fatalf("expected settle resolution")
+	}
+	if settleResolution.Outcome != ResultSettledByAcceptor ||
+		settleResolution.Preimage != testInvoicePreimage {
+
+		t.Fatalf("unexpected settle resolution %v", settleResolution)
+	}
+
+	// Failing the htlc returns the chosen failure code.
+	notify(1)
+	resolve(1, &HtlcAcceptResponse{
+		Action:      HtlcAcceptFail,
+		FailureCode: lnwire.CodeTemporaryNodeFailure,
+	})
+
+	failResolution, ok := nextResolution(1).(*HtlcFailResolution)
+	if !ok {
+		t.Fatalf("expected fail resolution")
+	}
+	if failResolution.Outcome != ResultRejectedByAcceptor ||
+		failResolution.FailureCode != lnwire.CodeTemporaryNodeFailure {
+
+		t.Fatalf("unexpected fail resolution %v", failResolution)
+	}
+
+	// If the acceptor doesn't decide in time, the htlc is processed as
+	// usual and fails because there's no invoice for it.
+	notify(2)
+	ctx.clock.SetTime(testTime.Add(acceptorTimeout))
+
+	failResolution, ok = nextResolution(2).(*HtlcFailResolution)
+	if !ok {
+		t.Fatalf("expected fail resolution")
+	}
+	if failResolution.Outcome != ResultInvoiceNotFound {
+		t.Fatalf("expected ResultInvoiceNotFound, got: %v",
+			failResolution.Outcome)
+	}
+
+	// A late decision is rejected.
+	err = ctx.registry.ResolveHtlc(getCircuitKey(2), &HtlcAcceptResponse{
+		Action: HtlcAcceptResume,
+	})
+	if err != ErrHtlcNotPending {
+		t.Fatalf("expected ErrHtlcNotPending, got %v", err)
+	}
+
+	// Hold two htlcs. They aren't resolved when the acceptor timeout
+	// passes, but only once the acceptor decides on them.
+	for _, htlcID := range []uint64{3, 4} {
+		notify(htlcID)
+		resolve(htlcID, &HtlcAcceptResponse{Action: HtlcAcceptHold})
+	}
+	ctx.clock.SetTime(testTime.Add(2 * acceptorTimeout))
+
+	select {
+	case resolution := <-hodlChan:
+		t.Fatalf("unexpected resolution for held htlc: %v",
+			resolution)
+
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	resolve(3, &HtlcAcceptResponse{
+		Action:   HtlcAcceptSettle,
+		Preimage: testInvoicePreimage,
+	})
+	if _, ok := nextResolution(3).(*HtlcSettleResolution); !ok {
+		t.Fatalf("expected settle resolution")
+	}
+
+	// Once the acceptor is deregistered, the remaining held htlc is
+	// processed as usual.
+	ctx.registry.DeregisterHtlcAcceptor(acceptor)
+
+	failResolution, ok = nextResolution(4).(*HtlcFailResolution)
+	if !ok {
+		t.Fatalf("expected fail resolution")
+	}
+	if failResolution.Outcome != ResultInvoiceNotFound {
+		t.Fatalf("unexpected fail resolution %v", failResolution)
+	}
+}
+
+// TestHtlcAcceptorStalled tests that the acceptor timeout also applies to an
+// acceptor that doesn't take the htlc from the registry.
+func TestHtlcAcceptorStalled(t *testing.T) {
+	defer timeout()()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	acceptorTimeout := time.Minute
+	ctx.registry.cfg.HtlcAcceptorTimeout = acceptorTimeout
+
+	acceptor := &stalledHtlcAcceptor{
+		calls: make(chan struct{}, 1),
+	}
+	if err := ctx.registry.RegisterHtlcAcceptor(acceptor); err != nil {
+		t.Fatalf("unable to register acceptor: %v", err)
+	}
+
+	hodlChan := make(chan interface{}, 1)
+	resolution, err := ctx.registry.NotifyExitHopHtlc(
+		testInvoicePaymentHash, testInvoiceAmount, testHtlcExpiry,
+		testCurrentHeight, getCircuitKey(0), hodlChan, testPayload,
+	)
+	if err != nil {
+		t.Fatalf("unable to notify htlc: %v", err)
+	}
+	if resolution != nil {
+		t.Fatalf("expected htlc to be held, got: %v", resolution)
+	}
+
+	// Once the acceptor was called, the timeout is running. When it
+	// passes, the htlc is processed as usual, and fails because there's
+	// no invoice for it.
+	<-acceptor.calls
+	ctx.clock.SetTime(testTime.Add(acceptorTimeout))
+
+	select {
+	case resolution := <-hodlChan:
+		failResolution, ok := resolution.(*HtlcFailResolution)
+		if !ok || failResolution.Outcome != ResultInvoiceNotFound {
+			t.Fatalf("expected ResultInvoiceNotFound, got: %v",
+				resolution)
+		}
+
+	case <-time.After(testTimeout):
+		t.Fatalf("no resolution received")
+	}
+}
+
+// TestHtlcAcceptorHoldExpiry tests that the htlcs held by the acceptor are
+// failed once they come within the hold expiry delta of their expiry.
+func TestHtlcAcceptorHoldExpiry(t *testing.T) {
+	defer timeout()()
+
+	const (
+		htlcExpiry      = 100
+		holdExpiryDelta = 10
+	)
+
+	testClock := clock.NewTestClock(testTime)
+	cdb, cleanup, err := newTestChannelDB(testClock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	epochs := make(chan *chainntnfs.BlockEpoch, 1)
+	cfg := RegistryConfig{
+		FinalCltvRejectDelta: testFinalCltvRejectDelta,
+		Clock:                testClock,
+		HtlcAcceptorTimeout:  time.Minute,
+		HoldExpiryDelta:      holdExpiryDelta,
+		EpochRegistrar: &mockEpochRegistrar{
+			epochs: epochs,
+		},
+	}
+	registry := NewRegistry(
+		cdb, NewInvoiceExpiryWatcher(testClock), &cfg,
+	)
+	if err := registry.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer registry.Stop()
+
+	acceptor := &mockHtlcAcceptor{
+		requests: make(chan *HtlcAcceptRequest, 1),
+	}
+	if err := registry.RegisterHtlcAcceptor(acceptor); err != nil {
+		t.Fatalf("unable to register acceptor: %v", err)
+	}
+
+	hodlChan := make(chan interface{}, 1)
+
+	// hold notifies the registry of an htlc, and holds it once it's
+	// passed to the acceptor.
+	hold := func(htlcID uint64) {
+		resolution, err := registry.NotifyExitHopHtlc(
+			testInvoicePaymentHash, testInvoiceAmount, htlcExpiry,
+			testCurrentHeight, getCircuitKey(htlcID), hodlChan,
+			testPayload,
+		)
+		if err != nil {
+			t.Fatalf("unable to notify htlc: %v", err)
+		}
+		if resolution != nil {
+			t.Fatalf("expected htlc to be held, got: %v",
+				resolution)
+		}
+
+		<-acceptor.requests
+		err = registry.ResolveHtlc(
+			getCircuitKey(htlcID),
+			&HtlcAcceptResponse{Action: HtlcAcceptHold},
+		)
+		if err != nil {
+			t.Fatalf("unable to hold htlc: %v", err)
+		}
+	}
+
+	// assertExpired asserts that the htlc is failed because of its
+	// expiry.
+	assertExpired := func(htlcID uint64) {
+		select {
+		case resolution := <-hodlChan:
+			failResolution, ok := resolution.(*HtlcFailResolution)
+			if !ok || failResolution.Outcome != ResultHoldExpiry ||
+				failResolution.CircuitKey() !=
+					getCircuitKey(htlcID) {
+
+				t.Fatalf("expected ResultHoldExpiry for htlc "+
+					"%v, got: %v", htlcID, resolution)
+			}
+
+		case <-time.After(testTimeout):
+			t.Fatalf("no resolution received")
+		}
+	}
+
+	// The htlc stays held while the chain tip is more than the hold
+	// expiry delta away from its expiry.
+	hold(0)
+	epochs <- &chainntnfs.BlockEpoch{
+		Height: htlcExpiry - holdExpiryDelta - 1,
+	}
+
+	select {
+	case resolution := <-hodlChan:
+		t.Fatalf("unexpected resolution for held htlc: %v",
+			resolution)
+
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once the chain tip is within the delta, the htlc is failed.
+	epochs <- &chainntnfs.BlockEpoch{
+		Height: htlcExpiry - holdExpiryDelta,
+	}
+	assertExpired(0)
+
+	// An htlc that is already too close to its expiry isn't held at all.
+	hold(1)
+	assertExpired(1)
+}
@@ -1,6 +1,7 @@
 package invoices
 
 import (
+	"github.com/Actinium-project/lnd/chainntnfs"
 	"github.com/Actinium-project/lnd/record"
 )
 
@@ -11,7 +12,23 @@ type Payload interface {
 	// the onion payload.
 	MultiPath() *record.MPP
 
+	// AMPRecord returns the record corresponding to option_amp parsed from
+	// the onion payload.
+	AMPRecord() *record.AMP
+
+	// TrampolineOnion returns the trampoline onion parsed from the onion
+	// payload, or nil if there is none.
+	TrampolineOnion() []byte
+
 	// CustomRecords returns the custom tlv type records that were parsed
 	// from the payload.
 	CustomRecords() record.CustomSet
 }
+
+// EpochRegistrar supports the ability to register for events corresponding to
+// newly created blocks.
+type EpochRegistrar interface {
+	// RegisterBlockEpochNtfn registers for a new block epoch subscription.
+	RegisterBlockEpochNtfn(
+		*chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/Actinium-project/lnd/chainntnfs"
 	"github.com/Actinium-project/lnd/channeldb"
 	"github.com/Actinium-project/lnd/clock"
 	"github.com/Actinium-project/lnd/lntypes"
@@ -57,6 +58,42 @@ type RegistryConfig struct {
 	// AcceptKeySend indicates whether we want to accept spontaneous key
 	// send payments.
 	AcceptKeySend bool
+
+	// NodePubKey is the compressed public key of our node. It is used to
+	// verify the signatures of the senders of keysend payments.
+	NodePubKey [33]byte
+
+	// AcceptAMP indicates whether we want to accept spontaneous atomic
+	// multi-path payments.
+	AcceptAMP bool
+
+	// HtlcAcceptorTimeout is the time the htlc acceptor is given to decide
+	// on an htlc, before the htlc is processed as if there were no
+	// acceptor.
+	HtlcAcceptorTimeout time.Duration
+
+	// MaxHoldDuration is the maximum time a hold invoice is kept in the
+	// accepted state before it is canceled automatically. Invoices may
+	// set a lower limit for themselves. If zero, only the limits of the
+	// invoices apply.
+	MaxHoldDuration time.Duration
+
+	// HoldExpiryDelta is the number of blocks before the expiry of the
+	// earliest htlc of an accepted hold invoice at which the invoice is
+	// canceled automatically. Htlcs held by the htlc acceptor are failed
+	// at the same point. It should exceed the incoming broadcast delta, so
+	// that the htlcs are canceled back before the channel would be force
+	// closed. If zero, hold invoices and held htlcs aren't canceled based
+	// on the expiry of their htlcs.
+	HoldExpiryDelta uint32
+
+	// EpochRegistrar is used to learn about new blocks, in order to
+	// enforce HoldExpiryDelta. It must be set if HoldExpiryDelta is.
+	EpochRegistrar EpochRegistrar
+
+	// TrampolineForwarder forwards the payments of htlcs that carry a
+	// trampoline onion. If nil, such htlcs are rejected.
+	TrampolineForwarder TrampolineForwarder
 }
 
 // htlcReleaseEvent describes an htlc auto-release event. It is used to release
@@ -117,6 +154,29 @@ type InvoiceRegistry struct {
 
 	expiryWatcher *InvoiceExpiryWatcher
 
+	// heldInvoiceChan contains the accepted hold invoices that the hold
+	// policy needs to be enforced on.
+	heldInvoiceChan chan *heldInvoice
+
+	// blockEpochs is the block subscription used to enforce the hold
+	// expiry delta. It is nil if no hold expiry delta is configured.
+	blockEpochs *chainntnfs.BlockEpochEvent
+
+	// acceptorMtx guards the htlc acceptor and the htlcs that wait for it
+	// or are held by it.
+	acceptorMtx   sync.Mutex
+	htlcAcceptor  HtlcAcceptor
+	acceptorHtlcs map[channeldb.CircuitKey]*acceptorHtlc
+
+	// acceptorHeight is the height of the chain tip that the htlcs held
+	// by the htlc acceptor are checked against, in order to enforce the
+	// hold expiry delta. It's guarded by the acceptorMtx.
+	acceptorHeight uint32
+
+	// trampolineHtlcs are the htlcs whose trampoline payment is being
+	// forwarded.
+	trampolineHtlcs map[channeldb.CircuitKey]struct{}
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -140,12 +200,16 @@ func NewRegistry(cdb *channeldb.DB, expiryWatcher *InvoiceExpiryWatcher,
 		cfg:                       cfg,
 		htlcAutoReleaseChan:       make(chan *htlcReleaseEvent),
 		expiryWatcher:             expiryWatcher,
+		heldInvoiceChan:           make(chan *heldInvoice),
+		acceptorHtlcs:             make(map[channeldb.CircuitKey]*acceptorHtlc),
+		trampolineHtlcs:           make(map[channeldb.CircuitKey]struct{}),
 		quit:                      make(chan struct{}),
 	}
 }
 
 // populateExpiryWatcher fetches all active invoices and their corresponding
-// payment hashes from ChannelDB and adds them to the expiry watcher.
+// payment hashes from ChannelDB and adds them to the expiry watcher. The
+// accepted hold invoices among them are handed to the hold policy.
 func (i *InvoiceRegistry) populateExpiryWatcher() error {
 	pendingOnly := true
 	pendingInvoices, err := i.cdb.FetchAllInvoicesWithPaymentHash(pendingOnly)
@@ -159,6 +223,20 @@ func (i *InvoiceRegistry) populateExpiryWatcher() error {
 	log.Debugf("Adding %d pending invoices to the expiry watcher",
 		len(pendingInvoices))
 	i.expiryWatcher.AddInvoices(pendingInvoices)
+
+	for _, pending := range pendingInvoices {
+		if pending.Invoice.State != channeldb.ContractAccepted {
+			continue
+		}
+
+		held := i.newHeldInvoice(
+			pending.PaymentHash, &pending.Invoice,
+		)
+		if err := i.trackHeldInvoice(held); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -168,13 +246,29 @@ func (i *InvoiceRegistry) Start() error {
 	// invoices.
 	err := i.expiryWatcher.Start(func(paymentHash lntypes.Hash) error {
 		cancelIfAccepted := false
-		return i.cancelInvoiceImpl(paymentHash, cancelIfAccepted)
+		return i.cancelInvoiceImpl(
+			paymentHash, cancelIfAccepted,
+			channeldb.CancelReasonExpired,
+		)
 	})
 
 	if err != nil {
 		return err
 	}
 
+	// Subscribe to new blocks if hold invoices need to be canceled ahead
+	// of the expiry of their htlcs.
+	if i.cfg.HoldExpiryDelta > 0 {
+		i.blockEpochs, err = i.cfg.EpochRegistrar.RegisterBlockEpochNtfn(
+			nil,
+		)
+		if err != nil {
+			i.expiryWatcher.Stop()
+			return fmt.Errorf("unable to register for block "+
+				"epochs: %v", err)
+		}
+	}
+
 	i.wg.Add(1)
 	go i.invoiceEventLoop()
 
@@ -195,6 +289,10 @@ func (i *InvoiceRegistry) Stop() {
 	close(i.quit)
 
 	i.wg.Wait()
+
+	if i.blockEpochs != nil {
+		i.blockEpochs.Cancel()
+	}
 }
 
 // invoiceEvent represents a new event that has modified on invoice on disk.
@@ -221,6 +319,35 @@ func (i *InvoiceRegistry) invoiceEventLoop() {
 	// Set up a heap for htlc auto-releases.
 	autoReleaseHeap := &queue.PriorityQueue{}
 
+	// Track the accepted hold invoices to enforce the hold policy on, and
+	// the height of the chain tip to check their htlcs against.
+	var (
+		heldInvoices = newHeldInvoiceSet()
+		blockEpochs  <-chan *chainntnfs.BlockEpoch
+		bestHeight   uint32
+	)
+	if i.blockEpochs != nil {
+		blockEpochs = i.blockEpochs.Epochs
+	}
+
+	// cancelNearExpiry cancels the held invoices that have an htlc
+	// expiring within the hold expiry delta of the chain tip.
+	cancelNearExpiry := func() {
+		if bestHeight == 0 {
+			return
+		}
+
+		hashes := heldInvoices.popNearExpiry(
+			bestHeight, i.cfg.HoldExpiryDelta,
+		)
+		for _, hash := range hashes {
+			i.wg.Add(1)
+			go i.cancelHeldInvoice(
+				hash, channeldb.CancelReasonHtlcExpiry,
+			)
+		}
+	}
+
 	for {
 		// If there is something to release, set up a release tick
 		// channel.
@@ -230,6 +357,13 @@ func (i *InvoiceRegistry) invoiceEventLoop() {
 			nextReleaseTick = i.tickAt(head.releaseTime)
 		}
 
+		// If a held invoice has a limited hold duration, set up a tick
+		// channel for the earliest one to end.
+		var nextHoldTick <-chan time.Time
+		if cancelTime, ok := heldInvoices.nextTimeout(); ok {
+			nextHoldTick = i.tickAt(cancelTime)
+		}
+
 		select {
 		// A new invoice subscription for all invoices has just arrived!
 		// We'll query for any backlog notifications, then add it to the
@@ -283,6 +417,14 @@ func (i *InvoiceRegistry) invoiceEventLoop() {
 				}
 				i.dispatchToSingleClients(e)
 
+				// Settled and canceled invoices are no
+				// longer held.
+				if state == channeldb.ContractSettled ||
+					state == channeldb.ContractCanceled {
+
+					heldInvoices.remove(e.hash)
+				}
+
 			// A new single invoice subscription has arrived. Add it
 			// to the set of clients. It is important to do this in
 			// sequence with any other invoice events, because an
@@ -318,6 +460,42 @@ func (i *InvoiceRegistry) invoiceEventLoop() {
 				log.Errorf("HTLC timer: %v", err)
 			}
 
+		// A hold invoice was accepted. Cancel it right away if one of
+		// its htlcs is already too close to its expiry.
+		case held := <-i.heldInvoiceChan:
+			log.Debugf("Enforcing hold policy on invoice %v: "+
+				"cancel time=%v, htlc expiry=%v", held.hash,
+				held.cancelTime, held.expiry)
+
+			heldInvoices.add(held)
+			cancelNearExpiry()
+
+		// The hold duration of the held invoice with the earliest
+		// cancel time has ended.
+		case <-nextHoldTick:
+			hash := heldInvoices.popTimeout()
+
+			i.wg.Add(1)
+			go i.cancelHeldInvoice(
+				hash, channeldb.CancelReasonHoldTimeout,
+			)
+
+		// A new block arrived. Cancel the held invoices and fail the
+		// htlcs held by the htlc acceptor that are now too close to
+		// their expiry.
+		case epoch, ok := <-blockEpochs:
+			if !ok {
+				log.Warnf("Block epoch subscription closed, " +
+					"no longer enforcing hold expiry delta")
+
+				blockEpochs = nil
+				continue
+			}
+
+			bestHeight = uint32(epoch.Height)
+			cancelNearExpiry()
+			i.expireAcceptorHtlcs(bestHeight)
+
 		case <-i.quit:
 			return
 		}
@@ -696,8 +874,15 @@ func (i *InvoiceRegistry) processKeySend(ctx invoiceUpdateCtx) error {
 		return errors.New("final expiry too soon")
 	}
 
+	// Surface the well-known records the sender attached to the payment
+	// on the invoice.
+	details := parseKeySendDetails(
+		ctx.customRecords, i.cfg.NodePubKey, ctx.hash,
+	)
+
 	// Create placeholder invoice.
 	invoice := &channeldb.Invoice{
+		Memo:         details.message,
 		CreationDate: i.cfg.Clock.Now(),
 		Terms: channeldb.ContractTerm{
 			FinalCltvDelta:  finalCltvDelta,
@@ -705,6 +890,9 @@ func (i *InvoiceRegistry) processKeySend(ctx invoiceUpdateCtx) error {
 			PaymentPreimage: preimage,
 			Features:        features,
 		},
+		KeySendSender:         details.sender,
+		KeySendSenderVerified: details.senderVerified,
+		KeySendReplyTo:        details.replyTo,
 	}
 
 	// Insert invoice into database. Ignore duplicates, because this
@@ -717,6 +905,81 @@ func (i *InvoiceRegistry) processKeySend(ctx invoiceUpdateCtx) error {
 	return nil
 }
 
+// lookupAMPInvoice returns the hash of the invoice an AMP htlc pays to, which
+// is identified by the payment address in its mpp record. If no such invoice
+// exists and spontaneous AMP payments are accepted, an invoice keyed by the
+// set id of the payment is inserted just-in-time.
+func (i *InvoiceRegistry) lookupAMPInvoice(ctx invoiceUpdateCtx) (
+	lntypes.Hash, error) {
+
+	// AMP htlcs are only valid as part of a multi-path payment.
+	if ctx.mpp == nil {
+		return lntypes.Hash{}, errors.New("amp without mpp record")
+	}
+
+	invoiceHash, err := i.cdb.LookupInvoiceHashByPayAddr(
+		ctx.mpp.PaymentAddr(),
+	)
+	switch {
+	case err == channeldb.ErrInvoiceNotFound ||
+		err == channeldb.ErrNoInvoicesCreated:
+
+		if !i.cfg.AcceptAMP {
+			return lntypes.Hash{}, channeldb.ErrInvoiceNotFound
+		}
+
+	case err != nil:
+		return lntypes.Hash{}, err
+
+	default:
+		return invoiceHash, nil
+	}
+
+	// Use the minimum block delta that we require for settling htlcs.
+	finalCltvDelta := i.cfg.FinalCltvRejectDelta
+
+	// Pre-check expiry here to prevent inserting an invoice that will not
+	// be settled.
+	if ctx.expiry < uint32(ctx.currentHeight+finalCltvDelta) {
+		return lntypes.Hash{}, errors.New("final expiry too soon")
+	}
+
+	// The preimage of the invoice is unknown until the full set has
+	// arrived, and the root of the payment, whose hash is the set id, can
+	// be reconstructed.
+	rawFeatures := lnwire.NewRawFeatureVector(
+		lnwire.TLVOnionPayloadOptional, lnwire.PaymentAddrOptional,
+		lnwire.MPPOptional, lnwire.AMPRequired,
+	)
+	invoice := &channeldb.Invoice{
+		CreationDate: i.cfg.Clock.Now(),
+		Terms: channeldb.ContractTerm{
+			FinalCltvDelta: finalCltvDelta,
+			PaymentAddr:    ctx.mpp.PaymentAddr(),
+			Features: lnwire.NewFeatureVector(
+				rawFeatures, lnwire.Features,
+			),
+		},
+	}
+
+	// Insert invoice into database. Ignore duplicates, because the other
+	// htlcs of the set insert the same invoice.
+	setID := lntypes.Hash(ctx.amp.SetID())
+	_, err = i.AddInvoice(invoice, setID)
+	switch err {
+	case nil, channeldb.ErrDuplicateInvoice:
+		return setID, nil
+
+	// Another set already inserted an invoice with this payment address.
+	// The update will fail, because the set ids don't match.
+	case channeldb.ErrDuplicatePayAddr:
+		return i.cdb.LookupInvoiceHashByPayAddr(ctx.mpp.PaymentAddr())
+
+	default:
+		return lntypes.Hash{}, err
+	}
+}
+
 // NotifyExitHopHtlc attempts to mark an invoice as settled. The return value
 // describes how the htlc should be resolved.
 //
@@ -732,6 +995,9 @@ func (i *InvoiceRegistry) processKeySend(ctx invoiceUpdateCtx) error {
 // same invoice (multi-path payment), the htlc is held until the set is
 // complete. If the set doesn't fully arrive in time, a timer will cancel the
 // held htlc.
+//
+// If an htlc acceptor is registered, it is consulted before any of this. It
+// may settle, fail or hold the htlc itself, or resume the default behaviour.
 func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 	amtPaid lnwire.MilliSatoshi, expiry uint32, currentHeight int32,
 	circuitKey channeldb.CircuitKey, hodlChan chan<- interface{},
@@ -743,6 +1009,7 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 	// incoming htlc.
 	updateCtx := invoiceUpdateCtx{
 		hash:                 rHash,
+		htlcHash:             rHash,
 		circuitKey:           circuitKey,
 		amtPaid:              amtPaid,
 		expiry:               expiry,
@@ -750,8 +1017,34 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		finalCltvRejectDelta: i.cfg.FinalCltvRejectDelta,
 		customRecords:        payload.CustomRecords(),
 		mpp:                  mpp,
+		amp:                  payload.AMPRecord(),
 	}
 
+	// Htlcs that carry a trampoline onion don't pay to one of our
+	// invoices. They instruct us to forward the payment instead.
+	if onion := payload.TrampolineOnion(); onion != nil {
+		return i.forwardTrampolineHtlc(&updateCtx, onion, hodlChan)
+	}
+
+	// If an htlc acceptor is registered, it decides on the htlc first.
+	// The htlc is held until the acceptor decides on it, and falls back
+	// to our default behaviour if the acceptor times out. Like for hold
+	// invoices, the resolution is delivered on the hodl channel.
+	if i.consultHtlcAcceptor(&updateCtx, hodlChan) {
+		return nil, nil
+	}
+
+	return i.notifyExitHopHtlcDefault(updateCtx, hodlChan)
+}
+
+// notifyExitHopHtlcDefault decides how an htlc that pays to our node is
+// resolved, based on the invoice it pays to.
+func (i *InvoiceRegistry) notifyExitHopHtlcDefault(updateCtx invoiceUpdateCtx,
+	hodlChan chan<- interface{}) (HtlcResolution, error) {
+
+	circuitKey := updateCtx.circuitKey
+	currentHeight := updateCtx.currentHeight
+
 	// Process keysend if present. Do this outside of the lock, because
 	// AddInvoice obtains its own lock. This is no problem, because the
 	// operation is idempotent.
@@ -766,6 +1059,30 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		}
 	}
 
+	// The htlcs of AMP payments don't carry the hash of the invoice they
+	// pay to, so we'll look it up by the payment address instead. Do this
+	// outside of the lock as well, as spontaneous AMP payments may need to
+	// insert an invoice.
+	if updateCtx.amp != nil {
+		invoiceHash, err := i.lookupAMPInvoice(updateCtx)
+		switch {
+		case err == channeldb.ErrInvoiceNotFound:
+			return NewFailResolution(
+				circuitKey, currentHeight,
+				ResultInvoiceNotFound,
+			), nil
+
+		case err != nil:
+			updateCtx.log(fmt.Sprintf("amp error: %v", err))
+
+			return NewFailResolution(
+				circuitKey, currentHeight, ResultAmpError,
+			), nil
+		}
+
+		updateCtx.hash = invoiceHash
+	}
+
 	// Execute locked notify exit hop logic.
 	i.Lock()
 	resolution, err := i.notifyExitHopHtlcLocked(&updateCtx, hodlChan)
@@ -780,12 +1097,22 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 	// main event loop.
 	case *htlcAcceptResolution:
 		if r.autoRelease {
-			err := i.startHtlcTimer(rHash, circuitKey, r.acceptTime)
+			err := i.startHtlcTimer(
+				updateCtx.hash, circuitKey, r.acceptTime,
+			)
 			if err != nil {
 				return nil, err
 			}
 		}
 
+		// Likewise, hand a hold invoice to the hold policy outside of
+		// the lock.
+		if r.heldInvoice != nil {
+			if err := i.trackHeldInvoice(r.heldInvoice); err != nil {
+				return nil, err
+			}
+		}
+
 		// We return a nil resolution because htlc acceptances are
 		// represented as nil resolutions externally.
 		// TODO(carla) update calling code to handle accept resolutions.
@@ -823,9 +1150,11 @@ func (i *InvoiceRegistry) notifyExitHopHtlcLocked(
 				return nil, err
 			}
 
-			// Only send an update if the invoice state was changed.
+			// Only send an update if the invoice state was changed,
+			// or a payment to a reusable invoice was received.
 			updateSubscribers = updateDesc != nil &&
-				updateDesc.State != nil
+				(updateDesc.State != nil ||
+					updateDesc.Payment != nil)
 
 			// Assign resolution to outer scope variable.
 			resolution = res
@@ -888,13 +1217,20 @@ func (i *InvoiceRegistry) notifyExitHopHtlcLocked(
 				continue
 			}
 
+			// AMP htlcs are settled with their own preimage
+			// instead of the one of the invoice.
+			preimage := res.Preimage
+			if htlc.AMP != nil && htlc.AMP.Preimage != nil {
+				preimage = *htlc.AMP.Preimage
+			}
+
 			// Notify subscribers that the htlcs should be settled
 			// with our peer. Note that the outcome of the
 			// resolution is set based on the outcome of the single
 			// htlc that we just settled, so may not be accurate
 			// for all htlcs.
 			htlcSettleResolution := NewSettleResolution(
-				res.Preimage, key,
+				preimage, key,
 				int32(htlc.AcceptHeight), res.Outcome,
 			)
 
@@ -934,6 +1270,12 @@ func (i *InvoiceRegistry) notifyExitHopHtlcLocked(
 
 		}
 
+		// An accepted invoice is a hold invoice waiting to be settled
+		// or canceled. The hold policy applies to it.
+		if invoice.State == channeldb.ContractAccepted {
+			res.heldInvoice = i.newHeldInvoice(ctx.hash, invoice)
+		}
+
 		i.hodlSubscribe(hodlChan, ctx.circuitKey)
 		return res, nil
 
@@ -1004,15 +1346,16 @@ func (i *InvoiceRegistry) SettleHodlInvoice(preimage lntypes.Preimage) error {
 // CancelInvoice attempts to cancel the invoice corresponding to the passed
 // payment hash.
 func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
-	return i.cancelInvoiceImpl(payHash, true)
+	return i.cancelInvoiceImpl(payHash, true, channeldb.CancelReasonUser)
 }
 
 // cancelInvoice attempts to cancel the invoice corresponding to the passed
 // payment hash. Accepted invoices will only be canceled if explicitly
-// requested to do so. It notifies subscribing links and resolvers that
-// the associated htlcs were canceled if they change state.
+// requested to do so. The reason is recorded on the canceled invoice. It
+// notifies subscribing links and resolvers that the associated htlcs were
+// canceled if they change state.
 func (i *InvoiceRegistry) cancelInvoiceImpl(payHash lntypes.Hash,
-	cancelAccepted bool) error {
+	cancelAccepted bool, reason channeldb.CancelReason) error {
 
 	i.Lock()
 	defer i.Unlock()
@@ -1033,7 +1376,8 @@ func (i *InvoiceRegistry) cancelInvoiceImpl(payHash lntypes.Hash,
 		// settled or canceled.
 		return &channeldb.InvoiceUpdateDesc{
 			State: &channeldb.InvoiceStateUpdateDesc{
-				NewState: channeldb.ContractCanceled,
+				NewState:     channeldb.ContractCanceled,
+				CancelReason: reason,
 			},
 		}, nil
 	}
@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Actinium-project/lnd/amp"
+	"github.com/Actinium-project/lnd/chainntnfs"
 	"github.com/Actinium-project/lnd/channeldb"
 	"github.com/Actinium-project/lnd/clock"
 	"github.com/Actinium-project/lnd/lntypes"
@@ -942,3 +944,530 @@ func TestInvoiceExpiryWithRegistry(t *testing.T) {
 		}
 	}
 }
+
+// TestAMPPayment tests that the shards of an AMP payment to an invoice are
+// settled with the preimages derived from the root share once the full set
+// has arrived, and that the invoice can't be paid to directly.
+func TestAMPPayment(t *testing.T) {
+	defer timeout()()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	// Add an invoice that requires AMP.
+	payAddr := [32]byte{5}
+	invoice := *testInvoice
+	invoice.Terms.PaymentAddr = payAddr
+	invoice.Terms.Features = lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrOptional, lnwire.MPPOptional,
+			lnwire.AMPRequired,
+		), lnwire.Features,
+	)
+	_, err := ctx.registry.AddInvoice(&invoice, testInvoicePaymentHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Paying to the invoice hash directly must fail.
+	resolution, err := ctx.registry.NotifyExitHopHtlc(
+		testInvoicePaymentHash, testInvoiceAmt, testHtlcExpiry,
+		testCurrentHeight, getCircuitKey(9), nil, &mockPayload{
+			mpp: record.NewMPP(testInvoiceAmt, payAddr),
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	failResolution, ok := resolution.(*HtlcFailResolution)
+	if !ok || failResolution.Outcome != ResultAmpRequired {
+		t.Fatalf("expected amp required failure, got: %v", resolution)
+	}
+
+	children, err := amp.NewChildren(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sendShard := func(i int, hodlChan chan interface{}) HtlcResolution {
+		resolution, err := ctx.registry.NotifyExitHopHtlc(
+			children[i].Hash, testInvoiceAmt/2, testHtlcExpiry,
+			testCurrentHeight, getCircuitKey(uint64(10+i)),
+			hodlChan, &mockPayload{
+				mpp: record.NewMPP(testInvoiceAmt, payAddr),
+				amp: children[i].Record,
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return resolution
+	}
+
+	// The first shard is held until the set is complete.
+	hodlChan := make(chan interface{}, 1)
+	if resolution := sendShard(0, hodlChan); resolution != nil {
+		t.Fatalf("expected no direct resolution, got: %v", resolution)
+	}
+
+	// The second shard completes the set, which settles both shards with
+	// their own preimages.
+	resolution = sendShard(1, make(chan interface{}, 1))
+	settleResolution, ok := resolution.(*HtlcSettleResolution)
+	if !ok || settleResolution.Outcome != ResultSettled {
+		t.Fatalf("expected settle resolution, got: %v", resolution)
+	}
+	if settleResolution.Preimage != children[1].Preimage {
+		t.Fatalf("expected preimage %v, got %v",
+			children[1].Preimage, settleResolution.Preimage)
+	}
+
+	settleResolution, ok = (<-hodlChan).(*HtlcSettleResolution)
+	if !ok {
+		t.Fatalf("expected settle resolution for first shard")
+	}
+	if settleResolution.Preimage != children[0].Preimage {
+		t.Fatalf("expected preimage %v, got %v",
+			children[0].Preimage, settleResolution.Preimage)
+	}
+
+	inv, err := ctx.registry.LookupInvoice(testInvoicePaymentHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.State != channeldb.ContractSettled {
+		t.Fatal("expected invoice to be settled")
+	}
+	if inv.AmtPaid != testInvoiceAmt {
+		t.Fatalf("amount incorrect, expected %v but got %v",
+			testInvoiceAmt, inv.AmtPaid)
+	}
+
+	// A replay of a shard must be settled with its own preimage again.
+	resolution = sendShard(0, nil)
+	settleResolution, ok = resolution.(*HtlcSettleResolution)
+	if !ok || settleResolution.Outcome != ResultReplayToSettled {
+		t.Fatalf("expected replay settle resolution, got: %v",
+			resolution)
+	}
+	if settleResolution.Preimage != children[0].Preimage {
+		t.Fatalf("expected preimage %v, got %v",
+			children[0].Preimage, settleResolution.Preimage)
+	}
+}
+
+// TestSpontaneousAMP tests that spontaneous AMP payments are only accepted
+// when enabled, and settle the invoice that's inserted for them with the root
+// of the payment.
+func TestSpontaneousAMP(t *testing.T) {
+	t.Run("enabled", func(t *testing.T) {
+		testSpontaneousAMP(t, true)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		testSpontaneousAMP(t, false)
+	})
+}
+
+func testSpontaneousAMP(t *testing.T, ampEnabled bool) {
+	defer timeout()()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.registry.cfg.AcceptAMP = ampEnabled
+
+	children, err := amp.NewChildren(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var resolution HtlcResolution
+	for i, child := range children {
+		resolution, err = ctx.registry.NotifyExitHopHtlc(
+			child.Hash, testInvoiceAmt/2, testHtlcExpiry,
+			testCurrentHeight, getCircuitKey(uint64(i)),
+			make(chan interface{}, 1), &mockPayload{
+				mpp: record.NewMPP(testInvoiceAmt, [32]byte{6}),
+				amp: child.Record,
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !ampEnabled {
+			failResolution, ok := resolution.(*HtlcFailResolution)
+			if !ok || failResolution.Outcome !=
+				ResultInvoiceNotFound {
+
+				t.Fatalf("expected invoice not found, got: %v",
+					resolution)
+			}
+
+			return
+		}
+	}
+
+	settleResolution, ok := resolution.(*HtlcSettleResolution)
+	if !ok || settleResolution.Preimage != children[1].Preimage {
+		t.Fatalf("expected settle resolution, got: %v", resolution)
+	}
+
+	// The inserted invoice is keyed by the set id, and settled with the
+	// root of the payment.
+	setID := lntypes.Hash(children[0].Record.SetID())
+	inv, err := ctx.registry.LookupInvoice(setID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.State != channeldb.ContractSettled {
+		t.Fatal("expected invoice to be settled")
+	}
+	if inv.Terms.PaymentPreimage.Hash() != setID {
+		t.Fatalf("expected invoice to be settled with the root")
+	}
+	if inv.AmtPaid != testInvoiceAmt {
+		t.Fatalf("amount incorrect, expected %v but got %v",
+			testInvoiceAmt, inv.AmtPaid)
+	}
+}
+
+// TestReusableInvoice tests that a reusable invoice only accepts AMP
+// payments, accepts any number of them, records each of them separately and
+// notifies single invoice subscribers of every payment.
+func TestReusableInvoice(t *testing.T) {
+	defer timeout()()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	subscription, err := ctx.registry.SubscribeSingleInvoice(
+		testInvoicePaymentHash,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Cancel()
+
+	// Reusable invoices don't need a preimage, as every payment is settled
+	// with the preimages derived by its sender.
+	payAddr := [32]byte{6}
+	invoice := *testInvoice
+	invoice.Terms.PaymentPreimage = channeldb.UnknownPreimage
+	invoice.Terms.PaymentAddr = payAddr
+	invoice.Terms.Features = lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrOptional, lnwire.MPPOptional,
+			lnwire.AMPRequired,
+		), lnwire.Features,
+	)
+	invoice.Reusable = true
+	_, err = ctx.registry.AddInvoice(&invoice, testInvoicePaymentHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkUpdate := func(state channeldb.ContractState, numPayments int,
+		amtPaid lnwire.MilliSatoshi) *channeldb.Invoice {
+
+		select {
+		case update := <-subscription.Updates:
+			if update.State != state {
+				t.Fatalf("expected state %v, got %v", state,
+					update.State)
+			}
+			if len(update.Payments) != numPayments {
+				t.Fatalf("expected %v payments, got %v",
+					numPayments, len(update.Payments))
+			}
+			if update.AmtPaid != amtPaid {
+				t.Fatalf("expected amount paid %v, got %v",
+					amtPaid, update.AmtPaid)
+			}
+
+			return update
+
+		case <-time.After(testTimeout):
+			t.Fatal("no update received")
+		}
+
+		return nil
+	}
+	checkUpdate(channeldb.ContractOpen, 0, 0)
+
+	// Legacy and mpp htlcs that pay to the invoice hash must be rejected.
+	mppPayload := &mockPayload{
+		mpp: record.NewMPP(testInvoiceAmt, payAddr),
+	}
+	for i, payload := range []*mockPayload{testPayload, mppPayload} {
+		resolution, err := ctx.registry.NotifyExitHopHtlc(
+			testInvoicePaymentHash, testInvoiceAmt, testHtlcExpiry,
+			testCurrentHeight, getCircuitKey(uint64(i)), nil,
+			payload,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		failResolution, ok := resolution.(*HtlcFailResolution)
+		if !ok || failResolution.Outcome != ResultAmpRequired {
+			t.Fatalf("expected amp required failure, got: %v",
+				resolution)
+		}
+	}
+
+	var htlcID uint64 = 10
+	sendShard := func(child *amp.Child, amt lnwire.MilliSatoshi,
+		hodlChan chan interface{}) HtlcResolution {
+
+		htlcID++
+		resolution, err := ctx.registry.NotifyExitHopHtlc(
+			child.Hash, amt, testHtlcExpiry, testCurrentHeight,
+			getCircuitKey(htlcID), hodlChan, &mockPayload{
+				mpp: record.NewMPP(testInvoiceAmt, payAddr),
+				amp: child.Record,
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return resolution
+	}
+
+	// Pay the invoice with a single shard. The shard is settled with its
+	// own preimage, but the invoice remains open.
+	children, err := amp.NewChildren(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolution := sendShard(children[0], testInvoiceAmt, nil)
+	settleResolution, ok := resolution.(*HtlcSettleResolution)
+	if !ok || settleResolution.Outcome != ResultSettled {
+		t.Fatalf("expected settle resolution, got: %v", resolution)
+	}
+	if settleResolution.Preimage != children[0].Preimage {
+		t.Fatalf("expected preimage %v, got %v",
+			children[0].Preimage, settleResolution.Preimage)
+	}
+	update := checkUpdate(channeldb.ContractOpen, 1, testInvoiceAmt)
+	if update.Payments[0].Amt != testInvoiceAmt {
+		t.Fatalf("expected payment amount %v, got %v",
+			testInvoiceAmt, update.Payments[0].Amt)
+	}
+
+	// Pay the invoice again, this time with a set of two shards. The first
+	// shard is held until the set is complete.
+	children, err = amp.NewChildren(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hodlChan := make(chan interface{}, 1)
+	resolution = sendShard(children[0], testInvoiceAmt/2, hodlChan)
+	if resolution != nil {
+		t.Fatalf("expected no direct resolution, got: %v", resolution)
+	}
+
+	resolution = sendShard(children[1], testInvoiceAmt/2, nil)
+	settleResolution, ok = resolution.(*HtlcSettleResolution)
+	if !ok || settleResolution.Outcome != ResultSettled {
+		t.Fatalf("expected settle resolution, got: %v", resolution)
+	}
+	settleResolution, ok = (<-hodlChan).(*HtlcSettleResolution)
+	if !ok || settleResolution.Preimage != children[0].Preimage {
+		t.Fatalf("expected settle resolution for first shard")
+	}
+
+	update = checkUpdate(channeldb.ContractOpen, 2, 2*testInvoiceAmt)
+	payment := update.Payments[1]
+	if payment.Index != 2 || payment.NumHtlcs != 2 ||
+		payment.Amt != testInvoiceAmt {
+
+		t.Fatalf("unexpected second payment: %+v", payment)
+	}
+
+	// Canceling the invoice stops it from accepting payments, but leaves
+	// the payments received so far untouched.
+	if err := ctx.registry.CancelInvoice(testInvoicePaymentHash); err != nil {
+		t.Fatal(err)
+	}
+	checkUpdate(channeldb.ContractCanceled, 2, 2*testInvoiceAmt)
+
+	children, err = amp.NewChildren(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolution = sendShard(children[0], testInvoiceAmt, nil)
+	failResolution, ok := resolution.(*HtlcFailResolution)
+	if !ok || failResolution.Outcome != ResultInvoiceNotOpen {
+		t.Fatalf("expected invoice not open failure, got: %v",
+			resolution)
+	}
+}
+
+// TestHoldInvoicePolicy tests that accepted hold invoices are canceled
+// automatically when their hold duration ends or their htlcs come close to
+// expiry, and that the reason of the cancellation is recorded.
+func TestHoldInvoicePolicy(t *testing.T) {
+	t.Parallel()
+
+	const (
+		htlcExpiry      = 20
+		holdExpiryDelta = 3
+	)
+
+	testCases := []struct {
+		name            string
+		maxHoldDuration time.Duration
+		holdDuration    time.Duration
+
+		// violate makes the held invoice violate the hold policy.
+		violate func(registry *InvoiceRegistry, clock *clock.TestClock,
+			epochs chan *chainntnfs.BlockEpoch) error
+
+		expectedReason channeldb.CancelReason
+	}{
+		{
+			name: "canceled by user",
+			violate: func(registry *InvoiceRegistry,
+				_ *clock.TestClock,
+				_ chan *chainntnfs.BlockEpoch) error {
+
+				return registry.CancelInvoice(
+					testInvoicePaymentHash,
+				)
+			},
+			expectedReason: channeldb.CancelReasonUser,
+		},
+		{
+			name:            "invoice hold duration",
+			maxHoldDuration: time.Hour,
+			holdDuration:    time.Minute,
+			violate: func(_ *InvoiceRegistry, clock *clock.TestClock,
+				_ chan *chainntnfs.BlockEpoch) error {
+
+				clock.SetTime(testTime.Add(time.Minute))
+				return nil
+			},
+			expectedReason: channeldb.CancelReasonHoldTimeout,
+		},
+		{
+			name:            "global hold duration",
+			maxHoldDuration: time.Minute,
+			violate: func(_ *InvoiceRegistry, clock *clock.TestClock,
+				_ chan *chainntnfs.BlockEpoch) error {
+
+				clock.SetTime(testTime.Add(time.Minute))
+				return nil
+			},
+			expectedReason: channeldb.CancelReasonHoldTimeout,
+		},
+		{
+			name: "htlc expiry",
+			violate: func(_ *InvoiceRegistry, _ *clock.TestClock,
+				epochs chan *chainntnfs.BlockEpoch) error {
+
+				epochs <- &chainntnfs.BlockEpoch{
+					Height: htlcExpiry - holdExpiryDelta,
+				}
+				return nil
+			},
+			expectedReason: channeldb.CancelReasonHtlcExpiry,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			testClock := clock.NewTestClock(testTime)
+			cdb, cleanup, err := newTestChannelDB(testClock)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer cleanup()
+
+			epochs := make(chan *chainntnfs.BlockEpoch, 1)
+			cfg := RegistryConfig{
+				FinalCltvRejectDelta: testFinalCltvRejectDelta,
+				Clock:                testClock,
+				MaxHoldDuration:      test.maxHoldDuration,
+				HoldExpiryDelta:      holdExpiryDelta,
+				EpochRegistrar: &mockEpochRegistrar{
+					epochs: epochs,
+				},
+			}
+			registry := NewRegistry(
+				cdb, NewInvoiceExpiryWatcher(testClock), &cfg,
+			)
+			if err := registry.Start(); err != nil {
+				t.Fatal(err)
+			}
+			defer registry.Stop()
+
+			// The chain tip is still well ahead of the expiry
+			// of the htlc.
+			epochs <- &chainntnfs.BlockEpoch{
+				Height: htlcExpiry - holdExpiryDelta - 1,
+			}
+
+			invoice := *testHodlInvoice
+			invoice.Terms.HoldDuration = test.holdDuration
+			_, err = registry.AddInvoice(
+				&invoice, testInvoicePaymentHash,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			hodlChan := make(chan interface{}, 1)
+			resolution, err := registry.NotifyExitHopHtlc(
+				testInvoicePaymentHash, testInvoiceAmt,
+				htlcExpiry, testCurrentHeight,
+				getCircuitKey(0), hodlChan, testPayload,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resolution != nil {
+				t.Fatalf("expected htlc to be held")
+			}
+
+			err = test.violate(registry, testClock, epochs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			select {
+			case htlcResolution := <-hodlChan:
+				_, ok := htlcResolution.(*HtlcFailResolution)
+				if !ok {
+					t.Fatalf("expected fail resolution, "+
+						"got: %T", htlcResolution)
+				}
+
+			case <-time.After(testTimeout):
+				t.Fatalf("expected htlc to be canceled")
+			}
+
+			dbInvoice, err := registry.LookupInvoice(
+				testInvoicePaymentHash,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dbInvoice.State != channeldb.ContractCanceled {
+				t.Fatalf("expected canceled invoice, got %v",
+					dbInvoice.State)
+			}
+			if dbInvoice.CancelReason != test.expectedReason {
+				t.Fatalf("expected cancel reason %v, got %v",
+					test.expectedReason,
+					dbInvoice.CancelReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package invoices
+
+import (
+	"bytes"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/record"
+)
+
+// signedMsgPrefix is the prefix the SignMessage RPC prepends to the messages
+// it signs. Keysend senders sign with this RPC, so we'll prepend it as well
+// when verifying their signatures.
+var signedMsgPrefix = []byte("Lightning Signed Message:")
+
+// keySendDetails holds the well-known records a sender attached to a keysend
+// payment.
+type keySendDetails struct {
+	// message is the message of the sender.
+	message []byte
+
+	// sender is the public key the sender identified itself with.
+	sender []byte
+
+	// senderVerified indicates whether the signature of the sender over
+	// the payment was valid.
+	senderVerified bool
+
+	// replyTo is a payment request to reply to the sender with.
+	replyTo []byte
+}
+
+// parseKeySendDetails extracts the well-known records from the custom records
+// of a keysend htlc, and verifies the signature of the sender if present.
+// Records that exceed the limits of the invoice database are dropped.
+func parseKeySendDetails(records record.CustomSet, receiver [33]byte,
+	hash lntypes.Hash) *keySendDetails {
+
+	var details keySendDetails
+
+	if message, ok := records[record.KeySendMessageType]; ok {
+		if len(message) > channeldb.MaxMemoSize {
+			message = message[:channeldb.MaxMemoSize]
+		}
+		details.message = message
+	}
+
+	if replyTo, ok := records[record.KeySendReplyToType]; ok &&
+		len(replyTo) <= channeldb.MaxPaymentRequestSize {
+
+		details.replyTo = replyTo
+	}
+
+	sender, ok := records[record.KeySendSenderType]
+	if !ok {
+		return &details
+	}
+	senderKey, err := btcec.ParsePubKey(sender, btcec.S256())
+	if err != nil {
+		log.Debugf("Invalid keysend sender %x: %v", sender, err)
+		return &details
+	}
+	details.sender = senderKey.SerializeCompressed()
+
+	// The sender is only verified if it signed the payment.
+	sig, ok := records[record.KeySendSignatureType]
+	if !ok {
+		return &details
+	}
+
+	var senderBytes [33]byte
+	copy(senderBytes[:], details.sender)
+	data := record.KeySendSignedData(senderBytes, receiver, hash, records)
+	digest := chainhash.DoubleHashB(append(signedMsgPrefix, data...))
+
+	// RecoverCompact both recovers the pubkey and validates the
+	// signature.
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), sig, digest)
+	if err != nil {
+		log.Debugf("Invalid keysend signature of sender %x: %v",
+			details.sender, err)
+		return &details
+	}
+
+	details.senderVerified = bytes.Equal(
+		pubKey.SerializeCompressed(), details.sender,
+	)
+
+	return &details
+}
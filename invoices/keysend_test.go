@@ -0,0 +1,133 @@
+package invoices
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/record"
+)
+
+// TestKeySendDetails tests that the well-known records of a keysend payment
+// are surfaced on its invoice, and that the signature of the sender is
+// verified.
+func TestKeySendDetails(t *testing.T) {
+	defer timeout()()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.registry.cfg.AcceptKeySend = true
+	copy(ctx.registry.cfg.NodePubKey[:], bytes.Repeat([]byte{2}, 33))
+
+	var sender [33]byte
+	copy(sender[:], testPrivKey.PubKey().SerializeCompressed())
+
+	// sign returns the signature of the sender over the payment, as
+	// created by the SignMessage RPC.
+	sign := func(receiver [33]byte, hash lntypes.Hash,
+		records record.CustomSet) []byte {
+
+		data := record.KeySendSignedData(
+			sender, receiver, hash, records,
+		)
+		digest := chainhash.DoubleHashB(append(signedMsgPrefix, data...))
+		sig, err := btcec.SignCompact(
+			btcec.S256(), testPrivKey, digest, true,
+		)
+		if err != nil {
+			t.Fatalf("unable to sign: %v", err)
+		}
+
+		return sig
+	}
+
+	testCases := []struct {
+		name     string
+		receiver [33]byte
+		tamper   bool
+		sign     bool
+		verified bool
+	}{
+		{
+			name:     "valid signature",
+			receiver: ctx.registry.cfg.NodePubKey,
+			sign:     true,
+			verified: true,
+		},
+		{
+			name:     "signed for other receiver",
+			receiver: sender,
+			sign:     true,
+		},
+		{
+			name:     "tampered message",
+			receiver: ctx.registry.cfg.NodePubKey,
+			sign:     true,
+			tamper:   true,
+		},
+		{
+			name: "unsigned",
+		},
+	}
+
+	for i, test := range testCases {
+		preimage := lntypes.Preimage{byte(i + 1)}
+		hash := preimage.Hash()
+
+		records := record.CustomSet{
+			record.KeySendType:        preimage[:],
+			record.KeySendMessageType: []byte("hello"),
+			record.KeySendReplyToType: []byte("lnbc1"),
+			record.KeySendSenderType:  sender[:],
+		}
+		if test.sign {
+			records[record.KeySendSignatureType] = sign(
+				test.receiver, hash, records,
+			)
+		}
+		if test.tamper {
+			records[record.KeySendMessageType] = []byte("bye")
+		}
+
+		hodlChan := make(chan interface{}, 1)
+		resolution, err := ctx.registry.NotifyExitHopHtlc(
+			hash, testInvoiceAmt, testHtlcExpiry, testCurrentHeight,
+			getCircuitKey(uint64(i)), hodlChan,
+			&mockPayload{customRecords: records},
+		)
+		if err != nil {
+			t.Fatalf("%v: unable to notify htlc: %v", test.name,
+				err)
+		}
+		if _, ok := resolution.(*HtlcSettleResolution); !ok {
+			t.Fatalf("%v: expected settle resolution, got: %T",
+				test.name, resolution)
+		}
+
+		invoice, err := ctx.registry.LookupInvoice(hash)
+		if err != nil {
+			t.Fatalf("%v: unable to look up invoice: %v",
+				test.name, err)
+		}
+
+		if !bytes.Equal(invoice.Memo, records[record.KeySendMessageType]) {
+			t.Fatalf("%v: unexpected memo %s", test.name,
+				invoice.Memo)
+		}
+		if !bytes.Equal(invoice.KeySendSender, sender[:]) {
+			t.Fatalf("%v: unexpected sender %x", test.name,
+				invoice.KeySendSender)
+		}
+		if string(invoice.KeySendReplyTo) != "lnbc1" {
+			t.Fatalf("%v: unexpected reply-to %s", test.name,
+				invoice.KeySendReplyTo)
+		}
+		if invoice.KeySendSenderVerified != test.verified {
+			t.Fatalf("%v: expected verified %v, got %v", test.name,
+				test.verified, invoice.KeySendSenderVerified)
+		}
+	}
+}
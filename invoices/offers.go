@@ -0,0 +1,150 @@
+package invoices
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/clock"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/offers"
+)
+
+const (
+	// DefaultOfferInvoiceExpiry is the default expiry of the invoices that
+	// are issued for offers. Payers fetch them right before paying, so
+	// they expire quickly.
+	DefaultOfferInvoiceExpiry = 10 * time.Minute
+
+	// DefaultMaxPendingOfferInvoices is the default number of unexpired
+	// invoices that are issued for offers at a time.
+	DefaultMaxPendingOfferInvoices = 1000
+)
+
+var (
+	// ErrUnknownOffer is returned when an invoice is requested for an
+	// offer that this node didn't issue.
+	ErrUnknownOffer = errors.New("unknown offer")
+
+	// ErrTooManyOfferInvoices is returned when an invoice is requested for
+	// an offer while the maximum number of unexpired offer invoices has
+	// been issued.
+	ErrTooManyOfferInvoices = errors.New("too many pending offer invoices")
+)
+
+// OfferIssuerConfig contains the dependencies of the offer issuer.
+type OfferIssuerConfig struct {
+	// LookupOffer returns the encoded offer with the given id, or
+	// channeldb.ErrOfferNotFound if this node didn't issue it.
+	LookupOffer func(offerID [32]byte) (string, error)
+
+	// AddInvoice adds an invoice with the given amount, memo and expiry,
+	// returning its payment request.
+	AddInvoice func(amt lnwire.MilliSatoshi, memo string,
+		expiry time.Duration) (string, error)
+
+	// Clock is the clock the expiry of offers is checked against.
+	Clock clock.Clock
+
+	// InvoiceExpiry is the expiry of the invoices that are issued for
+	// offers.
+	InvoiceExpiry time.Duration
+
+	// MaxPendingInvoices is the number of unexpired invoices that are
+	// issued for offers at a time. Every issued invoice is persisted, so
+	// this bounds the rate at which invoice requests grow the database.
+	MaxPendingInvoices int
+}
+
+// OfferIssuer issues invoices for the invoice requests that payers send for
+// the offers of this node.
+type OfferIssuer struct {
+	cfg *OfferIssuerConfig
+
+	// expiries are the expiry times of the issued invoices that haven't
+	// expired yet, in the order they were issued.
+	expiries []time.Time
+	mu       sync.Mutex
+}
+
+// A compile time check to ensure OfferIssuer implements the
+// offers.InvoiceIssuer interface.
+var _ offers.InvoiceIssuer = (*OfferIssuer)(nil)
+
+// NewOfferIssuer creates a new offer issuer. Limits that aren't set in the
+// config are set to their defaults.
+func NewOfferIssuer(cfg *OfferIssuerConfig) *OfferIssuer {
+	if cfg.InvoiceExpiry <= 0 {
+		cfg.InvoiceExpiry = DefaultOfferInvoiceExpiry
+	}
+	if cfg.MaxPendingInvoices <= 0 {
+		cfg.MaxPendingInvoices = DefaultMaxPendingOfferInvoices
+	}
+
+	return &OfferIssuer{
+		cfg: cfg,
+	}
+}
+
+// IssueInvoice adds a fresh invoice for an invoice request, returning its
+// payment request. The invoice is only added if the request matches the
+// terms of its offer, and not too many unexpired offer invoices have been
+// issued.
+//
+// NOTE: This is part of the offers.InvoiceIssuer interface.
+func (i *OfferIssuer) IssueInvoice(req *offers.InvoiceRequest) (string,
+	error) {
+
+	encoded, err := i.cfg.LookupOffer(req.OfferID)
+	switch {
+	case err == channeldb.ErrOfferNotFound:
+		return "", ErrUnknownOffer
+
+	case err != nil:
+		return "", err
+	}
+
+	offer, err := offers.DecodeOffer(encoded)
+	if err != nil {
+		return "", err
+	}
+
+	if offer.IsExpired(i.cfg.Clock.Now()) {
+		return "", offers.ErrOfferExpired
+	}
+
+	amt, err := offer.InvoiceAmount(req.Amount)
+	if err != nil {
+		return "", err
+	}
+
+	memo := offer.Description
+	if req.PayerNote != "" {
+		memo = fmt.Sprintf("%v (payer note: %v)", memo, req.PayerNote)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	// All invoices have the same expiry, so the ones that expired are at
+	// the front.
+	now := i.cfg.Clock.Now()
+	for len(i.expiries) > 0 && !i.expiries[0].After(now) {
+		i.expiries = i.expiries[1:]
+	}
+	if len(i.expiries) >= i.cfg.MaxPendingInvoices {
+		return "", ErrTooManyOfferInvoices
+	}
+
+	payReq, err := i.cfg.AddInvoice(amt, memo, i.cfg.InvoiceExpiry)
+	if err != nil {
+		return "", err
+	}
+	i.expiries = append(i.expiries, now.Add(i.cfg.InvoiceExpiry))
+
+	log.Debugf("Issued invoice of %v for offer %x", amt, req.OfferID[:])
+
+	return payReq, nil
+}
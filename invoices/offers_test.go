@@ -0,0 +1,75 @@
+package invoices
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/lnd/clock"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/offers"
+)
+
+// TestOfferIssuerLimit tests that offer invoices are issued with a short
+// expiry, and that no more than the maximum number of unexpired offer invoices
+// are issued.
+func TestOfferIssuerLimit(t *testing.T) {
+	nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	offer, err := offers.NewOffer(
+		chainhash.Hash{}, nodeKey.PubKey(), 1000, "coffee", time.Time{},
+	)
+	if err != nil {
+		t.Fatalf("unable to create offer: %v", err)
+	}
+	encoded, err := offer.Encode()
+	if err != nil {
+		t.Fatalf("unable to encode offer: %v", err)
+	}
+	offerID, err := offer.ID()
+	if err != nil {
+		t.Fatalf("unable to compute offer id: %v", err)
+	}
+
+	const expiry = time.Minute
+	testClock := clock.NewTestClock(testTime)
+	issuer := NewOfferIssuer(&OfferIssuerConfig{
+		LookupOffer: func([32]byte) (string, error) {
+			return encoded, nil
+		},
+		AddInvoice: func(amt lnwire.MilliSatoshi, memo string,
+			invoiceExpiry time.Duration) (string, error) {
+
+			if invoiceExpiry != expiry {
+				t.Fatalf("expected expiry %v, got %v", expiry,
+					invoiceExpiry)
+			}
+
+			return "payreq", nil
+		},
+		Clock:              testClock,
+		InvoiceExpiry:      expiry,
+		MaxPendingInvoices: 2,
+	})
+
+	req := &offers.InvoiceRequest{OfferID: offerID}
+	for i := 0; i < 2; i++ {
+		if _, err := issuer.IssueInvoice(req); err != nil {
+			t.Fatalf("unable to issue invoice: %v", err)
+		}
+	}
+
+	_, err = issuer.IssueInvoice(req)
+	if err != ErrTooManyOfferInvoices {
+		t.Fatalf("expected ErrTooManyOfferInvoices, got %v", err)
+	}
+
+	// Once the issued invoices expired, new ones can be issued again.
+	testClock.SetTime(testTime.Add(expiry))
+	if _, err := issuer.IssueInvoice(req); err != nil {
+		t.Fatalf("unable to issue invoice: %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Actinium-project/lnd/channeldb"
 	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwire"
 )
 
 // HtlcResolution describes how an htlc should be resolved.
@@ -25,6 +26,11 @@ type HtlcFailResolution struct {
 
 	// Outcome indicates the outcome of the invoice registry update.
 	Outcome FailResolutionResult
+
+	// FailureCode is the failure the htlc should be failed with. It is
+	// only set if the htlc acceptor chose the failure, otherwise the
+	// failure is derived from the outcome.
+	FailureCode lnwire.FailCode
 }
 
 // NewFailResolution returns a htlc failure resolution.
@@ -101,6 +107,10 @@ type htlcAcceptResolution struct {
 	// acceptTime is the time at which this htlc was accepted.
 	acceptTime time.Time
 
+	// heldInvoice is set if the invoice of the htlc is an accepted hold
+	// invoice that the hold policy needs to be enforced on.
+	heldInvoice *heldInvoice
+
 	// outcome indicates the outcome of the invoice registry update.
 	outcome acceptResolutionResult
 }
@@ -105,6 +105,35 @@ const (
 	// ResultMppInProgress is returned when we are busy receiving a mpp
 	// payment.
 	ResultMppInProgress
+
+	// ResultAmpError is returned when we receive invalid AMP parameters,
+	// or an AMP htlc for an invoice that doesn't support AMP.
+	ResultAmpError
+
+	// ResultAmpRequired is returned when a non-AMP htlc pays to an invoice
+	// that requires AMP.
+	ResultAmpRequired
+
+	// ResultAmpReconstruction is returned when the root reconstructed from
+	// the shares of a complete AMP set doesn't match its set id or the
+	// hashes of its htlcs.
+	ResultAmpReconstruction
+
+	// ResultRejectedByAcceptor is returned when the htlc acceptor chose to
+	// fail the htlc.
+	ResultRejectedByAcceptor
+
+	// ResultTrampolineDisabled is returned when an htlc carries a
+	// trampoline onion, but we don't forward trampoline payments.
+	ResultTrampolineDisabled
+
+	// ResultTrampolineFailed is returned when the payment of an htlc that
+	// carries a trampoline onion couldn't be forwarded.
+	ResultTrampolineFailed
+
+	// ResultHoldExpiry is returned when an htlc held by the htlc acceptor
+	// is failed because it got too close to its expiry.
+	ResultHoldExpiry
 )
 
 // FailureString returns a string representation of the result.
@@ -157,6 +186,27 @@ func (f FailResolutionResult) FailureString() string {
 	case ResultMppInProgress:
 		return "mpp reception in progress"
 
+	case ResultAmpError:
+		return "invalid amp parameters"
+
+	case ResultAmpRequired:
+		return "amp required"
+
+	case ResultAmpReconstruction:
+		return "amp reconstruction failed"
+
+	case ResultRejectedByAcceptor:
+		return "rejected by htlc acceptor"
+
+	case ResultTrampolineDisabled:
+		return "trampoline forwarding disabled"
+
+	case ResultTrampolineFailed:
+		return "trampoline forward failed"
+
+	case ResultHoldExpiry:
+		return "held htlc too close to expiry"
+
 	default:
 		return "unknown failure resolution result"
 	}
@@ -179,6 +229,14 @@ const (
 	// ResultDuplicateToSettled is returned when we settle an invoice which
 	// has already been settled at least once.
 	ResultDuplicateToSettled
+
+	// ResultSettledByAcceptor is returned when the htlc acceptor settled
+	// the htlc with a preimage it supplied.
+	ResultSettledByAcceptor
+
+	// ResultSettledByTrampoline is returned when the payment of an htlc
+	// that carries a trampoline onion was forwarded successfully.
+	ResultSettledByTrampoline
 )
 
 // String returns a string representation of the result.
@@ -196,6 +254,12 @@ func (s SettleResolutionResult) String() string {
 	case ResultDuplicateToSettled:
 		return "accepting duplicate payment to settled invoice"
 
+	case ResultSettledByAcceptor:
+		return "settled by htlc acceptor"
+
+	case ResultSettledByTrampoline:
+		return "settled by trampoline forward"
+
 	default:
 		return "unknown settle resolution result"
 	}
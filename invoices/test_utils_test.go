@@ -12,6 +12,8 @@ import (
 
 	"github.com/Actinium-project/acmd/btcec"
 	"github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/lnd/chainntnfs"
 	"github.com/Actinium-project/lnd/channeldb"
 	"github.com/Actinium-project/lnd/clock"
 	"github.com/Actinium-project/lnd/lntypes"
@@ -21,14 +23,24 @@ import (
 )
 
 type mockPayload struct {
-	mpp           *record.MPP
-	customRecords record.CustomSet
+	mpp             *record.MPP
+	amp             *record.AMP
+	trampolineOnion []byte
+	customRecords   record.CustomSet
 }
 
 func (p *mockPayload) MultiPath() *record.MPP {
 	return p.mpp
 }
 
+func (p *mockPayload) AMPRecord() *record.AMP {
+	return p.amp
+}
+
+func (p *mockPayload) TrampolineOnion() []byte {
+	return p.trampolineOnion
+}
+
 func (p *mockPayload) CustomRecords() record.CustomSet {
 	// This function should always return a map instance, but for mock
 	// configuration we do accept nil.
@@ -39,6 +51,20 @@ func (p *mockPayload) CustomRecords() record.CustomSet {
 	return p.customRecords
 }
 
+// mockEpochRegistrar delivers the block epochs that are sent on its channel.
+type mockEpochRegistrar struct {
+	epochs chan *chainntnfs.BlockEpoch
+}
+
+func (m *mockEpochRegistrar) RegisterBlockEpochNtfn(
+	*chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: m.epochs,
+		Cancel: func() {},
+	}, nil
+}
+
 var (
 	testTimeout = 5 * time.Second
 
@@ -69,7 +95,8 @@ var (
 	testNetParams = &chaincfg.MainNetParams
 
 	testMessageSigner = zpay32.MessageSigner{
-		SignCompact: func(hash []byte) ([]byte, error) {
+		SignCompact: func(msg []byte) ([]byte, error) {
+			hash := chainhash.HashB(msg)
 			sig, err := btcec.SignCompact(btcec.S256(), testPrivKey, hash, true)
 			if err != nil {
 				return nil, fmt.Errorf("can't sign the message: %v", err)
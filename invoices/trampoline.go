@@ -0,0 +1,144 @@
+package invoices
+
+import (
+	"fmt"
+
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/record"
+)
+
+// TrampolineRequest describes an htlc that pays to our node and carries a
+// trampoline onion, which instructs us to forward the payment.
+type TrampolineRequest struct {
+	// Hash is the payment hash of the htlc.
+	Hash lntypes.Hash
+
+	// CircuitKey identifies the htlc.
+	CircuitKey channeldb.CircuitKey
+
+	// Amount is the amount of the htlc.
+	Amount lnwire.MilliSatoshi
+
+	// Expiry is the absolute expiry height of the htlc.
+	Expiry uint32
+
+	// CurrentHeight is the block height at which the htlc was received.
+	CurrentHeight int32
+
+	// MPP is the mpp record of the final hop payload, if any.
+	MPP *record.MPP
+
+	// Onion is the trampoline onion of the htlc.
+	Onion []byte
+}
+
+// TrampolineResolution is the outcome of forwarding a trampoline htlc.
+type TrampolineResolution struct {
+	// Preimage is the preimage to settle the htlc with. It is nil if the
+	// payment couldn't be forwarded.
+	Preimage *lntypes.Preimage
+
+	// FailureCode is the failure to fail the htlc with if the payment
+	// couldn't be forwarded.
+	FailureCode lnwire.FailCode
+}
+
+// TrampolineForwarder forwards the payments of htlcs that carry a trampoline
+// onion.
+type TrampolineForwarder interface {
+	// ForwardTrampoline forwards the payment described by the trampoline
+	// onion of the htlc, and blocks until the payment is resolved. A nil
+	// resolution is returned if the forwarder is shutting down before the
+	// payment is resolved, in which case the htlc remains unresolved.
+	ForwardTrampoline(req *TrampolineRequest) *TrampolineResolution
+}
+
+// forwardTrampolineHtlc hands an htlc that carries a trampoline onion to the
+// trampoline forwarder. The htlc is held until the forwarded payment is
+// resolved, after which its resolution is delivered to its subscribers.
+func (i *InvoiceRegistry) forwardTrampolineHtlc(ctx *invoiceUpdateCtx,
+	onion []byte, hodlChan chan<- interface{}) (HtlcResolution, error) {
+
+	forwarder := i.cfg.TrampolineForwarder
+	if forwarder == nil {
+		ctx.log("trampoline forwarding disabled")
+
+		resolution := NewFailResolution(
+			ctx.circuitKey, ctx.currentHeight,
+			ResultTrampolineDisabled,
+		)
+		resolution.FailureCode = lnwire.CodePermanentNodeFailure
+
+		return resolution, nil
+	}
+
+	key := ctx.circuitKey
+
+	i.Lock()
+	i.hodlSubscribe(hodlChan, key)
+
+	// If the htlc is replayed while it is being forwarded, its resolution
+	// will be delivered to the new subscriber as well.
+	if _, ok := i.trampolineHtlcs[key]; ok {
+		i.Unlock()
+		return nil, nil
+	}
+	i.trampolineHtlcs[key] = struct{}{}
+	i.Unlock()
+
+	ctx.log("forwarding trampoline htlc")
+
+	req := &TrampolineRequest{
+		Hash:          ctx.htlcHash,
+		CircuitKey:    key,
+		Amount:        ctx.amtPaid,
+		Expiry:        ctx.expiry,
+		CurrentHeight: ctx.currentHeight,
+		MPP:           ctx.mpp,
+		Onion:         onion,
+	}
+
+	i.wg.Add(1)
+	go func() {
+		defer i.wg.Done()
+
+		result := forwarder.ForwardTrampoline(req)
+
+		i.Lock()
+		defer i.Unlock()
+
+		delete(i.trampolineHtlcs, key)
+
+		// The htlc is left unresolved when the forwarder shuts down,
+		// so that it is forwarded again when it is replayed after a
+		// restart.
+		if result == nil {
+			return
+		}
+
+		var resolution HtlcResolution
+		if result.Preimage != nil {
+			ctx.log("trampoline htlc settled")
+
+			resolution = NewSettleResolution(
+				*result.Preimage, key, ctx.currentHeight,
+				ResultSettledByTrampoline,
+			)
+		} else {
+			ctx.log(fmt.Sprintf("trampoline htlc failed: %v",
+				result.FailureCode))
+
+			failResolution := NewFailResolution(
+				key, ctx.currentHeight, ResultTrampolineFailed,
+			)
+			failResolution.FailureCode = result.FailureCode
+			resolution = failResolution
+		}
+
+		i.notifyHodlSubscribers(resolution)
+	}()
+
+	return nil, nil
+}
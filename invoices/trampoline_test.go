@@ -0,0 +1,145 @@
+package invoices
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Actinium-project/lnd/lnwire"
+)
+
+// mockTrampolineForwarder passes the requests it receives on to the test and
+// returns the resolutions the test supplies.
+type mockTrampolineForwarder struct {
+	requests    chan *TrampolineRequest
+	resolutions chan *TrampolineResolution
+}
+
+func (f *mockTrampolineForwarder) ForwardTrampoline(
+	req *TrampolineRequest) *TrampolineResolution {
+
+	f.requests <- req
+	return <-f.resolutions
+}
+
+// TestTrampolineHtlc tests that htlcs that carry a trampoline onion are handed
+// to the trampoline forwarder, and resolved with the outcome of the forward.
+func TestTrampolineHtlc(t *testing.T) {
+	defer timeout()()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	payload := &mockPayload{
+		trampolineOnion: []byte{1, 2, 3},
+	}
+
+	notify := func(htlcID uint64,
+		hodlChan chan interface{}) HtlcResolution {
+
+		resolution, err := ctx.registry.NotifyExitHopHtlc(
+			testInvoicePaymentHash, testInvoiceAmount,
+			testHtlcExpiry, testCurrentHeight,
+			getCircuitKey(htlcID), hodlChan, payload,
+		)
+		if err != nil {
+			t.Fatalf("unable to notify htlc: %v", err)
+		}
+
+		return resolution
+	}
+
+	// Without a forwarder, trampoline htlcs are rejected.
+	hodlChan := make(chan interface{}, 1)
+	resolution := notify(0, hodlChan)
+	failResolution, ok := resolution.(*HtlcFailResolution)
+	if !ok {
+		t.Fatalf("expected fail resolution, got: %T", resolution)
+	}
+	if failResolution.Outcome != ResultTrampolineDisabled {
+		t.Fatalf("unexpected outcome %v", failResolution.Outcome)
+	}
+
+	forwarder := &mockTrampolineForwarder{
+		requests:    make(chan *TrampolineRequest),
+		resolutions: make(chan *TrampolineResolution),
+	}
+	ctx.registry.cfg.TrampolineForwarder = forwarder
+
+	// The htlc is held while its payment is forwarded.
+	if resolution := notify(1, hodlChan); resolution != nil {
+		t.Fatalf("expected htlc to be held, got %v", resolution)
+	}
+
+	req := <-forwarder.requests
+	if req.CircuitKey != getCircuitKey(1) ||
+		req.Amount != testInvoiceAmount ||
+		string(req.Onion) != string(payload.trampolineOnion) {
+
+		t.Fatalf("unexpected request %v", req)
+	}
+
+	// A replayed htlc isn't forwarded again, but its new subscriber is
+	// notified of the outcome as well.
+	replayChan := make(chan interface{}, 1)
+	if resolution := notify(1, replayChan); resolution != nil {
+		t.Fatalf("expected htlc to be held, got %v", resolution)
+	}
+
+	preimage := testInvoicePreimage
+	forwarder.resolutions <- &TrampolineResolution{
+		Preimage: &preimage,
+	}
+
+	for _, c := range []chan interface{}{hodlChan, replayChan} {
+		settleResolution, ok := (<-c).(*HtlcSettleResolution)
+		if !ok {
+			t.Fatalf("expected settle resolution")
+		}
+		if settleResolution.Outcome != ResultSettledByTrampoline ||
+			settleResolution.Preimage != testInvoicePreimage {
+
+			t.Fatalf("unexpected settle resolution %v",
+				settleResolution)
+		}
+	}
+
+	// A failed forward fails the htlc with the failure code of the
+	// forwarder.
+	notify(2, hodlChan)
+	<-forwarder.requests
+	forwarder.resolutions <- &TrampolineResolution{
+		FailureCode: lnwire.CodeTemporaryNodeFailure,
+	}
+
+	failResolution, ok = (<-hodlChan).(*HtlcFailResolution)
+	if !ok {
+		t.Fatalf("expected fail resolution")
+	}
+	if failResolution.Outcome != ResultTrampolineFailed ||
+		failResolution.FailureCode != lnwire.CodeTemporaryNodeFailure {
+
+		t.Fatalf("unexpected fail resolution %v", failResolution)
+	}
+
+	// If the forwarder shuts down, the htlc remains unresolved and is
+	// forwarded again when it is replayed.
+	notify(3, hodlChan)
+	<-forwarder.requests
+	forwarder.resolutions <- nil
+
+	select {
+	case resolution := <-hodlChan:
+		t.Fatalf("unexpected resolution %v", resolution)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	notify(3, hodlChan)
+	req = <-forwarder.requests
+	if req.CircuitKey != getCircuitKey(3) {
+		t.Fatalf("unexpected request %v", req)
+	}
+	forwarder.resolutions <- &TrampolineResolution{
+		FailureCode: lnwire.CodeTemporaryNodeFailure,
+	}
+	<-hodlChan
+}
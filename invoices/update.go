@@ -3,6 +3,7 @@ package invoices
 import (
 	"errors"
 
+	"github.com/Actinium-project/lnd/amp"
 	"github.com/Actinium-project/lnd/channeldb"
 	"github.com/Actinium-project/lnd/lntypes"
 	"github.com/Actinium-project/lnd/lnwire"
@@ -13,6 +14,7 @@ import (
 // update to be carried out.
 type invoiceUpdateCtx struct {
 	hash                 lntypes.Hash
+	htlcHash             lntypes.Hash
 	circuitKey           channeldb.CircuitKey
 	amtPaid              lnwire.MilliSatoshi
 	expiry               uint32
@@ -20,12 +22,14 @@ type invoiceUpdateCtx struct {
 	finalCltvRejectDelta int32
 	customRecords        record.CustomSet
 	mpp                  *record.MPP
+	amp                  *record.AMP
 }
 
 // log logs a message specific to this update context.
 func (i *invoiceUpdateCtx) log(s string) {
-	log.Debugf("Invoice(%x): %v, amt=%v, expiry=%v, circuit=%v, mpp=%v",
-		i.hash[:], s, i.amtPaid, i.expiry, i.circuitKey, i.mpp)
+	log.Debugf("Invoice(%x): %v, amt=%v, expiry=%v, circuit=%v, mpp=%v, "+
+		"amp=%v", i.hash[:], s, i.amtPaid, i.expiry, i.circuitKey,
+		i.mpp, i.amp)
 }
 
 // failRes is a helper function which creates a failure resolution with
@@ -53,6 +57,26 @@ func (i invoiceUpdateCtx) acceptRes(outcome acceptResolutionResult) *htlcAcceptR
 	return newAcceptResolution(i.circuitKey, outcome)
 }
 
+// paymentDesc returns the descriptor of a payment to a reusable invoice. The
+// payment consists of the htlc of this update context and all htlcs that were
+// accepted before, as these make up the complete htlc set.
+func (i invoiceUpdateCtx) paymentDesc(
+	inv *channeldb.Invoice) *channeldb.InvoicePaymentDesc {
+
+	htlcs := map[channeldb.CircuitKey]struct{}{
+		i.circuitKey: {},
+	}
+	for key, htlc := range inv.Htlcs {
+		if htlc.State == channeldb.HtlcStateAccepted {
+			htlcs[key] = struct{}{}
+		}
+	}
+
+	return &channeldb.InvoicePaymentDesc{
+		Htlcs: htlcs,
+	}
+}
+
 // updateInvoice is a callback for DB.UpdateInvoice that contains the invoice
 // settlement logic. It returns a hltc resolution that indicates what the
 // outcome of the update was.
@@ -70,9 +94,15 @@ func updateInvoice(ctx *invoiceUpdateCtx, inv *channeldb.Invoice) (
 			return nil, ctx.acceptRes(resultReplayToAccepted), nil
 
 		case channeldb.HtlcStateSettled:
+			// AMP htlcs are settled with their own preimage
+			// instead of the one of the invoice.
+			preimage := inv.Terms.PaymentPreimage
+			if htlc.AMP != nil && htlc.AMP.Preimage != nil {
+				preimage = *htlc.AMP.Preimage
+			}
+
 			return nil, ctx.settleRes(
-				inv.Terms.PaymentPreimage,
-				ResultReplayToSettled,
+				preimage, ResultReplayToSettled,
 			), nil
 
 		default:
@@ -80,13 +110,190 @@ func updateInvoice(ctx *invoiceUpdateCtx, inv *channeldb.Invoice) (
 		}
 	}
 
-	if ctx.mpp == nil {
+	switch {
+	case ctx.amp != nil:
+		return updateAMP(ctx, inv)
+
+	// Invoices that require AMP don't reveal their preimage to htlcs that
+	// pay to the invoice hash directly. Reusable invoices are only paid
+	// with AMP as well, because every payment needs its own preimages.
+	case inv.Terms.Features.IsSet(lnwire.AMPRequired) || inv.Reusable:
+		return nil, ctx.failRes(ResultAmpRequired), nil
+
+	case ctx.mpp == nil:
 		return updateLegacy(ctx, inv)
 	}
 
 	return updateMpp(ctx, inv)
 }
 
+// updateAMP is a callback for DB.UpdateInvoice that contains the invoice
+// settlement logic for atomic multi-path payments. Every htlc of such a
+// payment carries a share of a root, from which the preimages of all htlcs
+// are derived once the full set has arrived.
+func updateAMP(ctx *invoiceUpdateCtx,
+	inv *channeldb.Invoice) (*channeldb.InvoiceUpdateDesc,
+	HtlcResolution, error) {
+
+	// AMP htlcs are only valid as part of a multi-path payment.
+	if ctx.mpp == nil {
+		return nil, ctx.failRes(ResultAmpError), nil
+	}
+
+	// Only accept AMP payments to invoices that support them.
+	if !inv.Terms.Features.HasFeature(lnwire.AMPOptional) {
+		return nil, ctx.failRes(ResultAmpError), nil
+	}
+
+	// Like mpp payments, AMP payments are only accepted by open invoices.
+	if inv.State != channeldb.ContractOpen {
+		return nil, ctx.failRes(ResultInvoiceNotOpen), nil
+	}
+
+	// Check the payment address that authorizes the payment.
+	if ctx.mpp.PaymentAddr() != inv.Terms.PaymentAddr {
+		return nil, ctx.failRes(ResultAddressMismatch), nil
+	}
+
+	// Invoices of spontaneous AMP payments don't know their preimage up
+	// front. They are keyed by the set id instead, and settled with the
+	// root once the full set has arrived. Hold invoices aren't supported.
+	// Reusable invoices don't need a preimage, as every payment is settled
+	// with the preimages derived from its own root.
+	setID := ctx.amp.SetID()
+	unknownPreimage := inv.Terms.PaymentPreimage == channeldb.UnknownPreimage
+	if unknownPreimage && !inv.Reusable && lntypes.Hash(setID) != ctx.hash {
+		return nil, ctx.failRes(ResultAmpError), nil
+	}
+
+	// Don't accept zero-valued sets.
+	if ctx.mpp.TotalMsat() == 0 {
+		return nil, ctx.failRes(ResultHtlcSetTotalTooLow), nil
+	}
+
+	// Check that the total amt of the htlc set is high enough. In case this
+	// is a zero-valued invoice, it will always be enough.
+	if ctx.mpp.TotalMsat() < inv.Terms.Value {
+		return nil, ctx.failRes(ResultHtlcSetTotalTooLow), nil
+	}
+
+	// Check whether the other accepted htlcs belong to the same set, and
+	// whether their total amt matches.
+	var (
+		newSetTotal lnwire.MilliSatoshi
+		setHtlcs    = make(map[channeldb.CircuitKey]*channeldb.InvoiceHTLC)
+	)
+	for key, htlc := range inv.Htlcs {
+		if htlc.State != channeldb.HtlcStateAccepted {
+			continue
+		}
+
+		if htlc.AMP == nil || htlc.AMP.Record.SetID() != setID {
+			return nil, ctx.failRes(ResultMppInProgress), nil
+		}
+
+		if ctx.mpp.TotalMsat() != htlc.MppTotalAmt {
+			return nil, ctx.failRes(ResultHtlcSetTotalMismatch), nil
+		}
+
+		newSetTotal += htlc.Amt
+		setHtlcs[key] = htlc
+	}
+
+	// Add amount of new htlc.
+	newSetTotal += ctx.amtPaid
+
+	// Make sure the communicated set total isn't overpaid.
+	if newSetTotal > ctx.mpp.TotalMsat() {
+		return nil, ctx.failRes(ResultHtlcSetOverpayment), nil
+	}
+
+	// The invoice is still open. Check the expiry.
+	if ctx.expiry < uint32(ctx.currentHeight+ctx.finalCltvRejectDelta) {
+		return nil, ctx.failRes(ResultExpiryTooSoon), nil
+	}
+
+	if ctx.expiry < uint32(ctx.currentHeight+inv.Terms.FinalCltvDelta) {
+		return nil, ctx.failRes(ResultExpiryTooSoon), nil
+	}
+
+	// Record HTLC in the invoice database along with its AMP details.
+	update := channeldb.InvoiceUpdateDesc{
+		AddHtlcs: map[channeldb.CircuitKey]*channeldb.HtlcAcceptDesc{
+			ctx.circuitKey: {
+				Amt:           ctx.amtPaid,
+				Expiry:        ctx.expiry,
+				AcceptHeight:  ctx.currentHeight,
+				MppTotalAmt:   ctx.mpp.TotalMsat(),
+				CustomRecords: ctx.customRecords,
+				AMP: &channeldb.InvoiceHtlcAMPData{
+					Record: *ctx.amp,
+					Hash:   ctx.htlcHash,
+				},
+			},
+		},
+	}
+
+	// If the invoice cannot be settled yet, only record the htlc.
+	if newSetTotal != ctx.mpp.TotalMsat() {
+		return &update, ctx.acceptRes(resultPartialAccepted), nil
+	}
+
+	// The set is complete, so we can reconstruct the root from the shares
+	// of all htlcs. The set id commits to the root, which ensures that the
+	// sender didn't withhold any shares.
+	shares := [][32]byte{ctx.amp.RootShare()}
+	for _, htlc := range setHtlcs {
+		shares = append(shares, htlc.AMP.Record.RootShare())
+	}
+	root, err := amp.ReconstructRoot(shares)
+	if err != nil {
+		return nil, nil, err
+	}
+	if amp.SetID(root) != setID {
+		return nil, ctx.failRes(ResultAmpReconstruction), nil
+	}
+
+	// Derive the preimages of all htlcs of the set, and verify that they
+	// match the htlc hashes.
+	htlcPreimage := amp.ChildPreimage(root, ctx.amp.ChildIndex())
+	if htlcPreimage.Hash() != ctx.htlcHash {
+		return nil, ctx.failRes(ResultAmpReconstruction), nil
+	}
+	preimages := map[channeldb.CircuitKey]lntypes.Preimage{
+		ctx.circuitKey: htlcPreimage,
+	}
+	for key, htlc := range setHtlcs {
+		preimage := amp.ChildPreimage(root, htlc.AMP.Record.ChildIndex())
+		if preimage.Hash() != htlc.AMP.Hash {
+			return nil, ctx.failRes(ResultAmpReconstruction), nil
+		}
+
+		preimages[key] = preimage
+	}
+
+	update.HTLCPreimages = preimages
+
+	// A reusable invoice stays open, only the htlcs of this payment are
+	// settled.
+	if inv.Reusable {
+		update.Payment = ctx.paymentDesc(inv)
+		return &update, ctx.settleRes(htlcPreimage, ResultSettled), nil
+	}
+
+	preimage := inv.Terms.PaymentPreimage
+	if unknownPreimage {
+		preimage = lntypes.Preimage(root)
+	}
+
+	update.State = &channeldb.InvoiceStateUpdateDesc{
+		NewState: channeldb.ContractSettled,
+		Preimage: preimage,
+	}
+
+	return &update, ctx.settleRes(htlcPreimage, ResultSettled), nil
+}
+
 // updateMpp is a callback for DB.UpdateInvoice that contains the invoice
 // settlement logic for mpp payments.
 func updateMpp(ctx *invoiceUpdateCtx,
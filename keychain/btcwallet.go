@@ -91,8 +91,10 @@ func (b *BtcWalletKeyRing) keyScope() (*waddrmgr.ScopedKeyManager, error) {
 	}
 
 	// Otherwise, we'll first do a check to ensure that the root manager
-	// isn't locked, as otherwise we won't be able to *use* the scope.
-	if b.wallet.Manager.IsLocked() {
+	// isn't locked, as otherwise we won't be able to *use* the scope. A
+	// watch-only manager can't be unlocked, but it can still be used to
+	// derive public keys.
+	if b.wallet.Manager.IsLocked() && !b.wallet.Manager.WatchOnly() {
 		return nil, fmt.Errorf("cannot create BtcWalletKeyRing with " +
 			"locked waddrmgr.Manager")
 	}
@@ -371,3 +373,40 @@ func (b *BtcWalletKeyRing) ScalarMult(keyDesc KeyDescriptor,
 
 	return h[:], nil
 }
+
+// SignMessage signs the sha256 of the message with the private key of the key
+// locator, or its double sha256 if doubleHash is true.
+//
+// NOTE: This is part of the keychain.MessageSignerRing interface.
+func (b *BtcWalletKeyRing) SignMessage(keyLoc KeyLocator, msg []byte,
+	doubleHash bool) (*btcec.Signature, error) {
+
+	privKey, err := b.DerivePrivKey(KeyDescriptor{
+		KeyLocator: keyLoc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return privKey.Sign(messageDigest(msg, doubleHash))
+}
+
+// SignMessageCompact signs the sha256 of the message with the private key of
+// the key locator, or its double sha256 if doubleHash is true. The returned
+// signature is a pubkey-recoverable signature.
+//
+// NOTE: This is part of the keychain.MessageSignerRing interface.
+func (b *BtcWalletKeyRing) SignMessageCompact(keyLoc KeyLocator, msg []byte,
+	doubleHash bool) ([]byte, error) {
+
+	privKey, err := b.DerivePrivKey(KeyDescriptor{
+		KeyLocator: keyLoc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return btcec.SignCompact(
+		btcec.S256(), privKey, messageDigest(msg, doubleHash), true,
+	)
+}
@@ -183,15 +183,13 @@ type SecretKeyRing interface {
 	// the KeyFamily within the partially populated KeyLocator.
 	DerivePrivKey(keyDesc KeyDescriptor) (*btcec.PrivateKey, error)
 
-	// ScalarMult performs a scalar multiplication (ECDH-like operation)
-	// between the target key descriptor and remote public key. The output
-	// returned will be the sha256 of the resulting shared point serialized
-	// in compressed format. If k is our private key, and P is the public
-	// key, we perform the following operation:
-	//
-	//  sx := k*P
-	//  s := sha256(sx.SerializeCompressed())
-	ScalarMult(keyDesc KeyDescriptor, pubKey *btcec.PublicKey) ([]byte, error)
+	// ECDHRing allows ECDH operations with the private keys of the key
+	// ring, without exposing them.
+	ECDHRing
+
+	// MessageSignerRing allows messages to be signed with the private keys
+	// of the key ring, without exposing them.
+	MessageSignerRing
 }
 
 // TODO(roasbeef): extend to actually support scalar mult of key?
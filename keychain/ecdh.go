@@ -0,0 +1,113 @@
+package keychain
+
+import (
+	"crypto/sha256"
+
+	"github.com/Actinium-project/acmd/btcec"
+)
+
+// ECDHRing is an interface that abstracts the ability to perform an ECDH
+// operation with the private key of a key descriptor, without handing out the
+// private key itself.
+type ECDHRing interface {
+	// ScalarMult performs a scalar multiplication (ECDH-like operation)
+	// between the target key descriptor and remote public key. The output
+	// returned will be the sha256 of the resulting shared point serialized
+	// in compressed format. If k is our private key, and P is the public
+	// key, we perform the following operation:
+	//
+	//  sx := k*P
+	//  s := sha256(sx.SerializeCompressed())
+	ScalarMult(keyDesc KeyDescriptor, pubKey *btcec.PublicKey) ([]byte, error)
+}
+
+// SingleKeyECDH is an abstraction interface that hides the implementation of
+// an ECDH operation against a specific private key. We use this abstraction
+// for the long term keys which we eventually want to be able to keep in a
+// remote signer, such as the node identity key.
+type SingleKeyECDH interface {
+	// PubKey returns the public key of the private key that is abstracted
+	// away by the interface.
+	PubKey() *btcec.PublicKey
+
+	// ECDH performs a scalar multiplication (ECDH-like operation) between
+	// the abstracted private key and a remote public key. The output
+	// returned will be the sha256 of the resulting shared point serialized
+	// in compressed format.
+	ECDH(pubKey *btcec.PublicKey) ([32]byte, error)
+}
+
+// PrivKeyECDH is an implementation of the SingleKeyECDH interface backed by a
+// private key that is held in memory.
+type PrivKeyECDH struct {
+	// PrivKey is the private key that is used for the ECDH operation.
+	PrivKey *btcec.PrivateKey
+}
+
+// A compile time check to ensure PrivKeyECDH implements the SingleKeyECDH
+// interface.
+var _ SingleKeyECDH = (*PrivKeyECDH)(nil)
+
+// PubKey returns the public key of the private key.
+//
+// NOTE: This is part of the SingleKeyECDH interface.
+func (p *PrivKeyECDH) PubKey() *btcec.PublicKey {
+	return p.PrivKey.PubKey()
+}
+
+// ECDH performs a scalar multiplication between the private key and the remote
+// public key, and returns the sha256 of the compressed shared point.
+//
+// NOTE: This is part of the SingleKeyECDH interface.
+func (p *PrivKeyECDH) ECDH(pub *btcec.PublicKey) ([32]byte, error) {
+	s := &btcec.PublicKey{}
+	s.X, s.Y = btcec.S256().ScalarMult(pub.X, pub.Y, p.PrivKey.D.Bytes())
+
+	return sha256.Sum256(s.SerializeCompressed()), nil
+}
+
+// PubKeyECDH is an implementation of the SingleKeyECDH interface that performs
+// the ECDH operation with the private key of a key descriptor through an
+// ECDHRing. The private key never leaves the key ring, which may be a remote
+// signer.
+type PubKeyECDH struct {
+	keyDesc KeyDescriptor
+	ecdh    ECDHRing
+}
+
+// A compile time check to ensure PubKeyECDH implements the SingleKeyECDH
+// interface.
+var _ SingleKeyECDH = (*PubKeyECDH)(nil)
+
+// NewPubKeyECDH creates a new SingleKeyECDH for the key descriptor, which must
+// have both its public key and key locator set.
+func NewPubKeyECDH(keyDesc KeyDescriptor, ecdh ECDHRing) *PubKeyECDH {
+	return &PubKeyECDH{
+		keyDesc: keyDesc,
+		ecdh:    ecdh,
+	}
+}
+
+// PubKey returns the public key of the key descriptor.
+//
+// NOTE: This is part of the SingleKeyECDH interface.
+func (p *PubKeyECDH) PubKey() *btcec.PublicKey {
+	return p.keyDesc.PubKey
+}
+
+// ECDH performs a scalar multiplication between the private key of the key
+// descriptor and the remote public key through the key ring, and returns the
+// sha256 of the compressed shared point.
+//
+// NOTE: This is part of the SingleKeyECDH interface.
+func (p *PubKeyECDH) ECDH(pub *btcec.PublicKey) ([32]byte, error) {
+	var shared [32]byte
+
+	secret, err := p.ecdh.ScalarMult(p.keyDesc, pub)
+	if err != nil {
+		return shared, err
+	}
+	copy(shared[:], secret)
+
+	return shared, nil
+}
@@ -1,6 +1,7 @@
 package keychain
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
@@ -371,6 +372,13 @@ func TestSecretKeyRingDerivation(t *testing.T) {
 						privKey.PubKey().SerializeCompressed())
 				}
 
+				// ECDH operations and signatures through the
+				// key ring must match those made with the
+				// private key itself.
+				assertSingleKeyOps(
+					t, pubKeyDesc, secretKeyRing, privKey,
+				)
+
 				// Next, we'll test that we're able to derive a
 				// key given only the public key and key
 				// family.
@@ -431,6 +439,77 @@ func TestSecretKeyRingDerivation(t *testing.T) {
 	}
 }
 
+// assertSingleKeyOps asserts that the ECDH operations and message signatures
+// made through the key ring with the given key descriptor match those made
+// with its private key.
+func assertSingleKeyOps(t *testing.T, keyDesc KeyDescriptor,
+	keyRing SecretKeyRing, privKey *btcec.PrivateKey) {
+
+	t.Helper()
+
+	remoteKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	ringECDH := NewPubKeyECDH(keyDesc, keyRing)
+	privECDH := &PrivKeyECDH{PrivKey: privKey}
+
+	ringSecret, err := ringECDH.ECDH(remoteKey.PubKey())
+	if err != nil {
+		t.Fatalf("unable to perform ecdh: %v", err)
+	}
+	privSecret, err := privECDH.ECDH(remoteKey.PubKey())
+	if err != nil {
+		t.Fatalf("unable to perform ecdh: %v", err)
+	}
+	if ringSecret != privSecret {
+		t.Fatalf("shared secrets mismatched: expected %x, got %x",
+			privSecret, ringSecret)
+	}
+
+	ringSigner := NewPubKeyMessageSigner(keyDesc, keyRing)
+	privSigner := &PrivKeyMessageSigner{PrivKey: privKey}
+
+	msg := []byte("test message")
+	for _, doubleHash := range []bool{false, true} {
+		digest := messageDigest(msg, doubleHash)
+
+		sig, err := ringSigner.SignMessage(msg, doubleHash)
+		if err != nil {
+			t.Fatalf("unable to sign message: %v", err)
+		}
+		if !sig.Verify(digest, privKey.PubKey()) {
+			t.Fatalf("invalid signature (double hash=%v)",
+				doubleHash)
+		}
+
+		ringSig, err := ringSigner.SignMessageCompact(msg, doubleHash)
+		if err != nil {
+			t.Fatalf("unable to sign message: %v", err)
+		}
+		privSig, err := privSigner.SignMessageCompact(msg, doubleHash)
+		if err != nil {
+			t.Fatalf("unable to sign message: %v", err)
+		}
+		if !bytes.Equal(ringSig, privSig) {
+			t.Fatalf("compact signatures mismatched (double "+
+				"hash=%v)", doubleHash)
+		}
+
+		pubKey, _, err := btcec.RecoverCompact(
+			btcec.S256(), ringSig, digest,
+		)
+		if err != nil {
+			t.Fatalf("unable to recover key: %v", err)
+		}
+		if !pubKey.IsEqual(keyDesc.PubKey) {
+			t.Fatalf("recovered wrong key (double hash=%v)",
+				doubleHash)
+		}
+	}
+}
+
 func init() {
 	// We'll clamp the max range scan to constrain the run time of the
 	// private key scan test.
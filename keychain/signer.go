@@ -0,0 +1,147 @@
+package keychain
+
+import (
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+)
+
+// MessageSignerRing is an interface that abstracts the ability to sign
+// messages with the private key of a key locator, without handing out the
+// private key itself.
+type MessageSignerRing interface {
+	// SignMessage signs the sha256 of the message with the private key of
+	// the key locator, or its double sha256 if doubleHash is true.
+	SignMessage(keyLoc KeyLocator, msg []byte,
+		doubleHash bool) (*btcec.Signature, error)
+
+	// SignMessageCompact signs the sha256 of the message with the private
+	// key of the key locator, or its double sha256 if doubleHash is true.
+	// The returned signature is a pubkey-recoverable signature in the
+	// format returned by btcec.SignCompact.
+	SignMessageCompact(keyLoc KeyLocator, msg []byte,
+		doubleHash bool) ([]byte, error)
+}
+
+// SingleKeyMessageSigner is an abstraction interface that hides the
+// implementation of message signing with a specific private key. We use this
+// abstraction for the long term keys which we eventually want to be able to
+// keep in a remote signer, such as the node identity key.
+type SingleKeyMessageSigner interface {
+	// PubKey returns the public key of the private key that is abstracted
+	// away by the interface.
+	PubKey() *btcec.PublicKey
+
+	// SignMessage signs the sha256 of the message with the abstracted
+	// private key, or its double sha256 if doubleHash is true.
+	SignMessage(msg []byte, doubleHash bool) (*btcec.Signature, error)
+
+	// SignMessageCompact signs the sha256 of the message with the
+	// abstracted private key, or its double sha256 if doubleHash is true.
+	// The returned signature is a pubkey-recoverable signature.
+	SignMessageCompact(msg []byte, doubleHash bool) ([]byte, error)
+}
+
+// PrivKeyMessageSigner is an implementation of the SingleKeyMessageSigner
+// interface backed by a private key that is held in memory.
+type PrivKeyMessageSigner struct {
+	// PrivKey is the private key that signs the messages.
+	PrivKey *btcec.PrivateKey
+}
+
+// A compile time check to ensure PrivKeyMessageSigner implements the
+// SingleKeyMessageSigner interface.
+var _ SingleKeyMessageSigner = (*PrivKeyMessageSigner)(nil)
+
+// PubKey returns the public key of the private key.
+//
+// NOTE: This is part of the SingleKeyMessageSigner interface.
+func (p *PrivKeyMessageSigner) PubKey() *btcec.PublicKey {
+	return p.PrivKey.PubKey()
+}
+
+// SignMessage signs the sha256 of the message with the private key, or its
+// double sha256 if doubleHash is true.
+//
+// NOTE: This is part of the SingleKeyMessageSigner interface.
+func (p *PrivKeyMessageSigner) SignMessage(msg []byte,
+	doubleHash bool) (*btcec.Signature, error) {
+
+	return p.PrivKey.Sign(messageDigest(msg, doubleHash))
+}
+
+// SignMessageCompact signs the sha256 of the message with the private key, or
+// its double sha256 if doubleHash is true. The returned signature is a
+// pubkey-recoverable signature.
+//
+// NOTE: This is part of the SingleKeyMessageSigner interface.
+func (p *PrivKeyMessageSigner) SignMessageCompact(msg []byte,
+	doubleHash bool) ([]byte, error) {
+
+	return btcec.SignCompact(
+		btcec.S256(), p.PrivKey, messageDigest(msg, doubleHash), true,
+	)
+}
+
+// PubKeyMessageSigner is an implementation of the SingleKeyMessageSigner
+// interface that signs messages with the private key of a key descriptor
+// through a MessageSignerRing. The private key never leaves the key ring,
+// which may be a remote signer.
+type PubKeyMessageSigner struct {
+	keyDesc KeyDescriptor
+	signer  MessageSignerRing
+}
+
+// A compile time check to ensure PubKeyMessageSigner implements the
+// SingleKeyMessageSigner interface.
+var _ SingleKeyMessageSigner = (*PubKeyMessageSigner)(nil)
+
+// NewPubKeyMessageSigner creates a new SingleKeyMessageSigner for the key
+// descriptor, which must have both its public key and key locator set.
+func NewPubKeyMessageSigner(keyDesc KeyDescriptor,
+	signer MessageSignerRing) *PubKeyMessageSigner {
+
+	return &PubKeyMessageSigner{
+		keyDesc: keyDesc,
+		signer:  signer,
+	}
+}
+
+// PubKey returns the public key of the key descriptor.
+//
+// NOTE: This is part of the SingleKeyMessageSigner interface.
+func (p *PubKeyMessageSigner) PubKey() *btcec.PublicKey {
+	return p.keyDesc.PubKey
+}
+
+// SignMessage signs the sha256 of the message with the private key of the key
+// descriptor through the key ring, or its double sha256 if doubleHash is true.
+//
+// NOTE: This is part of the SingleKeyMessageSigner interface.
+func (p *PubKeyMessageSigner) SignMessage(msg []byte,
+	doubleHash bool) (*btcec.Signature, error) {
+
+	return p.signer.SignMessage(p.keyDesc.KeyLocator, msg, doubleHash)
+}
+
+// SignMessageCompact signs the sha256 of the message with the private key of
+// the key descriptor through the key ring, or its double sha256 if doubleHash
+// is true. The returned signature is a pubkey-recoverable signature.
+//
+// NOTE: This is part of the SingleKeyMessageSigner interface.
+func (p *PubKeyMessageSigner) SignMessageCompact(msg []byte,
+	doubleHash bool) ([]byte, error) {
+
+	return p.signer.SignMessageCompact(
+		p.keyDesc.KeyLocator, msg, doubleHash,
+	)
+}
+
+// messageDigest returns the sha256 of the message, or its double sha256 if
+// doubleHash is true.
+func messageDigest(msg []byte, doubleHash bool) []byte {
+	if doubleHash {
+		return chainhash.DoubleHashB(msg)
+	}
+
+	return chainhash.HashB(msg)
+}
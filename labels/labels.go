@@ -0,0 +1,74 @@
+// Package labels contains the labels that lnd attaches to the transactions it
+// creates itself, along with validation of the labels provided by users.
+package labels
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Actinium-project/lnd/lnwire"
+)
+
+// MaxLabelLength is the maximum length of a transaction label.
+const MaxLabelLength = 500
+
+// ErrLabelTooLong is returned when a transaction label exceeds the maximum
+// length.
+var ErrLabelTooLong = fmt.Errorf("label length exceeds maximum of %v "+
+	"characters", MaxLabelLength)
+
+// ErrReservedPrefix is returned when a user provided label makes use of the
+// prefix reserved for the labels lnd creates itself.
+var ErrReservedPrefix = errors.New("labels starting with the reserved " +
+	"prefix \"" + autoLabelPrefix + "\" are not permitted")
+
+// autoLabelPrefix is the prefix of all labels that lnd creates itself. It's
+// reserved, so transactions labelled by lnd can be told apart from ones
+// labelled by the user.
+const autoLabelPrefix = "0:"
+
+// LabelType indicates the type of a transaction that lnd labels itself.
+type LabelType string
+
+const (
+	// LabelTypeChannelOpen is used to label channel funding transactions.
+	LabelTypeChannelOpen LabelType = "openchannel"
+
+	// LabelTypeChannelClose is used to label cooperative channel close
+	// transactions.
+	LabelTypeChannelClose LabelType = "closechannel"
+
+	// LabelTypeJusticeTransaction is used to label justice transactions
+	// that sweep the outputs of a breached channel.
+	LabelTypeJusticeTransaction LabelType = "justicetx"
+
+	// LabelTypeSweepTransaction is used to label sweep transactions.
+	LabelTypeSweepTransaction LabelType = "sweep"
+)
+
+// MakeLabel creates the label of a transaction of the given type. If the
+// short channel ID of the related channel is known, it's included within the
+// label.
+func MakeLabel(labelType LabelType, chanID *lnwire.ShortChannelID) string {
+	if chanID == nil {
+		return fmt.Sprintf("%v%v", autoLabelPrefix, labelType)
+	}
+
+	return fmt.Sprintf("%v%v:chan_id-%v", autoLabelPrefix, labelType,
+		chanID.ToUint64())
+}
+
+// ValidateAPI checks a label provided through the API. Empty labels are
+// permitted, as labels are optional.
+func ValidateAPI(label string) error {
+	if len(label) > MaxLabelLength {
+		return ErrLabelTooLong
+	}
+
+	if strings.HasPrefix(label, autoLabelPrefix) {
+		return ErrReservedPrefix
+	}
+
+	return nil
+}
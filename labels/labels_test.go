@@ -0,0 +1,51 @@
+package labels
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Actinium-project/lnd/lnwire"
+)
+
+// TestValidateAPI tests the validation of user provided labels.
+func TestValidateAPI(t *testing.T) {
+	t.Parallel()
+
+	chanID := lnwire.NewShortChanIDFromInt(123)
+
+	tests := []struct {
+		name  string
+		label string
+		err   error
+	}{
+		{
+			name:  "empty label",
+			label: "",
+		},
+		{
+			name:  "user label",
+			label: "coffee",
+		},
+		{
+			name:  "label too long",
+			label: strings.Repeat("a", MaxLabelLength+1),
+			err:   ErrLabelTooLong,
+		},
+		{
+			name:  "reserved prefix",
+			label: MakeLabel(LabelTypeChannelOpen, &chanID),
+			err:   ErrReservedPrefix,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateAPI(test.label)
+			if err != test.err {
+				t.Fatalf("expected: %v, got: %v", test.err, err)
+			}
+		})
+	}
+}
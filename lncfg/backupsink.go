@@ -0,0 +1,67 @@
+package lncfg
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// BackupSinks holds the configuration of the secondary locations that every
+// updated static channel backup is shipped to.
+type BackupSinks struct {
+	// Dirs is the set of directories, such as a mounted NAS, that
+	// versioned backups are written to.
+	Dirs []string `long:"dir" description:"Add a directory, such as a mounted NAS, that versioned channel backups should be written to"`
+
+	// URLs is the set of HTTP(S) base URLs that versioned backups are
+	// uploaded below using PUT requests.
+	URLs []string `long:"url" description:"Add an HTTP(S) base URL that versioned channel backups should be uploaded below using PUT requests"`
+
+	// MaxVersions is the number of backup versions each sink retains.
+	MaxVersions int `long:"maxversions" description:"The number of backup versions each sink should retain before pruning the oldest one"`
+
+	// Timeout is the timeout of a single HTTP request.
+	Timeout time.Duration `long:"timeout" description:"The timeout of a single request to an HTTP backup sink. Valid time units are {s, m, h}."`
+
+	// RetryInterval is the interval after which a failed push is retried.
+	RetryInterval time.Duration `long:"retryinterval" description:"The interval after which a failed push to a backup sink is retried. Valid time units are {s, m, h}."`
+}
+
+// Active returns true if at least one backup sink has been configured.
+func (b *BackupSinks) Active() bool {
+	return len(b.Dirs) != 0 || len(b.URLs) != 0
+}
+
+// Validate checks the BackupSinks configuration for sane values.
+func (b *BackupSinks) Validate() error {
+	if b.MaxVersions <= 0 {
+		return fmt.Errorf("number of backup versions (%d) must be "+
+			"positive", b.MaxVersions)
+	}
+	if b.Timeout <= 0 {
+		return fmt.Errorf("backup sink timeout (%v) must be positive",
+			b.Timeout)
+	}
+	if b.RetryInterval <= 0 {
+		return fmt.Errorf("backup sink retry interval (%v) must be "+
+			"positive", b.RetryInterval)
+	}
+
+	for _, rawURL := range b.URLs {
+		sinkURL, err := url.Parse(rawURL)
+		if err != nil {
+			return fmt.Errorf("invalid backup sink url %v: %v",
+				rawURL, err)
+		}
+		if sinkURL.Scheme != "http" && sinkURL.Scheme != "https" {
+			return fmt.Errorf("backup sink url %v must use http "+
+				"or https", rawURL)
+		}
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure BackupSinks implements the Validator
+// interface.
+var _ Validator = (*BackupSinks)(nil)
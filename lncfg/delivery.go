@@ -0,0 +1,102 @@
+package lncfg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/acmd/wire"
+)
+
+// Delivery holds the configuration of the destination the funds of closed
+// channels are sent to. If neither an address nor an
+// extended public key is set, a fresh address of the wallet is used.
+type Delivery struct {
+	// Address is a fixed address that the funds of all closed channels are
+	// sent to.
+	Address string `long:"address" description:"An address to send the funds of all cooperatively closed channels to, as well as the channel outputs swept after force closes and breaches. Must be a P2PKH, P2SH, P2WKH or P2WSH address. Scripts requested when closing a channel and upfront shutdown scripts take precedence"`
+
+	// XPub is an account level extended public key that a fresh P2WKH
+	// address is derived from for every destination that's needed.
+	XPub string `long:"xpub" description:"An account level BIP32 extended public key to derive a fresh P2WKH address from for every destination that's needed, from its external branch. This is an alternative to address that avoids address reuse"`
+
+	// Channels holds the delivery addresses of single channels, formatted
+	// as <funding txid>:<output index>,<address>.
+	Channels []string `long:"channel" description:"The address to send the funds of a single channel to when it's cooperatively closed, as <funding txid>:<output index>,<address>. Takes precedence over address and xpub for that channel. Can be set multiple times"`
+}
+
+// Validate checks the Delivery configuration for sane values. The addresses
+// and extended public key are only parsed once the active network is known.
+func (d *Delivery) Validate() error {
+	if d.Address != "" && d.XPub != "" {
+		return fmt.Errorf("only one of delivery.address and " +
+			"delivery.xpub can be set")
+	}
+
+	_, err := d.ChannelAddresses()
+	return err
+}
+
+// Enabled returns true if the funds of cooperatively closed channels should
+// be sent to an external destination.
+func (d *Delivery) Enabled() bool {
+	return d.Address != "" || d.XPub != "" || len(d.Channels) > 0
+}
+
+// ChannelAddresses parses the delivery addresses of single channels, and
+// returns them by the channel point of their channel.
+func (d *Delivery) ChannelAddresses() (map[wire.OutPoint]string, error) {
+	addrs := make(map[wire.OutPoint]string, len(d.Channels))
+	for _, channel := range d.Channels {
+		parts := strings.Split(channel, ",")
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid delivery.channel %v, "+
+				"expected <funding txid>:<output index>,"+
+				"<address>", channel)
+		}
+
+		chanPoint, err := parseChanPoint(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid delivery.channel %v: "+
+				"%v", channel, err)
+		}
+		if _, ok := addrs[*chanPoint]; ok {
+			return nil, fmt.Errorf("duplicate delivery.channel "+
+				"for channel %v", chanPoint)
+		}
+
+		addrs[*chanPoint] = parts[1]
+	}
+
+	return addrs, nil
+}
+
+// parseChanPoint parses a channel point formatted as
+// <funding txid>:<output index>.
+func parseChanPoint(s string) (*wire.OutPoint, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("channel point must be formatted as " +
+			"<funding txid>:<output index>")
+	}
+
+	if len(parts[0]) != chainhash.MaxHashStringSize {
+		return nil, fmt.Errorf("funding txid must be %v hex "+
+			"characters", chainhash.MaxHashStringSize)
+	}
+	txid, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid funding txid: %v", err)
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output index: %v", err)
+	}
+
+	return wire.NewOutPoint(txid, uint32(index)), nil
+}
+
+// Compile-time constraint to ensure Delivery implements the Validator
+// interface.
+var _ Validator = (*Delivery)(nil)
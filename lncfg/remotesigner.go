@@ -0,0 +1,60 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultRemoteSignerRPCTimeout is the default timeout of a single
+	// request to the remote signer.
+	DefaultRemoteSignerRPCTimeout = 5 * time.Second
+)
+
+// RemoteSigner holds the configuration of the remote signer that a watch-only
+// node forwards all of its signing operations to.
+type RemoteSigner struct {
+	// Enable turns on remote signing. The wallet of the node must then be a
+	// watch-only copy of the wallet of the remote signer.
+	Enable bool `long:"enable" description:"Use a remote signer for all signing operations. The wallet of this node must be a watch-only copy of the wallet of the remote signer, as exported through its ExportWatchOnlyWallet RPC"`
+
+	// RPCHost is the host:port of the gRPC interface of the remote signer.
+	RPCHost string `long:"rpchost" description:"The remote signer's RPC host:port"`
+
+	// MacaroonPath is the path of the macaroon used to authenticate to the
+	// remote signer.
+	MacaroonPath string `long:"macaroonpath" description:"The macaroon to use for authenticating with the remote signer, which must grant the signer:generate permission"`
+
+	// TLSCertPath is the path of the TLS certificate of the remote signer.
+	TLSCertPath string `long:"tlscertpath" description:"The TLS certificate to use for establishing the remote signer's identity"`
+
+	// Timeout is the timeout of a single request to the remote signer.
+	Timeout time.Duration `long:"timeout" description:"The timeout of a single request to the remote signer. Valid time units are {ms, s, m, h}."`
+}
+
+// Validate checks the RemoteSigner configuration for sane values.
+func (r *RemoteSigner) Validate() error {
+	if !r.Enable {
+		return nil
+	}
+
+	if r.RPCHost == "" {
+		return fmt.Errorf("remote signer rpchost must be set")
+	}
+	if r.MacaroonPath == "" {
+		return fmt.Errorf("remote signer macaroonpath must be set")
+	}
+	if r.TLSCertPath == "" {
+		return fmt.Errorf("remote signer tlscertpath must be set")
+	}
+	if r.Timeout <= 0 {
+		return fmt.Errorf("remote signer timeout (%v) must be "+
+			"positive", r.Timeout)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure RemoteSigner implements the Validator
+// interface.
+var _ Validator = (*RemoteSigner)(nil)
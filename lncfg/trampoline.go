@@ -0,0 +1,71 @@
+package lncfg
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/Actinium-project/acmd/btcec"
+)
+
+const (
+	// DefaultTrampolineFeeBaseMSat is the default base fee that is paid to
+	// the trampoline node for a payment sent in trampoline mode.
+	DefaultTrampolineFeeBaseMSat = 1000
+
+	// DefaultTrampolineFeeRate is the default fee rate in parts per
+	// million that is paid to the trampoline node for a payment sent in
+	// trampoline mode.
+	DefaultTrampolineFeeRate = 5000
+
+	// DefaultTrampolineCltvDelta is the default number of blocks that are
+	// granted to the trampoline node for routing a payment sent in
+	// trampoline mode.
+	DefaultTrampolineCltvDelta = 288
+)
+
+// Trampoline holds the configuration of trampoline routing. Routing nodes can
+// act as trampoline nodes, which find routes on behalf of light clients. Light
+// clients route their payments through a trampoline node, so that they don't
+// need to know the full graph.
+type Trampoline struct {
+	// Active turns on forwarding of trampoline payments.
+	Active bool `long:"active" description:"Act as a trampoline node. Htlcs that carry a trampoline onion are forwarded along a route that this node finds to the next trampoline node or the destination"`
+
+	// Node is the public key of the trampoline node payments are routed
+	// through in trampoline mode.
+	Node string `long:"node" description:"The public key of the trampoline node that payments sent in trampoline mode are routed through"`
+
+	// FeeBaseMSat is the base fee paid to the trampoline node.
+	FeeBaseMSat uint64 `long:"feebasemsat" description:"The base fee in millisatoshi that is paid to the trampoline node for a payment sent in trampoline mode. The fee is paid from the fee limit of the payment"`
+
+	// FeeRate is the fee rate paid to the trampoline node.
+	FeeRate uint64 `long:"feerate" description:"The fee rate in parts per million of the amount that is paid to the trampoline node for a payment sent in trampoline mode"`
+
+	// CltvDelta is the number of blocks granted to the trampoline node.
+	CltvDelta uint16 `long:"cltvdelta" description:"The number of blocks that are granted to the trampoline node for routing a payment sent in trampoline mode, on top of the final cltv delta of the payment"`
+}
+
+// Validate checks the Trampoline configuration for sane values.
+func (t *Trampoline) Validate() error {
+	if t.Node == "" {
+		return nil
+	}
+
+	nodeKey, err := hex.DecodeString(t.Node)
+	if err != nil {
+		return fmt.Errorf("invalid trampoline node: %v", err)
+	}
+	if _, err := btcec.ParsePubKey(nodeKey, btcec.S256()); err != nil {
+		return fmt.Errorf("invalid trampoline node: %v", err)
+	}
+
+	if t.CltvDelta == 0 {
+		return fmt.Errorf("trampoline cltvdelta must be positive")
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Trampoline implements the Validator
+// interface.
+var _ Validator = (*Trampoline)(nil)
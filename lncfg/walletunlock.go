@@ -0,0 +1,54 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultWalletUnlockCommandTimeout is the default time the password
+	// command is given to print the wallet password.
+	DefaultWalletUnlockCommandTimeout = 30 * time.Second
+)
+
+// WalletUnlock holds the configuration of the sources the wallet password is
+// read from to unlock the wallet at startup, without waiting for the
+// UnlockWallet RPC.
+type WalletUnlock struct {
+	// PasswordFile is the path of a file that contains the wallet
+	// password.
+	PasswordFile string `long:"password-file" description:"The full path to a file that contains the wallet password, which is used to unlock the wallet at startup. The file must only be accessible by its owner. Trailing newlines are ignored"`
+
+	// PasswordCommand is a command that prints the wallet password to its
+	// standard output.
+	PasswordCommand string `long:"password-command" description:"A command that prints the wallet password to its standard output, which is used to unlock the wallet at startup, for example the client of a local secrets agent. The command is run without a shell, and its executable must not be writable by group or others. Trailing newlines are ignored"`
+
+	// CommandTimeout is the time the password command is given to print
+	// the wallet password.
+	CommandTimeout time.Duration `long:"command-timeout" description:"The time the password command is given to print the wallet password. Valid time units are {ms, s, m, h}."`
+}
+
+// Validate checks the WalletUnlock configuration for sane values.
+func (w *WalletUnlock) Validate() error {
+	if w.PasswordFile != "" && w.PasswordCommand != "" {
+		return fmt.Errorf("only one of walletunlock.password-file and " +
+			"walletunlock.password-command can be set")
+	}
+
+	if w.PasswordCommand != "" && w.CommandTimeout <= 0 {
+		return fmt.Errorf("walletunlock command-timeout (%v) must be "+
+			"positive", w.CommandTimeout)
+	}
+
+	return nil
+}
+
+// Enabled returns true if the wallet should be unlocked automatically at
+// startup.
+func (w *WalletUnlock) Enabled() bool {
+	return w.PasswordFile != "" || w.PasswordCommand != ""
+}
+
+// Compile-time constraint to ensure WalletUnlock implements the Validator
+// interface.
+var _ Validator = (*WalletUnlock)(nil)
@@ -27,7 +27,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
-	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/wire"
 	"github.com/Actinium-project/acmutil"
 	"github.com/Actinium-project/acmwallet/wallet"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -353,11 +353,46 @@ func Main(lisCfg ListenerCfg) error {
 		return getListeners()
 	}
 
+	// The wallet unlocker service is kept running until we've sent the
+	// admin macaroon back to the client, but we'll make sure it's shut
+	// down if we exit early.
+	shutdownUnlocker := func() {}
+	defer func() {
+		shutdownUnlocker()
+	}()
+
 	// We wait until the user provides a password over RPC. In case lnd is
 	// started with the --noseedbackup flag, we use the default password
 	// for wallet encryption.
-	if !cfg.NoSeedBackup {
-		params, err := waitForWalletPassword(
+	// If a password source is configured, the wallet is unlocked with it
+	// right away instead, without serving the wallet unlocker at all. A
+	// wallet that doesn't exist yet still has to be created through the
+	// wallet unlocker, after which it is unlocked automatically on every
+	// later start.
+	var walletUnlocked bool
+	if !cfg.NoSeedBackup && cfg.WalletUnlock.Enabled() {
+		params, err := autoUnlockWallet()
+		switch {
+		case err == walletunlocker.ErrWalletNotFound:
+			ltndLog.Infof("No wallet found to unlock automatically, " +
+				"waiting for it to be created")
+
+		case err != nil:
+			err := fmt.Errorf("Unable to unlock wallet "+
+				"automatically: %v", err)
+			ltndLog.Error(err)
+			return err
+
+		default:
+			walletInitParams = *params
+			privateWalletPw = walletInitParams.Password
+			publicWalletPw = walletInitParams.Password
+			walletUnlocked = true
+		}
+	}
+
+	if !cfg.NoSeedBackup && !walletUnlocked {
+		params, shutdown, err := waitForWalletPassword(
 			cfg.RESTListeners, serverOpts, restDialOpts,
 			restProxyDest, tlsCfg, walletUnlockerListeners,
 		)
@@ -368,6 +403,7 @@ func Main(lisCfg ListenerCfg) error {
 			return err
 		}
 
+		shutdownUnlocker = shutdown
 		walletInitParams = *params
 		privateWalletPw = walletInitParams.Password
 		publicWalletPw = walletInitParams.Password
@@ -401,8 +437,11 @@ func Main(lisCfg ListenerCfg) error {
 			return err
 		}
 
-		// Create macaroon files for lncli to use if they don't exist.
-		if !fileExists(cfg.AdminMacPath) && !fileExists(cfg.ReadMacPath) &&
+		// Create macaroon files for lncli to use if they don't exist,
+		// unless the user requested a stateless initialization.
+		if !walletInitParams.StatelessInit &&
+			!fileExists(cfg.AdminMacPath) &&
+			!fileExists(cfg.ReadMacPath) &&
 			!fileExists(cfg.InvoiceMacPath) {
 
 			err = genMacaroons(
@@ -416,8 +455,59 @@ func Main(lisCfg ListenerCfg) error {
 				return err
 			}
 		}
+
+		// As a security service to the user, if they requested a
+		// stateless initialization and there are macaroon files on
+		// disk, we'll log a warning.
+		if walletInitParams.StatelessInit {
+			macPaths := []string{
+				cfg.AdminMacPath, cfg.ReadMacPath,
+				cfg.InvoiceMacPath,
+			}
+			for _, macPath := range macPaths {
+				if !fileExists(macPath) {
+					continue
+				}
+
+				ltndLog.Warnf("Found macaroon file %v on disk "+
+					"even though a stateless initialization "+
+					"was requested. Unencrypted state is "+
+					"accessible by the host system. You "+
+					"should change the password and use "+
+					"the stateless initialization to clean "+
+					"up and rotate the macaroon root key "+
+					"to invalidate old macaroons.", macPath)
+			}
+		}
+	}
+
+	// Hand the admin macaroon back to the wallet unlocker service, which
+	// returns it to the client that created or unlocked the wallet. If
+	// macaroons are disabled, an empty macaroon is returned.
+	if walletInitParams.MacResponseChan != nil {
+		var adminMac []byte
+		if macaroonService != nil {
+			adminMac, err = bakeMacaroon(
+				ctx, macaroonService, adminPermissions(),
+			)
+			if err != nil {
+				err := fmt.Errorf("Unable to create admin "+
+					"macaroon: %v", err)
+				ltndLog.Error(err)
+				return err
+			}
+		}
+
+		// The channel is buffered, so this never blocks, even if
+		// the client isn't waiting for the macaroon.
+		walletInitParams.MacResponseChan <- adminMac
 	}
 
+	// With the admin macaroon handed over, we no longer need the wallet
+	// unlocker service, and need to free its listeners for the main RPC
+	// server.
+	shutdownUnlocker()
+
 	// With the information parsed from the configuration, create valid
 	// instances of the pertinent interfaces required to operate the
 	// Lightning Network Daemon.
@@ -439,18 +529,21 @@ func Main(lisCfg ListenerCfg) error {
 	registeredChains.RegisterChain(primaryChain, activeChainControl)
 
 	// TODO(roasbeef): add rotation
-	idPrivKey, err := activeChainControl.wallet.DerivePrivKey(keychain.KeyDescriptor{
-		KeyLocator: keychain.KeyLocator{
+	//
+	// Only the public part of the node key is derived here. The private
+	// key stays in the key ring, which may be a remote signer, and is only
+	// used through ECDH and message signing.
+	idKeyDesc, err := activeChainControl.keyRing.DeriveKey(
+		keychain.KeyLocator{
 			Family: keychain.KeyFamilyNodeKey,
 			Index:  0,
 		},
-	})
+	)
 	if err != nil {
-		err := fmt.Errorf("Unable to derive node private key: %v", err)
+		err := fmt.Errorf("Unable to derive node key: %v", err)
 		ltndLog.Error(err)
 		return err
 	}
-	idPrivKey.Curve = btcec.S256()
 
 	if cfg.Tor.Active {
 		srvrLog.Infof("Proxying all network traffic via Tor "+
@@ -491,17 +584,15 @@ func Main(lisCfg ListenerCfg) error {
 		}
 		defer towerDB.Close()
 
-		towerPrivKey, err := activeChainControl.wallet.DerivePrivKey(
-			keychain.KeyDescriptor{
-				KeyLocator: keychain.KeyLocator{
-					Family: keychain.KeyFamilyTowerID,
-					Index:  0,
-				},
+		towerKeyDesc, err := activeChainControl.keyRing.DeriveKey(
+			keychain.KeyLocator{
+				Family: keychain.KeyFamilyTowerID,
+				Index:  0,
 			},
 		)
 		if err != nil {
 			err := fmt.Errorf("Unable to derive watchtower "+
-				"private key: %v", err)
+				"key: %v", err)
 			ltndLog.Error(err)
 			return err
 		}
@@ -516,9 +607,15 @@ func Main(lisCfg ListenerCfg) error {
 					lnwallet.WitnessPubKey, false,
 				)
 			},
-			NodePrivKey: towerPrivKey,
-			PublishTx:   activeChainControl.wallet.PublishTransaction,
-			ChainHash:   *activeNetParams.GenesisHash,
+			NodeKeyECDH: keychain.NewPubKeyECDH(
+				towerKeyDesc, activeChainControl.keyRing,
+			),
+			PublishTx: func(tx *wire.MsgTx) error {
+				return activeChainControl.wallet.PublishTransaction(
+					tx, "",
+				)
+			},
+			ChainHash: *activeNetParams.GenesisHash,
 		}, lncfg.NormalizeAddresses)
 		if err != nil {
 			err := fmt.Errorf("Unable to configure watchtower: %v",
@@ -542,7 +639,7 @@ func Main(lisCfg ListenerCfg) error {
 	// connections.
 	server, err := newServer(
 		cfg.Listeners, chanDB, towerClientDB, activeChainControl,
-		idPrivKey, walletInitParams.ChansToRestore, chainedAcceptor,
+		&idKeyDesc, walletInitParams.ChansToRestore, chainedAcceptor,
 	)
 	if err != nil {
 		err := fmt.Errorf("Unable to create server: %v", err)
@@ -798,6 +895,30 @@ func fileExists(name string) bool {
 	return true
 }
 
+// adminPermissions returns a list of all permissions in a safe way that doesn't
+// modify any of the source lists.
+func adminPermissions() []bakery.Op {
+	admin := make([]bakery.Op, len(readPermissions)+len(writePermissions))
+	copy(admin[:len(readPermissions)], readPermissions)
+	copy(admin[len(readPermissions):], writePermissions)
+	return admin
+}
+
+// bakeMacaroon creates a new macaroon with the default root key ID that
+// grants the given permissions, and returns it binary serialized.
+func bakeMacaroon(ctx context.Context, svc *macaroons.Service,
+	permissions []bakery.Op) ([]byte, error) {
+
+	mac, err := svc.NewMacaroon(
+		ctx, macaroons.DefaultRootKeyID, permissions...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mac.M().MarshalBinary()
+}
+
 // genMacaroons generates three macaroon files; one admin-level, one for
 // invoice access and one read-only. These can also be used to generate more
 // granular macaroons.
@@ -841,14 +962,7 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	}
 
 	// Generate the admin macaroon and write it to a file.
-	adminPermissions := append(readPermissions, writePermissions...)
-	admMacaroon, err := svc.Oven.NewMacaroon(
-		ctx, bakery.LatestVersion, nil, adminPermissions...,
-	)
-	if err != nil {
-		return err
-	}
-	admBytes, err := admMacaroon.M().MarshalBinary()
+	admBytes, err := bakeMacaroon(ctx, svc, adminPermissions())
 	if err != nil {
 		return err
 	}
@@ -883,48 +997,166 @@ type WalletUnlockParams struct {
 	// ChansToRestore a set of static channel backups that should be
 	// restored before the main server instance starts up.
 	ChansToRestore walletunlocker.ChannelsToRecover
+
+	// StatelessInit signals that the user requested the daemon to be
+	// initialized stateless, which means no unencrypted macaroons should
+	// be written to disk.
+	StatelessInit bool
+
+	// MacResponseChan is the channel for sending back the admin macaroon
+	// to the WalletUnlocker service.
+	MacResponseChan chan []byte
 }
 
 // waitForWalletPassword will spin up gRPC and REST endpoints for the
 // WalletUnlocker server, and block until a password is provided by
-// the user to this RPC server.
+// the user to this RPC server. The returned function shuts the WalletUnlocker
+// server down, and must be called once the admin macaroon has been sent over
+// the MacResponseChan of the returned params.
 func waitForWalletPassword(restEndpoints []net.Addr,
 	serverOpts []grpc.ServerOption, restDialOpts []grpc.DialOption,
 	restProxyDest string, tlsConf *tls.Config,
-	getListeners rpcListeners) (*WalletUnlockParams, error) {
+	getListeners rpcListeners) (*WalletUnlockParams, func(), error) {
 
 	// Start a gRPC server listening for HTTP/2 connections, solely used
 	// for getting the encryption password from the client.
 	listeners, cleanup, err := getListeners()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	defer cleanup()
 
 	// Set up a new PasswordService, which will listen for passwords
 	// provided over RPC.
 	grpcServer := grpc.NewServer(serverOpts...)
-	defer grpcServer.GracefulStop()
 
 	chainConfig := cfg.Bitcoin
 	if registeredChains.PrimaryChain() == actiniumChain {
 		chainConfig = cfg.Actinium
 	}
 
-	// The macaroon files are passed to the wallet unlocker since they are
-	// also encrypted with the wallet's password. These files will be
-	// deleted within it and recreated when successfully changing the
-	// wallet's password.
+	// The macaroon files are passed to the wallet unlocker, which deletes
+	// them when the macaroon root key is rotated or a stateless
+	// initialization is requested while changing the wallet's password.
+	// They're recreated at startup if needed. The macaroon database
+	// itself is re-encrypted with the new password.
 	macaroonFiles := []string{
-		filepath.Join(networkDir, macaroons.DBFilename),
 		cfg.AdminMacPath, cfg.ReadMacPath, cfg.InvoiceMacPath,
 	}
 	pwService := walletunlocker.New(
 		chainConfig.ChainDir, activeNetParams.Params, !cfg.SyncFreelist,
-		macaroonFiles,
+		networkDir, macaroonFiles,
 	)
 	lnrpc.RegisterWalletUnlockerServer(grpcServer, pwService)
 
+	// Start a REST proxy for our gRPC server below.
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+
+	// The unlocker servers need to stay up until the admin macaroon has
+	// been handed to the client, which happens after the wallet has been
+	// unlocked. Closing the macaroon response channel before stopping the
+	// gRPC server releases any client that is still waiting for its admin
+	// macaroon, as otherwise the graceful stop would block.
+	var (
+		restListeners []net.Listener
+		shutdownOnce  sync.Once
+	)
+	shutdownUnlocker := func() {
+		shutdownOnce.Do(func() {
+			close(pwService.MacResponseChan)
+			for _, lis := range restListeners {
+				lis.Close()
+			}
+			cancel()
+			grpcServer.GracefulStop()
+			cleanup()
+		})
+	}
+
+	params, err := serveWalletUnlocker(
+		ctx, pwService, grpcServer, listeners, &restListeners,
+		restEndpoints, restDialOpts, restProxyDest, tlsConf,
+		chainConfig,
+	)
+	if err != nil {
+		shutdownUnlocker()
+		return nil, nil, err
+	}
+
+	params.MacResponseChan = pwService.MacResponseChan
+	return params, shutdownUnlocker, nil
+}
+
+// autoUnlockWallet reads the wallet password from the configured password file
+// or command, and unlocks the existing wallet with it. If the wallet has not
+// been created through the wallet unlocker yet, walletunlocker.ErrWalletNotFound
+// is returned without reading the password.
+func autoUnlockWallet() (*WalletUnlockParams, error) {
+	chainConfig := cfg.Bitcoin
+	if registeredChains.PrimaryChain() == actiniumChain {
+		chainConfig = cfg.Actinium
+	}
+
+	pwService := walletunlocker.New(
+		chainConfig.ChainDir, activeNetParams.Params, !cfg.SyncFreelist,
+		networkDir, nil,
+	)
+	walletExists, err := pwService.WalletExists()
+	if err != nil {
+		return nil, err
+	}
+	if !walletExists {
+		return nil, walletunlocker.ErrWalletNotFound
+	}
+
+	var password []byte
+	switch {
+	case cfg.WalletUnlock.PasswordFile != "":
+		ltndLog.Infof("Reading wallet password from file %v",
+			cfg.WalletUnlock.PasswordFile)
+
+		password, err = walletunlocker.ReadPasswordFile(
+			cfg.WalletUnlock.PasswordFile,
+		)
+
+	default:
+		ltndLog.Infof("Reading wallet password from command")
+
+		password, err = walletunlocker.PasswordFromCommand(
+			cfg.WalletUnlock.PasswordCommand,
+			cfg.WalletUnlock.CommandTimeout,
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read wallet password: %v",
+			err)
+	}
+
+	unlockedWallet, err := pwService.LoadAndUnlock(password, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unlock wallet, make sure the "+
+			"password it was created with is correct: %v", err)
+	}
+
+	ltndLog.Infof("Wallet unlocked automatically")
+
+	return &WalletUnlockParams{
+		Password: password,
+		Wallet:   unlockedWallet,
+	}, nil
+}
+
+// serveWalletUnlocker starts serving the WalletUnlocker service on the gRPC
+// listeners and REST endpoints, and blocks until the wallet has been created
+// or unlocked by the user. The REST listeners are added to restListeners, so
+// the caller can close them once the service is no longer needed.
+func serveWalletUnlocker(ctx context.Context,
+	pwService *walletunlocker.UnlockerService, grpcServer *grpc.Server,
+	listeners []*ListenerWithSignal, restListeners *[]net.Listener,
+	restEndpoints []net.Addr, restDialOpts []grpc.DialOption,
+	restProxyDest string, tlsConf *tls.Config,
+	chainConfig *chainConfig) (*WalletUnlockParams, error) {
+
 	// Use a WaitGroup so we can be sure the instructions on how to input the
 	// password is the last thing to be printed to the console.
 	var wg sync.WaitGroup
@@ -943,14 +1175,9 @@ func waitForWalletPassword(restEndpoints []net.Addr,
 		}(lis)
 	}
 
-	// Start a REST proxy for our gRPC server above.
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
 	mux := proxy.NewServeMux()
 
-	err = lnrpc.RegisterWalletUnlockerHandlerFromEndpoint(
+	err := lnrpc.RegisterWalletUnlockerHandlerFromEndpoint(
 		ctx, mux, restProxyDest, restDialOpts,
 	)
 	if err != nil {
@@ -968,7 +1195,7 @@ func waitForWalletPassword(restEndpoints []net.Addr,
 			)
 			return nil, err
 		}
-		defer lis.Close()
+		*restListeners = append(*restListeners, lis)
 
 		wg.Add(1)
 		go func() {
@@ -1044,6 +1271,7 @@ func waitForWalletPassword(restEndpoints []net.Addr,
 			RecoveryWindow: recoveryWindow,
 			Wallet:         newWallet,
 			ChansToRestore: initMsg.ChanBackups,
+			StatelessInit:  initMsg.StatelessInit,
 		}, nil
 
 	// The wallet has already been created in the past, and is simply being
@@ -1054,6 +1282,7 @@ func waitForWalletPassword(restEndpoints []net.Addr,
 			RecoveryWindow: unlockMsg.RecoveryWindow,
 			Wallet:         unlockMsg.Wallet,
 			ChansToRestore: unlockMsg.ChanBackups,
+			StatelessInit:  unlockMsg.StatelessInit,
 		}, nil
 
 	case <-signal.ShutdownChannel():
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/acmd/wire"
 	"github.com/Actinium-project/acmutil"
 	"github.com/davecgh/go-spew/spew"
 
@@ -51,6 +52,13 @@ type AddInvoiceConfig struct {
 	// GenInvoiceFeatures returns a feature containing feature bits that
 	// should be advertised on freshly generated invoices.
 	GenInvoiceFeatures func() *lnwire.FeatureVector
+
+	// GetChannelUptime returns the lifetime of the channel with the given
+	// funding outpoint and the time the remote peer was online during it.
+	// It is used to rank the private channels for hop hints, and may be
+	// nil if uptime information isn't available.
+	GetChannelUptime func(chanPoint wire.OutPoint) (time.Duration,
+		time.Duration, error)
 }
 
 // AddInvoiceData contains the required data to create a new invoice.
@@ -91,6 +99,26 @@ type AddInvoiceData struct {
 	// Whether this invoice should include routing hints for private
 	// channels.
 	Private bool
+
+	// Amp signals that the invoice can only be paid with an atomic
+	// multi-path payment. The shards of such a payment carry their own
+	// payment hashes, derived from the shares of a root secret chosen by
+	// the sender.
+	Amp bool
+
+	// Reusable signals that the invoice accepts any number of payments.
+	// Reusable invoices can only be paid with atomic multi-path payments,
+	// so every payment is settled with its own preimages.
+	Reusable bool
+
+	// HintPolicy is the policy used to select the routing hints for
+	// private channels, if Private is set.
+	HintPolicy HintPolicy
+
+	// HoldDuration is the maximum time a hold invoice is kept in the
+	// accepted state before it is canceled automatically. It can only be
+	// set for hold invoices.
+	HoldDuration time.Duration
 }
 
 // AddInvoice attempts to add a new invoice to the invoice database. Any
@@ -111,6 +139,27 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		return nil, nil,
 			errors.New("preimage and hash both set")
 
+	// The shards of an AMP payment are settled with the preimages the
+	// sender derived, so they can't be held until a preimage is supplied.
+	case invoice.Amp && invoice.Hash != nil:
+		return nil, nil,
+			errors.New("amp invoices cannot be hold invoices")
+
+	// The payments to a reusable invoice are AMP payments, so they can't
+	// be held either.
+	case invoice.Reusable && invoice.Hash != nil:
+		return nil, nil,
+			errors.New("reusable invoices cannot be hold invoices")
+
+	// Only hold invoices are kept in the accepted state, so the hold
+	// duration doesn't apply to any other invoice.
+	case invoice.HoldDuration != 0 && invoice.Hash == nil:
+		return nil, nil,
+			errors.New("hold duration set for non-hold invoice")
+
+	case invoice.HoldDuration < 0:
+		return nil, nil, errors.New("hold duration cannot be negative")
+
 	// Prevent the unknown preimage magic value from being used for a
 	// regular invoice. This would cause the invoice the be handled as if it
 	// was a hold invoice.
@@ -250,125 +299,35 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 	}
 
 	// If we were requested to include routing hints in the invoice, then
-	// we'll fetch all of our available private channels and create routing
-	// hints for them.
+	// we'll fetch all of our available private channels and let the
+	// selector of the requested policy pick the ones to create routing
+	// hints for.
 	if invoice.Private {
-		openChannels, err := cfg.ChanDB.FetchAllChannels()
+		selector, err := NewHopHintSelector(invoice.HintPolicy)
 		if err != nil {
-			return nil, nil, fmt.Errorf("could not fetch all channels")
+			return nil, nil, err
+		}
+
+		candidates, err := hopHintCandidates(cfg)
+		if err != nil {
+			return nil, nil, err
 		}
 
-		graph := cfg.ChanDB.ChannelGraph()
-
-		numHints := 0
-		for _, channel := range openChannels {
-			// We'll restrict the number of individual route hints
-			// to 20 to avoid creating overly large invoices.
-			if numHints >= 20 {
-				break
-			}
-
-			// Since we're only interested in our private channels,
-			// we'll skip public ones.
-			isPublic := channel.ChannelFlags&lnwire.FFAnnounceChannel != 0
-			if isPublic {
-				continue
-			}
-
-			// Make sure the counterparty has enough balance in the
-			// channel for our amount. We do this in order to reduce
-			// payment errors when attempting to use this channel
-			// as a hint.
-			chanPoint := lnwire.NewChanIDFromOutPoint(
-				&channel.FundingOutpoint,
-			)
-			if amtMSat >= channel.LocalCommitment.RemoteBalance {
-				log.Debugf("Skipping channel %v due to "+
-					"not having enough remote balance",
-					chanPoint)
-				continue
-			}
-
-			// Make sure the channel is active.
-			if !cfg.IsChannelActive(chanPoint) {
-				log.Debugf("Skipping channel %v due to not "+
-					"being eligible to forward payments",
-					chanPoint)
-				continue
-			}
-
-			// To ensure we don't leak unadvertised nodes, we'll
-			// make sure our counterparty is publicly advertised
-			// within the network. Otherwise, we'll end up leaking
-			// information about nodes that intend to stay
-			// unadvertised, like in the case of a node only having
-			// private channels.
-			var remotePub [33]byte
-			copy(remotePub[:], channel.IdentityPub.SerializeCompressed())
-			isRemoteNodePublic, err := graph.IsPublicNode(remotePub)
-			if err != nil {
-				log.Errorf("Unable to determine if node %x "+
-					"is advertised: %v", remotePub, err)
-				continue
-			}
-
-			if !isRemoteNodePublic {
-				log.Debugf("Skipping channel %v due to "+
-					"counterparty %x being unadvertised",
-					chanPoint, remotePub)
-				continue
-			}
-
-			// Fetch the policies for each end of the channel.
-			chanID := channel.ShortChanID().ToUint64()
-			info, p1, p2, err := graph.FetchChannelEdgesByID(chanID)
-			if err != nil {
-				log.Errorf("Unable to fetch the routing "+
-					"policies for the edges of the channel "+
-					"%v: %v", chanPoint, err)
-				continue
-			}
-
-			// Now, we'll need to determine which is the correct
-			// policy for HTLCs being sent from the remote node.
-			var remotePolicy *channeldb.ChannelEdgePolicy
-			if bytes.Equal(remotePub[:], info.NodeKey1Bytes[:]) {
-				remotePolicy = p1
-			} else {
-				remotePolicy = p2
-			}
-
-			// If for some reason we don't yet have the edge for
-			// the remote party, then we'll just skip adding this
-			// channel as a routing hint.
-			if remotePolicy == nil {
-				continue
-			}
-
-			// Finally, create the routing hint for this channel and
-			// add it to our list of route hints.
-			hint := zpay32.HopHint{
-				NodeID:      channel.IdentityPub,
-				ChannelID:   chanID,
-				FeeBaseMSat: uint32(remotePolicy.FeeBaseMSat),
-				FeeProportionalMillionths: uint32(
-					remotePolicy.FeeProportionalMillionths,
-				),
-				CLTVExpiryDelta: remotePolicy.TimeLockDelta,
-			}
-
-			// Include the route hint in our set of options that
-			// will be used when creating the invoice.
+		// Include the route hints in our set of options that will be
+		// used when creating the invoice.
+		hints := selector.SelectHopHints(amtMSat, candidates)
+		for _, hint := range hints {
 			routeHint := []zpay32.HopHint{hint}
 			options = append(options, zpay32.RouteHint(routeHint))
-
-			numHints++
 		}
-
 	}
 
 	// Set our desired invoice features and add them to our list of options.
 	invoiceFeatures := cfg.GenInvoiceFeatures()
+	if invoice.Amp || invoice.Reusable {
+		invoiceFeatures = invoiceFeatures.Clone()
+		invoiceFeatures.Set(lnwire.AMPRequired)
+	}
 	options = append(options, zpay32.Features(invoiceFeatures))
 
 	// Generate and set a random payment address for this invoice. If the
@@ -391,7 +350,9 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 
 	payReqString, err := payReq.Encode(
 		zpay32.MessageSigner{
-			SignCompact: cfg.NodeSigner.SignDigestCompact,
+			SignCompact: func(msg []byte) ([]byte, error) {
+				return cfg.NodeSigner.SignMessageCompact(msg, false)
+			},
 		},
 	)
 	if err != nil {
@@ -409,7 +370,9 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 			PaymentPreimage: paymentPreimage,
 			PaymentAddr:     paymentAddr,
 			Features:        invoiceFeatures,
+			HoldDuration:    invoice.HoldDuration,
 		},
+		Reusable: invoice.Reusable,
 	}
 
 	log.Tracef("[addinvoice] adding new invoice %v",
@@ -426,3 +389,122 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 
 	return &paymentHash, newInvoice, nil
 }
+
+// hopHintCandidates returns the private channels that are eligible to be
+// added to an invoice as hop hints, in the order they are stored.
+func hopHintCandidates(cfg *AddInvoiceConfig) ([]*HopHintCandidate, error) {
+	openChannels, err := cfg.ChanDB.FetchAllChannels()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch all channels")
+	}
+
+	graph := cfg.ChanDB.ChannelGraph()
+
+	var candidates []*HopHintCandidate
+	for _, channel := range openChannels {
+		// Since we're only interested in our private channels, we'll
+		// skip public ones.
+		isPublic := channel.ChannelFlags&lnwire.FFAnnounceChannel != 0
+		if isPublic {
+			continue
+		}
+
+		// Make sure the channel is active, which also means that the
+		// remote peer is online.
+		chanPoint := lnwire.NewChanIDFromOutPoint(
+			&channel.FundingOutpoint,
+		)
+		if !cfg.IsChannelActive(chanPoint) {
+			log.Debugf("Skipping channel %v due to not being "+
+				"eligible to forward payments", chanPoint)
+			continue
+		}
+
+		// To ensure we don't leak unadvertised nodes, we'll make sure
+		// our counterparty is publicly advertised within the network.
+		// Otherwise, we'll end up leaking information about nodes that
+		// intend to stay unadvertised, like in the case of a node only
+		// having private channels.
+		var remotePub [33]byte
+		copy(remotePub[:], channel.IdentityPub.SerializeCompressed())
+		isRemoteNodePublic, err := graph.IsPublicNode(remotePub)
+		if err != nil {
+			log.Errorf("Unable to determine if node %x is "+
+				"advertised: %v", remotePub, err)
+			continue
+		}
+
+		if !isRemoteNodePublic {
+			log.Debugf("Skipping channel %v due to counterparty "+
+				"%x being unadvertised", chanPoint, remotePub)
+			continue
+		}
+
+		// Fetch the policies for each end of the channel.
+		chanID := channel.ShortChanID().ToUint64()
+		info, p1, p2, err := graph.FetchChannelEdgesByID(chanID)
+		if err != nil {
+			log.Errorf("Unable to fetch the routing policies for "+
+				"the edges of the channel %v: %v", chanPoint,
+				err)
+			continue
+		}
+
+		// Now, we'll need to determine which is the correct policy for
+		// HTLCs being sent from the remote node.
+		var remotePolicy *channeldb.ChannelEdgePolicy
+		if bytes.Equal(remotePub[:], info.NodeKey1Bytes[:]) {
+			remotePolicy = p1
+		} else {
+			remotePolicy = p2
+		}
+
+		// If for some reason we don't yet have the edge for the remote
+		// party, then we'll just skip adding this channel as a routing
+		// hint.
+		if remotePolicy == nil {
+			continue
+		}
+
+		// Finally, create the routing hint for this channel.
+		hint := zpay32.HopHint{
+			NodeID:      channel.IdentityPub,
+			ChannelID:   chanID,
+			FeeBaseMSat: uint32(remotePolicy.FeeBaseMSat),
+			FeeProportionalMillionths: uint32(
+				remotePolicy.FeeProportionalMillionths,
+			),
+			CLTVExpiryDelta: remotePolicy.TimeLockDelta,
+		}
+
+		candidates = append(candidates, &HopHintCandidate{
+			Hint:          hint,
+			RemoteBalance: channel.LocalCommitment.RemoteBalance,
+			Uptime:        channelUptime(cfg, channel.FundingOutpoint),
+		})
+	}
+
+	return candidates, nil
+}
+
+// channelUptime returns the fraction of the lifetime of the channel with the
+// given funding outpoint during which the remote peer was online. If this
+// isn't known, zero is returned so that the channel is ranked last.
+func channelUptime(cfg *AddInvoiceConfig, chanPoint wire.OutPoint) float64 {
+	if cfg.GetChannelUptime == nil {
+		return 0
+	}
+
+	lifetime, uptime, err := cfg.GetChannelUptime(chanPoint)
+	if err != nil {
+		log.Debugf("Unable to get uptime of channel %v: %v",
+			chanPoint, err)
+		return 0
+	}
+
+	if lifetime <= 0 {
+		return 0
+	}
+
+	return float64(uptime) / float64(lifetime)
+}
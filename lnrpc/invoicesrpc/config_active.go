@@ -3,7 +3,10 @@
 package invoicesrpc
 
 import (
+	"time"
+
 	"github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/acmd/wire"
 	"github.com/Actinium-project/lnd/channeldb"
 	"github.com/Actinium-project/lnd/invoices"
 	"github.com/Actinium-project/lnd/lnwire"
@@ -54,4 +57,9 @@ type Config struct {
 	// GenInvoiceFeatures returns a feature containing feature bits that
 	// should be advertised on freshly generated invoices.
 	GenInvoiceFeatures func() *lnwire.FeatureVector
+
+	// GetChannelUptime returns the lifetime of the channel with the given
+	// funding outpoint and the time the remote peer was online during it.
+	GetChannelUptime func(chanPoint wire.OutPoint) (time.Duration,
+		time.Duration, error)
 }
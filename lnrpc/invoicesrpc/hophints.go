@@ -0,0 +1,195 @@
+package invoicesrpc
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/zpay32"
+)
+
+const (
+	// maxHopHints is the maximum number of hop hints that are added to an
+	// invoice, to avoid creating overly large invoices.
+	maxHopHints = 20
+
+	// hopHintCoverageFactor is the multiple of the invoice amount that the
+	// inbound capacity of the selected hints should add up to. As the
+	// inbound capacity of a channel may be used by other payments before
+	// the invoice is paid, we'll aim for some headroom.
+	hopHintCoverageFactor = 2
+)
+
+// HintPolicy determines how the hop hints for the private channels of a node
+// are selected when creating an invoice.
+type HintPolicy uint8
+
+const (
+	// HintPolicyRanked ranks the private channels by their inbound
+	// capacity relative to the invoice amount and by the uptime of the
+	// remote peer, and adds hints until the invoice amount can be received
+	// through a multi-path payment.
+	HintPolicyRanked HintPolicy = iota
+
+	// HintPolicyLegacy adds a hint for every private channel that can
+	// receive the full invoice amount on its own.
+	HintPolicyLegacy
+)
+
+// String returns a human readable representation of the hint policy.
+func (p HintPolicy) String() string {
+	switch p {
+	case HintPolicyRanked:
+		return "ranked"
+
+	case HintPolicyLegacy:
+		return "legacy"
+
+	default:
+		return "unknown"
+	}
+}
+
+// HopHintCandidate is a private channel that is eligible to be added to an
+// invoice as a hop hint.
+type HopHintCandidate struct {
+	// Hint is the hop hint for the channel, which describes how the
+	// remote peer forwards payments to us.
+	Hint zpay32.HopHint
+
+	// RemoteBalance is the balance of the remote peer in the channel,
+	// which is the amount we can receive through it.
+	RemoteBalance lnwire.MilliSatoshi
+
+	// Uptime is the fraction of the lifetime of the channel during which
+	// the remote peer was online, between 0 and 1.
+	Uptime float64
+}
+
+// HopHintSelector selects the hop hints that are added to an invoice for the
+// given amount from the eligible private channels.
+type HopHintSelector interface {
+	// SelectHopHints returns the hop hints to add to an invoice of the
+	// given amount. A zero amount signals an invoice that lets the payer
+	// choose the amount.
+	SelectHopHints(amt lnwire.MilliSatoshi,
+		candidates []*HopHintCandidate) []zpay32.HopHint
+}
+
+// NewHopHintSelector returns the hop hint selector that implements the given
+// policy.
+func NewHopHintSelector(policy HintPolicy) (HopHintSelector, error) {
+	switch policy {
+	case HintPolicyRanked:
+		return &rankedHintSelector{}, nil
+
+	case HintPolicyLegacy:
+		return &legacyHintSelector{}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown hint policy %v", policy)
+	}
+}
+
+// legacyHintSelector adds the channels in their original order, as long as
+// they are able to receive the full amount on their own.
+type legacyHintSelector struct{}
+
+// SelectHopHints returns the hop hints to add to an invoice of the given
+// amount.
+//
+// NOTE: This is part of the HopHintSelector interface.
+func (s *legacyHintSelector) SelectHopHints(amt lnwire.MilliSatoshi,
+	candidates []*HopHintCandidate) []zpay32.HopHint {
+
+	var hints []zpay32.HopHint
+	for _, candidate := range candidates {
+		if len(hints) >= maxHopHints {
+			break
+		}
+
+		// Make sure the counterparty has enough balance in the channel
+		// for our amount. We do this in order to reduce payment errors
+		// when attempting to use this channel as a hint.
+		if amt >= candidate.RemoteBalance {
+			log.Debugf("Skipping channel %v due to not having "+
+				"enough remote balance", candidate.Hint.ChannelID)
+			continue
+		}
+
+		hints = append(hints, candidate.Hint)
+	}
+
+	return hints
+}
+
+// rankedHintSelector adds the channels in the order of their score, until
+// their combined inbound capacity covers the amount.
+type rankedHintSelector struct{}
+
+// SelectHopHints returns the hop hints to add to an invoice of the given
+// amount.
+//
+// NOTE: This is part of the HopHintSelector interface.
+func (s *rankedHintSelector) SelectHopHints(amt lnwire.MilliSatoshi,
+	candidates []*HopHintCandidate) []zpay32.HopHint {
+
+	// Channels without any inbound capacity can't help in receiving the
+	// payment at all.
+	ranked := make([]*HopHintCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.RemoteBalance == 0 {
+			continue
+		}
+		ranked = append(ranked, candidate)
+	}
+
+	// Rank the channels by their score, preferring the ones with more
+	// inbound capacity if the scores are equal. The sort is stable so
+	// that equal channels keep their original order.
+	sort.SliceStable(ranked, func(i, j int) bool {
+		scoreI := hintScore(amt, ranked[i])
+		scoreJ := hintScore(amt, ranked[j])
+		if scoreI != scoreJ {
+			return scoreI > scoreJ
+		}
+
+		return ranked[i].RemoteBalance > ranked[j].RemoteBalance
+	})
+
+	// Add the best channels until their combined inbound capacity covers
+	// the amount. If the payer chooses the amount, we don't know how much
+	// capacity is needed, so all channels are added.
+	target := amt * hopHintCoverageFactor
+
+	var (
+		hints   []zpay32.HopHint
+		covered lnwire.MilliSatoshi
+	)
+	for _, candidate := range ranked {
+		if len(hints) >= maxHopHints {
+			break
+		}
+		if amt != 0 && covered >= target {
+			break
+		}
+
+		hints = append(hints, candidate.Hint)
+		covered += candidate.RemoteBalance
+	}
+
+	return hints
+}
+
+// hintScore returns the score of a channel for an invoice of the given
+// amount. The score is the product of the share of the amount the channel is
+// able to receive and the uptime of the remote peer, so a channel that can
+// receive the full amount through a peer that is always online scores 1.
+func hintScore(amt lnwire.MilliSatoshi, candidate *HopHintCandidate) float64 {
+	capacityRatio := 1.0
+	if amt > 0 && candidate.RemoteBalance < amt {
+		capacityRatio = float64(candidate.RemoteBalance) / float64(amt)
+	}
+
+	return capacityRatio * candidate.Uptime
+}
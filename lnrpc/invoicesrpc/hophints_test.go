@@ -0,0 +1,132 @@
+package invoicesrpc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Actinium-project/lnd/lnwire"
+)
+
+// TestSelectHopHints asserts that the hop hint selectors pick the expected
+// channels for the amount of an invoice.
+func TestSelectHopHints(t *testing.T) {
+	t.Parallel()
+
+	candidate := func(chanID uint64, remoteBalance lnwire.MilliSatoshi,
+		uptime float64) *HopHintCandidate {
+
+		c := &HopHintCandidate{
+			RemoteBalance: remoteBalance,
+			Uptime:        uptime,
+		}
+		c.Hint.ChannelID = chanID
+
+		return c
+	}
+
+	candidates := []*HopHintCandidate{
+		candidate(1, 50000, 1),
+		candidate(2, 300000, 0.5),
+		candidate(3, 200000, 1),
+		candidate(4, 0, 1),
+		candidate(5, 150000, 0.9),
+		candidate(6, 150000, 0.9),
+	}
+
+	testCases := []struct {
+		name    string
+		policy  HintPolicy
+		amt     lnwire.MilliSatoshi
+		chanIDs []uint64
+	}{
+		{
+			// Channels 3 and 5 can receive the full amount through
+			// peers that are mostly online, and cover twice the
+			// amount together.
+			name:    "ranked single channel amount",
+			policy:  HintPolicyRanked,
+			amt:     150000,
+			chanIDs: []uint64{3, 5},
+		},
+		{
+			// No channel can receive the full amount, so the
+			// channels are ranked by their share of the amount and
+			// uptime, until they cover twice the amount.
+			name:    "ranked multi-path amount",
+			policy:  HintPolicyRanked,
+			amt:     400000,
+			chanIDs: []uint64{3, 2, 5, 6},
+		},
+		{
+			// Without an amount, all channels with inbound
+			// capacity are added.
+			name:    "ranked zero amount",
+			policy:  HintPolicyRanked,
+			amt:     0,
+			chanIDs: []uint64{3, 1, 5, 6, 2},
+		},
+		{
+			// Only the channels that can receive more than the
+			// full amount on their own are added.
+			name:    "legacy",
+			policy:  HintPolicyLegacy,
+			amt:     150000,
+			chanIDs: []uint64{2, 3},
+		},
+		{
+			name:   "legacy multi-path amount",
+			policy: HintPolicyLegacy,
+			amt:    400000,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			selector, err := NewHopHintSelector(test.policy)
+			if err != nil {
+				t.Fatalf("unable to create selector: %v", err)
+			}
+
+			hints := selector.SelectHopHints(test.amt, candidates)
+
+			var chanIDs []uint64
+			for _, hint := range hints {
+				chanIDs = append(chanIDs, hint.ChannelID)
+			}
+
+			if !reflect.DeepEqual(chanIDs, test.chanIDs) {
+				t.Fatalf("expected channels %v, got %v",
+					test.chanIDs, chanIDs)
+			}
+		})
+	}
+}
+
+// TestSelectHopHintsLimit asserts that no more than the maximum number of hop
+// hints are selected.
+func TestSelectHopHintsLimit(t *testing.T) {
+	t.Parallel()
+
+	var candidates []*HopHintCandidate
+	for i := 0; i < maxHopHints+5; i++ {
+		candidates = append(candidates, &HopHintCandidate{
+			RemoteBalance: 1000,
+			Uptime:        1,
+		})
+	}
+
+	for _, policy := range []HintPolicy{HintPolicyRanked, HintPolicyLegacy} {
+		selector, err := NewHopHintSelector(policy)
+		if err != nil {
+			t.Fatalf("unable to create selector: %v", err)
+		}
+
+		hints := selector.SelectHopHints(0, candidates)
+		if len(hints) != maxHopHints {
+			t.Fatalf("%v: expected %v hints, got %v", policy,
+				maxHopHints, len(hints))
+		}
+	}
+}
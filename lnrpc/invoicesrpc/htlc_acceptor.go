@@ -0,0 +1,210 @@
+// +build invoicesrpc
+
+package invoicesrpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/invoices"
+	"github.com/Actinium-project/lnd/lnrpc"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwire"
+)
+
+// errAcceptorClosed is returned when an htlc is passed to an acceptor whose
+// stream has been closed.
+var errAcceptorClosed = errors.New("htlc acceptor stream closed")
+
+// rpcHtlcAcceptor passes the htlcs of the invoice registry on to an
+// HtlcAcceptor stream.
+type rpcHtlcAcceptor struct {
+	requests chan *invoices.HtlcAcceptRequest
+	quit     chan struct{}
+}
+
+// AcceptHtlc passes an htlc to the acceptor. The htlc is handed to the stream,
+// unless the registry cancels the hand-off first.
+//
+// NOTE: This is part of the invoices.HtlcAcceptor interface.
+func (a *rpcHtlcAcceptor) AcceptHtlc(req *invoices.HtlcAcceptRequest,
+	cancel <-chan struct{}) error {
+
+	select {
+	case a.requests <- req:
+		return nil
+
+	case <-cancel:
+		return invoices.ErrHtlcAcceptorCanceled
+
+	case <-a.quit:
+		return errAcceptorClosed
+	}
+}
+
+// HtlcAcceptor dispatches a bi-directional streaming RPC in which every htlc
+// paying to this node is sent to the client, which responds with a decision
+// for it.
+func (s *Server) HtlcAcceptor(stream Invoices_HtlcAcceptorServer) error {
+	acceptor := &rpcHtlcAcceptor{
+		requests: make(chan *invoices.HtlcAcceptRequest),
+		quit:     make(chan struct{}),
+	}
+
+	err := s.cfg.InvoiceRegistry.RegisterHtlcAcceptor(acceptor)
+	if err != nil {
+		return err
+	}
+
+	// Once the stream closes, the htlcs that are held by the acceptor are
+	// processed as if there were no acceptor.
+	defer s.cfg.InvoiceRegistry.DeregisterHtlcAcceptor(acceptor)
+	defer close(acceptor.quit)
+
+	// errChan is used by the receive loop to signal any errors that occur
+	// during reading from the stream.
+	errChan := make(chan error, 1)
+
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			key, decision, err := unmarshallHtlcAcceptResponse(resp)
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			err = s.cfg.InvoiceRegistry.ResolveHtlc(key, decision)
+			switch err {
+			// A decision that arrives after the acceptor timed out
+			// is ignored, as the htlc has been processed already.
+			case invoices.ErrHtlcNotPending:
+				log.Warnf("Ignoring htlc acceptor decision for "+
+					"htlc %v: %v", key, err)
+
+			case nil:
+
+			default:
+				errChan <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case req := <-acceptor.requests:
+			err := stream.Send(marshallHtlcAcceptRequest(req))
+			if err != nil {
+				return err
+			}
+
+		case err := <-errChan:
+			return err
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-s.quit:
+			return nil
+		}
+	}
+}
+
+// marshallHtlcAcceptRequest converts an htlc accept request to its rpc
+// representation.
+func marshallHtlcAcceptRequest(
+	req *invoices.HtlcAcceptRequest) *HtlcAcceptRequest {
+
+	rpcReq := &HtlcAcceptRequest{
+		PaymentHash:   req.Hash[:],
+		ChanId:        req.CircuitKey.ChanID.ToUint64(),
+		HtlcId:        req.CircuitKey.HtlcID,
+		AmtMsat:       uint64(req.Amount),
+		Expiry:        req.Expiry,
+		CurrentHeight: req.CurrentHeight,
+		CustomRecords: req.CustomRecords,
+	}
+
+	if req.MPP != nil {
+		addr := req.MPP.PaymentAddr()
+		rpcReq.MppRecord = &lnrpc.MPPRecord{
+			PaymentAddr:  addr[:],
+			TotalAmtMsat: int64(req.MPP.TotalMsat()),
+		}
+	}
+
+	if req.AMP != nil {
+		rootShare := req.AMP.RootShare()
+		setID := req.AMP.SetID()
+		rpcReq.AmpRecord = &lnrpc.AMPRecord{
+			RootShare:  rootShare[:],
+			SetId:      setID[:],
+			ChildIndex: uint32(req.AMP.ChildIndex()),
+		}
+	}
+
+	return rpcReq
+}
+
+// unmarshallHtlcAcceptResponse converts an rpc htlc accept response to the
+// key of the htlc it applies to and the decision of the acceptor.
+func unmarshallHtlcAcceptResponse(resp *HtlcAcceptResponse) (
+	channeldb.CircuitKey, *invoices.HtlcAcceptResponse, error) {
+
+	key := channeldb.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(resp.ChanId),
+		HtlcID: resp.HtlcId,
+	}
+
+	var decision invoices.HtlcAcceptResponse
+	switch resp.Action {
+	case HtlcAcceptAction_RESUME:
+		decision.Action = invoices.HtlcAcceptResume
+
+	case HtlcAcceptAction_SETTLE:
+		preimage, err := lntypes.MakePreimage(resp.Preimage)
+		if err != nil {
+			return key, nil, err
+		}
+
+		decision.Action = invoices.HtlcAcceptSettle
+		decision.Preimage = preimage
+
+	case HtlcAcceptAction_FAIL:
+		decision.Action = invoices.HtlcAcceptFail
+
+		switch resp.FailureCode {
+		case HtlcFailureCode_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS:
+			decision.FailureCode =
+				lnwire.CodeIncorrectOrUnknownPaymentDetails
+
+		case HtlcFailureCode_TEMPORARY_NODE_FAILURE:
+			decision.FailureCode = lnwire.CodeTemporaryNodeFailure
+
+		case HtlcFailureCode_PERMANENT_NODE_FAILURE:
+			decision.FailureCode = lnwire.CodePermanentNodeFailure
+
+		case HtlcFailureCode_MPP_TIMEOUT:
+			decision.FailureCode = lnwire.CodeMPPTimeout
+
+		default:
+			return key, nil, fmt.Errorf("unknown failure code %v",
+				resp.FailureCode)
+		}
+
+	case HtlcAcceptAction_HOLD:
+		decision.Action = invoices.HtlcAcceptHold
+
+	default:
+		return key, nil, fmt.Errorf("unknown action %v", resp.Action)
+	}
+
+	return key, &decision, nil
+}
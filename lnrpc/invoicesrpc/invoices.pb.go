@@ -6,8 +6,8 @@ package invoicesrpc
 import (
 	context "context"
 	fmt "fmt"
-	proto "github.com/golang/protobuf/proto"
 	lnrpc "github.com/Actinium-project/lnd/lnrpc"
+	proto "github.com/golang/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	math "math"
@@ -24,6 +24,76 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type HtlcAcceptAction int32
+
+const (
+	/// Process the HTLC as if there were no acceptor.
+	HtlcAcceptAction_RESUME HtlcAcceptAction = 0
+	/// Settle the HTLC with the supplied preimage, without recording it on
+	/// any invoice.
+	HtlcAcceptAction_SETTLE HtlcAcceptAction = 1
+	/// Fail the HTLC with the supplied failure code.
+	HtlcAcceptAction_FAIL HtlcAcceptAction = 2
+	//
+	//Hold the HTLC until another decision is made for it. If a hold expiry
+	//delta is configured, the HTLC is failed once it comes that close to its
+	//expiry.
+	HtlcAcceptAction_HOLD HtlcAcceptAction = 3
+)
+
+var HtlcAcceptAction_name = map[int32]string{
+	0: "RESUME",
+	1: "SETTLE",
+	2: "FAIL",
+	3: "HOLD",
+}
+
+var HtlcAcceptAction_value = map[string]int32{
+	"RESUME": 0,
+	"SETTLE": 1,
+	"FAIL":   2,
+	"HOLD":   3,
+}
+
+func (x HtlcAcceptAction) String() string {
+	return proto.EnumName(HtlcAcceptAction_name, int32(x))
+}
+
+func (HtlcAcceptAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_090ab9c4958b987d, []int{0}
+}
+
+type HtlcFailureCode int32
+
+const (
+	HtlcFailureCode_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS HtlcFailureCode = 0
+	HtlcFailureCode_TEMPORARY_NODE_FAILURE               HtlcFailureCode = 1
+	HtlcFailureCode_PERMANENT_NODE_FAILURE               HtlcFailureCode = 2
+	HtlcFailureCode_MPP_TIMEOUT                          HtlcFailureCode = 3
+)
+
+var HtlcFailureCode_name = map[int32]string{
+	0: "INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS",
+	1: "TEMPORARY_NODE_FAILURE",
+	2: "PERMANENT_NODE_FAILURE",
+	3: "MPP_TIMEOUT",
+}
+
+var HtlcFailureCode_value = map[string]int32{
+	"INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS": 0,
+	"TEMPORARY_NODE_FAILURE":               1,
+	"PERMANENT_NODE_FAILURE":               2,
+	"MPP_TIMEOUT":                          3,
+}
+
+func (x HtlcFailureCode) String() string {
+	return proto.EnumName(HtlcFailureCode_name, int32(x))
+}
+
+func (HtlcFailureCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_090ab9c4958b987d, []int{1}
+}
+
 type CancelInvoiceMsg struct {
 	/// Hash corresponding to the (hold) invoice to cancel.
 	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
@@ -130,7 +200,16 @@ type AddHoldInvoiceRequest struct {
 	//invoice's destination.
 	RouteHints []*lnrpc.RouteHint `protobuf:"bytes,8,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
 	/// Whether this invoice should include routing hints for private channels.
-	Private              bool     `protobuf:"varint,9,opt,name=private,proto3" json:"private,omitempty"`
+	Private bool `protobuf:"varint,9,opt,name=private,proto3" json:"private,omitempty"`
+	//*
+	//The policy used to select the routing hints for private channels, if
+	//private is set.
+	HintPolicy lnrpc.HintPolicy `protobuf:"varint,11,opt,name=hint_policy,proto3,enum=lnrpc.HintPolicy" json:"hint_policy,omitempty"`
+	//*
+	//The maximum number of seconds the invoice is kept in the accepted state
+	//before it is canceled automatically. The lower global limit of the node
+	//applies if it has one. If zero, only the global limit applies.
+	HoldDuration         uint64   `protobuf:"varint,12,opt,name=hold_duration,proto3" json:"hold_duration,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -231,6 +310,20 @@ func (m *AddHoldInvoiceRequest) GetPrivate() bool {
 	return false
 }
 
+func (m *AddHoldInvoiceRequest) GetHintPolicy() lnrpc.HintPolicy {
+	if m != nil {
+		return m.HintPolicy
+	}
+	return lnrpc.HintPolicy_RANKED_HINTS
+}
+
+func (m *AddHoldInvoiceRequest) GetHoldDuration() uint64 {
+	if m != nil {
+		return m.HoldDuration
+	}
+	return 0
+}
+
 type AddHoldInvoiceResp struct {
 	//*
 	//A bare-bones invoice for a payment within the Lightning Network.  With the
@@ -385,7 +478,197 @@ func (m *SubscribeSingleInvoiceRequest) GetRHash() []byte {
 	return nil
 }
 
+type HtlcAcceptRequest struct {
+	/// The payment hash of the HTLC.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	/// The short channel id of the channel the HTLC arrived on.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	/// The index of the HTLC on the channel.
+	HtlcId uint64 `protobuf:"varint,3,opt,name=htlc_id,proto3" json:"htlc_id,omitempty"`
+	/// The amount of the HTLC in msat.
+	AmtMsat uint64 `protobuf:"varint,4,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
+	/// The absolute expiry height of the HTLC.
+	Expiry uint32 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	/// The block height at which the HTLC was received.
+	CurrentHeight int32 `protobuf:"varint,6,opt,name=current_height,proto3" json:"current_height,omitempty"`
+	/// Custom tlv records of the final hop payload.
+	CustomRecords map[uint64][]byte `protobuf:"bytes,7,rep,name=custom_records,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	/// The mpp record of the final hop payload, if any.
+	MppRecord *lnrpc.MPPRecord `protobuf:"bytes,8,opt,name=mpp_record,proto3" json:"mpp_record,omitempty"`
+	/// The amp record of the final hop payload, if any.
+	AmpRecord            *lnrpc.AMPRecord `protobuf:"bytes,9,opt,name=amp_record,proto3" json:"amp_record,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *HtlcAcceptRequest) Reset()         { *m = HtlcAcceptRequest{} }
+func (m *HtlcAcceptRequest) String() string { return proto.CompactTextString(m) }
+func (*HtlcAcceptRequest) ProtoMessage()    {}
+func (*HtlcAcceptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_090ab9c4958b987d, []int{7}
+}
+
+func (m *HtlcAcceptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcAcceptRequest.Unmarshal(m, b)
+}
+func (m *HtlcAcceptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcAcceptRequest.Marshal(b, m, deterministic)
+}
+func (m *HtlcAcceptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcAcceptRequest.Merge(m, src)
+}
+func (m *HtlcAcceptRequest) XXX_Size() int {
+	return xxx_messageInfo_HtlcAcceptRequest.Size(m)
+}
+func (m *HtlcAcceptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcAcceptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcAcceptRequest proto.InternalMessageInfo
+
+func (m *HtlcAcceptRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *HtlcAcceptRequest) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *HtlcAcceptRequest) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
+	}
+	return 0
+}
+
+func (m *HtlcAcceptRequest) GetAmtMsat() uint64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
+}
+
+func (m *HtlcAcceptRequest) GetExpiry() uint32 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *HtlcAcceptRequest) GetCurrentHeight() int32 {
+	if m != nil {
+		return m.CurrentHeight
+	}
+	return 0
+}
+
+func (m *HtlcAcceptRequest) GetCustomRecords() map[uint64][]byte {
+	if m != nil {
+		return m.CustomRecords
+	}
+	return nil
+}
+
+func (m *HtlcAcceptRequest) GetMppRecord() *lnrpc.MPPRecord {
+	if m != nil {
+		return m.MppRecord
+	}
+	return nil
+}
+
+func (m *HtlcAcceptRequest) GetAmpRecord() *lnrpc.AMPRecord {
+	if m != nil {
+		return m.AmpRecord
+	}
+	return nil
+}
+
+type HtlcAcceptResponse struct {
+	/// The short channel id of the channel the HTLC arrived on.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	/// The index of the HTLC on the channel.
+	HtlcId uint64 `protobuf:"varint,2,opt,name=htlc_id,proto3" json:"htlc_id,omitempty"`
+	/// The action to take on the HTLC.
+	Action HtlcAcceptAction `protobuf:"varint,3,opt,name=action,proto3,enum=invoicesrpc.HtlcAcceptAction" json:"action,omitempty"`
+	/// The preimage to settle the HTLC with, if the action is SETTLE.
+	Preimage []byte `protobuf:"bytes,4,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	/// The failure to fail the HTLC with, if the action is FAIL.
+	FailureCode          HtlcFailureCode `protobuf:"varint,5,opt,name=failure_code,proto3,enum=invoicesrpc.HtlcFailureCode" json:"failure_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *HtlcAcceptResponse) Reset()         { *m = HtlcAcceptResponse{} }
+func (m *HtlcAcceptResponse) String() string { return proto.CompactTextString(m) }
+func (*HtlcAcceptResponse) ProtoMessage()    {}
+func (*HtlcAcceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_090ab9c4958b987d, []int{8}
+}
+
+func (m *HtlcAcceptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcAcceptResponse.Unmarshal(m, b)
+}
+func (m *HtlcAcceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcAcceptResponse.Marshal(b, m, deterministic)
+}
+func (m *HtlcAcceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcAcceptResponse.Merge(m, src)
+}
+func (m *HtlcAcceptResponse) XXX_Size() int {
+	return xxx_messageInfo_HtlcAcceptResponse.Size(m)
+}
+func (m *HtlcAcceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcAcceptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcAcceptResponse proto.InternalMessageInfo
+
+func (m *HtlcAcceptResponse) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *HtlcAcceptResponse) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
+	}
+	return 0
+}
+
+func (m *HtlcAcceptResponse) GetAction() HtlcAcceptAction {
+	if m != nil {
+		return m.Action
+	}
+	return HtlcAcceptAction_RESUME
+}
+
+func (m *HtlcAcceptResponse) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *HtlcAcceptResponse) GetFailureCode() HtlcFailureCode {
+	if m != nil {
+		return m.FailureCode
+	}
+	return HtlcFailureCode_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS
+}
+
 func init() {
+	proto.RegisterEnum("invoicesrpc.HtlcAcceptAction", HtlcAcceptAction_name, HtlcAcceptAction_value)
+	proto.RegisterEnum("invoicesrpc.HtlcFailureCode", HtlcFailureCode_name, HtlcFailureCode_value)
 	proto.RegisterType((*CancelInvoiceMsg)(nil), "invoicesrpc.CancelInvoiceMsg")
 	proto.RegisterType((*CancelInvoiceResp)(nil), "invoicesrpc.CancelInvoiceResp")
 	proto.RegisterType((*AddHoldInvoiceRequest)(nil), "invoicesrpc.AddHoldInvoiceRequest")
@@ -393,45 +676,76 @@ func init() {
 	proto.RegisterType((*SettleInvoiceMsg)(nil), "invoicesrpc.SettleInvoiceMsg")
 	proto.RegisterType((*SettleInvoiceResp)(nil), "invoicesrpc.SettleInvoiceResp")
 	proto.RegisterType((*SubscribeSingleInvoiceRequest)(nil), "invoicesrpc.SubscribeSingleInvoiceRequest")
+	proto.RegisterType((*HtlcAcceptRequest)(nil), "invoicesrpc.HtlcAcceptRequest")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "invoicesrpc.HtlcAcceptRequest.CustomRecordsEntry")
+	proto.RegisterType((*HtlcAcceptResponse)(nil), "invoicesrpc.HtlcAcceptResponse")
 }
 
 func init() { proto.RegisterFile("invoicesrpc/invoices.proto", fileDescriptor_090ab9c4958b987d) }
 
 var fileDescriptor_090ab9c4958b987d = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x95, 0xd3, 0x34, 0x4d, 0x27, 0x6d, 0x09, 0x0b, 0x54, 0x96, 0x45, 0x8b, 0xb1, 0x38, 0x58,
-	0x3d, 0xd8, 0x90, 0x8a, 0x23, 0x48, 0xc0, 0x25, 0x20, 0xc1, 0xc1, 0x11, 0x1c, 0xb8, 0x58, 0x1b,
-	0x7b, 0xb1, 0x57, 0x5d, 0xef, 0x2e, 0xbb, 0x9b, 0x40, 0xbf, 0x92, 0x33, 0x7f, 0x83, 0xbc, 0x76,
-	0x2a, 0xdb, 0x2d, 0xbd, 0xcd, 0xbc, 0x99, 0x79, 0x1e, 0xbf, 0x37, 0x0b, 0x1e, 0xe5, 0x5b, 0x41,
-	0x33, 0xa2, 0x95, 0xcc, 0xe2, 0x5d, 0x1c, 0x49, 0x25, 0x8c, 0x40, 0xb3, 0x4e, 0xcd, 0x7b, 0x5a,
-	0x08, 0x51, 0x30, 0x12, 0x63, 0x49, 0x63, 0xcc, 0xb9, 0x30, 0xd8, 0x50, 0xc1, 0xdb, 0x56, 0xef,
-	0x50, 0xc9, 0xac, 0x09, 0x83, 0xd7, 0x30, 0xff, 0x80, 0x79, 0x46, 0xd8, 0xc7, 0x66, 0xfa, 0xb3,
-	0x2e, 0xd0, 0x73, 0x38, 0x92, 0xf8, 0xba, 0x22, 0xdc, 0xa4, 0x25, 0xd6, 0xa5, 0xeb, 0xf8, 0x4e,
-	0x78, 0x94, 0xcc, 0x5a, 0x6c, 0x89, 0x75, 0x19, 0x3c, 0x82, 0x87, 0xbd, 0xb1, 0x84, 0x68, 0x19,
-	0xfc, 0x19, 0xc1, 0x93, 0x77, 0x79, 0xbe, 0x14, 0x2c, 0xbf, 0x81, 0x7f, 0x6e, 0x88, 0x36, 0x08,
-	0xc1, 0xb8, 0x22, 0x95, 0xb0, 0x4c, 0x87, 0x89, 0x8d, 0x6b, 0xcc, 0xb2, 0x8f, 0x2c, 0xbb, 0x8d,
-	0xd1, 0x63, 0xd8, 0xdf, 0x62, 0xb6, 0x21, 0xee, 0x9e, 0xef, 0x84, 0x7b, 0x49, 0x93, 0xa0, 0x73,
-	0x00, 0x1b, 0xa4, 0x95, 0xc6, 0xc6, 0x05, 0x5b, 0xea, 0x20, 0xe8, 0x02, 0xe6, 0x39, 0xd1, 0x99,
-	0xa2, 0xb2, 0xfe, 0xc9, 0x66, 0xe7, 0xb1, 0x65, 0xbd, 0x85, 0xa3, 0x53, 0x98, 0x90, 0xdf, 0x92,
-	0xaa, 0x6b, 0x77, 0xdf, 0xf2, 0xb4, 0x19, 0x7a, 0x01, 0xc7, 0x3f, 0x30, 0x63, 0x6b, 0x9c, 0x5d,
-	0xa5, 0x38, 0xcf, 0x95, 0x3b, 0xb1, 0xab, 0xf6, 0x41, 0xe4, 0xc3, 0x2c, 0x63, 0x66, 0x9b, 0xb6,
-	0x14, 0x07, 0xbe, 0x13, 0x8e, 0x93, 0x2e, 0x84, 0x16, 0x30, 0x53, 0x62, 0x63, 0x48, 0x5a, 0x52,
-	0x6e, 0xb4, 0x3b, 0xf5, 0xf7, 0xc2, 0xd9, 0x62, 0x1e, 0x31, 0x5e, 0x4b, 0x9e, 0xd4, 0x95, 0x25,
-	0xe5, 0x26, 0xe9, 0x36, 0x21, 0x17, 0x0e, 0xa4, 0xa2, 0x5b, 0x6c, 0x88, 0x7b, 0xe8, 0x3b, 0xe1,
-	0x34, 0xd9, 0xa5, 0xc1, 0x5b, 0x40, 0x43, 0x41, 0xb5, 0x44, 0x21, 0x3c, 0xd8, 0xf9, 0xa3, 0x1a,
-	0x81, 0x5b, 0x61, 0x87, 0x70, 0x10, 0xc1, 0x7c, 0x45, 0x8c, 0x61, 0xa4, 0xe3, 0xae, 0x07, 0x53,
-	0xa9, 0x08, 0xad, 0x70, 0x41, 0x5a, 0x67, 0x6f, 0xf2, 0xda, 0xd6, 0x5e, 0xbf, 0xb5, 0xf5, 0x0d,
-	0x9c, 0xad, 0x36, 0xeb, 0x5a, 0xc7, 0x35, 0x59, 0x51, 0x5e, 0x74, 0xaa, 0x8d, 0xbb, 0xa7, 0x30,
-	0x51, 0x69, 0xc7, 0xcb, 0x36, 0xfb, 0x34, 0x9e, 0x3a, 0xf3, 0xd1, 0xe2, 0xef, 0x08, 0xa6, 0xed,
-	0x80, 0x46, 0xdf, 0xe0, 0xf4, 0x6e, 0x2e, 0x74, 0x11, 0x75, 0xee, 0x37, 0xba, 0xf7, 0x83, 0xde,
-	0x49, 0xab, 0x67, 0x0b, 0xbf, 0x74, 0xd0, 0x17, 0x38, 0xee, 0xdd, 0x23, 0x3a, 0xeb, 0xd1, 0x0d,
-	0x4f, 0xdc, 0x3b, 0xff, 0x7f, 0xd9, 0x4a, 0xfc, 0x15, 0x4e, 0xfa, 0xc2, 0xa3, 0xa0, 0x37, 0x71,
-	0xe7, 0x99, 0x7b, 0xcf, 0xee, 0xed, 0xd1, 0xb2, 0x5e, 0xb3, 0xa7, 0xef, 0x60, 0xcd, 0xa1, 0x57,
-	0x83, 0x35, 0x6f, 0x59, 0xf3, 0xfe, 0xf2, 0xfb, 0xab, 0x82, 0x9a, 0x72, 0xb3, 0x8e, 0x32, 0x51,
-	0xc5, 0x8c, 0x16, 0xa5, 0xe1, 0x94, 0x17, 0x9c, 0x98, 0x5f, 0x42, 0x5d, 0xc5, 0x8c, 0xe7, 0xb1,
-	0x55, 0x2a, 0xee, 0xd0, 0xac, 0x27, 0xf6, 0xe5, 0x5f, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x1b,
-	0x3c, 0xda, 0x98, 0x4d, 0x04, 0x00, 0x00,
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xdb, 0x6e, 0xdb, 0x46,
+	0x10, 0x0d, 0x45, 0x45, 0x96, 0x47, 0xbe, 0xd0, 0xdb, 0xd6, 0x20, 0x88, 0xc4, 0x51, 0x85, 0xa0,
+	0x10, 0x0c, 0x54, 0x72, 0x6c, 0x04, 0x28, 0x0a, 0x34, 0x88, 0x6a, 0x33, 0xb0, 0x5b, 0xeb, 0x82,
+	0x95, 0xd4, 0xc2, 0x7d, 0x21, 0xa8, 0xe5, 0x46, 0x62, 0x43, 0x72, 0xd9, 0xe5, 0xca, 0xa8, 0xde,
+	0xfb, 0x35, 0x7d, 0xe8, 0x77, 0xf4, 0x27, 0xfa, 0x2f, 0xc5, 0x2e, 0x29, 0x99, 0xa4, 0xad, 0xbc,
+	0xcd, 0xe5, 0x9c, 0xd9, 0xcb, 0x9c, 0x59, 0x12, 0x2c, 0x3f, 0xba, 0x67, 0x3e, 0xa1, 0x09, 0x8f,
+	0x49, 0x77, 0x6d, 0x77, 0x62, 0xce, 0x04, 0x43, 0x8d, 0x5c, 0xce, 0x7a, 0x31, 0x67, 0x6c, 0x1e,
+	0xd0, 0xae, 0x1b, 0xfb, 0x5d, 0x37, 0x8a, 0x98, 0x70, 0x85, 0xcf, 0xa2, 0x0c, 0x6a, 0xed, 0xf2,
+	0x98, 0xa4, 0x66, 0xeb, 0x2d, 0x18, 0x97, 0x6e, 0x44, 0x68, 0x70, 0x93, 0xb2, 0xfb, 0xc9, 0x1c,
+	0x7d, 0x0d, 0x7b, 0xb1, 0xbb, 0x0a, 0x69, 0x24, 0x9c, 0x85, 0x9b, 0x2c, 0x4c, 0xad, 0xa9, 0xb5,
+	0xf7, 0x70, 0x23, 0x8b, 0x5d, 0xbb, 0xc9, 0xa2, 0xf5, 0x05, 0x1c, 0x15, 0x68, 0x98, 0x26, 0x71,
+	0xeb, 0x6f, 0x1d, 0xbe, 0xea, 0x79, 0xde, 0x35, 0x0b, 0xbc, 0x4d, 0xf8, 0x8f, 0x25, 0x4d, 0x04,
+	0x42, 0x50, 0x0d, 0x69, 0xc8, 0x54, 0xa5, 0x5d, 0xac, 0x6c, 0x19, 0x53, 0xd5, 0x2b, 0xaa, 0xba,
+	0xb2, 0xd1, 0x97, 0xf0, 0xfc, 0xde, 0x0d, 0x96, 0xd4, 0xd4, 0x9b, 0x5a, 0x5b, 0xc7, 0xa9, 0x83,
+	0x4e, 0x00, 0x94, 0xe1, 0x84, 0x89, 0x2b, 0x4c, 0x50, 0xa9, 0x5c, 0x04, 0x9d, 0x82, 0xe1, 0xd1,
+	0x84, 0x70, 0x3f, 0x96, 0x87, 0x4c, 0xf7, 0x5c, 0x55, 0x55, 0x1f, 0xc5, 0xd1, 0x31, 0xd4, 0xe8,
+	0x9f, 0xb1, 0xcf, 0x57, 0xe6, 0x73, 0x55, 0x27, 0xf3, 0xd0, 0x6b, 0xd8, 0xff, 0xe8, 0x06, 0xc1,
+	0xcc, 0x25, 0x9f, 0x1c, 0xd7, 0xf3, 0xb8, 0x59, 0x53, 0x5b, 0x2d, 0x06, 0x51, 0x13, 0x1a, 0x24,
+	0x10, 0xf7, 0x4e, 0x56, 0x62, 0xa7, 0xa9, 0xb5, 0xab, 0x38, 0x1f, 0x42, 0xe7, 0xd0, 0xe0, 0x6c,
+	0x29, 0xa8, 0xb3, 0xf0, 0x23, 0x91, 0x98, 0xf5, 0xa6, 0xde, 0x6e, 0x9c, 0x1b, 0x9d, 0x20, 0x92,
+	0x57, 0x8e, 0x65, 0xe6, 0xda, 0x8f, 0x04, 0xce, 0x83, 0x90, 0x09, 0x3b, 0x31, 0xf7, 0xef, 0x5d,
+	0x41, 0xcd, 0xdd, 0xa6, 0xd6, 0xae, 0xe3, 0xb5, 0x8b, 0x2e, 0xa0, 0x21, 0x21, 0x4e, 0xcc, 0x02,
+	0x9f, 0xac, 0xcc, 0x46, 0x53, 0x6b, 0x1f, 0x9c, 0x1f, 0x65, 0xd5, 0x64, 0xa1, 0x91, 0x4a, 0xe0,
+	0x3c, 0x4a, 0x1e, 0x65, 0xc1, 0x02, 0xcf, 0xf1, 0x96, 0x5c, 0x75, 0xdd, 0xdc, 0x53, 0xdb, 0x2c,
+	0x06, 0x5b, 0xef, 0x00, 0x95, 0x7b, 0x95, 0xc4, 0xa8, 0x0d, 0x87, 0xeb, 0xd6, 0xf3, 0xb4, 0x77,
+	0x59, 0xcf, 0xca, 0xe1, 0x56, 0x07, 0x8c, 0x31, 0x15, 0x22, 0xa0, 0x39, 0xe1, 0x58, 0x50, 0x8f,
+	0x39, 0xf5, 0x43, 0x77, 0x4e, 0x33, 0xd1, 0x6c, 0x7c, 0xa9, 0x98, 0x02, 0x5e, 0x29, 0xe6, 0x07,
+	0x78, 0x39, 0x5e, 0xce, 0x64, 0x8b, 0x66, 0x74, 0xec, 0x47, 0xf3, 0x5c, 0x36, 0x15, 0xce, 0x31,
+	0xd4, 0xb8, 0x93, 0x93, 0x49, 0xe6, 0xfd, 0x54, 0xad, 0x6b, 0x46, 0xa5, 0xf5, 0xaf, 0x0e, 0x47,
+	0xd7, 0x22, 0x20, 0x3d, 0x42, 0x68, 0x2c, 0xd6, 0x9c, 0xd6, 0x93, 0xf2, 0x2d, 0xc4, 0xe4, 0x95,
+	0x93, 0x85, 0x1b, 0x39, 0xbe, 0xa7, 0x0a, 0x57, 0xf1, 0xda, 0x95, 0x99, 0x85, 0x08, 0x88, 0xcc,
+	0xe8, 0x69, 0x26, 0x73, 0xe5, 0xe9, 0xdc, 0x50, 0xa4, 0x22, 0xac, 0xaa, 0xd4, 0xc6, 0x2f, 0xc9,
+	0x6a, 0x7f, 0x23, 0xab, 0x6f, 0xe0, 0x80, 0x2c, 0x39, 0x57, 0xeb, 0x52, 0x7f, 0xbe, 0x10, 0x4a,
+	0x57, 0xcf, 0x71, 0x29, 0x8a, 0xee, 0x24, 0x2e, 0x11, 0x2c, 0x74, 0x38, 0x25, 0x8c, 0x7b, 0x89,
+	0xb9, 0xa3, 0x94, 0xf3, 0xa6, 0x93, 0x9b, 0xea, 0xce, 0xa3, 0xb3, 0x76, 0x2e, 0x15, 0x09, 0xa7,
+	0x1c, 0x3b, 0x12, 0x7c, 0x85, 0x4b, 0x85, 0xd0, 0x19, 0x40, 0x18, 0xc7, 0x99, 0x6b, 0xd6, 0x9b,
+	0x5a, 0x4e, 0x90, 0xfd, 0xd1, 0x28, 0xe5, 0xe2, 0x1c, 0x46, 0x32, 0xdc, 0x70, 0xc3, 0xd8, 0x2d,
+	0x30, 0x7a, 0xfd, 0x0d, 0xe3, 0x01, 0x63, 0xbd, 0x07, 0xf4, 0x78, 0x27, 0xc8, 0x00, 0xfd, 0x13,
+	0x5d, 0xa9, 0xfb, 0xaf, 0x62, 0x69, 0x3e, 0xcc, 0x77, 0xda, 0xcd, 0xd4, 0xf9, 0xbe, 0xf2, 0x9d,
+	0xd6, 0xfa, 0x4f, 0x03, 0x94, 0x3f, 0x5e, 0x12, 0xb3, 0x28, 0xa1, 0xf9, 0x3e, 0x69, 0x5b, 0xfb,
+	0x54, 0x29, 0xf6, 0xe9, 0x2d, 0xd4, 0x5c, 0xa2, 0x84, 0xaf, 0xab, 0x79, 0x79, 0xb9, 0xe5, 0x0e,
+	0x7b, 0x0a, 0x84, 0x33, 0x70, 0x41, 0xbc, 0xd5, 0xa2, 0x78, 0xd1, 0x7b, 0xd8, 0xfb, 0xe8, 0xfa,
+	0xc1, 0x92, 0x53, 0x87, 0x30, 0x8f, 0xaa, 0x26, 0x1f, 0x9c, 0xbf, 0x78, 0x54, 0xf8, 0x43, 0x0a,
+	0xba, 0x64, 0x1e, 0xc5, 0x05, 0xc6, 0xe9, 0x3b, 0x30, 0xca, 0x2b, 0x23, 0x80, 0x1a, 0xb6, 0xc7,
+	0xd3, 0xbe, 0x6d, 0x3c, 0x93, 0xf6, 0xd8, 0x9e, 0x4c, 0x6e, 0x6d, 0x43, 0x43, 0x75, 0xa8, 0x7e,
+	0xe8, 0xdd, 0xdc, 0x1a, 0x15, 0x69, 0x5d, 0x0f, 0x6f, 0xaf, 0x0c, 0xfd, 0xf4, 0x2f, 0x0d, 0x0e,
+	0x4b, 0x2b, 0xa0, 0x36, 0xbc, 0xbe, 0x19, 0x5c, 0x0e, 0x31, 0xb6, 0x2f, 0x27, 0xce, 0x10, 0x3b,
+	0xd3, 0xc1, 0xcf, 0x83, 0xe1, 0xaf, 0x03, 0x67, 0xd4, 0xbb, 0xeb, 0xdb, 0x83, 0x89, 0x73, 0x65,
+	0x4f, 0x7a, 0x37, 0xb7, 0x63, 0xe3, 0x19, 0xb2, 0xe0, 0x78, 0x62, 0xf7, 0x47, 0x43, 0xdc, 0xc3,
+	0x77, 0xce, 0x60, 0x78, 0x65, 0x3b, 0x72, 0x81, 0x29, 0x96, 0xab, 0x59, 0x70, 0x3c, 0xb2, 0x71,
+	0xbf, 0x37, 0x90, 0x94, 0x42, 0xae, 0x82, 0x0e, 0xa1, 0xd1, 0x1f, 0x8d, 0x9c, 0xc9, 0x4d, 0xdf,
+	0x1e, 0x4e, 0x27, 0x86, 0x7e, 0xfe, 0x8f, 0x0e, 0xf5, 0x6c, 0x44, 0x13, 0xf4, 0x0b, 0x1c, 0x3f,
+	0x3d, 0xbd, 0xe8, 0xb4, 0x70, 0x33, 0x9f, 0x1d, 0x71, 0xeb, 0x20, 0x53, 0x56, 0x16, 0x3e, 0xd3,
+	0xd0, 0x00, 0xf6, 0x0b, 0x1f, 0x17, 0x54, 0xec, 0x60, 0xf9, 0x7b, 0x65, 0x9d, 0x6c, 0x4f, 0xab,
+	0x47, 0x6d, 0x0a, 0x07, 0xc5, 0xa7, 0x0e, 0xb5, 0x0a, 0x8c, 0x27, 0xbf, 0x59, 0xd6, 0xab, 0xcf,
+	0x62, 0x92, 0x58, 0x6e, 0xb3, 0xf0, 0xa2, 0x95, 0xb6, 0x59, 0x7e, 0x1d, 0xad, 0x93, 0xed, 0x69,
+	0x55, 0x6f, 0x0c, 0x7b, 0x0f, 0x12, 0x61, 0x1c, 0xbd, 0xda, 0x3a, 0xfb, 0xe9, 0x70, 0x58, 0x27,
+	0x5b, 0x01, 0xea, 0x04, 0x6d, 0xed, 0x4c, 0xfb, 0xf1, 0xe2, 0xb7, 0x37, 0x73, 0x5f, 0x2c, 0x96,
+	0xb3, 0x0e, 0x61, 0x61, 0x57, 0x0a, 0x2f, 0xf2, 0x97, 0xe1, 0xb7, 0x31, 0x67, 0xbf, 0x53, 0x22,
+	0xba, 0x41, 0xe4, 0x75, 0x83, 0x28, 0xff, 0x37, 0xc1, 0x63, 0x32, 0xab, 0xa9, 0x7f, 0x83, 0x8b,
+	0xff, 0x07, 0x00, 0xac, 0xaf, 0x94, 0xdd, 0x6f, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -464,6 +778,16 @@ type InvoicesClient interface {
 	//SettleInvoice settles an accepted invoice. If the invoice is already
 	//settled, this call will succeed.
 	SettleInvoice(ctx context.Context, in *SettleInvoiceMsg, opts ...grpc.CallOption) (*SettleInvoiceResp, error)
+	//*
+	//HtlcAcceptor dispatches a bi-directional streaming RPC in which every HTLC
+	//paying to this node, including keysend HTLCs and HTLCs for unknown payment
+	//hashes, is sent to the client before it is processed. The client responds
+	//with a decision for each HTLC: resume the default processing, settle it with
+	//a preimage, fail it, or hold it until a later decision. If no decision is
+	//made in time, the HTLC is processed as usual. Only a single acceptor can be
+	//active at a time, and HTLCs that are held when the stream closes are
+	//processed as usual.
+	HtlcAcceptor(ctx context.Context, opts ...grpc.CallOption) (Invoices_HtlcAcceptorClient, error)
 }
 
 type invoicesClient struct {
@@ -533,6 +857,37 @@ func (c *invoicesClient) SettleInvoice(ctx context.Context, in *SettleInvoiceMsg
 	return out, nil
 }
 
+func (c *invoicesClient) HtlcAcceptor(ctx context.Context, opts ...grpc.CallOption) (Invoices_HtlcAcceptorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Invoices_serviceDesc.Streams[1], "/invoicesrpc.Invoices/HtlcAcceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &invoicesHtlcAcceptorClient{stream}
+	return x, nil
+}
+
+type Invoices_HtlcAcceptorClient interface {
+	Send(*HtlcAcceptResponse) error
+	Recv() (*HtlcAcceptRequest, error)
+	grpc.ClientStream
+}
+
+type invoicesHtlcAcceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *invoicesHtlcAcceptorClient) Send(m *HtlcAcceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *invoicesHtlcAcceptorClient) Recv() (*HtlcAcceptRequest, error) {
+	m := new(HtlcAcceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // InvoicesServer is the server API for Invoices service.
 type InvoicesServer interface {
 	//*
@@ -553,6 +908,16 @@ type InvoicesServer interface {
 	//SettleInvoice settles an accepted invoice. If the invoice is already
 	//settled, this call will succeed.
 	SettleInvoice(context.Context, *SettleInvoiceMsg) (*SettleInvoiceResp, error)
+	//*
+	//HtlcAcceptor dispatches a bi-directional streaming RPC in which every HTLC
+	//paying to this node, including keysend HTLCs and HTLCs for unknown payment
+	//hashes, is sent to the client before it is processed. The client responds
+	//with a decision for each HTLC: resume the default processing, settle it with
+	//a preimage, fail it, or hold it until a later decision. If no decision is
+	//made in time, the HTLC is processed as usual. Only a single acceptor can be
+	//active at a time, and HTLCs that are held when the stream closes are
+	//processed as usual.
+	HtlcAcceptor(Invoices_HtlcAcceptorServer) error
 }
 
 func RegisterInvoicesServer(s *grpc.Server, srv InvoicesServer) {
@@ -634,6 +999,32 @@ func _Invoices_SettleInvoice_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Invoices_HtlcAcceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(InvoicesServer).HtlcAcceptor(&invoicesHtlcAcceptorServer{stream})
+}
+
+type Invoices_HtlcAcceptorServer interface {
+	Send(*HtlcAcceptRequest) error
+	Recv() (*HtlcAcceptResponse, error)
+	grpc.ServerStream
+}
+
+type invoicesHtlcAcceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *invoicesHtlcAcceptorServer) Send(m *HtlcAcceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *invoicesHtlcAcceptorServer) Recv() (*HtlcAcceptResponse, error) {
+	m := new(HtlcAcceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Invoices_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "invoicesrpc.Invoices",
 	HandlerType: (*InvoicesServer)(nil),
@@ -657,6 +1048,12 @@ var _Invoices_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Invoices_SubscribeSingleInvoice_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "HtlcAcceptor",
+			Handler:       _Invoices_HtlcAcceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "invoicesrpc/invoices.proto",
 }
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -56,6 +57,10 @@ var (
 			Entity: "invoices",
 			Action: "write",
 		}},
+		"/invoicesrpc.Invoices/HtlcAcceptor": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
 	}
 
 	// DefaultInvoicesMacFilename is the default name of the invoices
@@ -254,6 +259,7 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 		DefaultCLTVExpiry:  s.cfg.DefaultCLTVExpiry,
 		ChanDB:             s.cfg.ChanDB,
 		GenInvoiceFeatures: s.cfg.GenInvoiceFeatures,
+		GetChannelUptime:   s.cfg.GetChannelUptime,
 	}
 
 	hash, err := lntypes.MakeHash(invoice.Hash)
@@ -275,6 +281,8 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 		FallbackAddr:    invoice.FallbackAddr,
 		CltvExpiry:      invoice.CltvExpiry,
 		Private:         invoice.Private,
+		HintPolicy:      HintPolicy(invoice.HintPolicy),
+		HoldDuration:    time.Duration(invoice.HoldDuration) * time.Second,
 	}
 
 	_, dbInvoice, err := AddInvoice(ctx, addInvoiceCfg, addInvoiceData)
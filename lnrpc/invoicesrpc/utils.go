@@ -15,13 +15,18 @@ import (
 // decodePayReq decodes the invoice payment request if present. This is needed,
 // because not all information is stored in dedicated invoice fields. If there
 // is no payment request present, a dummy request will be returned. This can
-// happen with just-in-time inserted keysend invoices.
+// happen with just-in-time inserted keysend and AMP invoices. The dummy request
+// of a spontaneous AMP invoice only carries a payment hash once the invoice is
+// settled and the root secret of the payment has been reconstructed.
 func decodePayReq(invoice *channeldb.Invoice,
 	activeNetParams *chaincfg.Params) (*zpay32.Invoice, error) {
 
 	paymentRequest := string(invoice.PaymentRequest)
 	if paymentRequest == "" {
 		preimage := invoice.Terms.PaymentPreimage
+		if preimage == channeldb.UnknownPreimage && isAMP(invoice) {
+			return &zpay32.Invoice{}, nil
+		}
 		if preimage == channeldb.UnknownPreimage {
 			return nil, errors.New("cannot reconstruct pay req")
 		}
@@ -89,6 +94,23 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 			invoice.State)
 	}
 
+	var cancelReason lnrpc.Invoice_CancelReason
+	switch invoice.CancelReason {
+	case channeldb.CancelReasonNone:
+		cancelReason = lnrpc.Invoice_NONE
+	case channeldb.CancelReasonUser:
+		cancelReason = lnrpc.Invoice_USER
+	case channeldb.CancelReasonExpired:
+		cancelReason = lnrpc.Invoice_EXPIRED
+	case channeldb.CancelReasonHoldTimeout:
+		cancelReason = lnrpc.Invoice_HOLD_TIMEOUT
+	case channeldb.CancelReasonHtlcExpiry:
+		cancelReason = lnrpc.Invoice_HTLC_EXPIRY
+	default:
+		return nil, fmt.Errorf("unknown cancel reason %v",
+			invoice.CancelReason)
+	}
+
 	rpcHtlcs := make([]*lnrpc.InvoiceHTLC, 0, len(invoice.Htlcs))
 	for key, htlc := range invoice.Htlcs {
 		var state lnrpc.InvoiceHTLCState
@@ -120,12 +142,40 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 			rpcHtlc.ResolveTime = htlc.ResolveTime.Unix()
 		}
 
+		// Report the AMP details of the htlc if present.
+		if htlc.AMP != nil {
+			rootShare := htlc.AMP.Record.RootShare()
+			setID := htlc.AMP.Record.SetID()
+
+			rpcHtlc.Amp = &lnrpc.AMP{
+				RootShare:  rootShare[:],
+				SetId:      setID[:],
+				ChildIndex: uint32(htlc.AMP.Record.ChildIndex()),
+				Hash:       htlc.AMP.Hash[:],
+			}
+			if htlc.AMP.Preimage != nil {
+				rpcHtlc.Amp.Preimage = htlc.AMP.Preimage[:]
+			}
+		}
+
 		rpcHtlcs = append(rpcHtlcs, &rpcHtlc)
 	}
 
+	isAmp := isAMP(invoice)
+
+	rpcPayments := make([]*lnrpc.InvoicePayment, 0, len(invoice.Payments))
+	for _, payment := range invoice.Payments {
+		rpcPayments = append(rpcPayments, &lnrpc.InvoicePayment{
+			Index:         payment.Index,
+			AmtMsat:       uint64(payment.Amt),
+			SettleTime:    payment.SettleTime.Unix(),
+			NumHtlcs:      payment.NumHtlcs,
+			CustomRecords: payment.CustomRecords,
+		})
+	}
+
 	rpcInvoice := &lnrpc.Invoice{
 		Memo:            string(invoice.Memo[:]),
-		RHash:           decoded.PaymentHash[:],
 		Value:           int64(satAmt),
 		ValueMsat:       int64(invoice.Terms.Value),
 		CreationDate:    invoice.CreationDate.Unix(),
@@ -146,7 +196,21 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 		State:           state,
 		Htlcs:           rpcHtlcs,
 		Features:        CreateRPCFeatures(invoice.Terms.Features),
-		IsKeysend:       len(invoice.PaymentRequest) == 0,
+		IsKeysend:       len(invoice.PaymentRequest) == 0 && !isAmp,
+		IsAmp:           isAmp,
+		Reusable:        invoice.Reusable,
+		Payments:        rpcPayments,
+
+		KeysendSender:         invoice.KeySendSender,
+		KeysendSenderVerified: invoice.KeySendSenderVerified,
+		KeysendReplyTo:        string(invoice.KeySendReplyTo),
+
+		CancelReason: cancelReason,
+		HoldDuration: uint64(invoice.Terms.HoldDuration.Seconds()),
+	}
+
+	if decoded.PaymentHash != nil {
+		rpcInvoice.RHash = decoded.PaymentHash[:]
 	}
 
 	if preimage != channeldb.UnknownPreimage {
@@ -156,6 +220,14 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 	return rpcInvoice, nil
 }
 
+// isAMP returns true if the invoice can only be paid with an atomic multi-path
+// payment.
+func isAMP(invoice *channeldb.Invoice) bool {
+	features := invoice.Terms.Features
+
+	return features != nil && features.HasFeature(lnwire.AMPOptional)
+}
+
 // CreateRPCFeatures maps a feature vector into a list of lnrpc.Features.
 func CreateRPCFeatures(fv *lnwire.FeatureVector) map[uint32]*lnrpc.Feature {
 	if fv == nil {
@@ -2,7 +2,10 @@ package lnrpc
 
 import (
 	"errors"
+	"fmt"
 
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/acmd/wire"
 	"github.com/Actinium-project/acmutil"
 	"github.com/Actinium-project/lnd/lnwire"
 )
@@ -54,3 +57,60 @@ func UnmarshallAmt(amtSat, amtMsat int64) (lnwire.MilliSatoshi, error) {
 
 	return lnwire.MilliSatoshi(amtMsat), nil
 }
+
+// UnmarshallOutPoint converts an outpoint from its lnrpc type to its canonical
+// type.
+func UnmarshallOutPoint(op *OutPoint) (*wire.OutPoint, error) {
+	if op == nil {
+		return nil, fmt.Errorf("empty outpoint provided")
+	}
+
+	var hash chainhash.Hash
+	switch {
+	case len(op.TxidBytes) == 0 && len(op.TxidStr) == 0:
+		fallthrough
+
+	case len(op.TxidBytes) != 0 && len(op.TxidStr) != 0:
+		return nil, fmt.Errorf("either TxidBytes or TxidStr must be " +
+			"specified, but not both")
+
+	// The hash was provided as raw bytes.
+	case len(op.TxidBytes) != 0:
+		copy(hash[:], op.TxidBytes)
+
+	// The hash was provided as a hex-encoded string.
+	case len(op.TxidStr) != 0:
+		h, err := chainhash.NewHashFromStr(op.TxidStr)
+		if err != nil {
+			return nil, err
+		}
+		hash = *h
+	}
+
+	return &wire.OutPoint{
+		Hash:  hash,
+		Index: op.OutputIndex,
+	}, nil
+}
+
+// UnmarshallOutPoints converts a list of outpoints from their lnrpc type to
+// their canonical type, failing if any outpoint is listed more than once.
+func UnmarshallOutPoints(ops []*OutPoint) ([]wire.OutPoint, error) {
+	outPoints := make([]wire.OutPoint, 0, len(ops))
+	seen := make(map[wire.OutPoint]struct{}, len(ops))
+	for _, op := range ops {
+		outPoint, err := UnmarshallOutPoint(op)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[*outPoint]; ok {
+			return nil, fmt.Errorf("duplicate outpoint %v", outPoint)
+		}
+		seen[*outPoint] = struct{}{}
+
+		outPoints = append(outPoints, *outPoint)
+	}
+
+	return outPoints, nil
+}
@@ -6,8 +6,8 @@ package routerrpc
 import (
 	context "context"
 	fmt "fmt"
-	proto "github.com/golang/protobuf/proto"
 	lnrpc "github.com/Actinium-project/lnd/lnrpc"
+	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	math "math"
 )
@@ -264,10 +264,29 @@ type SendPaymentRequest struct {
 	//optional or remote may be set, but not both. If this field is nil or empty,
 	//the router will try to load destination features from the graph as a
 	//fallback.
-	DestFeatures         []lnrpc.FeatureBit `protobuf:"varint,16,rep,packed,name=dest_features,json=destFeatures,proto3,enum=lnrpc.FeatureBit" json:"dest_features,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	DestFeatures []lnrpc.FeatureBit `protobuf:"varint,16,rep,packed,name=dest_features,json=destFeatures,proto3,enum=lnrpc.FeatureBit" json:"dest_features,omitempty"`
+	//*
+	//If set, the payment is sent as an atomic multi-path payment. The amount is
+	//split into amp_shards shards, each carrying its own payment hash derived
+	//from a share of a random root secret. The receiver can only settle the
+	//shards once all of them have arrived. If no payment request is given, the
+	//payment is spontaneous and payment_hash must be left empty. [EXPERIMENTAL]
+	Amp bool `protobuf:"varint,17,opt,name=amp,proto3" json:"amp,omitempty"`
+	//*
+	//The number of shards an AMP payment is split into. If zero, the payment is
+	//split into two shards. [EXPERIMENTAL]
+	AmpShards uint32 `protobuf:"varint,18,opt,name=amp_shards,json=ampShards,proto3" json:"amp_shards,omitempty"`
+	//*
+	//If set, the payment is routed through the trampoline node configured with
+	//trampoline.node. Only a route to the trampoline node is needed, which finds
+	//the route to the destination. This allows nodes with a partial graph, like
+	//neutrino nodes, to send payments. The fee of the trampoline node is paid
+	//from the fee limit. Route hints, custom records and AMP aren't supported.
+	//[EXPERIMENTAL]
+	Trampoline           bool     `protobuf:"varint,19,opt,name=trampoline,proto3" json:"trampoline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SendPaymentRequest) Reset()         { *m = SendPaymentRequest{} }
@@ -407,6 +426,27 @@ func (m *SendPaymentRequest) GetDestFeatures() []lnrpc.FeatureBit {
 	return nil
 }
 
+func (m *SendPaymentRequest) GetAmp() bool {
+	if m != nil {
+		return m.Amp
+	}
+	return false
+}
+
+func (m *SendPaymentRequest) GetAmpShards() uint32 {
+	if m != nil {
+		return m.AmpShards
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetTrampoline() bool {
+	if m != nil {
+		return m.Trampoline
+	}
+	return false
+}
+
 type TrackPaymentRequest struct {
 	/// The hash of the payment to look up.
 	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
@@ -1501,6 +1541,228 @@ func (m *BuildRouteResponse) GetRoute() *lnrpc.Route {
 	return nil
 }
 
+type ProbePaymentRequest struct {
+	//*
+	//The candidate routes to probe. Every route is probed with an htlc of the
+	//amount of the route, one route after the other.
+	Routes               []*lnrpc.Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ProbePaymentRequest) Reset()         { *m = ProbePaymentRequest{} }
+func (m *ProbePaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*ProbePaymentRequest) ProtoMessage()    {}
+func (*ProbePaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{19}
+}
+
+func (m *ProbePaymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbePaymentRequest.Unmarshal(m, b)
+}
+func (m *ProbePaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbePaymentRequest.Marshal(b, m, deterministic)
+}
+func (m *ProbePaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbePaymentRequest.Merge(m, src)
+}
+func (m *ProbePaymentRequest) XXX_Size() int {
+	return xxx_messageInfo_ProbePaymentRequest.Size(m)
+}
+func (m *ProbePaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbePaymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbePaymentRequest proto.InternalMessageInfo
+
+func (m *ProbePaymentRequest) GetRoutes() []*lnrpc.Route {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type RouteProbe struct {
+	/// The route that was probed.
+	Route *lnrpc.Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	//*
+	//Whether the probe reached the final hop of the route, meaning that the
+	//route can carry its amount.
+	ReachedDestination bool `protobuf:"varint,2,opt,name=reached_destination,json=reachedDestination,proto3" json:"reached_destination,omitempty"`
+	/// The number of hops of the route whose node received the probe.
+	ReachedHops uint32 `protobuf:"varint,3,opt,name=reached_hops,json=reachedHops,proto3" json:"reached_hops,omitempty"`
+	/// The failure the probe was failed back with.
+	Failure              *Failure `protobuf:"bytes,4,opt,name=failure,proto3" json:"failure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RouteProbe) Reset()         { *m = RouteProbe{} }
+func (m *RouteProbe) String() string { return proto.CompactTextString(m) }
+func (*RouteProbe) ProtoMessage()    {}
+func (*RouteProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{20}
+}
+
+func (m *RouteProbe) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteProbe.Unmarshal(m, b)
+}
+func (m *RouteProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteProbe.Marshal(b, m, deterministic)
+}
+func (m *RouteProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteProbe.Merge(m, src)
+}
+func (m *RouteProbe) XXX_Size() int {
+	return xxx_messageInfo_RouteProbe.Size(m)
+}
+func (m *RouteProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteProbe proto.InternalMessageInfo
+
+func (m *RouteProbe) GetRoute() *lnrpc.Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *RouteProbe) GetReachedDestination() bool {
+	if m != nil {
+		return m.ReachedDestination
+	}
+	return false
+}
+
+func (m *RouteProbe) GetReachedHops() uint32 {
+	if m != nil {
+		return m.ReachedHops
+	}
+	return 0
+}
+
+func (m *RouteProbe) GetFailure() *Failure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+type HopReach struct {
+	/// The channel id of the channel of the hop.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	/// The public key of the node the hop leads to.
+	PubKey []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	//*
+	//The maximum amount of the probes that reached the node of the hop over
+	//the channel, in millisatoshis. It is zero if no probe reached it.
+	MaxAmtMsat           int64    `protobuf:"varint,3,opt,name=max_amt_msat,json=maxAmtMsat,proto3" json:"max_amt_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HopReach) Reset()         { *m = HopReach{} }
+func (m *HopReach) String() string { return proto.CompactTextString(m) }
+func (*HopReach) ProtoMessage()    {}
+func (*HopReach) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{21}
+}
+
+func (m *HopReach) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HopReach.Unmarshal(m, b)
+}
+func (m *HopReach) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HopReach.Marshal(b, m, deterministic)
+}
+func (m *HopReach) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopReach.Merge(m, src)
+}
+func (m *HopReach) XXX_Size() int {
+	return xxx_messageInfo_HopReach.Size(m)
+}
+func (m *HopReach) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopReach.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HopReach proto.InternalMessageInfo
+
+func (m *HopReach) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *HopReach) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *HopReach) GetMaxAmtMsat() int64 {
+	if m != nil {
+		return m.MaxAmtMsat
+	}
+	return 0
+}
+
+type ProbePaymentResponse struct {
+	/// The outcome of the probe of every route, in the order of the request.
+	Probes []*RouteProbe `protobuf:"bytes,1,rep,name=probes,proto3" json:"probes,omitempty"`
+	//*
+	//The maximum amount that reached every hop of the probed routes, in the
+	//order the hops appear in the routes.
+	Hops                 []*HopReach `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ProbePaymentResponse) Reset()         { *m = ProbePaymentResponse{} }
+func (m *ProbePaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*ProbePaymentResponse) ProtoMessage()    {}
+func (*ProbePaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a0613f69d37b0a5, []int{22}
+}
+
+func (m *ProbePaymentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbePaymentResponse.Unmarshal(m, b)
+}
+func (m *ProbePaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbePaymentResponse.Marshal(b, m, deterministic)
+}
+func (m *ProbePaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbePaymentResponse.Merge(m, src)
+}
+func (m *ProbePaymentResponse) XXX_Size() int {
+	return xxx_messageInfo_ProbePaymentResponse.Size(m)
+}
+func (m *ProbePaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbePaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbePaymentResponse proto.InternalMessageInfo
+
+func (m *ProbePaymentResponse) GetProbes() []*RouteProbe {
+	if m != nil {
+		return m.Probes
+	}
+	return nil
+}
+
+func (m *ProbePaymentResponse) GetHops() []*HopReach {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("routerrpc.PaymentState", PaymentState_name, PaymentState_value)
 	proto.RegisterEnum("routerrpc.Failure_FailureCode", Failure_FailureCode_name, Failure_FailureCode_value)
@@ -1524,144 +1786,162 @@ func init() {
 	proto.RegisterType((*QueryProbabilityResponse)(nil), "routerrpc.QueryProbabilityResponse")
 	proto.RegisterType((*BuildRouteRequest)(nil), "routerrpc.BuildRouteRequest")
 	proto.RegisterType((*BuildRouteResponse)(nil), "routerrpc.BuildRouteResponse")
+	proto.RegisterType((*ProbePaymentRequest)(nil), "routerrpc.ProbePaymentRequest")
+	proto.RegisterType((*RouteProbe)(nil), "routerrpc.RouteProbe")
+	proto.RegisterType((*HopReach)(nil), "routerrpc.HopReach")
+	proto.RegisterType((*ProbePaymentResponse)(nil), "routerrpc.ProbePaymentResponse")
 }
 
 func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_7a0613f69d37b0a5) }
 
 var fileDescriptor_7a0613f69d37b0a5 = []byte{
-	// 2102 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xcb, 0x73, 0xdb, 0xc6,
-	0x19, 0x0f, 0xc4, 0xf7, 0xc7, 0x17, 0xb4, 0x92, 0x65, 0x98, 0xb2, 0x62, 0x05, 0x76, 0x1d, 0x8e,
-	0xc7, 0x91, 0x5c, 0xb5, 0xf1, 0x78, 0x7a, 0x68, 0x87, 0x22, 0xc1, 0x08, 0x32, 0x09, 0xca, 0x4b,
-	0xd2, 0x89, 0x9b, 0xc3, 0x0e, 0x44, 0xae, 0x44, 0x8c, 0x40, 0x80, 0x01, 0x96, 0x8e, 0xf5, 0x0f,
-	0xf4, 0xd4, 0xff, 0xa3, 0xbd, 0xb4, 0x97, 0x9e, 0xfb, 0xef, 0xb4, 0xf7, 0xde, 0x7a, 0xeb, 0xec,
-	0x2e, 0x40, 0x82, 0x14, 0xe5, 0xf4, 0x44, 0xec, 0xef, 0x7b, 0xed, 0xee, 0xb7, 0xdf, 0x8b, 0xb0,
-	0x17, 0xf8, 0x73, 0x46, 0x83, 0x60, 0x36, 0x3a, 0x96, 0x5f, 0x47, 0xb3, 0xc0, 0x67, 0x3e, 0x2a,
-	0x2c, 0xf0, 0x5a, 0x21, 0x98, 0x8d, 0x24, 0xaa, 0xff, 0x37, 0x03, 0xa8, 0x4f, 0xbd, 0xf1, 0x85,
-	0x7d, 0x3b, 0xa5, 0x1e, 0xc3, 0xf4, 0xa7, 0x39, 0x0d, 0x19, 0x42, 0x90, 0x1e, 0xd3, 0x90, 0x69,
-	0xca, 0xa1, 0x52, 0x2f, 0x61, 0xf1, 0x8d, 0x54, 0x48, 0xd9, 0x53, 0xa6, 0x6d, 0x1d, 0x2a, 0xf5,
-	0x14, 0xe6, 0x9f, 0xe8, 0x11, 0xe4, 0xed, 0x29, 0x23, 0xd3, 0xd0, 0x66, 0x5a, 0x49, 0xc0, 0x39,
-	0x7b, 0xca, 0xba, 0xa1, 0xcd, 0xd0, 0x57, 0x50, 0x9a, 0x49, 0x95, 0x64, 0x62, 0x87, 0x13, 0x2d,
-	0x25, 0x14, 0x15, 0x23, 0xec, 0xcc, 0x0e, 0x27, 0xa8, 0x0e, 0xea, 0x95, 0xe3, 0xd9, 0x2e, 0x19,
-	0xb9, 0xec, 0x23, 0x19, 0x53, 0x97, 0xd9, 0x5a, 0xfa, 0x50, 0xa9, 0x67, 0x70, 0x45, 0xe0, 0x4d,
-	0x97, 0x7d, 0x6c, 0x71, 0x14, 0x7d, 0x0d, 0xd5, 0x58, 0x59, 0x20, 0x37, 0xa8, 0x65, 0x0e, 0x95,
-	0x7a, 0x01, 0x57, 0x66, 0xab, 0xdb, 0xfe, 0x1a, 0xaa, 0xcc, 0x99, 0x52, 0x7f, 0xce, 0x48, 0x48,
-	0x47, 0xbe, 0x37, 0x0e, 0xb5, 0xac, 0xd4, 0x18, 0xc1, 0x7d, 0x89, 0x22, 0x1d, 0xca, 0x57, 0x94,
-	0x12, 0xd7, 0x99, 0x3a, 0x8c, 0xf0, 0xed, 0xe7, 0xc4, 0xf6, 0x8b, 0x57, 0x94, 0x76, 0x38, 0xd6,
-	0xb7, 0x19, 0x7a, 0x06, 0x95, 0x25, 0x8f, 0x38, 0x63, 0x59, 0x30, 0x95, 0x62, 0x26, 0x71, 0xd0,
-	0x97, 0xa0, 0xfa, 0x73, 0x76, 0xed, 0x3b, 0xde, 0x35, 0x19, 0x4d, 0x6c, 0x8f, 0x38, 0x63, 0x2d,
-	0x7f, 0xa8, 0xd4, 0xd3, 0xa7, 0x5b, 0xaf, 0x14, 0x5c, 0x89, 0x69, 0xcd, 0x89, 0xed, 0x99, 0x63,
-	0xf4, 0x1c, 0xaa, 0xae, 0x1d, 0x32, 0x32, 0xf1, 0x67, 0x64, 0x36, 0xbf, 0xbc, 0xa1, 0xb7, 0x5a,
-	0x45, 0xdc, 0x4c, 0x99, 0xc3, 0x67, 0xfe, 0xec, 0x42, 0x80, 0xe8, 0x00, 0x40, 0xdc, 0x8a, 0x30,
-	0xae, 0x15, 0xc4, 0x19, 0x0a, 0x1c, 0x11, 0x86, 0xd1, 0x09, 0x14, 0x85, 0x37, 0xc9, 0xc4, 0xf1,
-	0x58, 0xa8, 0xc1, 0x61, 0xaa, 0x5e, 0x3c, 0x51, 0x8f, 0x5c, 0x8f, 0x3b, 0x16, 0x73, 0xca, 0x99,
-	0xe3, 0x31, 0x9c, 0x64, 0x42, 0x63, 0xd8, 0xe1, 0x6e, 0x24, 0xa3, 0x79, 0xc8, 0xfc, 0x29, 0x09,
-	0xe8, 0xc8, 0x0f, 0xc6, 0xa1, 0x56, 0x14, 0xb2, 0xbf, 0x3d, 0x5a, 0xbc, 0x8e, 0xa3, 0xbb, 0xcf,
-	0xe1, 0xa8, 0x45, 0x43, 0xd6, 0x14, 0x72, 0x58, 0x8a, 0x19, 0x1e, 0x0b, 0x6e, 0xf1, 0xf6, 0x78,
-	0x1d, 0x47, 0x2f, 0x01, 0xd9, 0xae, 0xeb, 0xff, 0x4c, 0x42, 0xea, 0x5e, 0x91, 0xc8, 0x3d, 0x5a,
-	0xf5, 0x50, 0xa9, 0xe7, 0xb1, 0x2a, 0x28, 0x7d, 0xea, 0x5e, 0x45, 0xea, 0xd1, 0x6b, 0x28, 0x8b,
-	0x3d, 0x5d, 0x51, 0x9b, 0xcd, 0x03, 0x1a, 0x6a, 0xea, 0x61, 0xaa, 0x5e, 0x39, 0xd9, 0x8e, 0x4e,
-	0xd2, 0x96, 0xf0, 0xa9, 0xc3, 0x70, 0x89, 0xf3, 0x45, 0xeb, 0xb0, 0xd6, 0x82, 0xbd, 0xcd, 0x5b,
-	0xe2, 0x8f, 0x94, 0x5f, 0x2a, 0x7f, 0xb7, 0x69, 0xcc, 0x3f, 0xd1, 0x2e, 0x64, 0x3e, 0xda, 0xee,
-	0x9c, 0x8a, 0x87, 0x5b, 0xc2, 0x72, 0xf1, 0xbb, 0xad, 0x37, 0x8a, 0xfe, 0x06, 0x76, 0x06, 0x81,
-	0x3d, 0xba, 0x59, 0x7b, 0xfb, 0xeb, 0x4f, 0x57, 0xb9, 0xf3, 0x74, 0xf5, 0xbf, 0x2a, 0x50, 0x8e,
-	0xa4, 0xfa, 0xcc, 0x66, 0xf3, 0x10, 0x7d, 0x03, 0x99, 0x90, 0xd9, 0x8c, 0x0a, 0xee, 0xca, 0xc9,
-	0xc3, 0xc4, 0x7d, 0x26, 0x18, 0x29, 0x96, 0x5c, 0xa8, 0x06, 0xf9, 0x59, 0x40, 0x9d, 0xa9, 0x7d,
-	0x1d, 0xef, 0x6b, 0xb1, 0x46, 0x3a, 0x64, 0x84, 0xb0, 0x88, 0x99, 0xe2, 0x49, 0x29, 0xe9, 0x56,
-	0x2c, 0x49, 0xa8, 0x0e, 0x99, 0x09, 0x73, 0x47, 0xa1, 0x96, 0x16, 0xee, 0x43, 0x11, 0xcf, 0xd9,
-	0xa0, 0xd3, 0x6c, 0x30, 0x46, 0xa7, 0x33, 0x86, 0x25, 0x83, 0xfe, 0x7b, 0xa8, 0x0a, 0xc9, 0x36,
-	0xa5, 0x9f, 0x0b, 0xee, 0x87, 0xc0, 0x43, 0x57, 0x84, 0x82, 0x0c, 0xf0, 0xac, 0x3d, 0xe5, 0x51,
-	0xa0, 0x8f, 0x41, 0x5d, 0xca, 0x87, 0x33, 0xdf, 0x0b, 0xb9, 0x75, 0x95, 0x6f, 0x83, 0x3f, 0x79,
-	0x1e, 0x21, 0x22, 0x36, 0x14, 0x21, 0x55, 0x89, 0xf0, 0x36, 0xa5, 0x22, 0x3a, 0x9e, 0xcb, 0x80,
-	0x24, 0xae, 0x3f, 0xba, 0xe1, 0x21, 0x6e, 0xdf, 0x46, 0xea, 0xcb, 0x1c, 0xee, 0xf8, 0xa3, 0x9b,
-	0x16, 0x07, 0xf5, 0x1f, 0x65, 0x16, 0x1a, 0xf8, 0xf2, 0x94, 0xff, 0xb7, 0x27, 0x96, 0x97, 0xb5,
-	0x75, 0xef, 0x65, 0xe9, 0x04, 0x76, 0x56, 0x94, 0x47, 0xa7, 0x48, 0xfa, 0x40, 0x59, 0xf3, 0xc1,
-	0x4b, 0xc8, 0x5d, 0xd9, 0x8e, 0x3b, 0x0f, 0x62, 0xc5, 0x28, 0xe1, 0xd0, 0xb6, 0xa4, 0xe0, 0x98,
-	0x45, 0xff, 0x53, 0x1e, 0x72, 0x11, 0x88, 0x4e, 0x20, 0x3d, 0xf2, 0xc7, 0xf1, 0x3b, 0xf8, 0xf2,
-	0xae, 0x58, 0xfc, 0xdb, 0xf4, 0xc7, 0x14, 0x0b, 0x5e, 0xf4, 0x07, 0xa8, 0xf0, 0xd4, 0xe1, 0x51,
-	0x97, 0xcc, 0x67, 0x63, 0x7b, 0xe1, 0x7a, 0x2d, 0x21, 0xdd, 0x94, 0x0c, 0x43, 0x41, 0xc7, 0xe5,
-	0x51, 0x72, 0x89, 0xf6, 0xa1, 0xc0, 0xbd, 0x2d, 0x3d, 0x91, 0x16, 0x6f, 0x3f, 0xcf, 0x01, 0xe1,
-	0x03, 0x1d, 0xca, 0xbe, 0xe7, 0xf8, 0x1e, 0x09, 0x27, 0x36, 0x39, 0xf9, 0xf6, 0xb5, 0xc8, 0x9d,
-	0x25, 0x5c, 0x14, 0x60, 0x7f, 0x62, 0x9f, 0x7c, 0xfb, 0x1a, 0x3d, 0x81, 0xa2, 0xc8, 0x37, 0xf4,
-	0xd3, 0xcc, 0x09, 0x6e, 0x45, 0xd2, 0x2c, 0x63, 0x91, 0x82, 0x0c, 0x81, 0xf0, 0x28, 0xba, 0x72,
-	0xed, 0xeb, 0x50, 0x24, 0xca, 0x32, 0x96, 0x0b, 0xf4, 0x0a, 0x76, 0xa3, 0x3b, 0x20, 0xa1, 0x3f,
-	0x0f, 0x46, 0x94, 0x38, 0xde, 0x98, 0x7e, 0x12, 0x09, 0xb0, 0x8c, 0x51, 0x44, 0xeb, 0x0b, 0x92,
-	0xc9, 0x29, 0x68, 0x0f, 0xb2, 0x13, 0xea, 0x5c, 0x4f, 0x64, 0x52, 0x2b, 0xe3, 0x68, 0xa5, 0xff,
-	0x33, 0x03, 0xc5, 0xc4, 0xc5, 0xa0, 0x12, 0xe4, 0xb1, 0xd1, 0x37, 0xf0, 0x7b, 0xa3, 0xa5, 0x7e,
-	0x81, 0xea, 0xf0, 0xcc, 0xb4, 0x9a, 0x3d, 0x8c, 0x8d, 0xe6, 0x80, 0xf4, 0x30, 0x19, 0x5a, 0x6f,
-	0xad, 0xde, 0xf7, 0x16, 0xb9, 0x68, 0x7c, 0xe8, 0x1a, 0xd6, 0x80, 0xb4, 0x8c, 0x41, 0xc3, 0xec,
-	0xf4, 0x55, 0x05, 0x3d, 0x06, 0x6d, 0xc9, 0x19, 0x93, 0x1b, 0xdd, 0xde, 0xd0, 0x1a, 0xa8, 0x5b,
-	0xe8, 0x09, 0xec, 0xb7, 0x4d, 0xab, 0xd1, 0x21, 0x4b, 0x9e, 0x66, 0x67, 0xf0, 0x9e, 0x18, 0x3f,
-	0x5c, 0x98, 0xf8, 0x83, 0x9a, 0xda, 0xc4, 0xc0, 0x63, 0x2a, 0xd6, 0x90, 0x46, 0x8f, 0xe0, 0x81,
-	0x64, 0x90, 0x22, 0x64, 0xd0, 0xeb, 0x91, 0x7e, 0xaf, 0x67, 0xa9, 0x19, 0xb4, 0x0d, 0x65, 0xd3,
-	0x7a, 0xdf, 0xe8, 0x98, 0x2d, 0x82, 0x8d, 0x46, 0xa7, 0xab, 0x66, 0xd1, 0x0e, 0x54, 0xd7, 0xf9,
-	0x72, 0x5c, 0x45, 0xcc, 0xd7, 0xb3, 0xcc, 0x9e, 0x45, 0xde, 0x1b, 0xb8, 0x6f, 0xf6, 0x2c, 0x35,
-	0x8f, 0xf6, 0x00, 0xad, 0x92, 0xce, 0xba, 0x8d, 0xa6, 0x5a, 0x40, 0x0f, 0x60, 0x7b, 0x15, 0x7f,
-	0x6b, 0x7c, 0x50, 0x01, 0x69, 0xb0, 0x2b, 0x37, 0x46, 0x4e, 0x8d, 0x4e, 0xef, 0x7b, 0xd2, 0x35,
-	0x2d, 0xb3, 0x3b, 0xec, 0xaa, 0x45, 0xb4, 0x0b, 0x6a, 0xdb, 0x30, 0x88, 0x69, 0xf5, 0x87, 0xed,
-	0xb6, 0xd9, 0x34, 0x0d, 0x6b, 0xa0, 0x96, 0xa4, 0xe5, 0x4d, 0x07, 0x2f, 0x73, 0x81, 0xe6, 0x59,
-	0xc3, 0xb2, 0x8c, 0x0e, 0x69, 0x99, 0xfd, 0xc6, 0x69, 0xc7, 0x68, 0xa9, 0x15, 0x74, 0x00, 0x8f,
-	0x06, 0x46, 0xf7, 0xa2, 0x87, 0x1b, 0xf8, 0x03, 0x89, 0xe9, 0xed, 0x86, 0xd9, 0x19, 0x62, 0x43,
-	0xad, 0xa2, 0xaf, 0xe0, 0x00, 0x1b, 0xef, 0x86, 0x26, 0x36, 0x5a, 0xc4, 0xea, 0xb5, 0x0c, 0xd2,
-	0x36, 0x1a, 0x83, 0x21, 0x36, 0x48, 0xd7, 0xec, 0xf7, 0x4d, 0xeb, 0x3b, 0x55, 0x45, 0xcf, 0xe0,
-	0x70, 0xc1, 0xb2, 0x50, 0xb0, 0xc6, 0xb5, 0xcd, 0xcf, 0x17, 0xbb, 0xd4, 0x32, 0x7e, 0x18, 0x90,
-	0x0b, 0xc3, 0xc0, 0x2a, 0x42, 0x35, 0xd8, 0x5b, 0x9a, 0x97, 0x06, 0x22, 0xdb, 0x3b, 0x9c, 0x76,
-	0x61, 0xe0, 0x6e, 0xc3, 0xe2, 0x0e, 0x5e, 0xa1, 0xed, 0xf2, 0x6d, 0x2f, 0x69, 0xeb, 0xdb, 0x7e,
-	0x80, 0x10, 0x54, 0x12, 0x5e, 0x69, 0x37, 0xb0, 0xba, 0x87, 0xaa, 0x50, 0xec, 0x5e, 0x5c, 0x90,
-	0x81, 0xd9, 0x35, 0x7a, 0xc3, 0x81, 0xfa, 0x10, 0xed, 0x42, 0x35, 0xde, 0x52, 0x2c, 0xf9, 0xaf,
-	0x1c, 0x7a, 0x08, 0x68, 0x68, 0x61, 0xa3, 0xd1, 0xe2, 0x37, 0xb4, 0x20, 0xfc, 0x3b, 0x77, 0x9e,
-	0xce, 0x6f, 0xa9, 0x29, 0xfd, 0x1f, 0x29, 0x28, 0xaf, 0x04, 0x2a, 0x7a, 0x0c, 0x85, 0xd0, 0xb9,
-	0xf6, 0x44, 0xdd, 0x8a, 0xb2, 0xcc, 0x12, 0x10, 0x65, 0x7e, 0x62, 0x3b, 0x9e, 0x4c, 0x6f, 0xb2,
-	0x10, 0x14, 0x04, 0x22, 0x92, 0xdb, 0x3e, 0xe4, 0xe2, 0x96, 0x22, 0xb5, 0x68, 0x29, 0xb2, 0x23,
-	0xd9, 0x4a, 0x3c, 0x86, 0x02, 0xcf, 0xa1, 0x21, 0xb3, 0xa7, 0x33, 0x11, 0xf3, 0x65, 0xbc, 0x04,
-	0xd0, 0x53, 0x28, 0x4f, 0x69, 0x18, 0xda, 0xd7, 0x94, 0xc8, 0xb8, 0x05, 0xc1, 0x51, 0x8a, 0xc0,
-	0xb6, 0x08, 0xdf, 0xa7, 0x10, 0xe7, 0x91, 0x88, 0x29, 0x23, 0x99, 0x22, 0x50, 0x32, 0xad, 0xa7,
-	0x70, 0x66, 0x47, 0xe9, 0x21, 0x99, 0xc2, 0x99, 0x8d, 0x5e, 0xc0, 0xb6, 0xcc, 0x41, 0x8e, 0xe7,
-	0x4c, 0xe7, 0x53, 0x99, 0x8b, 0x72, 0x22, 0x17, 0x55, 0x45, 0x2e, 0x92, 0xb8, 0x48, 0x49, 0x8f,
-	0x20, 0x7f, 0x69, 0x87, 0x94, 0x57, 0x8f, 0x28, 0x57, 0xe4, 0xf8, 0xba, 0x4d, 0x29, 0x27, 0xf1,
-	0x9a, 0x12, 0xf0, 0x2c, 0x28, 0x53, 0x44, 0xee, 0x8a, 0x52, 0xcc, 0xef, 0x72, 0x61, 0xc1, 0xfe,
-	0xb4, 0xb4, 0x50, 0x4c, 0x58, 0x90, 0xb8, 0xb0, 0xf0, 0x02, 0xb6, 0xe9, 0x27, 0x16, 0xd8, 0xc4,
-	0x9f, 0xd9, 0x3f, 0xcd, 0x29, 0x19, 0xdb, 0xcc, 0x16, 0x3d, 0x6a, 0x09, 0x57, 0x05, 0xa1, 0x27,
-	0xf0, 0x96, 0xcd, 0x6c, 0xfd, 0x31, 0xd4, 0x30, 0x0d, 0x29, 0xeb, 0x3a, 0x61, 0xe8, 0xf8, 0x5e,
-	0xd3, 0xf7, 0x58, 0xe0, 0xbb, 0x51, 0x11, 0xd2, 0x0f, 0x60, 0x7f, 0x23, 0x55, 0x56, 0x11, 0x2e,
-	0xfc, 0x6e, 0x4e, 0x83, 0xdb, 0xcd, 0xc2, 0xef, 0x60, 0x7f, 0x23, 0x35, 0x2a, 0x41, 0x2f, 0x21,
-	0x33, 0xb3, 0x9d, 0x20, 0xd4, 0xb6, 0x44, 0x19, 0xdf, 0x5b, 0xe9, 0x1a, 0x9c, 0xe0, 0xcc, 0x09,
-	0x99, 0x1f, 0xdc, 0x62, 0xc9, 0x74, 0x9e, 0xce, 0x2b, 0xea, 0x96, 0xfe, 0x67, 0x05, 0x8a, 0x09,
-	0x22, 0x7f, 0x07, 0x9e, 0x3f, 0xa6, 0xe4, 0x2a, 0xf0, 0xa7, 0xf1, 0x0b, 0x5b, 0x00, 0x48, 0x83,
-	0x9c, 0x58, 0x30, 0x3f, 0x7a, 0x5e, 0xf1, 0x12, 0x7d, 0x03, 0xb9, 0x89, 0x54, 0x21, 0xbc, 0x54,
-	0x3c, 0xd9, 0x59, 0xb3, 0xce, 0xef, 0x06, 0xc7, 0x3c, 0xe7, 0xe9, 0x7c, 0x4a, 0x4d, 0x9f, 0xa7,
-	0xf3, 0x69, 0x35, 0x73, 0x9e, 0xce, 0x67, 0xd4, 0xec, 0x79, 0x3a, 0x9f, 0x55, 0x73, 0xfa, 0x7f,
-	0x14, 0xc8, 0xc7, 0xdc, 0x7c, 0x2f, 0x3c, 0xe7, 0x13, 0xfe, 0x32, 0xa2, 0x8e, 0x60, 0x09, 0x20,
-	0x1d, 0x4a, 0x62, 0xb1, 0xda, 0x68, 0xac, 0x60, 0xe8, 0x19, 0x94, 0x17, 0xeb, 0x45, 0x35, 0x4b,
-	0xe1, 0x55, 0x90, 0x6b, 0x0a, 0xe7, 0xa3, 0x11, 0x0d, 0x43, 0x69, 0x2a, 0x23, 0x35, 0x25, 0x31,
-	0x54, 0x87, 0x6a, 0xbc, 0x8e, 0x0d, 0x66, 0x05, 0xdb, 0x3a, 0x8c, 0x5e, 0x80, 0x9a, 0x84, 0xa6,
-	0xcb, 0x79, 0xe0, 0x0e, 0x2e, 0xaf, 0x41, 0x9f, 0xc2, 0x43, 0xe1, 0xd6, 0x8b, 0xc0, 0xbf, 0xb4,
-	0x2f, 0x1d, 0xd7, 0x61, 0xb7, 0x71, 0xcf, 0xc2, 0xaf, 0x20, 0xf0, 0xa7, 0xc4, 0x8b, 0x9b, 0x80,
-	0x12, 0x5e, 0x02, 0xdc, 0x1d, 0xcc, 0x97, 0xb4, 0xc8, 0x1d, 0xd1, 0x92, 0x77, 0x23, 0x0b, 0xe3,
-	0x29, 0x61, 0x7c, 0xb1, 0xd6, 0x6f, 0x40, 0xbb, 0x6b, 0x2e, 0x7a, 0x42, 0x87, 0x50, 0x9c, 0x2d,
-	0x61, 0x61, 0x51, 0xc1, 0x49, 0x28, 0xe9, 0xe8, 0xad, 0x5f, 0x76, 0xb4, 0xfe, 0x17, 0x05, 0xb6,
-	0x4f, 0xe7, 0x8e, 0x3b, 0x5e, 0x69, 0xc5, 0x92, 0xa3, 0x9e, 0xb2, 0x3a, 0xea, 0x6d, 0x9a, 0xe3,
-	0xb6, 0x36, 0xce, 0x71, 0x9b, 0x66, 0xa5, 0xd4, 0xbd, 0xb3, 0xd2, 0x13, 0x28, 0x2e, 0xc7, 0x24,
-	0xd9, 0xe9, 0x96, 0x30, 0x4c, 0xe2, 0x19, 0x29, 0xd4, 0xdf, 0x00, 0x4a, 0x6e, 0x34, 0xba, 0x90,
-	0x45, 0x47, 0xa8, 0xdc, 0xdb, 0x11, 0xbe, 0xf8, 0xbb, 0x02, 0xa5, 0x64, 0x5b, 0x8e, 0xca, 0x50,
-	0x30, 0x2d, 0xd2, 0xee, 0x98, 0xdf, 0x9d, 0x0d, 0xd4, 0x2f, 0xf8, 0xb2, 0x3f, 0x6c, 0x36, 0x0d,
-	0xa3, 0x65, 0xb4, 0x54, 0x85, 0x17, 0x0c, 0x9e, 0xea, 0x8d, 0xd6, 0xa2, 0x3e, 0x6c, 0xf1, 0xd2,
-	0x1e, 0x61, 0x56, 0x8f, 0xe0, 0xde, 0x70, 0x60, 0xa8, 0x29, 0xa4, 0x42, 0x29, 0x02, 0x0d, 0x8c,
-	0x7b, 0x58, 0x4d, 0xf3, 0xfa, 0x17, 0x21, 0x77, 0xdb, 0x92, 0xb8, 0x6b, 0xc9, 0x88, 0xb6, 0x23,
-	0xe6, 0x5a, 0x56, 0x6c, 0x72, 0xda, 0xe8, 0x34, 0xac, 0xa6, 0xa1, 0x66, 0x4f, 0xfe, 0x96, 0x81,
-	0xac, 0x38, 0x41, 0x80, 0xce, 0xa0, 0x98, 0x98, 0xd0, 0xd0, 0xc1, 0x67, 0x27, 0xb7, 0x9a, 0xb6,
-	0x79, 0x10, 0x99, 0x87, 0xaf, 0x14, 0x74, 0x0e, 0xa5, 0xe4, 0xfc, 0x83, 0x92, 0xcd, 0xea, 0x86,
-	0xc1, 0xe8, 0xb3, 0xba, 0xde, 0x82, 0x6a, 0x84, 0xcc, 0x99, 0xf2, 0xe6, 0x34, 0x1a, 0x17, 0x50,
-	0x2d, 0xc1, 0xbf, 0x36, 0x83, 0xd4, 0xf6, 0x37, 0xd2, 0x22, 0x17, 0x76, 0xe4, 0x11, 0xa3, 0x86,
-	0xfd, 0xce, 0x11, 0x57, 0xa7, 0x84, 0xda, 0x97, 0xf7, 0x91, 0x23, 0x6d, 0x63, 0xd8, 0xd9, 0x90,
-	0xc0, 0xd1, 0xaf, 0x92, 0x3b, 0xb8, 0x37, 0xfd, 0xd7, 0x9e, 0xff, 0x12, 0xdb, 0xd2, 0xca, 0x86,
-	0x4c, 0xbf, 0x62, 0xe5, 0xfe, 0x3a, 0xb1, 0x62, 0xe5, 0x73, 0x05, 0xe3, 0x47, 0x50, 0xd7, 0x33,
-	0x01, 0xd2, 0xd7, 0x65, 0xef, 0x66, 0xa5, 0xda, 0xd3, 0xcf, 0xf2, 0x44, 0xca, 0x4d, 0x80, 0x65,
-	0x3c, 0xa1, 0xc7, 0x09, 0x91, 0x3b, 0xf9, 0xa0, 0x76, 0x70, 0x0f, 0x55, 0xaa, 0x3a, 0xfd, 0xf5,
-	0x1f, 0x8f, 0xaf, 0x1d, 0x36, 0x99, 0x5f, 0x1e, 0x8d, 0xfc, 0xe9, 0xb1, 0xcb, 0x5b, 0x7c, 0xcf,
-	0xf1, 0xae, 0x3d, 0xca, 0x7e, 0xf6, 0x83, 0x9b, 0x63, 0xd7, 0x1b, 0x1f, 0x8b, 0xb0, 0x3c, 0x5e,
-	0x68, 0xb9, 0xcc, 0x8a, 0x3f, 0xa4, 0x7e, 0xf3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0xf6,
-	0xab, 0x74, 0xc0, 0x12, 0x00, 0x00,
+	// 2326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xdb, 0x72, 0x1a, 0xc9,
+	0xf9, 0xdf, 0x11, 0x47, 0x7d, 0x80, 0x34, 0x6a, 0xc9, 0xf2, 0x18, 0xdb, 0x6b, 0x76, 0xd6, 0x7f,
+	0x2f, 0xe5, 0xb2, 0xad, 0xfd, 0x2b, 0xd9, 0xad, 0xad, 0xa4, 0x2a, 0x29, 0x04, 0xc3, 0x0a, 0x19,
+	0x06, 0x6d, 0x03, 0xde, 0x75, 0xf6, 0xa2, 0xab, 0x05, 0x2d, 0x31, 0x31, 0x73, 0xd8, 0x99, 0xc6,
+	0x91, 0x5e, 0x20, 0x57, 0x79, 0x8f, 0xe4, 0x2a, 0x17, 0xc9, 0x75, 0x5e, 0x20, 0x0f, 0x92, 0xdc,
+	0xe7, 0x01, 0x52, 0xa9, 0xee, 0x9e, 0x81, 0x01, 0x21, 0x7b, 0xaf, 0x98, 0xfe, 0x7d, 0xa7, 0xee,
+	0xfe, 0xfa, 0x3b, 0x01, 0x87, 0xa1, 0x3f, 0xe7, 0x2c, 0x0c, 0x83, 0xf1, 0x91, 0xfa, 0x7a, 0x15,
+	0x84, 0x3e, 0xf7, 0xd1, 0xf6, 0x02, 0xaf, 0x6e, 0x87, 0xc1, 0x58, 0xa1, 0xe6, 0x3f, 0xf3, 0x80,
+	0x06, 0xcc, 0x9b, 0x9c, 0xd3, 0x1b, 0x97, 0x79, 0x1c, 0xb3, 0x9f, 0xe6, 0x2c, 0xe2, 0x08, 0x41,
+	0x76, 0xc2, 0x22, 0x6e, 0x68, 0x35, 0xad, 0x5e, 0xc6, 0xf2, 0x1b, 0xe9, 0x90, 0xa1, 0x2e, 0x37,
+	0xb6, 0x6a, 0x5a, 0x3d, 0x83, 0xc5, 0x27, 0x7a, 0x00, 0x45, 0xea, 0x72, 0xe2, 0x46, 0x94, 0x1b,
+	0x65, 0x09, 0x17, 0xa8, 0xcb, 0x7b, 0x11, 0xe5, 0xe8, 0x33, 0x28, 0x07, 0x4a, 0x25, 0x99, 0xd2,
+	0x68, 0x6a, 0x64, 0xa4, 0xa2, 0x52, 0x8c, 0x9d, 0xd2, 0x68, 0x8a, 0xea, 0xa0, 0x5f, 0x3a, 0x1e,
+	0x9d, 0x91, 0xf1, 0x8c, 0xbf, 0x27, 0x13, 0x36, 0xe3, 0xd4, 0xc8, 0xd6, 0xb4, 0x7a, 0x0e, 0xef,
+	0x48, 0xbc, 0x39, 0xe3, 0xef, 0x5b, 0x02, 0x45, 0x5f, 0xc0, 0x6e, 0xa2, 0x2c, 0x54, 0x1b, 0x34,
+	0x72, 0x35, 0xad, 0xbe, 0x8d, 0x77, 0x82, 0xd5, 0x6d, 0x7f, 0x01, 0xbb, 0xdc, 0x71, 0x99, 0x3f,
+	0xe7, 0x24, 0x62, 0x63, 0xdf, 0x9b, 0x44, 0x46, 0x5e, 0x69, 0x8c, 0xe1, 0x81, 0x42, 0x91, 0x09,
+	0x95, 0x4b, 0xc6, 0xc8, 0xcc, 0x71, 0x1d, 0x4e, 0xc4, 0xf6, 0x0b, 0x72, 0xfb, 0xa5, 0x4b, 0xc6,
+	0xba, 0x02, 0x1b, 0x50, 0x8e, 0x9e, 0xc2, 0xce, 0x92, 0x47, 0x9e, 0xb1, 0x22, 0x99, 0xca, 0x09,
+	0x93, 0x3c, 0xe8, 0x0b, 0xd0, 0xfd, 0x39, 0xbf, 0xf2, 0x1d, 0xef, 0x8a, 0x8c, 0xa7, 0xd4, 0x23,
+	0xce, 0xc4, 0x28, 0xd6, 0xb4, 0x7a, 0xf6, 0x64, 0xeb, 0x4b, 0x0d, 0xef, 0x24, 0xb4, 0xe6, 0x94,
+	0x7a, 0x9d, 0x09, 0x7a, 0x06, 0xbb, 0x33, 0x1a, 0x71, 0x32, 0xf5, 0x03, 0x12, 0xcc, 0x2f, 0xde,
+	0xb1, 0x1b, 0x63, 0x47, 0xde, 0x4c, 0x45, 0xc0, 0xa7, 0x7e, 0x70, 0x2e, 0x41, 0xf4, 0x18, 0x40,
+	0xde, 0x8a, 0x34, 0x6e, 0x6c, 0xcb, 0x33, 0x6c, 0x0b, 0x44, 0x1a, 0x46, 0xc7, 0x50, 0x92, 0xde,
+	0x24, 0x53, 0xc7, 0xe3, 0x91, 0x01, 0xb5, 0x4c, 0xbd, 0x74, 0xac, 0xbf, 0x9a, 0x79, 0xc2, 0xb1,
+	0x58, 0x50, 0x4e, 0x1d, 0x8f, 0xe3, 0x34, 0x13, 0x9a, 0xc0, 0xbe, 0x70, 0x23, 0x19, 0xcf, 0x23,
+	0xee, 0xbb, 0x24, 0x64, 0x63, 0x3f, 0x9c, 0x44, 0x46, 0x49, 0xca, 0xfe, 0xf2, 0xd5, 0xe2, 0x75,
+	0xbc, 0xba, 0xfd, 0x1c, 0x5e, 0xb5, 0x58, 0xc4, 0x9b, 0x52, 0x0e, 0x2b, 0x31, 0xcb, 0xe3, 0xe1,
+	0x0d, 0xde, 0x9b, 0xac, 0xe3, 0xe8, 0x05, 0x20, 0x3a, 0x9b, 0xf9, 0x7f, 0x20, 0x11, 0x9b, 0x5d,
+	0x92, 0xd8, 0x3d, 0xc6, 0x6e, 0x4d, 0xab, 0x17, 0xb1, 0x2e, 0x29, 0x03, 0x36, 0xbb, 0x8c, 0xd5,
+	0xa3, 0xaf, 0xa1, 0x22, 0xf7, 0x74, 0xc9, 0x28, 0x9f, 0x87, 0x2c, 0x32, 0xf4, 0x5a, 0xa6, 0xbe,
+	0x73, 0xbc, 0x17, 0x9f, 0xa4, 0xad, 0xe0, 0x13, 0x87, 0xe3, 0xb2, 0xe0, 0x8b, 0xd7, 0x91, 0x7a,
+	0x8a, 0x81, 0xb1, 0x27, 0xd5, 0x8a, 0x4f, 0x71, 0x61, 0xd4, 0x0d, 0x48, 0x34, 0xa5, 0xe2, 0x50,
+	0xa8, 0xa6, 0xd5, 0x2b, 0x78, 0x9b, 0xba, 0xc1, 0x40, 0x02, 0xe8, 0x53, 0x00, 0x1e, 0x52, 0x37,
+	0xf0, 0x67, 0x8e, 0xc7, 0x8c, 0x7d, 0x29, 0x97, 0x42, 0xaa, 0x2d, 0x38, 0xdc, 0x7c, 0x46, 0x61,
+	0x4a, 0x78, 0x49, 0x04, 0x42, 0x16, 0x8b, 0x4f, 0x74, 0x00, 0xb9, 0xf7, 0x74, 0x36, 0x67, 0x32,
+	0x12, 0xca, 0x58, 0x2d, 0x7e, 0xb5, 0xf5, 0x8d, 0x66, 0x7e, 0x03, 0xfb, 0xc3, 0x90, 0x8e, 0xdf,
+	0xad, 0x05, 0xd3, 0x7a, 0x2c, 0x68, 0xb7, 0x62, 0xc1, 0xfc, 0x8b, 0x06, 0x95, 0x58, 0x6a, 0xc0,
+	0x29, 0x9f, 0x47, 0xe8, 0x25, 0xe4, 0x22, 0x4e, 0x39, 0x93, 0xdc, 0x3b, 0xc7, 0xf7, 0x53, 0x0e,
+	0x4a, 0x31, 0x32, 0xac, 0xb8, 0x50, 0x15, 0x8a, 0x41, 0xc8, 0x1c, 0x97, 0x5e, 0x25, 0xfb, 0x5a,
+	0xac, 0x91, 0x09, 0x39, 0x29, 0x2c, 0x83, 0xb0, 0x74, 0x5c, 0x4e, 0xbf, 0x13, 0xac, 0x48, 0xa8,
+	0x0e, 0xb9, 0x29, 0x9f, 0x8d, 0x23, 0x23, 0x2b, 0xdf, 0x03, 0x8a, 0x79, 0x4e, 0x87, 0xdd, 0x66,
+	0x83, 0x73, 0xe6, 0x06, 0x1c, 0x2b, 0x06, 0xf3, 0x37, 0xb0, 0x2b, 0x25, 0xdb, 0x8c, 0x7d, 0x28,
+	0x5b, 0xdc, 0x07, 0x91, 0x0b, 0x64, 0x6c, 0xa9, 0x8c, 0x91, 0xa7, 0xae, 0x08, 0x2b, 0x73, 0x02,
+	0xfa, 0x52, 0x3e, 0x0a, 0x7c, 0x2f, 0x12, 0xd6, 0x75, 0xb1, 0x0d, 0x11, 0x43, 0x22, 0xe4, 0x64,
+	0xb0, 0x69, 0x52, 0x6a, 0x27, 0xc6, 0xdb, 0x8c, 0xc9, 0x70, 0x7b, 0xa6, 0x22, 0x9c, 0xcc, 0xfc,
+	0xf1, 0x3b, 0x91, 0x33, 0xe8, 0x4d, 0xac, 0xbe, 0x22, 0xe0, 0xae, 0x3f, 0x7e, 0xd7, 0x12, 0xa0,
+	0xf9, 0xa3, 0x4a, 0x6b, 0x43, 0x5f, 0x9d, 0xf2, 0x67, 0x7b, 0x62, 0x79, 0x59, 0x5b, 0x77, 0x5e,
+	0x96, 0x49, 0x60, 0x7f, 0x45, 0x79, 0x7c, 0x8a, 0xb4, 0x0f, 0xb4, 0x35, 0x1f, 0xbc, 0x80, 0xc2,
+	0x25, 0x75, 0x66, 0xf3, 0x30, 0x51, 0x8c, 0x52, 0x0e, 0x6d, 0x2b, 0x0a, 0x4e, 0x58, 0xcc, 0x3f,
+	0x16, 0xa1, 0x10, 0x83, 0xe8, 0x18, 0xb2, 0x63, 0x7f, 0x92, 0xbc, 0x83, 0x4f, 0x6f, 0x8b, 0x25,
+	0xbf, 0x4d, 0x7f, 0xc2, 0xb0, 0xe4, 0x45, 0xbf, 0x85, 0x1d, 0x91, 0x8b, 0x3c, 0x36, 0x23, 0xf3,
+	0x60, 0x42, 0x17, 0xae, 0x37, 0x52, 0xd2, 0x4d, 0xc5, 0x30, 0x92, 0x74, 0x5c, 0x19, 0xa7, 0x97,
+	0xe8, 0x21, 0x6c, 0x0b, 0x6f, 0x2b, 0x4f, 0x64, 0xe5, 0xdb, 0x2f, 0x0a, 0x40, 0xfa, 0xc0, 0x84,
+	0x8a, 0xef, 0x39, 0xbe, 0x27, 0xa2, 0x8d, 0x1c, 0x7f, 0xf5, 0xb5, 0x4c, 0xc6, 0x65, 0x5c, 0x92,
+	0xe0, 0x60, 0x4a, 0x8f, 0xbf, 0xfa, 0x1a, 0x3d, 0x81, 0x92, 0x4c, 0x60, 0xec, 0x3a, 0x70, 0xc2,
+	0x1b, 0x99, 0x85, 0x2b, 0x58, 0xe6, 0x34, 0x4b, 0x22, 0x22, 0x8a, 0x2e, 0x67, 0xf4, 0x2a, 0x92,
+	0x99, 0xb7, 0x82, 0xd5, 0x02, 0x7d, 0x09, 0x07, 0xf1, 0x1d, 0x90, 0xc8, 0x9f, 0x87, 0x63, 0x46,
+	0x1c, 0x6f, 0xc2, 0xae, 0x65, 0x46, 0xad, 0x60, 0x14, 0xd3, 0x06, 0x92, 0xd4, 0x11, 0x14, 0x74,
+	0x08, 0xf9, 0x29, 0x73, 0xae, 0xa6, 0x2a, 0x4b, 0x56, 0x70, 0xbc, 0x32, 0xff, 0x91, 0x83, 0x52,
+	0xea, 0x62, 0x50, 0x19, 0x8a, 0xd8, 0x1a, 0x58, 0xf8, 0x8d, 0xd5, 0xd2, 0x3f, 0x41, 0x75, 0x78,
+	0xda, 0xb1, 0x9b, 0x7d, 0x8c, 0xad, 0xe6, 0x90, 0xf4, 0x31, 0x19, 0xd9, 0xaf, 0xed, 0xfe, 0xf7,
+	0x36, 0x39, 0x6f, 0xbc, 0xed, 0x59, 0xf6, 0x90, 0xb4, 0xac, 0x61, 0xa3, 0xd3, 0x1d, 0xe8, 0x1a,
+	0x7a, 0x04, 0xc6, 0x92, 0x33, 0x21, 0x37, 0x7a, 0xfd, 0x91, 0x3d, 0xd4, 0xb7, 0xd0, 0x13, 0x78,
+	0xd8, 0xee, 0xd8, 0x8d, 0x2e, 0x59, 0xf2, 0x34, 0xbb, 0xc3, 0x37, 0xc4, 0xfa, 0xe1, 0xbc, 0x83,
+	0xdf, 0xea, 0x99, 0x4d, 0x0c, 0x22, 0xa6, 0x12, 0x0d, 0x59, 0xf4, 0x00, 0xee, 0x29, 0x06, 0x25,
+	0x42, 0x86, 0xfd, 0x3e, 0x19, 0xf4, 0xfb, 0xb6, 0x9e, 0x43, 0x7b, 0x50, 0xe9, 0xd8, 0x6f, 0x1a,
+	0xdd, 0x4e, 0x8b, 0x60, 0xab, 0xd1, 0xed, 0xe9, 0x79, 0xb4, 0x0f, 0xbb, 0xeb, 0x7c, 0x05, 0xa1,
+	0x22, 0xe1, 0xeb, 0xdb, 0x9d, 0xbe, 0x4d, 0xde, 0x58, 0x78, 0xd0, 0xe9, 0xdb, 0x7a, 0x11, 0x1d,
+	0x02, 0x5a, 0x25, 0x9d, 0xf6, 0x1a, 0x4d, 0x7d, 0x1b, 0xdd, 0x83, 0xbd, 0x55, 0xfc, 0xb5, 0xf5,
+	0x56, 0x07, 0x64, 0xc0, 0x81, 0xda, 0x18, 0x39, 0xb1, 0xba, 0xfd, 0xef, 0x49, 0xaf, 0x63, 0x77,
+	0x7a, 0xa3, 0x9e, 0x5e, 0x42, 0x07, 0xa0, 0xb7, 0x2d, 0x8b, 0x74, 0xec, 0xc1, 0xa8, 0xdd, 0xee,
+	0x34, 0x3b, 0x96, 0x3d, 0xd4, 0xcb, 0xca, 0xf2, 0xa6, 0x83, 0x57, 0x84, 0x40, 0xf3, 0xb4, 0x61,
+	0xdb, 0x56, 0x97, 0xb4, 0x3a, 0x83, 0xc6, 0x49, 0xd7, 0x6a, 0xe9, 0x3b, 0xe8, 0x31, 0x3c, 0x18,
+	0x5a, 0xbd, 0xf3, 0x3e, 0x6e, 0xe0, 0xb7, 0x24, 0xa1, 0xb7, 0x1b, 0x9d, 0xee, 0x08, 0x5b, 0xfa,
+	0x2e, 0xfa, 0x0c, 0x1e, 0x63, 0xeb, 0xbb, 0x51, 0x07, 0x5b, 0x2d, 0x62, 0xf7, 0x5b, 0x16, 0x69,
+	0x5b, 0x8d, 0xe1, 0x08, 0x5b, 0xa4, 0xd7, 0x19, 0x0c, 0x3a, 0xf6, 0xb7, 0xba, 0x8e, 0x9e, 0x42,
+	0x6d, 0xc1, 0xb2, 0x50, 0xb0, 0xc6, 0xb5, 0x27, 0xce, 0x97, 0xb8, 0xd4, 0xb6, 0x7e, 0x18, 0x92,
+	0x73, 0xcb, 0xc2, 0x3a, 0x42, 0x55, 0x38, 0x5c, 0x9a, 0x57, 0x06, 0x62, 0xdb, 0xfb, 0x82, 0x76,
+	0x6e, 0xe1, 0x5e, 0xc3, 0x16, 0x0e, 0x5e, 0xa1, 0x1d, 0x88, 0x6d, 0x2f, 0x69, 0xeb, 0xdb, 0xbe,
+	0x87, 0x10, 0xec, 0xa4, 0xbc, 0xd2, 0x6e, 0x60, 0xfd, 0x10, 0xed, 0x42, 0xa9, 0x77, 0x7e, 0x4e,
+	0x86, 0x9d, 0x9e, 0xd5, 0x1f, 0x0d, 0xf5, 0xfb, 0xe8, 0x00, 0x76, 0x93, 0x2d, 0x25, 0x92, 0xff,
+	0x2a, 0xa0, 0xfb, 0x80, 0x46, 0x36, 0xb6, 0x1a, 0x2d, 0x71, 0x43, 0x0b, 0xc2, 0xbf, 0x0b, 0x67,
+	0xd9, 0xe2, 0x96, 0x9e, 0x31, 0xff, 0x9e, 0x81, 0xca, 0x4a, 0xa0, 0xa2, 0x47, 0xb0, 0x1d, 0x39,
+	0x57, 0x9e, 0x2c, 0x84, 0x71, 0x96, 0x59, 0x02, 0xb2, 0x6f, 0x98, 0x52, 0xc7, 0x53, 0xe9, 0x4d,
+	0x15, 0x82, 0x6d, 0x89, 0xc8, 0xe4, 0xf6, 0x10, 0x0a, 0x49, 0x8f, 0x92, 0x59, 0xf4, 0x28, 0xf9,
+	0xb1, 0xea, 0x4d, 0x1e, 0xc1, 0xb6, 0xc8, 0xa1, 0x11, 0x17, 0xa5, 0x35, 0xab, 0x2a, 0xe8, 0x02,
+	0x40, 0x9f, 0x43, 0xc5, 0x65, 0x51, 0x44, 0xaf, 0x18, 0x51, 0x71, 0x0b, 0x92, 0xa3, 0x1c, 0x83,
+	0x6d, 0x81, 0x09, 0xa6, 0x24, 0xef, 0x28, 0xa6, 0x9c, 0x62, 0x8a, 0x41, 0xc5, 0xb4, 0x9e, 0xc2,
+	0x39, 0x8d, 0xd3, 0x43, 0x3a, 0x85, 0x73, 0x8a, 0x9e, 0xc3, 0x9e, 0xca, 0x41, 0x8e, 0xe7, 0xb8,
+	0x73, 0x57, 0xe5, 0xa2, 0x82, 0xcc, 0x45, 0xbb, 0x32, 0x17, 0x29, 0x5c, 0xa6, 0xa4, 0x07, 0x50,
+	0xbc, 0xa0, 0x11, 0x13, 0xd5, 0x23, 0xce, 0x15, 0x05, 0xb1, 0x6e, 0x33, 0x26, 0x48, 0xa2, 0xa6,
+	0x84, 0x22, 0x0b, 0xaa, 0x14, 0x51, 0xb8, 0x64, 0x0c, 0x8b, 0xbb, 0x5c, 0x58, 0xa0, 0xd7, 0x4b,
+	0x0b, 0xa5, 0x94, 0x05, 0x7a, 0xbd, 0xb0, 0xf0, 0x1c, 0xf6, 0xd8, 0x35, 0x0f, 0x29, 0xf1, 0x03,
+	0xfa, 0xd3, 0x9c, 0x91, 0x09, 0xe5, 0x54, 0x36, 0xbd, 0x65, 0xbc, 0x2b, 0x09, 0x7d, 0x89, 0xb7,
+	0x28, 0xa7, 0xe6, 0x23, 0xa8, 0x62, 0x16, 0x31, 0xde, 0x73, 0xa2, 0xc8, 0xf1, 0xbd, 0xa6, 0xef,
+	0xf1, 0xd0, 0x9f, 0xc5, 0x45, 0xc8, 0x7c, 0x0c, 0x0f, 0x37, 0x52, 0x55, 0x15, 0x11, 0xc2, 0xdf,
+	0xcd, 0x59, 0x78, 0xb3, 0x59, 0xf8, 0x3b, 0x78, 0xb8, 0x91, 0xaa, 0x84, 0xd1, 0x0b, 0xc8, 0x05,
+	0xd4, 0x09, 0x23, 0x63, 0x4b, 0x96, 0xf1, 0xc3, 0x95, 0xae, 0xc1, 0x09, 0x4f, 0x9d, 0x88, 0xfb,
+	0xe1, 0x0d, 0x56, 0x4c, 0x67, 0xd9, 0xa2, 0xa6, 0x6f, 0x99, 0x7f, 0xd2, 0xa0, 0x94, 0x22, 0x8a,
+	0x77, 0xe0, 0xf9, 0x13, 0x46, 0x2e, 0x43, 0xdf, 0x4d, 0x5e, 0xd8, 0x02, 0x40, 0x06, 0x14, 0xe4,
+	0x82, 0xfb, 0xf1, 0xf3, 0x4a, 0x96, 0xe8, 0x25, 0x14, 0xa6, 0x4a, 0x85, 0xf4, 0x52, 0xe9, 0x78,
+	0x7f, 0xcd, 0xba, 0xb8, 0x1b, 0x9c, 0xf0, 0x9c, 0x65, 0x8b, 0x19, 0x3d, 0x7b, 0x96, 0x2d, 0x66,
+	0xf5, 0xdc, 0x59, 0xb6, 0x98, 0xd3, 0xf3, 0x67, 0xd9, 0x62, 0x5e, 0x2f, 0x98, 0xff, 0xd1, 0xa0,
+	0x98, 0x70, 0x8b, 0xbd, 0x88, 0x9c, 0x4f, 0xc4, 0xcb, 0x88, 0x3b, 0x82, 0x25, 0x80, 0x4c, 0x28,
+	0xcb, 0xc5, 0x6a, 0xa3, 0xb1, 0x82, 0xa1, 0xa7, 0x50, 0x59, 0xac, 0x17, 0xd5, 0x2c, 0x83, 0x57,
+	0x41, 0xa1, 0x29, 0x9a, 0x8f, 0xc7, 0x2c, 0x8a, 0x94, 0xa9, 0x9c, 0xd2, 0x94, 0xc6, 0x50, 0x1d,
+	0x76, 0x93, 0x75, 0x62, 0x30, 0x2f, 0xd9, 0xd6, 0x61, 0xf4, 0x1c, 0xf4, 0x34, 0xe4, 0x2e, 0x07,
+	0x8c, 0x5b, 0xb8, 0xba, 0x06, 0xd3, 0x85, 0xfb, 0xd2, 0xad, 0xe7, 0xa1, 0x7f, 0x41, 0x2f, 0x9c,
+	0x99, 0xc3, 0x6f, 0x92, 0x9e, 0x45, 0x5c, 0x41, 0xe8, 0xbb, 0xc4, 0x4b, 0x9a, 0x80, 0x32, 0x5e,
+	0x02, 0xc2, 0x1d, 0xdc, 0x57, 0xb4, 0xd8, 0x1d, 0xf1, 0x52, 0x74, 0x23, 0x0b, 0xe3, 0x19, 0x69,
+	0x7c, 0xb1, 0x36, 0xdf, 0x81, 0x71, 0xdb, 0x5c, 0xfc, 0x84, 0x6a, 0x50, 0x0a, 0x96, 0xb0, 0xb4,
+	0xa8, 0xe1, 0x34, 0x94, 0x76, 0xf4, 0xd6, 0xc7, 0x1d, 0x6d, 0xfe, 0x59, 0x83, 0xbd, 0x93, 0xb9,
+	0x33, 0x9b, 0xac, 0xb4, 0x62, 0xe9, 0xd9, 0x51, 0x5b, 0x9d, 0x1d, 0x37, 0x0d, 0x86, 0x5b, 0x1b,
+	0x07, 0xc3, 0x4d, 0xc3, 0x57, 0xe6, 0xce, 0xe1, 0xeb, 0x09, 0x94, 0x96, 0x73, 0x97, 0xea, 0x74,
+	0xcb, 0x18, 0xa6, 0xc9, 0xd0, 0x15, 0x99, 0xdf, 0x00, 0x4a, 0x6f, 0x34, 0xbe, 0x90, 0x45, 0x47,
+	0xa8, 0xdd, 0xdd, 0x11, 0xfe, 0x1a, 0xf6, 0xc5, 0x5d, 0xb2, 0xb5, 0xce, 0xff, 0x29, 0xe4, 0x25,
+	0x3d, 0x32, 0xb4, 0x5a, 0xe6, 0x96, 0x6c, 0x4c, 0x33, 0xff, 0xa6, 0x01, 0x48, 0x44, 0xaa, 0xf8,
+	0x39, 0xf6, 0xd0, 0x11, 0xec, 0x87, 0x8c, 0x8e, 0xa7, 0x6c, 0x42, 0x44, 0xb7, 0xed, 0x78, 0x94,
+	0x3b, 0xbe, 0x27, 0x6f, 0xa9, 0x88, 0x51, 0x4c, 0x6a, 0x2d, 0x29, 0xa2, 0xf3, 0x4d, 0x04, 0xa6,
+	0x7e, 0x10, 0xc9, 0x5b, 0xaa, 0xe0, 0x52, 0x8c, 0x9d, 0xfa, 0x41, 0x94, 0x6e, 0x51, 0xb3, 0x1f,
+	0x6f, 0x51, 0x2f, 0xa0, 0x78, 0xea, 0x07, 0x58, 0xc8, 0xa7, 0xcb, 0x8a, 0x76, 0xab, 0xac, 0xdc,
+	0x87, 0x42, 0x30, 0xbf, 0x20, 0x62, 0x88, 0x52, 0x2f, 0x34, 0x1f, 0xcc, 0x2f, 0x5e, 0xb3, 0x1b,
+	0x54, 0x83, 0xb2, 0x4b, 0xaf, 0xc9, 0xda, 0x23, 0x05, 0x97, 0x5e, 0x37, 0xd4, 0x43, 0x30, 0x3d,
+	0x38, 0x58, 0xbd, 0xd5, 0xd8, 0x23, 0x2f, 0x21, 0x2f, 0xde, 0xe3, 0xe2, 0x5a, 0xef, 0xa5, 0x36,
+	0xba, 0xbc, 0x48, 0x1c, 0x33, 0xa1, 0x2f, 0x20, 0x2b, 0xcf, 0xac, 0x72, 0x62, 0xfa, 0xb1, 0x26,
+	0x27, 0xc0, 0x92, 0xe1, 0xf9, 0x5f, 0x35, 0x28, 0xa7, 0x87, 0x2b, 0x54, 0x81, 0xed, 0x8e, 0x4d,
+	0xda, 0xdd, 0xce, 0xb7, 0xa7, 0x43, 0xfd, 0x13, 0xb1, 0x1c, 0x8c, 0x9a, 0x4d, 0xcb, 0x6a, 0x59,
+	0x2d, 0x5d, 0x13, 0x65, 0x5f, 0x14, 0x6c, 0xab, 0xb5, 0xa8, 0xf2, 0x5b, 0xa2, 0x41, 0x8b, 0x31,
+	0xbb, 0x4f, 0x70, 0x7f, 0x34, 0xb4, 0xf4, 0x0c, 0xd2, 0xa1, 0x1c, 0x83, 0x16, 0xc6, 0x7d, 0xac,
+	0x67, 0x45, 0x17, 0x13, 0x23, 0xb7, 0x9b, 0xcb, 0xa4, 0xf7, 0xcc, 0xc9, 0xe6, 0x31, 0xe1, 0x5a,
+	0xf6, 0x5d, 0xe4, 0xa4, 0xd1, 0x6d, 0xd8, 0x4d, 0x4b, 0xcf, 0x1f, 0xff, 0x37, 0x07, 0x79, 0x79,
+	0xe0, 0x10, 0x9d, 0x42, 0x29, 0x35, 0xb8, 0xa3, 0xc7, 0x1f, 0x1c, 0xe8, 0xab, 0xc6, 0xe6, 0x71,
+	0x72, 0x1e, 0x7d, 0xa9, 0xa1, 0x33, 0x28, 0xa7, 0xa7, 0x58, 0x94, 0x1e, 0x39, 0x36, 0x8c, 0xb7,
+	0x1f, 0xd4, 0xf5, 0x1a, 0x74, 0x2b, 0xe2, 0x8e, 0x2b, 0x46, 0x8c, 0x78, 0xe8, 0x43, 0xd5, 0x75,
+	0x6f, 0x2d, 0x27, 0xc9, 0xea, 0xc3, 0x8d, 0xb4, 0xd8, 0xed, 0x5d, 0x28, 0xa5, 0xc6, 0xae, 0x5b,
+	0x47, 0x5c, 0x9d, 0xf5, 0xaa, 0x9f, 0xde, 0x45, 0x8e, 0xb5, 0x4d, 0x60, 0x7f, 0x43, 0x19, 0x46,
+	0xff, 0x97, 0xde, 0xc1, 0x9d, 0x45, 0xbc, 0xfa, 0xec, 0x63, 0x6c, 0x4b, 0x2b, 0x1b, 0xea, 0xf5,
+	0x8a, 0x95, 0xbb, 0xab, 0x7d, 0xf5, 0xd9, 0xc7, 0xd8, 0x62, 0x2b, 0x3f, 0x82, 0xbe, 0x9e, 0xcf,
+	0x91, 0xb9, 0x2e, 0x7b, 0xbb, 0xb6, 0x54, 0x3f, 0xff, 0x20, 0x4f, 0xac, 0xbc, 0x03, 0xb0, 0xcc,
+	0x8a, 0xe8, 0x51, 0x4a, 0xe4, 0x56, 0x56, 0xaf, 0x3e, 0xbe, 0x83, 0x1a, 0xab, 0xea, 0x43, 0x39,
+	0x1d, 0xd0, 0x2b, 0x4f, 0x6b, 0x43, 0xfe, 0xac, 0x3e, 0xb9, 0x93, 0xae, 0x14, 0x9e, 0xfc, 0xff,
+	0xef, 0x8e, 0xae, 0x1c, 0x3e, 0x9d, 0x5f, 0xbc, 0x1a, 0xfb, 0xee, 0x51, 0x63, 0xcc, 0x1d, 0xcf,
+	0x99, 0xbb, 0x2f, 0x83, 0xd0, 0xff, 0x3d, 0x1b, 0xf3, 0xa3, 0x99, 0x37, 0x39, 0x9a, 0x79, 0xcb,
+	0xbf, 0x42, 0xc3, 0x60, 0x7c, 0x91, 0x97, 0x7f, 0x7c, 0xfe, 0xe2, 0x7f, 0x03, 0x00, 0xa4, 0x47,
+	0xf4, 0x98, 0x28, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1711,6 +1991,13 @@ type RouterClient interface {
 	//keys. It retrieves the relevant channel policies from the graph in order to
 	//calculate the correct fees and time locks.
 	BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error)
+	//*
+	//ProbePayment probes the liquidity of one or more candidate routes without
+	//risking funds. Every route is probed with an htlc that pays to a payment
+	//hash nobody knows the preimage of. The failures the probes return with
+	//are reported to mission control as probe results, and the maximum amount
+	//that reached every hop is returned.
+	ProbePayment(ctx context.Context, in *ProbePaymentRequest, opts ...grpc.CallOption) (*ProbePaymentResponse, error)
 }
 
 type routerClient struct {
@@ -1839,6 +2126,15 @@ func (c *routerClient) BuildRoute(ctx context.Context, in *BuildRouteRequest, op
 	return out, nil
 }
 
+func (c *routerClient) ProbePayment(ctx context.Context, in *ProbePaymentRequest, opts ...grpc.CallOption) (*ProbePaymentResponse, error) {
+	out := new(ProbePaymentResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ProbePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RouterServer is the server API for Router service.
 type RouterServer interface {
 	//*
@@ -1876,6 +2172,13 @@ type RouterServer interface {
 	//keys. It retrieves the relevant channel policies from the graph in order to
 	//calculate the correct fees and time locks.
 	BuildRoute(context.Context, *BuildRouteRequest) (*BuildRouteResponse, error)
+	//*
+	//ProbePayment probes the liquidity of one or more candidate routes without
+	//risking funds. Every route is probed with an htlc that pays to a payment
+	//hash nobody knows the preimage of. The failures the probes return with
+	//are reported to mission control as probe results, and the maximum amount
+	//that reached every hop is returned.
+	ProbePayment(context.Context, *ProbePaymentRequest) (*ProbePaymentResponse, error)
 }
 
 func RegisterRouterServer(s *grpc.Server, srv RouterServer) {
@@ -2032,6 +2335,24 @@ func _Router_BuildRoute_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_ProbePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ProbePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ProbePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ProbePayment(ctx, req.(*ProbePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Router_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "routerrpc.Router",
 	HandlerType: (*RouterServer)(nil),
@@ -2060,6 +2381,10 @@ var _Router_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BuildRoute",
 			Handler:    _Router_BuildRoute_Handler,
 		},
+		{
+			MethodName: "ProbePayment",
+			Handler:    _Router_ProbePayment_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
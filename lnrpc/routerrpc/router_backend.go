@@ -67,6 +67,11 @@ type RouterBackend struct {
 	// DefaultFinalCltvDelta is the default value used as final cltv delta
 	// when an RPC caller doesn't specify a value.
 	DefaultFinalCltvDelta uint16
+
+	// Trampoline returns the trampoline node a payment of the given
+	// amount is routed through when the caller asks for it. It returns
+	// nil if no trampoline node is configured.
+	Trampoline func(amt lnwire.MilliSatoshi) *routing.Trampoline
 }
 
 // MissionControl defines the mission control dependencies of routerrpc.
@@ -385,6 +390,19 @@ func (r *RouterBackend) MarshallRoute(route *route.Route) (*lnrpc.Route, error)
 			}
 		}
 
+		// Extract the AMP fields if present on this hop.
+		var amp *lnrpc.AMPRecord
+		if hop.AMP != nil {
+			rootShare := hop.AMP.RootShare()
+			setID := hop.AMP.SetID()
+
+			amp = &lnrpc.AMPRecord{
+				RootShare:  rootShare[:],
+				SetId:      setID[:],
+				ChildIndex: uint32(hop.AMP.ChildIndex()),
+			}
+		}
+
 		resp.Hops[i] = &lnrpc.Hop{
 			ChanId:           hop.ChannelID,
 			ChanCapacity:     int64(chanCapacity),
@@ -399,6 +417,7 @@ func (r *RouterBackend) MarshallRoute(route *route.Route) (*lnrpc.Route, error)
 			CustomRecords: hop.CustomRecords,
 			TlvPayload:    !hop.LegacyPayload,
 			MppRecord:     mpp,
+			AmpRecord:     amp,
 		}
 		incomingAmt = hop.AmtToForward
 	}
@@ -421,6 +440,11 @@ func UnmarshallHopWithPubkey(rpcHop *lnrpc.Hop, pubkey route.Vertex) (*route.Hop
 		return nil, err
 	}
 
+	amp, err := UnmarshalAMP(rpcHop.AmpRecord)
+	if err != nil {
+		return nil, err
+	}
+
 	return &route.Hop{
 		OutgoingTimeLock: rpcHop.Expiry,
 		AmtToForward:     lnwire.MilliSatoshi(rpcHop.AmtToForwardMsat),
@@ -429,6 +453,7 @@ func UnmarshallHopWithPubkey(rpcHop *lnrpc.Hop, pubkey route.Vertex) (*route.Hop
 		CustomRecords:    customRecords,
 		LegacyPayload:    !rpcHop.TlvPayload,
 		MPP:              mpp,
+		AMP:              amp,
 	}, nil
 }
 
@@ -671,7 +696,12 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 
 		payIntent.Amount = reqAmt
 
-		// Payment hash.
+		// Payment hash. The shards of an AMP payment each carry their
+		// own payment hash, derived from the root secret chosen by us.
+		if rpcPayReq.Amp && len(rpcPayReq.PaymentHash) > 0 {
+			return nil, errors.New("payment_hash cannot be set " +
+				"for amp payments")
+		}
 		copy(payIntent.PaymentHash[:], rpcPayReq.PaymentHash)
 
 		// Parse destination feature bits.
@@ -700,6 +730,30 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		return nil, errors.New("self-payments not allowed")
 	}
 
+	// Invoices that require AMP can't be paid with a regular payment.
+	if !rpcPayReq.Amp && payIntent.DestFeatures != nil &&
+		payIntent.DestFeatures.HasFeature(lnwire.AMPRequired) {
+
+		return nil, errors.New("destination requires an amp payment")
+	}
+
+	if rpcPayReq.Trampoline {
+		if rpcPayReq.Amp {
+			return nil, errors.New("amp payments can't be routed " +
+				"through a trampoline node")
+		}
+
+		var trampoline *routing.Trampoline
+		if r.Trampoline != nil {
+			trampoline = r.Trampoline(payIntent.Amount)
+		}
+		if trampoline == nil {
+			return nil, errors.New("no trampoline node configured")
+		}
+
+		payIntent.Trampoline = trampoline
+	}
+
 	return payIntent, nil
 }
 
@@ -883,3 +937,31 @@ func MarshalTimeNano(t time.Time) int64 {
 	}
 	return t.UnixNano()
 }
+
+// UnmarshalAMP converts the AMP record of an RPC hop into a record.AMP. If no
+// record was submitted, nil is returned. An error is returned if the root
+// share or set id aren't 32 bytes, or the child index exceeds its range.
+func UnmarshalAMP(reqAMP *lnrpc.AMPRecord) (*record.AMP, error) {
+	if reqAMP == nil {
+		return nil, nil
+	}
+
+	rootShare, err := lntypes.MakeHash(reqAMP.RootShare)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse root_share: %v", err)
+	}
+
+	setID, err := lntypes.MakeHash(reqAMP.SetId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse set_id: %v", err)
+	}
+
+	if reqAMP.ChildIndex > math.MaxUint16 {
+		return nil, fmt.Errorf("child_index %v exceeds maximum of %v",
+			reqAMP.ChildIndex, math.MaxUint16)
+	}
+
+	return record.NewAMP(
+		rootShare, setID, uint16(reqAMP.ChildIndex),
+	), nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/Actinium-project/lnd/lnrpc"
 	"github.com/Actinium-project/lnd/lntypes"
 	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/macaroons"
 	"github.com/Actinium-project/lnd/routing"
 	"github.com/Actinium-project/lnd/routing/route"
 
@@ -30,6 +31,10 @@ const (
 	// to register ourselves, and we also require that the main
 	// SubServerConfigDispatcher instance recognize as the name of our
 	subServerName = "RouterRPC"
+
+	// defaultAMPShards is the number of shards an AMP payment is split
+	// into if the request doesn't specify it.
+	defaultAMPShards = 2
 )
 
 var (
@@ -80,6 +85,10 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/routerrpc.Router/ProbePayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultRouterMacFilename is the default name of the router macaroon
@@ -208,6 +217,21 @@ func (s *Server) SendPayment(req *SendPaymentRequest,
 		return err
 	}
 
+	// Make sure the payment is allowed by the spending limits of the
+	// macaroon used to authenticate the request. The payment may take up
+	// to its amount plus the fee limit from our channels.
+	err = s.validatePaymentCaveats(stream.Context(), &macaroons.Payment{
+		Amount: payment.Amount + payment.FeeLimit,
+		Dest:   payment.Target,
+	})
+	if err != nil {
+		return err
+	}
+
+	if req.Amp {
+		return s.sendAMPPayment(payment, req.AmpShards, stream)
+	}
+
 	err = s.cfg.Router.SendPaymentAsync(payment)
 	if err != nil {
 		// Transform user errors to grpc code.
@@ -231,6 +255,28 @@ func (s *Server) SendPayment(req *SendPaymentRequest,
 	return s.trackPayment(payment.PaymentHash, stream)
 }
 
+// sendAMPPayment sends the payment as an atomic multi-path payment split into
+// the given number of shards, and streams the combined outcome of the shards.
+func (s *Server) sendAMPPayment(payment *routing.LightningPayment,
+	numShards uint32, stream Router_SendPaymentServer) error {
+
+	if numShards == 0 {
+		numShards = defaultAMPShards
+	}
+
+	shardHashes, err := s.cfg.Router.SendAMPPaymentAsync(
+		payment, int(numShards),
+	)
+	if err != nil {
+		log.Errorf("SendPayment amp error to %v: %v", payment.Target,
+			err)
+
+		return err
+	}
+
+	return s.trackAMPPayment(shardHashes, stream)
+}
+
 // EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
 // may cost to send an HTLC to the target end destination.
 func (s *Server) EstimateRouteFee(ctx context.Context,
@@ -291,6 +337,17 @@ func (s *Server) SendToRoute(ctx context.Context,
 		return nil, err
 	}
 
+	// Make sure the payment is allowed by the spending limits of the
+	// macaroon used to authenticate the request.
+	payment, err := macaroons.PaymentFromRoute(route)
+	if err != nil {
+		return nil, err
+	}
+	err = s.validatePaymentCaveats(ctx, payment)
+	if err != nil {
+		return nil, err
+	}
+
 	preimage, err := s.cfg.Router.SendToRoute(hash, route)
 
 	// In the success case, return the preimage.
@@ -312,6 +369,20 @@ func (s *Server) SendToRoute(ctx context.Context,
 	}, nil
 }
 
+// validatePaymentCaveats checks the payment against the spending limit
+// caveats of the macaroon within the context, and deducts the payment from its
+// budgets.
+func (s *Server) validatePaymentCaveats(ctx context.Context,
+	payment *macaroons.Payment) error {
+
+	// If macaroons are disabled, there are no spending limits to enforce.
+	if s.cfg.MacService == nil {
+		return nil
+	}
+
+	return s.cfg.MacService.ValidatePayment(ctx, payment)
+}
+
 // marshallError marshall an error as received from the switch to rpc structs
 // suitable for returning to the caller of an rpc method.
 //
@@ -598,68 +669,18 @@ func (s *Server) trackPayment(paymentHash lntypes.Hash,
 	// completed, the result should already be waiting on the channel.
 	select {
 	case result := <-resultChan:
-		// Marshall result to rpc type.
-		var status PaymentStatus
 		if result.Success {
 			log.Debugf("Payment %v successfully completed",
 				paymentHash)
-
-			status.State = PaymentState_SUCCEEDED
-			status.Preimage = result.Preimage[:]
-		} else {
-			state, err := marshallFailureReason(
-				result.FailureReason,
-			)
-			if err != nil {
-				return err
-			}
-			status.State = state
 		}
 
-		// Extract the last route from the given list of HTLCs. This
-		// will populate the legacy route field for backwards
-		// compatibility.
-		//
-		// NOTE: For now there will be at most one HTLC, this code
-		// should be revisted or the field removed when multiple HTLCs
-		// are permitted.
-		var legacyRoute *route.Route
-		for _, htlc := range result.HTLCs {
-			switch {
-			case htlc.Settle != nil:
-				legacyRoute = &htlc.Route
-
-			// Only display the route for failed payments if we got
-			// an incorrect payment details error, so that it can be
-			// used for probing or fee estimation.
-			case htlc.Failure != nil && result.FailureReason ==
-				channeldb.FailureReasonPaymentDetails:
-
-				legacyRoute = &htlc.Route
-			}
-		}
-		if legacyRoute != nil {
-			status.Route, err = router.MarshallRoute(legacyRoute)
-			if err != nil {
-				return err
-			}
-		}
-
-		// Marshal our list of HTLCs that have been tried for this
-		// payment.
-		htlcs := make([]*lnrpc.HTLCAttempt, 0, len(result.HTLCs))
-		for _, dbHtlc := range result.HTLCs {
-			htlc, err := router.MarshalHTLCAttempt(dbHtlc)
-			if err != nil {
-				return err
-			}
-
-			htlcs = append(htlcs, htlc)
+		status, err := s.marshallPaymentResult(&result)
+		if err != nil {
+			return err
 		}
-		status.Htlcs = htlcs
 
 		// Send event to the client.
-		err = stream.Send(&status)
+		err = stream.Send(status)
 		if err != nil {
 			return err
 		}
@@ -672,6 +693,121 @@ func (s *Server) trackPayment(paymentHash lntypes.Hash,
 	return nil
 }
 
+// marshallPaymentResult marshalls the final outcome of a payment to the rpc
+// payment status.
+func (s *Server) marshallPaymentResult(result *routing.PaymentResult) (
+	*PaymentStatus, error) {
+
+	router := s.cfg.RouterBackend
+
+	var status PaymentStatus
+	if result.Success {
+		status.State = PaymentState_SUCCEEDED
+		status.Preimage = result.Preimage[:]
+	} else {
+		state, err := marshallFailureReason(result.FailureReason)
+		if err != nil {
+			return nil, err
+		}
+		status.State = state
+	}
+
+	// Extract the last route from the given list of HTLCs. This will
+	// populate the legacy route field for backwards compatibility.
+	//
+	// NOTE: For now there will be at most one HTLC, this code should be
+	// revisted or the field removed when multiple HTLCs are permitted.
+	var legacyRoute *route.Route
+	for _, htlc := range result.HTLCs {
+		switch {
+		case htlc.Settle != nil:
+			legacyRoute = &htlc.Route
+
+		// Only display the route for failed payments if we got an
+		// incorrect payment details error, so that it can be used for
+		// probing or fee estimation.
+		case htlc.Failure != nil && result.FailureReason ==
+			channeldb.FailureReasonPaymentDetails:
+
+			legacyRoute = &htlc.Route
+		}
+	}
+	if legacyRoute != nil {
+		var err error
+		status.Route, err = router.MarshallRoute(legacyRoute)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Marshal our list of HTLCs that have been tried for this payment.
+	htlcs := make([]*lnrpc.HTLCAttempt, 0, len(result.HTLCs))
+	for _, dbHtlc := range result.HTLCs {
+		htlc, err := router.MarshalHTLCAttempt(dbHtlc)
+		if err != nil {
+			return nil, err
+		}
+
+		htlcs = append(htlcs, htlc)
+	}
+	status.Htlcs = htlcs
+
+	return &status, nil
+}
+
+// trackAMPPayment streams the combined outcome of the shards of an AMP
+// payment. The payment only succeeds if every shard succeeded, otherwise the
+// failure reason of the first failed shard is reported. As every shard has
+// its own preimage, no preimage is returned.
+func (s *Server) trackAMPPayment(shardHashes []lntypes.Hash,
+	stream Router_SendPaymentServer) error {
+
+	resultChans := make([]chan routing.PaymentResult, 0, len(shardHashes))
+	for _, hash := range shardHashes {
+		_, resultChan, err := s.cfg.RouterBackend.Tower.SubscribePayment(
+			hash,
+		)
+		if err != nil {
+			return err
+		}
+		resultChans = append(resultChans, resultChan)
+	}
+
+	err := stream.Send(&PaymentStatus{
+		State: PaymentState_IN_FLIGHT,
+	})
+	if err != nil {
+		return err
+	}
+
+	combined := routing.PaymentResult{
+		Success: true,
+	}
+	for i, resultChan := range resultChans {
+		select {
+		case result := <-resultChan:
+			combined.HTLCs = append(combined.HTLCs, result.HTLCs...)
+			if !result.Success && combined.Success {
+				combined.Success = false
+				combined.FailureReason = result.FailureReason
+			}
+
+		case <-stream.Context().Done():
+			log.Debugf("AMP payment status stream %v canceled",
+				shardHashes[i])
+			return stream.Context().Err()
+		}
+	}
+
+	status, err := s.marshallPaymentResult(&combined)
+	if err != nil {
+		return err
+	}
+	status.Preimage = nil
+
+	return stream.Send(status)
+}
+
 // marshallFailureReason marshalls the failure reason to the corresponding rpc
 // type.
 func marshallFailureReason(reason channeldb.FailureReason) (
@@ -743,3 +879,96 @@ func (s *Server) BuildRoute(ctx context.Context,
 
 	return routeResp, nil
 }
+
+// ProbePayment probes the liquidity of the given routes one after the other.
+// The probes pay to a payment hash nobody knows the preimage of, so they can't
+// be settled. Because no funds are at risk, the spending limits of the
+// macaroon aren't applied.
+func (s *Server) ProbePayment(ctx context.Context,
+	req *ProbePaymentRequest) (*ProbePaymentResponse, error) {
+
+	if len(req.Routes) == 0 {
+		return nil, errors.New("no routes provided")
+	}
+
+	routes := make([]*route.Route, 0, len(req.Routes))
+	for _, rpcRoute := range req.Routes {
+		rt, err := s.cfg.RouterBackend.UnmarshallRoute(rpcRoute)
+		if err != nil {
+			return nil, err
+		}
+		if len(rt.Hops) == 0 {
+			return nil, errors.New("route has no hops")
+		}
+
+		routes = append(routes, rt)
+	}
+
+	// Track the maximum amount that reached every hop of the routes,
+	// keyed by the channel and the node the hop leads to.
+	type hopKey struct {
+		chanID uint64
+		node   route.Vertex
+	}
+	var (
+		hopOrder []hopKey
+		hopAmts  = make(map[hopKey]lnwire.MilliSatoshi)
+	)
+
+	resp := &ProbePaymentResponse{}
+	for i, rt := range routes {
+		// Probes are sent one after the other, so we check whether
+		// the caller is still waiting before sending the next one.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		result, err := s.cfg.Router.ProbeRoute(rt)
+		if err != nil {
+			return nil, err
+		}
+
+		failure, err := marshallError(result.Err)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Probes = append(resp.Probes, &RouteProbe{
+			Route:              req.Routes[i],
+			ReachedDestination: result.ReachedDestination(),
+			ReachedHops:        uint32(result.ReachedHops()),
+			Failure:            failure,
+		})
+
+		for j, hop := range rt.Hops {
+			key := hopKey{
+				chanID: hop.ChannelID,
+				node:   hop.PubKeyBytes,
+			}
+			if _, ok := hopAmts[key]; !ok {
+				hopOrder = append(hopOrder, key)
+				hopAmts[key] = 0
+			}
+
+			if j >= result.ReachedHops() {
+				continue
+			}
+
+			amt := result.HopAmount(j)
+			if amt > hopAmts[key] {
+				hopAmts[key] = amt
+			}
+		}
+	}
+
+	for _, key := range hopOrder {
+		node := key.node
+		resp.Hops = append(resp.Hops, &HopReach{
+			ChanId:     key.chanID,
+			PubKey:     node[:],
+			MaxAmtMsat: int64(hopAmts[key]),
+		})
+	}
+
+	return resp, nil
+}
@@ -59,6 +59,38 @@ func (AddressType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{0}
 }
 
+type HintPolicy int32
+
+const (
+	//*
+	//Rank the private channels by the inbound capacity relative to the invoice
+	//amount and by the uptime of the remote peer, and add hints until the
+	//invoice amount can be received through a multi-path payment.
+	HintPolicy_RANKED_HINTS HintPolicy = 0
+	//*
+	//Add a hint for every private channel that can receive the full invoice
+	//amount on its own, up to the maximum number of hints.
+	HintPolicy_LEGACY_HINTS HintPolicy = 1
+)
+
+var HintPolicy_name = map[int32]string{
+	0: "RANKED_HINTS",
+	1: "LEGACY_HINTS",
+}
+
+var HintPolicy_value = map[string]int32{
+	"RANKED_HINTS": 0,
+	"LEGACY_HINTS": 1,
+}
+
+func (x HintPolicy) String() string {
+	return proto.EnumName(HintPolicy_name, int32(x))
+}
+
+func (HintPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{1}
+}
+
 type InvoiceHTLCState int32
 
 const (
@@ -84,7 +116,7 @@ func (x InvoiceHTLCState) String() string {
 }
 
 func (InvoiceHTLCState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{1}
+	return fileDescriptor_77a6da22d6a3feb1, []int{2}
 }
 
 type FeatureBit int32
@@ -107,6 +139,10 @@ const (
 	FeatureBit_PAYMENT_ADDR_OPT            FeatureBit = 15
 	FeatureBit_MPP_REQ                     FeatureBit = 16
 	FeatureBit_MPP_OPT                     FeatureBit = 17
+	FeatureBit_AMP_REQ                     FeatureBit = 30
+	FeatureBit_AMP_OPT                     FeatureBit = 31
+	FeatureBit_ONION_MESSAGES_REQ          FeatureBit = 38
+	FeatureBit_ONION_MESSAGES_OPT          FeatureBit = 39
 )
 
 var FeatureBit_name = map[int32]string{
@@ -127,6 +163,10 @@ var FeatureBit_name = map[int32]string{
 	15: "PAYMENT_ADDR_OPT",
 	16: "MPP_REQ",
 	17: "MPP_OPT",
+	30: "AMP_REQ",
+	31: "AMP_OPT",
+	38: "ONION_MESSAGES_REQ",
+	39: "ONION_MESSAGES_OPT",
 }
 
 var FeatureBit_value = map[string]int32{
@@ -147,6 +187,10 @@ var FeatureBit_value = map[string]int32{
 	"PAYMENT_ADDR_OPT":            15,
 	"MPP_REQ":                     16,
 	"MPP_OPT":                     17,
+	"AMP_REQ":                     30,
+	"AMP_OPT":                     31,
+	"ONION_MESSAGES_REQ":          38,
+	"ONION_MESSAGES_OPT":          39,
 }
 
 func (x FeatureBit) String() string {
@@ -154,7 +198,7 @@ func (x FeatureBit) String() string {
 }
 
 func (FeatureBit) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{2}
+	return fileDescriptor_77a6da22d6a3feb1, []int{3}
 }
 
 type ChannelCloseSummary_ClosureType int32
@@ -315,7 +359,7 @@ func (x ChannelEventUpdate_UpdateType) String() string {
 }
 
 func (ChannelEventUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{73, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{78, 0}
 }
 
 type Invoice_InvoiceState int32
@@ -346,7 +390,41 @@ func (x Invoice_InvoiceState) String() string {
 }
 
 func (Invoice_InvoiceState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{105, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{112, 0}
+}
+
+type Invoice_CancelReason int32
+
+const (
+	Invoice_NONE         Invoice_CancelReason = 0
+	Invoice_USER         Invoice_CancelReason = 1
+	Invoice_EXPIRED      Invoice_CancelReason = 2
+	Invoice_HOLD_TIMEOUT Invoice_CancelReason = 3
+	Invoice_HTLC_EXPIRY  Invoice_CancelReason = 4
+)
+
+var Invoice_CancelReason_name = map[int32]string{
+	0: "NONE",
+	1: "USER",
+	2: "EXPIRED",
+	3: "HOLD_TIMEOUT",
+	4: "HTLC_EXPIRY",
+}
+
+var Invoice_CancelReason_value = map[string]int32{
+	"NONE":         0,
+	"USER":         1,
+	"EXPIRED":      2,
+	"HOLD_TIMEOUT": 3,
+	"HTLC_EXPIRY":  4,
+}
+
+func (x Invoice_CancelReason) String() string {
+	return proto.EnumName(Invoice_CancelReason_name, int32(x))
+}
+
+func (Invoice_CancelReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{112, 1}
 }
 
 type Payment_PaymentStatus int32
@@ -377,7 +455,7 @@ func (x Payment_PaymentStatus) String() string {
 }
 
 func (Payment_PaymentStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{112, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{121, 0}
 }
 
 type HTLCAttempt_HTLCStatus int32
@@ -405,7 +483,44 @@ func (x HTLCAttempt_HTLCStatus) String() string {
 }
 
 func (HTLCAttempt_HTLCStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{113, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{122, 0}
+}
+
+type LedgerEntry_EntryType int32
+
+const (
+	LedgerEntry_RECEIPT       LedgerEntry_EntryType = 0
+	LedgerEntry_PAYMENT       LedgerEntry_EntryType = 1
+	LedgerEntry_FORWARD       LedgerEntry_EntryType = 2
+	LedgerEntry_ON_CHAIN      LedgerEntry_EntryType = 3
+	LedgerEntry_CHANNEL_OPEN  LedgerEntry_EntryType = 4
+	LedgerEntry_CHANNEL_CLOSE LedgerEntry_EntryType = 5
+)
+
+var LedgerEntry_EntryType_name = map[int32]string{
+	0: "RECEIPT",
+	1: "PAYMENT",
+	2: "FORWARD",
+	3: "ON_CHAIN",
+	4: "CHANNEL_OPEN",
+	5: "CHANNEL_CLOSE",
+}
+
+var LedgerEntry_EntryType_value = map[string]int32{
+	"RECEIPT":       0,
+	"PAYMENT":       1,
+	"FORWARD":       2,
+	"ON_CHAIN":      3,
+	"CHANNEL_OPEN":  4,
+	"CHANNEL_CLOSE": 5,
+}
+
+func (x LedgerEntry_EntryType) String() string {
+	return proto.EnumName(LedgerEntry_EntryType_name, int32(x))
+}
+
+func (LedgerEntry_EntryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{150, 0}
 }
 
 type GenSeedRequest struct {
@@ -550,10 +665,16 @@ type InitWalletRequest struct {
 	//total data loss occurred. If specified, then after on-chain recovery of
 	//funds, lnd begin to carry out the data loss recovery protocol in order to
 	//recover the funds in each channel from a remote force closed transaction.
-	ChannelBackups       *ChanBackupSnapshot `protobuf:"bytes,5,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	ChannelBackups *ChanBackupSnapshot `protobuf:"bytes,5,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
+	//*
+	//stateless_init is an optional argument instructing the daemon NOT to create
+	//any *.macaroon files in its filesystem. If this parameter is set, then the
+	//admin macaroon returned in the response MUST be stored by the caller of the
+	//RPC as otherwise all access to the daemon will be lost!
+	StatelessInit        bool     `protobuf:"varint,6,opt,name=stateless_init,json=statelessInit,proto3" json:"stateless_init,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InitWalletRequest) Reset()         { *m = InitWalletRequest{} }
@@ -616,7 +737,21 @@ func (m *InitWalletRequest) GetChannelBackups() *ChanBackupSnapshot {
 	return nil
 }
 
+func (m *InitWalletRequest) GetStatelessInit() bool {
+	if m != nil {
+		return m.StatelessInit
+	}
+	return false
+}
+
 type InitWalletResponse struct {
+	//*
+	//The binary serialized admin macaroon that can be used to access the daemon
+	//after creating the wallet. If the stateless_init parameter was set to true,
+	//this is the ONLY copy of the macaroon and MUST be stored safely by the
+	//caller. Otherwise a copy of this macaroon is also persisted on disk by the
+	//daemon, together with other macaroon files.
+	AdminMacaroon        []byte   `protobuf:"bytes,1,opt,name=admin_macaroon,json=adminMacaroon,proto3" json:"admin_macaroon,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -647,6 +782,13 @@ func (m *InitWalletResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InitWalletResponse proto.InternalMessageInfo
 
+func (m *InitWalletResponse) GetAdminMacaroon() []byte {
+	if m != nil {
+		return m.AdminMacaroon
+	}
+	return nil
+}
+
 type UnlockWalletRequest struct {
 	//*
 	//wallet_password should be the current valid passphrase for the daemon. This
@@ -667,10 +809,14 @@ type UnlockWalletRequest struct {
 	//total data loss occurred. If specified, then after on-chain recovery of
 	//funds, lnd begin to carry out the data loss recovery protocol in order to
 	//recover the funds in each channel from a remote force closed transaction.
-	ChannelBackups       *ChanBackupSnapshot `protobuf:"bytes,3,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	ChannelBackups *ChanBackupSnapshot `protobuf:"bytes,3,opt,name=channel_backups,json=channelBackups,proto3" json:"channel_backups,omitempty"`
+	//*
+	//stateless_init is an optional argument instructing the daemon NOT to create
+	//any *.macaroon files in its file system.
+	StatelessInit        bool     `protobuf:"varint,4,opt,name=stateless_init,json=statelessInit,proto3" json:"stateless_init,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UnlockWalletRequest) Reset()         { *m = UnlockWalletRequest{} }
@@ -719,6 +865,13 @@ func (m *UnlockWalletRequest) GetChannelBackups() *ChanBackupSnapshot {
 	return nil
 }
 
+func (m *UnlockWalletRequest) GetStatelessInit() bool {
+	if m != nil {
+		return m.StatelessInit
+	}
+	return false
+}
+
 type UnlockWalletResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -758,7 +911,19 @@ type ChangePasswordRequest struct {
 	//*
 	//new_password should be the new passphrase that will be needed to unlock the
 	//daemon. When using REST, this field must be encoded as base64.
-	NewPassword          []byte   `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	NewPassword []byte `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	//*
+	//stateless_init is an optional argument instructing the daemon NOT to create
+	//any *.macaroon files in its filesystem. If this parameter is set, then the
+	//admin macaroon returned in the response MUST be stored by the caller of the
+	//RPC as otherwise all access to the daemon will be lost! Any existing
+	//macaroon files are deleted.
+	StatelessInit bool `protobuf:"varint,3,opt,name=stateless_init,json=statelessInit,proto3" json:"stateless_init,omitempty"`
+	//*
+	//new_macaroon_root_key is an optional argument instructing the daemon to
+	//rotate the macaroon root key when set to true. This will invalidate all
+	//previously generated macaroons.
+	NewMacaroonRootKey   bool     `protobuf:"varint,4,opt,name=new_macaroon_root_key,json=newMacaroonRootKey,proto3" json:"new_macaroon_root_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -803,7 +968,28 @@ func (m *ChangePasswordRequest) GetNewPassword() []byte {
 	return nil
 }
 
+func (m *ChangePasswordRequest) GetStatelessInit() bool {
+	if m != nil {
+		return m.StatelessInit
+	}
+	return false
+}
+
+func (m *ChangePasswordRequest) GetNewMacaroonRootKey() bool {
+	if m != nil {
+		return m.NewMacaroonRootKey
+	}
+	return false
+}
+
 type ChangePasswordResponse struct {
+	//*
+	//The binary serialized admin macaroon that can be used to access the daemon
+	//after changing the password. If the stateless_init parameter was set to
+	//true, no copy of this macaroon is persisted on disk by the daemon, so it
+	//MUST be stored safely by the caller. Otherwise a copy of this macaroon is
+	//also persisted on disk by the daemon, together with other macaroon files.
+	AdminMacaroon        []byte   `protobuf:"bytes,1,opt,name=admin_macaroon,json=adminMacaroon,proto3" json:"admin_macaroon,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -834,6 +1020,13 @@ func (m *ChangePasswordResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ChangePasswordResponse proto.InternalMessageInfo
 
+func (m *ChangePasswordResponse) GetAdminMacaroon() []byte {
+	if m != nil {
+		return m.AdminMacaroon
+	}
+	return nil
+}
+
 type Utxo struct {
 	/// The type of address
 	Type AddressType `protobuf:"varint,1,opt,name=type,json=address_type,proto3,enum=lnrpc.AddressType" json:"type,omitempty"`
@@ -937,7 +1130,9 @@ type Transaction struct {
 	/// Addresses that received funds for this transaction
 	DestAddresses []string `protobuf:"bytes,8,rep,name=dest_addresses,proto3" json:"dest_addresses,omitempty"`
 	/// The raw transaction hex.
-	RawTxHex             string   `protobuf:"bytes,9,opt,name=raw_tx_hex,proto3" json:"raw_tx_hex,omitempty"`
+	RawTxHex string `protobuf:"bytes,9,opt,name=raw_tx_hex,proto3" json:"raw_tx_hex,omitempty"`
+	/// A label that was optionally set on transaction broadcast.
+	Label                string   `protobuf:"bytes,10,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1031,6 +1226,13 @@ func (m *Transaction) GetRawTxHex() string {
 	return ""
 }
 
+func (m *Transaction) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type GetTransactionsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -2029,10 +2231,23 @@ type SendManyRequest struct {
 	/// The target number of blocks that this transaction should be confirmed by.
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
 	/// A manual fee rate set in sat/byte that should be used when crafting the transaction.
-	SatPerByte           int64    `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	/// An optional label for the transaction, limited to 500 characters.
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	/// The minimum number of confirmations each one of your outputs used for
+	/// the transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,7,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
+	/// Whether unconfirmed outputs should be used as inputs for the transaction.
+	SpendUnconfirmed bool `protobuf:"varint,8,opt,name=spend_unconfirmed,json=spendUnconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	//*
+	//An optional list of outpoints of the wallet that should be spent by the
+	//transaction. If set, exactly these outputs are spent, with any remaining
+	//value being sent to a change output, instead of letting the wallet select
+	//the inputs. The outputs must not be leased.
+	Outpoints            []*OutPoint `protobuf:"bytes,9,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *SendManyRequest) Reset()         { *m = SendManyRequest{} }
@@ -2081,6 +2296,34 @@ func (m *SendManyRequest) GetSatPerByte() int64 {
 	return 0
 }
 
+func (m *SendManyRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *SendManyRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+func (m *SendManyRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
+	}
+	return false
+}
+
+func (m *SendManyRequest) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
 type SendManyResponse struct {
 	/// The id of the transaction
 	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
@@ -2134,10 +2377,24 @@ type SendCoinsRequest struct {
 	//If set, then the amount field will be ignored, and lnd will attempt to
 	//send all the coins under control of the internal wallet to the specified
 	//address.
-	SendAll              bool     `protobuf:"varint,6,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SendAll bool `protobuf:"varint,6,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	/// An optional label for the transaction, limited to 500 characters.
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	/// The minimum number of confirmations each one of your outputs used for
+	/// the transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,8,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
+	/// Whether unconfirmed outputs should be used as inputs for the transaction.
+	SpendUnconfirmed bool `protobuf:"varint,9,opt,name=spend_unconfirmed,json=spendUnconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	//*
+	//An optional list of outpoints of the wallet that should be spent by the
+	//transaction. If set, exactly these outputs are spent, with any remaining
+	//value being sent to a change output, instead of letting the wallet select
+	//the inputs. If send_all is set, exactly these outputs are swept. The
+	//outputs must not be leased.
+	Outpoints            []*OutPoint `protobuf:"bytes,10,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *SendCoinsRequest) Reset()         { *m = SendCoinsRequest{} }
@@ -2200,6 +2457,34 @@ func (m *SendCoinsRequest) GetSendAll() bool {
 	return false
 }
 
+func (m *SendCoinsRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *SendCoinsRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+func (m *SendCoinsRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
+	}
+	return false
+}
+
+func (m *SendCoinsRequest) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
 type SendCoinsResponse struct {
 	/// The transaction ID of the transaction
 	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
@@ -3956,7 +4241,7 @@ func (m *GetInfoResponse) GetFeatures() map[uint32]*Feature {
 }
 
 type Chain struct {
-	/// The blockchain the node is on (eg bitcoin, litecoin)
+	/// The blockchain the node is on (eg bitcoin, actinium)
 	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
 	/// The network the node is on (eg regtest, testnet, mainnet)
 	Network              string   `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
@@ -4004,134 +4289,442 @@ func (m *Chain) GetNetwork() string {
 	return ""
 }
 
-type ConfirmationUpdate struct {
-	BlockSha             []byte   `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
-	BlockHeight          int32    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	NumConfsLeft         uint32   `protobuf:"varint,3,opt,name=num_confs_left,json=numConfsLeft,proto3" json:"num_confs_left,omitempty"`
+type GetRecoveryInfoRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
-func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
-func (*ConfirmationUpdate) ProtoMessage()    {}
-func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
+func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{} }
+func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRecoveryInfoRequest) ProtoMessage()    {}
+func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{54}
 }
 
-func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConfirmationUpdate.Unmarshal(m, b)
+func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRecoveryInfoRequest.Unmarshal(m, b)
 }
-func (m *ConfirmationUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConfirmationUpdate.Marshal(b, m, deterministic)
+func (m *GetRecoveryInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRecoveryInfoRequest.Marshal(b, m, deterministic)
 }
-func (m *ConfirmationUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConfirmationUpdate.Merge(m, src)
+func (m *GetRecoveryInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRecoveryInfoRequest.Merge(m, src)
 }
-func (m *ConfirmationUpdate) XXX_Size() int {
-	return xxx_messageInfo_ConfirmationUpdate.Size(m)
+func (m *GetRecoveryInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRecoveryInfoRequest.Size(m)
 }
-func (m *ConfirmationUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConfirmationUpdate.DiscardUnknown(m)
+func (m *GetRecoveryInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRecoveryInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConfirmationUpdate proto.InternalMessageInfo
+var xxx_messageInfo_GetRecoveryInfoRequest proto.InternalMessageInfo
 
-func (m *ConfirmationUpdate) GetBlockSha() []byte {
+type GetRecoveryInfoResponse struct {
+	/// Whether the wallet is in recovery mode
+	RecoveryMode bool `protobuf:"varint,1,opt,name=recovery_mode,proto3" json:"recovery_mode,omitempty"`
+	/// Whether the wallet recovery progress is finished
+	RecoveryFinished bool `protobuf:"varint,2,opt,name=recovery_finished,proto3" json:"recovery_finished,omitempty"`
+	/// The recovery progress, ranging from 0 to 1.
+	Progress float64 `protobuf:"fixed64,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	/// The height of the block the rescan of the wallet started from.
+	BirthdayHeight int32 `protobuf:"varint,4,opt,name=birthday_height,proto3" json:"birthday_height,omitempty"`
+	/// The number of keys derived so far for each key family.
+	KeyFamilies []*KeyFamilyRecovery `protobuf:"bytes,5,rep,name=key_families,proto3" json:"key_families,omitempty"`
+	/// The progress of the rescan for commitment outputs of closed channels.
+	CommitOutputs        *CommitOutputRecovery `protobuf:"bytes,6,opt,name=commit_outputs,proto3" json:"commit_outputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse{} }
+func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRecoveryInfoResponse) ProtoMessage()    {}
+func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{55}
+}
+
+func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRecoveryInfoResponse.Unmarshal(m, b)
+}
+func (m *GetRecoveryInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRecoveryInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRecoveryInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRecoveryInfoResponse.Merge(m, src)
+}
+func (m *GetRecoveryInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRecoveryInfoResponse.Size(m)
+}
+func (m *GetRecoveryInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRecoveryInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRecoveryInfoResponse proto.InternalMessageInfo
+
+func (m *GetRecoveryInfoResponse) GetRecoveryMode() bool {
 	if m != nil {
-		return m.BlockSha
+		return m.RecoveryMode
 	}
-	return nil
+	return false
 }
 
-func (m *ConfirmationUpdate) GetBlockHeight() int32 {
+func (m *GetRecoveryInfoResponse) GetRecoveryFinished() bool {
 	if m != nil {
-		return m.BlockHeight
+		return m.RecoveryFinished
 	}
-	return 0
+	return false
 }
 
-func (m *ConfirmationUpdate) GetNumConfsLeft() uint32 {
+func (m *GetRecoveryInfoResponse) GetProgress() float64 {
 	if m != nil {
-		return m.NumConfsLeft
+		return m.Progress
 	}
 	return 0
 }
 
-type ChannelOpenUpdate struct {
-	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
-func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
-func (*ChannelOpenUpdate) ProtoMessage()    {}
-func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{55}
+func (m *GetRecoveryInfoResponse) GetBirthdayHeight() int32 {
+	if m != nil {
+		return m.BirthdayHeight
+	}
+	return 0
 }
 
-func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelOpenUpdate.Unmarshal(m, b)
-}
-func (m *ChannelOpenUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelOpenUpdate.Marshal(b, m, deterministic)
-}
-func (m *ChannelOpenUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelOpenUpdate.Merge(m, src)
-}
-func (m *ChannelOpenUpdate) XXX_Size() int {
-	return xxx_messageInfo_ChannelOpenUpdate.Size(m)
-}
-func (m *ChannelOpenUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelOpenUpdate.DiscardUnknown(m)
+func (m *GetRecoveryInfoResponse) GetKeyFamilies() []*KeyFamilyRecovery {
+	if m != nil {
+		return m.KeyFamilies
+	}
+	return nil
 }
 
-var xxx_messageInfo_ChannelOpenUpdate proto.InternalMessageInfo
-
-func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
+func (m *GetRecoveryInfoResponse) GetCommitOutputs() *CommitOutputRecovery {
 	if m != nil {
-		return m.ChannelPoint
+		return m.CommitOutputs
 	}
 	return nil
 }
 
-type ChannelCloseUpdate struct {
-	ClosingTxid          []byte   `protobuf:"bytes,1,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
-	Success              bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+type KeyFamilyRecovery struct {
+	/// The key family.
+	KeyFamily uint32 `protobuf:"varint,1,opt,name=key_family,proto3" json:"key_family,omitempty"`
+	/// The number of keys that have been derived for the key family.
+	KeyCount             uint32   `protobuf:"varint,2,opt,name=key_count,proto3" json:"key_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
-func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
-func (*ChannelCloseUpdate) ProtoMessage()    {}
-func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
+func (m *KeyFamilyRecovery) Reset()         { *m = KeyFamilyRecovery{} }
+func (m *KeyFamilyRecovery) String() string { return proto.CompactTextString(m) }
+func (*KeyFamilyRecovery) ProtoMessage()    {}
+func (*KeyFamilyRecovery) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{56}
 }
 
-func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelCloseUpdate.Unmarshal(m, b)
+func (m *KeyFamilyRecovery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyFamilyRecovery.Unmarshal(m, b)
 }
-func (m *ChannelCloseUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelCloseUpdate.Marshal(b, m, deterministic)
+func (m *KeyFamilyRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyFamilyRecovery.Marshal(b, m, deterministic)
 }
-func (m *ChannelCloseUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelCloseUpdate.Merge(m, src)
+func (m *KeyFamilyRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyFamilyRecovery.Merge(m, src)
 }
-func (m *ChannelCloseUpdate) XXX_Size() int {
-	return xxx_messageInfo_ChannelCloseUpdate.Size(m)
+func (m *KeyFamilyRecovery) XXX_Size() int {
+	return xxx_messageInfo_KeyFamilyRecovery.Size(m)
 }
-func (m *ChannelCloseUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelCloseUpdate.DiscardUnknown(m)
+func (m *KeyFamilyRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyFamilyRecovery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelCloseUpdate proto.InternalMessageInfo
+var xxx_messageInfo_KeyFamilyRecovery proto.InternalMessageInfo
 
-func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
+func (m *KeyFamilyRecovery) GetKeyFamily() uint32 {
+	if m != nil {
+		return m.KeyFamily
+	}
+	return 0
+}
+
+func (m *KeyFamilyRecovery) GetKeyCount() uint32 {
+	if m != nil {
+		return m.KeyCount
+	}
+	return 0
+}
+
+type CommitOutputRecovery struct {
+	/// Whether the rescan has caught up with the chain.
+	Finished bool `protobuf:"varint,1,opt,name=finished,proto3" json:"finished,omitempty"`
+	/// The rescan progress, ranging from 0 to 1.
+	Progress float64 `protobuf:"fixed64,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	/// The unspent commitment outputs found so far.
+	Outputs              []*RecoveredCommitOutput `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CommitOutputRecovery) Reset()         { *m = CommitOutputRecovery{} }
+func (m *CommitOutputRecovery) String() string { return proto.CompactTextString(m) }
+func (*CommitOutputRecovery) ProtoMessage()    {}
+func (*CommitOutputRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{57}
+}
+
+func (m *CommitOutputRecovery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitOutputRecovery.Unmarshal(m, b)
+}
+func (m *CommitOutputRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitOutputRecovery.Marshal(b, m, deterministic)
+}
+func (m *CommitOutputRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitOutputRecovery.Merge(m, src)
+}
+func (m *CommitOutputRecovery) XXX_Size() int {
+	return xxx_messageInfo_CommitOutputRecovery.Size(m)
+}
+func (m *CommitOutputRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitOutputRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitOutputRecovery proto.InternalMessageInfo
+
+func (m *CommitOutputRecovery) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *CommitOutputRecovery) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *CommitOutputRecovery) GetOutputs() []*RecoveredCommitOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+type RecoveredCommitOutput struct {
+	/// The outpoint of the commitment output.
+	Outpoint string `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	/// The value of the commitment output in satoshis.
+	AmountSat int64 `protobuf:"varint,2,opt,name=amount_sat,proto3" json:"amount_sat,omitempty"`
+	/// The key family of the key the output pays to.
+	KeyFamily uint32 `protobuf:"varint,3,opt,name=key_family,proto3" json:"key_family,omitempty"`
+	/// The index of the key the output pays to.
+	KeyIndex uint32 `protobuf:"varint,4,opt,name=key_index,proto3" json:"key_index,omitempty"`
+	/// The height of the block that confirmed the output.
+	ConfHeight uint32 `protobuf:"varint,5,opt,name=conf_height,proto3" json:"conf_height,omitempty"`
+	/// Whether the output has been handed to the sweeper.
+	Swept                bool     `protobuf:"varint,6,opt,name=swept,proto3" json:"swept,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecoveredCommitOutput) Reset()         { *m = RecoveredCommitOutput{} }
+func (m *RecoveredCommitOutput) String() string { return proto.CompactTextString(m) }
+func (*RecoveredCommitOutput) ProtoMessage()    {}
+func (*RecoveredCommitOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{58}
+}
+
+func (m *RecoveredCommitOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecoveredCommitOutput.Unmarshal(m, b)
+}
+func (m *RecoveredCommitOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecoveredCommitOutput.Marshal(b, m, deterministic)
+}
+func (m *RecoveredCommitOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveredCommitOutput.Merge(m, src)
+}
+func (m *RecoveredCommitOutput) XXX_Size() int {
+	return xxx_messageInfo_RecoveredCommitOutput.Size(m)
+}
+func (m *RecoveredCommitOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveredCommitOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveredCommitOutput proto.InternalMessageInfo
+
+func (m *RecoveredCommitOutput) GetOutpoint() string {
+	if m != nil {
+		return m.Outpoint
+	}
+	return ""
+}
+
+func (m *RecoveredCommitOutput) GetAmountSat() int64 {
+	if m != nil {
+		return m.AmountSat
+	}
+	return 0
+}
+
+func (m *RecoveredCommitOutput) GetKeyFamily() uint32 {
+	if m != nil {
+		return m.KeyFamily
+	}
+	return 0
+}
+
+func (m *RecoveredCommitOutput) GetKeyIndex() uint32 {
+	if m != nil {
+		return m.KeyIndex
+	}
+	return 0
+}
+
+func (m *RecoveredCommitOutput) GetConfHeight() uint32 {
+	if m != nil {
+		return m.ConfHeight
+	}
+	return 0
+}
+
+func (m *RecoveredCommitOutput) GetSwept() bool {
+	if m != nil {
+		return m.Swept
+	}
+	return false
+}
+
+type ConfirmationUpdate struct {
+	BlockSha             []byte   `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
+	BlockHeight          int32    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	NumConfsLeft         uint32   `protobuf:"varint,3,opt,name=num_confs_left,json=numConfsLeft,proto3" json:"num_confs_left,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
+func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
+func (*ConfirmationUpdate) ProtoMessage()    {}
+func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{59}
+}
+
+func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmationUpdate.Unmarshal(m, b)
+}
+func (m *ConfirmationUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmationUpdate.Marshal(b, m, deterministic)
+}
+func (m *ConfirmationUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmationUpdate.Merge(m, src)
+}
+func (m *ConfirmationUpdate) XXX_Size() int {
+	return xxx_messageInfo_ConfirmationUpdate.Size(m)
+}
+func (m *ConfirmationUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmationUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmationUpdate proto.InternalMessageInfo
+
+func (m *ConfirmationUpdate) GetBlockSha() []byte {
+	if m != nil {
+		return m.BlockSha
+	}
+	return nil
+}
+
+func (m *ConfirmationUpdate) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ConfirmationUpdate) GetNumConfsLeft() uint32 {
+	if m != nil {
+		return m.NumConfsLeft
+	}
+	return 0
+}
+
+type ChannelOpenUpdate struct {
+	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
+func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelOpenUpdate) ProtoMessage()    {}
+func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{60}
+}
+
+func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelOpenUpdate.Unmarshal(m, b)
+}
+func (m *ChannelOpenUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelOpenUpdate.Marshal(b, m, deterministic)
+}
+func (m *ChannelOpenUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelOpenUpdate.Merge(m, src)
+}
+func (m *ChannelOpenUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelOpenUpdate.Size(m)
+}
+func (m *ChannelOpenUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelOpenUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelOpenUpdate proto.InternalMessageInfo
+
+func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+type ChannelCloseUpdate struct {
+	ClosingTxid          []byte   `protobuf:"bytes,1,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	Success              bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
+func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelCloseUpdate) ProtoMessage()    {}
+func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{61}
+}
+
+func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelCloseUpdate.Unmarshal(m, b)
+}
+func (m *ChannelCloseUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelCloseUpdate.Marshal(b, m, deterministic)
+}
+func (m *ChannelCloseUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelCloseUpdate.Merge(m, src)
+}
+func (m *ChannelCloseUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelCloseUpdate.Size(m)
+}
+func (m *ChannelCloseUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelCloseUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelCloseUpdate proto.InternalMessageInfo
+
+func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
 	if m != nil {
 		return m.ClosingTxid
 	}
@@ -4172,7 +4765,7 @@ func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
 func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseChannelRequest) ProtoMessage()    {}
 func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{57}
+	return fileDescriptor_77a6da22d6a3feb1, []int{62}
 }
 
 func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -4242,7 +4835,7 @@ func (m *CloseStatusUpdate) Reset()         { *m = CloseStatusUpdate{} }
 func (m *CloseStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*CloseStatusUpdate) ProtoMessage()    {}
 func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{58}
+	return fileDescriptor_77a6da22d6a3feb1, []int{63}
 }
 
 func (m *CloseStatusUpdate) XXX_Unmarshal(b []byte) error {
@@ -4320,7 +4913,7 @@ func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
 func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
 func (*PendingUpdate) ProtoMessage()    {}
 func (*PendingUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{59}
+	return fileDescriptor_77a6da22d6a3feb1, []int{64}
 }
 
 func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
@@ -4398,17 +4991,27 @@ type OpenChannelRequest struct {
 	//particular key for the commitment key (ideally cold) rather than use one
 	//that is generated by the wallet as normal, or signal that signing will be
 	//carried out in an interactive manner (PSBT based).
-	FundingShim          *FundingShim `protobuf:"bytes,14,opt,name=funding_shim,proto3" json:"funding_shim,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	FundingShim *FundingShim `protobuf:"bytes,14,opt,name=funding_shim,proto3" json:"funding_shim,omitempty"`
+	//*
+	//An optional label for the funding transaction, limited to 500 characters.
+	//If not set, the funding transaction is labelled automatically.
+	Label string `protobuf:"bytes,15,opt,name=label,proto3" json:"label,omitempty"`
+	//*
+	//An optional list of outpoints of the wallet that restricts the outputs
+	//that may be used to fund the channel. The funding transaction will only
+	//spend outputs from this list, which must satisfy min_confs and must not be
+	//leased.
+	Outpoints            []*OutPoint `protobuf:"bytes,16,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
 func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*OpenChannelRequest) ProtoMessage()    {}
 func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{60}
+	return fileDescriptor_77a6da22d6a3feb1, []int{65}
 }
 
 func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -4521,6 +5124,20 @@ func (m *OpenChannelRequest) GetFundingShim() *FundingShim {
 	return nil
 }
 
+func (m *OpenChannelRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *OpenChannelRequest) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
 type OpenStatusUpdate struct {
 	// Types that are valid to be assigned to Update:
 	//	*OpenStatusUpdate_ChanPending
@@ -4539,7 +5156,7 @@ func (m *OpenStatusUpdate) Reset()         { *m = OpenStatusUpdate{} }
 func (m *OpenStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*OpenStatusUpdate) ProtoMessage()    {}
 func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{61}
+	return fileDescriptor_77a6da22d6a3feb1, []int{66}
 }
 
 func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
@@ -4626,7 +5243,7 @@ func (m *KeyLocator) Reset()         { *m = KeyLocator{} }
 func (m *KeyLocator) String() string { return proto.CompactTextString(m) }
 func (*KeyLocator) ProtoMessage()    {}
 func (*KeyLocator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{62}
+	return fileDescriptor_77a6da22d6a3feb1, []int{67}
 }
 
 func (m *KeyLocator) XXX_Unmarshal(b []byte) error {
@@ -4677,7 +5294,7 @@ func (m *KeyDescriptor) Reset()         { *m = KeyDescriptor{} }
 func (m *KeyDescriptor) String() string { return proto.CompactTextString(m) }
 func (*KeyDescriptor) ProtoMessage()    {}
 func (*KeyDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{63}
+	return fileDescriptor_77a6da22d6a3feb1, []int{68}
 }
 
 func (m *KeyDescriptor) XXX_Unmarshal(b []byte) error {
@@ -4738,7 +5355,7 @@ func (m *ChanPointShim) Reset()         { *m = ChanPointShim{} }
 func (m *ChanPointShim) String() string { return proto.CompactTextString(m) }
 func (*ChanPointShim) ProtoMessage()    {}
 func (*ChanPointShim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{64}
+	return fileDescriptor_77a6da22d6a3feb1, []int{69}
 }
 
 func (m *ChanPointShim) XXX_Unmarshal(b []byte) error {
@@ -4807,7 +5424,7 @@ func (m *FundingShim) Reset()         { *m = FundingShim{} }
 func (m *FundingShim) String() string { return proto.CompactTextString(m) }
 func (*FundingShim) ProtoMessage()    {}
 func (*FundingShim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{65}
+	return fileDescriptor_77a6da22d6a3feb1, []int{70}
 }
 
 func (m *FundingShim) XXX_Unmarshal(b []byte) error {
@@ -4871,7 +5488,7 @@ func (m *FundingShimCancel) Reset()         { *m = FundingShimCancel{} }
 func (m *FundingShimCancel) String() string { return proto.CompactTextString(m) }
 func (*FundingShimCancel) ProtoMessage()    {}
 func (*FundingShimCancel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{66}
+	return fileDescriptor_77a6da22d6a3feb1, []int{71}
 }
 
 func (m *FundingShimCancel) XXX_Unmarshal(b []byte) error {
@@ -4913,7 +5530,7 @@ func (m *FundingTransitionMsg) Reset()         { *m = FundingTransitionMsg{} }
 func (m *FundingTransitionMsg) String() string { return proto.CompactTextString(m) }
 func (*FundingTransitionMsg) ProtoMessage()    {}
 func (*FundingTransitionMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{67}
+	return fileDescriptor_77a6da22d6a3feb1, []int{72}
 }
 
 func (m *FundingTransitionMsg) XXX_Unmarshal(b []byte) error {
@@ -4989,7 +5606,7 @@ func (m *FundingStateStepResp) Reset()         { *m = FundingStateStepResp{} }
 func (m *FundingStateStepResp) String() string { return proto.CompactTextString(m) }
 func (*FundingStateStepResp) ProtoMessage()    {}
 func (*FundingStateStepResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{68}
+	return fileDescriptor_77a6da22d6a3feb1, []int{73}
 }
 
 func (m *FundingStateStepResp) XXX_Unmarshal(b []byte) error {
@@ -5035,7 +5652,7 @@ func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
 func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
 func (*PendingHTLC) ProtoMessage()    {}
 func (*PendingHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{69}
+	return fileDescriptor_77a6da22d6a3feb1, []int{74}
 }
 
 func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
@@ -5108,7 +5725,7 @@ func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{}
 func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsRequest) ProtoMessage()    {}
 func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{70}
+	return fileDescriptor_77a6da22d6a3feb1, []int{75}
 }
 
 func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -5149,7 +5766,7 @@ func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse
 func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse) ProtoMessage()    {}
 func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71}
+	return fileDescriptor_77a6da22d6a3feb1, []int{76}
 }
 
 func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
@@ -5228,7 +5845,7 @@ func (m *PendingChannelsResponse_PendingChannel) Reset() {
 func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{76, 0}
 }
 
 func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
@@ -5330,7 +5947,7 @@ func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
 }
 func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71, 1}
+	return fileDescriptor_77a6da22d6a3feb1, []int{76, 1}
 }
 
 func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
@@ -5404,7 +6021,7 @@ func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
 }
 func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71, 2}
+	return fileDescriptor_77a6da22d6a3feb1, []int{76, 2}
 }
 
 func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
@@ -5453,7 +6070,7 @@ func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingCh
 func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71, 3}
+	return fileDescriptor_77a6da22d6a3feb1, []int{76, 3}
 }
 
 func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
@@ -5518,7 +6135,7 @@ func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
 }
 func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71, 4}
+	return fileDescriptor_77a6da22d6a3feb1, []int{76, 4}
 }
 
 func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
@@ -5598,7 +6215,7 @@ func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscripti
 func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
 func (*ChannelEventSubscription) ProtoMessage()    {}
 func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72}
+	return fileDescriptor_77a6da22d6a3feb1, []int{77}
 }
 
 func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
@@ -5637,7 +6254,7 @@ func (m *ChannelEventUpdate) Reset()         { *m = ChannelEventUpdate{} }
 func (m *ChannelEventUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelEventUpdate) ProtoMessage()    {}
 func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{73}
+	return fileDescriptor_77a6da22d6a3feb1, []int{78}
 }
 
 func (m *ChannelEventUpdate) XXX_Unmarshal(b []byte) error {
@@ -5762,7 +6379,7 @@ func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
 func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*WalletBalanceRequest) ProtoMessage()    {}
 func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{74}
+	return fileDescriptor_77a6da22d6a3feb1, []int{79}
 }
 
 func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -5789,17 +6406,21 @@ type WalletBalanceResponse struct {
 	/// The confirmed balance of a wallet(with >= 1 confirmations)
 	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance,proto3" json:"confirmed_balance,omitempty"`
 	/// The unconfirmed balance of a wallet(with 0 confirmations)
-	UnconfirmedBalance   int64    `protobuf:"varint,3,opt,name=unconfirmed_balance,proto3" json:"unconfirmed_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UnconfirmedBalance int64 `protobuf:"varint,3,opt,name=unconfirmed_balance,proto3" json:"unconfirmed_balance,omitempty"`
+	//
+	//The balance of each account of the wallet, keyed by account name. Accounts
+	//of different address types that share a name are reported together.
+	AccountBalance       map[string]*WalletAccountBalance `protobuf:"bytes,4,rep,name=account_balance,proto3" json:"account_balance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
 func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
 func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*WalletBalanceResponse) ProtoMessage()    {}
 func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{75}
+	return fileDescriptor_77a6da22d6a3feb1, []int{80}
 }
 
 func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -5841,6 +6462,62 @@ func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
 	return 0
 }
 
+func (m *WalletBalanceResponse) GetAccountBalance() map[string]*WalletAccountBalance {
+	if m != nil {
+		return m.AccountBalance
+	}
+	return nil
+}
+
+type WalletAccountBalance struct {
+	/// The confirmed balance of the account (with >= 1 confirmations).
+	ConfirmedBalance int64 `protobuf:"varint,1,opt,name=confirmed_balance,proto3" json:"confirmed_balance,omitempty"`
+	/// The unconfirmed balance of the account (with 0 confirmations).
+	UnconfirmedBalance   int64    `protobuf:"varint,2,opt,name=unconfirmed_balance,proto3" json:"unconfirmed_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletAccountBalance) Reset()         { *m = WalletAccountBalance{} }
+func (m *WalletAccountBalance) String() string { return proto.CompactTextString(m) }
+func (*WalletAccountBalance) ProtoMessage()    {}
+func (*WalletAccountBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{81}
+}
+
+func (m *WalletAccountBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletAccountBalance.Unmarshal(m, b)
+}
+func (m *WalletAccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletAccountBalance.Marshal(b, m, deterministic)
+}
+func (m *WalletAccountBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletAccountBalance.Merge(m, src)
+}
+func (m *WalletAccountBalance) XXX_Size() int {
+	return xxx_messageInfo_WalletAccountBalance.Size(m)
+}
+func (m *WalletAccountBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletAccountBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletAccountBalance proto.InternalMessageInfo
+
+func (m *WalletAccountBalance) GetConfirmedBalance() int64 {
+	if m != nil {
+		return m.ConfirmedBalance
+	}
+	return 0
+}
+
+func (m *WalletAccountBalance) GetUnconfirmedBalance() int64 {
+	if m != nil {
+		return m.UnconfirmedBalance
+	}
+	return 0
+}
+
 type ChannelBalanceRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -5851,7 +6528,7 @@ func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
 func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelBalanceRequest) ProtoMessage()    {}
 func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{76}
+	return fileDescriptor_77a6da22d6a3feb1, []int{82}
 }
 
 func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -5886,7 +6563,7 @@ func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{}
 func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*ChannelBalanceResponse) ProtoMessage()    {}
 func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{77}
+	return fileDescriptor_77a6da22d6a3feb1, []int{83}
 }
 
 func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -6004,7 +6681,7 @@ func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
 func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRoutesRequest) ProtoMessage()    {}
 func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{78}
+	return fileDescriptor_77a6da22d6a3feb1, []int{84}
 }
 
 func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
@@ -6156,7 +6833,7 @@ func (m *NodePair) Reset()         { *m = NodePair{} }
 func (m *NodePair) String() string { return proto.CompactTextString(m) }
 func (*NodePair) ProtoMessage()    {}
 func (*NodePair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{79}
+	return fileDescriptor_77a6da22d6a3feb1, []int{85}
 }
 
 func (m *NodePair) XXX_Unmarshal(b []byte) error {
@@ -6209,7 +6886,7 @@ func (m *EdgeLocator) Reset()         { *m = EdgeLocator{} }
 func (m *EdgeLocator) String() string { return proto.CompactTextString(m) }
 func (*EdgeLocator) ProtoMessage()    {}
 func (*EdgeLocator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{80}
+	return fileDescriptor_77a6da22d6a3feb1, []int{86}
 }
 
 func (m *EdgeLocator) XXX_Unmarshal(b []byte) error {
@@ -6262,7 +6939,7 @@ func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
 func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRoutesResponse) ProtoMessage()    {}
 func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{81}
+	return fileDescriptor_77a6da22d6a3feb1, []int{87}
 }
 
 func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
@@ -6328,17 +7005,23 @@ type Hop struct {
 	//An optional set of key-value TLV records. This is useful within the context
 	//of the SendToRoute call as it allows callers to specify arbitrary K-V pairs
 	//to drop off at each hop within the onion.
-	CustomRecords        map[uint64][]byte `protobuf:"bytes,11,rep,name=custom_records,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	CustomRecords map[uint64][]byte `protobuf:"bytes,11,rep,name=custom_records,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//*
+	//An optional TLV record that signals the use of an AMP payment. If present,
+	//the receiver reconstructs the root share from the shares of all HTLCs in
+	//the set, and settles each of them with the child preimage derived from it.
+	//An amp_record is only valid alongside an mpp_record.
+	AmpRecord            *AMPRecord `protobuf:"bytes,12,opt,name=amp_record,proto3" json:"amp_record,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *Hop) Reset()         { *m = Hop{} }
 func (m *Hop) String() string { return proto.CompactTextString(m) }
 func (*Hop) ProtoMessage()    {}
 func (*Hop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{82}
+	return fileDescriptor_77a6da22d6a3feb1, []int{88}
 }
 
 func (m *Hop) XXX_Unmarshal(b []byte) error {
@@ -6438,6 +7121,71 @@ func (m *Hop) GetCustomRecords() map[uint64][]byte {
 	return nil
 }
 
+func (m *Hop) GetAmpRecord() *AMPRecord {
+	if m != nil {
+		return m.AmpRecord
+	}
+	return nil
+}
+
+type AMPRecord struct {
+	/// The share of the root secret carried by this HTLC.
+	RootShare []byte `protobuf:"bytes,1,opt,name=root_share,proto3" json:"root_share,omitempty"`
+	/// The identifier of the HTLC set, the hash of the root secret.
+	SetId []byte `protobuf:"bytes,2,opt,name=set_id,proto3" json:"set_id,omitempty"`
+	/// The index used to derive the child preimage of this HTLC.
+	ChildIndex           uint32   `protobuf:"varint,3,opt,name=child_index,proto3" json:"child_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AMPRecord) Reset()         { *m = AMPRecord{} }
+func (m *AMPRecord) String() string { return proto.CompactTextString(m) }
+func (*AMPRecord) ProtoMessage()    {}
+func (*AMPRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+}
+
+func (m *AMPRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AMPRecord.Unmarshal(m, b)
+}
+func (m *AMPRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AMPRecord.Marshal(b, m, deterministic)
+}
+func (m *AMPRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AMPRecord.Merge(m, src)
+}
+func (m *AMPRecord) XXX_Size() int {
+	return xxx_messageInfo_AMPRecord.Size(m)
+}
+func (m *AMPRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AMPRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AMPRecord proto.InternalMessageInfo
+
+func (m *AMPRecord) GetRootShare() []byte {
+	if m != nil {
+		return m.RootShare
+	}
+	return nil
+}
+
+func (m *AMPRecord) GetSetId() []byte {
+	if m != nil {
+		return m.SetId
+	}
+	return nil
+}
+
+func (m *AMPRecord) GetChildIndex() uint32 {
+	if m != nil {
+		return m.ChildIndex
+	}
+	return 0
+}
+
 type MPPRecord struct {
 	//*
 	//A unique, random identifier used to authenticate the sender as the intended
@@ -6460,7 +7208,7 @@ func (m *MPPRecord) Reset()         { *m = MPPRecord{} }
 func (m *MPPRecord) String() string { return proto.CompactTextString(m) }
 func (*MPPRecord) ProtoMessage()    {}
 func (*MPPRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{83}
+	return fileDescriptor_77a6da22d6a3feb1, []int{90}
 }
 
 func (m *MPPRecord) XXX_Unmarshal(b []byte) error {
@@ -6538,7 +7286,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{84}
+	return fileDescriptor_77a6da22d6a3feb1, []int{91}
 }
 
 func (m *Route) XXX_Unmarshal(b []byte) error {
@@ -6617,7 +7365,7 @@ func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()    {}
 func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{85}
+	return fileDescriptor_77a6da22d6a3feb1, []int{92}
 }
 
 func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -6674,7 +7422,7 @@ func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
 func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()    {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{86}
+	return fileDescriptor_77a6da22d6a3feb1, []int{93}
 }
 
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
@@ -6744,7 +7492,7 @@ func (m *LightningNode) Reset()         { *m = LightningNode{} }
 func (m *LightningNode) String() string { return proto.CompactTextString(m) }
 func (*LightningNode) ProtoMessage()    {}
 func (*LightningNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{87}
+	return fileDescriptor_77a6da22d6a3feb1, []int{94}
 }
 
 func (m *LightningNode) XXX_Unmarshal(b []byte) error {
@@ -6819,7 +7567,7 @@ func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
 func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()    {}
 func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{88}
+	return fileDescriptor_77a6da22d6a3feb1, []int{95}
 }
 
 func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
@@ -6871,7 +7619,7 @@ func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
 func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
 func (*RoutingPolicy) ProtoMessage()    {}
 func (*RoutingPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+	return fileDescriptor_77a6da22d6a3feb1, []int{96}
 }
 
 func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
@@ -6969,7 +7717,7 @@ func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
 func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdge) ProtoMessage()    {}
 func (*ChannelEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{90}
+	return fileDescriptor_77a6da22d6a3feb1, []int{97}
 }
 
 func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
@@ -7062,7 +7810,7 @@ func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
 func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraphRequest) ProtoMessage()    {}
 func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{91}
+	return fileDescriptor_77a6da22d6a3feb1, []int{98}
 }
 
 func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
@@ -7105,7 +7853,7 @@ func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
 func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
 func (*ChannelGraph) ProtoMessage()    {}
 func (*ChannelGraph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{92}
+	return fileDescriptor_77a6da22d6a3feb1, []int{99}
 }
 
 func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
@@ -7155,7 +7903,7 @@ func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
 func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanInfoRequest) ProtoMessage()    {}
 func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{93}
+	return fileDescriptor_77a6da22d6a3feb1, []int{100}
 }
 
 func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -7193,7 +7941,7 @@ func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
 func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfoRequest) ProtoMessage()    {}
 func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{94}
+	return fileDescriptor_77a6da22d6a3feb1, []int{101}
 }
 
 func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -7236,7 +7984,7 @@ func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()    {}
 func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{95}
+	return fileDescriptor_77a6da22d6a3feb1, []int{102}
 }
 
 func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
@@ -7344,7 +8092,7 @@ func (m *StopRequest) Reset()         { *m = StopRequest{} }
 func (m *StopRequest) String() string { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()    {}
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{96}
+	return fileDescriptor_77a6da22d6a3feb1, []int{103}
 }
 
 func (m *StopRequest) XXX_Unmarshal(b []byte) error {
@@ -7375,7 +8123,7 @@ func (m *StopResponse) Reset()         { *m = StopResponse{} }
 func (m *StopResponse) String() string { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()    {}
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{97}
+	return fileDescriptor_77a6da22d6a3feb1, []int{104}
 }
 
 func (m *StopResponse) XXX_Unmarshal(b []byte) error {
@@ -7406,7 +8154,7 @@ func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscrip
 func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologySubscription) ProtoMessage()    {}
 func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{98}
+	return fileDescriptor_77a6da22d6a3feb1, []int{105}
 }
 
 func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
@@ -7440,7 +8188,7 @@ func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
 func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
 func (*GraphTopologyUpdate) ProtoMessage()    {}
 func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{99}
+	return fileDescriptor_77a6da22d6a3feb1, []int{106}
 }
 
 func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
@@ -7497,7 +8245,7 @@ func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
 func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
 func (*NodeUpdate) ProtoMessage()    {}
 func (*NodeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{100}
+	return fileDescriptor_77a6da22d6a3feb1, []int{107}
 }
 
 func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
@@ -7573,7 +8321,7 @@ func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
 func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelEdgeUpdate) ProtoMessage()    {}
 func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{101}
+	return fileDescriptor_77a6da22d6a3feb1, []int{108}
 }
 
 func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
@@ -7654,7 +8402,7 @@ func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
 func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelUpdate) ProtoMessage()    {}
 func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{102}
+	return fileDescriptor_77a6da22d6a3feb1, []int{109}
 }
 
 func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
@@ -7725,7 +8473,7 @@ func (m *HopHint) Reset()         { *m = HopHint{} }
 func (m *HopHint) String() string { return proto.CompactTextString(m) }
 func (*HopHint) ProtoMessage()    {}
 func (*HopHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{103}
+	return fileDescriptor_77a6da22d6a3feb1, []int{110}
 }
 
 func (m *HopHint) XXX_Unmarshal(b []byte) error {
@@ -7795,7 +8543,7 @@ func (m *RouteHint) Reset()         { *m = RouteHint{} }
 func (m *RouteHint) String() string { return proto.CompactTextString(m) }
 func (*RouteHint) ProtoMessage()    {}
 func (*RouteHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{104}
+	return fileDescriptor_77a6da22d6a3feb1, []int{111}
 }
 
 func (m *RouteHint) XXX_Unmarshal(b []byte) error {
@@ -7918,7 +8666,50 @@ type Invoice struct {
 	//*
 	//Indicates if this invoice was a spontaneous payment that arrived via keysend
 	//[EXPERIMENTAL].
-	IsKeysend            bool     `protobuf:"varint,25,opt,name=is_keysend,proto3" json:"is_keysend,omitempty"`
+	IsKeysend bool `protobuf:"varint,25,opt,name=is_keysend,proto3" json:"is_keysend,omitempty"`
+	//*
+	//Indicates if this invoice can only be paid with an atomic multi-path
+	//payment. For spontaneous AMP payments, the r_hash is only known once the
+	//invoice is settled, and is the set id of the payment [EXPERIMENTAL].
+	IsAmp bool `protobuf:"varint,26,opt,name=is_amp,proto3" json:"is_amp,omitempty"`
+	//*
+	//Indicates if this invoice accepts any number of payments. A reusable
+	//invoice stays open after a payment has been received, until it expires or
+	//is canceled. The amount paid is the total of all payments. Reusable
+	//invoices can only be paid with atomic multi-path payments. [EXPERIMENTAL]
+	Reusable bool `protobuf:"varint,27,opt,name=reusable,proto3" json:"reusable,omitempty"`
+	//*
+	//The payments received by a reusable invoice, in the order they were
+	//received. [EXPERIMENTAL]
+	Payments []*InvoicePayment `protobuf:"bytes,28,rep,name=payments,proto3" json:"payments,omitempty"`
+	//*
+	//The policy used to select the routing hints for private channels, if
+	//private is set.
+	HintPolicy HintPolicy `protobuf:"varint,29,opt,name=hint_policy,proto3,enum=lnrpc.HintPolicy" json:"hint_policy,omitempty"`
+	//*
+	//The public key the sender of a keysend payment identified itself with, if
+	//any. The message of the sender is set as the memo of the invoice.
+	//[EXPERIMENTAL]
+	KeysendSender []byte `protobuf:"bytes,30,opt,name=keysend_sender,proto3" json:"keysend_sender,omitempty"`
+	//*
+	//Indicates if the sender of a keysend payment signed the payment with the
+	//key in keysend_sender. [EXPERIMENTAL]
+	KeysendSenderVerified bool `protobuf:"varint,31,opt,name=keysend_sender_verified,proto3" json:"keysend_sender_verified,omitempty"`
+	//*
+	//A payment request the sender of a keysend payment supplied to be replied
+	//to, if any. [EXPERIMENTAL]
+	KeysendReplyTo string `protobuf:"bytes,32,opt,name=keysend_reply_to,proto3" json:"keysend_reply_to,omitempty"`
+	//*
+	//The reason the invoice was canceled for. Hold invoices are canceled
+	//automatically when they are held for longer than their hold duration
+	//(HOLD_TIMEOUT) or when one of their htlcs comes too close to its expiry
+	//(HTLC_EXPIRY). Only set for canceled invoices.
+	CancelReason Invoice_CancelReason `protobuf:"varint,33,opt,name=cancel_reason,proto3,enum=lnrpc.Invoice_CancelReason" json:"cancel_reason,omitempty"`
+	//*
+	//The maximum number of seconds a hold invoice is kept in the accepted
+	//state before it is canceled automatically. The lower global limit of the
+	//node applies if it has one. Zero if the invoice sets no limit.
+	HoldDuration         uint64   `protobuf:"varint,34,opt,name=hold_duration,proto3" json:"hold_duration,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -7928,7 +8719,7 @@ func (m *Invoice) Reset()         { *m = Invoice{} }
 func (m *Invoice) String() string { return proto.CompactTextString(m) }
 func (*Invoice) ProtoMessage()    {}
 func (*Invoice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{105}
+	return fileDescriptor_77a6da22d6a3feb1, []int{112}
 }
 
 func (m *Invoice) XXX_Unmarshal(b []byte) error {
@@ -8119,6 +8910,145 @@ func (m *Invoice) GetIsKeysend() bool {
 	return false
 }
 
+func (m *Invoice) GetIsAmp() bool {
+	if m != nil {
+		return m.IsAmp
+	}
+	return false
+}
+
+func (m *Invoice) GetReusable() bool {
+	if m != nil {
+		return m.Reusable
+	}
+	return false
+}
+
+func (m *Invoice) GetPayments() []*InvoicePayment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+func (m *Invoice) GetHintPolicy() HintPolicy {
+	if m != nil {
+		return m.HintPolicy
+	}
+	return HintPolicy_RANKED_HINTS
+}
+
+func (m *Invoice) GetKeysendSender() []byte {
+	if m != nil {
+		return m.KeysendSender
+	}
+	return nil
+}
+
+func (m *Invoice) GetKeysendSenderVerified() bool {
+	if m != nil {
+		return m.KeysendSenderVerified
+	}
+	return false
+}
+
+func (m *Invoice) GetKeysendReplyTo() string {
+	if m != nil {
+		return m.KeysendReplyTo
+	}
+	return ""
+}
+
+func (m *Invoice) GetCancelReason() Invoice_CancelReason {
+	if m != nil {
+		return m.CancelReason
+	}
+	return Invoice_NONE
+}
+
+func (m *Invoice) GetHoldDuration() uint64 {
+	if m != nil {
+		return m.HoldDuration
+	}
+	return 0
+}
+
+type InvoicePayment struct {
+	/// The index of the payment among the payments to the invoice.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	/// The amount of the payment in msat.
+	AmtMsat uint64 `protobuf:"varint,2,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
+	/// Time at which the payment was settled.
+	SettleTime int64 `protobuf:"varint,3,opt,name=settle_time,proto3" json:"settle_time,omitempty"`
+	/// The number of htlcs the payment consisted of.
+	NumHtlcs uint32 `protobuf:"varint,4,opt,name=num_htlcs,proto3" json:"num_htlcs,omitempty"`
+	/// Custom tlv records that accompanied the htlcs of the payment.
+	CustomRecords        map[uint64][]byte `protobuf:"bytes,5,rep,name=custom_records,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InvoicePayment) Reset()         { *m = InvoicePayment{} }
+func (m *InvoicePayment) String() string { return proto.CompactTextString(m) }
+func (*InvoicePayment) ProtoMessage()    {}
+func (*InvoicePayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{113}
+}
+
+func (m *InvoicePayment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvoicePayment.Unmarshal(m, b)
+}
+func (m *InvoicePayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvoicePayment.Marshal(b, m, deterministic)
+}
+func (m *InvoicePayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvoicePayment.Merge(m, src)
+}
+func (m *InvoicePayment) XXX_Size() int {
+	return xxx_messageInfo_InvoicePayment.Size(m)
+}
+func (m *InvoicePayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvoicePayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvoicePayment proto.InternalMessageInfo
+
+func (m *InvoicePayment) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *InvoicePayment) GetAmtMsat() uint64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
+}
+
+func (m *InvoicePayment) GetSettleTime() int64 {
+	if m != nil {
+		return m.SettleTime
+	}
+	return 0
+}
+
+func (m *InvoicePayment) GetNumHtlcs() uint32 {
+	if m != nil {
+		return m.NumHtlcs
+	}
+	return 0
+}
+
+func (m *InvoicePayment) GetCustomRecords() map[uint64][]byte {
+	if m != nil {
+		return m.CustomRecords
+	}
+	return nil
+}
+
 /// Details of an HTLC that paid to an invoice
 type InvoiceHTLC struct {
 	/// Short channel id over which the htlc was received.
@@ -8140,7 +9070,9 @@ type InvoiceHTLC struct {
 	/// Custom tlv records.
 	CustomRecords map[uint64][]byte `protobuf:"bytes,9,rep,name=custom_records,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	/// The total amount of the mpp payment in msat.
-	MppTotalAmtMsat      uint64   `protobuf:"varint,10,opt,name=mpp_total_amt_msat,proto3" json:"mpp_total_amt_msat,omitempty"`
+	MppTotalAmtMsat uint64 `protobuf:"varint,10,opt,name=mpp_total_amt_msat,proto3" json:"mpp_total_amt_msat,omitempty"`
+	/// Details relevant to AMP HTLCs, only populated if this is an AMP HTLC.
+	Amp                  *AMP     `protobuf:"bytes,11,opt,name=amp,proto3" json:"amp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -8150,7 +9082,7 @@ func (m *InvoiceHTLC) Reset()         { *m = InvoiceHTLC{} }
 func (m *InvoiceHTLC) String() string { return proto.CompactTextString(m) }
 func (*InvoiceHTLC) ProtoMessage()    {}
 func (*InvoiceHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{106}
+	return fileDescriptor_77a6da22d6a3feb1, []int{114}
 }
 
 func (m *InvoiceHTLC) XXX_Unmarshal(b []byte) error {
@@ -8241,12 +9173,100 @@ func (m *InvoiceHTLC) GetMppTotalAmtMsat() uint64 {
 	return 0
 }
 
-type AddInvoiceResponse struct {
-	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-	//*
-	//A bare-bones invoice for a payment within the Lightning Network. With the
-	//details of the invoice, the sender has all the data necessary to send a
-	//payment to the recipient.
+func (m *InvoiceHTLC) GetAmp() *AMP {
+	if m != nil {
+		return m.Amp
+	}
+	return nil
+}
+
+// Details specific to AMP HTLCs.
+type AMP struct {
+	/// An n-of-n secret share of the root seed from which child payment hashes
+	/// and preimages are derived.
+	RootShare []byte `protobuf:"bytes,1,opt,name=root_share,proto3" json:"root_share,omitempty"`
+	/// An identifier for the HTLC set that this HTLC belongs to.
+	SetId []byte `protobuf:"bytes,2,opt,name=set_id,proto3" json:"set_id,omitempty"`
+	/// A nonce used to randomize the child preimage and child hash from a
+	/// given root_share.
+	ChildIndex uint32 `protobuf:"varint,3,opt,name=child_index,proto3" json:"child_index,omitempty"`
+	/// The payment hash of the AMP HTLC.
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	/// The preimage used to settle this AMP htlc. This field will only be
+	/// populated if the invoice is in InvoiceState_ACCEPTED or
+	/// InvoiceState_SETTLED.
+	Preimage             []byte   `protobuf:"bytes,5,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AMP) Reset()         { *m = AMP{} }
+func (m *AMP) String() string { return proto.CompactTextString(m) }
+func (*AMP) ProtoMessage()    {}
+func (*AMP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{115}
+}
+
+func (m *AMP) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AMP.Unmarshal(m, b)
+}
+func (m *AMP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AMP.Marshal(b, m, deterministic)
+}
+func (m *AMP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AMP.Merge(m, src)
+}
+func (m *AMP) XXX_Size() int {
+	return xxx_messageInfo_AMP.Size(m)
+}
+func (m *AMP) XXX_DiscardUnknown() {
+	xxx_messageInfo_AMP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AMP proto.InternalMessageInfo
+
+func (m *AMP) GetRootShare() []byte {
+	if m != nil {
+		return m.RootShare
+	}
+	return nil
+}
+
+func (m *AMP) GetSetId() []byte {
+	if m != nil {
+		return m.SetId
+	}
+	return nil
+}
+
+func (m *AMP) GetChildIndex() uint32 {
+	if m != nil {
+		return m.ChildIndex
+	}
+	return 0
+}
+
+func (m *AMP) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *AMP) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+type AddInvoiceResponse struct {
+	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	//*
+	//A bare-bones invoice for a payment within the Lightning Network. With the
+	//details of the invoice, the sender has all the data necessary to send a
+	//payment to the recipient.
 	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
 	//*
 	//The "add" index of this invoice. Each newly created invoice will increment
@@ -8263,7 +9283,7 @@ func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
 func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*AddInvoiceResponse) ProtoMessage()    {}
 func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{107}
+	return fileDescriptor_77a6da22d6a3feb1, []int{116}
 }
 
 func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
@@ -8325,7 +9345,7 @@ func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
 func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
 func (*PaymentHash) ProtoMessage()    {}
 func (*PaymentHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{108}
+	return fileDescriptor_77a6da22d6a3feb1, []int{117}
 }
 
 func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
@@ -8385,7 +9405,7 @@ func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
 func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceRequest) ProtoMessage()    {}
 func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{109}
+	return fileDescriptor_77a6da22d6a3feb1, []int{118}
 }
 
 func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
@@ -8456,7 +9476,7 @@ func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
 func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
 func (*ListInvoiceResponse) ProtoMessage()    {}
 func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{110}
+	return fileDescriptor_77a6da22d6a3feb1, []int{119}
 }
 
 func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
@@ -8520,7 +9540,7 @@ func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
 func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
 func (*InvoiceSubscription) ProtoMessage()    {}
 func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{111}
+	return fileDescriptor_77a6da22d6a3feb1, []int{120}
 }
 
 func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
@@ -8593,7 +9613,7 @@ func (m *Payment) Reset()         { *m = Payment{} }
 func (m *Payment) String() string { return proto.CompactTextString(m) }
 func (*Payment) ProtoMessage()    {}
 func (*Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{112}
+	return fileDescriptor_77a6da22d6a3feb1, []int{121}
 }
 
 func (m *Payment) XXX_Unmarshal(b []byte) error {
@@ -8736,7 +9756,7 @@ func (m *HTLCAttempt) Reset()         { *m = HTLCAttempt{} }
 func (m *HTLCAttempt) String() string { return proto.CompactTextString(m) }
 func (*HTLCAttempt) ProtoMessage()    {}
 func (*HTLCAttempt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{113}
+	return fileDescriptor_77a6da22d6a3feb1, []int{122}
 }
 
 func (m *HTLCAttempt) XXX_Unmarshal(b []byte) error {
@@ -8800,7 +9820,7 @@ func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
 func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsRequest) ProtoMessage()    {}
 func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{114}
+	return fileDescriptor_77a6da22d6a3feb1, []int{123}
 }
 
 func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
@@ -8840,7 +9860,7 @@ func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
 func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPaymentsResponse) ProtoMessage()    {}
 func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{115}
+	return fileDescriptor_77a6da22d6a3feb1, []int{124}
 }
 
 func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
@@ -8878,7 +9898,7 @@ func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsReque
 func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
 func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{116}
+	return fileDescriptor_77a6da22d6a3feb1, []int{125}
 }
 
 func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
@@ -8909,7 +9929,7 @@ func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResp
 func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
 func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{117}
+	return fileDescriptor_77a6da22d6a3feb1, []int{126}
 }
 
 func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
@@ -8941,7 +9961,7 @@ func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
 func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelRequest) ProtoMessage()    {}
 func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{118}
+	return fileDescriptor_77a6da22d6a3feb1, []int{127}
 }
 
 func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -8979,7 +9999,7 @@ func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{}
 func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*AbandonChannelResponse) ProtoMessage()    {}
 func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{119}
+	return fileDescriptor_77a6da22d6a3feb1, []int{128}
 }
 
 func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -9012,7 +10032,7 @@ func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()    {}
 func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{120}
+	return fileDescriptor_77a6da22d6a3feb1, []int{129}
 }
 
 func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
@@ -9058,7 +10078,7 @@ func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
 func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelResponse) ProtoMessage()    {}
 func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{121}
+	return fileDescriptor_77a6da22d6a3feb1, []int{130}
 }
 
 func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
@@ -9098,7 +10118,7 @@ func (m *PayReqString) Reset()         { *m = PayReqString{} }
 func (m *PayReqString) String() string { return proto.CompactTextString(m) }
 func (*PayReqString) ProtoMessage()    {}
 func (*PayReqString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{122}
+	return fileDescriptor_77a6da22d6a3feb1, []int{131}
 }
 
 func (m *PayReqString) XXX_Unmarshal(b []byte) error {
@@ -9149,7 +10169,7 @@ func (m *PayReq) Reset()         { *m = PayReq{} }
 func (m *PayReq) String() string { return proto.CompactTextString(m) }
 func (*PayReq) ProtoMessage()    {}
 func (*PayReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{123}
+	return fileDescriptor_77a6da22d6a3feb1, []int{132}
 }
 
 func (m *PayReq) XXX_Unmarshal(b []byte) error {
@@ -9261,223 +10281,592 @@ func (m *PayReq) GetFeatures() map[uint32]*Feature {
 	return nil
 }
 
-type Feature struct {
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IsRequired           bool     `protobuf:"varint,3,opt,name=is_required,proto3" json:"is_required,omitempty"`
-	IsKnown              bool     `protobuf:"varint,4,opt,name=is_known,proto3" json:"is_known,omitempty"`
+type AddOfferRequest struct {
+	/// A description of the purpose of payments to the offer.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	//*
+	//The amount of every payment to the offer, in millisatoshis. If zero, the
+	//payer chooses the amount.
+	AmtMsat int64 `protobuf:"varint,2,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
+	//*
+	//The number of seconds after which no more invoices are issued for the
+	//offer. If zero, the offer doesn't expire.
+	Expiry               int64    `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Feature) Reset()         { *m = Feature{} }
-func (m *Feature) String() string { return proto.CompactTextString(m) }
-func (*Feature) ProtoMessage()    {}
-func (*Feature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{124}
+func (m *AddOfferRequest) Reset()         { *m = AddOfferRequest{} }
+func (m *AddOfferRequest) String() string { return proto.CompactTextString(m) }
+func (*AddOfferRequest) ProtoMessage()    {}
+func (*AddOfferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{133}
 }
 
-func (m *Feature) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Feature.Unmarshal(m, b)
+func (m *AddOfferRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddOfferRequest.Unmarshal(m, b)
 }
-func (m *Feature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Feature.Marshal(b, m, deterministic)
+func (m *AddOfferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddOfferRequest.Marshal(b, m, deterministic)
 }
-func (m *Feature) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Feature.Merge(m, src)
+func (m *AddOfferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddOfferRequest.Merge(m, src)
 }
-func (m *Feature) XXX_Size() int {
-	return xxx_messageInfo_Feature.Size(m)
+func (m *AddOfferRequest) XXX_Size() int {
+	return xxx_messageInfo_AddOfferRequest.Size(m)
 }
-func (m *Feature) XXX_DiscardUnknown() {
-	xxx_messageInfo_Feature.DiscardUnknown(m)
+func (m *AddOfferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddOfferRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Feature proto.InternalMessageInfo
+var xxx_messageInfo_AddOfferRequest proto.InternalMessageInfo
 
-func (m *Feature) GetName() string {
+func (m *AddOfferRequest) GetDescription() string {
 	if m != nil {
-		return m.Name
+		return m.Description
 	}
 	return ""
 }
 
-func (m *Feature) GetIsRequired() bool {
+func (m *AddOfferRequest) GetAmtMsat() int64 {
 	if m != nil {
-		return m.IsRequired
+		return m.AmtMsat
 	}
-	return false
+	return 0
 }
 
-func (m *Feature) GetIsKnown() bool {
+func (m *AddOfferRequest) GetExpiry() int64 {
 	if m != nil {
-		return m.IsKnown
+		return m.Expiry
 	}
-	return false
+	return 0
 }
 
-type FeeReportRequest struct {
+type AddOfferResponse struct {
+	/// The encoded offer.
+	Offer string `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	/// The id of the offer.
+	OfferId              []byte   `protobuf:"bytes,2,opt,name=offer_id,proto3" json:"offer_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
-func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
-func (*FeeReportRequest) ProtoMessage()    {}
-func (*FeeReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{125}
+func (m *AddOfferResponse) Reset()         { *m = AddOfferResponse{} }
+func (m *AddOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*AddOfferResponse) ProtoMessage()    {}
+func (*AddOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{134}
 }
 
-func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FeeReportRequest.Unmarshal(m, b)
+func (m *AddOfferResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddOfferResponse.Unmarshal(m, b)
 }
-func (m *FeeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FeeReportRequest.Marshal(b, m, deterministic)
+func (m *AddOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddOfferResponse.Marshal(b, m, deterministic)
 }
-func (m *FeeReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FeeReportRequest.Merge(m, src)
+func (m *AddOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddOfferResponse.Merge(m, src)
 }
-func (m *FeeReportRequest) XXX_Size() int {
-	return xxx_messageInfo_FeeReportRequest.Size(m)
+func (m *AddOfferResponse) XXX_Size() int {
+	return xxx_messageInfo_AddOfferResponse.Size(m)
 }
-func (m *FeeReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FeeReportRequest.DiscardUnknown(m)
+func (m *AddOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddOfferResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FeeReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddOfferResponse proto.InternalMessageInfo
 
-type ChannelFeeReport struct {
-	/// The channel that this fee report belongs to.
-	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point,proto3" json:"chan_point,omitempty"`
-	/// The base fee charged regardless of the number of milli-satoshis sent.
-	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
-	/// The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
-	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil,proto3" json:"fee_per_mil,omitempty"`
-	/// The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
-	FeeRate              float64  `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+func (m *AddOfferResponse) GetOffer() string {
+	if m != nil {
+		return m.Offer
+	}
+	return ""
+}
+
+func (m *AddOfferResponse) GetOfferId() []byte {
+	if m != nil {
+		return m.OfferId
+	}
+	return nil
+}
+
+type ListOffersRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
-func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
-func (*ChannelFeeReport) ProtoMessage()    {}
-func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{126}
+func (m *ListOffersRequest) Reset()         { *m = ListOffersRequest{} }
+func (m *ListOffersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOffersRequest) ProtoMessage()    {}
+func (*ListOffersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{135}
 }
 
-func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelFeeReport.Unmarshal(m, b)
+func (m *ListOffersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOffersRequest.Unmarshal(m, b)
 }
-func (m *ChannelFeeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelFeeReport.Marshal(b, m, deterministic)
+func (m *ListOffersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOffersRequest.Marshal(b, m, deterministic)
 }
-func (m *ChannelFeeReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelFeeReport.Merge(m, src)
+func (m *ListOffersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOffersRequest.Merge(m, src)
 }
-func (m *ChannelFeeReport) XXX_Size() int {
-	return xxx_messageInfo_ChannelFeeReport.Size(m)
+func (m *ListOffersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListOffersRequest.Size(m)
 }
-func (m *ChannelFeeReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelFeeReport.DiscardUnknown(m)
+func (m *ListOffersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOffersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelFeeReport proto.InternalMessageInfo
+var xxx_messageInfo_ListOffersRequest proto.InternalMessageInfo
 
-func (m *ChannelFeeReport) GetChanPoint() string {
+type Offer struct {
+	/// The encoded offer.
+	Offer string `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	/// The id of the offer.
+	OfferId []byte `protobuf:"bytes,2,opt,name=offer_id,proto3" json:"offer_id,omitempty"`
+	/// The description of the offer.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	/// The amount of the offer in millisatoshis, zero if the payer chooses.
+	AmtMsat int64 `protobuf:"varint,4,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
+	/// The unix timestamp the offer expires at, zero if it doesn't expire.
+	Expiry               int64    `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Offer) Reset()         { *m = Offer{} }
+func (m *Offer) String() string { return proto.CompactTextString(m) }
+func (*Offer) ProtoMessage()    {}
+func (*Offer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{136}
+}
+
+func (m *Offer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Offer.Unmarshal(m, b)
+}
+func (m *Offer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Offer.Marshal(b, m, deterministic)
+}
+func (m *Offer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Offer.Merge(m, src)
+}
+func (m *Offer) XXX_Size() int {
+	return xxx_messageInfo_Offer.Size(m)
+}
+func (m *Offer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Offer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Offer proto.InternalMessageInfo
+
+func (m *Offer) GetOffer() string {
 	if m != nil {
-		return m.ChanPoint
+		return m.Offer
 	}
 	return ""
 }
 
-func (m *ChannelFeeReport) GetBaseFeeMsat() int64 {
+func (m *Offer) GetOfferId() []byte {
 	if m != nil {
-		return m.BaseFeeMsat
+		return m.OfferId
 	}
-	return 0
+	return nil
 }
 
-func (m *ChannelFeeReport) GetFeePerMil() int64 {
+func (m *Offer) GetDescription() string {
 	if m != nil {
-		return m.FeePerMil
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Offer) GetAmtMsat() int64 {
+	if m != nil {
+		return m.AmtMsat
 	}
 	return 0
 }
 
-func (m *ChannelFeeReport) GetFeeRate() float64 {
+func (m *Offer) GetExpiry() int64 {
 	if m != nil {
-		return m.FeeRate
+		return m.Expiry
 	}
 	return 0
 }
 
-type FeeReportResponse struct {
-	/// An array of channel fee reports which describes the current fee schedule for each channel.
-	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees,proto3" json:"channel_fees,omitempty"`
-	/// The total amount of fee revenue (in satoshis) the switch has collected over the past 24 hrs.
-	DayFeeSum uint64 `protobuf:"varint,2,opt,name=day_fee_sum,proto3" json:"day_fee_sum,omitempty"`
-	/// The total amount of fee revenue (in satoshis) the switch has collected over the past 1 week.
-	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum,proto3" json:"week_fee_sum,omitempty"`
-	/// The total amount of fee revenue (in satoshis) the switch has collected over the past 1 month.
-	MonthFeeSum          uint64   `protobuf:"varint,4,opt,name=month_fee_sum,proto3" json:"month_fee_sum,omitempty"`
+type ListOffersResponse struct {
+	/// The offers this node created.
+	Offers               []*Offer `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
-func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
-func (*FeeReportResponse) ProtoMessage()    {}
-func (*FeeReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{127}
+func (m *ListOffersResponse) Reset()         { *m = ListOffersResponse{} }
+func (m *ListOffersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOffersResponse) ProtoMessage()    {}
+func (*ListOffersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{137}
 }
 
-func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FeeReportResponse.Unmarshal(m, b)
+func (m *ListOffersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOffersResponse.Unmarshal(m, b)
 }
-func (m *FeeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FeeReportResponse.Marshal(b, m, deterministic)
+func (m *ListOffersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOffersResponse.Marshal(b, m, deterministic)
 }
-func (m *FeeReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FeeReportResponse.Merge(m, src)
+func (m *ListOffersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOffersResponse.Merge(m, src)
 }
-func (m *FeeReportResponse) XXX_Size() int {
-	return xxx_messageInfo_FeeReportResponse.Size(m)
+func (m *ListOffersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOffersResponse.Size(m)
 }
-func (m *FeeReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FeeReportResponse.DiscardUnknown(m)
+func (m *ListOffersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOffersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FeeReportResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListOffersResponse proto.InternalMessageInfo
 
-func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
+func (m *ListOffersResponse) GetOffers() []*Offer {
 	if m != nil {
-		return m.ChannelFees
+		return m.Offers
 	}
 	return nil
 }
 
-func (m *FeeReportResponse) GetDayFeeSum() uint64 {
-	if m != nil {
-		return m.DayFeeSum
-	}
-	return 0
+type FetchInvoiceRequest struct {
+	/// The encoded offer to fetch an invoice for.
+	Offer string `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	//*
+	//The amount to request the invoice for, in millisatoshis. Must be set if
+	//the offer doesn't specify an amount.
+	AmtMsat int64 `protobuf:"varint,2,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
+	/// An optional note to the issuer, included in the memo of the invoice.
+	PayerNote string `protobuf:"bytes,3,opt,name=payer_note,proto3" json:"payer_note,omitempty"`
+	//*
+	//The number of seconds to wait for the issuer to reply. Defaults to 60
+	//seconds if unset.
+	TimeoutSeconds       int32    `protobuf:"varint,4,opt,name=timeout_seconds,proto3" json:"timeout_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FeeReportResponse) GetWeekFeeSum() uint64 {
-	if m != nil {
-		return m.WeekFeeSum
-	}
-	return 0
+func (m *FetchInvoiceRequest) Reset()         { *m = FetchInvoiceRequest{} }
+func (m *FetchInvoiceRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchInvoiceRequest) ProtoMessage()    {}
+func (*FetchInvoiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{138}
 }
 
-func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
-	if m != nil {
-		return m.MonthFeeSum
-	}
+func (m *FetchInvoiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FetchInvoiceRequest.Unmarshal(m, b)
+}
+func (m *FetchInvoiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FetchInvoiceRequest.Marshal(b, m, deterministic)
+}
+func (m *FetchInvoiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchInvoiceRequest.Merge(m, src)
+}
+func (m *FetchInvoiceRequest) XXX_Size() int {
+	return xxx_messageInfo_FetchInvoiceRequest.Size(m)
+}
+func (m *FetchInvoiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchInvoiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchInvoiceRequest proto.InternalMessageInfo
+
+func (m *FetchInvoiceRequest) GetOffer() string {
+	if m != nil {
+		return m.Offer
+	}
+	return ""
+}
+
+func (m *FetchInvoiceRequest) GetAmtMsat() int64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
+}
+
+func (m *FetchInvoiceRequest) GetPayerNote() string {
+	if m != nil {
+		return m.PayerNote
+	}
+	return ""
+}
+
+func (m *FetchInvoiceRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type FetchInvoiceResponse struct {
+	/// The payment request of the fetched invoice.
+	PaymentRequest       string   `protobuf:"bytes,1,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FetchInvoiceResponse) Reset()         { *m = FetchInvoiceResponse{} }
+func (m *FetchInvoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*FetchInvoiceResponse) ProtoMessage()    {}
+func (*FetchInvoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{139}
+}
+
+func (m *FetchInvoiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FetchInvoiceResponse.Unmarshal(m, b)
+}
+func (m *FetchInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FetchInvoiceResponse.Marshal(b, m, deterministic)
+}
+func (m *FetchInvoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchInvoiceResponse.Merge(m, src)
+}
+func (m *FetchInvoiceResponse) XXX_Size() int {
+	return xxx_messageInfo_FetchInvoiceResponse.Size(m)
+}
+func (m *FetchInvoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchInvoiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchInvoiceResponse proto.InternalMessageInfo
+
+func (m *FetchInvoiceResponse) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+type Feature struct {
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsRequired           bool     `protobuf:"varint,3,opt,name=is_required,proto3" json:"is_required,omitempty"`
+	IsKnown              bool     `protobuf:"varint,4,opt,name=is_known,proto3" json:"is_known,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Feature) Reset()         { *m = Feature{} }
+func (m *Feature) String() string { return proto.CompactTextString(m) }
+func (*Feature) ProtoMessage()    {}
+func (*Feature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{140}
+}
+
+func (m *Feature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Feature.Unmarshal(m, b)
+}
+func (m *Feature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Feature.Marshal(b, m, deterministic)
+}
+func (m *Feature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Feature.Merge(m, src)
+}
+func (m *Feature) XXX_Size() int {
+	return xxx_messageInfo_Feature.Size(m)
+}
+func (m *Feature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Feature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Feature proto.InternalMessageInfo
+
+func (m *Feature) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Feature) GetIsRequired() bool {
+	if m != nil {
+		return m.IsRequired
+	}
+	return false
+}
+
+func (m *Feature) GetIsKnown() bool {
+	if m != nil {
+		return m.IsKnown
+	}
+	return false
+}
+
+type FeeReportRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
+func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
+func (*FeeReportRequest) ProtoMessage()    {}
+func (*FeeReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{141}
+}
+
+func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeReportRequest.Unmarshal(m, b)
+}
+func (m *FeeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeReportRequest.Marshal(b, m, deterministic)
+}
+func (m *FeeReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeReportRequest.Merge(m, src)
+}
+func (m *FeeReportRequest) XXX_Size() int {
+	return xxx_messageInfo_FeeReportRequest.Size(m)
+}
+func (m *FeeReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeReportRequest proto.InternalMessageInfo
+
+type ChannelFeeReport struct {
+	/// The channel that this fee report belongs to.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point,proto3" json:"chan_point,omitempty"`
+	/// The base fee charged regardless of the number of milli-satoshis sent.
+	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
+	/// The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
+	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil,proto3" json:"fee_per_mil,omitempty"`
+	/// The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
+	FeeRate              float64  `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
+func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
+func (*ChannelFeeReport) ProtoMessage()    {}
+func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{142}
+}
+
+func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelFeeReport.Unmarshal(m, b)
+}
+func (m *ChannelFeeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelFeeReport.Marshal(b, m, deterministic)
+}
+func (m *ChannelFeeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFeeReport.Merge(m, src)
+}
+func (m *ChannelFeeReport) XXX_Size() int {
+	return xxx_messageInfo_ChannelFeeReport.Size(m)
+}
+func (m *ChannelFeeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFeeReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFeeReport proto.InternalMessageInfo
+
+func (m *ChannelFeeReport) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return ""
+}
+
+func (m *ChannelFeeReport) GetBaseFeeMsat() int64 {
+	if m != nil {
+		return m.BaseFeeMsat
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetFeePerMil() int64 {
+	if m != nil {
+		return m.FeePerMil
+	}
+	return 0
+}
+
+func (m *ChannelFeeReport) GetFeeRate() float64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+type FeeReportResponse struct {
+	/// An array of channel fee reports which describes the current fee schedule for each channel.
+	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees,proto3" json:"channel_fees,omitempty"`
+	/// The total amount of fee revenue (in satoshis) the switch has collected over the past 24 hrs.
+	DayFeeSum uint64 `protobuf:"varint,2,opt,name=day_fee_sum,proto3" json:"day_fee_sum,omitempty"`
+	/// The total amount of fee revenue (in satoshis) the switch has collected over the past 1 week.
+	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum,proto3" json:"week_fee_sum,omitempty"`
+	/// The total amount of fee revenue (in satoshis) the switch has collected over the past 1 month.
+	MonthFeeSum          uint64   `protobuf:"varint,4,opt,name=month_fee_sum,proto3" json:"month_fee_sum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
+func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
+func (*FeeReportResponse) ProtoMessage()    {}
+func (*FeeReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{143}
+}
+
+func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeReportResponse.Unmarshal(m, b)
+}
+func (m *FeeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeReportResponse.Marshal(b, m, deterministic)
+}
+func (m *FeeReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeReportResponse.Merge(m, src)
+}
+func (m *FeeReportResponse) XXX_Size() int {
+	return xxx_messageInfo_FeeReportResponse.Size(m)
+}
+func (m *FeeReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeReportResponse proto.InternalMessageInfo
+
+func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
+	if m != nil {
+		return m.ChannelFees
+	}
+	return nil
+}
+
+func (m *FeeReportResponse) GetDayFeeSum() uint64 {
+	if m != nil {
+		return m.DayFeeSum
+	}
+	return 0
+}
+
+func (m *FeeReportResponse) GetWeekFeeSum() uint64 {
+	if m != nil {
+		return m.WeekFeeSum
+	}
+	return 0
+}
+
+func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
+	if m != nil {
+		return m.MonthFeeSum
+	}
 	return 0
 }
 
@@ -9507,7 +10896,7 @@ func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
 func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*PolicyUpdateRequest) ProtoMessage()    {}
 func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{128}
+	return fileDescriptor_77a6da22d6a3feb1, []int{144}
 }
 
 func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
@@ -9625,7 +11014,7 @@ func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
 func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*PolicyUpdateResponse) ProtoMessage()    {}
 func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{129}
+	return fileDescriptor_77a6da22d6a3feb1, []int{145}
 }
 
 func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
@@ -9664,7 +11053,7 @@ func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryReque
 func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryRequest) ProtoMessage()    {}
 func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{130}
+	return fileDescriptor_77a6da22d6a3feb1, []int{146}
 }
 
 func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
@@ -9741,7 +11130,7 @@ func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
 func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
 func (*ForwardingEvent) ProtoMessage()    {}
 func (*ForwardingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{131}
+	return fileDescriptor_77a6da22d6a3feb1, []int{147}
 }
 
 func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
@@ -9839,7 +11228,7 @@ func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResp
 func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*ForwardingHistoryResponse) ProtoMessage()    {}
 func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{132}
+	return fileDescriptor_77a6da22d6a3feb1, []int{148}
 }
 
 func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
@@ -9874,6 +11263,327 @@ func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
 	return 0
 }
 
+type AccountingReportRequest struct {
+	/// Start of the time range of the report, in seconds since the unix epoch.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	//*
+	//End of the time range of the report, in seconds since the unix epoch. If
+	//not set, the report ends now.
+	EndTime              uint64   `protobuf:"varint,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountingReportRequest) Reset()         { *m = AccountingReportRequest{} }
+func (m *AccountingReportRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountingReportRequest) ProtoMessage()    {}
+func (*AccountingReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{149}
+}
+
+func (m *AccountingReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountingReportRequest.Unmarshal(m, b)
+}
+func (m *AccountingReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountingReportRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountingReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountingReportRequest.Merge(m, src)
+}
+func (m *AccountingReportRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountingReportRequest.Size(m)
+}
+func (m *AccountingReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountingReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountingReportRequest proto.InternalMessageInfo
+
+func (m *AccountingReportRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *AccountingReportRequest) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+type LedgerEntry struct {
+	//*
+	//The time of the event in seconds since the unix epoch. For htlcs it is
+	//the time they were settled, for on-chain transactions the time they were
+	//confirmed.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	/// The kind of event.
+	Type LedgerEntry_EntryType `protobuf:"varint,2,opt,name=type,proto3,enum=lnrpc.LedgerEntry_EntryType" json:"type,omitempty"`
+	//*
+	//The change in balance excluding the fees paid by the node. Negative for
+	//debits. For forwards it is the fee earned, and the routing fees paid to
+	//forward a trampoline payment are its fee.
+	AmountMsat int64 `protobuf:"varint,3,opt,name=amount_msat,proto3" json:"amount_msat,omitempty"`
+	/// The fee paid by the node for this event.
+	FeeMsat int64 `protobuf:"varint,4,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+	//*
+	//The channel the htlc of the event was settled on. For forwards it is the
+	//incoming channel. Not set for on-chain events.
+	ChanId uint64 `protobuf:"varint,5,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	/// The outgoing channel of a forward.
+	OutgoingChanId uint64 `protobuf:"varint,6,opt,name=outgoing_chan_id,proto3" json:"outgoing_chan_id,omitempty"`
+	//*
+	//The payment hash of receipts, payments and trampoline forwards, or the
+	//transaction id of on-chain events. Channel close events include the
+	//transactions that swept the outputs of force closed channels.
+	Reference string `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"`
+	/// The memo of the invoice of a receipt, or the label of a transaction.
+	Note                 string   `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LedgerEntry) Reset()         { *m = LedgerEntry{} }
+func (m *LedgerEntry) String() string { return proto.CompactTextString(m) }
+func (*LedgerEntry) ProtoMessage()    {}
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{150}
+}
+
+func (m *LedgerEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LedgerEntry.Unmarshal(m, b)
+}
+func (m *LedgerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LedgerEntry.Marshal(b, m, deterministic)
+}
+func (m *LedgerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerEntry.Merge(m, src)
+}
+func (m *LedgerEntry) XXX_Size() int {
+	return xxx_messageInfo_LedgerEntry.Size(m)
+}
+func (m *LedgerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LedgerEntry proto.InternalMessageInfo
+
+func (m *LedgerEntry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetType() LedgerEntry_EntryType {
+	if m != nil {
+		return m.Type
+	}
+	return LedgerEntry_RECEIPT
+}
+
+func (m *LedgerEntry) GetAmountMsat() int64 {
+	if m != nil {
+		return m.AmountMsat
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetFeeMsat() int64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+type AccountingTotals struct {
+	/// The total amount received by invoices.
+	ReceivedMsat int64 `protobuf:"varint,1,opt,name=received_msat,proto3" json:"received_msat,omitempty"`
+	/// The total amount paid to the receivers of payments, excluding fees.
+	SentMsat int64 `protobuf:"varint,2,opt,name=sent_msat,proto3" json:"sent_msat,omitempty"`
+	/// The total of the routing fees paid for payments and trampoline forwards.
+	PaymentFeesMsat int64 `protobuf:"varint,3,opt,name=payment_fees_msat,proto3" json:"payment_fees_msat,omitempty"`
+	//*
+	//The total of the fees earned by forwarding, before the routing fees paid
+	//for trampoline forwards.
+	ForwardFeesMsat int64 `protobuf:"varint,4,opt,name=forward_fees_msat,proto3" json:"forward_fees_msat,omitempty"`
+	/// The total amount received on-chain, excluding channel closes.
+	OnChainReceivedMsat int64 `protobuf:"varint,5,opt,name=on_chain_received_msat,proto3" json:"on_chain_received_msat,omitempty"`
+	/// The total amount sent on-chain, excluding fees and channel opens.
+	OnChainSentMsat int64 `protobuf:"varint,6,opt,name=on_chain_sent_msat,proto3" json:"on_chain_sent_msat,omitempty"`
+	/// The total of the on-chain fees paid by the wallet.
+	OnChainFeesMsat int64 `protobuf:"varint,7,opt,name=on_chain_fees_msat,proto3" json:"on_chain_fees_msat,omitempty"`
+	//*
+	//The net change in the balance of the node. Channel opens and closes only
+	//move funds between the wallet and the channels, so only their fees count.
+	NetMsat              int64    `protobuf:"varint,8,opt,name=net_msat,proto3" json:"net_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountingTotals) Reset()         { *m = AccountingTotals{} }
+func (m *AccountingTotals) String() string { return proto.CompactTextString(m) }
+func (*AccountingTotals) ProtoMessage()    {}
+func (*AccountingTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{151}
+}
+
+func (m *AccountingTotals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountingTotals.Unmarshal(m, b)
+}
+func (m *AccountingTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountingTotals.Marshal(b, m, deterministic)
+}
+func (m *AccountingTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountingTotals.Merge(m, src)
+}
+func (m *AccountingTotals) XXX_Size() int {
+	return xxx_messageInfo_AccountingTotals.Size(m)
+}
+func (m *AccountingTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountingTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountingTotals proto.InternalMessageInfo
+
+func (m *AccountingTotals) GetReceivedMsat() int64 {
+	if m != nil {
+		return m.ReceivedMsat
+	}
+	return 0
+}
+
+func (m *AccountingTotals) GetSentMsat() int64 {
+	if m != nil {
+		return m.SentMsat
+	}
+	return 0
+}
+
+func (m *AccountingTotals) GetPaymentFeesMsat() int64 {
+	if m != nil {
+		return m.PaymentFeesMsat
+	}
+	return 0
+}
+
+func (m *AccountingTotals) GetForwardFeesMsat() int64 {
+	if m != nil {
+		return m.ForwardFeesMsat
+	}
+	return 0
+}
+
+func (m *AccountingTotals) GetOnChainReceivedMsat() int64 {
+	if m != nil {
+		return m.OnChainReceivedMsat
+	}
+	return 0
+}
+
+func (m *AccountingTotals) GetOnChainSentMsat() int64 {
+	if m != nil {
+		return m.OnChainSentMsat
+	}
+	return 0
+}
+
+func (m *AccountingTotals) GetOnChainFeesMsat() int64 {
+	if m != nil {
+		return m.OnChainFeesMsat
+	}
+	return 0
+}
+
+func (m *AccountingTotals) GetNetMsat() int64 {
+	if m != nil {
+		return m.NetMsat
+	}
+	return 0
+}
+
+type AccountingReportResponse struct {
+	/// The balance changing events in the time range, ordered by time.
+	Entries []*LedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	/// The totals of the entries.
+	Totals               *AccountingTotals `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AccountingReportResponse) Reset()         { *m = AccountingReportResponse{} }
+func (m *AccountingReportResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountingReportResponse) ProtoMessage()    {}
+func (*AccountingReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{152}
+}
+
+func (m *AccountingReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountingReportResponse.Unmarshal(m, b)
+}
+func (m *AccountingReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountingReportResponse.Marshal(b, m, deterministic)
+}
+func (m *AccountingReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountingReportResponse.Merge(m, src)
+}
+func (m *AccountingReportResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountingReportResponse.Size(m)
+}
+func (m *AccountingReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountingReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountingReportResponse proto.InternalMessageInfo
+
+func (m *AccountingReportResponse) GetEntries() []*LedgerEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *AccountingReportResponse) GetTotals() *AccountingTotals {
+	if m != nil {
+		return m.Totals
+	}
+	return nil
+}
+
 type ExportChannelBackupRequest struct {
 	/// The target channel point to obtain a back up for.
 	ChanPoint            *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
@@ -9886,7 +11596,7 @@ func (m *ExportChannelBackupRequest) Reset()         { *m = ExportChannelBackupR
 func (m *ExportChannelBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*ExportChannelBackupRequest) ProtoMessage()    {}
 func (*ExportChannelBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{133}
+	return fileDescriptor_77a6da22d6a3feb1, []int{153}
 }
 
 func (m *ExportChannelBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -9933,7 +11643,7 @@ func (m *ChannelBackup) Reset()         { *m = ChannelBackup{} }
 func (m *ChannelBackup) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackup) ProtoMessage()    {}
 func (*ChannelBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{134}
+	return fileDescriptor_77a6da22d6a3feb1, []int{154}
 }
 
 func (m *ChannelBackup) XXX_Unmarshal(b []byte) error {
@@ -9987,7 +11697,7 @@ func (m *MultiChanBackup) Reset()         { *m = MultiChanBackup{} }
 func (m *MultiChanBackup) String() string { return proto.CompactTextString(m) }
 func (*MultiChanBackup) ProtoMessage()    {}
 func (*MultiChanBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{135}
+	return fileDescriptor_77a6da22d6a3feb1, []int{155}
 }
 
 func (m *MultiChanBackup) XXX_Unmarshal(b []byte) error {
@@ -10032,7 +11742,7 @@ func (m *ChanBackupExportRequest) Reset()         { *m = ChanBackupExportRequest
 func (m *ChanBackupExportRequest) String() string { return proto.CompactTextString(m) }
 func (*ChanBackupExportRequest) ProtoMessage()    {}
 func (*ChanBackupExportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{136}
+	return fileDescriptor_77a6da22d6a3feb1, []int{156}
 }
 
 func (m *ChanBackupExportRequest) XXX_Unmarshal(b []byte) error {
@@ -10071,7 +11781,7 @@ func (m *ChanBackupSnapshot) Reset()         { *m = ChanBackupSnapshot{} }
 func (m *ChanBackupSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ChanBackupSnapshot) ProtoMessage()    {}
 func (*ChanBackupSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{137}
+	return fileDescriptor_77a6da22d6a3feb1, []int{157}
 }
 
 func (m *ChanBackupSnapshot) XXX_Unmarshal(b []byte) error {
@@ -10119,7 +11829,7 @@ func (m *ChannelBackups) Reset()         { *m = ChannelBackups{} }
 func (m *ChannelBackups) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackups) ProtoMessage()    {}
 func (*ChannelBackups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{138}
+	return fileDescriptor_77a6da22d6a3feb1, []int{158}
 }
 
 func (m *ChannelBackups) XXX_Unmarshal(b []byte) error {
@@ -10161,7 +11871,7 @@ func (m *RestoreChanBackupRequest) Reset()         { *m = RestoreChanBackupReque
 func (m *RestoreChanBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreChanBackupRequest) ProtoMessage()    {}
 func (*RestoreChanBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{139}
+	return fileDescriptor_77a6da22d6a3feb1, []int{159}
 }
 
 func (m *RestoreChanBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -10237,7 +11947,7 @@ func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
 func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreBackupResponse) ProtoMessage()    {}
 func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{140}
+	return fileDescriptor_77a6da22d6a3feb1, []int{160}
 }
 
 func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
@@ -10268,7 +11978,7 @@ func (m *ChannelBackupSubscription) Reset()         { *m = ChannelBackupSubscrip
 func (m *ChannelBackupSubscription) String() string { return proto.CompactTextString(m) }
 func (*ChannelBackupSubscription) ProtoMessage()    {}
 func (*ChannelBackupSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{141}
+	return fileDescriptor_77a6da22d6a3feb1, []int{161}
 }
 
 func (m *ChannelBackupSubscription) XXX_Unmarshal(b []byte) error {
@@ -10299,7 +12009,7 @@ func (m *VerifyChanBackupResponse) Reset()         { *m = VerifyChanBackupRespon
 func (m *VerifyChanBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyChanBackupResponse) ProtoMessage()    {}
 func (*VerifyChanBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{142}
+	return fileDescriptor_77a6da22d6a3feb1, []int{162}
 }
 
 func (m *VerifyChanBackupResponse) XXX_Unmarshal(b []byte) error {
@@ -10320,8 +12030,176 @@ func (m *VerifyChanBackupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VerifyChanBackupResponse proto.InternalMessageInfo
 
+type ListBackupSinksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBackupSinksRequest) Reset()         { *m = ListBackupSinksRequest{} }
+func (m *ListBackupSinksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBackupSinksRequest) ProtoMessage()    {}
+func (*ListBackupSinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{163}
+}
+
+func (m *ListBackupSinksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBackupSinksRequest.Unmarshal(m, b)
+}
+func (m *ListBackupSinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBackupSinksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListBackupSinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBackupSinksRequest.Merge(m, src)
+}
+func (m *ListBackupSinksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBackupSinksRequest.Size(m)
+}
+func (m *ListBackupSinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBackupSinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBackupSinksRequest proto.InternalMessageInfo
+
+type BackupSinkStatus struct {
+	/// The name of the sink, made up of its type and location.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	/// The unix timestamp of the latest push attempt.
+	LastAttempt int64 `protobuf:"varint,2,opt,name=last_attempt,proto3" json:"last_attempt,omitempty"`
+	/// The unix timestamp of the latest successful push.
+	LastSuccess int64 `protobuf:"varint,3,opt,name=last_success,proto3" json:"last_success,omitempty"`
+	/// The version of the latest backup that was successfully pushed.
+	LastVersion string `protobuf:"bytes,4,opt,name=last_version,proto3" json:"last_version,omitempty"`
+	/// The error of the latest push attempt, empty if it succeeded.
+	LastError string `protobuf:"bytes,5,opt,name=last_error,proto3" json:"last_error,omitempty"`
+	/// The total number of successful pushes since startup.
+	NumPushes uint64 `protobuf:"varint,6,opt,name=num_pushes,proto3" json:"num_pushes,omitempty"`
+	/// The total number of failed pushes since startup.
+	NumFailures          uint64   `protobuf:"varint,7,opt,name=num_failures,proto3" json:"num_failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupSinkStatus) Reset()         { *m = BackupSinkStatus{} }
+func (m *BackupSinkStatus) String() string { return proto.CompactTextString(m) }
+func (*BackupSinkStatus) ProtoMessage()    {}
+func (*BackupSinkStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{164}
+}
+
+func (m *BackupSinkStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupSinkStatus.Unmarshal(m, b)
+}
+func (m *BackupSinkStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupSinkStatus.Marshal(b, m, deterministic)
+}
+func (m *BackupSinkStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupSinkStatus.Merge(m, src)
+}
+func (m *BackupSinkStatus) XXX_Size() int {
+	return xxx_messageInfo_BackupSinkStatus.Size(m)
+}
+func (m *BackupSinkStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupSinkStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupSinkStatus proto.InternalMessageInfo
+
+func (m *BackupSinkStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BackupSinkStatus) GetLastAttempt() int64 {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return 0
+}
+
+func (m *BackupSinkStatus) GetLastSuccess() int64 {
+	if m != nil {
+		return m.LastSuccess
+	}
+	return 0
+}
+
+func (m *BackupSinkStatus) GetLastVersion() string {
+	if m != nil {
+		return m.LastVersion
+	}
+	return ""
+}
+
+func (m *BackupSinkStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *BackupSinkStatus) GetNumPushes() uint64 {
+	if m != nil {
+		return m.NumPushes
+	}
+	return 0
+}
+
+func (m *BackupSinkStatus) GetNumFailures() uint64 {
+	if m != nil {
+		return m.NumFailures
+	}
+	return 0
+}
+
+type ListBackupSinksResponse struct {
+	/// The status of each configured backup sink.
+	Sinks                []*BackupSinkStatus `protobuf:"bytes,1,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListBackupSinksResponse) Reset()         { *m = ListBackupSinksResponse{} }
+func (m *ListBackupSinksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBackupSinksResponse) ProtoMessage()    {}
+func (*ListBackupSinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{165}
+}
+
+func (m *ListBackupSinksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBackupSinksResponse.Unmarshal(m, b)
+}
+func (m *ListBackupSinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBackupSinksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListBackupSinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBackupSinksResponse.Merge(m, src)
+}
+func (m *ListBackupSinksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListBackupSinksResponse.Size(m)
+}
+func (m *ListBackupSinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBackupSinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBackupSinksResponse proto.InternalMessageInfo
+
+func (m *ListBackupSinksResponse) GetSinks() []*BackupSinkStatus {
+	if m != nil {
+		return m.Sinks
+	}
+	return nil
+}
+
 type MacaroonPermission struct {
-	/// The entity a permission grants access to.
+	//*
+	//The entity a permission grants access to. The special entity "uri" grants
+	//access to the single RPC method whose full URI is given as the action,
+	//for example uri:/lnrpc.Lightning/AddInvoice.
 	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
 	/// The action that is granted.
 	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
@@ -10334,7 +12212,7 @@ func (m *MacaroonPermission) Reset()         { *m = MacaroonPermission{} }
 func (m *MacaroonPermission) String() string { return proto.CompactTextString(m) }
 func (*MacaroonPermission) ProtoMessage()    {}
 func (*MacaroonPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{143}
+	return fileDescriptor_77a6da22d6a3feb1, []int{166}
 }
 
 func (m *MacaroonPermission) XXX_Unmarshal(b []byte) error {
@@ -10352,105 +12230,382 @@ func (m *MacaroonPermission) XXX_Size() int {
 func (m *MacaroonPermission) XXX_DiscardUnknown() {
 	xxx_messageInfo_MacaroonPermission.DiscardUnknown(m)
 }
-
-var xxx_messageInfo_MacaroonPermission proto.InternalMessageInfo
-
-func (m *MacaroonPermission) GetEntity() string {
-	if m != nil {
-		return m.Entity
-	}
-	return ""
+
+var xxx_messageInfo_MacaroonPermission proto.InternalMessageInfo
+
+func (m *MacaroonPermission) GetEntity() string {
+	if m != nil {
+		return m.Entity
+	}
+	return ""
+}
+
+func (m *MacaroonPermission) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type BakeMacaroonRequest struct {
+	/// The list of permissions the new macaroon should grant.
+	Permissions []*MacaroonPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	//*
+	//The root key ID used to create the macaroon, must be a positive integer.
+	//If not set, the default root key ID 0 is used. Deleting the root key ID
+	//later on invalidates all macaroons created with it.
+	RootKeyId            uint64   `protobuf:"varint,2,opt,name=root_key_id,proto3" json:"root_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BakeMacaroonRequest) Reset()         { *m = BakeMacaroonRequest{} }
+func (m *BakeMacaroonRequest) String() string { return proto.CompactTextString(m) }
+func (*BakeMacaroonRequest) ProtoMessage()    {}
+func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{167}
+}
+
+func (m *BakeMacaroonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BakeMacaroonRequest.Unmarshal(m, b)
+}
+func (m *BakeMacaroonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BakeMacaroonRequest.Marshal(b, m, deterministic)
+}
+func (m *BakeMacaroonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BakeMacaroonRequest.Merge(m, src)
+}
+func (m *BakeMacaroonRequest) XXX_Size() int {
+	return xxx_messageInfo_BakeMacaroonRequest.Size(m)
+}
+func (m *BakeMacaroonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BakeMacaroonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BakeMacaroonRequest proto.InternalMessageInfo
+
+func (m *BakeMacaroonRequest) GetPermissions() []*MacaroonPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *BakeMacaroonRequest) GetRootKeyId() uint64 {
+	if m != nil {
+		return m.RootKeyId
+	}
+	return 0
+}
+
+type BakeMacaroonResponse struct {
+	/// The hex encoded macaroon, serialized in binary format.
+	Macaroon             string   `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BakeMacaroonResponse) Reset()         { *m = BakeMacaroonResponse{} }
+func (m *BakeMacaroonResponse) String() string { return proto.CompactTextString(m) }
+func (*BakeMacaroonResponse) ProtoMessage()    {}
+func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{168}
+}
+
+func (m *BakeMacaroonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BakeMacaroonResponse.Unmarshal(m, b)
+}
+func (m *BakeMacaroonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BakeMacaroonResponse.Marshal(b, m, deterministic)
+}
+func (m *BakeMacaroonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BakeMacaroonResponse.Merge(m, src)
+}
+func (m *BakeMacaroonResponse) XXX_Size() int {
+	return xxx_messageInfo_BakeMacaroonResponse.Size(m)
+}
+func (m *BakeMacaroonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BakeMacaroonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BakeMacaroonResponse proto.InternalMessageInfo
+
+func (m *BakeMacaroonResponse) GetMacaroon() string {
+	if m != nil {
+		return m.Macaroon
+	}
+	return ""
+}
+
+type ListMacaroonIDsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMacaroonIDsRequest) Reset()         { *m = ListMacaroonIDsRequest{} }
+func (m *ListMacaroonIDsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsRequest) ProtoMessage()    {}
+func (*ListMacaroonIDsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{169}
+}
+
+func (m *ListMacaroonIDsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Unmarshal(m, b)
+}
+func (m *ListMacaroonIDsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListMacaroonIDsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMacaroonIDsRequest.Merge(m, src)
+}
+func (m *ListMacaroonIDsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMacaroonIDsRequest.Size(m)
+}
+func (m *ListMacaroonIDsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMacaroonIDsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMacaroonIDsRequest proto.InternalMessageInfo
+
+type ListMacaroonIDsResponse struct {
+	/// The list of root key IDs that are in use.
+	RootKeyIds           []uint64 `protobuf:"varint,1,rep,packed,name=root_key_ids,proto3" json:"root_key_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMacaroonIDsResponse) Reset()         { *m = ListMacaroonIDsResponse{} }
+func (m *ListMacaroonIDsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsResponse) ProtoMessage()    {}
+func (*ListMacaroonIDsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{170}
+}
+
+func (m *ListMacaroonIDsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Unmarshal(m, b)
+}
+func (m *ListMacaroonIDsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListMacaroonIDsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMacaroonIDsResponse.Merge(m, src)
+}
+func (m *ListMacaroonIDsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMacaroonIDsResponse.Size(m)
+}
+func (m *ListMacaroonIDsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMacaroonIDsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMacaroonIDsResponse proto.InternalMessageInfo
+
+func (m *ListMacaroonIDsResponse) GetRootKeyIds() []uint64 {
+	if m != nil {
+		return m.RootKeyIds
+	}
+	return nil
+}
+
+type DeleteMacaroonIDRequest struct {
+	/// The root key ID to be removed.
+	RootKeyId            uint64   `protobuf:"varint,1,opt,name=root_key_id,proto3" json:"root_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteMacaroonIDRequest) Reset()         { *m = DeleteMacaroonIDRequest{} }
+func (m *DeleteMacaroonIDRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDRequest) ProtoMessage()    {}
+func (*DeleteMacaroonIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{171}
+}
+
+func (m *DeleteMacaroonIDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Unmarshal(m, b)
+}
+func (m *DeleteMacaroonIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteMacaroonIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMacaroonIDRequest.Merge(m, src)
+}
+func (m *DeleteMacaroonIDRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteMacaroonIDRequest.Size(m)
+}
+func (m *DeleteMacaroonIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMacaroonIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteMacaroonIDRequest proto.InternalMessageInfo
+
+func (m *DeleteMacaroonIDRequest) GetRootKeyId() uint64 {
+	if m != nil {
+		return m.RootKeyId
+	}
+	return 0
+}
+
+type DeleteMacaroonIDResponse struct {
+	/// A boolean indicates that the deletion is successful.
+	Deleted              bool     `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteMacaroonIDResponse) Reset()         { *m = DeleteMacaroonIDResponse{} }
+func (m *DeleteMacaroonIDResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDResponse) ProtoMessage()    {}
+func (*DeleteMacaroonIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{172}
+}
+
+func (m *DeleteMacaroonIDResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Unmarshal(m, b)
+}
+func (m *DeleteMacaroonIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteMacaroonIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMacaroonIDResponse.Merge(m, src)
+}
+func (m *DeleteMacaroonIDResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteMacaroonIDResponse.Size(m)
+}
+func (m *DeleteMacaroonIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMacaroonIDResponse.DiscardUnknown(m)
 }
 
-func (m *MacaroonPermission) GetAction() string {
+var xxx_messageInfo_DeleteMacaroonIDResponse proto.InternalMessageInfo
+
+func (m *DeleteMacaroonIDResponse) GetDeleted() bool {
 	if m != nil {
-		return m.Action
+		return m.Deleted
 	}
-	return ""
+	return false
 }
 
-type BakeMacaroonRequest struct {
-	/// The list of permissions the new macaroon should grant.
+type MacaroonPermissionList struct {
+	/// A list of macaroon permissions.
 	Permissions          []*MacaroonPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *BakeMacaroonRequest) Reset()         { *m = BakeMacaroonRequest{} }
-func (m *BakeMacaroonRequest) String() string { return proto.CompactTextString(m) }
-func (*BakeMacaroonRequest) ProtoMessage()    {}
-func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{144}
+func (m *MacaroonPermissionList) Reset()         { *m = MacaroonPermissionList{} }
+func (m *MacaroonPermissionList) String() string { return proto.CompactTextString(m) }
+func (*MacaroonPermissionList) ProtoMessage()    {}
+func (*MacaroonPermissionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{173}
 }
 
-func (m *BakeMacaroonRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BakeMacaroonRequest.Unmarshal(m, b)
+func (m *MacaroonPermissionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacaroonPermissionList.Unmarshal(m, b)
 }
-func (m *BakeMacaroonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BakeMacaroonRequest.Marshal(b, m, deterministic)
+func (m *MacaroonPermissionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacaroonPermissionList.Marshal(b, m, deterministic)
 }
-func (m *BakeMacaroonRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BakeMacaroonRequest.Merge(m, src)
+func (m *MacaroonPermissionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacaroonPermissionList.Merge(m, src)
 }
-func (m *BakeMacaroonRequest) XXX_Size() int {
-	return xxx_messageInfo_BakeMacaroonRequest.Size(m)
+func (m *MacaroonPermissionList) XXX_Size() int {
+	return xxx_messageInfo_MacaroonPermissionList.Size(m)
 }
-func (m *BakeMacaroonRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BakeMacaroonRequest.DiscardUnknown(m)
+func (m *MacaroonPermissionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacaroonPermissionList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BakeMacaroonRequest proto.InternalMessageInfo
+var xxx_messageInfo_MacaroonPermissionList proto.InternalMessageInfo
 
-func (m *BakeMacaroonRequest) GetPermissions() []*MacaroonPermission {
+func (m *MacaroonPermissionList) GetPermissions() []*MacaroonPermission {
 	if m != nil {
 		return m.Permissions
 	}
 	return nil
 }
 
-type BakeMacaroonResponse struct {
-	/// The hex encoded macaroon, serialized in binary format.
-	Macaroon             string   `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+type ListPermissionsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BakeMacaroonResponse) Reset()         { *m = BakeMacaroonResponse{} }
-func (m *BakeMacaroonResponse) String() string { return proto.CompactTextString(m) }
-func (*BakeMacaroonResponse) ProtoMessage()    {}
-func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{145}
+func (m *ListPermissionsRequest) Reset()         { *m = ListPermissionsRequest{} }
+func (m *ListPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPermissionsRequest) ProtoMessage()    {}
+func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{174}
 }
 
-func (m *BakeMacaroonResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BakeMacaroonResponse.Unmarshal(m, b)
+func (m *ListPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPermissionsRequest.Unmarshal(m, b)
 }
-func (m *BakeMacaroonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BakeMacaroonResponse.Marshal(b, m, deterministic)
+func (m *ListPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPermissionsRequest.Marshal(b, m, deterministic)
 }
-func (m *BakeMacaroonResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BakeMacaroonResponse.Merge(m, src)
+func (m *ListPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPermissionsRequest.Merge(m, src)
 }
-func (m *BakeMacaroonResponse) XXX_Size() int {
-	return xxx_messageInfo_BakeMacaroonResponse.Size(m)
+func (m *ListPermissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPermissionsRequest.Size(m)
 }
-func (m *BakeMacaroonResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BakeMacaroonResponse.DiscardUnknown(m)
+func (m *ListPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPermissionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BakeMacaroonResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListPermissionsRequest proto.InternalMessageInfo
 
-func (m *BakeMacaroonResponse) GetMacaroon() string {
+type ListPermissionsResponse struct {
+	//*
+	//A map between all RPC method URIs and their required macaroon permissions
+	//to access them.
+	MethodPermissions    map[string]*MacaroonPermissionList `protobuf:"bytes,1,rep,name=method_permissions,proto3" json:"method_permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *ListPermissionsResponse) Reset()         { *m = ListPermissionsResponse{} }
+func (m *ListPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPermissionsResponse) ProtoMessage()    {}
+func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{175}
+}
+
+func (m *ListPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPermissionsResponse.Unmarshal(m, b)
+}
+func (m *ListPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPermissionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPermissionsResponse.Merge(m, src)
+}
+func (m *ListPermissionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPermissionsResponse.Size(m)
+}
+func (m *ListPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPermissionsResponse proto.InternalMessageInfo
+
+func (m *ListPermissionsResponse) GetMethodPermissions() map[string]*MacaroonPermissionList {
 	if m != nil {
-		return m.Macaroon
+		return m.MethodPermissions
 	}
-	return ""
+	return nil
 }
 
 func init() {
 	proto.RegisterEnum("lnrpc.AddressType", AddressType_name, AddressType_value)
+	proto.RegisterEnum("lnrpc.HintPolicy", HintPolicy_name, HintPolicy_value)
 	proto.RegisterEnum("lnrpc.InvoiceHTLCState", InvoiceHTLCState_name, InvoiceHTLCState_value)
 	proto.RegisterEnum("lnrpc.FeatureBit", FeatureBit_name, FeatureBit_value)
 	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
@@ -10459,8 +12614,10 @@ func init() {
 	proto.RegisterEnum("lnrpc.PeerEvent_EventType", PeerEvent_EventType_name, PeerEvent_EventType_value)
 	proto.RegisterEnum("lnrpc.ChannelEventUpdate_UpdateType", ChannelEventUpdate_UpdateType_name, ChannelEventUpdate_UpdateType_value)
 	proto.RegisterEnum("lnrpc.Invoice_InvoiceState", Invoice_InvoiceState_name, Invoice_InvoiceState_value)
+	proto.RegisterEnum("lnrpc.Invoice_CancelReason", Invoice_CancelReason_name, Invoice_CancelReason_value)
 	proto.RegisterEnum("lnrpc.Payment_PaymentStatus", Payment_PaymentStatus_name, Payment_PaymentStatus_value)
 	proto.RegisterEnum("lnrpc.HTLCAttempt_HTLCStatus", HTLCAttempt_HTLCStatus_name, HTLCAttempt_HTLCStatus_value)
+	proto.RegisterEnum("lnrpc.LedgerEntry_EntryType", LedgerEntry_EntryType_name, LedgerEntry_EntryType_value)
 	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
 	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
 	proto.RegisterType((*InitWalletRequest)(nil), "lnrpc.InitWalletRequest")
@@ -10520,6 +12677,11 @@ func init() {
 	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
 	proto.RegisterMapType((map[uint32]*Feature)(nil), "lnrpc.GetInfoResponse.FeaturesEntry")
 	proto.RegisterType((*Chain)(nil), "lnrpc.Chain")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "lnrpc.GetRecoveryInfoRequest")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "lnrpc.GetRecoveryInfoResponse")
+	proto.RegisterType((*KeyFamilyRecovery)(nil), "lnrpc.KeyFamilyRecovery")
+	proto.RegisterType((*CommitOutputRecovery)(nil), "lnrpc.CommitOutputRecovery")
+	proto.RegisterType((*RecoveredCommitOutput)(nil), "lnrpc.RecoveredCommitOutput")
 	proto.RegisterType((*ConfirmationUpdate)(nil), "lnrpc.ConfirmationUpdate")
 	proto.RegisterType((*ChannelOpenUpdate)(nil), "lnrpc.ChannelOpenUpdate")
 	proto.RegisterType((*ChannelCloseUpdate)(nil), "lnrpc.ChannelCloseUpdate")
@@ -10547,6 +12709,8 @@ func init() {
 	proto.RegisterType((*ChannelEventUpdate)(nil), "lnrpc.ChannelEventUpdate")
 	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
 	proto.RegisterType((*WalletBalanceResponse)(nil), "lnrpc.WalletBalanceResponse")
+	proto.RegisterMapType((map[string]*WalletAccountBalance)(nil), "lnrpc.WalletBalanceResponse.AccountBalanceEntry")
+	proto.RegisterType((*WalletAccountBalance)(nil), "lnrpc.WalletAccountBalance")
 	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
 	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
 	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
@@ -10556,6 +12720,7 @@ func init() {
 	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
 	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
 	proto.RegisterMapType((map[uint64][]byte)(nil), "lnrpc.Hop.CustomRecordsEntry")
+	proto.RegisterType((*AMPRecord)(nil), "lnrpc.AMPRecord")
 	proto.RegisterType((*MPPRecord)(nil), "lnrpc.MPPRecord")
 	proto.RegisterType((*Route)(nil), "lnrpc.Route")
 	proto.RegisterType((*NodeInfoRequest)(nil), "lnrpc.NodeInfoRequest")
@@ -10581,8 +12746,11 @@ func init() {
 	proto.RegisterType((*RouteHint)(nil), "lnrpc.RouteHint")
 	proto.RegisterType((*Invoice)(nil), "lnrpc.Invoice")
 	proto.RegisterMapType((map[uint32]*Feature)(nil), "lnrpc.Invoice.FeaturesEntry")
+	proto.RegisterType((*InvoicePayment)(nil), "lnrpc.InvoicePayment")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "lnrpc.InvoicePayment.CustomRecordsEntry")
 	proto.RegisterType((*InvoiceHTLC)(nil), "lnrpc.InvoiceHTLC")
 	proto.RegisterMapType((map[uint64][]byte)(nil), "lnrpc.InvoiceHTLC.CustomRecordsEntry")
+	proto.RegisterType((*AMP)(nil), "lnrpc.AMP")
 	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
 	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
 	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
@@ -10601,6 +12769,13 @@ func init() {
 	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
 	proto.RegisterType((*PayReq)(nil), "lnrpc.PayReq")
 	proto.RegisterMapType((map[uint32]*Feature)(nil), "lnrpc.PayReq.FeaturesEntry")
+	proto.RegisterType((*AddOfferRequest)(nil), "lnrpc.AddOfferRequest")
+	proto.RegisterType((*AddOfferResponse)(nil), "lnrpc.AddOfferResponse")
+	proto.RegisterType((*ListOffersRequest)(nil), "lnrpc.ListOffersRequest")
+	proto.RegisterType((*Offer)(nil), "lnrpc.Offer")
+	proto.RegisterType((*ListOffersResponse)(nil), "lnrpc.ListOffersResponse")
+	proto.RegisterType((*FetchInvoiceRequest)(nil), "lnrpc.FetchInvoiceRequest")
+	proto.RegisterType((*FetchInvoiceResponse)(nil), "lnrpc.FetchInvoiceResponse")
 	proto.RegisterType((*Feature)(nil), "lnrpc.Feature")
 	proto.RegisterType((*FeeReportRequest)(nil), "lnrpc.FeeReportRequest")
 	proto.RegisterType((*ChannelFeeReport)(nil), "lnrpc.ChannelFeeReport")
@@ -10610,6 +12785,10 @@ func init() {
 	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
 	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
 	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
+	proto.RegisterType((*AccountingReportRequest)(nil), "lnrpc.AccountingReportRequest")
+	proto.RegisterType((*LedgerEntry)(nil), "lnrpc.LedgerEntry")
+	proto.RegisterType((*AccountingTotals)(nil), "lnrpc.AccountingTotals")
+	proto.RegisterType((*AccountingReportResponse)(nil), "lnrpc.AccountingReportResponse")
 	proto.RegisterType((*ExportChannelBackupRequest)(nil), "lnrpc.ExportChannelBackupRequest")
 	proto.RegisterType((*ChannelBackup)(nil), "lnrpc.ChannelBackup")
 	proto.RegisterType((*MultiChanBackup)(nil), "lnrpc.MultiChanBackup")
@@ -10620,636 +12799,751 @@ func init() {
 	proto.RegisterType((*RestoreBackupResponse)(nil), "lnrpc.RestoreBackupResponse")
 	proto.RegisterType((*ChannelBackupSubscription)(nil), "lnrpc.ChannelBackupSubscription")
 	proto.RegisterType((*VerifyChanBackupResponse)(nil), "lnrpc.VerifyChanBackupResponse")
+	proto.RegisterType((*ListBackupSinksRequest)(nil), "lnrpc.ListBackupSinksRequest")
+	proto.RegisterType((*BackupSinkStatus)(nil), "lnrpc.BackupSinkStatus")
+	proto.RegisterType((*ListBackupSinksResponse)(nil), "lnrpc.ListBackupSinksResponse")
 	proto.RegisterType((*MacaroonPermission)(nil), "lnrpc.MacaroonPermission")
 	proto.RegisterType((*BakeMacaroonRequest)(nil), "lnrpc.BakeMacaroonRequest")
 	proto.RegisterType((*BakeMacaroonResponse)(nil), "lnrpc.BakeMacaroonResponse")
+	proto.RegisterType((*ListMacaroonIDsRequest)(nil), "lnrpc.ListMacaroonIDsRequest")
+	proto.RegisterType((*ListMacaroonIDsResponse)(nil), "lnrpc.ListMacaroonIDsResponse")
+	proto.RegisterType((*DeleteMacaroonIDRequest)(nil), "lnrpc.DeleteMacaroonIDRequest")
+	proto.RegisterType((*DeleteMacaroonIDResponse)(nil), "lnrpc.DeleteMacaroonIDResponse")
+	proto.RegisterType((*MacaroonPermissionList)(nil), "lnrpc.MacaroonPermissionList")
+	proto.RegisterType((*ListPermissionsRequest)(nil), "lnrpc.ListPermissionsRequest")
+	proto.RegisterType((*ListPermissionsResponse)(nil), "lnrpc.ListPermissionsResponse")
+	proto.RegisterMapType((map[string]*MacaroonPermissionList)(nil), "lnrpc.ListPermissionsResponse.MethodPermissionsEntry")
 }
 
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 9926 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0xbd, 0x5d, 0x6c, 0x1c, 0xd9,
-	0x95, 0x18, 0xac, 0xea, 0x1f, 0xb2, 0xfb, 0xf4, 0x0f, 0x9b, 0x97, 0x14, 0xd9, 0x6a, 0xfd, 0x8c,
-	0xa6, 0x2c, 0xcf, 0xc8, 0xf2, 0x98, 0xd2, 0xd0, 0xf6, 0xac, 0x3c, 0xfa, 0xd6, 0x6b, 0xfe, 0x49,
-	0xe4, 0x0c, 0x45, 0xd2, 0x45, 0xca, 0x5a, 0xdb, 0xdf, 0xa2, 0x5c, 0xec, 0xbe, 0x24, 0xcb, 0xea,
-	0xae, 0xea, 0xa9, 0xaa, 0x26, 0x45, 0x3b, 0x93, 0x87, 0x20, 0x09, 0x82, 0xbc, 0x04, 0x86, 0x11,
-	0x20, 0x9b, 0x1f, 0x2c, 0xe0, 0x4d, 0x10, 0x04, 0x01, 0x92, 0x3c, 0x05, 0x1b, 0xc0, 0x79, 0xca,
-	0xc3, 0xe6, 0x25, 0xc8, 0x43, 0x02, 0x04, 0x48, 0x80, 0x00, 0x8b, 0xcd, 0x43, 0x16, 0x01, 0xf2,
-	0x94, 0xe4, 0x39, 0xb8, 0xe7, 0xfe, 0xd4, 0xbd, 0x55, 0xd5, 0x94, 0xc6, 0x76, 0xfc, 0x22, 0xf1,
-	0x9e, 0x7b, 0xea, 0xfe, 0x9e, 0x73, 0xee, 0xf9, 0xb9, 0xe7, 0x36, 0xd4, 0xa3, 0x71, 0x7f, 0x65,
-	0x1c, 0x85, 0x49, 0x48, 0xaa, 0xc3, 0x20, 0x1a, 0xf7, 0x7b, 0xb7, 0x4e, 0xc3, 0xf0, 0x74, 0x48,
-	0x1f, 0x7a, 0x63, 0xff, 0xa1, 0x17, 0x04, 0x61, 0xe2, 0x25, 0x7e, 0x18, 0xc4, 0x1c, 0xc9, 0xfe,
-	0x11, 0xb4, 0x9f, 0xd1, 0xe0, 0x90, 0xd2, 0x81, 0x43, 0x3f, 0x9b, 0xd0, 0x38, 0x21, 0x5f, 0x85,
-	0x79, 0x8f, 0xfe, 0x84, 0xd2, 0x81, 0x3b, 0xf6, 0xe2, 0x78, 0x7c, 0x16, 0x79, 0x31, 0xed, 0x5a,
-	0x77, 0xad, 0xfb, 0x4d, 0xa7, 0xc3, 0x2b, 0x0e, 0x14, 0x9c, 0xbc, 0x0b, 0xcd, 0x98, 0xa1, 0xd2,
-	0x20, 0x89, 0xc2, 0xf1, 0x65, 0xb7, 0x84, 0x78, 0x0d, 0x06, 0xdb, 0xe2, 0x20, 0x7b, 0x08, 0x73,
-	0xaa, 0x87, 0x78, 0x1c, 0x06, 0x31, 0x25, 0x8f, 0x60, 0xb1, 0xef, 0x8f, 0xcf, 0x68, 0xe4, 0xe2,
-	0xc7, 0xa3, 0x80, 0x8e, 0xc2, 0xc0, 0xef, 0x77, 0xad, 0xbb, 0xe5, 0xfb, 0x75, 0x87, 0xf0, 0x3a,
-	0xf6, 0xc5, 0x73, 0x51, 0x43, 0xde, 0x87, 0x39, 0x1a, 0x70, 0x38, 0x1d, 0xe0, 0x57, 0xa2, 0xab,
-	0x76, 0x0a, 0x66, 0x1f, 0xd8, 0x7f, 0xa3, 0x04, 0xf3, 0x3b, 0x81, 0x9f, 0xbc, 0xf4, 0x86, 0x43,
-	0x9a, 0xc8, 0x39, 0xbd, 0x0f, 0x73, 0x17, 0x08, 0xc0, 0x39, 0x5d, 0x84, 0xd1, 0x40, 0xcc, 0xa8,
-	0xcd, 0xc1, 0x07, 0x02, 0x3a, 0x75, 0x64, 0xa5, 0xa9, 0x23, 0x2b, 0x5c, 0xae, 0xf2, 0x94, 0xe5,
-	0x7a, 0x1f, 0xe6, 0x22, 0xda, 0x0f, 0xcf, 0x69, 0x74, 0xe9, 0x5e, 0xf8, 0xc1, 0x20, 0xbc, 0xe8,
-	0x56, 0xee, 0x5a, 0xf7, 0xab, 0x4e, 0x5b, 0x82, 0x5f, 0x22, 0x94, 0xac, 0xc3, 0x5c, 0xff, 0xcc,
-	0x0b, 0x02, 0x3a, 0x74, 0x8f, 0xbd, 0xfe, 0xab, 0xc9, 0x38, 0xee, 0x56, 0xef, 0x5a, 0xf7, 0x1b,
-	0xab, 0x37, 0x56, 0x70, 0x57, 0x57, 0x36, 0xce, 0xbc, 0x60, 0x1d, 0x6b, 0x0e, 0x03, 0x6f, 0x1c,
-	0x9f, 0x85, 0x89, 0xd3, 0x16, 0x5f, 0x70, 0x70, 0x6c, 0x2f, 0x02, 0xd1, 0x57, 0x82, 0xaf, 0xbd,
-	0xfd, 0x4f, 0x2d, 0x58, 0x78, 0x11, 0x0c, 0xc3, 0xfe, 0xab, 0x5f, 0x71, 0x89, 0x0a, 0xe6, 0x50,
-	0x7a, 0xdb, 0x39, 0x94, 0xbf, 0xe8, 0x1c, 0x96, 0x60, 0xd1, 0x1c, 0xac, 0x98, 0x05, 0x85, 0xeb,
-	0xec, 0xeb, 0x53, 0x2a, 0x87, 0x25, 0xa7, 0xf1, 0x15, 0xe8, 0xf4, 0x27, 0x51, 0x44, 0x83, 0xdc,
-	0x3c, 0xe6, 0x04, 0x5c, 0x4d, 0xe4, 0x5d, 0x68, 0x06, 0xf4, 0x22, 0x45, 0x13, 0xb4, 0x1b, 0xd0,
-	0x0b, 0x89, 0x62, 0x77, 0x61, 0x29, 0xdb, 0x8d, 0x18, 0xc0, 0x9f, 0x59, 0x50, 0x79, 0x91, 0xbc,
-	0x0e, 0xc9, 0x0a, 0x54, 0x92, 0xcb, 0x31, 0xe7, 0x90, 0xf6, 0x2a, 0x11, 0x53, 0x5b, 0x1b, 0x0c,
-	0x22, 0x1a, 0xc7, 0x47, 0x97, 0x63, 0xea, 0x34, 0x3d, 0x5e, 0x70, 0x19, 0x1e, 0xe9, 0xc2, 0xac,
-	0x28, 0x63, 0x87, 0x75, 0x47, 0x16, 0xc9, 0x1d, 0x00, 0x6f, 0x14, 0x4e, 0x82, 0xc4, 0x8d, 0xbd,
-	0x04, 0x97, 0xaa, 0xec, 0x68, 0x10, 0x72, 0x0b, 0xea, 0xe3, 0x57, 0x6e, 0xdc, 0x8f, 0xfc, 0x71,
-	0x82, 0x64, 0x53, 0x77, 0x52, 0x00, 0xf9, 0x2a, 0xd4, 0xc2, 0x49, 0x32, 0x0e, 0xfd, 0x20, 0x11,
-	0xa4, 0x32, 0x27, 0xc6, 0xb2, 0x3f, 0x49, 0x0e, 0x18, 0xd8, 0x51, 0x08, 0xe4, 0x1e, 0xb4, 0xfa,
-	0x61, 0x70, 0xe2, 0x47, 0x23, 0x2e, 0x0c, 0xba, 0x33, 0xd8, 0x9b, 0x09, 0xb4, 0xff, 0x55, 0x09,
-	0x1a, 0x47, 0x91, 0x17, 0xc4, 0x5e, 0x9f, 0x01, 0xd8, 0xd0, 0x93, 0xd7, 0xee, 0x99, 0x17, 0x9f,
-	0xe1, 0x6c, 0xeb, 0x8e, 0x2c, 0x92, 0x25, 0x98, 0xe1, 0x03, 0xc5, 0x39, 0x95, 0x1d, 0x51, 0x22,
-	0x1f, 0xc0, 0x7c, 0x30, 0x19, 0xb9, 0x66, 0x5f, 0x65, 0xa4, 0x96, 0x7c, 0x05, 0x5b, 0x80, 0x63,
-	0xb6, 0xd7, 0xbc, 0x0b, 0x3e, 0x43, 0x0d, 0x42, 0x6c, 0x68, 0x8a, 0x12, 0xf5, 0x4f, 0xcf, 0xf8,
-	0x34, 0xab, 0x8e, 0x01, 0x63, 0x6d, 0x24, 0xfe, 0x88, 0xba, 0x71, 0xe2, 0x8d, 0xc6, 0x62, 0x5a,
-	0x1a, 0x04, 0xeb, 0xc3, 0xc4, 0x1b, 0xba, 0x27, 0x94, 0xc6, 0xdd, 0x59, 0x51, 0xaf, 0x20, 0xe4,
-	0x3d, 0x68, 0x0f, 0x68, 0x9c, 0xb8, 0x62, 0x53, 0x68, 0xdc, 0xad, 0x21, 0xeb, 0x67, 0xa0, 0xac,
-	0x9d, 0xc8, 0xbb, 0x70, 0xd9, 0x02, 0xd0, 0xd7, 0xdd, 0x3a, 0x1f, 0x6b, 0x0a, 0x61, 0x94, 0xf3,
-	0x8c, 0x26, 0xda, 0xea, 0xc5, 0x82, 0x42, 0xed, 0x5d, 0x20, 0x1a, 0x78, 0x93, 0x26, 0x9e, 0x3f,
-	0x8c, 0xc9, 0x47, 0xd0, 0x4c, 0x34, 0x64, 0x14, 0x85, 0x0d, 0x45, 0x4e, 0xda, 0x07, 0x8e, 0x81,
-	0x67, 0x9f, 0x41, 0xed, 0x29, 0xa5, 0xbb, 0xfe, 0xc8, 0x4f, 0xc8, 0x12, 0x54, 0x4f, 0xfc, 0xd7,
-	0x94, 0x13, 0x7c, 0x79, 0xfb, 0x9a, 0xc3, 0x8b, 0xe4, 0x1d, 0x00, 0xfc, 0xc3, 0x1d, 0x29, 0xc2,
-	0xda, 0xbe, 0xe6, 0xd4, 0x11, 0xf6, 0x9c, 0x51, 0x56, 0x0f, 0x66, 0xc7, 0x34, 0xea, 0x53, 0xb9,
-	0x7f, 0xdb, 0xd7, 0x1c, 0x09, 0x58, 0x9f, 0x85, 0xea, 0x90, 0xb5, 0x6e, 0xff, 0x69, 0x15, 0x1a,
-	0x87, 0x34, 0x50, 0x9c, 0x46, 0xa0, 0xc2, 0xd6, 0x44, 0x70, 0x17, 0xfe, 0x4d, 0xbe, 0x04, 0x0d,
-	0x5c, 0xa7, 0x38, 0x89, 0xfc, 0xe0, 0x94, 0x13, 0xf8, 0x7a, 0xa9, 0x6b, 0x39, 0xc0, 0xc0, 0x87,
-	0x08, 0x25, 0x1d, 0x28, 0x7b, 0x23, 0x49, 0xe0, 0xec, 0x4f, 0x72, 0x03, 0x6a, 0xde, 0x28, 0xe1,
-	0xc3, 0x6b, 0x22, 0x78, 0xd6, 0x1b, 0x25, 0x38, 0xb4, 0x77, 0xa1, 0x39, 0xf6, 0x2e, 0x47, 0x8c,
-	0x9f, 0x15, 0x55, 0x34, 0x9d, 0x86, 0x80, 0x6d, 0x33, 0xb2, 0x58, 0x85, 0x05, 0x1d, 0x45, 0x76,
-	0x5e, 0x55, 0x9d, 0xcf, 0x6b, 0xd8, 0x62, 0x0c, 0xef, 0xc3, 0x9c, 0xfc, 0x26, 0xe2, 0xf3, 0x41,
-	0x5a, 0xa9, 0x3b, 0x6d, 0x01, 0x96, 0xb3, 0xbc, 0x0f, 0x9d, 0x13, 0x3f, 0xf0, 0x86, 0x6e, 0x7f,
-	0x98, 0x9c, 0xbb, 0x03, 0x3a, 0x4c, 0x3c, 0xa4, 0x9a, 0xaa, 0xd3, 0x46, 0xf8, 0xc6, 0x30, 0x39,
-	0xdf, 0x64, 0x50, 0xf2, 0x01, 0xd4, 0x4f, 0x28, 0x75, 0x71, 0xb1, 0xba, 0x35, 0x83, 0x03, 0xe5,
-	0x0e, 0x39, 0xb5, 0x13, 0xb9, 0x57, 0x1f, 0x40, 0x27, 0x9c, 0x24, 0xa7, 0xa1, 0x1f, 0x9c, 0xba,
-	0x4c, 0xe6, 0xb9, 0xfe, 0x00, 0xa9, 0xa8, 0xb2, 0x5e, 0x7a, 0x64, 0x39, 0x6d, 0x59, 0xc7, 0xa4,
-	0xcf, 0xce, 0x80, 0xbc, 0x07, 0x73, 0x43, 0x2f, 0x4e, 0xdc, 0xb3, 0x70, 0xec, 0x8e, 0x27, 0xc7,
-	0xaf, 0xe8, 0x65, 0xb7, 0x85, 0x0b, 0xd1, 0x62, 0xe0, 0xed, 0x70, 0x7c, 0x80, 0x40, 0x72, 0x1b,
-	0x00, 0xc7, 0xc9, 0x07, 0x01, 0x77, 0xad, 0xfb, 0x2d, 0xa7, 0xce, 0x20, 0xbc, 0xd3, 0xef, 0xc3,
-	0x02, 0x6e, 0x4f, 0x7f, 0x12, 0x27, 0xe1, 0xc8, 0x65, 0xf2, 0x3a, 0x1a, 0xc4, 0xdd, 0x06, 0xd2,
-	0xda, 0x57, 0xc4, 0x60, 0xb5, 0x3d, 0x5e, 0xd9, 0xa4, 0x71, 0xb2, 0x81, 0xc8, 0x0e, 0xc7, 0x65,
-	0x87, 0xfa, 0xa5, 0x33, 0x3f, 0xc8, 0xc2, 0xc9, 0x07, 0x40, 0xbc, 0xe1, 0x30, 0xbc, 0x70, 0x63,
-	0x3a, 0x3c, 0x71, 0xc5, 0x22, 0x76, 0xdb, 0x77, 0xad, 0xfb, 0x35, 0xa7, 0x83, 0x35, 0x87, 0x74,
-	0x78, 0x72, 0xc0, 0xe1, 0xe4, 0x23, 0x68, 0xe1, 0x40, 0x4e, 0xa8, 0x97, 0x4c, 0x22, 0x1a, 0x77,
-	0xe7, 0xee, 0x96, 0xef, 0xb7, 0x57, 0xe7, 0xd5, 0x7a, 0x21, 0x78, 0xdd, 0x4f, 0x9c, 0x26, 0xc3,
-	0x13, 0xe5, 0xb8, 0xb7, 0x09, 0x4b, 0xc5, 0x43, 0x62, 0x44, 0xc5, 0x56, 0x85, 0x11, 0x63, 0xc5,
-	0x61, 0x7f, 0x92, 0x45, 0xa8, 0x9e, 0x7b, 0xc3, 0x09, 0x15, 0x72, 0x9d, 0x17, 0x3e, 0x2e, 0x3d,
-	0xb6, 0xec, 0x3f, 0xb1, 0xa0, 0xc9, 0x67, 0x29, 0xf4, 0x91, 0x7b, 0xd0, 0x92, 0xd4, 0x40, 0xa3,
-	0x28, 0x8c, 0x84, 0x78, 0x33, 0x81, 0xe4, 0x01, 0x74, 0x24, 0x60, 0x1c, 0x51, 0x7f, 0xe4, 0x9d,
-	0xca, 0xb6, 0x73, 0x70, 0xb2, 0x9a, 0xb6, 0x18, 0x85, 0x93, 0x84, 0x8a, 0x93, 0xaf, 0x29, 0x26,
-	0xe8, 0x30, 0x98, 0x63, 0xa2, 0x30, 0xf1, 0x56, 0x40, 0xea, 0x06, 0xcc, 0xfe, 0xdb, 0x16, 0x10,
-	0x36, 0xf4, 0xa3, 0x90, 0x37, 0x21, 0xa8, 0x34, 0xcb, 0x25, 0xd6, 0x5b, 0x73, 0x49, 0xe9, 0x2a,
-	0x2e, 0xb1, 0xa1, 0xca, 0x47, 0x5f, 0x29, 0x18, 0x3d, 0xaf, 0xfa, 0xa4, 0x52, 0x2b, 0x77, 0x2a,
-	0xf6, 0x7f, 0x2e, 0xc3, 0xe2, 0x06, 0x3f, 0xba, 0xd7, 0xfa, 0x7d, 0x3a, 0x56, 0xfc, 0xf3, 0x0e,
-	0x34, 0x82, 0x70, 0x40, 0x25, 0xd5, 0xf2, 0x81, 0x01, 0x03, 0x69, 0x24, 0x7b, 0xe6, 0xf9, 0x01,
-	0x1f, 0x38, 0x5f, 0xcf, 0x3a, 0x42, 0x70, 0xd8, 0xef, 0xc1, 0xdc, 0x98, 0x06, 0x03, 0x9d, 0x4d,
-	0xb8, 0x72, 0xd5, 0x12, 0x60, 0xc1, 0x21, 0xef, 0x40, 0xe3, 0x64, 0xc2, 0xf1, 0x98, 0x70, 0xa9,
-	0x20, 0x1d, 0x80, 0x00, 0xad, 0x71, 0x19, 0x33, 0x9e, 0xc4, 0x67, 0x58, 0x5b, 0xc5, 0xda, 0x59,
-	0x56, 0x66, 0x55, 0xb7, 0x01, 0x06, 0x93, 0x38, 0x11, 0x5c, 0x33, 0x83, 0x95, 0x75, 0x06, 0xe1,
-	0x5c, 0xf3, 0x35, 0x58, 0x18, 0x79, 0xaf, 0x5d, 0xa4, 0x1f, 0xd7, 0x0f, 0xdc, 0x93, 0x21, 0x9e,
-	0x3e, 0xb3, 0x88, 0xd7, 0x19, 0x79, 0xaf, 0xbf, 0xc7, 0x6a, 0x76, 0x82, 0xa7, 0x08, 0x67, 0xa2,
-	0x45, 0xaa, 0x3d, 0x11, 0x8d, 0x69, 0x74, 0x4e, 0x51, 0x1a, 0x54, 0x94, 0x6e, 0xe3, 0x70, 0x28,
-	0x1b, 0xd1, 0x88, 0xcd, 0x3b, 0x19, 0xf6, 0x39, 0xeb, 0x3b, 0xb3, 0x23, 0x3f, 0xd8, 0x4e, 0x86,
-	0x7d, 0x72, 0x0b, 0x80, 0xc9, 0x92, 0x31, 0x8d, 0xdc, 0x57, 0x17, 0xc8, 0xc7, 0x15, 0x94, 0x1d,
-	0x07, 0x34, 0xfa, 0xf4, 0x82, 0xdc, 0x84, 0x7a, 0x3f, 0x46, 0x61, 0xe4, 0x5d, 0x76, 0x1b, 0xc8,
-	0xe4, 0xb5, 0x7e, 0xcc, 0xc4, 0x90, 0x77, 0xc9, 0x18, 0x91, 0x8d, 0xd6, 0xc3, 0x5d, 0xa0, 0x03,
-	0x6c, 0x3e, 0x46, 0xa9, 0xda, 0xc2, 0xc1, 0xae, 0x89, 0x0a, 0xd6, 0x4f, 0x4c, 0xbe, 0x04, 0x2d,
-	0x39, 0xd8, 0x93, 0xa1, 0x77, 0x1a, 0xa3, 0x58, 0x69, 0x39, 0x4d, 0x01, 0x7c, 0xca, 0x60, 0xf6,
-	0x4b, 0xae, 0x6c, 0x69, 0x7b, 0x2b, 0xf8, 0x86, 0x1d, 0xfb, 0x08, 0xc1, 0x7d, 0xad, 0x39, 0xa2,
-	0x54, 0xb4, 0x69, 0xa5, 0x82, 0x4d, 0xb3, 0x7f, 0x61, 0x41, 0x53, 0xb4, 0x8c, 0x1a, 0x0a, 0x79,
-	0x04, 0x44, 0xee, 0x62, 0xf2, 0xda, 0x1f, 0xb8, 0xc7, 0x97, 0x09, 0x8d, 0x39, 0xd1, 0x6c, 0x5f,
-	0x73, 0x0a, 0xea, 0x98, 0x1c, 0x35, 0xa0, 0x71, 0x12, 0x71, 0x9a, 0xde, 0xbe, 0xe6, 0xe4, 0x6a,
-	0x18, 0x8b, 0x31, 0x1d, 0x68, 0x92, 0xb8, 0x7e, 0x30, 0xa0, 0xaf, 0x91, 0x94, 0x5a, 0x8e, 0x01,
-	0x5b, 0x6f, 0x43, 0x53, 0xff, 0xce, 0xfe, 0x31, 0xd4, 0xa4, 0x06, 0x85, 0xda, 0x43, 0x66, 0x5c,
-	0x8e, 0x06, 0x21, 0x3d, 0xa8, 0x99, 0xa3, 0x70, 0x6a, 0x5f, 0xa4, 0x6f, 0xfb, 0xdb, 0xd0, 0xd9,
-	0x65, 0x44, 0x14, 0x30, 0xa2, 0x15, 0x6a, 0xe1, 0x12, 0xcc, 0x68, 0xcc, 0x53, 0x77, 0x44, 0x89,
-	0x9d, 0xbf, 0x67, 0x61, 0x9c, 0x88, 0x7e, 0xf0, 0x6f, 0xfb, 0x4f, 0x2d, 0x20, 0x5b, 0x71, 0xe2,
-	0x8f, 0xbc, 0x84, 0x3e, 0xa5, 0x4a, 0x3c, 0xec, 0x43, 0x93, 0xb5, 0x76, 0x14, 0xae, 0x71, 0x25,
-	0x8d, 0x2b, 0x17, 0x5f, 0x15, 0xec, 0x9c, 0xff, 0x60, 0x45, 0xc7, 0xe6, 0x22, 0xdf, 0x68, 0x80,
-	0x71, 0x5b, 0xe2, 0x45, 0xa7, 0x34, 0x41, 0x0d, 0x4e, 0xe8, 0xff, 0xc0, 0x41, 0x1b, 0x61, 0x70,
-	0xd2, 0xfb, 0x3d, 0x98, 0xcf, 0xb5, 0xa1, 0xcb, 0xe8, 0x7a, 0x81, 0x8c, 0x2e, 0xeb, 0x32, 0xba,
-	0x0f, 0x0b, 0xc6, 0xb8, 0x04, 0xc5, 0x75, 0x61, 0x96, 0x31, 0x06, 0x53, 0x14, 0x2c, 0xae, 0x28,
-	0x88, 0x22, 0x59, 0x85, 0xc5, 0x13, 0x4a, 0x23, 0x2f, 0xc1, 0x22, 0xb2, 0x0e, 0xdb, 0x13, 0xd1,
-	0x72, 0x61, 0x9d, 0xfd, 0xe7, 0x16, 0xcc, 0x31, 0x69, 0xfa, 0xdc, 0x0b, 0x2e, 0xe5, 0x5a, 0xed,
-	0x16, 0xae, 0xd5, 0x7d, 0xed, 0x70, 0xd4, 0xb0, 0xbf, 0xe8, 0x42, 0x95, 0xb3, 0x0b, 0x45, 0xee,
-	0x42, 0xd3, 0x18, 0x6e, 0x95, 0x6b, 0xa4, 0xb1, 0x97, 0x1c, 0xd0, 0x68, 0xfd, 0x32, 0xa1, 0xbf,
-	0xfe, 0x52, 0xbe, 0x07, 0x9d, 0x74, 0xd8, 0x62, 0x1d, 0x09, 0x54, 0x18, 0x61, 0x8a, 0x06, 0xf0,
-	0x6f, 0xfb, 0xef, 0x5b, 0x1c, 0x71, 0x23, 0xf4, 0x95, 0xb6, 0xca, 0x10, 0x99, 0xd2, 0x2b, 0x11,
-	0xd9, 0xdf, 0x53, 0xb5, 0xfd, 0x5f, 0x7f, 0xb2, 0x4c, 0x26, 0xc6, 0x34, 0x18, 0xb8, 0xde, 0x70,
-	0x88, 0x82, 0xb8, 0xe6, 0xcc, 0xb2, 0xf2, 0xda, 0x70, 0x68, 0xbf, 0x0f, 0xf3, 0xda, 0xe8, 0xae,
-	0x98, 0xc7, 0x1e, 0x90, 0x5d, 0x3f, 0x4e, 0x5e, 0x04, 0xf1, 0x58, 0x53, 0xe4, 0x6e, 0x42, 0x9d,
-	0x49, 0x5b, 0x36, 0x32, 0xce, 0xb9, 0x55, 0x87, 0x89, 0x5f, 0x36, 0xae, 0x18, 0x2b, 0xbd, 0xd7,
-	0xa2, 0xb2, 0x24, 0x2a, 0xbd, 0xd7, 0x58, 0x69, 0x3f, 0x86, 0x05, 0xa3, 0x3d, 0xd1, 0xf5, 0xbb,
-	0x50, 0x9d, 0x24, 0xaf, 0x43, 0xa9, 0xaa, 0x37, 0x04, 0x85, 0x30, 0xa3, 0xd0, 0xe1, 0x35, 0xf6,
-	0x13, 0x98, 0xdf, 0xa3, 0x17, 0x82, 0x91, 0xe5, 0x40, 0xde, 0x7b, 0xa3, 0xc1, 0x88, 0xf5, 0xf6,
-	0x0a, 0x10, 0xfd, 0xe3, 0x94, 0x01, 0xa4, 0xf9, 0x68, 0x19, 0xe6, 0xa3, 0xfd, 0x1e, 0x90, 0x43,
-	0xff, 0x34, 0x78, 0x4e, 0xe3, 0xd8, 0x3b, 0x55, 0xac, 0xdf, 0x81, 0xf2, 0x28, 0x3e, 0x15, 0xa2,
-	0x8a, 0xfd, 0x69, 0x7f, 0x1d, 0x16, 0x0c, 0x3c, 0xd1, 0xf0, 0x2d, 0xa8, 0xc7, 0xfe, 0x69, 0x80,
-	0x8a, 0x96, 0x68, 0x3a, 0x05, 0xd8, 0x4f, 0x61, 0xf1, 0x7b, 0x34, 0xf2, 0x4f, 0x2e, 0xdf, 0xd4,
-	0xbc, 0xd9, 0x4e, 0x29, 0xdb, 0xce, 0x16, 0x5c, 0xcf, 0xb4, 0x23, 0xba, 0xe7, 0xe4, 0x2b, 0x76,
-	0xb2, 0xe6, 0xf0, 0x82, 0x26, 0xfb, 0x4a, 0xba, 0xec, 0xb3, 0x5f, 0x00, 0xd9, 0x08, 0x83, 0x80,
-	0xf6, 0x93, 0x03, 0x4a, 0xa3, 0xd4, 0x73, 0x95, 0xd2, 0x6a, 0x63, 0x75, 0x59, 0xac, 0x6c, 0x56,
-	0xa0, 0x0a, 0x22, 0x26, 0x50, 0x19, 0xd3, 0x68, 0x84, 0x0d, 0xd7, 0x1c, 0xfc, 0xdb, 0xbe, 0x0e,
-	0x0b, 0x46, 0xb3, 0xc2, 0xd6, 0xff, 0x10, 0xae, 0x6f, 0xfa, 0x71, 0x3f, 0xdf, 0x61, 0x17, 0x66,
-	0xc7, 0x93, 0x63, 0x37, 0xe5, 0x44, 0x59, 0x64, 0xe6, 0x5f, 0xf6, 0x13, 0xd1, 0xd8, 0x5f, 0xb7,
-	0xa0, 0xb2, 0x7d, 0xb4, 0xbb, 0xc1, 0xce, 0x0a, 0x3f, 0xe8, 0x87, 0x23, 0xa6, 0x85, 0xf1, 0x49,
-	0xab, 0xf2, 0x54, 0x0e, 0xbb, 0x05, 0x75, 0x54, 0xde, 0x98, 0xc5, 0x2b, 0xf4, 0xa0, 0x14, 0xc0,
-	0xac, 0x6d, 0xfa, 0x7a, 0xec, 0x47, 0x68, 0x4e, 0x4b, 0x23, 0xb9, 0x82, 0xc7, 0x4c, 0xbe, 0xc2,
-	0xfe, 0xb7, 0xb3, 0x30, 0x2b, 0x0e, 0x5f, 0x7e, 0x90, 0x27, 0xfe, 0x39, 0x4d, 0x0f, 0x72, 0x56,
-	0x62, 0x8a, 0x71, 0x44, 0x47, 0x61, 0xa2, 0xf4, 0x37, 0xbe, 0x0d, 0x26, 0x10, 0xbd, 0x09, 0x42,
-	0x89, 0xe0, 0xfe, 0x87, 0x32, 0xc7, 0x32, 0x80, 0xe4, 0x16, 0xcc, 0x4a, 0x65, 0xa0, 0xa2, 0x0c,
-	0x1d, 0x09, 0x62, 0xab, 0xd1, 0xf7, 0xc6, 0x5e, 0xdf, 0x4f, 0x2e, 0x85, 0x58, 0x50, 0x65, 0xd6,
-	0xfe, 0x30, 0xec, 0x7b, 0x43, 0xf7, 0xd8, 0x1b, 0x7a, 0x41, 0x9f, 0x4a, 0x6f, 0x85, 0x01, 0x64,
-	0x96, 0xbb, 0x18, 0x96, 0x44, 0xe3, 0xd6, 0x7d, 0x06, 0xca, 0xce, 0xf0, 0x7e, 0x38, 0x1a, 0xf9,
-	0xcc, 0xfa, 0xe0, 0xaa, 0x59, 0xd9, 0xd1, 0x20, 0xdc, 0x37, 0x82, 0xa5, 0x0b, 0xbe, 0x82, 0x75,
-	0xe9, 0x1b, 0xd1, 0x80, 0xac, 0x95, 0x8c, 0x86, 0x56, 0x76, 0x34, 0x08, 0xdb, 0x8b, 0x49, 0x10,
-	0xd3, 0x24, 0x19, 0xd2, 0x81, 0x1a, 0x50, 0x03, 0xd1, 0xf2, 0x15, 0xe4, 0x11, 0x2c, 0x70, 0x1f,
-	0x44, 0xec, 0x25, 0x61, 0x7c, 0xe6, 0xc7, 0x6e, 0xcc, 0xcc, 0x27, 0x6e, 0x0b, 0x17, 0x55, 0x91,
-	0xc7, 0xb0, 0x9c, 0x01, 0x47, 0xb4, 0x4f, 0xfd, 0x73, 0x3a, 0x40, 0x15, 0xae, 0xec, 0x4c, 0xab,
-	0x26, 0x77, 0xa1, 0x11, 0x4c, 0x46, 0xee, 0x64, 0x3c, 0xf0, 0x98, 0x12, 0xd3, 0x46, 0xe5, 0x52,
-	0x07, 0x91, 0x0f, 0x41, 0xea, 0x69, 0x42, 0x7b, 0x9c, 0x33, 0x24, 0x1c, 0xa3, 0x5e, 0xc7, 0xc4,
-	0x60, 0x84, 0x99, 0xaa, 0xa4, 0x1d, 0x61, 0x77, 0x4a, 0x00, 0xf2, 0x49, 0xe4, 0x9f, 0x7b, 0x09,
-	0xed, 0xce, 0x73, 0xa1, 0x2e, 0x8a, 0xec, 0x3b, 0x3f, 0xf0, 0x13, 0xdf, 0x4b, 0xc2, 0xa8, 0x4b,
-	0xb0, 0x2e, 0x05, 0xb0, 0x45, 0x44, 0xfa, 0x88, 0x13, 0x2f, 0x99, 0xc4, 0x42, 0x43, 0x5d, 0x40,
-	0xe2, 0xca, 0x57, 0x90, 0x8f, 0x60, 0x89, 0x53, 0x04, 0x56, 0x09, 0xdd, 0x1b, 0x55, 0x85, 0x45,
-	0x5c, 0x91, 0x29, 0xb5, 0x6c, 0x29, 0x05, 0x89, 0xe4, 0x3e, 0xbc, 0xce, 0x97, 0x72, 0x4a, 0x35,
-	0x1b, 0x1f, 0x1b, 0x81, 0xdf, 0x77, 0x05, 0x06, 0x63, 0x91, 0x25, 0x9c, 0x45, 0xbe, 0x82, 0x91,
-	0xf8, 0xd0, 0x3f, 0xa1, 0x89, 0x3f, 0xa2, 0xdd, 0x65, 0x4e, 0xe2, 0xb2, 0xcc, 0x18, 0x70, 0x32,
-	0xc6, 0x9a, 0x2e, 0x67, 0x78, 0x5e, 0x42, 0x62, 0x1c, 0x86, 0x31, 0x95, 0x9e, 0xa7, 0xee, 0x0d,
-	0xc1, 0x5a, 0x3a, 0xd0, 0xfe, 0x23, 0x8b, 0x1f, 0x51, 0x82, 0x9d, 0x63, 0xcd, 0xf8, 0xe2, 0x8c,
-	0xec, 0x86, 0xc1, 0xf0, 0x52, 0xf0, 0x36, 0x70, 0xd0, 0x7e, 0x30, 0xbc, 0x64, 0xea, 0xbf, 0x1f,
-	0xe8, 0x28, 0x5c, 0x1a, 0x36, 0x25, 0x10, 0x91, 0xde, 0x81, 0xc6, 0x78, 0x72, 0x3c, 0xf4, 0xfb,
-	0x1c, 0xa5, 0xcc, 0x5b, 0xe1, 0x20, 0x44, 0x60, 0xd6, 0x27, 0xdf, 0x4f, 0x8e, 0x51, 0x41, 0x8c,
-	0x86, 0x80, 0x31, 0x14, 0x7b, 0x1d, 0x16, 0xcd, 0x01, 0x0a, 0xb1, 0xff, 0x00, 0x6a, 0x42, 0x4a,
-	0x48, 0x37, 0x44, 0x5b, 0x73, 0x0e, 0x33, 0x63, 0x49, 0xd5, 0xdb, 0x3f, 0x9f, 0x81, 0x05, 0x01,
-	0xdd, 0x60, 0xd3, 0x3f, 0x9c, 0x8c, 0x46, 0x5e, 0x54, 0x20, 0x7e, 0xac, 0x37, 0x88, 0x9f, 0x52,
-	0x5e, 0xfc, 0xdc, 0x31, 0xac, 0x50, 0x2e, 0xbf, 0x34, 0x08, 0xb9, 0x0f, 0x73, 0x6c, 0xc9, 0xb9,
-	0x51, 0xa0, 0xfb, 0x27, 0xb3, 0xe0, 0xbc, 0xc8, 0xac, 0x16, 0x89, 0x4c, 0x5d, 0xdc, 0xcd, 0x64,
-	0xc4, 0x9d, 0x0d, 0x4d, 0xbe, 0xbd, 0x42, 0x82, 0xcf, 0x0a, 0x93, 0x4c, 0x83, 0xb1, 0xf1, 0x64,
-	0x85, 0x0b, 0x97, 0x64, 0x73, 0x45, 0xa2, 0xc5, 0x1f, 0x51, 0x3c, 0x21, 0x34, 0xec, 0xba, 0x10,
-	0x2d, 0xf9, 0x2a, 0xf2, 0x14, 0x80, 0xf7, 0x85, 0x6a, 0x0a, 0xa0, 0x9a, 0xf2, 0x9e, 0xb9, 0x2b,
-	0xfa, 0xfa, 0xaf, 0xb0, 0xc2, 0x24, 0xa2, 0xa8, 0xba, 0x68, 0x5f, 0x92, 0x5d, 0x68, 0x87, 0x63,
-	0x1a, 0xb8, 0x29, 0x83, 0x37, 0xb0, 0xad, 0x7b, 0x57, 0xb4, 0xb5, 0x23, 0x71, 0x9d, 0xcc, 0xb7,
-	0x64, 0x8f, 0xef, 0x00, 0xd5, 0x9a, 0x6b, 0x7e, 0x81, 0xe6, 0xb2, 0x1f, 0xdb, 0x7f, 0xd3, 0x82,
-	0x86, 0x36, 0x72, 0x72, 0x1d, 0xe6, 0x37, 0xf6, 0xf7, 0x0f, 0xb6, 0x9c, 0xb5, 0xa3, 0x9d, 0xef,
-	0x6d, 0xb9, 0x1b, 0xbb, 0xfb, 0x87, 0x5b, 0x9d, 0x6b, 0x0c, 0xbc, 0xbb, 0xbf, 0xb1, 0xb6, 0xeb,
-	0x3e, 0xdd, 0x77, 0x36, 0x24, 0xd8, 0x22, 0x4b, 0x40, 0x9c, 0xad, 0xe7, 0xfb, 0x47, 0x5b, 0x06,
-	0xbc, 0x44, 0x3a, 0xd0, 0x5c, 0x77, 0xb6, 0xd6, 0x36, 0xb6, 0x05, 0xa4, 0x4c, 0x16, 0xa1, 0xf3,
-	0xf4, 0xc5, 0xde, 0xe6, 0xce, 0xde, 0x33, 0x77, 0x63, 0x6d, 0x6f, 0x63, 0x6b, 0x77, 0x6b, 0xb3,
-	0x53, 0x21, 0x2d, 0xa8, 0xaf, 0xad, 0xaf, 0xed, 0x6d, 0xee, 0xef, 0x6d, 0x6d, 0x76, 0xaa, 0xf6,
-	0xb7, 0xa0, 0xae, 0x86, 0x4a, 0x1a, 0x30, 0xfb, 0x62, 0xef, 0xd3, 0xbd, 0xfd, 0x97, 0x7b, 0x9d,
-	0x6b, 0xa4, 0x0e, 0x55, 0xec, 0xbf, 0x63, 0x11, 0x80, 0x19, 0xde, 0x67, 0xa7, 0x44, 0x6a, 0x50,
-	0x59, 0xdf, 0x3f, 0xda, 0xee, 0x94, 0xed, 0xff, 0x6a, 0xc1, 0x75, 0x9c, 0xf3, 0x20, 0xcb, 0xfd,
-	0x77, 0xa1, 0xd1, 0x0f, 0xc3, 0x31, 0xb3, 0x7b, 0xd2, 0x93, 0x5d, 0x07, 0x31, 0xce, 0xe6, 0x32,
-	0xf1, 0x24, 0x8c, 0xfa, 0x54, 0x30, 0x3f, 0x20, 0xe8, 0x29, 0x83, 0x30, 0xce, 0x16, 0x74, 0xcb,
-	0x31, 0x38, 0xef, 0x37, 0x38, 0x8c, 0xa3, 0x2c, 0xc1, 0xcc, 0x71, 0x44, 0xbd, 0xfe, 0x99, 0x60,
-	0x7b, 0x51, 0x22, 0x5f, 0x49, 0x0d, 0xf3, 0x3e, 0x23, 0xab, 0x21, 0x1d, 0x20, 0x2b, 0xd4, 0x9c,
-	0x39, 0x01, 0xdf, 0x10, 0x60, 0x76, 0x08, 0x78, 0xc7, 0x5e, 0x30, 0x08, 0x03, 0x3a, 0x10, 0x5a,
-	0x7f, 0x0a, 0xb0, 0x0f, 0x60, 0x29, 0x3b, 0x3f, 0x21, 0x3c, 0x3e, 0xd2, 0x84, 0x07, 0x57, 0xc2,
-	0x7b, 0xd3, 0x69, 0x41, 0x13, 0x24, 0x7f, 0x5e, 0x86, 0x0a, 0xd3, 0xc9, 0xa6, 0xeb, 0x6f, 0xba,
-	0x9a, 0x5d, 0xce, 0x45, 0x69, 0xd0, 0xd6, 0xe7, 0x27, 0xb4, 0xf0, 0x33, 0xa5, 0x90, 0xb4, 0x3e,
-	0xa2, 0xfd, 0x73, 0xe1, 0x69, 0xd2, 0x20, 0x8c, 0xf3, 0x99, 0x0d, 0x84, 0x5f, 0x0b, 0xce, 0x97,
-	0x65, 0x59, 0x87, 0x5f, 0xce, 0xa6, 0x75, 0xf8, 0x5d, 0x17, 0x66, 0xfd, 0xe0, 0x38, 0x9c, 0x04,
-	0x03, 0xe4, 0xf4, 0x9a, 0x23, 0x8b, 0x18, 0x17, 0x42, 0x09, 0xc4, 0x8e, 0x0f, 0xce, 0xd7, 0x29,
-	0x80, 0xac, 0x42, 0x3d, 0xbe, 0x0c, 0xfa, 0x3a, 0x33, 0x2f, 0x8a, 0x55, 0x62, 0x6b, 0xb0, 0x72,
-	0x78, 0x19, 0xf4, 0x91, 0x75, 0x53, 0x34, 0xf2, 0x4d, 0xa8, 0x29, 0xcf, 0x2c, 0x97, 0xca, 0x37,
-	0xf4, 0x4f, 0xa4, 0x3b, 0x96, 0x1b, 0xbc, 0x0a, 0xb5, 0xf7, 0x29, 0xb4, 0x8c, 0x2a, 0xdd, 0x4a,
-	0x6d, 0x71, 0x2b, 0xf5, 0x9e, 0x6e, 0xa5, 0xa6, 0xc2, 0x5e, 0x7c, 0xa6, 0x5b, 0xad, 0xbf, 0x07,
-	0x35, 0x39, 0x34, 0xc6, 0x55, 0x82, 0x23, 0xdc, 0xc3, 0xef, 0xef, 0x6d, 0x74, 0xae, 0x91, 0x39,
-	0x68, 0xac, 0x6d, 0x20, 0xa3, 0x22, 0xc0, 0x62, 0x28, 0x07, 0x6b, 0x87, 0x87, 0x0a, 0x52, 0xb2,
-	0x09, 0x74, 0xd8, 0x91, 0xc3, 0x46, 0xac, 0x62, 0x2f, 0x1f, 0xc1, 0xbc, 0x06, 0x4b, 0x0d, 0xb9,
-	0x31, 0x03, 0x64, 0x0c, 0x39, 0xd4, 0xda, 0x79, 0x8d, 0xbd, 0x0c, 0xd7, 0x59, 0x71, 0xeb, 0x9c,
-	0x06, 0xc9, 0xe1, 0xe4, 0x98, 0x87, 0xdc, 0xfc, 0x30, 0xb0, 0xff, 0x9a, 0x05, 0x75, 0x55, 0x73,
-	0x05, 0x3d, 0xc9, 0x28, 0x61, 0x09, 0x37, 0xa0, 0xa7, 0x75, 0x81, 0x5f, 0xae, 0xe0, 0xbf, 0x86,
-	0xf1, 0x57, 0x57, 0x20, 0x36, 0xd9, 0x83, 0xad, 0x2d, 0xc7, 0xdd, 0xdf, 0xdb, 0xdd, 0xd9, 0x63,
-	0x42, 0x89, 0x4d, 0x16, 0x01, 0x4f, 0x9f, 0x22, 0xc4, 0xb2, 0x3b, 0xd0, 0x7e, 0x46, 0x93, 0x9d,
-	0xe0, 0x24, 0x94, 0x53, 0xfd, 0x3f, 0x55, 0x98, 0x53, 0xa0, 0xd4, 0x78, 0x3c, 0xa7, 0x51, 0xec,
-	0x87, 0x01, 0xaa, 0x7d, 0x75, 0x47, 0x16, 0xd9, 0x79, 0xe2, 0x0f, 0x68, 0x90, 0xf8, 0xc9, 0xa5,
-	0x6b, 0x78, 0x9b, 0xb2, 0x60, 0x66, 0xa8, 0x79, 0x43, 0xdf, 0x93, 0xd1, 0x4b, 0x5e, 0x60, 0xd0,
-	0x7e, 0x38, 0x0c, 0x23, 0xd4, 0xef, 0xea, 0x0e, 0x2f, 0x90, 0x55, 0x58, 0x64, 0x7a, 0xa5, 0xee,
-	0x0b, 0x44, 0x66, 0xe5, 0xae, 0xaf, 0xc2, 0x3a, 0x76, 0x5e, 0x31, 0xb8, 0x50, 0x4a, 0xd4, 0x27,
-	0xdc, 0x8c, 0x29, 0xaa, 0x22, 0xdf, 0x80, 0xeb, 0x0c, 0xac, 0x14, 0x19, 0xf5, 0xcd, 0x1c, 0x7e,
-	0x53, 0x5c, 0xc9, 0xb8, 0x86, 0xf7, 0xcf, 0x76, 0xbe, 0xca, 0x35, 0x56, 0x05, 0xc8, 0x85, 0x1a,
-	0x67, 0xf8, 0x19, 0x9c, 0x0d, 0x35, 0x6a, 0xe1, 0xca, 0x5a, 0x2e, 0x5c, 0xf9, 0x0d, 0xb8, 0x7e,
-	0x4c, 0xe3, 0xc4, 0x3d, 0xa3, 0xde, 0x80, 0x46, 0xc8, 0x8d, 0x3c, 0x2a, 0xc9, 0x15, 0xf4, 0xe2,
-	0x4a, 0x3c, 0xd9, 0x2f, 0x83, 0x3e, 0x1d, 0xb8, 0x49, 0xe8, 0xa2, 0x06, 0x82, 0x3c, 0x5d, 0x73,
-	0xb2, 0x60, 0x13, 0xf3, 0x34, 0xf2, 0xc6, 0x67, 0x42, 0x83, 0xce, 0x82, 0x99, 0xee, 0x93, 0xd0,
-	0x38, 0x09, 0x28, 0x8f, 0x09, 0xd5, 0xd0, 0xdf, 0x2f, 0x41, 0xe4, 0x1e, 0xcc, 0x60, 0x83, 0x71,
-	0xb7, 0x83, 0x0c, 0xd0, 0x4c, 0x85, 0xa8, 0x1f, 0x38, 0xa2, 0x8e, 0xd9, 0xcb, 0x93, 0xc8, 0x8f,
-	0xbb, 0x4d, 0x0c, 0x87, 0xe2, 0xdf, 0xe4, 0x3b, 0x9a, 0x9c, 0x58, 0xc0, 0x6f, 0xe5, 0x61, 0x9c,
-	0xa1, 0xbc, 0xdf, 0x8a, 0xc8, 0xf8, 0xa4, 0x52, 0x6b, 0x74, 0x9a, 0xf6, 0xef, 0x40, 0x15, 0x47,
-	0x8e, 0x34, 0x89, 0xeb, 0x67, 0x09, 0x9a, 0x44, 0x68, 0x17, 0x66, 0x03, 0x9a, 0x5c, 0x84, 0xd1,
-	0x2b, 0x19, 0x7f, 0x17, 0x45, 0xfb, 0x27, 0xe8, 0x54, 0x50, 0xf1, 0xe8, 0x17, 0x68, 0x0d, 0x91,
-	0x9b, 0x50, 0xe7, 0x7b, 0x1a, 0x9f, 0x79, 0xc2, 0xcf, 0x51, 0x43, 0xc0, 0xe1, 0x99, 0xc7, 0xce,
-	0x47, 0x83, 0x4c, 0xb8, 0xeb, 0xa8, 0x81, 0xb0, 0x6d, 0x4e, 0x25, 0xf7, 0xa0, 0x2d, 0x23, 0xdd,
-	0xb1, 0x3b, 0xa4, 0x27, 0x89, 0x74, 0xfc, 0x06, 0x93, 0x11, 0xfa, 0x97, 0x76, 0xe9, 0x49, 0x62,
-	0xef, 0xc1, 0xbc, 0x38, 0xb3, 0xf6, 0xc7, 0x54, 0x76, 0xfd, 0xad, 0x22, 0xc5, 0xb6, 0xb1, 0xba,
-	0x60, 0x1e, 0x72, 0x3c, 0xb6, 0x6f, 0x62, 0xda, 0x0e, 0x10, 0xfd, 0x0c, 0x14, 0x0d, 0x0a, 0xcd,
-	0x52, 0xba, 0xb6, 0xc5, 0x74, 0x0c, 0x18, 0x5b, 0x9f, 0x78, 0xd2, 0xef, 0xcb, 0xfb, 0x09, 0x35,
-	0x47, 0x16, 0xed, 0xff, 0x68, 0xc1, 0x02, 0xb6, 0x26, 0x55, 0x73, 0xa1, 0x67, 0x3c, 0xfe, 0x02,
-	0xc3, 0x94, 0x81, 0x05, 0xee, 0x4e, 0x5f, 0x84, 0xaa, 0xae, 0x79, 0xf0, 0xc2, 0x17, 0x77, 0x23,
-	0x56, 0x72, 0x6e, 0xc4, 0x07, 0xd0, 0x19, 0xd0, 0xa1, 0x8f, 0x77, 0x54, 0xe4, 0x39, 0xce, 0xf5,
-	0xf0, 0x1c, 0xdc, 0xfe, 0x3b, 0x16, 0xcc, 0x73, 0x45, 0x01, 0x8d, 0x49, 0xb1, 0x54, 0xff, 0x9f,
-	0x34, 0xbc, 0x84, 0x80, 0x12, 0x93, 0x4a, 0x8f, 0x4e, 0x84, 0x72, 0xe4, 0xed, 0x6b, 0x8e, 0x89,
-	0x4c, 0x9e, 0xa0, 0x39, 0x11, 0xb8, 0x08, 0x2d, 0xb8, 0xf5, 0x62, 0xee, 0xcb, 0xf6, 0x35, 0x47,
-	0x43, 0x5f, 0xaf, 0x31, 0x5b, 0x90, 0xc1, 0xed, 0x67, 0xd0, 0x32, 0x3a, 0x32, 0xdc, 0x9d, 0x4d,
-	0xee, 0xee, 0xcc, 0xc5, 0x15, 0x4a, 0x05, 0x71, 0x85, 0x9f, 0x57, 0x80, 0x30, 0xc2, 0xca, 0xec,
-	0xdc, 0x5d, 0x33, 0x38, 0x27, 0x2f, 0xc0, 0xa4, 0x20, 0xb2, 0x0a, 0x44, 0x2b, 0xca, 0xa0, 0x61,
-	0x59, 0x05, 0x0d, 0x0b, 0x6a, 0x99, 0xd4, 0x17, 0x5a, 0xa5, 0x0a, 0xc8, 0xa1, 0x2b, 0x8b, 0x6f,
-	0x53, 0x61, 0x1d, 0xd3, 0x7c, 0x30, 0x3a, 0xc7, 0x8c, 0x6e, 0xe1, 0xfe, 0x91, 0xe5, 0x2c, 0x3d,
-	0xcc, 0xbc, 0x91, 0x1e, 0x66, 0x73, 0xf4, 0xa0, 0x39, 0x20, 0x6a, 0xa6, 0x03, 0xe2, 0x1e, 0xb4,
-	0x64, 0x10, 0x8e, 0xdf, 0x3f, 0x10, 0xde, 0x1e, 0x03, 0xc8, 0xe8, 0x49, 0xfa, 0x00, 0x94, 0x97,
-	0x83, 0x47, 0xd7, 0x73, 0x70, 0x76, 0xb0, 0xa4, 0x8e, 0xe6, 0x06, 0x0e, 0x36, 0x05, 0xa0, 0xcb,
-	0x80, 0x51, 0x89, 0x3b, 0x09, 0xc4, 0xe5, 0x17, 0x3a, 0x40, 0x43, 0xa6, 0xe6, 0xe4, 0x2b, 0xf2,
-	0xe6, 0x7f, 0xab, 0xc0, 0xfc, 0x27, 0x1f, 0xa5, 0x11, 0xab, 0xf8, 0xcc, 0x1f, 0xe1, 0xd9, 0x9e,
-	0xde, 0x1d, 0x79, 0xca, 0xab, 0x0e, 0xcf, 0xfc, 0x91, 0x63, 0xe0, 0xd9, 0xbf, 0xb4, 0xa0, 0xc3,
-	0xa8, 0xc2, 0x20, 0xfc, 0x8f, 0x01, 0x79, 0xf4, 0x2d, 0xe9, 0xde, 0xc0, 0x25, 0x8f, 0xa1, 0x8e,
-	0x65, 0x66, 0xba, 0x09, 0xaa, 0xef, 0x9a, 0x54, 0x9f, 0x4a, 0xb7, 0xed, 0x6b, 0x4e, 0x8a, 0xcc,
-	0xce, 0xb2, 0x6c, 0xc4, 0x90, 0x87, 0xbf, 0xb3, 0x60, 0x8d, 0x3b, 0xb6, 0x01, 0x3e, 0xa5, 0x97,
-	0xbb, 0x61, 0x1f, 0xad, 0xa6, 0xdb, 0x00, 0x8c, 0x06, 0x4f, 0xbc, 0x91, 0x2f, 0x5c, 0x1d, 0x55,
-	0xa7, 0xfe, 0x8a, 0x5e, 0x3e, 0x45, 0x00, 0x13, 0xe3, 0xac, 0x3a, 0x65, 0x91, 0xaa, 0x53, 0x7b,
-	0x45, 0x2f, 0x77, 0x90, 0x3d, 0x5c, 0x68, 0x7d, 0x4a, 0x2f, 0x37, 0x29, 0xd7, 0xeb, 0xc2, 0x88,
-	0xd8, 0xd0, 0x8a, 0xbc, 0x0b, 0xa6, 0xb9, 0x19, 0xa1, 0xbe, 0x46, 0xe4, 0x5d, 0x7c, 0x4a, 0x2f,
-	0xd7, 0x31, 0xd6, 0xf7, 0x00, 0x66, 0x59, 0xfd, 0x30, 0xec, 0x8b, 0x93, 0x49, 0xde, 0x5e, 0x48,
-	0x07, 0xe5, 0xcc, 0xbc, 0xc2, 0xbf, 0xed, 0x7f, 0x6f, 0x41, 0x8b, 0xad, 0x00, 0x8a, 0x3d, 0xb6,
-	0x13, 0xf2, 0x12, 0x8c, 0x95, 0x5e, 0x82, 0x59, 0x15, 0x32, 0x83, 0xcb, 0xd0, 0xd2, 0x74, 0x19,
-	0x8a, 0xcb, 0xc6, 0x05, 0xe8, 0x87, 0x50, 0xe7, 0xec, 0xc4, 0xd8, 0xb7, 0x6c, 0xec, 0x94, 0x31,
-	0x21, 0xa7, 0x86, 0x68, 0x9f, 0xf2, 0x78, 0xbb, 0xe6, 0xac, 0xe2, 0x8b, 0x5c, 0xe7, 0x10, 0x56,
-	0x5d, 0x10, 0xba, 0xad, 0x16, 0x85, 0x6e, 0x5f, 0x40, 0x43, 0x23, 0x2c, 0xf2, 0x6d, 0x1e, 0xf4,
-	0xe6, 0x83, 0xe7, 0x54, 0x68, 0x12, 0x8e, 0x31, 0x7b, 0x14, 0x98, 0x3a, 0x60, 0x7d, 0x06, 0x2a,
-	0x48, 0x92, 0x4f, 0x60, 0x5e, 0x6b, 0x96, 0x5b, 0x88, 0x45, 0x63, 0xb2, 0x8a, 0xc6, 0xf4, 0x87,
-	0x16, 0x2c, 0x8a, 0xaf, 0xf1, 0xc2, 0x94, 0xcf, 0x8e, 0xf1, 0xe7, 0xf1, 0x29, 0x3b, 0x48, 0x59,
-	0xeb, 0x6e, 0x44, 0x4f, 0xfd, 0x38, 0xa1, 0x32, 0x42, 0x50, 0xc0, 0x21, 0x8c, 0xa4, 0x19, 0xaa,
-	0x23, 0x30, 0xc9, 0x13, 0x68, 0xe0, 0xa7, 0xdc, 0x86, 0x15, 0xdb, 0xd2, 0xcd, 0x7f, 0xc8, 0x87,
-	0xca, 0x24, 0x79, 0xac, 0x4a, 0xeb, 0x75, 0x98, 0x4d, 0x22, 0xff, 0xf4, 0x94, 0x46, 0xf6, 0x92,
-	0x1a, 0x1a, 0xe3, 0x36, 0x7a, 0x98, 0xd0, 0x31, 0x53, 0x8e, 0x18, 0x65, 0x34, 0x04, 0x53, 0xfd,
-	0xca, 0x51, 0x81, 0x9e, 0x76, 0xf5, 0x8f, 0x5b, 0xab, 0xe9, 0x4d, 0xbf, 0xfb, 0x30, 0x37, 0x62,
-	0x8a, 0x12, 0xd3, 0xe0, 0x8d, 0x88, 0x40, 0x16, 0xcc, 0x14, 0x6f, 0xd4, 0x5b, 0x62, 0x37, 0xf1,
-	0x87, 0xae, 0xac, 0x15, 0x97, 0xec, 0x8a, 0xaa, 0xd8, 0xf1, 0x1d, 0x27, 0xde, 0x29, 0x15, 0xda,
-	0x31, 0x2f, 0xd8, 0x5d, 0x58, 0x3a, 0x48, 0xb7, 0x45, 0x73, 0x48, 0xd8, 0xff, 0xbc, 0x05, 0xcb,
-	0xb9, 0x2a, 0x75, 0x25, 0x58, 0xb8, 0xb9, 0x87, 0xfe, 0xe8, 0x38, 0x54, 0x6e, 0x2a, 0x4b, 0xf7,
-	0x80, 0x1b, 0x55, 0xe4, 0x14, 0xae, 0x4b, 0xaa, 0x40, 0x57, 0x91, 0x52, 0xfb, 0x4b, 0xa8, 0x89,
-	0x7e, 0x68, 0x4a, 0xac, 0x6c, 0x87, 0x12, 0xae, 0x9f, 0x8a, 0xc5, 0xed, 0x91, 0x33, 0xe8, 0x2a,
-	0xf2, 0x13, 0x9a, 0x92, 0x66, 0xc9, 0xb0, 0xbe, 0x3e, 0x78, 0x43, 0x5f, 0x86, 0xff, 0xc2, 0x99,
-	0xda, 0x1a, 0xb9, 0x84, 0x3b, 0xb2, 0x0e, 0x55, 0xa1, 0x7c, 0x7f, 0x95, 0xb7, 0x9a, 0x1b, 0x7a,
-	0x66, 0xcc, 0x4e, 0xdf, 0xd0, 0x30, 0xf9, 0x31, 0x2c, 0x5d, 0x78, 0x7e, 0x22, 0x87, 0xa5, 0x59,
-	0x51, 0x55, 0xec, 0x72, 0xf5, 0x0d, 0x5d, 0xbe, 0xe4, 0x1f, 0x1b, 0xfa, 0xe1, 0x94, 0x16, 0x7b,
-	0xbf, 0x2c, 0x41, 0xdb, 0x6c, 0x87, 0x91, 0xa9, 0x90, 0x4a, 0x52, 0xa1, 0x90, 0xf6, 0x67, 0x06,
-	0x9c, 0xf7, 0xf6, 0x96, 0x8a, 0xbc, 0xbd, 0xba, 0x7f, 0xb5, 0xfc, 0xa6, 0x70, 0x52, 0xe5, 0xed,
-	0xc2, 0x49, 0xd5, 0xc2, 0x70, 0xd2, 0xf4, 0xa8, 0xc3, 0xcc, 0xaf, 0x1a, 0x75, 0x98, 0xbd, 0x32,
-	0xea, 0xd0, 0xfb, 0xdf, 0x16, 0x90, 0x3c, 0xf5, 0x92, 0x67, 0xdc, 0xc1, 0x1d, 0xd0, 0xa1, 0x10,
-	0x6f, 0x5f, 0x7b, 0x3b, 0x0e, 0x90, 0xbb, 0x25, 0xbf, 0x66, 0xac, 0xa8, 0xdf, 0xcb, 0xd5, 0x6d,
-	0x9b, 0x96, 0x53, 0x54, 0x95, 0x09, 0xa9, 0x55, 0xde, 0x1c, 0x52, 0xab, 0xbe, 0x39, 0xa4, 0x36,
-	0x93, 0x0d, 0xa9, 0xf5, 0xfe, 0xaa, 0x05, 0x0b, 0x05, 0x64, 0xf6, 0x9b, 0x9b, 0x38, 0x23, 0x0c,
-	0x43, 0xfa, 0x94, 0x04, 0x61, 0xe8, 0xc0, 0xde, 0x5f, 0x82, 0x96, 0xc1, 0x5a, 0xbf, 0xb9, 0xfe,
-	0xb3, 0xe6, 0x19, 0xa7, 0x6c, 0x03, 0xd6, 0xfb, 0x1f, 0x25, 0x20, 0x79, 0xf6, 0xfe, 0xad, 0x8e,
-	0x21, 0xbf, 0x4e, 0xe5, 0x82, 0x75, 0xfa, 0x7f, 0x7a, 0xf2, 0x7c, 0x00, 0xf3, 0x22, 0xd9, 0x40,
-	0x0b, 0x69, 0x70, 0x8a, 0xc9, 0x57, 0x30, 0x03, 0xd5, 0x8c, 0x67, 0xd6, 0x8c, 0xcb, 0xd5, 0xda,
-	0xf1, 0x9b, 0x09, 0x6b, 0xda, 0x3d, 0xe8, 0x8a, 0x15, 0xca, 0xbb, 0xfe, 0xfe, 0x5e, 0x45, 0xd9,
-	0xd8, 0x58, 0x29, 0xf4, 0xe7, 0x6f, 0x40, 0x53, 0x3f, 0x3e, 0xc4, 0x76, 0x64, 0xa2, 0x5a, 0x4c,
-	0xcd, 0xd0, 0xb1, 0xc8, 0x26, 0xb4, 0x51, 0x48, 0x0e, 0xd4, 0x77, 0x5c, 0xd3, 0xb8, 0xc2, 0xa1,
-	0xbd, 0x7d, 0xcd, 0xc9, 0x7c, 0x43, 0x7e, 0x17, 0xda, 0xa6, 0x9b, 0x4b, 0xe8, 0x84, 0x45, 0x6a,
-	0x24, 0xfb, 0xdc, 0x44, 0x26, 0x6b, 0xd0, 0xc9, 0xfa, 0xc9, 0xc4, 0xcd, 0xcf, 0x29, 0x0d, 0xe4,
-	0xd0, 0xc9, 0x27, 0xb0, 0x58, 0x74, 0x88, 0xe2, 0xde, 0x4c, 0xb7, 0x22, 0x0a, 0xbf, 0x21, 0x8f,
-	0x85, 0xcf, 0xb4, 0x5a, 0x14, 0xe6, 0xd1, 0x96, 0x7c, 0x85, 0xff, 0xa7, 0x79, 0x4f, 0xcf, 0x01,
-	0x52, 0x18, 0xe9, 0x40, 0x73, 0xff, 0x60, 0x6b, 0xcf, 0xdd, 0xd8, 0x5e, 0xdb, 0xdb, 0xdb, 0xda,
-	0xed, 0x5c, 0x23, 0x04, 0xda, 0x18, 0x9e, 0xd9, 0x54, 0x30, 0x8b, 0xc1, 0x84, 0x47, 0x59, 0xc2,
-	0x4a, 0x64, 0x11, 0x3a, 0x3b, 0x7b, 0x19, 0x68, 0x99, 0x74, 0x61, 0xf1, 0x60, 0x8b, 0x47, 0x74,
-	0x8c, 0x76, 0x2b, 0x4c, 0xdf, 0x13, 0x83, 0x67, 0xfa, 0x1e, 0x4f, 0x59, 0x59, 0xe7, 0x44, 0x28,
-	0x75, 0xa0, 0x7f, 0x60, 0xc1, 0xf5, 0x4c, 0x45, 0x7a, 0x09, 0x99, 0xab, 0x39, 0xa6, 0xee, 0x63,
-	0x02, 0x31, 0x24, 0x2e, 0xcd, 0xc3, 0x8c, 0x9c, 0xca, 0x57, 0x30, 0xce, 0xd2, 0xcc, 0xc9, 0x0c,
-	0xbf, 0x16, 0x55, 0xd9, 0xcb, 0xea, 0xae, 0x67, 0x66, 0xe0, 0x27, 0x3c, 0x15, 0x46, 0xaf, 0x48,
-	0xbd, 0xca, 0xe6, 0x90, 0x65, 0x91, 0xac, 0x66, 0xa8, 0xc1, 0x1c, 0x6f, 0x61, 0x9d, 0xfd, 0xcf,
-	0x66, 0x80, 0x7c, 0x77, 0x42, 0xa3, 0x4b, 0xbc, 0x65, 0xac, 0x82, 0x59, 0xcb, 0x59, 0xd7, 0xfa,
-	0xcc, 0x78, 0x72, 0xcc, 0x0c, 0x16, 0x61, 0x48, 0x95, 0xde, 0x2a, 0x9b, 0xa0, 0xe8, 0x36, 0x7f,
-	0xe5, 0xcd, 0xb7, 0xf9, 0xab, 0x6f, 0xba, 0xcd, 0xff, 0x25, 0x68, 0xf9, 0xa7, 0x41, 0xc8, 0x84,
-	0x0e, 0x53, 0x54, 0xe2, 0xee, 0xcc, 0xdd, 0xf2, 0xfd, 0xa6, 0xd3, 0x14, 0xc0, 0x3d, 0x06, 0x23,
-	0x4f, 0x52, 0x24, 0x3a, 0x38, 0xc5, 0xec, 0x13, 0x5d, 0x0c, 0x6d, 0x0d, 0x4e, 0xa9, 0xb0, 0x1b,
-	0xd1, 0xb5, 0x22, 0x3f, 0x66, 0xf0, 0x98, 0xdc, 0x83, 0x76, 0x1c, 0x4e, 0x98, 0xea, 0x26, 0x97,
-	0x81, 0x3b, 0x9c, 0x9b, 0x1c, 0x7a, 0xc0, 0x17, 0x63, 0x05, 0x16, 0x26, 0x31, 0x75, 0x47, 0x7e,
-	0x1c, 0xb3, 0xe3, 0xb9, 0x1f, 0x06, 0x49, 0x14, 0x0e, 0x85, 0x03, 0x79, 0x7e, 0x12, 0xd3, 0xe7,
-	0xbc, 0x66, 0x83, 0x57, 0x90, 0x6f, 0xa4, 0x43, 0x1a, 0x7b, 0x7e, 0x14, 0x77, 0x01, 0x87, 0x24,
-	0x67, 0xca, 0xc6, 0x7d, 0xe0, 0xf9, 0x91, 0x1a, 0x0b, 0x2b, 0xc4, 0x99, 0x2c, 0x83, 0x46, 0x36,
-	0xcb, 0xe0, 0x47, 0xc5, 0x59, 0x06, 0x2d, 0x6c, 0xfa, 0x91, 0x68, 0x3a, 0xbf, 0xc5, 0x5f, 0x28,
-	0xd9, 0x20, 0x9f, 0x3c, 0xd1, 0xfe, 0x22, 0xc9, 0x13, 0x73, 0x45, 0xc9, 0x13, 0x1f, 0x42, 0x03,
-	0xaf, 0xb4, 0xbb, 0x67, 0x7e, 0x90, 0x48, 0x67, 0x78, 0x47, 0xbf, 0xf3, 0xbe, 0xcd, 0xcc, 0x6f,
-	0x88, 0xe4, 0x9f, 0x71, 0x3e, 0x8f, 0x61, 0xfe, 0xb7, 0x98, 0xc7, 0x20, 0xae, 0xde, 0xaf, 0x40,
-	0x4d, 0xee, 0x13, 0x21, 0x50, 0x39, 0x89, 0xc2, 0x91, 0xf4, 0x0f, 0xb2, 0xbf, 0x49, 0x1b, 0x4a,
-	0x49, 0x28, 0x3e, 0x2e, 0x25, 0xa1, 0xfd, 0x07, 0xd0, 0xd0, 0x48, 0x8d, 0xbc, 0xcb, 0xdd, 0x0e,
-	0x4c, 0x75, 0x16, 0x76, 0x35, 0x5f, 0xc5, 0xba, 0x80, 0xee, 0x0c, 0xc8, 0x57, 0x61, 0x7e, 0xe0,
-	0x47, 0x14, 0x33, 0x8e, 0xdc, 0x88, 0x9e, 0xd3, 0x28, 0x96, 0x2e, 0xdb, 0x8e, 0xaa, 0x70, 0x38,
-	0xdc, 0x76, 0x61, 0xc1, 0xd8, 0x5b, 0x25, 0xdd, 0x66, 0x70, 0xdd, 0x64, 0x94, 0xcd, 0xcc, 0x25,
-	0x10, 0x75, 0x4c, 0xfb, 0x10, 0xde, 0x66, 0x77, 0x1c, 0x85, 0xc7, 0xd8, 0x89, 0xe5, 0x18, 0x30,
-	0xfb, 0xbf, 0x97, 0xa1, 0xbc, 0x1d, 0x8e, 0xf5, 0x0b, 0x1d, 0x56, 0xfe, 0x42, 0x87, 0x30, 0x13,
-	0x5c, 0x65, 0x05, 0x08, 0x5d, 0xce, 0x00, 0x92, 0x07, 0xd0, 0x66, 0xa2, 0x22, 0x09, 0x99, 0x59,
-	0x74, 0xe1, 0x45, 0x3c, 0xb9, 0xa0, 0x8c, 0xfc, 0x97, 0xa9, 0x21, 0x8b, 0x50, 0x56, 0xda, 0x2d,
-	0x22, 0xb0, 0x22, 0xb3, 0xc9, 0xf1, 0x6a, 0xdd, 0xa5, 0x88, 0x21, 0x89, 0x12, 0x93, 0xbc, 0xe6,
-	0xf7, 0x5c, 0x1e, 0x71, 0x1d, 0xa5, 0xa8, 0x8a, 0x99, 0x2c, 0x4c, 0xe2, 0x8c, 0x52, 0x0b, 0x40,
-	0x95, 0xf5, 0xc0, 0x62, 0xcd, 0x0c, 0x2c, 0xde, 0x85, 0x46, 0x32, 0x3c, 0x77, 0xc7, 0xde, 0xe5,
-	0x30, 0xf4, 0x06, 0x82, 0xd3, 0x75, 0x10, 0x79, 0x04, 0x30, 0x1a, 0x8f, 0x05, 0x1b, 0xa2, 0xd7,
-	0x32, 0xa5, 0xea, 0xe7, 0x07, 0x07, 0x9c, 0xfa, 0x1c, 0x0d, 0x87, 0x6c, 0x41, 0xbb, 0x30, 0x43,
-	0xe8, 0xb6, 0xbc, 0x00, 0x16, 0x8e, 0x57, 0x0a, 0x18, 0x35, 0xf3, 0x51, 0xef, 0x3b, 0x40, 0x7e,
-	0xcd, 0x44, 0x9d, 0x97, 0x50, 0x57, 0x23, 0xd4, 0xd3, 0x63, 0xf0, 0x96, 0x67, 0xc3, 0x4c, 0x8f,
-	0xc1, 0x4b, 0x9d, 0xef, 0x41, 0x9b, 0x1f, 0x97, 0xea, 0x00, 0xe0, 0x37, 0xf3, 0x32, 0x50, 0xfb,
-	0x2f, 0x2c, 0xa8, 0x22, 0xe5, 0x31, 0x2d, 0x95, 0xd7, 0xa9, 0x9b, 0x30, 0x22, 0xf6, 0x94, 0x05,
-	0x13, 0xdb, 0xc8, 0x1c, 0x2c, 0x29, 0x32, 0xd0, 0xb3, 0x07, 0xef, 0x42, 0x5d, 0xf5, 0xa4, 0x91,
-	0x52, 0x0a, 0x24, 0x77, 0xa0, 0x72, 0x16, 0x8e, 0xa5, 0x21, 0x0f, 0xe9, 0x8a, 0x3a, 0x08, 0x4f,
-	0xc7, 0xc3, 0xda, 0xe3, 0x53, 0xe0, 0xc6, 0x52, 0x16, 0x5c, 0x30, 0xd7, 0x99, 0xc2, 0xb9, 0xbe,
-	0x80, 0x39, 0x26, 0x1f, 0xb4, 0xd8, 0xf0, 0xf4, 0xc3, 0xf4, 0x2b, 0x4c, 0x03, 0xec, 0x0f, 0x27,
-	0x03, 0xaa, 0xbb, 0x53, 0x30, 0xa6, 0x28, 0xe0, 0xd2, 0x90, 0xb0, 0xff, 0x85, 0xc5, 0xe5, 0x0e,
-	0x6b, 0x97, 0xdc, 0x87, 0x0a, 0x3b, 0xf7, 0x32, 0x3e, 0x3f, 0x75, 0xf3, 0x96, 0xe1, 0x39, 0x88,
-	0xc1, 0x76, 0x11, 0xc3, 0x61, 0x7a, 0xeb, 0x3c, 0x18, 0x96, 0xfa, 0x22, 0xd4, 0xcc, 0x32, 0x26,
-	0x7c, 0x06, 0x4a, 0x56, 0xb4, 0xfb, 0x1f, 0x15, 0xe3, 0x2c, 0x95, 0x4a, 0xe2, 0xe0, 0x94, 0x6a,
-	0xf7, 0x3e, 0xfe, 0x65, 0x09, 0x5a, 0xc6, 0x98, 0x18, 0xf7, 0xe0, 0xd1, 0xc0, 0x3d, 0xca, 0x62,
-	0xe7, 0x75, 0x90, 0xce, 0x79, 0x25, 0x93, 0xf3, 0x54, 0x20, 0xbc, 0xac, 0x07, 0xc2, 0x1f, 0x41,
-	0x3d, 0x4d, 0x1d, 0x35, 0x07, 0xc5, 0x7a, 0x94, 0x77, 0x90, 0x53, 0xa4, 0x34, 0x74, 0x5e, 0xd5,
-	0x43, 0xe7, 0xdf, 0xd6, 0x42, 0xab, 0x33, 0xd8, 0x8c, 0x5d, 0xb4, 0xaa, 0xbf, 0x9d, 0xbb, 0x18,
-	0x4f, 0xa0, 0xa1, 0x0d, 0x5e, 0x0f, 0xa1, 0x5a, 0x46, 0x08, 0x55, 0x65, 0x0b, 0x94, 0xd2, 0x6c,
-	0x01, 0xfb, 0x67, 0x25, 0x68, 0x31, 0x5e, 0xf3, 0x83, 0xd3, 0x83, 0x70, 0xe8, 0xf7, 0x2f, 0x91,
-	0xc6, 0x25, 0x5b, 0x09, 0x25, 0x4c, 0xf2, 0x9c, 0x09, 0x66, 0x32, 0x51, 0xa5, 0x48, 0x71, 0x01,
-	0xae, 0xca, 0x4c, 0xc2, 0x33, 0xf9, 0x78, 0xec, 0xc5, 0x54, 0x4b, 0x6c, 0x75, 0x4c, 0x20, 0x93,
-	0xc3, 0x0c, 0x80, 0xb9, 0x1f, 0x23, 0x7f, 0x38, 0xf4, 0x39, 0x2e, 0xf7, 0x51, 0x14, 0x55, 0xb1,
-	0x3e, 0x07, 0x7e, 0xec, 0x1d, 0xa7, 0x17, 0x96, 0x54, 0x19, 0xa3, 0x45, 0xde, 0x6b, 0x2d, 0x5a,
-	0xc4, 0x93, 0xc5, 0x4c, 0x60, 0x96, 0xaa, 0x66, 0x73, 0x54, 0x65, 0xff, 0x9b, 0x12, 0x34, 0x34,
-	0x1a, 0x65, 0xb2, 0xa5, 0xf0, 0x10, 0xd6, 0xa0, 0xe2, 0x8a, 0x62, 0x60, 0x78, 0xbd, 0x34, 0x08,
-	0xb9, 0x67, 0xf6, 0x8a, 0x51, 0x66, 0x94, 0x3e, 0x06, 0x3d, 0xdf, 0x82, 0x3a, 0xe3, 0xc3, 0x0f,
-	0xd1, 0xc5, 0x26, 0x92, 0xc8, 0x15, 0x40, 0xd6, 0xae, 0x62, 0x6d, 0x35, 0xad, 0x45, 0xc0, 0x95,
-	0x97, 0x16, 0x1f, 0x43, 0x53, 0x34, 0x83, 0x7b, 0x8c, 0x93, 0x4e, 0x25, 0x81, 0xb1, 0xff, 0x8e,
-	0x81, 0x29, 0xbf, 0x5c, 0x95, 0x5f, 0xd6, 0xde, 0xf4, 0xa5, 0xc4, 0xb4, 0x9f, 0xa9, 0xfb, 0xa0,
-	0xcf, 0x22, 0x6f, 0x7c, 0x26, 0xa5, 0xdb, 0x23, 0x58, 0x90, 0x42, 0x6c, 0x12, 0x78, 0x41, 0x10,
-	0x4e, 0x82, 0x3e, 0x95, 0x89, 0x05, 0x45, 0x55, 0xf6, 0x40, 0xa5, 0xa1, 0x61, 0x43, 0xe4, 0x01,
-	0x54, 0xb9, 0x1a, 0xcf, 0x75, 0x95, 0x62, 0x79, 0xc6, 0x51, 0xc8, 0x7d, 0xa8, 0x72, 0x6d, 0xbe,
-	0x34, 0x55, 0x02, 0x71, 0x04, 0x7b, 0x05, 0xe6, 0x50, 0x23, 0xd5, 0x04, 0xf1, 0xcd, 0x22, 0x1d,
-	0x66, 0xa6, 0xcf, 0xc3, 0x19, 0x8b, 0x40, 0xf6, 0x38, 0x5f, 0xe9, 0xf7, 0x7a, 0xfe, 0xa2, 0x0c,
-	0x0d, 0x0d, 0xcc, 0x84, 0x25, 0x5e, 0xf2, 0x70, 0x07, 0xbe, 0x37, 0xa2, 0x32, 0xb8, 0xd1, 0x72,
-	0x32, 0x50, 0x86, 0xe7, 0x9d, 0x9f, 0xba, 0xe1, 0x24, 0x71, 0x07, 0xf4, 0x34, 0xa2, 0x54, 0x28,
-	0x57, 0x19, 0x28, 0xc3, 0x63, 0xd4, 0xac, 0xe1, 0xf1, 0xfb, 0x0a, 0x19, 0xa8, 0xbc, 0x3f, 0xc3,
-	0xd7, 0xa9, 0x92, 0xde, 0x9f, 0xe1, 0xab, 0x92, 0x15, 0xf3, 0xd5, 0x02, 0x31, 0xff, 0x11, 0x2c,
-	0x71, 0x81, 0x2e, 0xa4, 0x87, 0x9b, 0x21, 0xae, 0x29, 0xb5, 0xe4, 0x01, 0x74, 0xd8, 0x98, 0x25,
-	0x6b, 0xc4, 0xfe, 0x4f, 0x38, 0x8f, 0x59, 0x4e, 0x0e, 0xce, 0x70, 0x31, 0xf6, 0xaa, 0xe3, 0xf2,
-	0x8b, 0xb2, 0x39, 0x38, 0xe2, 0x7a, 0xaf, 0x4d, 0xdc, 0xba, 0xc0, 0xcd, 0xc0, 0xc9, 0x63, 0x58,
-	0x1e, 0xd1, 0x81, 0xef, 0x99, 0x4d, 0xb8, 0xa9, 0xc6, 0x31, 0xad, 0x9a, 0xf5, 0xc2, 0x56, 0xe1,
-	0x27, 0xe1, 0xe8, 0xd8, 0xe7, 0xa7, 0x2c, 0x8f, 0x12, 0x57, 0x9c, 0x1c, 0xdc, 0x6e, 0x41, 0xe3,
-	0x30, 0x09, 0xc7, 0x72, 0xeb, 0xdb, 0xd0, 0xe4, 0x45, 0x91, 0x4a, 0x72, 0x13, 0x6e, 0x20, 0xbd,
-	0x1e, 0x85, 0xe3, 0x70, 0x18, 0x9e, 0x5e, 0x1a, 0xee, 0xa9, 0x7f, 0x67, 0xc1, 0x82, 0x51, 0x9b,
-	0xfa, 0xa7, 0xd0, 0x97, 0x2e, 0xef, 0xff, 0x73, 0x12, 0x9f, 0xd7, 0xce, 0x28, 0x8e, 0xc8, 0xef,
-	0x01, 0xbc, 0x10, 0x29, 0x01, 0x6b, 0x69, 0x52, 0xab, 0xfc, 0x90, 0xd3, 0x7b, 0x37, 0x4f, 0xef,
-	0xe2, 0x7b, 0x99, 0xee, 0x2a, 0x9b, 0xf8, 0x5d, 0x71, 0xad, 0x79, 0x20, 0x26, 0x5d, 0x36, 0x6f,
-	0x6c, 0xea, 0xee, 0x4c, 0x39, 0x82, 0xbe, 0x02, 0xc6, 0xf6, 0x2f, 0x2c, 0x80, 0x74, 0x74, 0x78,
-	0x67, 0x54, 0x9d, 0xb3, 0xfc, 0xdd, 0x18, 0xed, 0x4c, 0x7d, 0x17, 0x9a, 0xea, 0xde, 0x5a, 0x7a,
-	0x74, 0x37, 0x24, 0x8c, 0xa9, 0x3a, 0xef, 0xc3, 0xdc, 0xe9, 0x30, 0x3c, 0x46, 0x95, 0x4a, 0x9c,
-	0xb3, 0x3c, 0xa1, 0xa6, 0xcd, 0xc1, 0xf2, 0xf4, 0x4c, 0xcf, 0xf9, 0x4a, 0xe1, 0x85, 0x37, 0xfd,
-	0xd4, 0x66, 0x67, 0xdd, 0x7c, 0x6e, 0x25, 0xae, 0xe4, 0xf2, 0x5f, 0x29, 0xec, 0x7b, 0x55, 0x74,
-	0xe3, 0x09, 0xb4, 0x23, 0x2e, 0x33, 0xa5, 0x40, 0xad, 0x5c, 0x21, 0x50, 0x5b, 0x91, 0x71, 0x32,
-	0x7f, 0x05, 0x3a, 0xde, 0xe0, 0x9c, 0x46, 0x89, 0x8f, 0xde, 0x5e, 0xd4, 0xe9, 0xf8, 0x04, 0xe7,
-	0x34, 0x38, 0xaa, 0x4e, 0xef, 0xc3, 0x9c, 0x48, 0x6f, 0x52, 0x98, 0xe2, 0x05, 0x85, 0x14, 0xcc,
-	0x10, 0xed, 0x7f, 0x2c, 0xaf, 0x0d, 0x99, 0xbb, 0x7b, 0xf5, 0xaa, 0xe8, 0x33, 0x2c, 0x65, 0x66,
-	0xf8, 0x25, 0x71, 0x29, 0x62, 0x20, 0xdd, 0xca, 0x65, 0xed, 0x82, 0xfc, 0x40, 0x5c, 0xbb, 0x32,
-	0x97, 0xb5, 0xf2, 0x36, 0xcb, 0x6a, 0xff, 0x27, 0x0b, 0x66, 0xb7, 0xc3, 0x31, 0x33, 0xed, 0x51,
-	0xc7, 0x61, 0x6c, 0xa2, 0x72, 0x0b, 0x65, 0xf1, 0x0d, 0x89, 0x04, 0x85, 0x5a, 0x49, 0x2b, 0xab,
-	0x95, 0x7c, 0x07, 0x6e, 0x62, 0x60, 0x23, 0x0a, 0xc7, 0x61, 0xc4, 0xd8, 0xd5, 0x1b, 0x72, 0x15,
-	0x24, 0x0c, 0x92, 0x33, 0x29, 0x4e, 0xaf, 0x42, 0x41, 0x3f, 0xe0, 0x30, 0x39, 0x77, 0xb9, 0xb9,
-	0x29, 0xb4, 0x28, 0x2e, 0x65, 0xf3, 0x15, 0xf6, 0xb7, 0xa0, 0xae, 0x1c, 0x18, 0xe4, 0x03, 0xa8,
-	0x9f, 0x85, 0x63, 0xe1, 0xe5, 0xb0, 0x8c, 0xa4, 0x0b, 0x31, 0x7b, 0x27, 0x45, 0xb0, 0xff, 0xe7,
-	0x2c, 0xcc, 0xee, 0x04, 0xe7, 0xa1, 0xdf, 0xc7, 0xeb, 0x47, 0x23, 0x3a, 0x0a, 0x65, 0xb6, 0x25,
-	0xfb, 0x1b, 0x1f, 0x42, 0x49, 0xdf, 0x43, 0xe0, 0x2c, 0xa4, 0x41, 0x98, 0x81, 0x1c, 0xe9, 0xef,
-	0x19, 0x88, 0x52, 0x6a, 0xf5, 0x55, 0xb5, 0x7c, 0x55, 0xd6, 0x1a, 0xcf, 0xb3, 0xc7, 0xb5, 0xe3,
-	0x59, 0x32, 0x1a, 0x84, 0x2d, 0xbe, 0x48, 0x70, 0xe0, 0x17, 0xc5, 0xf9, 0x4d, 0x46, 0x01, 0x42,
-	0xa3, 0x3f, 0xa2, 0x3c, 0x34, 0xa5, 0x54, 0x2f, 0x66, 0xf4, 0xeb, 0x40, 0xa6, 0x9e, 0xf1, 0x0f,
-	0x38, 0x0e, 0x3f, 0x0e, 0x74, 0x10, 0xde, 0x46, 0xc9, 0xbc, 0x0e, 0xc2, 0x5f, 0x78, 0xc9, 0x82,
-	0xf9, 0x45, 0x33, 0x25, 0x74, 0xf9, 0x3c, 0x81, 0xbf, 0x09, 0x91, 0x85, 0x6b, 0xae, 0x02, 0x9e,
-	0x07, 0x26, 0x5d, 0x05, 0x8c, 0x64, 0xbc, 0xe1, 0xf0, 0xd8, 0xeb, 0xbf, 0xe2, 0x96, 0x6d, 0x93,
-	0x47, 0x34, 0x0d, 0x20, 0xde, 0xe6, 0x4f, 0xf7, 0x15, 0x2f, 0x02, 0x55, 0x1c, 0x1d, 0x44, 0x56,
-	0x4d, 0xff, 0x55, 0x7b, 0x8a, 0xff, 0x4a, 0x47, 0xd2, 0x2f, 0x46, 0xcd, 0xe5, 0x32, 0xb3, 0xbc,
-	0xc1, 0x40, 0x5c, 0x98, 0xe9, 0xf0, 0x37, 0x11, 0x14, 0x00, 0x1d, 0x35, 0x7c, 0xc1, 0x38, 0xc2,
-	0x3c, 0x22, 0x18, 0x30, 0x72, 0x87, 0xfb, 0x61, 0xc7, 0x9e, 0x3f, 0xc0, 0x8b, 0xa9, 0xdc, 0x16,
-	0x56, 0x30, 0xd6, 0x86, 0xfc, 0x1b, 0x0f, 0xce, 0x05, 0x5c, 0x15, 0x03, 0xc6, 0xd6, 0x46, 0x95,
-	0x47, 0x69, 0x2a, 0x97, 0x09, 0x24, 0x1f, 0xe2, 0x45, 0x84, 0x84, 0x62, 0xbe, 0x56, 0x7b, 0xf5,
-	0xa6, 0x98, 0xb3, 0x20, 0x5b, 0xf9, 0x3f, 0x5e, 0xbc, 0x70, 0x38, 0x26, 0x53, 0xdb, 0x78, 0x2c,
-	0x68, 0xc9, 0x50, 0xdb, 0x04, 0x2a, 0xc6, 0x82, 0x38, 0x02, 0x79, 0xac, 0x59, 0x62, 0x5d, 0x44,
-	0xbe, 0x95, 0x69, 0x7f, 0x8a, 0x0d, 0xc6, 0x88, 0xd9, 0x8f, 0xd9, 0xf9, 0x13, 0xd3, 0x60, 0x80,
-	0x99, 0x5b, 0x35, 0x47, 0x83, 0xfc, 0x66, 0x6d, 0xb4, 0x35, 0x68, 0xea, 0xf3, 0x24, 0x35, 0xa8,
-	0xec, 0x1f, 0x6c, 0xed, 0x75, 0xae, 0x91, 0x06, 0xcc, 0x1e, 0x6e, 0x1d, 0x1d, 0xed, 0x6e, 0x6d,
-	0x76, 0x2c, 0xd2, 0x84, 0x9a, 0x4a, 0x43, 0x29, 0xb1, 0xd2, 0xda, 0xc6, 0xc6, 0xd6, 0xc1, 0xd1,
-	0xd6, 0x66, 0xa7, 0xfc, 0x49, 0xa5, 0x56, 0xea, 0x94, 0x51, 0xc1, 0xd4, 0x96, 0xe1, 0x0d, 0x7e,
-	0xb6, 0x3b, 0x00, 0x68, 0xf8, 0xa4, 0x17, 0xab, 0x2a, 0x8e, 0x06, 0x61, 0x82, 0x5c, 0xf9, 0x27,
-	0xca, 0xfc, 0x1d, 0x0b, 0x59, 0xc6, 0xcd, 0xc5, 0x07, 0x23, 0xf4, 0xf8, 0x60, 0xd5, 0x31, 0x81,
-	0x8c, 0xf0, 0x05, 0x00, 0x13, 0x1c, 0xb8, 0xb8, 0xd0, 0x41, 0x8c, 0x90, 0x22, 0x1a, 0x87, 0xc3,
-	0x73, 0xca, 0x51, 0xb8, 0xfa, 0x68, 0xc0, 0x58, 0x5f, 0x42, 0x22, 0x6a, 0x59, 0x55, 0x55, 0xc7,
-	0x04, 0x92, 0xaf, 0x49, 0x42, 0xaa, 0x21, 0x21, 0x2d, 0xe7, 0xa9, 0xc2, 0x20, 0xa2, 0xe7, 0x39,
-	0x47, 0x59, 0x1d, 0x09, 0xe4, 0xcb, 0xf9, 0xef, 0xde, 0xc2, 0x61, 0x46, 0x56, 0x80, 0x8c, 0xc6,
-	0x63, 0xb7, 0xc0, 0x83, 0x55, 0x71, 0x0a, 0x6a, 0x7e, 0x03, 0x0e, 0xb6, 0x04, 0xc8, 0xda, 0x60,
-	0x20, 0x86, 0xa9, 0x3f, 0xeb, 0x11, 0xe9, 0xef, 0xc8, 0x48, 0x91, 0x5d, 0x20, 0x16, 0x4b, 0xc5,
-	0x62, 0xf1, 0x4a, 0xe1, 0x61, 0xef, 0x40, 0xe3, 0x40, 0x7b, 0x99, 0xc6, 0x66, 0x27, 0x88, 0x7c,
-	0x93, 0x86, 0x9f, 0x2d, 0xdc, 0xb1, 0x96, 0x42, 0xb5, 0x21, 0x95, 0xf4, 0x21, 0xd9, 0xff, 0xd0,
-	0xe2, 0xc9, 0xfe, 0x6a, 0x0a, 0xbc, 0x7f, 0x1b, 0x9a, 0x2a, 0xb8, 0x94, 0x66, 0x3e, 0x1a, 0x30,
-	0x86, 0x83, 0xc3, 0x71, 0xc3, 0x93, 0x93, 0x98, 0xca, 0x54, 0x1e, 0x03, 0x26, 0x95, 0x75, 0xa6,
-	0xfe, 0xfb, 0xbc, 0x87, 0x58, 0xa4, 0xf4, 0xe4, 0xe0, 0x8c, 0xd2, 0x85, 0x6f, 0x5c, 0x26, 0x31,
-	0xa9, 0xb2, 0x4a, 0xd0, 0xcc, 0xae, 0xf4, 0x03, 0xa8, 0xa9, 0x76, 0xcd, 0x93, 0x58, 0x62, 0xaa,
-	0x7a, 0x76, 0xe2, 0xa3, 0x21, 0x6f, 0x0c, 0x9a, 0x33, 0x5c, 0xbe, 0x82, 0xd1, 0xd2, 0x89, 0x1f,
-	0x65, 0xd1, 0x39, 0x07, 0x16, 0xd4, 0xd8, 0x2f, 0x61, 0x41, 0x8a, 0x0f, 0xcd, 0x8a, 0x30, 0x37,
-	0xd2, 0x7a, 0xd3, 0x29, 0x50, 0xca, 0x9f, 0x02, 0xf6, 0xbf, 0xae, 0xc0, 0xac, 0x7c, 0xf6, 0xc9,
-	0x2e, 0x78, 0xa6, 0xa8, 0x6e, 0xbe, 0x70, 0x44, 0xba, 0xc6, 0x3b, 0x16, 0x48, 0x08, 0x42, 0x37,
-	0xb8, 0x9f, 0x3d, 0xdd, 0x53, 0x07, 0x6b, 0xe6, 0x84, 0x5f, 0x82, 0xca, 0xd8, 0x4b, 0xce, 0xd0,
-	0xff, 0xc6, 0x69, 0x09, 0xcb, 0xd2, 0x85, 0x5f, 0x35, 0x5d, 0xf8, 0x45, 0xef, 0x3a, 0x71, 0x55,
-	0x36, 0xff, 0xae, 0xd3, 0x2d, 0xa8, 0x73, 0x6d, 0x24, 0xf5, 0xd2, 0xa7, 0x80, 0x8c, 0xf6, 0x52,
-	0xcb, 0x69, 0x2f, 0x6f, 0xaf, 0x57, 0x7c, 0x03, 0x66, 0x78, 0x6e, 0xb3, 0x48, 0xd9, 0x92, 0x47,
-	0x8e, 0x58, 0x49, 0xf9, 0x3f, 0xbf, 0xb9, 0xeb, 0x08, 0x5c, 0xfd, 0x75, 0x94, 0x86, 0xf9, 0x3a,
-	0x8a, 0x1e, 0x5c, 0x68, 0x66, 0x82, 0x0b, 0x0f, 0xa0, 0xa3, 0x96, 0x0f, 0x1d, 0x70, 0x41, 0x2c,
-	0x52, 0x54, 0x72, 0xf0, 0xf4, 0xd8, 0x6c, 0x1b, 0xc7, 0x26, 0x93, 0x70, 0x6b, 0x49, 0x42, 0x47,
-	0xe3, 0x44, 0x1c, 0x9b, 0xf6, 0x53, 0x68, 0x19, 0x83, 0x34, 0xd3, 0x1a, 0x5b, 0x50, 0xdf, 0xd9,
-	0x73, 0x9f, 0xee, 0xee, 0x3c, 0xdb, 0x3e, 0xea, 0x58, 0xac, 0x78, 0xf8, 0x62, 0x63, 0x63, 0x6b,
-	0x6b, 0x13, 0x8f, 0x25, 0x80, 0x99, 0xa7, 0x6b, 0x3b, 0xec, 0x88, 0x2a, 0xdb, 0xff, 0xcb, 0x82,
-	0x86, 0xd6, 0x3c, 0xf9, 0xa6, 0x5a, 0x19, 0xfe, 0x80, 0xc6, 0xed, 0xfc, 0x10, 0x56, 0xa4, 0xa0,
-	0xd6, 0x96, 0x46, 0x3d, 0x65, 0x55, 0x9a, 0xfa, 0x94, 0x15, 0xdb, 0x1e, 0x8f, 0xb7, 0xa0, 0xd6,
-	0x81, 0x5b, 0x57, 0x59, 0x30, 0xbf, 0xae, 0x96, 0x9e, 0x2e, 0x0c, 0x93, 0x7b, 0x14, 0xb3, 0x60,
-	0xfb, 0x23, 0x80, 0x74, 0x34, 0xe6, 0xb4, 0xaf, 0x99, 0xd3, 0xb6, 0xb4, 0x69, 0x97, 0xec, 0x4d,
-	0x2e, 0x30, 0xc4, 0x12, 0xaa, 0x30, 0xf8, 0xd7, 0x80, 0x48, 0x07, 0x16, 0x5e, 0x0b, 0x1d, 0x0f,
-	0x69, 0x22, 0x53, 0x3b, 0xe7, 0x45, 0xcd, 0x8e, 0xaa, 0x90, 0x69, 0xd7, 0x69, 0x2b, 0xa9, 0xdc,
-	0x11, 0x14, 0x97, 0x95, 0x3b, 0x02, 0xd5, 0x51, 0xf5, 0x76, 0x0f, 0xba, 0x9b, 0x94, 0xb5, 0xb6,
-	0x36, 0x1c, 0x66, 0x86, 0x63, 0xdf, 0x84, 0x1b, 0x05, 0x75, 0xc2, 0x3d, 0xf1, 0x5d, 0xb8, 0xbe,
-	0xc6, 0xb3, 0x38, 0x7f, 0x53, 0x09, 0x23, 0x76, 0x17, 0x96, 0xb2, 0x4d, 0x8a, 0xce, 0x9e, 0xc2,
-	0xfc, 0x26, 0x3d, 0x9e, 0x9c, 0xee, 0xd2, 0xf3, 0xb4, 0x23, 0x02, 0x95, 0xf8, 0x2c, 0xbc, 0x10,
-	0xeb, 0x83, 0x7f, 0x93, 0xdb, 0x00, 0x43, 0x86, 0xe3, 0xc6, 0x63, 0xda, 0x97, 0x0f, 0x94, 0x20,
-	0xe4, 0x70, 0x4c, 0xfb, 0xf6, 0x47, 0x40, 0xf4, 0x76, 0xc4, 0x7a, 0x31, 0x93, 0x61, 0x72, 0xec,
-	0xc6, 0x97, 0x71, 0x42, 0x47, 0xf2, 0xe5, 0x15, 0x1d, 0x64, 0xbf, 0x0f, 0xcd, 0x03, 0xef, 0xd2,
-	0xa1, 0x9f, 0x89, 0xa7, 0xd3, 0x96, 0x61, 0x76, 0xec, 0x5d, 0x32, 0x7e, 0x56, 0x21, 0x16, 0xac,
-	0xb6, 0xff, 0xa4, 0x02, 0x33, 0x1c, 0x93, 0xb5, 0x3a, 0xa0, 0x71, 0xe2, 0x07, 0xc8, 0x63, 0xb2,
-	0x55, 0x0d, 0x94, 0x13, 0x98, 0xa5, 0x02, 0x81, 0x29, 0x5c, 0x6d, 0xf2, 0xa1, 0x07, 0x41, 0xb2,
-	0x06, 0x8c, 0x89, 0xad, 0x34, 0xfd, 0x8c, 0x53, 0x6a, 0x0a, 0xc8, 0xc4, 0x30, 0x53, 0xc3, 0x84,
-	0x8f, 0x4f, 0x9e, 0x05, 0x42, 0x26, 0xea, 0xa0, 0x42, 0xf3, 0x67, 0x56, 0xe6, 0xd9, 0x64, 0xcc,
-	0x9f, 0x9c, 0x99, 0x53, 0x7b, 0x0b, 0x33, 0x87, 0xfb, 0xdf, 0xae, 0x32, 0x73, 0xe0, 0x6d, 0xcc,
-	0x9c, 0xb7, 0x89, 0x1d, 0xf6, 0xa0, 0x86, 0x67, 0xba, 0x26, 0x22, 0x65, 0x99, 0xfc, 0x8e, 0x66,
-	0x03, 0xf0, 0x7b, 0x0c, 0x37, 0x53, 0x7e, 0x71, 0xe8, 0x67, 0xbf, 0x9d, 0x30, 0xcc, 0x0f, 0x61,
-	0x56, 0x40, 0x19, 0x65, 0x07, 0xde, 0x48, 0x3e, 0xb0, 0x83, 0x7f, 0xb3, 0xa5, 0xc3, 0x77, 0x3e,
-	0x3e, 0x9b, 0xf8, 0x11, 0x1d, 0xc8, 0x5c, 0x6d, 0x0d, 0x84, 0x97, 0xcb, 0x63, 0xf7, 0x55, 0x10,
-	0x5e, 0x04, 0x22, 0x5b, 0x5b, 0x95, 0x6d, 0x02, 0x1d, 0x7c, 0x68, 0x6b, 0x1c, 0x46, 0xf2, 0xcd,
-	0x24, 0xfb, 0x0f, 0x2d, 0xe8, 0x08, 0x46, 0x53, 0x75, 0xf2, 0xc2, 0xc0, 0x55, 0x6f, 0x2d, 0xdc,
-	0x83, 0x16, 0xfa, 0x3a, 0xd4, 0x91, 0x23, 0x82, 0xef, 0x06, 0x90, 0x8d, 0x57, 0xde, 0xee, 0x1c,
-	0xf9, 0x43, 0x41, 0xb7, 0x3a, 0x48, 0x9e, 0x5a, 0x91, 0x27, 0x92, 0xbc, 0x2c, 0x47, 0x95, 0xed,
-	0x5f, 0x5a, 0x30, 0xaf, 0x0d, 0x58, 0x30, 0xea, 0x13, 0x68, 0xaa, 0xf7, 0xec, 0xa8, 0x52, 0xaa,
-	0x96, 0x4d, 0xc9, 0x92, 0x7e, 0x66, 0x20, 0x23, 0xbd, 0x7b, 0x97, 0x38, 0xc0, 0x78, 0x32, 0x12,
-	0xda, 0x8c, 0x0e, 0x62, 0x74, 0x74, 0x41, 0xe9, 0x2b, 0x85, 0xc2, 0xf5, 0x29, 0x03, 0x86, 0x31,
-	0xa2, 0x30, 0x48, 0xce, 0x14, 0x52, 0x45, 0xc4, 0x88, 0x74, 0xa0, 0xfd, 0x5f, 0x4a, 0xb0, 0xc0,
-	0x9d, 0x6e, 0xc2, 0xd9, 0xa9, 0x9e, 0x14, 0x9a, 0xe1, 0xfe, 0x47, 0x2e, 0xb4, 0xb6, 0xaf, 0x39,
-	0xa2, 0x4c, 0xbe, 0xf9, 0x96, 0x8e, 0x42, 0x95, 0x4d, 0x36, 0x65, 0x2f, 0xca, 0x45, 0x7b, 0x71,
-	0xc5, 0x4a, 0x17, 0x85, 0xeb, 0xaa, 0xc5, 0xe1, 0xba, 0xb7, 0x0b, 0x8f, 0xe5, 0x52, 0xae, 0x66,
-	0x05, 0x96, 0x91, 0x72, 0xb5, 0x0a, 0xcb, 0x06, 0x00, 0xe5, 0xb5, 0x7f, 0xe2, 0x53, 0x99, 0xff,
-	0x3e, 0x1f, 0xd3, 0xc4, 0x35, 0x50, 0xd6, 0x67, 0xa1, 0x1a, 0xf7, 0xc3, 0x31, 0xb5, 0x97, 0x60,
-	0xd1, 0x5c, 0x5c, 0x71, 0x4a, 0xfc, 0xc2, 0x82, 0xee, 0x53, 0x7e, 0xe9, 0xc2, 0x0f, 0x4e, 0xb7,
-	0xfd, 0x38, 0x09, 0x23, 0xf5, 0xf2, 0xdb, 0x1d, 0x80, 0x38, 0xf1, 0x22, 0x61, 0x67, 0x72, 0x65,
-	0x57, 0x83, 0xb0, 0x35, 0xa2, 0xc1, 0x80, 0xd7, 0x72, 0xda, 0x50, 0xe5, 0x9c, 0x31, 0x21, 0x5c,
-	0x92, 0x86, 0x4a, 0xfe, 0x1e, 0xcf, 0x04, 0x65, 0x8b, 0x41, 0xcf, 0xf1, 0xe8, 0xe5, 0x7e, 0xbe,
-	0x0c, 0xd4, 0xfe, 0xa3, 0x12, 0xcc, 0xa5, 0x83, 0xe4, 0x99, 0xe5, 0x86, 0x00, 0x17, 0x7a, 0x78,
-	0x2a, 0xc0, 0x45, 0xf8, 0xd0, 0xf5, 0x99, 0x62, 0xae, 0x79, 0x25, 0x35, 0x28, 0xb9, 0x07, 0x0d,
-	0x59, 0x0a, 0x27, 0x89, 0xf6, 0x04, 0x93, 0x0e, 0xe6, 0x29, 0x26, 0xcc, 0x34, 0x10, 0x66, 0x8e,
-	0x28, 0xe1, 0x7b, 0x08, 0xa3, 0x04, 0xbf, 0xe4, 0x7b, 0x2a, 0x8b, 0x4c, 0xa0, 0x31, 0x9d, 0x9a,
-	0xef, 0x21, 0xea, 0xd3, 0xba, 0xae, 0x59, 0x53, 0x4f, 0x57, 0x2a, 0x9e, 0xe7, 0x2d, 0xa6, 0xc9,
-	0x76, 0x15, 0x47, 0x07, 0x49, 0xaf, 0x50, 0x38, 0x31, 0xcc, 0x5f, 0x03, 0x66, 0xff, 0x2d, 0x0b,
-	0x6e, 0x14, 0x6c, 0xa3, 0x90, 0x01, 0x9b, 0x30, 0x7f, 0xa2, 0x2a, 0xe5, 0x52, 0x73, 0x41, 0xb0,
-	0x24, 0x85, 0xab, 0xb9, 0xbc, 0x4e, 0xfe, 0x03, 0x65, 0x6e, 0xf1, 0xcd, 0x33, 0x72, 0x2b, 0xf3,
-	0x15, 0xf6, 0x01, 0xf4, 0xb6, 0x5e, 0x33, 0x91, 0xb2, 0xa1, 0xbf, 0x5f, 0x2e, 0x29, 0x6b, 0x35,
-	0x27, 0x32, 0xdf, 0xec, 0x8c, 0x3e, 0xe1, 0x19, 0x63, 0xaa, 0x2d, 0xf2, 0xf5, 0xb7, 0x6d, 0x44,
-	0xe7, 0xfe, 0xbb, 0x62, 0xd7, 0xf9, 0x03, 0xec, 0x32, 0xc3, 0x53, 0x03, 0xd9, 0xe7, 0x30, 0xf7,
-	0x7c, 0x32, 0x4c, 0xfc, 0xf4, 0x31, 0x76, 0xf2, 0x4d, 0xf1, 0x11, 0x36, 0x21, 0x97, 0xae, 0xb0,
-	0x2b, 0x1d, 0x8f, 0xad, 0xd8, 0x88, 0xb5, 0xe4, 0xe6, 0x7b, 0xcc, 0x57, 0xd8, 0x37, 0x60, 0x39,
-	0xed, 0x92, 0xaf, 0x9d, 0x3c, 0x76, 0xfe, 0xd8, 0xe2, 0x37, 0xab, 0xcd, 0xb7, 0xe1, 0xc9, 0x33,
-	0x58, 0x88, 0xfd, 0xe0, 0x74, 0x48, 0xf5, 0x76, 0x62, 0xb1, 0x12, 0xd7, 0xcd, 0xe1, 0x89, 0xf7,
-	0xe3, 0x9d, 0xa2, 0x2f, 0x18, 0x81, 0x14, 0x0f, 0x34, 0x25, 0x90, 0xcc, 0x92, 0x14, 0x4d, 0xe0,
-	0x13, 0x68, 0x9b, 0x9d, 0x91, 0xc7, 0x22, 0x75, 0x32, 0x1d, 0x59, 0x39, 0x93, 0x01, 0x97, 0x52,
-	0x86, 0x81, 0x69, 0xff, 0xcc, 0x82, 0xae, 0x43, 0x19, 0x19, 0x53, 0xad, 0x53, 0x41, 0x3d, 0x4f,
-	0x72, 0xcd, 0x4e, 0x9f, 0xb0, 0x4a, 0xc9, 0x94, 0x73, 0x5d, 0x99, 0xba, 0x29, 0xdb, 0xd7, 0x0a,
-	0x66, 0xb5, 0x5e, 0x83, 0x19, 0x31, 0xbf, 0x65, 0xb8, 0x2e, 0x86, 0x24, 0x87, 0x93, 0x86, 0x1d,
-	0x8d, 0x4e, 0x8d, 0xb0, 0x63, 0x0f, 0xba, 0xfc, 0x81, 0x3f, 0x7d, 0x1e, 0xe2, 0xc3, 0x4d, 0x20,
-	0xcf, 0xbd, 0xbe, 0x17, 0x85, 0x61, 0x70, 0x40, 0x23, 0x71, 0x49, 0x15, 0xb5, 0x4f, 0x8c, 0xca,
-	0x49, 0x45, 0x99, 0x97, 0xe4, 0x9b, 0x74, 0x61, 0x20, 0xdf, 0xfe, 0xe3, 0x25, 0xdb, 0x81, 0x85,
-	0x75, 0xef, 0x15, 0x95, 0x2d, 0xa5, 0xab, 0xd4, 0x18, 0xab, 0x46, 0xe5, 0xda, 0xcb, 0x9c, 0xeb,
-	0x7c, 0xb7, 0x8e, 0x8e, 0x6d, 0xaf, 0xc2, 0xa2, 0xd9, 0xa6, 0x10, 0x25, 0x3d, 0xa8, 0x8d, 0x04,
-	0x4c, 0x8c, 0x4e, 0x95, 0x1f, 0x7c, 0x0e, 0x0d, 0xed, 0xd1, 0x46, 0xb2, 0x0c, 0x0b, 0x2f, 0x77,
-	0x8e, 0xf6, 0xb6, 0x0e, 0x0f, 0xdd, 0x83, 0x17, 0xeb, 0x9f, 0x6e, 0x7d, 0xdf, 0xdd, 0x5e, 0x3b,
-	0xdc, 0xee, 0x5c, 0x23, 0x4b, 0x40, 0xf6, 0xb6, 0x0e, 0x8f, 0xb6, 0x36, 0x0d, 0xb8, 0x45, 0xee,
-	0x40, 0xef, 0xc5, 0xde, 0x8b, 0xc3, 0xad, 0x4d, 0xb7, 0xe8, 0xbb, 0x12, 0xb9, 0x0d, 0x37, 0x44,
-	0x7d, 0xc1, 0xe7, 0xe5, 0x07, 0x4f, 0xa0, 0x93, 0x75, 0x4b, 0x1a, 0xee, 0xdc, 0xab, 0xfc, 0xbe,
-	0x0f, 0xfe, 0x51, 0x19, 0x20, 0xbd, 0x9c, 0x4a, 0xba, 0xb0, 0xb8, 0xb9, 0x76, 0xb4, 0xb6, 0xbb,
-	0xcf, 0x06, 0xe1, 0xec, 0x1f, 0x6d, 0x6d, 0x1c, 0xb9, 0xce, 0xd6, 0x77, 0x3b, 0xd7, 0x0a, 0x6b,
-	0xf6, 0x0f, 0x98, 0xc9, 0xbe, 0x0c, 0x0b, 0x3b, 0x7b, 0x3b, 0x47, 0x3b, 0x6b, 0xbb, 0xae, 0xb3,
-	0xff, 0x62, 0x67, 0xef, 0x19, 0x7f, 0x77, 0xa5, 0x4c, 0xde, 0x81, 0x9b, 0x2f, 0x0e, 0x9e, 0x3a,
-	0xfb, 0x7b, 0x47, 0xee, 0xe1, 0xf6, 0x8b, 0xa3, 0x4d, 0x7c, 0xb5, 0x65, 0xc3, 0xd9, 0x39, 0xe0,
-	0x6d, 0x56, 0xae, 0x42, 0x60, 0x4d, 0x57, 0xd9, 0x8a, 0x3d, 0xdb, 0x3f, 0x3c, 0xdc, 0x39, 0x70,
-	0xbf, 0xfb, 0x62, 0xcb, 0xd9, 0xd9, 0x3a, 0xc4, 0x0f, 0x67, 0x0a, 0xe0, 0x0c, 0x7f, 0x96, 0xcc,
-	0x43, 0xeb, 0x68, 0xf7, 0x7b, 0xee, 0xfe, 0xde, 0xce, 0xfe, 0x1e, 0xa2, 0xd6, 0x4c, 0x10, 0xc3,
-	0xaa, 0x93, 0x1e, 0x2c, 0x6d, 0xfd, 0xfe, 0x91, 0x5b, 0xd0, 0x32, 0x4c, 0xa9, 0x63, 0xdf, 0x35,
-	0xc8, 0x0d, 0xb8, 0x7e, 0x78, 0xb4, 0x76, 0xb4, 0xb3, 0xe1, 0x8a, 0x17, 0x9f, 0xd8, 0x26, 0xb0,
-	0xcf, 0x9a, 0xc5, 0x55, 0xec, 0xab, 0x16, 0x59, 0x84, 0xce, 0xc1, 0xda, 0xf7, 0x9f, 0x6f, 0xed,
-	0x1d, 0xb9, 0x6b, 0x9b, 0x9b, 0x0e, 0x7e, 0xd0, 0xce, 0x41, 0x19, 0xee, 0x1c, 0xdb, 0xa8, 0xe7,
-	0x07, 0x07, 0x88, 0xd2, 0x91, 0x05, 0x56, 0x33, 0xbf, 0xfa, 0xb3, 0x32, 0xb4, 0x79, 0xb6, 0x00,
-	0xff, 0x15, 0x0c, 0x1a, 0x91, 0xe7, 0x30, 0x2b, 0x7e, 0x4e, 0x85, 0x5c, 0x57, 0x8f, 0x6d, 0xe8,
-	0x3f, 0xe0, 0xd2, 0x5b, 0xca, 0x82, 0x05, 0xfb, 0x2d, 0xfc, 0x95, 0xff, 0xf0, 0xdf, 0x7e, 0x5e,
-	0x6a, 0x91, 0xc6, 0xc3, 0xf3, 0x0f, 0x1f, 0x9e, 0xd2, 0x20, 0x66, 0x6d, 0xfc, 0xff, 0x00, 0xe9,
-	0x8f, 0x84, 0x90, 0xae, 0xf2, 0x3e, 0x66, 0x7e, 0x41, 0xa5, 0x77, 0xa3, 0xa0, 0x46, 0xb4, 0x7b,
-	0x03, 0xdb, 0x5d, 0xb0, 0xdb, 0xac, 0x5d, 0x3f, 0xf0, 0x13, 0xfe, 0x83, 0x21, 0x1f, 0x5b, 0x0f,
-	0xc8, 0x00, 0x9a, 0xfa, 0xcf, 0x77, 0x10, 0x19, 0xed, 0x2f, 0xf8, 0x01, 0x92, 0xde, 0xcd, 0xc2,
-	0x3a, 0x29, 0x73, 0xb0, 0x8f, 0xeb, 0x76, 0x87, 0xf5, 0x31, 0x41, 0x8c, 0xb4, 0x97, 0x21, 0x97,
-	0xc4, 0xe9, 0xaf, 0x74, 0x90, 0x5b, 0x9a, 0x70, 0xcc, 0xfd, 0x46, 0x48, 0xef, 0xf6, 0x94, 0x5a,
-	0xd1, 0xd7, 0x6d, 0xec, 0x6b, 0xd9, 0x26, 0xac, 0xaf, 0x3e, 0xe2, 0xc8, 0xdf, 0x08, 0xf9, 0xd8,
-	0x7a, 0xb0, 0xfa, 0x67, 0xf7, 0xa1, 0xae, 0x6e, 0x02, 0x91, 0x1f, 0x43, 0xcb, 0x48, 0xe7, 0x20,
-	0x72, 0x1a, 0x45, 0xd9, 0x1f, 0xbd, 0x5b, 0xc5, 0x95, 0xa2, 0xe3, 0x3b, 0xd8, 0x71, 0x97, 0x2c,
-	0xb1, 0x8e, 0x45, 0x3e, 0xc4, 0x43, 0x4c, 0x7f, 0xe2, 0x4f, 0x97, 0xbc, 0xd2, 0x4e, 0x1c, 0xde,
-	0xd9, 0xad, 0xec, 0x21, 0x60, 0xf4, 0x76, 0x7b, 0x4a, 0xad, 0xe8, 0xee, 0x16, 0x76, 0xb7, 0x44,
-	0x16, 0xf5, 0xee, 0xd4, 0xed, 0x1c, 0x8a, 0xcf, 0x07, 0xe9, 0x3f, 0x60, 0x41, 0x6e, 0xa7, 0x8f,
-	0xbb, 0x14, 0xfc, 0xb0, 0x85, 0x22, 0x91, 0xfc, 0xaf, 0x5b, 0xd8, 0x5d, 0xec, 0x8a, 0x10, 0xdc,
-	0x3e, 0xfd, 0xf7, 0x2b, 0xc8, 0x31, 0x34, 0xb4, 0x77, 0x9e, 0xc9, 0x8d, 0xa9, 0x6f, 0x52, 0xf7,
-	0x7a, 0x45, 0x55, 0x45, 0x53, 0xd1, 0xdb, 0x7f, 0xc8, 0x14, 0xd2, 0x1f, 0x42, 0x5d, 0xbd, 0x1c,
-	0x4c, 0x96, 0xb5, 0x97, 0x9c, 0xf5, 0x97, 0x8e, 0x7b, 0xdd, 0x7c, 0x45, 0x11, 0xf1, 0xe9, 0xad,
-	0x33, 0xe2, 0x7b, 0x09, 0x0d, 0xed, 0x75, 0x60, 0x35, 0x81, 0xfc, 0x0b, 0xc4, 0x6a, 0x02, 0x05,
-	0x8f, 0x09, 0xdb, 0xf3, 0xd8, 0x45, 0x83, 0xd4, 0x91, 0xbe, 0x93, 0xd7, 0x61, 0x4c, 0x76, 0xe1,
-	0xba, 0x38, 0x59, 0x8f, 0xe9, 0x17, 0xd9, 0x86, 0x82, 0xdf, 0x0c, 0x79, 0x64, 0x91, 0x27, 0x50,
-	0x93, 0x8f, 0x40, 0x93, 0xa5, 0xe2, 0xc7, 0xac, 0x7b, 0xcb, 0x39, 0xb8, 0x38, 0x06, 0xbf, 0x0f,
-	0x90, 0x3e, 0x45, 0xac, 0x84, 0x44, 0xee, 0x69, 0x63, 0x45, 0x01, 0xf9, 0x77, 0x8b, 0xed, 0x25,
-	0x9c, 0x60, 0x87, 0xa0, 0x90, 0x08, 0xe8, 0x85, 0x7c, 0xbb, 0xe2, 0x47, 0xd0, 0xd0, 0x5e, 0x23,
-	0x56, 0xcb, 0x97, 0x7f, 0xc9, 0x58, 0x2d, 0x5f, 0xc1, 0xe3, 0xc5, 0x76, 0x0f, 0x5b, 0x5f, 0xb4,
-	0xe7, 0x58, 0xeb, 0xb1, 0x7f, 0x1a, 0x8c, 0x38, 0x02, 0xdb, 0xa0, 0x33, 0x68, 0x19, 0x4f, 0x0e,
-	0x2b, 0x0e, 0x2d, 0x7a, 0xd0, 0x58, 0x71, 0x68, 0xe1, 0x2b, 0xc5, 0x92, 0xce, 0xec, 0x79, 0xd6,
-	0xcf, 0x39, 0xa2, 0x68, 0x3d, 0xfd, 0x00, 0x1a, 0xda, 0xf3, 0xc1, 0x6a, 0x2e, 0xf9, 0x97, 0x8a,
-	0xd5, 0x5c, 0x8a, 0x5e, 0x1b, 0x5e, 0xc4, 0x3e, 0xda, 0x36, 0x92, 0x02, 0xbe, 0x46, 0xc5, 0xda,
-	0xfe, 0x31, 0xb4, 0xcd, 0x07, 0x85, 0x15, 0xef, 0x17, 0x3e, 0x4d, 0xac, 0x78, 0x7f, 0xca, 0x2b,
-	0xc4, 0x82, 0xa4, 0x1f, 0x2c, 0xa8, 0x4e, 0x1e, 0xfe, 0x54, 0x5c, 0x6c, 0xfe, 0x9c, 0x7c, 0x97,
-	0x09, 0x38, 0xf1, 0x4a, 0x1a, 0x59, 0xd6, 0xa8, 0x56, 0x7f, 0x4b, 0x4d, 0xf1, 0x4b, 0xee, 0x41,
-	0x35, 0x93, 0x98, 0xf9, 0x7b, 0x5a, 0xcf, 0x60, 0x41, 0x11, 0xb3, 0x7a, 0xf5, 0x2c, 0x56, 0x73,
-	0x28, 0x7c, 0x5c, 0xad, 0xd7, 0xc9, 0xd6, 0x3e, 0xb2, 0xf8, 0xf1, 0x87, 0x6f, 0x4b, 0x69, 0xc7,
-	0x9f, 0xfe, 0xf0, 0x99, 0x76, 0xfc, 0x19, 0x4f, 0x50, 0x65, 0x8f, 0xbf, 0xc4, 0x67, 0x6d, 0x04,
-	0x30, 0x97, 0xc9, 0x90, 0x55, 0xec, 0x55, 0xfc, 0x88, 0x41, 0xef, 0xce, 0xd5, 0x89, 0xb5, 0xa6,
-	0x28, 0x92, 0xd2, 0xf4, 0xa1, 0x7c, 0x21, 0xe5, 0x0f, 0xa0, 0xa9, 0xbf, 0x83, 0x4a, 0x74, 0x99,
-	0x90, 0xed, 0xe9, 0x66, 0x61, 0x9d, 0x49, 0x25, 0xa4, 0xa9, 0x77, 0x43, 0xbe, 0x07, 0x4b, 0x6a,
-	0x99, 0xf5, 0x44, 0xc9, 0x98, 0xbc, 0x53, 0x90, 0x3e, 0x69, 0x2c, 0xf6, 0x8d, 0xa9, 0xf9, 0x95,
-	0x8f, 0x2c, 0x46, 0x7d, 0xe6, 0x1b, 0x8c, 0xe9, 0xc9, 0x53, 0xf4, 0xf4, 0x64, 0x7a, 0xf2, 0x14,
-	0x3e, 0xdc, 0x28, 0xa9, 0x8f, 0x2c, 0x18, 0x6b, 0xc4, 0xef, 0x6f, 0x91, 0x1f, 0xc0, 0x9c, 0x96,
-	0xd6, 0x7e, 0x78, 0x19, 0xf4, 0x15, 0x27, 0xe5, 0x9f, 0x30, 0xea, 0x15, 0x99, 0xa5, 0xf6, 0x32,
-	0xb6, 0x3f, 0x6f, 0x1b, 0x8b, 0xc3, 0xb8, 0x68, 0x03, 0x1a, 0x7a, 0xca, 0xfc, 0x15, 0xed, 0x2e,
-	0x6b, 0x55, 0xfa, 0xfb, 0x38, 0x8f, 0x2c, 0xb2, 0x0b, 0x9d, 0xec, 0x53, 0x1e, 0x4a, 0xa6, 0x14,
-	0x3d, 0x3f, 0xd2, 0xcb, 0x54, 0x1a, 0x0f, 0x80, 0x90, 0x03, 0x7e, 0x2b, 0x58, 0xfd, 0xb8, 0x46,
-	0x18, 0x65, 0x4f, 0x75, 0xf3, 0x47, 0x37, 0x54, 0x6b, 0x45, 0x3f, 0xb7, 0x72, 0xdf, 0x7a, 0x64,
-	0x91, 0xbf, 0x6b, 0x41, 0xd3, 0x48, 0x90, 0x37, 0xee, 0x58, 0x66, 0xe6, 0xd9, 0xd5, 0xeb, 0xf4,
-	0x89, 0xda, 0x0e, 0x2e, 0xe2, 0xee, 0x83, 0x4f, 0x8c, 0x4d, 0xfa, 0xa9, 0xe1, 0xe9, 0x5d, 0xc9,
-	0xfe, 0xc2, 0xc6, 0xe7, 0x59, 0x04, 0xfd, 0x19, 0xaa, 0xcf, 0x1f, 0x59, 0xe4, 0x9f, 0x58, 0xd0,
-	0x36, 0x43, 0x38, 0x6a, 0xba, 0x85, 0xc1, 0x22, 0x45, 0x4a, 0x53, 0xe2, 0x3e, 0x3f, 0xc0, 0x51,
-	0x1e, 0x3d, 0x70, 0x8c, 0x51, 0x8a, 0xd7, 0x43, 0x7f, 0xbd, 0xd1, 0x92, 0x8f, 0xf9, 0x0f, 0x5e,
-	0xc9, 0xe8, 0x35, 0xc9, 0xff, 0x40, 0x92, 0x22, 0x3f, 0xfd, 0xe7, 0x84, 0x70, 0x13, 0x7e, 0xc4,
-	0x7f, 0x59, 0x42, 0x86, 0x40, 0x19, 0x15, 0xbf, 0xed, 0xf7, 0xf6, 0x3d, 0x9c, 0xd3, 0x1d, 0xfb,
-	0x86, 0x31, 0xa7, 0xac, 0xe2, 0xb1, 0xc6, 0x47, 0x27, 0x7e, 0x09, 0x28, 0x3d, 0x39, 0x73, 0xbf,
-	0x0e, 0x34, 0x7d, 0x90, 0x23, 0x3e, 0x48, 0x81, 0x6e, 0xb0, 0xda, 0x5b, 0x36, 0x63, 0x3f, 0xc0,
-	0xb1, 0xde, 0xb3, 0xdf, 0x99, 0x3a, 0xd6, 0x87, 0x18, 0x88, 0x61, 0x23, 0x3e, 0x00, 0x48, 0x6f,
-	0x9b, 0x90, 0xcc, 0x4d, 0x07, 0x25, 0x80, 0xf2, 0x17, 0x52, 0x4c, 0x7e, 0x96, 0x17, 0x22, 0x58,
-	0x8b, 0x3f, 0xe4, 0xe2, 0x74, 0x47, 0xde, 0x91, 0xd0, 0xb5, 0x2f, 0xf3, 0x4a, 0x88, 0xa1, 0x7d,
-	0x65, 0xdb, 0x37, 0x84, 0xa9, 0xba, 0x70, 0xf1, 0x02, 0x5a, 0xbb, 0x61, 0xf8, 0x6a, 0x32, 0x56,
-	0xd7, 0x1f, 0xcd, 0x18, 0xe9, 0xb6, 0x17, 0x9f, 0xf5, 0x32, 0xb3, 0xb0, 0xef, 0x62, 0x53, 0x3d,
-	0xd2, 0xd5, 0x9a, 0x7a, 0xf8, 0xd3, 0xf4, 0x26, 0xcb, 0xe7, 0xc4, 0x83, 0x79, 0x25, 0xa3, 0xd5,
-	0xc0, 0x7b, 0x66, 0x33, 0x86, 0x64, 0xce, 0x76, 0x61, 0x98, 0x09, 0x72, 0xb4, 0x0f, 0x63, 0xd9,
-	0xe6, 0x23, 0x8b, 0x1c, 0x40, 0x73, 0x93, 0xf6, 0x31, 0x29, 0x14, 0x03, 0x8d, 0x0b, 0x46, 0xb0,
-	0x8a, 0x47, 0x28, 0x7b, 0x2d, 0x03, 0x68, 0x9e, 0x5b, 0x63, 0xef, 0x32, 0xa2, 0x9f, 0x3d, 0xfc,
-	0xa9, 0x08, 0x61, 0x7e, 0x2e, 0xcf, 0x2d, 0x19, 0xe3, 0x35, 0xce, 0xad, 0x4c, 0x50, 0xd8, 0x38,
-	0xb7, 0x72, 0x41, 0x61, 0x63, 0xa9, 0x65, 0x8c, 0x99, 0x0c, 0x61, 0x3e, 0x17, 0x47, 0x56, 0x47,
-	0xd6, 0xb4, 0xe8, 0x73, 0xef, 0xee, 0x74, 0x04, 0xb3, 0xb7, 0x07, 0x66, 0x6f, 0x87, 0xd0, 0xe2,
-	0x4f, 0x63, 0x1d, 0x53, 0x9e, 0xef, 0x91, 0x79, 0x65, 0x41, 0xcf, 0x26, 0xc9, 0x1e, 0x30, 0x58,
-	0x67, 0x6a, 0x38, 0xfc, 0x8d, 0xcd, 0x1f, 0x42, 0xe3, 0x19, 0x4d, 0x64, 0x82, 0x87, 0xd2, 0xb1,
-	0x33, 0x19, 0x1f, 0xbd, 0x82, 0xfc, 0x10, 0x93, 0x66, 0xb0, 0xb5, 0x87, 0x74, 0x70, 0x4a, 0xb9,
-	0x70, 0x72, 0xfd, 0xc1, 0xe7, 0xe4, 0xf7, 0xb1, 0x71, 0x95, 0x6e, 0xb7, 0xa4, 0xdd, 0xd6, 0xd7,
-	0x1b, 0x9f, 0xcb, 0xc0, 0x8b, 0x5a, 0x0e, 0xc2, 0x01, 0xd5, 0x74, 0xbd, 0x00, 0x1a, 0x5a, 0xba,
-	0xae, 0x62, 0xa0, 0x7c, 0x7a, 0xb6, 0x62, 0xa0, 0x82, 0xec, 0x5e, 0xfb, 0x3e, 0xf6, 0x63, 0x93,
-	0xbb, 0x69, 0x3f, 0x3c, 0xa3, 0x37, 0xed, 0xe9, 0xe1, 0x4f, 0xbd, 0x51, 0xf2, 0x39, 0x79, 0x89,
-	0x0f, 0xd5, 0xea, 0x09, 0x2c, 0xa9, 0xd1, 0x90, 0xcd, 0x75, 0x51, 0x8b, 0xa5, 0x55, 0x99, 0x86,
-	0x04, 0xef, 0x0a, 0x35, 0xb9, 0x6f, 0x02, 0x1c, 0x26, 0xe1, 0x78, 0xd3, 0xa3, 0xa3, 0x30, 0x48,
-	0x65, 0x6d, 0x9a, 0x3e, 0x91, 0xca, 0x2f, 0x2d, 0x87, 0x82, 0xbc, 0xd4, 0xac, 0x2c, 0x23, 0x07,
-	0x48, 0x12, 0xd7, 0xd4, 0x0c, 0x0b, 0xb5, 0x20, 0x05, 0x59, 0x16, 0x8f, 0x2c, 0xb2, 0x06, 0x90,
-	0x5e, 0x24, 0x50, 0x36, 0x53, 0xee, 0x8e, 0x82, 0x12, 0x7b, 0x05, 0xb7, 0x0e, 0x0e, 0xa0, 0x9e,
-	0x86, 0x5d, 0x97, 0xd3, 0xd7, 0x07, 0x8c, 0x20, 0xad, 0x3a, 0xc1, 0x73, 0xc1, 0x50, 0xbb, 0x83,
-	0x4b, 0x05, 0xa4, 0xc6, 0x96, 0x0a, 0x23, 0x9c, 0x3e, 0x2c, 0xf0, 0x01, 0x2a, 0x75, 0x09, 0xaf,
-	0xfd, 0xab, 0xf7, 0x88, 0xf3, 0x01, 0x49, 0xc5, 0xcd, 0x85, 0xf1, 0x34, 0xc3, 0xf5, 0xc3, 0xa8,
-	0x95, 0xa7, 0x1c, 0x30, 0xd1, 0x3c, 0x82, 0xf9, 0x5c, 0x88, 0x46, 0xb1, 0xf4, 0xb4, 0x18, 0x9c,
-	0x62, 0xe9, 0xa9, 0xd1, 0x1d, 0xfb, 0x3a, 0x76, 0x39, 0x67, 0x03, 0x9a, 0x7a, 0x17, 0x7e, 0xd2,
-	0x3f, 0x63, 0xdd, 0xfd, 0xb1, 0x05, 0x0b, 0x05, 0x11, 0x18, 0xf2, 0xae, 0xf4, 0x1a, 0x4c, 0x8d,
-	0xce, 0xf4, 0x0a, 0x1d, 0xf4, 0xf6, 0x21, 0xf6, 0xf3, 0x9c, 0x7c, 0x6a, 0x1c, 0x6c, 0xdc, 0x37,
-	0x2e, 0x38, 0xf3, 0x4a, 0xa5, 0xa2, 0x50, 0xa3, 0xf8, 0x0c, 0x96, 0xf9, 0x40, 0xd6, 0x86, 0xc3,
-	0x4c, 0xf0, 0xe0, 0x4e, 0xee, 0x47, 0x71, 0x8d, 0xa0, 0x48, 0x6f, 0xfa, 0x8f, 0xe6, 0x4e, 0x51,
-	0xa7, 0xf9, 0x50, 0xc9, 0x04, 0x3a, 0x59, 0x87, 0x3c, 0x99, 0xde, 0x56, 0xef, 0x1d, 0xc3, 0xfe,
-	0x2d, 0x70, 0xe2, 0x7f, 0x19, 0x3b, 0x7b, 0xc7, 0xee, 0x15, 0xad, 0x0b, 0x37, 0x89, 0xd9, 0x7e,
-	0xfc, 0x65, 0x15, 0x3d, 0xc8, 0xcc, 0x53, 0x76, 0x30, 0x2d, 0xdc, 0xa1, 0x2c, 0xf0, 0xe2, 0xe0,
-	0xc3, 0x7b, 0xd8, 0xfd, 0x5d, 0xfb, 0x66, 0x51, 0xf7, 0x11, 0xff, 0x84, 0xdb, 0xe2, 0xcb, 0x59,
-	0xbe, 0x96, 0x23, 0xb8, 0x5b, 0xb4, 0xdf, 0x53, 0x6d, 0xa1, 0xcc, 0x5a, 0x5f, 0x43, 0xdd, 0xae,
-	0xa9, 0x47, 0x0b, 0x14, 0xfb, 0x14, 0x84, 0x25, 0x14, 0xfb, 0x14, 0x85, 0x17, 0x4c, 0xbd, 0x46,
-	0x06, 0x16, 0x3e, 0xb6, 0x1e, 0xac, 0xbf, 0xff, 0x83, 0x2f, 0x9f, 0xfa, 0xc9, 0xd9, 0xe4, 0x78,
-	0xa5, 0x1f, 0x8e, 0x1e, 0x0e, 0xa5, 0xb7, 0x51, 0xa4, 0xc2, 0x3d, 0x1c, 0x06, 0x83, 0x87, 0xd8,
-	0xec, 0xf1, 0x0c, 0xfe, 0x8a, 0xf7, 0xd7, 0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x6d,
-	0x27, 0xff, 0xf7, 0x7b, 0x00, 0x00,
+	// 11591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0xbd, 0x5b, 0x6c, 0x24, 0x49,
+	0x76, 0x1e, 0xdc, 0x59, 0x55, 0x24, 0xab, 0x4e, 0x55, 0x91, 0xc5, 0x60, 0x93, 0xac, 0xae, 0xbe,
+	0x4e, 0xee, 0xec, 0x4c, 0x6f, 0xef, 0x0c, 0xbb, 0xa7, 0x77, 0x66, 0x34, 0x9a, 0xd6, 0x5e, 0xaa,
+	0x49, 0x76, 0x93, 0xd3, 0x6c, 0x92, 0x93, 0x24, 0xb7, 0x77, 0x76, 0x7f, 0xfd, 0xb9, 0xc9, 0xaa,
+	0x20, 0x99, 0xdb, 0x55, 0x99, 0xb5, 0x99, 0x59, 0x64, 0x73, 0xc7, 0xe3, 0x07, 0xc1, 0x17, 0xc0,
+	0x2f, 0xc2, 0x42, 0xb0, 0x61, 0xc9, 0x80, 0x05, 0x48, 0x06, 0x0c, 0xc3, 0x80, 0xe5, 0x27, 0x41,
+	0x0f, 0x32, 0xfc, 0xe0, 0x07, 0xd9, 0x0f, 0x86, 0x00, 0x5f, 0xe0, 0x1b, 0x60, 0xc0, 0xf0, 0x05,
+	0x10, 0xfc, 0x60, 0xc0, 0xb0, 0xf5, 0x6c, 0x9c, 0xb8, 0x65, 0x44, 0x66, 0x16, 0xbb, 0x7b, 0x67,
+	0xb4, 0x2f, 0x64, 0xc5, 0x77, 0xe2, 0x7e, 0x39, 0x71, 0xe2, 0x9c, 0x13, 0x91, 0x50, 0x8b, 0x46,
+	0xbd, 0x95, 0x51, 0x14, 0x26, 0x21, 0x99, 0x1a, 0x04, 0xd1, 0xa8, 0xd7, 0xb9, 0x76, 0x1c, 0x86,
+	0xc7, 0x03, 0x7a, 0xd7, 0x1b, 0xf9, 0x77, 0xbd, 0x20, 0x08, 0x13, 0x2f, 0xf1, 0xc3, 0x20, 0xe6,
+	0x91, 0xec, 0x1f, 0xc3, 0xec, 0x63, 0x1a, 0xec, 0x51, 0xda, 0x77, 0xe8, 0x4f, 0xc7, 0x34, 0x4e,
+	0xc8, 0x37, 0x61, 0xde, 0xa3, 0x3f, 0xa3, 0xb4, 0xef, 0x8e, 0xbc, 0x38, 0x1e, 0x9d, 0x44, 0x5e,
+	0x4c, 0xdb, 0xd6, 0x2d, 0xeb, 0x76, 0xc3, 0x69, 0x71, 0xc2, 0xae, 0xc2, 0xc9, 0x1b, 0xd0, 0x88,
+	0x31, 0x2a, 0x0d, 0x92, 0x28, 0x1c, 0x9d, 0xb7, 0x4b, 0x2c, 0x5e, 0x1d, 0xb1, 0x75, 0x0e, 0xd9,
+	0x03, 0x98, 0x53, 0x25, 0xc4, 0xa3, 0x30, 0x88, 0x29, 0xb9, 0x07, 0x97, 0x7b, 0xfe, 0xe8, 0x84,
+	0x46, 0x2e, 0x4b, 0x3c, 0x0c, 0xe8, 0x30, 0x0c, 0xfc, 0x5e, 0xdb, 0xba, 0x55, 0xbe, 0x5d, 0x73,
+	0x08, 0xa7, 0x61, 0x8a, 0xa7, 0x82, 0x42, 0xde, 0x86, 0x39, 0x1a, 0x70, 0x9c, 0xf6, 0x59, 0x2a,
+	0x51, 0xd4, 0x6c, 0x0a, 0x63, 0x02, 0xfb, 0x0f, 0x4a, 0x30, 0xbf, 0x19, 0xf8, 0xc9, 0x33, 0x6f,
+	0x30, 0xa0, 0x89, 0x6c, 0xd3, 0xdb, 0x30, 0x77, 0xc6, 0x00, 0xd6, 0xa6, 0xb3, 0x30, 0xea, 0x8b,
+	0x16, 0xcd, 0x72, 0x78, 0x57, 0xa0, 0x13, 0x6b, 0x56, 0x9a, 0x58, 0xb3, 0xc2, 0xee, 0x2a, 0x4f,
+	0xe8, 0xae, 0xb7, 0x61, 0x2e, 0xa2, 0xbd, 0xf0, 0x94, 0x46, 0xe7, 0xee, 0x99, 0x1f, 0xf4, 0xc3,
+	0xb3, 0x76, 0xe5, 0x96, 0x75, 0x7b, 0xca, 0x99, 0x95, 0xf0, 0x33, 0x86, 0x92, 0x87, 0x30, 0xd7,
+	0x3b, 0xf1, 0x82, 0x80, 0x0e, 0xdc, 0x43, 0xaf, 0xf7, 0x7c, 0x3c, 0x8a, 0xdb, 0x53, 0xb7, 0xac,
+	0xdb, 0xf5, 0xfb, 0x57, 0x56, 0xd8, 0xa8, 0xae, 0xac, 0x9e, 0x78, 0xc1, 0x43, 0x46, 0xd9, 0x0b,
+	0xbc, 0x51, 0x7c, 0x12, 0x26, 0xce, 0xac, 0x48, 0xc1, 0xe1, 0x98, 0x7c, 0x1d, 0x66, 0xe3, 0xc4,
+	0x4b, 0xe8, 0x80, 0xc6, 0xb1, 0xeb, 0x07, 0x7e, 0xd2, 0x9e, 0xbe, 0x65, 0xdd, 0xae, 0x3a, 0x4d,
+	0x85, 0x62, 0x47, 0xd9, 0x0f, 0x80, 0xe8, 0x1d, 0x26, 0x86, 0xe8, 0xeb, 0x30, 0xeb, 0xf5, 0x87,
+	0x7e, 0xe0, 0x0e, 0xbd, 0x9e, 0x17, 0x85, 0x61, 0x20, 0x3a, 0xac, 0xc9, 0xd0, 0xa7, 0x02, 0xb4,
+	0xff, 0x95, 0x05, 0x0b, 0x07, 0xc1, 0x20, 0xec, 0x3d, 0xff, 0x05, 0x3b, 0xbc, 0xa0, 0x47, 0x4a,
+	0xaf, 0xda, 0x23, 0xe5, 0x2f, 0xdf, 0x23, 0x95, 0xa2, 0x1e, 0x59, 0x82, 0xcb, 0x66, 0x9b, 0x78,
+	0x9f, 0xd8, 0xff, 0xd4, 0x82, 0x45, 0x2c, 0xe5, 0x98, 0xca, 0xea, 0xcb, 0xe6, 0x7e, 0x03, 0x5a,
+	0xbd, 0x71, 0x14, 0xd1, 0x20, 0xd7, 0xde, 0x39, 0x81, 0xab, 0x06, 0xbf, 0x01, 0x8d, 0x80, 0x9e,
+	0xa5, 0xd1, 0xc4, 0x8a, 0x09, 0xe8, 0x99, 0x8a, 0x92, 0xaf, 0x66, 0xb9, 0xa0, 0x9a, 0xe4, 0x3d,
+	0x58, 0xc4, 0x9c, 0xe4, 0x00, 0xb9, 0x51, 0x18, 0x26, 0xee, 0x73, 0x7a, 0x2e, 0x1a, 0x45, 0x02,
+	0x7a, 0x26, 0xc7, 0xc9, 0x09, 0xc3, 0xe4, 0x09, 0x3d, 0xb7, 0xbf, 0x0b, 0x4b, 0xd9, 0x06, 0xbc,
+	0xde, 0x78, 0xff, 0x17, 0x0b, 0x2a, 0x07, 0xc9, 0x8b, 0x90, 0xac, 0x40, 0x25, 0x39, 0x1f, 0x71,
+	0xc6, 0x30, 0x7b, 0x9f, 0x88, 0x31, 0xe8, 0xf6, 0xfb, 0x11, 0x8d, 0xe3, 0xfd, 0xf3, 0x11, 0x75,
+	0x1a, 0x1e, 0x0f, 0xb8, 0x18, 0x8f, 0xb4, 0x61, 0x46, 0x84, 0x59, 0x8b, 0x6b, 0x8e, 0x0c, 0x92,
+	0x1b, 0x00, 0xde, 0x30, 0x1c, 0x07, 0x89, 0x1b, 0x7b, 0xbc, 0xa5, 0x65, 0x47, 0x43, 0xc8, 0x35,
+	0xa8, 0x8d, 0x9e, 0xbb, 0x71, 0x2f, 0xf2, 0x47, 0x7c, 0xbc, 0x6a, 0x4e, 0x0a, 0x90, 0x6f, 0x42,
+	0x35, 0x1c, 0x27, 0xa3, 0xd0, 0x0f, 0x12, 0xb1, 0x42, 0xe6, 0x44, 0x5d, 0x76, 0xc6, 0xc9, 0x2e,
+	0xc2, 0x8e, 0x8a, 0x40, 0xde, 0x84, 0x66, 0x2f, 0x0c, 0x8e, 0xfc, 0x68, 0xc8, 0x79, 0x20, 0x5b,
+	0x10, 0x65, 0xc7, 0x04, 0xed, 0x3f, 0x2d, 0x41, 0x7d, 0x3f, 0xf2, 0x82, 0xd8, 0xeb, 0x21, 0x80,
+	0x55, 0x4f, 0x5e, 0xb8, 0x27, 0x5e, 0x7c, 0xc2, 0x5a, 0x5b, 0x73, 0x64, 0x90, 0x2c, 0xc1, 0x34,
+	0xaf, 0x28, 0x6b, 0x53, 0xd9, 0x11, 0x21, 0xf2, 0x0e, 0xcc, 0x07, 0xe3, 0xa1, 0x6b, 0x96, 0x55,
+	0x66, 0xd3, 0x3a, 0x4f, 0xc0, 0x0e, 0x38, 0xc4, 0xd9, 0xc6, 0x8b, 0xe0, 0x2d, 0xd4, 0x10, 0x62,
+	0x43, 0x43, 0x84, 0xa8, 0x7f, 0x7c, 0xc2, 0x9b, 0x39, 0xe5, 0x18, 0x18, 0xe6, 0x91, 0xf8, 0x43,
+	0xea, 0xc6, 0x89, 0x37, 0x1c, 0x89, 0x66, 0x69, 0x08, 0xa3, 0x87, 0x89, 0x37, 0x70, 0x8f, 0x28,
+	0x8d, 0xdb, 0x33, 0x82, 0xae, 0x10, 0xf2, 0x16, 0xcc, 0xf6, 0x69, 0x9c, 0xb8, 0x62, 0x50, 0x68,
+	0xdc, 0xae, 0x32, 0x8e, 0x97, 0x41, 0x31, 0x9f, 0xc8, 0x3b, 0x73, 0xb1, 0x03, 0xe8, 0x8b, 0x76,
+	0x8d, 0xd7, 0x35, 0x45, 0xc8, 0x65, 0x98, 0x1a, 0x78, 0x87, 0x74, 0xd0, 0x06, 0x46, 0xe2, 0x01,
+	0xbb, 0x0d, 0x4b, 0x8f, 0x69, 0xa2, 0xf5, 0x69, 0x2c, 0x16, 0x8e, 0xbd, 0x05, 0x44, 0x83, 0xd7,
+	0x68, 0xe2, 0xf9, 0x83, 0x98, 0x7c, 0x08, 0x8d, 0x44, 0x8b, 0xcc, 0xf6, 0x85, 0xba, 0x9a, 0x64,
+	0x5a, 0x02, 0xc7, 0x88, 0x67, 0x9f, 0x40, 0xf5, 0x11, 0xa5, 0x5b, 0xfe, 0xd0, 0x4f, 0xc8, 0x12,
+	0x4c, 0x1d, 0xf9, 0x2f, 0x28, 0x5f, 0x87, 0xe5, 0x8d, 0x4b, 0x0e, 0x0f, 0x92, 0x9b, 0x00, 0xec,
+	0x87, 0x3b, 0x54, 0xd3, 0x6d, 0xe3, 0x92, 0x53, 0x63, 0xd8, 0x53, 0x9c, 0x6f, 0x1d, 0x98, 0x19,
+	0xd1, 0xa8, 0x47, 0xe5, 0xa8, 0x6e, 0x5c, 0x72, 0x24, 0xf0, 0x70, 0x06, 0xa6, 0x06, 0x98, 0xbb,
+	0xfd, 0x27, 0x53, 0x50, 0xdf, 0xa3, 0x81, 0x62, 0x00, 0x04, 0x2a, 0xd8, 0x53, 0x62, 0xd1, 0xb0,
+	0xdf, 0xe4, 0x6b, 0x50, 0xc7, 0xff, 0x6e, 0x9c, 0x44, 0x7e, 0x70, 0xcc, 0xa7, 0xfd, 0xc3, 0x52,
+	0xdb, 0x72, 0x00, 0xe1, 0x3d, 0x86, 0x92, 0x16, 0x94, 0xbd, 0xa1, 0x9c, 0xf6, 0xf8, 0x93, 0x5c,
+	0x81, 0xaa, 0x37, 0x4c, 0x78, 0xf5, 0x1a, 0x0c, 0x9e, 0xf1, 0x86, 0x09, 0xab, 0xda, 0x1b, 0xd0,
+	0x18, 0x79, 0xe7, 0x43, 0x64, 0x33, 0x6a, 0xae, 0x34, 0x9c, 0xba, 0xc0, 0x36, 0x70, 0xb2, 0xdc,
+	0x87, 0x05, 0x3d, 0x8a, 0x2c, 0x7c, 0x4a, 0x15, 0x3e, 0xaf, 0xc5, 0x16, 0x75, 0x78, 0x1b, 0xe6,
+	0x64, 0x9a, 0x88, 0xb7, 0x87, 0xcd, 0xa0, 0x9a, 0x33, 0x2b, 0x60, 0xd9, 0xca, 0xdb, 0xd0, 0x3a,
+	0xf2, 0x03, 0x6f, 0xe0, 0xf6, 0x06, 0xc9, 0xa9, 0xdb, 0xa7, 0x83, 0xc4, 0x63, 0x73, 0x69, 0xca,
+	0x99, 0x65, 0xf8, 0xea, 0x20, 0x39, 0x5d, 0x43, 0x94, 0xbc, 0x03, 0xb5, 0x23, 0x4a, 0x5d, 0xd6,
+	0x59, 0xed, 0xaa, 0xb1, 0x2e, 0xe5, 0x08, 0x39, 0xd5, 0x23, 0xf1, 0x8b, 0xbc, 0x03, 0xad, 0x70,
+	0x9c, 0x1c, 0x87, 0x7e, 0x70, 0xec, 0x22, 0xcb, 0x76, 0xfd, 0x3e, 0x9b, 0x5b, 0x95, 0x87, 0xa5,
+	0x7b, 0x96, 0x33, 0x2b, 0x69, 0xc8, 0xba, 0x36, 0xfb, 0xe4, 0x2d, 0x98, 0x1b, 0x78, 0x71, 0xe2,
+	0x9e, 0x84, 0x23, 0x77, 0x34, 0x3e, 0x44, 0x8e, 0xd7, 0xe4, 0xbc, 0x0a, 0xe1, 0x8d, 0x70, 0xb4,
+	0xcb, 0x40, 0x72, 0x1d, 0x80, 0xd5, 0x93, 0x57, 0x02, 0x27, 0x64, 0xd3, 0xa9, 0x21, 0xc2, 0x0b,
+	0xfd, 0x0c, 0x16, 0xd8, 0xf0, 0xf4, 0xc6, 0x71, 0x12, 0x0e, 0x5d, 0xdc, 0x6e, 0xa2, 0x7e, 0xdc,
+	0xae, 0xb3, 0xb9, 0xf6, 0x0d, 0x51, 0x59, 0x6d, 0x8c, 0x57, 0xd6, 0x68, 0x9c, 0xac, 0xb2, 0xc8,
+	0x0e, 0x8f, 0x8b, 0x12, 0xce, 0xb9, 0x33, 0xdf, 0xcf, 0xe2, 0xe4, 0x1d, 0x20, 0xde, 0x60, 0x10,
+	0x9e, 0xb9, 0x31, 0x1d, 0x1c, 0xb9, 0xa2, 0x13, 0xdb, 0xb3, 0x8c, 0x2d, 0xb7, 0x18, 0x65, 0x8f,
+	0x0e, 0x8e, 0x76, 0x39, 0x4e, 0x3e, 0x84, 0x26, 0xab, 0xc8, 0x11, 0xf5, 0x92, 0x71, 0x44, 0xe3,
+	0xf6, 0xdc, 0xad, 0xf2, 0xed, 0xd9, 0xfb, 0xf3, 0xaa, 0xbf, 0x18, 0xfc, 0xd0, 0x4f, 0x9c, 0x06,
+	0xc6, 0x13, 0xe1, 0xb8, 0xb3, 0x06, 0x4b, 0xc5, 0x55, 0xc2, 0x49, 0x85, 0xbd, 0x82, 0x93, 0xb1,
+	0xe2, 0xe0, 0x4f, 0x5c, 0x97, 0xa7, 0xde, 0x60, 0x4c, 0xc5, 0x76, 0xc3, 0x03, 0x1f, 0x97, 0x3e,
+	0xb2, 0xec, 0x3f, 0xb2, 0xa0, 0xc1, 0x5b, 0x29, 0x76, 0x82, 0x37, 0xa1, 0x29, 0x67, 0x03, 0x8d,
+	0xa2, 0x30, 0x12, 0x4c, 0xcf, 0x04, 0xc9, 0x1d, 0x68, 0x49, 0x60, 0x14, 0x51, 0x7f, 0xe8, 0x1d,
+	0xcb, 0xbc, 0x73, 0x38, 0xb9, 0x9f, 0xe6, 0x18, 0x85, 0xe3, 0x84, 0x8a, 0x8d, 0xbb, 0x21, 0x1a,
+	0xe8, 0x20, 0xe6, 0x98, 0x51, 0x90, 0xe9, 0x15, 0x4c, 0x75, 0x03, 0xb3, 0xff, 0xa6, 0x05, 0x04,
+	0xab, 0xbe, 0x1f, 0xf2, 0x2c, 0xc4, 0x2c, 0xcd, 0xae, 0x12, 0xeb, 0x95, 0x57, 0x49, 0xe9, 0xa2,
+	0x55, 0x62, 0xc3, 0x14, 0xaf, 0x7d, 0xa5, 0xa0, 0xf6, 0x9c, 0xf4, 0x49, 0xa5, 0x5a, 0x6e, 0x55,
+	0xec, 0xff, 0x58, 0x86, 0xcb, 0xab, 0x5c, 0xf2, 0xe8, 0xf6, 0x7a, 0x74, 0xa4, 0xd6, 0xcf, 0x4d,
+	0xa8, 0x07, 0x61, 0x9f, 0xca, 0x59, 0xcb, 0x2b, 0x06, 0x08, 0x69, 0x53, 0xf6, 0xc4, 0xf3, 0x03,
+	0x5e, 0x71, 0xde, 0x9f, 0x35, 0x86, 0xb0, 0x6a, 0xbf, 0x05, 0x73, 0x23, 0x1a, 0xf4, 0xf5, 0x65,
+	0xc2, 0x25, 0xcd, 0xa6, 0x80, 0xc5, 0x0a, 0xb9, 0x09, 0xf5, 0xa3, 0x31, 0x8f, 0x87, 0xcc, 0xa5,
+	0xc2, 0xe6, 0x01, 0x08, 0xa8, 0xcb, 0x79, 0xcc, 0x68, 0x1c, 0x9f, 0x30, 0xea, 0x14, 0xa3, 0xce,
+	0x60, 0x18, 0x49, 0xd7, 0x01, 0xfa, 0xe3, 0x38, 0x11, 0xab, 0x66, 0x9a, 0x11, 0x6b, 0x88, 0xf0,
+	0x55, 0xf3, 0x2e, 0x2c, 0x0c, 0xbd, 0x17, 0x2e, 0x9b, 0x3f, 0xae, 0x1f, 0xb8, 0x47, 0x03, 0xb6,
+	0x27, 0xcd, 0xb0, 0x78, 0xad, 0xa1, 0xf7, 0xe2, 0xfb, 0x48, 0xd9, 0x0c, 0x1e, 0x31, 0x1c, 0x59,
+	0x8b, 0x94, 0xda, 0x22, 0x1a, 0xd3, 0xe8, 0x94, 0x32, 0x6e, 0x50, 0x51, 0xa2, 0x99, 0xc3, 0x51,
+	0xac, 0x11, 0x4a, 0x1f, 0x27, 0xc9, 0xa0, 0xc7, 0x97, 0xbe, 0x33, 0x33, 0xf4, 0x83, 0x8d, 0x64,
+	0xd0, 0x23, 0xd7, 0x00, 0x90, 0x97, 0x8c, 0x68, 0xe4, 0x3e, 0x3f, 0x63, 0xeb, 0xb8, 0xc2, 0x78,
+	0xc7, 0x2e, 0x8d, 0x9e, 0x9c, 0x91, 0xab, 0x50, 0xeb, 0xc5, 0x8c, 0x19, 0x79, 0xe7, 0xed, 0x3a,
+	0x5b, 0xe4, 0xd5, 0x5e, 0x8c, 0x6c, 0xc8, 0x3b, 0xc7, 0x85, 0x88, 0xb5, 0xf5, 0xd8, 0x28, 0xd0,
+	0x3e, 0xcb, 0x3e, 0x66, 0x5c, 0xb5, 0xc9, 0x2a, 0xdb, 0x15, 0x04, 0x2c, 0x27, 0x26, 0x5f, 0x83,
+	0xa6, 0xac, 0xec, 0xd1, 0xc0, 0x3b, 0x8e, 0x19, 0x5b, 0x69, 0x3a, 0x0d, 0x01, 0x3e, 0x42, 0xcc,
+	0x7e, 0x06, 0x8b, 0x99, 0xb1, 0x15, 0xeb, 0x06, 0x85, 0x01, 0x86, 0xb0, 0x71, 0xad, 0x3a, 0x22,
+	0x54, 0x34, 0x68, 0xa5, 0x82, 0x41, 0xb3, 0x7f, 0xcf, 0x82, 0x86, 0xc8, 0x99, 0xc9, 0x2d, 0xe4,
+	0x1e, 0x10, 0x39, 0x8a, 0xc9, 0x0b, 0xbf, 0xef, 0x1e, 0x9e, 0x27, 0x34, 0xe6, 0x93, 0x66, 0xe3,
+	0x92, 0x53, 0x40, 0x43, 0x3e, 0x6a, 0xa0, 0x71, 0x12, 0xf1, 0x39, 0xbd, 0x71, 0xc9, 0xc9, 0x51,
+	0x70, 0x89, 0xa1, 0x64, 0x34, 0x4e, 0x5c, 0x3f, 0xe8, 0xd3, 0x17, 0x6c, 0x2a, 0x35, 0x1d, 0x03,
+	0x7b, 0x38, 0x0b, 0x0d, 0x3d, 0x9d, 0xfd, 0x13, 0xa8, 0x4a, 0xb9, 0x8a, 0xc9, 0x14, 0x99, 0x7a,
+	0x39, 0x1a, 0x42, 0x3a, 0x50, 0x35, 0x6b, 0xe1, 0x54, 0x5f, 0xa7, 0x6c, 0xfb, 0x3b, 0xd0, 0xda,
+	0xc2, 0x49, 0x14, 0xe0, 0xa4, 0x15, 0xc2, 0xe2, 0x12, 0x4c, 0x6b, 0x8b, 0xa7, 0xe6, 0x88, 0x10,
+	0xee, 0xbf, 0x27, 0x61, 0x9c, 0x88, 0x72, 0xd8, 0x6f, 0xfb, 0x4f, 0x2c, 0x20, 0xeb, 0x71, 0xe2,
+	0x0f, 0xbd, 0x84, 0x3e, 0xa2, 0x8a, 0x3d, 0xec, 0x40, 0x03, 0x73, 0xdb, 0x0f, 0xbb, 0x5c, 0x74,
+	0xe3, 0xc2, 0xc5, 0x37, 0xc5, 0x72, 0xce, 0x27, 0x58, 0xd1, 0x63, 0x73, 0x96, 0x6f, 0x64, 0x80,
+	0xab, 0x2d, 0xf1, 0xa2, 0x63, 0x9a, 0x30, 0xb9, 0x4e, 0x1c, 0x5f, 0x80, 0x43, 0xab, 0x61, 0x70,
+	0xd4, 0xf9, 0x2e, 0xcc, 0xe7, 0xf2, 0xd0, 0x79, 0x74, 0xad, 0x80, 0x47, 0x97, 0x75, 0x1e, 0xdd,
+	0x83, 0x05, 0xa3, 0x5e, 0x62, 0xc6, 0xb5, 0x61, 0x06, 0x17, 0x06, 0x0a, 0x0a, 0x16, 0x17, 0x14,
+	0x44, 0x90, 0xdc, 0x87, 0xcb, 0x47, 0x94, 0x46, 0x5e, 0xc2, 0x82, 0x6c, 0xe9, 0xe0, 0x98, 0x88,
+	0x9c, 0x0b, 0x69, 0xf6, 0xff, 0x2e, 0xc1, 0x1c, 0x72, 0xd3, 0xa7, 0x5e, 0x70, 0x2e, 0xfb, 0x6a,
+	0xab, 0xb0, 0xaf, 0x6e, 0x6b, 0x9b, 0xa3, 0x16, 0xfb, 0x75, 0x3b, 0xaa, 0x9c, 0xed, 0x28, 0x72,
+	0x0b, 0x1a, 0x46, 0x75, 0xa7, 0xb8, 0x9c, 0x1a, 0x7b, 0xc9, 0x2e, 0x8d, 0x1e, 0x9e, 0x27, 0x34,
+	0x95, 0x2f, 0xa7, 0x35, 0xf9, 0x12, 0x79, 0x00, 0x32, 0x0f, 0xcc, 0x35, 0x16, 0x02, 0x09, 0x72,
+	0x13, 0xcc, 0x33, 0xc6, 0x03, 0x7a, 0x8c, 0x2b, 0xcd, 0x1d, 0x07, 0x42, 0xee, 0xa6, 0x7d, 0xc6,
+	0x84, 0xaa, 0x4e, 0x8b, 0x11, 0x0e, 0x52, 0x9c, 0xbc, 0x0b, 0x35, 0x79, 0x5a, 0x88, 0xdb, 0xb5,
+	0x5b, 0x65, 0x4d, 0x6e, 0x51, 0xe7, 0x89, 0x34, 0xc6, 0x97, 0x1f, 0xd9, 0xb7, 0xa0, 0x95, 0xf6,
+	0xa2, 0x18, 0x56, 0x02, 0x15, 0x5c, 0x27, 0x22, 0x03, 0xf6, 0xdb, 0xfe, 0x87, 0x25, 0x1e, 0x71,
+	0x35, 0xf4, 0x95, 0xf0, 0x8c, 0x11, 0x51, 0x32, 0x97, 0x11, 0xf1, 0xf7, 0xc4, 0x23, 0xc9, 0x57,
+	0xd0, 0xf7, 0x57, 0xa0, 0x1a, 0x63, 0x3f, 0x7a, 0x83, 0x81, 0xd0, 0x24, 0xcc, 0x60, 0xb8, 0x3b,
+	0x18, 0xa4, 0xc3, 0x32, 0x33, 0x71, 0x58, 0xaa, 0xaf, 0x32, 0x2c, 0xb5, 0x57, 0x19, 0x16, 0x78,
+	0xd9, 0xb0, 0xd8, 0x6f, 0xc3, 0xbc, 0xd6, 0x59, 0x17, 0x74, 0xeb, 0x36, 0x90, 0x2d, 0x3f, 0x4e,
+	0x0e, 0x02, 0x2c, 0x51, 0x6d, 0xd3, 0x46, 0xbd, 0xad, 0x4c, 0xbd, 0x91, 0xe8, 0xbd, 0x10, 0xc4,
+	0x92, 0x20, 0x7a, 0x2f, 0x18, 0xd1, 0xfe, 0x08, 0x16, 0x8c, 0xfc, 0x44, 0xd1, 0x6f, 0xc0, 0xd4,
+	0x38, 0x79, 0x11, 0xca, 0x83, 0x4c, 0x5d, 0x54, 0x1d, 0x0f, 0xd2, 0x0e, 0xa7, 0xd8, 0x0f, 0x60,
+	0x7e, 0x9b, 0x9e, 0x09, 0x36, 0x27, 0x2b, 0xf2, 0xd6, 0x4b, 0x0f, 0xd9, 0x8c, 0x6e, 0xaf, 0x00,
+	0xd1, 0x13, 0xa7, 0xec, 0x41, 0x1e, 0xb9, 0x2d, 0xe3, 0xc8, 0x6d, 0xbf, 0x05, 0x64, 0xcf, 0x3f,
+	0x0e, 0x9e, 0xd2, 0x38, 0xf6, 0x8e, 0x15, 0x63, 0x6c, 0x41, 0x79, 0x18, 0x1f, 0x0b, 0x46, 0x8e,
+	0x3f, 0xed, 0x6f, 0xc1, 0x82, 0x11, 0x4f, 0x64, 0x7c, 0x0d, 0x6a, 0xb1, 0x7f, 0x1c, 0x30, 0x31,
+	0x54, 0x64, 0x9d, 0x02, 0xf6, 0x23, 0xb8, 0xfc, 0x7d, 0x1a, 0xf9, 0x47, 0xe7, 0x2f, 0xcb, 0xde,
+	0xcc, 0xa7, 0x94, 0xcd, 0x67, 0x1d, 0x16, 0x33, 0xf9, 0x88, 0xe2, 0xf9, 0x6a, 0x12, 0x23, 0x59,
+	0x75, 0x78, 0x40, 0xdb, 0x19, 0x4a, 0xfa, 0xce, 0x60, 0x1f, 0x00, 0x59, 0x0d, 0x83, 0x80, 0xf6,
+	0x92, 0x5d, 0x4a, 0xa3, 0x54, 0xc9, 0x99, 0x2e, 0x9d, 0xfa, 0xfd, 0x65, 0xd1, 0xb3, 0xd9, 0xed,
+	0x46, 0xac, 0x29, 0x02, 0x95, 0x11, 0x8d, 0x86, 0x2c, 0xe3, 0xaa, 0xc3, 0x7e, 0xdb, 0x8b, 0xb0,
+	0x60, 0x64, 0x2b, 0x54, 0x44, 0xef, 0xc1, 0xe2, 0x9a, 0x1f, 0xf7, 0xf2, 0x05, 0xb6, 0x61, 0x66,
+	0x34, 0x3e, 0x74, 0x53, 0xc6, 0x20, 0x83, 0x78, 0x38, 0xce, 0x26, 0x11, 0x99, 0xfd, 0x35, 0x0b,
+	0x2a, 0x1b, 0xfb, 0x5b, 0xab, 0xb8, 0x93, 0xfa, 0x41, 0x2f, 0x1c, 0xa2, 0x8c, 0xca, 0x1b, 0xad,
+	0xc2, 0x13, 0x17, 0xfc, 0x35, 0xa8, 0x31, 0xd1, 0x16, 0xb5, 0x04, 0x42, 0x4a, 0x4c, 0x01, 0xd4,
+	0x50, 0xd0, 0x17, 0x23, 0x3f, 0x62, 0x2a, 0x08, 0xa9, 0x58, 0xa8, 0xb0, 0x4d, 0x38, 0x4f, 0xb0,
+	0xff, 0xf9, 0x0c, 0xcc, 0x08, 0xd1, 0x84, 0x95, 0xd7, 0x4b, 0xfc, 0x53, 0x9a, 0x8a, 0x39, 0x18,
+	0xc2, 0x63, 0x43, 0x44, 0x87, 0x61, 0xa2, 0xa4, 0x5b, 0x3e, 0x0c, 0x26, 0x88, 0xb1, 0xa4, 0x88,
+	0xc5, 0x75, 0x36, 0x65, 0x1e, 0xcb, 0x00, 0xc9, 0x35, 0x98, 0x91, 0xa2, 0x52, 0x45, 0x1d, 0x03,
+	0x25, 0x84, 0xbd, 0xd1, 0xf3, 0x46, 0x5e, 0xcf, 0x4f, 0xce, 0x05, 0x97, 0x52, 0x61, 0xcc, 0x7f,
+	0x10, 0xf6, 0x3c, 0xd4, 0x11, 0x0e, 0xbc, 0xa0, 0x47, 0xa5, 0x86, 0xc7, 0x00, 0x51, 0xdb, 0x21,
+	0xaa, 0x25, 0xa3, 0x71, 0x8d, 0x48, 0x06, 0x45, 0x09, 0xa7, 0x17, 0x0e, 0x87, 0x3e, 0x9e, 0xcd,
+	0xb8, 0xe0, 0x5a, 0x76, 0x34, 0x84, 0xb5, 0x86, 0x87, 0xce, 0x78, 0x0f, 0xd6, 0xa4, 0x3e, 0x49,
+	0x03, 0x31, 0x97, 0x8c, 0xfc, 0x5a, 0x76, 0x34, 0x04, 0xc7, 0x62, 0x1c, 0xc4, 0x34, 0x49, 0x06,
+	0xb4, 0xaf, 0x2a, 0x54, 0x67, 0xd1, 0xf2, 0x04, 0x72, 0x0f, 0x16, 0xb8, 0xde, 0x26, 0xf6, 0x92,
+	0x30, 0x3e, 0xf1, 0x63, 0x37, 0xc6, 0xc3, 0x25, 0xd7, 0x14, 0x14, 0x91, 0xc8, 0x47, 0xb0, 0x9c,
+	0x81, 0x23, 0xda, 0xa3, 0xfe, 0x29, 0xed, 0x33, 0x01, 0xb7, 0xec, 0x4c, 0x22, 0x93, 0x5b, 0x50,
+	0x47, 0x75, 0xd5, 0x78, 0xd4, 0xf7, 0x50, 0xc4, 0x9b, 0x65, 0xa2, 0xb7, 0x0e, 0x91, 0xf7, 0x40,
+	0x4a, 0xb1, 0x42, 0xb6, 0x9e, 0x33, 0x38, 0x1c, 0xce, 0x5e, 0xc7, 0x8c, 0x41, 0xae, 0xe9, 0x02,
+	0x7b, 0x4b, 0x9c, 0xca, 0x25, 0xc0, 0xd6, 0x49, 0xe4, 0x9f, 0x7a, 0x09, 0x6d, 0xcf, 0xf3, 0x3d,
+	0x46, 0x04, 0x31, 0x9d, 0x1f, 0xf8, 0x89, 0xef, 0x25, 0x61, 0xd4, 0x26, 0x8c, 0x96, 0x02, 0xd8,
+	0x89, 0x6c, 0x7e, 0xc4, 0x89, 0x97, 0x8c, 0x63, 0x21, 0xbf, 0x2f, 0xb0, 0xc9, 0x95, 0x27, 0x90,
+	0x0f, 0x61, 0x89, 0xcf, 0x08, 0x46, 0x12, 0x27, 0x13, 0x26, 0x48, 0x5d, 0x66, 0x3d, 0x32, 0x81,
+	0x8a, 0x5d, 0x29, 0xa6, 0x48, 0x2e, 0xe1, 0x22, 0xef, 0xca, 0x09, 0x64, 0xac, 0x1f, 0xd6, 0xc0,
+	0xef, 0xb9, 0x22, 0x06, 0x2e, 0x91, 0x25, 0xd6, 0x8a, 0x3c, 0x01, 0xa7, 0xf8, 0xc0, 0x3f, 0xa2,
+	0xa8, 0xc0, 0x6b, 0x2f, 0xf3, 0x29, 0x2e, 0xc3, 0xb8, 0x00, 0xc7, 0x23, 0x46, 0x69, 0xf3, 0x05,
+	0xcf, 0x43, 0x6c, 0x32, 0x0e, 0xc2, 0x98, 0x4a, 0x6d, 0x5d, 0xfb, 0x8a, 0x58, 0x5a, 0x3a, 0x68,
+	0xff, 0xae, 0xc5, 0xb7, 0x28, 0xb1, 0x9c, 0x63, 0xed, 0x68, 0xca, 0x17, 0xb2, 0x1b, 0x06, 0x83,
+	0x73, 0xb1, 0xb6, 0x81, 0x43, 0x3b, 0xc1, 0xe0, 0x1c, 0x0f, 0x47, 0x7e, 0xa0, 0x47, 0xe1, 0xdc,
+	0xb0, 0xe1, 0x07, 0x5a, 0xa4, 0x9b, 0x50, 0x1f, 0x8d, 0x0f, 0x07, 0x7e, 0x8f, 0x47, 0xe1, 0x6a,
+	0x6b, 0xe0, 0x10, 0x8b, 0x80, 0x67, 0x73, 0x3e, 0x9e, 0x3c, 0x06, 0x57, 0x55, 0xd7, 0x05, 0x86,
+	0x51, 0xec, 0x87, 0x70, 0xd9, 0xac, 0xa0, 0x60, 0xfb, 0x77, 0xa0, 0x2a, 0xb8, 0x84, 0x54, 0xd2,
+	0xcc, 0x6a, 0x9a, 0x7f, 0x3c, 0x4a, 0x2a, 0xba, 0xfd, 0x5b, 0xd3, 0xb0, 0x20, 0xd0, 0x55, 0x6c,
+	0xfe, 0xde, 0x78, 0x38, 0xf4, 0xa2, 0x02, 0xf6, 0x63, 0xbd, 0x84, 0xfd, 0x94, 0xf2, 0xec, 0xe7,
+	0x86, 0x71, 0x46, 0xe7, 0xfc, 0x4b, 0x43, 0xc8, 0x6d, 0x98, 0xc3, 0x2e, 0xe7, 0x47, 0x26, 0x5d,
+	0xa7, 0x9b, 0x85, 0xf3, 0x2c, 0x73, 0xaa, 0x88, 0x65, 0xea, 0xec, 0x6e, 0x3a, 0xc3, 0xee, 0x6c,
+	0x68, 0xf0, 0xe1, 0x15, 0x1c, 0x7c, 0x46, 0x1c, 0x58, 0x35, 0x0c, 0xeb, 0x93, 0x65, 0x2e, 0x9c,
+	0x93, 0xcd, 0x15, 0xb1, 0x16, 0x54, 0x19, 0xe3, 0x0e, 0xa1, 0xc5, 0xae, 0x09, 0xd6, 0x92, 0x27,
+	0x91, 0x47, 0x00, 0xbc, 0x2c, 0x26, 0xa6, 0x00, 0x13, 0x53, 0xde, 0x32, 0x47, 0x45, 0xef, 0xff,
+	0x15, 0x0c, 0x8c, 0x23, 0xca, 0x44, 0x17, 0x2d, 0x25, 0xd9, 0x82, 0xd9, 0x70, 0x44, 0x03, 0x37,
+	0x5d, 0xe0, 0x75, 0x96, 0xd7, 0x9b, 0x17, 0xe4, 0xb5, 0x29, 0xe3, 0x3a, 0x99, 0xb4, 0x64, 0x9b,
+	0x8f, 0x00, 0xd5, 0xb2, 0x6b, 0xbc, 0x46, 0x76, 0xd9, 0xc4, 0xf6, 0xdf, 0xb0, 0xa0, 0xae, 0xd5,
+	0x9c, 0x2c, 0xc2, 0xfc, 0xea, 0xce, 0xce, 0xee, 0xba, 0xd3, 0xdd, 0xdf, 0xfc, 0xfe, 0xba, 0xbb,
+	0xba, 0xb5, 0xb3, 0xb7, 0xde, 0xba, 0x84, 0xf0, 0xd6, 0xce, 0x6a, 0x77, 0xcb, 0x7d, 0xb4, 0xe3,
+	0xac, 0x4a, 0xd8, 0x22, 0x4b, 0x40, 0x9c, 0xf5, 0xa7, 0x3b, 0xfb, 0xeb, 0x06, 0x5e, 0x22, 0x2d,
+	0x68, 0x3c, 0x74, 0xd6, 0xbb, 0xab, 0x1b, 0x02, 0x29, 0x93, 0xcb, 0xd0, 0x7a, 0x74, 0xb0, 0xbd,
+	0xb6, 0xb9, 0xfd, 0xd8, 0x5d, 0xed, 0x6e, 0xaf, 0xae, 0x6f, 0xad, 0xaf, 0xb5, 0x2a, 0xa4, 0x09,
+	0xb5, 0xee, 0xc3, 0xee, 0xf6, 0xda, 0xce, 0xf6, 0xfa, 0x5a, 0x6b, 0xca, 0xfe, 0x55, 0xa8, 0xa9,
+	0xaa, 0x92, 0x3a, 0xcc, 0x1c, 0x6c, 0x3f, 0xd9, 0xde, 0x79, 0xb6, 0xdd, 0xba, 0x44, 0x6a, 0x30,
+	0xc5, 0xca, 0x6f, 0x59, 0x04, 0x60, 0x9a, 0x97, 0xd9, 0x2a, 0x91, 0x2a, 0x54, 0x1e, 0xee, 0xec,
+	0x6f, 0xb4, 0xca, 0xf6, 0x7f, 0x46, 0xfb, 0x15, 0xb6, 0xad, 0x9f, 0x5d, 0xfd, 0xb7, 0xa0, 0xde,
+	0x0b, 0xc3, 0x11, 0x8d, 0x3c, 0x6d, 0x67, 0xd7, 0x21, 0x5c, 0xd9, 0x9c, 0x27, 0x1e, 0x85, 0x51,
+	0x8f, 0x8a, 0xc5, 0x0f, 0x0c, 0x7a, 0x84, 0x08, 0xae, 0x6c, 0x31, 0x6f, 0x79, 0x0c, 0xbe, 0xf6,
+	0xeb, 0x1c, 0xe3, 0x51, 0x96, 0x60, 0xfa, 0x30, 0xa2, 0x5e, 0xef, 0x44, 0x2c, 0x7b, 0x11, 0x42,
+	0xeb, 0x99, 0x54, 0x32, 0xf4, 0x70, 0x5a, 0x0d, 0x68, 0x9f, 0x2d, 0x85, 0xaa, 0x33, 0x27, 0xf0,
+	0x55, 0x01, 0xe3, 0x26, 0xe0, 0x1d, 0x7a, 0x41, 0x3f, 0x0c, 0x68, 0x5f, 0x1c, 0x42, 0x52, 0xc0,
+	0xde, 0x85, 0xa5, 0x6c, 0xfb, 0x04, 0xf3, 0xf8, 0x50, 0x63, 0x1e, 0x5c, 0x08, 0xef, 0x4c, 0x9e,
+	0x0b, 0x1a, 0x23, 0xf9, 0xaf, 0x65, 0xa8, 0xa0, 0x4c, 0x36, 0x59, 0x7e, 0xd3, 0xc5, 0xec, 0x72,
+	0xce, 0xb2, 0xc5, 0x34, 0x21, 0x7c, 0x87, 0x16, 0x5a, 0xb8, 0x14, 0x49, 0xe9, 0x11, 0xed, 0x9d,
+	0x0a, 0x3d, 0x9c, 0x86, 0xe0, 0xca, 0xc7, 0x23, 0x19, 0x4b, 0x2d, 0x56, 0xbe, 0x0c, 0x4b, 0x1a,
+	0x4b, 0x39, 0x93, 0xd2, 0x58, 0xba, 0x36, 0xcc, 0xf8, 0xc1, 0x61, 0x38, 0x0e, 0xe4, 0x39, 0x57,
+	0x06, 0x99, 0x2d, 0x8d, 0x71, 0x20, 0x7f, 0x28, 0xd7, 0x75, 0x0a, 0x90, 0xfb, 0x50, 0x8b, 0xcf,
+	0x83, 0x9e, 0xbe, 0x98, 0x2f, 0x8b, 0x5e, 0xc2, 0x3e, 0x58, 0xd9, 0x3b, 0x0f, 0x7a, 0x6c, 0xe9,
+	0xa6, 0xd1, 0xc8, 0x07, 0x50, 0x55, 0x7a, 0x6b, 0xce, 0x95, 0xaf, 0xe8, 0x49, 0xa4, 0xb2, 0x9a,
+	0xab, 0x03, 0x54, 0xd4, 0xce, 0x13, 0x68, 0x1a, 0x24, 0xfd, 0xd0, 0xdc, 0xe4, 0x87, 0xe6, 0x37,
+	0xf5, 0x43, 0x73, 0xca, 0xec, 0x45, 0x32, 0xfd, 0x10, 0xfd, 0x5d, 0xa8, 0xca, 0xaa, 0xe1, 0xaa,
+	0x12, 0x2b, 0xc2, 0xdd, 0xfb, 0x6c, 0x7b, 0xb5, 0x75, 0x89, 0xcc, 0x41, 0xbd, 0xbb, 0xca, 0x16,
+	0x2a, 0x03, 0x2c, 0x8c, 0xb2, 0xdb, 0xdd, 0xdb, 0x53, 0x48, 0xc9, 0x26, 0xa8, 0x69, 0x8a, 0x99,
+	0xf0, 0xad, 0x2c, 0x53, 0x1f, 0xc2, 0xbc, 0x86, 0xa5, 0x07, 0xb9, 0x11, 0x02, 0x99, 0x83, 0x1c,
+	0x46, 0x72, 0x38, 0xc5, 0x5e, 0x86, 0x45, 0x0c, 0xae, 0x9f, 0xd2, 0x20, 0xd9, 0x1b, 0x1f, 0x72,
+	0x33, 0xa5, 0x1f, 0x06, 0xf6, 0x5f, 0xb5, 0xa0, 0xa6, 0x28, 0x17, 0xcc, 0x27, 0x69, 0x59, 0x2d,
+	0xb1, 0x01, 0xe8, 0x68, 0x45, 0xb0, 0x94, 0x2b, 0xec, 0xaf, 0x71, 0xf8, 0xab, 0x29, 0x08, 0x1b,
+	0xbb, 0xbb, 0xbe, 0xee, 0xb8, 0x3b, 0xdb, 0x5b, 0x9b, 0xdb, 0xc8, 0x94, 0xb0, 0xb1, 0x0c, 0x78,
+	0xf4, 0x88, 0x21, 0x96, 0xdd, 0x42, 0x8f, 0x8f, 0x64, 0x33, 0x38, 0x0a, 0x65, 0x53, 0xff, 0x7c,
+	0x0a, 0xe6, 0x14, 0x94, 0x1e, 0x1e, 0x4f, 0x69, 0x14, 0xfb, 0x61, 0xc0, 0xc4, 0xbe, 0x9a, 0x23,
+	0x83, 0xb8, 0x9f, 0xf8, 0x7d, 0x1a, 0x24, 0x7e, 0x72, 0xee, 0x1a, 0xba, 0xb8, 0x2c, 0x8c, 0x07,
+	0x35, 0x6f, 0xe0, 0x7b, 0xd2, 0xe2, 0xcb, 0x03, 0x88, 0xf6, 0xc2, 0x41, 0x18, 0x31, 0xf9, 0xae,
+	0xe6, 0xf0, 0x00, 0x6a, 0xac, 0x50, 0xae, 0xd4, 0x35, 0xa5, 0x6c, 0xb1, 0x72, 0xc5, 0x60, 0x21,
+	0x0d, 0xf7, 0x2b, 0xc4, 0x85, 0x50, 0xa2, 0x92, 0xf0, 0x63, 0x4c, 0x11, 0x89, 0xbc, 0x0f, 0x8b,
+	0x08, 0xfb, 0x41, 0x86, 0xd0, 0x9e, 0x63, 0x69, 0x8a, 0x89, 0xb8, 0x6a, 0x78, 0xf9, 0x38, 0xf2,
+	0x53, 0x5c, 0x62, 0x55, 0x40, 0xce, 0x3c, 0x3b, 0xcd, 0xf7, 0xe0, 0xac, 0x79, 0x56, 0x33, 0xf1,
+	0x56, 0x73, 0x26, 0xde, 0xf7, 0x61, 0xf1, 0x90, 0xa2, 0x49, 0x8b, 0x7a, 0x7d, 0x1a, 0xb1, 0xd5,
+	0xc8, 0x2d, 0xb9, 0x5c, 0x40, 0x2f, 0x26, 0xb2, 0x9d, 0xfd, 0x3c, 0xe8, 0xd1, 0xbe, 0x9b, 0x84,
+	0x2e, 0x93, 0x40, 0x84, 0x02, 0x25, 0x0b, 0x9b, 0x31, 0x8f, 0x23, 0x6f, 0x74, 0x22, 0x24, 0xe8,
+	0x2c, 0x8c, 0xb2, 0x4f, 0x42, 0xe3, 0x24, 0xa0, 0xdc, 0x62, 0x56, 0x65, 0xd6, 0x10, 0x09, 0x91,
+	0x37, 0x61, 0x9a, 0x65, 0x18, 0xb7, 0x5b, 0xb7, 0xca, 0x9a, 0x11, 0x64, 0x15, 0x41, 0x47, 0xd0,
+	0xf0, 0xbc, 0x3c, 0x8e, 0x7c, 0xd4, 0xb3, 0xa3, 0x09, 0x99, 0xfd, 0x26, 0xdf, 0xd3, 0xf8, 0xc4,
+	0x02, 0x4b, 0x2b, 0x37, 0xe3, 0xcc, 0xcc, 0xfb, 0xa5, 0xb0, 0x8c, 0x4f, 0x2a, 0xd5, 0x7a, 0xab,
+	0x61, 0xff, 0x0a, 0x4c, 0xb1, 0x9a, 0xb3, 0x39, 0xc9, 0xfa, 0xcf, 0x12, 0x73, 0x92, 0xa1, 0x6d,
+	0x98, 0x09, 0x68, 0x72, 0x16, 0x46, 0xcf, 0xa5, 0xcf, 0x82, 0x08, 0x0a, 0x83, 0xb6, 0x23, 0x3c,
+	0x54, 0xf4, 0xb5, 0xf4, 0x87, 0x25, 0x58, 0xce, 0x91, 0x52, 0xcb, 0x9a, 0xf2, 0x75, 0x19, 0x86,
+	0x7d, 0xb9, 0xcf, 0x9a, 0x20, 0x9e, 0x14, 0x14, 0x70, 0xe4, 0x07, 0x7e, 0x7c, 0x22, 0x9c, 0x9d,
+	0xaa, 0x4e, 0x9e, 0x80, 0xfb, 0xc0, 0x28, 0x0a, 0x8f, 0xd5, 0xf6, 0x63, 0x39, 0x2a, 0x8c, 0xa3,
+	0x7e, 0xe8, 0x47, 0xc9, 0x49, 0xdf, 0x3b, 0xd7, 0x8f, 0xf8, 0x53, 0x4e, 0x16, 0x26, 0xbf, 0x06,
+	0x8d, 0xe7, 0xf4, 0xdc, 0x3d, 0xf2, 0x86, 0xfe, 0xc0, 0xa7, 0x38, 0xc9, 0x71, 0x84, 0xda, 0xa2,
+	0xff, 0x9e, 0xd0, 0xf3, 0x47, 0x48, 0x39, 0x97, 0xad, 0x72, 0x8c, 0xd8, 0x64, 0x15, 0x66, 0xc5,
+	0x89, 0x97, 0xeb, 0xef, 0xb9, 0x5f, 0x45, 0xfd, 0xfe, 0x55, 0x39, 0x3b, 0x18, 0x71, 0x87, 0xd1,
+	0x54, 0x16, 0x99, 0x24, 0xf6, 0xa7, 0x30, 0x9f, 0x2b, 0x07, 0xd7, 0x8d, 0x2a, 0x49, 0x8e, 0xb4,
+	0x86, 0xe0, 0xca, 0xc4, 0x50, 0x4f, 0xe9, 0x3f, 0x9a, 0x4e, 0x0a, 0xa0, 0xfe, 0xe4, 0x72, 0x51,
+	0xd9, 0xd8, 0x69, 0xaa, 0x67, 0x85, 0x3e, 0xa5, 0xb0, 0x43, 0x4b, 0x99, 0x0e, 0xfd, 0x10, 0x66,
+	0x64, 0x0b, 0xcb, 0xac, 0x87, 0xae, 0x89, 0x16, 0x8a, 0x9c, 0x69, 0xdf, 0x28, 0x4e, 0x46, 0xb6,
+	0xff, 0x85, 0x05, 0x8b, 0x85, 0x51, 0xb0, 0x34, 0xe5, 0xbe, 0xc2, 0xe7, 0x9e, 0x0a, 0x67, 0x1c,
+	0x63, 0x4a, 0x39, 0xc7, 0x18, 0xb3, 0x73, 0xca, 0x93, 0x3a, 0x87, 0x1b, 0x58, 0x2a, 0x69, 0xe7,
+	0x30, 0x80, 0x8b, 0x7c, 0xc1, 0x91, 0xee, 0x54, 0xd2, 0x74, 0x74, 0x08, 0x17, 0x45, 0x7c, 0x86,
+	0xf6, 0x2c, 0x2e, 0x67, 0xf1, 0x80, 0xfd, 0x33, 0xa6, 0x4f, 0x53, 0xee, 0x2b, 0x07, 0x4c, 0x11,
+	0x80, 0x5a, 0x51, 0xce, 0xce, 0xe2, 0x13, 0x4f, 0xa8, 0xf8, 0xaa, 0x0c, 0xd8, 0x3b, 0xf1, 0x50,
+	0x34, 0x34, 0x38, 0x24, 0xd7, 0x9a, 0xd6, 0x19, 0xb6, 0xc1, 0xcb, 0x7a, 0x13, 0x66, 0xa5, 0x63,
+	0x4c, 0xec, 0x0e, 0xe8, 0x51, 0x22, 0x2d, 0x42, 0xc1, 0x78, 0x88, 0xc5, 0xc5, 0x5b, 0xf4, 0x28,
+	0xb1, 0xb7, 0x61, 0x5e, 0x88, 0x6b, 0x3b, 0x23, 0x2a, 0x8b, 0xfe, 0xd5, 0xa2, 0x33, 0x5d, 0xfd,
+	0xfe, 0x82, 0x29, 0xdf, 0x71, 0x1d, 0xb1, 0x19, 0xd3, 0x76, 0x80, 0xe8, 0xe2, 0x9f, 0xc8, 0x50,
+	0x1c, 0xaa, 0xa4, 0xcd, 0x4b, 0x34, 0xc7, 0xc0, 0x90, 0x35, 0xc4, 0xe3, 0x5e, 0x4f, 0x4e, 0x92,
+	0xaa, 0x23, 0x83, 0xf6, 0xbf, 0xb1, 0x60, 0x81, 0xe5, 0xb6, 0x2a, 0x0d, 0x9c, 0x5c, 0xc4, 0xfe,
+	0xe8, 0x35, 0xaa, 0xd9, 0xe8, 0x69, 0x21, 0x1c, 0x07, 0x5d, 0xe8, 0xe6, 0x81, 0xd7, 0x57, 0xe8,
+	0x57, 0x72, 0x0a, 0xfd, 0x3b, 0xd0, 0xea, 0xd3, 0x81, 0xcf, 0x18, 0x8a, 0x14, 0x61, 0xf9, 0x11,
+	0x34, 0x87, 0xdb, 0x7f, 0xdb, 0x82, 0x79, 0x2e, 0x23, 0x33, 0x3d, 0x8a, 0xe8, 0xaa, 0x5f, 0x93,
+	0x3a, 0x07, 0xb1, 0x37, 0x8b, 0x46, 0xa5, 0x52, 0x23, 0x43, 0x79, 0xe4, 0x8d, 0x4b, 0x8e, 0x19,
+	0x99, 0x3c, 0x60, 0x27, 0xe9, 0xc0, 0x65, 0x68, 0x81, 0x37, 0x9f, 0x39, 0x2e, 0x1b, 0x97, 0x1c,
+	0x2d, 0xfa, 0xc3, 0x2a, 0xaa, 0x41, 0x10, 0xb7, 0x1f, 0x43, 0xd3, 0x28, 0xc8, 0xd0, 0xf4, 0x37,
+	0xb8, 0xa6, 0x3f, 0x67, 0x70, 0x2c, 0x15, 0x18, 0x1c, 0xff, 0x7d, 0x05, 0x08, 0x4e, 0xac, 0xcc,
+	0xc8, 0xdd, 0x32, 0xad, 0xf6, 0xd2, 0x61, 0x2f, 0x85, 0xc8, 0x7d, 0x20, 0x5a, 0x50, 0x7a, 0x13,
+	0x94, 0x95, 0x37, 0x41, 0x01, 0x15, 0x05, 0x1e, 0x71, 0xa0, 0x52, 0x96, 0x7a, 0xc6, 0xc5, 0xf8,
+	0x30, 0x15, 0xd2, 0x48, 0x47, 0x98, 0xed, 0x91, 0x1f, 0x08, 0xcd, 0xa7, 0x0c, 0x67, 0xe7, 0xc3,
+	0xf4, 0x4b, 0xe7, 0xc3, 0x4c, 0x6e, 0x3e, 0x68, 0xba, 0xb7, 0xaa, 0xa9, 0x7b, 0x7b, 0x13, 0x9a,
+	0xd2, 0x3a, 0xcf, 0x1d, 0x93, 0x84, 0xa2, 0xd3, 0x00, 0x71, 0x3e, 0x49, 0xf5, 0x97, 0x52, 0xf0,
+	0x71, 0xb7, 0x9b, 0x1c, 0x8e, 0xcc, 0x29, 0xb5, 0xb1, 0xd4, 0x59, 0x65, 0x53, 0x80, 0x69, 0xcb,
+	0x72, 0xc6, 0xa1, 0x86, 0xd0, 0x96, 0x65, 0x09, 0x79, 0xcd, 0x57, 0xb3, 0x40, 0xf3, 0x85, 0x4e,
+	0x65, 0xb2, 0x3b, 0xe3, 0x13, 0x7f, 0xc8, 0xc4, 0xda, 0xd4, 0xa9, 0xec, 0x11, 0x27, 0xed, 0x9d,
+	0xf8, 0x43, 0xc7, 0x88, 0x97, 0xda, 0xb6, 0xe6, 0x74, 0xdb, 0x96, 0x61, 0x91, 0x6a, 0xbd, 0xd4,
+	0x22, 0xf5, 0xc7, 0x16, 0xb4, 0x70, 0x6a, 0x19, 0xab, 0xe7, 0x63, 0x60, 0x0b, 0xfd, 0x15, 0x17,
+	0x8f, 0x11, 0x97, 0x7c, 0x04, 0x35, 0x16, 0x0e, 0x47, 0x34, 0x10, 0x4b, 0xa7, 0x6d, 0x2e, 0x9d,
+	0x94, 0x45, 0xa2, 0x7f, 0x9b, 0x8a, 0x8c, 0x52, 0x41, 0xd6, 0x1f, 0x81, 0x3b, 0xd7, 0x64, 0x61,
+	0x6d, 0x89, 0x6d, 0x00, 0x3c, 0xa1, 0xe7, 0x5b, 0x61, 0x8f, 0x69, 0x1d, 0xae, 0xe7, 0x76, 0xe5,
+	0x29, 0xb6, 0xb3, 0xf0, 0xcd, 0x1b, 0xf7, 0x82, 0x74, 0xdf, 0x11, 0x16, 0xb2, 0xe7, 0xf4, 0x7c,
+	0x93, 0xad, 0x31, 0x17, 0x9a, 0x4f, 0xe8, 0xf9, 0x1a, 0xe5, 0xe7, 0xa2, 0x10, 0x3d, 0x01, 0x9a,
+	0xe8, 0x3f, 0x88, 0x29, 0x74, 0x47, 0x82, 0x7a, 0xe4, 0x9d, 0x3d, 0xa1, 0xe7, 0x38, 0x2f, 0x63,
+	0x72, 0x07, 0x66, 0x90, 0x3e, 0x08, 0x7b, 0x42, 0xb2, 0x9b, 0x4f, 0x25, 0x13, 0x51, 0x29, 0x67,
+	0xfa, 0x39, 0xfb, 0x6d, 0xff, 0xa9, 0x05, 0x4d, 0xec, 0x01, 0x36, 0x04, 0x38, 0x9c, 0xd2, 0xc5,
+	0xce, 0x4a, 0x5d, 0xec, 0xee, 0x0b, 0xc6, 0xc3, 0x19, 0x71, 0x69, 0x32, 0x23, 0x66, 0xdd, 0xc6,
+	0x7e, 0x92, 0xf7, 0xa0, 0xc6, 0xd7, 0x24, 0xf2, 0x80, 0xb2, 0x31, 0x52, 0x46, 0x83, 0x9c, 0x2a,
+	0x8b, 0xf6, 0x84, 0x7b, 0xf3, 0x68, 0xca, 0x5e, 0xde, 0xc9, 0x35, 0x8e, 0x20, 0xb9, 0xc0, 0x31,
+	0x64, 0xaa, 0xc8, 0x31, 0xe4, 0x00, 0xea, 0xda, 0xec, 0x24, 0xdf, 0x81, 0xb9, 0xb4, 0xf2, 0x7c,
+	0x2a, 0x9b, 0x13, 0xc7, 0x68, 0x3d, 0xe3, 0xba, 0x3a, 0xf0, 0x70, 0x1a, 0x2a, 0x98, 0x08, 0x2d,
+	0x8e, 0x5a, 0xb6, 0x5c, 0xc3, 0x52, 0x54, 0x27, 0xab, 0xa8, 0x4e, 0xbf, 0x6d, 0xc1, 0x65, 0x91,
+	0x9a, 0xb9, 0x63, 0xfa, 0x28, 0x0b, 0x3c, 0x8d, 0x8f, 0x71, 0x37, 0xc6, 0xdc, 0xdd, 0x88, 0x1e,
+	0xfb, 0x71, 0x42, 0xa5, 0x85, 0xad, 0x60, 0x99, 0xe1, 0x94, 0xc6, 0xa8, 0x8e, 0x88, 0x49, 0x1e,
+	0x40, 0x9d, 0x25, 0xe5, 0x3a, 0xa0, 0x76, 0xc9, 0x98, 0xd4, 0xb9, 0xaa, 0xe2, 0x76, 0x10, 0xab,
+	0xd0, 0xc3, 0x1a, 0xcc, 0x24, 0x91, 0x7f, 0x7c, 0x4c, 0x23, 0x74, 0xdf, 0x96, 0xb1, 0x13, 0x2f,
+	0xa1, 0x7b, 0x09, 0x1d, 0xa1, 0x08, 0x8e, 0x33, 0xa3, 0x2e, 0x16, 0xd5, 0x2f, 0x6c, 0x55, 0xd3,
+	0xe5, 0xb5, 0x72, 0x46, 0x5e, 0xbb, 0x0d, 0x73, 0x43, 0x3c, 0x68, 0xe0, 0x09, 0xd8, 0xb0, 0xa8,
+	0x65, 0x61, 0x3c, 0xb8, 0x32, 0xe1, 0x27, 0x76, 0x13, 0x7f, 0xe0, 0x4a, 0xaa, 0x70, 0xec, 0x2d,
+	0x22, 0x31, 0x59, 0x2c, 0x41, 0x1f, 0x3b, 0x7e, 0xba, 0xe4, 0x01, 0x3c, 0x86, 0xec, 0xa6, 0xc3,
+	0xa2, 0x29, 0xf4, 0xec, 0x3f, 0x68, 0xc2, 0x72, 0x8e, 0xa4, 0x6e, 0x5f, 0x08, 0x33, 0xd1, 0xc0,
+	0x1f, 0x1e, 0x86, 0x4a, 0xcd, 0x6b, 0xe9, 0x16, 0x24, 0x83, 0x44, 0x8e, 0x61, 0x51, 0xce, 0x0a,
+	0xa6, 0x6a, 0x55, 0xc7, 0xe6, 0x12, 0x63, 0x7c, 0xef, 0x99, 0x1c, 0x2b, 0x5b, 0xa0, 0xc4, 0xf5,
+	0xad, 0xb5, 0x38, 0x3f, 0x72, 0x02, 0x6d, 0x49, 0x90, 0xe2, 0x96, 0xa6, 0x09, 0xc0, 0xb2, 0xde,
+	0x79, 0x49, 0x59, 0x86, 0xfe, 0xcf, 0x99, 0x98, 0x1b, 0x39, 0x87, 0x1b, 0x92, 0xc6, 0xe4, 0xa9,
+	0x7c, 0x79, 0x95, 0x57, 0x6a, 0x1b, 0xd3, 0x6c, 0x9a, 0x85, 0xbe, 0x24, 0x63, 0xf2, 0x13, 0x58,
+	0x3a, 0xf3, 0xfc, 0x44, 0x56, 0x4b, 0xd3, 0x42, 0xf0, 0x63, 0xd7, 0xfd, 0x97, 0x14, 0xf9, 0x8c,
+	0x27, 0x36, 0x84, 0xcc, 0x09, 0x39, 0x76, 0xfe, 0xb8, 0x04, 0xb3, 0x66, 0x3e, 0x38, 0x4d, 0x05,
+	0x57, 0x92, 0x52, 0x89, 0xd4, 0xdf, 0x64, 0xe0, 0xbc, 0xb5, 0xa4, 0x54, 0x64, 0x2d, 0xd1, 0xed,
+	0x13, 0xe5, 0x97, 0x99, 0x63, 0x2b, 0xaf, 0x66, 0x8e, 0x9d, 0x2a, 0x34, 0xc7, 0x4e, 0xb6, 0xda,
+	0x4d, 0xff, 0xa2, 0x56, 0xbb, 0x99, 0x0b, 0xad, 0x76, 0x9d, 0xff, 0x6b, 0x01, 0xc9, 0xcf, 0x5e,
+	0xf2, 0x98, 0x1b, 0x88, 0x02, 0x3a, 0x10, 0xec, 0xed, 0xdd, 0x57, 0x5b, 0x01, 0x72, 0xb4, 0x64,
+	0x6a, 0x5c, 0x8a, 0xfa, 0x5d, 0x00, 0xfd, 0x80, 0xd4, 0x74, 0x8a, 0x48, 0x19, 0x93, 0x74, 0xe5,
+	0xe5, 0x26, 0xe9, 0xa9, 0x97, 0x9b, 0xa4, 0xa7, 0xb3, 0x26, 0xe9, 0xce, 0x5f, 0xb1, 0x60, 0xa1,
+	0x60, 0x9a, 0x7d, 0x75, 0x0d, 0xc7, 0x89, 0x61, 0x70, 0x9f, 0x92, 0x98, 0x18, 0x3a, 0xd8, 0xf9,
+	0x4b, 0xd0, 0x34, 0x96, 0xd6, 0x57, 0x57, 0x7e, 0xf6, 0x8c, 0xc7, 0x67, 0xb6, 0x81, 0x75, 0xfe,
+	0x67, 0x09, 0x48, 0x7e, 0x79, 0xff, 0x52, 0xeb, 0x90, 0xef, 0xa7, 0x72, 0x41, 0x3f, 0xfd, 0x85,
+	0xee, 0x3c, 0xa9, 0x3a, 0x4a, 0x33, 0x09, 0xf2, 0x19, 0x93, 0x27, 0xe0, 0x29, 0xd7, 0xf4, 0x07,
+	0xa8, 0x1a, 0x57, 0x37, 0xb4, 0xed, 0x37, 0xe3, 0x16, 0x60, 0x77, 0xa0, 0x2d, 0x7a, 0x28, 0xaf,
+	0x3a, 0xff, 0x3b, 0x15, 0x20, 0x3a, 0x51, 0xc8, 0xcf, 0xef, 0x43, 0x43, 0xdf, 0x3e, 0xda, 0x96,
+	0xa1, 0xf5, 0x13, 0x09, 0x50, 0xcc, 0xd0, 0x63, 0x91, 0x35, 0x98, 0x65, 0x4c, 0xb2, 0xaf, 0xd2,
+	0x71, 0x49, 0xe3, 0x02, 0x83, 0xd0, 0xc6, 0x25, 0x27, 0x93, 0x86, 0x7c, 0x1b, 0x66, 0x4d, 0x35,
+	0x71, 0xbb, 0x3c, 0x51, 0x8c, 0xc4, 0xe4, 0x66, 0x64, 0xd2, 0x85, 0x56, 0x56, 0xcf, 0xdc, 0xae,
+	0x5c, 0x94, 0x41, 0x2e, 0x3a, 0xf9, 0x04, 0x2e, 0x17, 0x6d, 0xa2, 0xed, 0x69, 0x43, 0x18, 0xcc,
+	0x9e, 0x22, 0x0a, 0xd3, 0x90, 0x8f, 0x84, 0xcd, 0x61, 0xaa, 0xc8, 0x4c, 0xaa, 0x75, 0xf9, 0x0a,
+	0xff, 0xa7, 0x59, 0x1f, 0x4e, 0x01, 0x52, 0x0c, 0xad, 0x0d, 0x3b, 0xbb, 0xeb, 0xdb, 0xee, 0xea,
+	0x46, 0x77, 0x7b, 0x7b, 0x7d, 0xab, 0x75, 0x89, 0x10, 0x98, 0x65, 0xe6, 0xcd, 0x35, 0x85, 0x59,
+	0x88, 0x09, 0x8b, 0x8c, 0xc4, 0x4a, 0x68, 0xfb, 0xdc, 0xdc, 0xce, 0xa0, 0x65, 0xd2, 0x86, 0xcb,
+	0xbb, 0xeb, 0xdc, 0x22, 0x6a, 0xe4, 0x5b, 0x41, 0x79, 0x4f, 0x54, 0x1e, 0xe5, 0x3d, 0x7e, 0x51,
+	0xef, 0x21, 0x9f, 0x84, 0x52, 0x06, 0xfa, 0x0f, 0x25, 0x58, 0xcc, 0x10, 0x52, 0x45, 0x2c, 0x17,
+	0x73, 0x4c, 0xd9, 0xc7, 0x04, 0x99, 0x4b, 0x89, 0x3c, 0x63, 0x66, 0xf8, 0x54, 0x9e, 0x80, 0x2b,
+	0x6b, 0x1c, 0xe4, 0x60, 0xb1, 0x5e, 0x8b, 0x48, 0xe4, 0x87, 0x30, 0xe7, 0xf5, 0x98, 0xa6, 0x52,
+	0xdb, 0x1e, 0x71, 0xb5, 0xdc, 0x13, 0xfd, 0x5f, 0x58, 0xf9, 0x95, 0x2e, 0x4f, 0x23, 0x60, 0xae,
+	0x25, 0xcf, 0x66, 0xd4, 0xf9, 0xff, 0x61, 0xa1, 0x20, 0x5e, 0x81, 0x6b, 0xea, 0x7b, 0xa6, 0xca,
+	0xfc, 0xaa, 0x51, 0xb4, 0x99, 0x85, 0x6e, 0x72, 0x3b, 0x85, 0xcb, 0x45, 0x51, 0x8a, 0xfb, 0xcc,
+	0x7a, 0xcd, 0x3e, 0x2b, 0x4d, 0xec, 0x33, 0xb4, 0xae, 0xad, 0xca, 0x3b, 0x9d, 0xc6, 0x60, 0x1f,
+	0xc1, 0x52, 0x96, 0x90, 0x5a, 0xb2, 0xcc, 0x8a, 0xc8, 0x20, 0xaa, 0x60, 0x8c, 0xd5, 0x60, 0x96,
+	0x5f, 0x48, 0xb3, 0xff, 0xd1, 0x34, 0x90, 0x4f, 0xc7, 0xa8, 0xc0, 0x0e, 0xc7, 0x09, 0x55, 0x06,
+	0xf4, 0xe5, 0xac, 0x39, 0x0f, 0xdd, 0x0f, 0xf1, 0x90, 0x27, 0x0e, 0x9f, 0xa5, 0x57, 0xba, 0xdf,
+	0x55, 0x74, 0xbf, 0xaa, 0xf2, 0xf2, 0xfb, 0x55, 0x53, 0x2f, 0xbb, 0x5f, 0x85, 0xbe, 0x3b, 0xc7,
+	0x41, 0x88, 0x8c, 0x1a, 0x85, 0x3b, 0xd4, 0xcf, 0x97, 0x51, 0xa5, 0x29, 0xc0, 0x6d, 0xc4, 0xc8,
+	0x83, 0x34, 0x12, 0xed, 0x1f, 0xb3, 0x5b, 0x82, 0x3a, 0xeb, 0x5e, 0xef, 0x1f, 0x53, 0x71, 0xd6,
+	0x66, 0x3a, 0x2d, 0x99, 0x18, 0xf1, 0x18, 0xf5, 0xb7, 0x71, 0x38, 0x46, 0x71, 0x57, 0x76, 0x03,
+	0x37, 0x72, 0x35, 0x38, 0xba, 0xcb, 0x3b, 0x63, 0x05, 0x16, 0xc6, 0x31, 0x75, 0x87, 0x7e, 0x8c,
+	0x96, 0x44, 0xd4, 0xf5, 0x24, 0x51, 0x38, 0x10, 0x46, 0xab, 0xf9, 0x71, 0x4c, 0x9f, 0x72, 0xca,
+	0x2a, 0x27, 0x90, 0xf7, 0xd3, 0x2a, 0x8d, 0x3c, 0x3f, 0xca, 0xba, 0xfe, 0x62, 0xbd, 0x77, 0x3d,
+	0x3f, 0x52, 0x75, 0xc1, 0x40, 0x9c, 0xb9, 0xf7, 0x55, 0xcf, 0xde, 0xfb, 0xfa, 0x71, 0xf1, 0xbd,
+	0xaf, 0xa6, 0xb1, 0xf4, 0xf2, 0x43, 0xfc, 0x5a, 0xd7, 0xbf, 0xf2, 0xd7, 0xd9, 0x66, 0x5f, 0xe7,
+	0x3a, 0xdb, 0x5c, 0xd1, 0x75, 0xb6, 0xf7, 0xa0, 0xce, 0x2e, 0x19, 0xb9, 0x27, 0x9a, 0xce, 0xa9,
+	0xa5, 0xdf, 0x42, 0xda, 0xf0, 0x83, 0xc4, 0x81, 0x48, 0xfe, 0x8c, 0xf3, 0x37, 0xcb, 0xe6, 0x7f,
+	0x89, 0x37, 0xcb, 0xc4, 0x65, 0xa8, 0x15, 0xa8, 0xca, 0x71, 0x42, 0xc5, 0xec, 0x51, 0x14, 0x0e,
+	0xa5, 0x62, 0x16, 0x7f, 0x93, 0x59, 0x28, 0x25, 0xa1, 0x48, 0x5c, 0x4a, 0x42, 0xfb, 0xd7, 0xa1,
+	0xae, 0x4d, 0x35, 0xf2, 0x06, 0x80, 0x3c, 0x6e, 0x08, 0x5d, 0x04, 0xef, 0xc5, 0x9a, 0x40, 0x37,
+	0xfb, 0xe8, 0x49, 0xde, 0xf7, 0x23, 0xca, 0xee, 0x80, 0xba, 0x11, 0x45, 0x3b, 0xb5, 0xd4, 0x95,
+	0xb7, 0x14, 0xc1, 0xe1, 0xb8, 0xed, 0xc2, 0x82, 0x31, 0xb6, 0x6a, 0x47, 0x98, 0x66, 0xfd, 0x26,
+	0x2d, 0xfb, 0xe6, 0xed, 0x2e, 0x41, 0x43, 0x89, 0x4d, 0xa8, 0xf9, 0xdd, 0x51, 0x14, 0x1e, 0x0a,
+	0xfb, 0x90, 0x81, 0xd9, 0xbf, 0x53, 0x81, 0xf2, 0x46, 0x38, 0xd2, 0x9d, 0xc8, 0xac, 0xbc, 0x13,
+	0x99, 0x38, 0x5a, 0xb9, 0xea, 0xe4, 0x24, 0xe4, 0x5f, 0x03, 0x24, 0x77, 0x60, 0x16, 0x59, 0x45,
+	0x12, 0xe2, 0x51, 0xf2, 0xcc, 0x8b, 0xf8, 0x75, 0xaf, 0x32, 0x5b, 0x7f, 0x19, 0x0a, 0xb9, 0x0c,
+	0x65, 0x75, 0x22, 0x60, 0x11, 0x30, 0x88, 0x7a, 0x0c, 0xe6, 0xce, 0x7b, 0x2e, 0x0c, 0x3c, 0x22,
+	0x84, 0x9c, 0xd7, 0x4c, 0xcf, 0xf9, 0x11, 0x97, 0xeb, 0x8a, 0x48, 0xcc, 0x66, 0x46, 0xa9, 0x3b,
+	0x4c, 0x4f, 0x4d, 0x2a, 0xac, 0x3b, 0x33, 0x54, 0x4d, 0x67, 0x86, 0x5b, 0x50, 0x4f, 0x06, 0xa7,
+	0x78, 0x05, 0x72, 0x10, 0x7a, 0xd2, 0xbf, 0x5f, 0x87, 0xc8, 0x3d, 0x80, 0xe1, 0x68, 0x24, 0x96,
+	0x21, 0x53, 0x17, 0xa7, 0xb3, 0xfa, 0xe9, 0xee, 0x2e, 0x9f, 0x7d, 0x8e, 0x16, 0x87, 0xac, 0xc3,
+	0x6c, 0xe1, 0x9d, 0xcd, 0xeb, 0x22, 0xd5, 0x46, 0x38, 0x5a, 0x29, 0x58, 0xa8, 0x99, 0x44, 0x58,
+	0xb0, 0x37, 0x54, 0x05, 0x37, 0x8c, 0x82, 0xbb, 0x4f, 0x55, 0xc1, 0x69, 0x9c, 0xce, 0xf7, 0x80,
+	0x7c, 0xc9, 0xcb, 0x96, 0x14, 0x6a, 0x2a, 0x6b, 0x76, 0x97, 0x3a, 0x0c, 0x51, 0x85, 0xe7, 0x45,
+	0xf2, 0x85, 0x0d, 0x0d, 0xc1, 0xb1, 0x8b, 0x69, 0x92, 0xde, 0x17, 0x13, 0x21, 0x66, 0xb9, 0x3b,
+	0xf1, 0x07, 0x7d, 0xe3, 0xea, 0x94, 0x0e, 0xd9, 0xcf, 0xa0, 0xa6, 0xba, 0x4e, 0xbf, 0x49, 0xc9,
+	0x5c, 0xde, 0xeb, 0xe6, 0x4d, 0x4a, 0xc4, 0xf0, 0x04, 0xce, 0x65, 0x1f, 0xb5, 0x33, 0x71, 0x37,
+	0xe5, 0x0c, 0x6a, 0xff, 0x99, 0x05, 0x53, 0x6c, 0x49, 0xe0, 0x91, 0x83, 0xd3, 0x94, 0x5b, 0xa0,
+	0x30, 0xcf, 0x66, 0x61, 0x62, 0x1b, 0x57, 0xcf, 0x4b, 0x6a, 0x7e, 0x6a, 0x28, 0xb9, 0x05, 0x35,
+	0x55, 0x92, 0x36, 0xc7, 0x53, 0x90, 0xdc, 0xc0, 0x0b, 0x5e, 0x23, 0xa9, 0x95, 0x81, 0x74, 0xa8,
+	0x1d, 0x86, 0xa7, 0xf5, 0xc1, 0xfc, 0x78, 0x13, 0xf8, 0xc9, 0x37, 0x0b, 0x17, 0xb4, 0x75, 0xba,
+	0xb0, 0xad, 0x07, 0x30, 0x87, 0x8c, 0x4b, 0x33, 0xee, 0x4f, 0xde, 0xe5, 0xbf, 0x81, 0xe2, 0x7c,
+	0x6f, 0x30, 0xee, 0x53, 0x5d, 0x37, 0xc6, 0x1c, 0x2c, 0x04, 0x2e, 0x4f, 0x85, 0xf6, 0x3f, 0xb6,
+	0xa0, 0x2a, 0xf3, 0x25, 0xb7, 0xa1, 0x12, 0x48, 0x47, 0x80, 0x54, 0x66, 0x57, 0xd7, 0x10, 0x30,
+	0x9e, 0xc3, 0x62, 0xe0, 0x28, 0x32, 0x03, 0xa9, 0x9e, 0x7b, 0xd3, 0x31, 0xb0, 0xb4, 0x65, 0x19,
+	0x7d, 0x4c, 0x06, 0x25, 0x2b, 0x9a, 0x33, 0x5c, 0xc5, 0xd8, 0xe4, 0xa5, 0xc4, 0xdf, 0x3f, 0xa6,
+	0x9a, 0x13, 0xdc, 0x1f, 0x96, 0xa0, 0x69, 0xd4, 0x09, 0xa7, 0x20, 0xdb, 0xb3, 0xb8, 0x79, 0x40,
+	0x8c, 0xbc, 0x0e, 0xe9, 0x2c, 0xa1, 0x64, 0xb2, 0x04, 0xe5, 0x15, 0x54, 0xd6, 0xbd, 0x82, 0xee,
+	0x41, 0x2d, 0x7d, 0x7b, 0xc0, 0xac, 0x14, 0x96, 0x28, 0x2f, 0x64, 0xa4, 0x91, 0x52, 0x3f, 0xa2,
+	0x29, 0xdd, 0x8f, 0xe8, 0x3b, 0x9a, 0x9f, 0xc9, 0x34, 0xcb, 0xc6, 0x2e, 0xea, 0xd5, 0x5f, 0x8e,
+	0x63, 0xda, 0x03, 0xa8, 0x6b, 0x95, 0xd7, 0xfd, 0x49, 0x2c, 0xc3, 0x9f, 0x44, 0xdd, 0xe4, 0x2a,
+	0xa5, 0x37, 0xb9, 0xec, 0x9f, 0x97, 0xa0, 0x89, 0x6b, 0xcd, 0x0f, 0x8e, 0x77, 0xc3, 0x81, 0xdf,
+	0x3b, 0x67, 0x73, 0x5c, 0x2e, 0x2b, 0x21, 0x1d, 0xca, 0x35, 0x67, 0xc2, 0xc8, 0xac, 0xd5, 0x6d,
+	0x5a, 0xbe, 0xb3, 0xa8, 0x30, 0x6e, 0x3d, 0xc8, 0xb8, 0x0f, 0xbd, 0x98, 0x6a, 0x6f, 0x20, 0x38,
+	0x26, 0x88, 0x1b, 0x04, 0x02, 0xec, 0x9a, 0xe0, 0xd0, 0x1f, 0x0c, 0x7c, 0x1e, 0x97, 0x2b, 0x9c,
+	0x8a, 0x48, 0x58, 0x66, 0xdf, 0x8f, 0xbd, 0xc3, 0xd4, 0x7b, 0x53, 0x85, 0xb1, 0x4c, 0xbc, 0x34,
+	0x95, 0xda, 0x0f, 0xf9, 0xbd, 0x62, 0x13, 0xcc, 0xce, 0xaa, 0x99, 0xdc, 0xac, 0xb2, 0xff, 0x59,
+	0x09, 0xea, 0xda, 0x1c, 0x45, 0xde, 0x52, 0x28, 0x1d, 0x68, 0xa8, 0xf0, 0xd7, 0x0e, 0x0c, 0x15,
+	0xa6, 0x86, 0x90, 0x37, 0xcd, 0x52, 0x19, 0x3b, 0x65, 0xdc, 0x47, 0x87, 0x99, 0x0f, 0x58, 0xd8,
+	0xa7, 0xef, 0x31, 0x7d, 0xa9, 0x78, 0x85, 0x44, 0x01, 0x92, 0x7a, 0x9f, 0x51, 0xa7, 0x52, 0x2a,
+	0x03, 0x2e, 0xf4, 0xe0, 0xfe, 0x08, 0x1a, 0x22, 0x1b, 0x36, 0xc6, 0xed, 0x19, 0x83, 0x13, 0x18,
+	0xe3, 0xef, 0x18, 0x31, 0x65, 0xca, 0xfb, 0x32, 0x65, 0xf5, 0x65, 0x29, 0x65, 0x4c, 0xfb, 0xb1,
+	0x72, 0x8e, 0x7f, 0x8c, 0x3e, 0x5f, 0x92, 0xbb, 0xdd, 0x83, 0x05, 0xc9, 0xc4, 0xc6, 0x81, 0x17,
+	0x04, 0xe1, 0x38, 0xe8, 0x29, 0x07, 0x99, 0x22, 0x92, 0xdd, 0x87, 0x86, 0x9e, 0x11, 0xb9, 0x03,
+	0x53, 0xfc, 0x7c, 0xc1, 0x85, 0xa8, 0x62, 0x7e, 0xc6, 0xa3, 0x90, 0xdb, 0x30, 0xc5, 0x8f, 0x19,
+	0xa5, 0x89, 0x1c, 0x88, 0x47, 0xb0, 0x57, 0x60, 0x8e, 0x89, 0xca, 0x1a, 0x23, 0xbe, 0x5a, 0x24,
+	0x5c, 0x4d, 0xf7, 0xb8, 0x6d, 0xea, 0x32, 0xde, 0x86, 0x63, 0xeb, 0x4a, 0x4b, 0x62, 0xff, 0x59,
+	0x19, 0xea, 0x1a, 0x8c, 0xcc, 0x92, 0x79, 0xbc, 0xb9, 0x7d, 0xdf, 0x1b, 0x52, 0x69, 0xa9, 0x6a,
+	0x3a, 0x19, 0x14, 0xe3, 0x79, 0xa7, 0xc7, 0xe8, 0xa6, 0xe4, 0xf6, 0xe9, 0x71, 0x44, 0xa9, 0x90,
+	0xfa, 0x32, 0x28, 0xc6, 0xc3, 0xd9, 0xac, 0xc5, 0xe3, 0x1b, 0x73, 0x06, 0x95, 0xce, 0x84, 0xbc,
+	0x9f, 0x2a, 0xa9, 0x33, 0x21, 0xef, 0x95, 0x2c, 0x9b, 0x9f, 0x2a, 0x60, 0xf3, 0x1f, 0xc2, 0x12,
+	0x67, 0xe8, 0x82, 0x7b, 0xb8, 0x99, 0xc9, 0x35, 0x81, 0x8a, 0xe6, 0x79, 0xac, 0xb3, 0x5c, 0x1a,
+	0xb1, 0xff, 0x33, 0xbe, 0xc6, 0x2c, 0x27, 0x87, 0x63, 0x5c, 0x66, 0x8d, 0xd7, 0xe3, 0xf2, 0x5b,
+	0x03, 0x39, 0x9c, 0xc5, 0xf5, 0x5e, 0x18, 0x98, 0xf0, 0x0f, 0xc8, 0xe1, 0xa8, 0x8a, 0x1f, 0xd2,
+	0xbe, 0xef, 0x99, 0x59, 0xb8, 0xa9, 0xc4, 0x31, 0x89, 0x8c, 0xa5, 0x60, 0x2f, 0xfc, 0x2c, 0x1c,
+	0x1e, 0xfa, 0x7c, 0x97, 0xe5, 0x7e, 0x03, 0x15, 0x27, 0x87, 0xdb, 0x4d, 0xa8, 0xef, 0x25, 0xe1,
+	0x48, 0x0e, 0xfd, 0x2c, 0x34, 0x78, 0x50, 0xdc, 0xab, 0xbb, 0x0a, 0x57, 0xd8, 0x7c, 0xdd, 0x0f,
+	0x47, 0xe1, 0x20, 0x3c, 0x3e, 0x37, 0x74, 0x8d, 0xff, 0xd2, 0x82, 0x05, 0x83, 0x9a, 0x2a, 0x1b,
+	0x99, 0x61, 0x44, 0x5e, 0x86, 0xe2, 0x53, 0x7c, 0x5e, 0xdb, 0xa3, 0x78, 0x44, 0xee, 0x19, 0xc2,
+	0x7f, 0xc7, 0xa4, 0x9b, 0xbe, 0x7f, 0x20, 0x13, 0x96, 0x0c, 0xdf, 0x3a, 0x6d, 0xbe, 0x8b, 0xf4,
+	0xf2, 0x65, 0x04, 0x99, 0xc5, 0xb7, 0xa1, 0xa1, 0xe9, 0x1e, 0xa5, 0x1d, 0x4c, 0x69, 0x2b, 0x75,
+	0xdd, 0xb4, 0xac, 0x41, 0x4f, 0x81, 0x31, 0x3e, 0x2b, 0x00, 0x69, 0xed, 0x70, 0xfa, 0xa5, 0xfb,
+	0x2c, 0x7f, 0x6f, 0x2d, 0x05, 0xd0, 0x53, 0x4b, 0x39, 0xf1, 0xa6, 0x5b, 0x77, 0x5d, 0x62, 0x28,
+	0xea, 0xbc, 0x0d, 0x73, 0xc7, 0x83, 0xf0, 0x90, 0x89, 0x54, 0x62, 0x9f, 0xe5, 0xb7, 0x0b, 0x67,
+	0x39, 0x2c, 0x77, 0xcf, 0x74, 0x9f, 0xaf, 0x14, 0x7a, 0xff, 0xea, 0xbb, 0x36, 0xee, 0x75, 0xf3,
+	0xb9, 0x9e, 0xb8, 0x70, 0x95, 0xff, 0x42, 0x36, 0xfc, 0x8b, 0x4c, 0x55, 0x0f, 0x60, 0x36, 0xe2,
+	0x3c, 0x53, 0x32, 0xd4, 0xca, 0x05, 0x0c, 0xb5, 0x19, 0xe9, 0x41, 0x94, 0xff, 0xbc, 0xfe, 0x29,
+	0x8d, 0x12, 0x9f, 0xa9, 0xee, 0x99, 0x4c, 0xc7, 0x1b, 0x38, 0xa7, 0xe1, 0x4c, 0x74, 0xc2, 0x17,
+	0x31, 0xf8, 0x5d, 0x4f, 0x15, 0x53, 0x3c, 0xb6, 0x93, 0xc2, 0x18, 0xd1, 0xfe, 0xfb, 0xd2, 0x91,
+	0xcc, 0x1c, 0xdd, 0x8b, 0x7b, 0x45, 0x6f, 0x61, 0x29, 0xd3, 0xc2, 0xaf, 0x09, 0x37, 0x99, 0xbe,
+	0xb4, 0x11, 0x94, 0xb5, 0xdb, 0x42, 0x7d, 0xe1, 0x88, 0x67, 0x76, 0x6b, 0xe5, 0x55, 0xba, 0xd5,
+	0xfe, 0x77, 0x16, 0xcc, 0x6c, 0x84, 0x23, 0xd4, 0x39, 0x30, 0x19, 0x07, 0x97, 0x89, 0xba, 0x68,
+	0x2d, 0x83, 0x2f, 0xb9, 0x55, 0x55, 0x28, 0x95, 0x34, 0xb3, 0x52, 0xc9, 0xf7, 0xe0, 0x2a, 0x02,
+	0xa3, 0x28, 0x1c, 0x85, 0x11, 0x2e, 0x57, 0x6f, 0xc0, 0x45, 0x90, 0x30, 0x48, 0x4e, 0x24, 0x3b,
+	0xbd, 0x28, 0x0a, 0x53, 0x50, 0xa2, 0x72, 0x88, 0x9f, 0x83, 0x85, 0x14, 0xc5, 0xb9, 0x6c, 0x9e,
+	0x80, 0x97, 0x6d, 0x94, 0x66, 0x05, 0x75, 0x6e, 0xa8, 0xa3, 0xe1, 0xea, 0x17, 0xcb, 0xb8, 0x81,
+	0x26, 0x5a, 0xef, 0xa4, 0x11, 0xec, 0x7f, 0x5b, 0x87, 0x99, 0xcd, 0xe0, 0x34, 0xf4, 0x7b, 0xcc,
+	0x21, 0x6d, 0x48, 0x87, 0xa1, 0xbc, 0x7a, 0x8e, 0xbf, 0xd9, 0xe9, 0x2f, 0x7d, 0x3a, 0xa7, 0x2c,
+	0x4e, 0x7f, 0x0a, 0xc1, 0xd3, 0x5f, 0xa4, 0x3f, 0x7d, 0x23, 0x42, 0xe9, 0xe1, 0x72, 0x4a, 0x7b,
+	0x4b, 0x00, 0x73, 0x63, 0x3f, 0x78, 0xdf, 0xf1, 0x2b, 0x83, 0x1a, 0x82, 0x9d, 0x2f, 0x6e, 0x7b,
+	0x71, 0x6f, 0x4e, 0xee, 0xd6, 0x2d, 0x20, 0xa6, 0x8d, 0x88, 0x28, 0xb7, 0x33, 0x2a, 0xd1, 0xab,
+	0xec, 0x98, 0x20, 0x8a, 0x67, 0x3c, 0x01, 0x8f, 0xc3, 0xb7, 0x03, 0x1d, 0x62, 0xae, 0x45, 0x99,
+	0x87, 0xa4, 0xf8, 0x13, 0x61, 0x59, 0x98, 0xbb, 0x1e, 0x2a, 0xa6, 0xcb, 0xdb, 0x09, 0xfc, 0xf9,
+	0xa0, 0x2c, 0xae, 0xe9, 0x30, 0xf8, 0xa5, 0x58, 0x11, 0x62, 0x53, 0xc6, 0x1b, 0x0c, 0xf0, 0x35,
+	0x40, 0x7e, 0xb2, 0x6d, 0x70, 0xf3, 0xb4, 0x01, 0xb2, 0xd3, 0x72, 0x3a, 0xae, 0xcc, 0x35, 0xac,
+	0xe2, 0xe8, 0x10, 0xb9, 0x6f, 0x2a, 0xd6, 0x66, 0x27, 0x28, 0xd6, 0xf4, 0x48, 0xba, 0xab, 0xdc,
+	0x5c, 0xee, 0x9a, 0xaa, 0xd7, 0x97, 0x67, 0xf3, 0x16, 0x2b, 0x2d, 0x05, 0x98, 0x06, 0x89, 0x77,
+	0x18, 0x8f, 0x30, 0xcf, 0x22, 0x18, 0x18, 0xb9, 0xc1, 0x15, 0xc4, 0x23, 0xcf, 0xef, 0xb7, 0x89,
+	0x3a, 0x0b, 0x2b, 0x0c, 0xf3, 0x90, 0xbf, 0xd9, 0xc6, 0xb9, 0xc0, 0x7a, 0xc5, 0xc0, 0xb0, 0x6f,
+	0x54, 0x78, 0x98, 0xde, 0x6b, 0x35, 0x41, 0x54, 0xfe, 0xb3, 0x27, 0x05, 0xd9, 0xe5, 0xd5, 0x59,
+	0xa5, 0xfc, 0x17, 0xd3, 0x56, 0xfe, 0x67, 0x5e, 0x34, 0x0e, 0x8f, 0x89, 0x62, 0x1b, 0x37, 0xec,
+	0x2d, 0x19, 0x62, 0x9b, 0x88, 0xca, 0x0c, 0x7b, 0x3c, 0x02, 0xf9, 0x48, 0x3b, 0x89, 0xb5, 0x0d,
+	0x6f, 0x69, 0x99, 0xff, 0x84, 0x33, 0x18, 0x4e, 0x66, 0x3f, 0xc6, 0xfd, 0x27, 0xa6, 0x41, 0x9f,
+	0x5d, 0x63, 0xad, 0x3a, 0x1a, 0x82, 0x13, 0xc2, 0x8f, 0x5d, 0xbc, 0x1e, 0xd1, 0x61, 0x34, 0x11,
+	0x42, 0xe6, 0x17, 0xd1, 0x31, 0x3b, 0x72, 0xb4, 0xaf, 0x32, 0x8a, 0x0a, 0x93, 0xf7, 0xa0, 0x2a,
+	0xe6, 0x60, 0xdc, 0xbe, 0xc6, 0x6a, 0xb3, 0x68, 0xd6, 0x46, 0xbc, 0xc6, 0xe5, 0xa8, 0x68, 0xe4,
+	0x5b, 0x50, 0xc7, 0xc1, 0x96, 0xdb, 0xc1, 0x75, 0xd6, 0x47, 0x72, 0xc3, 0xc7, 0x29, 0x21, 0xf6,
+	0x02, 0x3d, 0x16, 0x8a, 0x81, 0xa2, 0x9a, 0x2e, 0xfe, 0xa1, 0x51, 0xfb, 0x06, 0xdf, 0x1d, 0x4d,
+	0x14, 0x05, 0x21, 0x13, 0x71, 0x4f, 0xf1, 0xb1, 0x03, 0x9f, 0xf6, 0xdb, 0x37, 0x59, 0xd5, 0x27,
+	0x91, 0x71, 0xe9, 0x48, 0x52, 0x44, 0x47, 0x83, 0x73, 0x37, 0x09, 0xdb, 0xb7, 0xb8, 0xd7, 0x6e,
+	0x16, 0x27, 0x5d, 0x68, 0x72, 0x6f, 0x2a, 0x37, 0xa2, 0x5e, 0x1c, 0x06, 0xed, 0x37, 0x0a, 0x07,
+	0x9a, 0xfb, 0x50, 0x39, 0x2c, 0x8a, 0x63, 0xa6, 0xc0, 0x99, 0x74, 0x12, 0x0e, 0xfa, 0x6e, 0x7f,
+	0xcc, 0xdf, 0x04, 0x68, 0xdb, 0xfc, 0xe8, 0x66, 0x80, 0x5f, 0xed, 0xb1, 0xb9, 0x0b, 0x0d, 0x7d,
+	0xea, 0xe1, 0x0d, 0x46, 0xb4, 0xfe, 0xb5, 0x2e, 0xe1, 0x7d, 0xc7, 0xbd, 0xf5, 0xfd, 0x7d, 0xbc,
+	0x18, 0x69, 0x91, 0x06, 0x54, 0xd5, 0x35, 0xc9, 0x12, 0x86, 0xba, 0xab, 0xab, 0xeb, 0xbb, 0xfb,
+	0xeb, 0x6b, 0xad, 0xb2, 0xed, 0x40, 0x43, 0x6f, 0x14, 0x66, 0xb1, 0xbd, 0xc3, 0x2e, 0x44, 0x55,
+	0xa1, 0x72, 0xb0, 0xb7, 0xee, 0xb4, 0x2c, 0xcc, 0x6c, 0xfd, 0x07, 0xbb, 0x9b, 0x0e, 0x4b, 0xde,
+	0x82, 0xc6, 0xc6, 0xce, 0xd6, 0x9a, 0xbb, 0xbf, 0xf9, 0x74, 0x7d, 0xe7, 0x60, 0xbf, 0x55, 0xc6,
+	0xab, 0x54, 0x38, 0x9d, 0x5d, 0x16, 0xe7, 0xb3, 0x56, 0xe5, 0x93, 0x4a, 0xb5, 0xd4, 0x2a, 0xdb,
+	0x7f, 0xab, 0x04, 0xb3, 0xe6, 0x94, 0x41, 0x96, 0xcc, 0x57, 0x33, 0xd7, 0x01, 0xf2, 0x00, 0xce,
+	0x46, 0xa5, 0x61, 0x62, 0x1b, 0x9e, 0xa3, 0xc2, 0x1a, 0x2b, 0x65, 0x37, 0xf1, 0xca, 0x06, 0x2b,
+	0x45, 0x48, 0x1e, 0x13, 0xf8, 0x5a, 0xd3, 0x8e, 0x09, 0x0c, 0x20, 0xbb, 0x39, 0x15, 0xe8, 0x94,
+	0xf1, 0x32, 0x8f, 0x59, 0xc1, 0x57, 0xd0, 0x86, 0x7e, 0x05, 0xba, 0xcd, 0xbf, 0x5e, 0x81, 0xba,
+	0xc6, 0x06, 0x5e, 0xa2, 0x00, 0xbf, 0x01, 0xc0, 0x0e, 0xfe, 0xa9, 0x97, 0x68, 0xc5, 0xd1, 0x10,
+	0xa3, 0xf7, 0xca, 0x99, 0xde, 0x43, 0xe6, 0xc6, 0xde, 0xd6, 0x32, 0x6f, 0xb5, 0x98, 0x20, 0xf6,
+	0xb1, 0x00, 0x58, 0x1f, 0xf3, 0xed, 0x52, 0x87, 0x90, 0x91, 0x46, 0x34, 0x0e, 0x07, 0xa7, 0x62,
+	0x18, 0xf8, 0xf1, 0xc9, 0xc0, 0xb0, 0x2c, 0x21, 0x11, 0x68, 0x57, 0xac, 0xa7, 0x1c, 0x13, 0x24,
+	0xef, 0x4a, 0x46, 0x5a, 0x65, 0xeb, 0x6b, 0x39, 0xcf, 0x15, 0x0d, 0x26, 0xfa, 0x34, 0x37, 0x7c,
+	0xfc, 0xa9, 0xa1, 0xaf, 0xe7, 0xd3, 0xbd, 0x8a, 0x26, 0x7b, 0x05, 0x08, 0xaa, 0xc7, 0x0b, 0x34,
+	0xb8, 0x15, 0xa7, 0x80, 0x42, 0xae, 0xa1, 0x4d, 0x72, 0xc4, 0x76, 0xd3, 0x54, 0x95, 0x8a, 0x7a,
+	0x69, 0x84, 0xbf, 0x82, 0x99, 0xf0, 0x9b, 0x16, 0x94, 0xbb, 0x4f, 0x77, 0xff, 0xe2, 0x14, 0xdc,
+	0xec, 0xb9, 0xaf, 0x54, 0x34, 0x62, 0xbf, 0xf9, 0xc5, 0x1d, 0x21, 0x4e, 0x71, 0x3f, 0x5a, 0x15,
+	0xb6, 0x13, 0x20, 0xdd, 0x7e, 0x5f, 0x74, 0xab, 0xfe, 0x62, 0x5b, 0xa4, 0x3f, 0x11, 0x28, 0x42,
+	0x45, 0x62, 0x4c, 0xa9, 0x58, 0x8c, 0xb9, 0x70, 0xb3, 0xb7, 0x37, 0xa1, 0xbe, 0xab, 0x3d, 0x3a,
+	0x68, 0x03, 0xf0, 0x02, 0xd8, 0x8b, 0x68, 0x56, 0xfa, 0x1c, 0x68, 0x8a, 0x6a, 0x55, 0x2a, 0xe9,
+	0x55, 0xb2, 0xff, 0x9e, 0xc5, 0x5f, 0x2a, 0x52, 0x4d, 0xe0, 0xe5, 0xa3, 0x6e, 0x5f, 0x5a, 0xa9,
+	0xd3, 0x67, 0x1b, 0x0c, 0x0c, 0xe3, 0xb0, 0xea, 0xb8, 0xe1, 0xd1, 0x51, 0x4c, 0xe5, 0x3d, 0x64,
+	0x03, 0x93, 0x87, 0x6b, 0x3c, 0xae, 0xfb, 0xbc, 0x84, 0x58, 0xdc, 0x47, 0xce, 0xe1, 0x7c, 0x97,
+	0x65, 0xc6, 0x34, 0x79, 0x03, 0x5b, 0x85, 0xd5, 0xeb, 0x12, 0xd9, 0x9e, 0xbe, 0x83, 0xae, 0xb6,
+	0x22, 0x5f, 0x53, 0x72, 0x96, 0x31, 0x15, 0x1d, 0x25, 0x74, 0xa6, 0x78, 0x33, 0x2a, 0xcd, 0x19,
+	0x44, 0x9e, 0x80, 0x73, 0xff, 0xc8, 0x8f, 0xb2, 0xd1, 0x39, 0xc7, 0x28, 0xa0, 0xd8, 0xcf, 0x60,
+	0x41, 0xee, 0x2d, 0xda, 0xa9, 0xdf, 0x1c, 0x48, 0xeb, 0x65, 0x52, 0x5b, 0x29, 0x2f, 0xb5, 0xd9,
+	0xff, 0xa4, 0x02, 0x33, 0x72, 0x43, 0xb0, 0x0b, 0x5e, 0xa0, 0xac, 0x99, 0x8f, 0x57, 0x92, 0xb6,
+	0xf1, 0x26, 0x18, 0x9b, 0x08, 0x1c, 0x20, 0xb7, 0xb3, 0xd2, 0x78, 0x6a, 0x10, 0x31, 0x09, 0x64,
+	0x09, 0x2a, 0x23, 0x2f, 0x39, 0x61, 0xfa, 0x72, 0x3e, 0x97, 0x58, 0x58, 0xda, 0x02, 0xa7, 0x4c,
+	0x5b, 0x60, 0xd1, 0x93, 0x9d, 0xfc, 0xe8, 0x99, 0xc3, 0xb1, 0x3f, 0xf8, 0xe9, 0x21, 0x35, 0xf7,
+	0xa5, 0x40, 0xe6, 0xb4, 0x51, 0xcd, 0x9d, 0x36, 0x5e, 0xfd, 0x1c, 0xf0, 0x3e, 0x4c, 0xf3, 0x87,
+	0x59, 0xc4, 0x7d, 0x73, 0x29, 0x22, 0xca, 0x9d, 0x4b, 0xfc, 0xe7, 0xd7, 0x26, 0x1c, 0x11, 0x57,
+	0x7f, 0xf8, 0xae, 0x6e, 0x3e, 0x7c, 0xa7, 0x5b, 0x29, 0x1b, 0x19, 0x2b, 0xe5, 0x1d, 0x68, 0xa9,
+	0xee, 0x63, 0x0a, 0xf3, 0x20, 0x16, 0xf7, 0x6b, 0x73, 0x78, 0x2a, 0xe6, 0xce, 0x1a, 0x62, 0x2e,
+	0x72, 0xe4, 0x6e, 0x92, 0xd0, 0xe1, 0x28, 0x11, 0x62, 0xae, 0xfd, 0x08, 0x9a, 0x46, 0x25, 0xcd,
+	0x37, 0x19, 0x9a, 0x50, 0xdb, 0xdc, 0x76, 0x1f, 0x6d, 0x6d, 0x3e, 0xde, 0xd8, 0x6f, 0x59, 0x18,
+	0xdc, 0x3b, 0x58, 0x5d, 0x5d, 0x5f, 0x5f, 0x63, 0x42, 0x07, 0xc0, 0xf4, 0xa3, 0xee, 0xe6, 0x16,
+	0x93, 0x58, 0xfe, 0x8f, 0x05, 0x75, 0x2d, 0x7b, 0xf2, 0x81, 0xea, 0x19, 0xfe, 0xfa, 0xd7, 0xf5,
+	0x7c, 0x15, 0x56, 0xe4, 0xc6, 0xa2, 0x75, 0x8d, 0x7a, 0xa5, 0xb4, 0x34, 0xf1, 0x95, 0x52, 0x1c,
+	0x1e, 0x8f, 0xe7, 0xa0, 0xfa, 0x81, 0x4b, 0x20, 0x59, 0x98, 0xfb, 0x0a, 0xa7, 0xbb, 0x21, 0xc6,
+	0xe4, 0x16, 0x80, 0x2c, 0x6c, 0x7f, 0x08, 0x90, 0xd6, 0xc6, 0x6c, 0xf6, 0x25, 0xb3, 0xd9, 0x96,
+	0xd6, 0xec, 0x92, 0xbd, 0xc6, 0x19, 0x86, 0xe8, 0x42, 0xe5, 0x4f, 0xf3, 0x2e, 0x10, 0xa9, 0x70,
+	0x66, 0x3e, 0xf9, 0xa3, 0x01, 0x4d, 0xe4, 0x7d, 0xd9, 0x79, 0x41, 0xd9, 0x54, 0x04, 0xf9, 0x66,
+	0x4c, 0x9a, 0x4b, 0xca, 0x77, 0x94, 0xd4, 0x6f, 0xf2, 0x9d, 0x9c, 0xb8, 0x8f, 0x0e, 0x88, 0x6b,
+	0x14, 0x73, 0xeb, 0x0e, 0x06, 0x99, 0xea, 0xa0, 0xc6, 0xb0, 0x80, 0x26, 0xd4, 0x89, 0x9f, 0xc2,
+	0x62, 0x97, 0x3f, 0x41, 0xf1, 0x55, 0x5d, 0xf9, 0x43, 0xc7, 0xfe, 0x6c, 0x96, 0xa2, 0xb0, 0x47,
+	0x30, 0xbf, 0x46, 0x0f, 0xc7, 0xc7, 0x5b, 0xf4, 0x34, 0x2d, 0x88, 0xe0, 0x95, 0x8e, 0xf0, 0x4c,
+	0xf4, 0x0f, 0xfb, 0x8d, 0x5e, 0x30, 0x03, 0x8c, 0xe3, 0xc6, 0x23, 0xda, 0x93, 0xaf, 0xab, 0x31,
+	0x64, 0x6f, 0x44, 0x7b, 0xf6, 0x87, 0x40, 0xf4, 0x7c, 0x44, 0x7f, 0xa1, 0x5c, 0x3a, 0x3e, 0x74,
+	0xe3, 0xf3, 0x38, 0xa1, 0x43, 0xf9, 0x6c, 0x9c, 0x0e, 0xd9, 0x6f, 0x43, 0x63, 0xd7, 0xc3, 0x17,
+	0x1f, 0xc5, 0xab, 0xb8, 0x68, 0x12, 0xf5, 0xce, 0x71, 0x3d, 0x2b, 0x93, 0x28, 0x23, 0xdb, 0x7f,
+	0x54, 0x81, 0x69, 0x1e, 0x13, 0x73, 0xed, 0xd3, 0x38, 0xf1, 0x03, 0x7e, 0x80, 0x10, 0xb9, 0x6a,
+	0x50, 0x8e, 0x61, 0x96, 0x0a, 0x18, 0xa6, 0x50, 0x8d, 0xcb, 0x57, 0xaa, 0xc4, 0x94, 0x35, 0x30,
+	0x64, 0x5b, 0xe9, 0xdd, 0x79, 0x3e, 0x53, 0x53, 0x20, 0xe3, 0x0c, 0x91, 0x2a, 0x12, 0x78, 0xfd,
+	0xe4, 0x5e, 0x20, 0x78, 0xa2, 0x0e, 0x15, 0xaa, 0x2b, 0x66, 0xe4, 0x4d, 0x49, 0x13, 0xcf, 0xab,
+	0x25, 0xaa, 0xaf, 0xa0, 0x96, 0xe0, 0xfa, 0xf2, 0x8b, 0xd4, 0x12, 0xf0, 0x2a, 0x6a, 0x89, 0x57,
+	0xb1, 0xf5, 0x77, 0xa0, 0xca, 0xf6, 0x74, 0x8d, 0x45, 0xca, 0x30, 0xf9, 0x15, 0xed, 0xcc, 0xce,
+	0x1d, 0xa2, 0xae, 0xa6, 0xeb, 0xc5, 0xa1, 0x3f, 0xfd, 0xe5, 0x98, 0x4d, 0x8f, 0x61, 0xae, 0xdb,
+	0xef, 0xef, 0x1c, 0x1d, 0xa5, 0xcf, 0xe7, 0x65, 0x86, 0xc8, 0xca, 0x0f, 0x51, 0xf6, 0xb8, 0x55,
+	0xd6, 0x0e, 0x0c, 0xe9, 0xc0, 0x97, 0xf5, 0x81, 0xb7, 0xd7, 0xa0, 0x95, 0x16, 0x94, 0xbe, 0x2e,
+	0x18, 0x22, 0x20, 0xca, 0xe0, 0x01, 0xcc, 0x9d, 0xfd, 0x48, 0x85, 0x55, 0x15, 0xb6, 0x17, 0xf8,
+	0x4b, 0x21, 0x2c, 0x1b, 0xc5, 0x31, 0x7e, 0xd3, 0x82, 0x29, 0x86, 0xbc, 0x7e, 0x86, 0xd9, 0xc6,
+	0x96, 0x2f, 0x6e, 0x6c, 0x65, 0x62, 0x63, 0x8d, 0x59, 0x6e, 0x7f, 0xcc, 0x25, 0x48, 0x59, 0xcd,
+	0xd4, 0xf1, 0x89, 0x95, 0x9b, 0x75, 0x7c, 0xe2, 0x9d, 0x22, 0x68, 0xf6, 0xcf, 0x2d, 0x58, 0x78,
+	0x44, 0x93, 0xde, 0x49, 0x46, 0xfe, 0x9c, 0xd8, 0xb6, 0x89, 0x43, 0x71, 0x03, 0x60, 0xe4, 0x9d,
+	0xd3, 0xc8, 0x0d, 0x42, 0x21, 0xd9, 0xd4, 0x1c, 0x0d, 0x91, 0x36, 0x6e, 0x34, 0x98, 0xc5, 0xb4,
+	0x17, 0x06, 0xfd, 0x58, 0xbe, 0x59, 0x90, 0x81, 0xed, 0xef, 0xc1, 0x65, 0xb3, 0x4a, 0xa2, 0x45,
+	0x05, 0xc2, 0x87, 0x55, 0x28, 0x7c, 0xd8, 0x3f, 0x82, 0x19, 0x31, 0xfb, 0x90, 0x83, 0x06, 0xde,
+	0x50, 0xbe, 0x42, 0xc9, 0x7e, 0xe3, 0x30, 0xb0, 0xc7, 0xf0, 0x7e, 0x3a, 0xf6, 0x23, 0xda, 0x97,
+	0x0f, 0x1a, 0x69, 0x10, 0x36, 0x14, 0xd5, 0x52, 0x41, 0x78, 0x16, 0x88, 0x27, 0x8d, 0x54, 0x18,
+	0xdf, 0x94, 0x61, 0x6f, 0xf5, 0xa2, 0x16, 0x5a, 0x4e, 0x8a, 0xdf, 0xb6, 0xa0, 0x25, 0x18, 0xba,
+	0xa2, 0x49, 0x0f, 0xb7, 0x8b, 0x1e, 0x24, 0x7b, 0x13, 0x9a, 0x4c, 0x07, 0xae, 0x44, 0x1b, 0xe1,
+	0x2d, 0x66, 0x80, 0x58, 0x5f, 0x79, 0x85, 0x63, 0xe8, 0x0f, 0xa4, 0x52, 0x41, 0x83, 0xa4, 0x74,
+	0x14, 0x79, 0xe2, 0x3a, 0xb8, 0xe5, 0xa8, 0x30, 0xde, 0x50, 0x9d, 0xd7, 0x2a, 0x2c, 0x3a, 0xf3,
+	0x01, 0xc8, 0x8d, 0x89, 0xbb, 0xef, 0xf0, 0x49, 0xb2, 0x6c, 0xee, 0x60, 0x69, 0x32, 0x23, 0x32,
+	0x9b, 0xc7, 0xde, 0x39, 0xab, 0x60, 0x3c, 0x1e, 0x0a, 0xa9, 0x59, 0x87, 0x90, 0x5f, 0x9d, 0x51,
+	0xfa, 0x5c, 0x45, 0xe1, 0x72, 0xbb, 0x81, 0x61, 0xe3, 0x87, 0xa8, 0xbb, 0x57, 0x91, 0x2a, 0xc2,
+	0x77, 0x40, 0x07, 0xed, 0xff, 0x54, 0x82, 0x05, 0xae, 0x8f, 0x13, 0x46, 0x30, 0xf5, 0xee, 0xe6,
+	0x34, 0xb7, 0x4b, 0xf1, 0xcd, 0x71, 0xe3, 0x92, 0x23, 0xc2, 0xe4, 0x83, 0x57, 0x34, 0x20, 0xa9,
+	0x7b, 0xe7, 0x13, 0xc6, 0xa2, 0x5c, 0x34, 0x16, 0x17, 0xf4, 0x74, 0x91, 0x1b, 0xc7, 0x54, 0xb1,
+	0x1b, 0xc7, 0xab, 0xb9, 0x4d, 0xe4, 0x2e, 0x67, 0xcf, 0x88, 0x58, 0x3a, 0x48, 0xee, 0xc3, 0xb2,
+	0x01, 0x30, 0xb9, 0x80, 0x2b, 0x1c, 0xab, 0xe2, 0x62, 0x35, 0x4d, 0x5c, 0x23, 0x0a, 0x7e, 0xee,
+	0x22, 0xee, 0x85, 0x23, 0x8a, 0x2e, 0xf6, 0x66, 0xe7, 0x0a, 0x69, 0xe4, 0xf7, 0x2c, 0x68, 0x3f,
+	0xe2, 0x5e, 0x82, 0x78, 0xad, 0xc3, 0x8f, 0x93, 0x30, 0x52, 0x8f, 0x47, 0xdf, 0x00, 0x88, 0x13,
+	0x2f, 0x12, 0xfa, 0x17, 0x7e, 0xa8, 0xd2, 0x10, 0xec, 0x23, 0x54, 0x56, 0x32, 0xaa, 0x50, 0x90,
+	0xc9, 0x70, 0xee, 0xd0, 0x2a, 0x4c, 0x55, 0x3a, 0x86, 0xaa, 0x56, 0x79, 0x38, 0xa5, 0xa7, 0x4c,
+	0xc4, 0xe3, 0x7a, 0xb2, 0x0c, 0x6a, 0xff, 0x6e, 0x09, 0xe6, 0xd2, 0x4a, 0xf2, 0xe7, 0x97, 0x0c,
+	0x41, 0x41, 0x9c, 0xf7, 0x14, 0x20, 0xdd, 0x4a, 0x5c, 0x1f, 0x0f, 0x80, 0x9a, 0xb5, 0x4a, 0x43,
+	0xd1, 0x6d, 0x44, 0x86, 0xc2, 0x71, 0xa2, 0xbd, 0x53, 0xaa, 0xc3, 0xfc, 0x1e, 0x29, 0x1e, 0x41,
+	0xc5, 0x71, 0x5a, 0x84, 0xd8, 0xa3, 0x61, 0x43, 0xf6, 0xf8, 0x89, 0x18, 0x53, 0x19, 0x24, 0x2d,
+	0x7e, 0x76, 0xe3, 0x63, 0x88, 0x3f, 0x8d, 0x33, 0x4d, 0x55, 0xbd, 0x7e, 0xaf, 0xd6, 0x3c, 0xcf,
+	0x31, 0xbd, 0x96, 0x5f, 0x71, 0x74, 0x48, 0x5a, 0x0b, 0x90, 0x73, 0x6a, 0x6a, 0x21, 0x03, 0xc3,
+	0xcd, 0xea, 0x4a, 0xc1, 0x30, 0x0a, 0x1e, 0xb0, 0x06, 0xf3, 0x47, 0x8a, 0x28, 0xbb, 0x9a, 0x33,
+	0x82, 0x25, 0xb9, 0x89, 0x9b, 0xdd, 0xeb, 0xe4, 0x13, 0xa8, 0x63, 0x3d, 0x1f, 0x3c, 0xe3, 0x15,
+	0x86, 0x3c, 0xc1, 0x3e, 0x80, 0x65, 0x71, 0xb3, 0xc0, 0x0f, 0x8e, 0x0d, 0x26, 0xfa, 0x65, 0xa6,
+	0x95, 0xfd, 0x1b, 0x65, 0xa8, 0x6f, 0xa1, 0x57, 0x49, 0xc4, 0xa5, 0x94, 0xdc, 0x34, 0x30, 0xe4,
+	0xc5, 0x7b, 0xc6, 0x4b, 0x5c, 0xf2, 0x68, 0xaa, 0xa5, 0x5f, 0x61, 0x7f, 0xd3, 0xdb, 0x30, 0x7c,
+	0x38, 0xb0, 0xd6, 0x3a, 0x6b, 0xd0, 0x21, 0x63, 0x30, 0x2b, 0x99, 0x03, 0xaa, 0xa6, 0x31, 0x9d,
+	0xca, 0x6b, 0x4c, 0x57, 0x0a, 0xbc, 0xca, 0xa7, 0x55, 0xb4, 0x1c, 0x0d, 0xdb, 0x16, 0xd1, 0x23,
+	0x1a, 0x51, 0xf9, 0xbe, 0x6d, 0xcd, 0x49, 0x01, 0xb6, 0xe1, 0x85, 0x42, 0x61, 0x89, 0x1b, 0x5e,
+	0x98, 0x50, 0xf4, 0x4e, 0x55, 0x0d, 0xc2, 0x63, 0xac, 0xb3, 0xbe, 0xba, 0xbe, 0xb9, 0xbb, 0xcf,
+	0xf5, 0xee, 0xbb, 0xdd, 0xcf, 0x9e, 0xae, 0x6f, 0xef, 0x73, 0xbd, 0xf9, 0xa3, 0x1d, 0xe7, 0x59,
+	0xd7, 0x11, 0x6a, 0xf7, 0x1d, 0x76, 0x2f, 0x67, 0x73, 0xbb, 0x55, 0x46, 0x2d, 0xba, 0xb8, 0xa2,
+	0xc3, 0xee, 0xeb, 0xb4, 0x2a, 0x64, 0x1e, 0x9a, 0x12, 0xe1, 0xcf, 0x1c, 0x4e, 0xd9, 0xff, 0xa3,
+	0x04, 0xad, 0x74, 0x70, 0xf7, 0xc3, 0xc4, 0x1b, 0xc4, 0xe2, 0x6d, 0x24, 0xf6, 0xec, 0x2c, 0xef,
+	0x1c, 0x71, 0x25, 0xc7, 0x00, 0xb1, 0x4d, 0x31, 0x0d, 0x0c, 0xd1, 0x22, 0x05, 0x70, 0x8a, 0xc9,
+	0x2d, 0x3e, 0xf5, 0x02, 0xe5, 0x63, 0x90, 0x27, 0x60, 0x6c, 0xe9, 0xe0, 0x9c, 0xc6, 0xe6, 0x43,
+	0x92, 0x27, 0xa0, 0xd3, 0x4d, 0x18, 0xf0, 0xd7, 0xb4, 0x5c, 0xb3, 0xa2, 0x5c, 0xca, 0x9a, 0x40,
+	0x45, 0xfd, 0x94, 0xa2, 0xa4, 0x55, 0xe7, 0x9a, 0xe6, 0x02, 0x8a, 0x11, 0x3f, 0xad, 0xd6, 0x4c,
+	0x26, 0x7e, 0x5a, 0x2f, 0x94, 0xe6, 0x69, 0xa2, 0x2b, 0x62, 0x54, 0xd8, 0x3e, 0x87, 0x76, 0x7e,
+	0x11, 0x89, 0x45, 0xfd, 0x0e, 0xcc, 0xd0, 0x20, 0x89, 0x7c, 0x9a, 0xfd, 0xba, 0x92, 0x36, 0xbd,
+	0x1d, 0x19, 0x85, 0xdc, 0x85, 0x69, 0xa6, 0x43, 0x8e, 0xc5, 0x3e, 0x29, 0x05, 0x80, 0xec, 0x30,
+	0x3a, 0x22, 0x9a, 0xbd, 0x0b, 0x9d, 0xf5, 0x17, 0x58, 0xe0, 0xaa, 0xfe, 0x05, 0x36, 0xb9, 0x84,
+	0xef, 0xe7, 0x44, 0x9e, 0x97, 0x3b, 0x19, 0x1c, 0x41, 0xd3, 0xc8, 0x8b, 0x7c, 0xeb, 0x55, 0x33,
+	0xd1, 0xa2, 0x71, 0xd5, 0xb2, 0x17, 0x88, 0x4f, 0xc8, 0xc9, 0xb7, 0x5c, 0x34, 0xc8, 0x3e, 0x85,
+	0xb9, 0xa7, 0xe3, 0x41, 0xe2, 0xa7, 0x9f, 0x93, 0x23, 0x1f, 0x40, 0x3d, 0xcd, 0x42, 0xf6, 0x57,
+	0x61, 0x51, 0x7a, 0x3c, 0x9c, 0x60, 0x43, 0xcc, 0xc9, 0xcd, 0x97, 0x98, 0x27, 0xd8, 0x57, 0x60,
+	0x39, 0x2d, 0x92, 0xf7, 0x9d, 0x14, 0x1b, 0x7f, 0xdf, 0x02, 0x92, 0xd2, 0xe4, 0xd7, 0xed, 0xc8,
+	0x63, 0x58, 0x40, 0xaf, 0x92, 0x01, 0xd5, 0xf3, 0x89, 0x45, 0x4f, 0x2c, 0x9a, 0xd5, 0xe3, 0x49,
+	0x63, 0xa7, 0x28, 0x05, 0x32, 0xf8, 0xe2, 0x8a, 0xa6, 0x0c, 0x3e, 0xd3, 0x25, 0x45, 0x0d, 0xf8,
+	0x04, 0x66, 0xcd, 0xc2, 0xd0, 0x43, 0x31, 0x53, 0xb3, 0x72, 0xe6, 0x99, 0x8a, 0x74, 0x66, 0x18,
+	0x31, 0xf1, 0xbc, 0xd1, 0x76, 0x28, 0x6e, 0x43, 0x54, 0x2b, 0x54, 0xcc, 0x9e, 0x07, 0xb9, 0x6c,
+	0x27, 0x37, 0x58, 0xbd, 0x9b, 0x22, 0xdb, 0xba, 0x32, 0x71, 0x50, 0x36, 0x2e, 0x15, 0xb4, 0x0a,
+	0xdf, 0x40, 0x11, 0xed, 0x5b, 0x86, 0x45, 0x51, 0x25, 0x59, 0x9d, 0xd4, 0x9d, 0xcc, 0x28, 0xd4,
+	0x70, 0x27, 0xeb, 0x40, 0x9b, 0xbf, 0x62, 0xaf, 0xb7, 0x43, 0x24, 0x6c, 0xc3, 0x12, 0x9e, 0xc8,
+	0x44, 0x2a, 0x3f, 0x78, 0xae, 0x4e, 0x8f, 0x7f, 0x6e, 0x41, 0x2b, 0x85, 0x85, 0x52, 0x4d, 0x9e,
+	0x51, 0x2c, 0xed, 0x8c, 0x62, 0x43, 0x83, 0x6d, 0x9e, 0x42, 0x71, 0x27, 0x78, 0xa2, 0x81, 0xa9,
+	0x38, 0xf2, 0xc1, 0xaa, 0xb2, 0x16, 0x47, 0x60, 0x2a, 0x8e, 0x7c, 0xf3, 0x91, 0xfb, 0x6c, 0x19,
+	0x18, 0x6e, 0xbc, 0x2c, 0xcc, 0xbf, 0x0a, 0xc5, 0xdd, 0x9b, 0x34, 0x04, 0xe9, 0xec, 0x55, 0xc4,
+	0x71, 0x7c, 0x42, 0x63, 0x21, 0xd6, 0x68, 0x88, 0x54, 0xe0, 0x1c, 0x79, 0xfe, 0x80, 0x29, 0x18,
+	0xb8, 0x88, 0x63, 0x60, 0xf6, 0x06, 0x2c, 0xe7, 0xba, 0x44, 0x70, 0x2c, 0xb4, 0xb1, 0x21, 0x90,
+	0x39, 0x83, 0x64, 0xbb, 0xc9, 0xe1, 0xb1, 0xec, 0x35, 0x20, 0xf2, 0xab, 0x85, 0xbb, 0x34, 0x12,
+	0x57, 0xce, 0xd8, 0xe1, 0x98, 0xb9, 0xb2, 0x49, 0x6d, 0x15, 0x0f, 0xc9, 0x57, 0xed, 0xc3, 0x40,
+	0x7e, 0x3d, 0x80, 0x87, 0xec, 0x04, 0x16, 0x1e, 0x7a, 0xcf, 0xa9, 0xcc, 0x29, 0x9d, 0x82, 0xf5,
+	0x91, 0xca, 0x54, 0xd6, 0x48, 0x3e, 0x5d, 0x95, 0x2f, 0xd6, 0xd1, 0x63, 0x23, 0x0f, 0x92, 0x9f,
+	0x6a, 0x54, 0xce, 0x50, 0x8e, 0x0e, 0xd9, 0xf7, 0xe1, 0xb2, 0x59, 0xaa, 0xe8, 0x02, 0x74, 0xeb,
+	0xd6, 0x3f, 0xcf, 0x58, 0x73, 0x54, 0x58, 0x4e, 0x26, 0x99, 0x66, 0x73, 0x4d, 0x4d, 0xa6, 0x6f,
+	0xc3, 0x72, 0x8e, 0x22, 0x32, 0x44, 0x0b, 0x68, 0x5a, 0x2e, 0x6f, 0x48, 0xc5, 0x31, 0x30, 0xfb,
+	0x01, 0x2c, 0x73, 0xdd, 0x67, 0x9a, 0x81, 0xa6, 0x95, 0xd1, 0x5b, 0x62, 0xe5, 0x5b, 0xf2, 0x3e,
+	0xb4, 0xf3, 0x89, 0xd3, 0x8b, 0x99, 0x7d, 0x46, 0x93, 0x3e, 0xc6, 0x32, 0x68, 0x1f, 0xc0, 0x52,
+	0xbe, 0x13, 0xb7, 0xfc, 0x2f, 0xd9, 0xf1, 0xb2, 0x8b, 0x52, 0xb2, 0xea, 0xa2, 0xff, 0x6e, 0xc1,
+	0x72, 0x8e, 0x24, 0xaa, 0x49, 0x81, 0x0c, 0x69, 0x72, 0x12, 0xf6, 0xdd, 0x7c, 0xc9, 0x1f, 0x28,
+	0x0f, 0xe7, 0xc2, 0xb4, 0x2b, 0x4f, 0x59, 0x42, 0x8d, 0xc2, 0xf7, 0xd5, 0x82, 0x0c, 0x3b, 0x3d,
+	0x58, 0x2a, 0x8e, 0x5d, 0x70, 0x69, 0xf7, 0x5b, 0xa6, 0x2a, 0xed, 0xfa, 0xc4, 0xf6, 0x63, 0xbd,
+	0x34, 0xcd, 0xda, 0x9d, 0x2f, 0xa0, 0xae, 0x7d, 0x3d, 0x84, 0x2c, 0xc3, 0xc2, 0xb3, 0xcd, 0xfd,
+	0xed, 0xf5, 0xbd, 0x3d, 0x77, 0xf7, 0xe0, 0xe1, 0x93, 0xf5, 0xcf, 0xdc, 0x8d, 0xee, 0xde, 0x46,
+	0xeb, 0x12, 0xbe, 0x59, 0xbd, 0xbd, 0xbe, 0xb7, 0xbf, 0xbe, 0x66, 0xe0, 0x16, 0xb9, 0x01, 0x9d,
+	0x83, 0xed, 0x03, 0xbc, 0xcd, 0x5d, 0x94, 0xae, 0x44, 0xae, 0xc3, 0x15, 0x41, 0x2f, 0x48, 0x5e,
+	0xbe, 0x73, 0x0f, 0x20, 0xf5, 0x9b, 0x41, 0x61, 0xd1, 0xe9, 0x6e, 0x3f, 0x59, 0x5f, 0x73, 0x37,
+	0x36, 0xb7, 0xf7, 0xf7, 0xf8, 0x63, 0xb5, 0x5b, 0xeb, 0x8f, 0xbb, 0xab, 0x9f, 0x09, 0xc4, 0xba,
+	0xf3, 0x00, 0x5a, 0x59, 0x23, 0xba, 0xe1, 0xe9, 0x71, 0x91, 0x4b, 0xc8, 0x9d, 0xff, 0x55, 0x06,
+	0x48, 0xef, 0x38, 0xe2, 0x65, 0xf2, 0xb5, 0xee, 0x7e, 0x77, 0x6b, 0x07, 0xab, 0xed, 0xec, 0xec,
+	0xaf, 0xaf, 0xee, 0xbb, 0xce, 0xfa, 0xa7, 0xad, 0x4b, 0x85, 0x94, 0x9d, 0x5d, 0x94, 0x75, 0x97,
+	0x61, 0x61, 0x73, 0x7b, 0x73, 0x7f, 0xb3, 0xbb, 0xe5, 0x3a, 0x3b, 0x07, 0x78, 0x0f, 0x9d, 0x3d,
+	0x19, 0x5c, 0x26, 0x37, 0xe1, 0xea, 0xc1, 0xee, 0x23, 0x67, 0x67, 0x7b, 0xdf, 0xdd, 0xdb, 0x38,
+	0xd8, 0x5f, 0x63, 0x0f, 0x0e, 0xaf, 0x3a, 0x9b, 0xbb, 0x3c, 0xcf, 0xca, 0x45, 0x11, 0x30, 0xeb,
+	0x29, 0xec, 0xe3, 0xc7, 0x3b, 0x7b, 0x7b, 0x9b, 0xbb, 0xee, 0xa7, 0x07, 0xeb, 0xce, 0xe6, 0xfa,
+	0x1e, 0x4b, 0x38, 0x5d, 0x80, 0x63, 0xfc, 0x19, 0x94, 0xa4, 0xf7, 0xb7, 0xbe, 0xef, 0xee, 0x6c,
+	0x6f, 0xee, 0x6c, 0xb3, 0xa8, 0x55, 0x13, 0xc2, 0x58, 0x35, 0xd2, 0x81, 0xa5, 0xf5, 0x1f, 0xec,
+	0xbb, 0x05, 0x39, 0xc3, 0x04, 0x1a, 0xa6, 0xab, 0x93, 0x2b, 0xb0, 0xb8, 0xb7, 0xdf, 0xdd, 0xdf,
+	0x5c, 0x75, 0xc5, 0x63, 0xe5, 0x38, 0x6c, 0x98, 0xac, 0x51, 0x4c, 0xc2, 0x54, 0x4d, 0xbc, 0xb5,
+	0x2f, 0xce, 0x05, 0x6e, 0x77, 0x6d, 0xcd, 0x61, 0x09, 0x66, 0x73, 0x28, 0xc6, 0x9d, 0xc3, 0x81,
+	0x7a, 0xba, 0xbb, 0xcb, 0xa2, 0xb4, 0x64, 0x00, 0x29, 0xf3, 0x18, 0xe8, 0x3e, 0xe5, 0x94, 0x1b,
+	0x32, 0x80, 0x94, 0x9b, 0xd8, 0x17, 0xbc, 0x71, 0x4f, 0xd7, 0xf7, 0xf6, 0xba, 0x8f, 0x45, 0x4b,
+	0xde, 0x2a, 0xc0, 0x31, 0xfe, 0xdb, 0xf7, 0x7f, 0x5e, 0x86, 0x59, 0x7e, 0x2f, 0x9d, 0x7f, 0xc0,
+	0x97, 0x46, 0xe4, 0x29, 0xcc, 0x88, 0xcf, 0x4f, 0x93, 0x45, 0xf5, 0xe2, 0xac, 0xfe, 0xc1, 0xeb,
+	0xce, 0x52, 0x16, 0x16, 0xdb, 0xf3, 0xc2, 0x6f, 0xfc, 0xeb, 0xff, 0xf6, 0x5b, 0xa5, 0x26, 0xa9,
+	0xdf, 0x3d, 0x7d, 0xef, 0xee, 0x31, 0x0d, 0x62, 0xcc, 0xe3, 0xff, 0x03, 0x48, 0xbf, 0x96, 0x4c,
+	0xda, 0xca, 0x8a, 0x9d, 0xf9, 0xe2, 0x74, 0xe7, 0x4a, 0x01, 0x45, 0xe4, 0x7b, 0x85, 0xe5, 0xbb,
+	0x60, 0xcf, 0x62, 0xbe, 0x7e, 0xe0, 0x27, 0xfc, 0x93, 0xc8, 0x1f, 0x5b, 0x77, 0x48, 0x1f, 0x1a,
+	0xfa, 0x97, 0x87, 0x89, 0xf4, 0xf2, 0x2e, 0xf8, 0xc4, 0x72, 0xe7, 0x6a, 0x21, 0x4d, 0xca, 0x24,
+	0xac, 0x8c, 0x45, 0xbb, 0x85, 0x65, 0x8c, 0x59, 0x8c, 0xb4, 0x94, 0x01, 0xcc, 0x9a, 0x5f, 0x01,
+	0x26, 0xd7, 0x34, 0xe1, 0x29, 0xf7, 0x75, 0xe3, 0xce, 0xf5, 0x09, 0x54, 0x51, 0xd6, 0x75, 0x56,
+	0xd6, 0xb2, 0x4d, 0xb0, 0xac, 0x1e, 0x8b, 0x23, 0xbf, 0x6e, 0xfc, 0xb1, 0x75, 0xe7, 0xfe, 0xdf,
+	0xbd, 0x07, 0x35, 0x75, 0x03, 0x84, 0xfc, 0x04, 0x9a, 0xc6, 0xb3, 0x06, 0xe4, 0x6a, 0xf1, 0x63,
+	0x07, 0xbc, 0xe4, 0x6b, 0x17, 0xbd, 0x84, 0x60, 0xdf, 0x60, 0x05, 0xb7, 0xc9, 0x12, 0x16, 0x2c,
+	0x2e, 0xe8, 0xdf, 0x65, 0x6f, 0x98, 0xf0, 0xf7, 0x7b, 0x9f, 0x6b, 0x12, 0x29, 0x2f, 0xec, 0x5a,
+	0x56, 0x48, 0x34, 0x4a, 0xbb, 0x3e, 0x81, 0x2a, 0x8a, 0xbb, 0xc6, 0x8a, 0x5b, 0x22, 0x97, 0xf5,
+	0xe2, 0xd4, 0xad, 0x0c, 0xca, 0xde, 0xd0, 0xd6, 0xbf, 0x71, 0x4b, 0xae, 0xab, 0x89, 0x55, 0xf4,
+	0xed, 0x5b, 0x35, 0x45, 0xf2, 0x1f, 0xc0, 0xb5, 0xdb, 0xac, 0x28, 0x42, 0xd8, 0xf0, 0xe9, 0x9f,
+	0xb8, 0x25, 0x87, 0x50, 0xd7, 0x3e, 0x05, 0x47, 0xae, 0x4c, 0xfc, 0x6c, 0x5d, 0xa7, 0x53, 0x44,
+	0x2a, 0x6a, 0x8a, 0x9e, 0xff, 0x5d, 0x54, 0x38, 0xfd, 0x08, 0x6a, 0xea, 0xf3, 0x59, 0x64, 0x59,
+	0xfb, 0xd8, 0x9b, 0xfe, 0xf5, 0xb1, 0x4e, 0x3b, 0x4f, 0x28, 0x9a, 0x7c, 0x7a, 0xee, 0x38, 0xf9,
+	0x9e, 0x41, 0x5d, 0xfb, 0x44, 0x96, 0x6a, 0x40, 0xfe, 0x33, 0x5c, 0x9d, 0x4e, 0x11, 0x49, 0x14,
+	0x31, 0xcf, 0x8a, 0xa8, 0x93, 0x1a, 0x9b, 0xdf, 0xf8, 0x05, 0x2d, 0xb2, 0x05, 0x8b, 0x42, 0xf2,
+	0x3e, 0xa4, 0xaf, 0x33, 0x0c, 0x05, 0x9f, 0x15, 0xbe, 0x67, 0x91, 0x07, 0x50, 0x95, 0x1f, 0x66,
+	0x23, 0x4b, 0xc5, 0xdf, 0xbb, 0xeb, 0x2c, 0xe7, 0x70, 0x21, 0x32, 0x7c, 0x06, 0x90, 0x7e, 0x8f,
+	0x4b, 0x31, 0x89, 0xdc, 0xf7, 0xbd, 0x3a, 0x57, 0x0a, 0x28, 0xa2, 0x81, 0x4b, 0xac, 0x81, 0x2d,
+	0xc2, 0x98, 0x44, 0x40, 0xcf, 0xe4, 0x2b, 0x96, 0x3f, 0x86, 0xba, 0xf6, 0x49, 0x2e, 0xd5, 0x7d,
+	0xf9, 0xcf, 0x79, 0x75, 0x3a, 0x45, 0x24, 0x91, 0x7b, 0x87, 0xe5, 0x7e, 0xd9, 0x9e, 0xc3, 0xdc,
+	0xf1, 0x93, 0x5b, 0x43, 0x1e, 0x01, 0x07, 0xe8, 0x04, 0x9a, 0xc6, 0x77, 0xb7, 0xd4, 0x0a, 0x2d,
+	0xfa, 0xaa, 0x57, 0xe7, 0x5a, 0x31, 0xd1, 0x9c, 0x67, 0xf6, 0x3c, 0x96, 0xc3, 0x3c, 0x58, 0xcf,
+	0xb5, 0x92, 0x7e, 0x08, 0x75, 0xed, 0x1b, 0x5a, 0xaa, 0x2d, 0xf9, 0xcf, 0x75, 0x75, 0x3a, 0x45,
+	0x24, 0x51, 0xc6, 0x65, 0x56, 0xc6, 0xac, 0xcd, 0xa6, 0x02, 0x7b, 0x92, 0x1d, 0xf3, 0xfe, 0x09,
+	0xcc, 0x9a, 0x5f, 0xd5, 0x52, 0x6b, 0xbf, 0xf0, 0xfb, 0x5c, 0x9d, 0xeb, 0x13, 0xa8, 0xe6, 0x94,
+	0xbe, 0xb3, 0xa0, 0x0a, 0xb9, 0xfb, 0xb9, 0xb8, 0xd0, 0xfa, 0x05, 0xf9, 0x14, 0x6a, 0x5c, 0x00,
+	0xa4, 0x51, 0xba, 0x5e, 0xb2, 0x1f, 0x14, 0xe8, 0xb4, 0xf3, 0x84, 0xa2, 0xc9, 0xcc, 0x32, 0xc7,
+	0xb3, 0xbd, 0x9a, 0xcc, 0xea, 0xe9, 0xff, 0x58, 0xb5, 0xa1, 0xf0, 0x0b, 0x03, 0x9d, 0x56, 0x96,
+	0x7a, 0xcf, 0xe2, 0xdb, 0x1f, 0x7b, 0x60, 0x5d, 0xdb, 0xfe, 0xf4, 0xd7, 0xff, 0x3b, 0x4b, 0x59,
+	0xb8, 0x78, 0xfb, 0x4b, 0x7c, 0xcc, 0x63, 0xc8, 0xb8, 0x9c, 0xfe, 0xba, 0xb9, 0xbe, 0xbc, 0x0a,
+	0x1e, 0x44, 0xef, 0xdc, 0x98, 0x44, 0x36, 0x7b, 0x96, 0x2c, 0x88, 0x62, 0xe4, 0x13, 0xe7, 0xac,
+	0xb8, 0x00, 0xe6, 0x32, 0xaf, 0x6a, 0xa9, 0xe2, 0x8a, 0x1f, 0x3e, 0xec, 0xdc, 0x98, 0x44, 0x2e,
+	0xe2, 0x7c, 0x92, 0x79, 0xdf, 0x95, 0xaf, 0xaa, 0xfe, 0x3a, 0x34, 0xf4, 0x6f, 0x0f, 0x11, 0x9d,
+	0x05, 0x65, 0x4b, 0xba, 0x5a, 0x48, 0x33, 0x27, 0x25, 0x69, 0xe8, 0xc5, 0x90, 0xef, 0xc3, 0x92,
+	0x1a, 0x55, 0xfd, 0x71, 0xa5, 0x98, 0xdc, 0x2c, 0x78, 0x72, 0xc9, 0x18, 0xdb, 0x2b, 0x13, 0xdf,
+	0x64, 0xba, 0x67, 0xe1, 0x64, 0x37, 0xbf, 0x7b, 0x92, 0x6e, 0x74, 0x45, 0x9f, 0x7b, 0xe9, 0x5c,
+	0x9f, 0x40, 0x2d, 0x1a, 0x12, 0xd5, 0x47, 0xfc, 0x9a, 0x10, 0xbe, 0x5a, 0xa4, 0x3d, 0x85, 0x87,
+	0xdf, 0xdd, 0x50, 0x0b, 0x37, 0xff, 0x76, 0x72, 0xa7, 0x48, 0x4b, 0x66, 0x2f, 0xb3, 0xfc, 0xe7,
+	0x6d, 0xa3, 0x73, 0x70, 0xd1, 0xae, 0x42, 0x5d, 0xcb, 0xe3, 0xa2, 0x7c, 0x97, 0x35, 0x92, 0xfe,
+	0xa6, 0xee, 0x3d, 0x8b, 0x6c, 0x41, 0x2b, 0xfb, 0xfc, 0xa7, 0x62, 0x61, 0x45, 0x4f, 0x96, 0x76,
+	0x32, 0x44, 0xe3, 0xd1, 0x50, 0xb2, 0x0b, 0x73, 0xc6, 0xe7, 0x7e, 0xc3, 0x28, 0x2b, 0x44, 0x98,
+	0x9f, 0x01, 0xee, 0x5c, 0x2d, 0xa6, 0xb2, 0x6a, 0xdf, 0xb6, 0xee, 0x59, 0xe4, 0x77, 0xf0, 0x3b,
+	0xbf, 0xfa, 0xa3, 0x7a, 0xc6, 0x55, 0xbe, 0x4c, 0x3b, 0xdb, 0x3a, 0x4d, 0x6f, 0xa8, 0xed, 0xb0,
+	0x4e, 0xdc, 0xba, 0xf3, 0x89, 0x31, 0x48, 0x9f, 0x1b, 0x86, 0xe3, 0x95, 0xec, 0x37, 0x7f, 0xbf,
+	0xc8, 0x46, 0xd0, 0xdf, 0xbf, 0xfe, 0xe2, 0x9e, 0x45, 0xfe, 0x81, 0x05, 0xb3, 0xa6, 0xe7, 0x91,
+	0x6a, 0x6e, 0xa1, 0x8f, 0x53, 0xe7, 0xfa, 0x04, 0xaa, 0x98, 0x4a, 0x3f, 0x64, 0xb5, 0xdc, 0xbf,
+	0xe3, 0x18, 0xb5, 0x14, 0x5f, 0xec, 0xf9, 0x72, 0xb5, 0x25, 0x1f, 0xf3, 0x4f, 0xf0, 0x4b, 0xa7,
+	0x4b, 0x92, 0xff, 0x64, 0x7b, 0x67, 0xc1, 0xc0, 0x78, 0x9d, 0xd8, 0x20, 0xfc, 0x18, 0xe6, 0xb4,
+	0xb4, 0x6c, 0x16, 0xbf, 0x6a, 0x7a, 0xfb, 0x4d, 0xd6, 0xa6, 0x1b, 0xf6, 0x15, 0xa3, 0x4d, 0x59,
+	0x39, 0xa7, 0x0b, 0x75, 0xed, 0xdb, 0xe4, 0xe9, 0x46, 0x9d, 0xfb, 0x5e, 0xf9, 0xe4, 0x4a, 0x0e,
+	0x61, 0x4e, 0x8b, 0x6e, 0x2c, 0xb5, 0x57, 0xcc, 0xc6, 0xbe, 0xc3, 0xea, 0xfa, 0xa6, 0x7d, 0x73,
+	0x62, 0x5d, 0xef, 0x32, 0xff, 0x21, 0xac, 0xf1, 0x2e, 0x40, 0xea, 0x24, 0x4d, 0x32, 0x0e, 0xba,
+	0x8a, 0x01, 0xe5, 0xfd, 0xa8, 0xcd, 0xf5, 0x2c, 0xfd, 0x78, 0x31, 0xc7, 0x1f, 0x71, 0x76, 0x2a,
+	0xe2, 0xc7, 0x86, 0xb0, 0x67, 0x7a, 0x92, 0x74, 0x3a, 0x45, 0xa4, 0x22, 0x66, 0x2a, 0xf3, 0x27,
+	0x07, 0xd0, 0xdc, 0x0a, 0xc3, 0xe7, 0xe3, 0x91, 0xac, 0x31, 0x31, 0x5d, 0xfb, 0xd0, 0xe7, 0xba,
+	0x93, 0x69, 0x85, 0x7d, 0x8b, 0x65, 0xd5, 0x21, 0x6d, 0x2d, 0xab, 0xbb, 0x9f, 0xa7, 0x0e, 0xd8,
+	0x5f, 0x10, 0x0f, 0xe6, 0x15, 0x8f, 0x56, 0x15, 0xef, 0x98, 0xd9, 0x18, 0x9c, 0x39, 0x5b, 0x84,
+	0x71, 0x2a, 0x91, 0xb5, 0xbd, 0x1b, 0xcb, 0x3c, 0xef, 0x59, 0x64, 0x17, 0x1a, 0x6b, 0xb4, 0xc7,
+	0x1e, 0x45, 0x62, 0xfe, 0x71, 0x0b, 0x86, 0x8f, 0x15, 0x77, 0xac, 0xeb, 0x34, 0x0d, 0xd0, 0xdc,
+	0xb7, 0x46, 0xde, 0x79, 0x44, 0x7f, 0x7a, 0xf7, 0x73, 0xe1, 0x79, 0xf7, 0x05, 0x71, 0xa0, 0x2a,
+	0x1d, 0x99, 0x94, 0xb4, 0x9a, 0x71, 0xa1, 0xea, 0x2c, 0xe7, 0x70, 0xd1, 0xbd, 0x8b, 0x2c, 0xeb,
+	0x39, 0x1b, 0x30, 0x6b, 0xee, 0xf0, 0x83, 0x83, 0x77, 0x00, 0x90, 0xfa, 0x0b, 0x11, 0x5d, 0x7a,
+	0x31, 0x3c, 0x9d, 0x3a, 0x57, 0x0a, 0x28, 0x22, 0x67, 0xc2, 0x72, 0x6e, 0x10, 0x2d, 0x67, 0x72,
+	0x04, 0x0d, 0xdd, 0x6d, 0x47, 0x75, 0x6d, 0x81, 0x7b, 0x51, 0xe7, 0x6a, 0x21, 0xad, 0xe8, 0xd8,
+	0xc9, 0x33, 0x97, 0xdd, 0x8d, 0xd5, 0x17, 0x5b, 0xb9, 0xf4, 0xd6, 0x34, 0xb6, 0xf2, 0x8c, 0x7b,
+	0x67, 0xe7, 0x6a, 0x21, 0xad, 0x68, 0xf6, 0xa9, 0xcb, 0x61, 0x03, 0xf4, 0xc3, 0xcc, 0x78, 0x84,
+	0xaa, 0x5d, 0x7c, 0x92, 0x1f, 0x69, 0xe7, 0xd6, 0xe4, 0x08, 0x66, 0x69, 0x77, 0xcc, 0xd2, 0xf6,
+	0xa0, 0xc9, 0x5f, 0x18, 0x3f, 0xa4, 0xfc, 0xa5, 0x85, 0xcc, 0x63, 0x95, 0xfa, 0x3b, 0x0e, 0x9d,
+	0x85, 0x02, 0x9a, 0x29, 0x63, 0xf2, 0x4f, 0xfd, 0xfc, 0x08, 0xea, 0x8f, 0x69, 0x22, 0x9f, 0x56,
+	0x50, 0xf3, 0x26, 0xf3, 0xd6, 0x42, 0xa7, 0xe0, 0x65, 0x06, 0x73, 0x19, 0xb1, 0xdc, 0xee, 0xa2,
+	0xd9, 0x90, 0xf3, 0x6b, 0xd7, 0xef, 0x7f, 0x41, 0x7e, 0xc0, 0x32, 0x57, 0x0f, 0xdd, 0x2c, 0x69,
+	0xf7, 0xe4, 0xf5, 0xcc, 0xe7, 0x32, 0x78, 0x51, 0xce, 0x41, 0xd8, 0xa7, 0x9a, 0xb4, 0x1d, 0x40,
+	0x5d, 0x7b, 0xc1, 0x4b, 0xf1, 0x94, 0xfc, 0x8b, 0x6d, 0x9d, 0x4e, 0x11, 0x49, 0xf4, 0xf3, 0x6d,
+	0x56, 0x8e, 0x4d, 0x6e, 0xa5, 0xe5, 0xf0, 0x47, 0xbe, 0xd2, 0x92, 0xee, 0x7e, 0xee, 0x0d, 0x93,
+	0x2f, 0xc8, 0x33, 0xf6, 0xbd, 0x2c, 0xfd, 0xe9, 0x88, 0xf4, 0xd8, 0x96, 0x7d, 0x65, 0xa2, 0x43,
+	0xf2, 0x24, 0xf3, 0x28, 0xc7, 0x8b, 0x62, 0xc2, 0xed, 0x07, 0x00, 0xf8, 0x2c, 0xc1, 0x9a, 0x47,
+	0x87, 0x61, 0x90, 0x6e, 0x3f, 0xe9, 0xc3, 0x05, 0x9d, 0x05, 0x03, 0x13, 0x87, 0xcb, 0x67, 0xda,
+	0x39, 0x57, 0x1f, 0x62, 0x22, 0x27, 0xd7, 0xc4, 0xb7, 0x0d, 0x3a, 0x9d, 0xa2, 0x18, 0x4a, 0x70,
+	0xea, 0x02, 0xa4, 0x2e, 0xc1, 0x6a, 0xc1, 0xe7, 0xbc, 0x8d, 0x3b, 0x57, 0x0a, 0x28, 0xa2, 0x6e,
+	0xbb, 0x50, 0x4b, 0x1d, 0xdb, 0x96, 0xd5, 0xea, 0x35, 0xdd, 0xe0, 0x3a, 0xed, 0x3c, 0x41, 0x8c,
+	0x4a, 0x8b, 0x75, 0x15, 0x90, 0x2a, 0x76, 0x15, 0xf3, 0x21, 0xf3, 0x61, 0x81, 0x57, 0x50, 0x49,
+	0x90, 0x4c, 0x77, 0xac, 0x3e, 0x8b, 0x96, 0x77, 0xf9, 0xea, 0x5c, 0x2d, 0xa4, 0x15, 0x29, 0xdf,
+	0x70, 0xb6, 0xf2, 0x7b, 0x9b, 0xc8, 0x31, 0x86, 0x30, 0x9f, 0x73, 0x82, 0x51, 0x4b, 0x7a, 0x92,
+	0x97, 0x53, 0xe7, 0xd6, 0xe4, 0x08, 0x45, 0xfc, 0x35, 0x3e, 0xf3, 0x93, 0xde, 0x09, 0x16, 0xf7,
+	0x5c, 0xf7, 0x82, 0x10, 0x5d, 0x76, 0x23, 0x67, 0x57, 0x37, 0x7b, 0xee, 0xe6, 0x44, 0x7a, 0x91,
+	0xda, 0xc0, 0x53, 0xb1, 0xc8, 0xef, 0x5b, 0xb0, 0x50, 0x60, 0x90, 0x27, 0x6f, 0x48, 0x25, 0xd1,
+	0x44, 0x63, 0x7d, 0xa7, 0xd0, 0x5e, 0x6b, 0xef, 0xb1, 0x82, 0x9e, 0x92, 0x27, 0x86, 0x60, 0xc1,
+	0x4d, 0xa5, 0x82, 0x0d, 0x5c, 0x28, 0xd4, 0x15, 0x4a, 0x74, 0x3f, 0x85, 0x65, 0x5e, 0x91, 0xee,
+	0x60, 0x90, 0xb1, 0x25, 0xdf, 0xd0, 0x6a, 0x51, 0x60, 0x23, 0xef, 0x5c, 0xc9, 0xd1, 0xa5, 0x9d,
+	0x7c, 0xc2, 0x71, 0x86, 0x57, 0x95, 0x8c, 0xa1, 0x95, 0xb5, 0xcf, 0x92, 0xc9, 0x79, 0xa9, 0x01,
+	0x98, 0x68, 0xd3, 0xfd, 0x3a, 0x2b, 0xec, 0xa6, 0xdd, 0x29, 0xea, 0x17, 0xae, 0x01, 0xc1, 0xc1,
+	0xff, 0xcb, 0xca, 0x98, 0x9c, 0x69, 0xe7, 0x4d, 0xf5, 0x45, 0xa9, 0x62, 0xeb, 0x77, 0xe7, 0x9a,
+	0x19, 0x21, 0x53, 0xfc, 0x5b, 0xac, 0xf8, 0x5b, 0xf6, 0xd5, 0xa2, 0xe2, 0x23, 0x9e, 0x84, 0xab,
+	0x5e, 0x96, 0xb3, 0x4c, 0x44, 0xd6, 0xe0, 0x56, 0xd1, 0x78, 0x4f, 0x3c, 0x8b, 0x66, 0xfa, 0xfa,
+	0xd2, 0x3d, 0x8b, 0xc4, 0x30, 0x97, 0xb1, 0xe1, 0xaa, 0x43, 0x7b, 0xb1, 0xb9, 0xbb, 0x73, 0x63,
+	0x12, 0x59, 0xb4, 0xea, 0x0d, 0xd6, 0xaa, 0xab, 0xe4, 0x4a, 0x51, 0xab, 0x98, 0xb9, 0x97, 0xfc,
+	0x18, 0x1a, 0xba, 0xc9, 0x54, 0x31, 0x88, 0x02, 0xeb, 0x6d, 0xe7, 0x6a, 0x21, 0xad, 0x48, 0x98,
+	0x95, 0xd6, 0x55, 0xae, 0x51, 0x9a, 0xcb, 0x98, 0x51, 0x8d, 0x66, 0xe5, 0x0d, 0xaf, 0x9d, 0x1b,
+	0x93, 0xc8, 0xa2, 0x28, 0x43, 0xcb, 0x2b, 0x8b, 0xba, 0xeb, 0xf7, 0x63, 0x72, 0x06, 0xad, 0xac,
+	0xd9, 0x54, 0xad, 0x80, 0x09, 0xc6, 0xd8, 0xce, 0xcd, 0x89, 0x74, 0x51, 0x9c, 0xcd, 0x8a, 0xbb,
+	0x76, 0xa7, 0x63, 0x14, 0xf7, 0xb9, 0x66, 0xae, 0xfd, 0x82, 0x44, 0xbc, 0x91, 0x9a, 0x0d, 0xd2,
+	0x68, 0x64, 0xde, 0x74, 0xda, 0xb9, 0x31, 0x89, 0x2c, 0x4a, 0x35, 0x36, 0x74, 0x55, 0xaa, 0x66,
+	0xf9, 0x7c, 0xf8, 0xf6, 0x0f, 0xbf, 0x7e, 0xec, 0x27, 0x27, 0xe3, 0xc3, 0x95, 0x5e, 0x38, 0xbc,
+	0xdb, 0xed, 0x25, 0x7e, 0xe0, 0x8f, 0x87, 0xef, 0x8e, 0xa2, 0xf0, 0x27, 0xb4, 0x97, 0xdc, 0x1d,
+	0x04, 0xfd, 0xbb, 0xac, 0x88, 0xc3, 0xe9, 0x51, 0x14, 0x26, 0xe1, 0xb7, 0xfe, 0xdf, 0x00, 0x23,
+	0xdb, 0xe0, 0xf4, 0xe4, 0x94, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -11559,6 +13853,12 @@ type LightningClient interface {
 	//it's identity pubkey, alias, the chains it is connected to, and information
 	//concerning the number of open+pending channels.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	//* lncli: `getrecoveryinfo`
+	//GetRecoveryInfo returns information concerning the recovery mode including
+	//whether it's in a recovery mode, whether the recovery is finished, and the
+	//progress made so far. This includes the rescan for the commitment outputs
+	//of channels that were closed before the node was restored from its seed.
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
 	//* lncli: `pendingchannels`
 	//PendingChannels returns a list of all the channels that are currently
 	//considered "pending". A channel is pending if it has finished the funding
@@ -11684,6 +13984,18 @@ type LightningClient interface {
 	//it, returning a full description of the conditions encoded within the
 	//payment request.
 	DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error)
+	//* lncli: `addoffer`
+	//AddOffer creates a new offer: a long-lived payment code that can be paid
+	//many times. Payers fetch a fresh invoice for every payment from this node
+	//over onion messages, which this node issues automatically.
+	AddOffer(ctx context.Context, in *AddOfferRequest, opts ...grpc.CallOption) (*AddOfferResponse, error)
+	//* lncli: `listoffers`
+	//ListOffers returns all offers this node created.
+	ListOffers(ctx context.Context, in *ListOffersRequest, opts ...grpc.CallOption) (*ListOffersResponse, error)
+	//* lncli: `fetchinvoice`
+	//FetchInvoice requests an invoice for an offer from its issuer over onion
+	//messages. The returned payment request can be paid like any other.
+	FetchInvoice(ctx context.Context, in *FetchInvoiceRequest, opts ...grpc.CallOption) (*FetchInvoiceResponse, error)
 	//* lncli: `listpayments`
 	//ListPayments returns a list of all outgoing payments.
 	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
@@ -11757,6 +14069,13 @@ type LightningClient interface {
 	//the index offset of the last entry. The index offset can be provided to the
 	//request to allow the caller to skip a series of records.
 	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	//* lncli: `accountingreport`
+	//AccountingReport returns a ledger of every event that changed the balance
+	//of the node within the target time range: the settled htlcs of invoices
+	//and payments, the fees earned by forwarding and the on-chain transactions
+	//of the wallet. The entries are ordered by time and summarized by totals.
+	//All amounts are in millisatoshis.
+	AccountingReport(ctx context.Context, in *AccountingReportRequest, opts ...grpc.CallOption) (*AccountingReportResponse, error)
 	//* lncli: `exportchanbackup`
 	//ExportChannelBackup attempts to return an encrypted static channel backup
 	//for the target channel identified by it channel point. The backup is
@@ -11792,11 +14111,27 @@ type LightningClient interface {
 	//ups, but the updated set of encrypted multi-chan backups with the closed
 	//channel(s) removed.
 	SubscribeChannelBackups(ctx context.Context, in *ChannelBackupSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelBackupsClient, error)
+	//* lncli: `listbackupsinks`
+	//ListBackupSinks returns the status of every configured sink that the
+	//encrypted multi-channel backup is shipped to each time it is updated, such
+	//as a mounted directory or an HTTP server.
+	ListBackupSinks(ctx context.Context, in *ListBackupSinksRequest, opts ...grpc.CallOption) (*ListBackupSinksResponse, error)
 	//* lncli: `bakemacaroon`
 	//BakeMacaroon allows the creation of a new macaroon with custom read and
 	//write permissions. No first-party caveats are added since this can be done
 	//offline.
 	BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error)
+	//* lncli: `listmacaroonids`
+	//ListMacaroonIDs returns all root key IDs that are in use.
+	ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error)
+	//* lncli: `deletemacaroonid`
+	//DeleteMacaroonID deletes the specified macaroon ID and invalidates all
+	//macaroons derived from the root key with that ID.
+	DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error)
+	//* lncli: `listpermissions`
+	//ListPermissions lists all RPC method URIs and their required macaroon
+	//permissions to access them.
+	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
 }
 
 type lightningClient struct {
@@ -11997,6 +14332,15 @@ func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts
 	return out, nil
 }
 
+func (c *lightningClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error) {
+	out := new(GetRecoveryInfoResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetRecoveryInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error) {
 	out := new(PendingChannelsResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/PendingChannels", in, out, opts...)
@@ -12326,6 +14670,33 @@ func (c *lightningClient) DecodePayReq(ctx context.Context, in *PayReqString, op
 	return out, nil
 }
 
+func (c *lightningClient) AddOffer(ctx context.Context, in *AddOfferRequest, opts ...grpc.CallOption) (*AddOfferResponse, error) {
+	out := new(AddOfferResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AddOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListOffers(ctx context.Context, in *ListOffersRequest, opts ...grpc.CallOption) (*ListOffersResponse, error) {
+	out := new(ListOffersResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListOffers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) FetchInvoice(ctx context.Context, in *FetchInvoiceRequest, opts ...grpc.CallOption) (*FetchInvoiceResponse, error) {
+	out := new(FetchInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FetchInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
 	out := new(ListPaymentsResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListPayments", in, out, opts...)
@@ -12466,6 +14837,15 @@ func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingH
 	return out, nil
 }
 
+func (c *lightningClient) AccountingReport(ctx context.Context, in *AccountingReportRequest, opts ...grpc.CallOption) (*AccountingReportResponse, error) {
+	out := new(AccountingReportResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/AccountingReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ExportChannelBackup(ctx context.Context, in *ExportChannelBackupRequest, opts ...grpc.CallOption) (*ChannelBackup, error) {
 	out := new(ChannelBackup)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ExportChannelBackup", in, out, opts...)
@@ -12534,6 +14914,15 @@ func (x *lightningSubscribeChannelBackupsClient) Recv() (*ChanBackupSnapshot, er
 	return m, nil
 }
 
+func (c *lightningClient) ListBackupSinks(ctx context.Context, in *ListBackupSinksRequest, opts ...grpc.CallOption) (*ListBackupSinksResponse, error) {
+	out := new(ListBackupSinksResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListBackupSinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
 	out := new(BakeMacaroonResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BakeMacaroon", in, out, opts...)
@@ -12543,6 +14932,33 @@ func (c *lightningClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequ
 	return out, nil
 }
 
+func (c *lightningClient) ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error) {
+	out := new(ListMacaroonIDsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListMacaroonIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error) {
+	out := new(DeleteMacaroonIDResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DeleteMacaroonID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	out := new(ListPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LightningServer is the server API for Lightning service.
 type LightningServer interface {
 	//* lncli: `walletbalance`
@@ -12621,6 +15037,12 @@ type LightningServer interface {
 	//it's identity pubkey, alias, the chains it is connected to, and information
 	//concerning the number of open+pending channels.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	//* lncli: `getrecoveryinfo`
+	//GetRecoveryInfo returns information concerning the recovery mode including
+	//whether it's in a recovery mode, whether the recovery is finished, and the
+	//progress made so far. This includes the rescan for the commitment outputs
+	//of channels that were closed before the node was restored from its seed.
+	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
 	//* lncli: `pendingchannels`
 	//PendingChannels returns a list of all the channels that are currently
 	//considered "pending". A channel is pending if it has finished the funding
@@ -12746,6 +15168,18 @@ type LightningServer interface {
 	//it, returning a full description of the conditions encoded within the
 	//payment request.
 	DecodePayReq(context.Context, *PayReqString) (*PayReq, error)
+	//* lncli: `addoffer`
+	//AddOffer creates a new offer: a long-lived payment code that can be paid
+	//many times. Payers fetch a fresh invoice for every payment from this node
+	//over onion messages, which this node issues automatically.
+	AddOffer(context.Context, *AddOfferRequest) (*AddOfferResponse, error)
+	//* lncli: `listoffers`
+	//ListOffers returns all offers this node created.
+	ListOffers(context.Context, *ListOffersRequest) (*ListOffersResponse, error)
+	//* lncli: `fetchinvoice`
+	//FetchInvoice requests an invoice for an offer from its issuer over onion
+	//messages. The returned payment request can be paid like any other.
+	FetchInvoice(context.Context, *FetchInvoiceRequest) (*FetchInvoiceResponse, error)
 	//* lncli: `listpayments`
 	//ListPayments returns a list of all outgoing payments.
 	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
@@ -12819,6 +15253,13 @@ type LightningServer interface {
 	//the index offset of the last entry. The index offset can be provided to the
 	//request to allow the caller to skip a series of records.
 	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	//* lncli: `accountingreport`
+	//AccountingReport returns a ledger of every event that changed the balance
+	//of the node within the target time range: the settled htlcs of invoices
+	//and payments, the fees earned by forwarding and the on-chain transactions
+	//of the wallet. The entries are ordered by time and summarized by totals.
+	//All amounts are in millisatoshis.
+	AccountingReport(context.Context, *AccountingReportRequest) (*AccountingReportResponse, error)
 	//* lncli: `exportchanbackup`
 	//ExportChannelBackup attempts to return an encrypted static channel backup
 	//for the target channel identified by it channel point. The backup is
@@ -12854,11 +15295,27 @@ type LightningServer interface {
 	//ups, but the updated set of encrypted multi-chan backups with the closed
 	//channel(s) removed.
 	SubscribeChannelBackups(*ChannelBackupSubscription, Lightning_SubscribeChannelBackupsServer) error
+	//* lncli: `listbackupsinks`
+	//ListBackupSinks returns the status of every configured sink that the
+	//encrypted multi-channel backup is shipped to each time it is updated, such
+	//as a mounted directory or an HTTP server.
+	ListBackupSinks(context.Context, *ListBackupSinksRequest) (*ListBackupSinksResponse, error)
 	//* lncli: `bakemacaroon`
 	//BakeMacaroon allows the creation of a new macaroon with custom read and
 	//write permissions. No first-party caveats are added since this can be done
 	//offline.
 	BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error)
+	//* lncli: `listmacaroonids`
+	//ListMacaroonIDs returns all root key IDs that are in use.
+	ListMacaroonIDs(context.Context, *ListMacaroonIDsRequest) (*ListMacaroonIDsResponse, error)
+	//* lncli: `deletemacaroonid`
+	//DeleteMacaroonID deletes the specified macaroon ID and invalidates all
+	//macaroons derived from the root key with that ID.
+	DeleteMacaroonID(context.Context, *DeleteMacaroonIDRequest) (*DeleteMacaroonIDResponse, error)
+	//* lncli: `listpermissions`
+	//ListPermissions lists all RPC method URIs and their required macaroon
+	//permissions to access them.
+	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
 }
 
 func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
@@ -13159,6 +15616,24 @@ func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_GetRecoveryInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetRecoveryInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetRecoveryInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetRecoveryInfo(ctx, req.(*GetRecoveryInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_PendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PendingChannelsRequest)
 	if err := dec(in); err != nil {
@@ -13537,6 +16012,60 @@ func _Lightning_DecodePayReq_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_AddOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AddOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AddOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AddOffer(ctx, req.(*AddOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListOffers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListOffers(ctx, req.(*ListOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_FetchInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).FetchInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/FetchInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).FetchInvoice(ctx, req.(*FetchInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPaymentsRequest)
 	if err := dec(in); err != nil {
@@ -13774,6 +16303,24 @@ func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_AccountingReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountingReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AccountingReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AccountingReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AccountingReport(ctx, req.(*AccountingReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ExportChannelBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportChannelBackupRequest)
 	if err := dec(in); err != nil {
@@ -13867,6 +16414,24 @@ func (x *lightningSubscribeChannelBackupsServer) Send(m *ChanBackupSnapshot) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_ListBackupSinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackupSinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListBackupSinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListBackupSinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListBackupSinks(ctx, req.(*ListBackupSinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BakeMacaroonRequest)
 	if err := dec(in); err != nil {
@@ -13885,6 +16450,60 @@ func _Lightning_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ListMacaroonIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMacaroonIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListMacaroonIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListMacaroonIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListMacaroonIDs(ctx, req.(*ListMacaroonIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_DeleteMacaroonID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMacaroonIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DeleteMacaroonID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DeleteMacaroonID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DeleteMacaroonID(ctx, req.(*DeleteMacaroonIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Lightning_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lnrpc.Lightning",
 	HandlerType: (*LightningServer)(nil),
@@ -13945,6 +16564,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInfo",
 			Handler:    _Lightning_GetInfo_Handler,
 		},
+		{
+			MethodName: "GetRecoveryInfo",
+			Handler:    _Lightning_GetRecoveryInfo_Handler,
+		},
 		{
 			MethodName: "PendingChannels",
 			Handler:    _Lightning_PendingChannels_Handler,
@@ -13993,6 +16616,18 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DecodePayReq",
 			Handler:    _Lightning_DecodePayReq_Handler,
 		},
+		{
+			MethodName: "AddOffer",
+			Handler:    _Lightning_AddOffer_Handler,
+		},
+		{
+			MethodName: "ListOffers",
+			Handler:    _Lightning_ListOffers_Handler,
+		},
+		{
+			MethodName: "FetchInvoice",
+			Handler:    _Lightning_FetchInvoice_Handler,
+		},
 		{
 			MethodName: "ListPayments",
 			Handler:    _Lightning_ListPayments_Handler,
@@ -14041,6 +16676,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForwardingHistory",
 			Handler:    _Lightning_ForwardingHistory_Handler,
 		},
+		{
+			MethodName: "AccountingReport",
+			Handler:    _Lightning_AccountingReport_Handler,
+		},
 		{
 			MethodName: "ExportChannelBackup",
 			Handler:    _Lightning_ExportChannelBackup_Handler,
@@ -14057,10 +16696,26 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestoreChannelBackups",
 			Handler:    _Lightning_RestoreChannelBackups_Handler,
 		},
+		{
+			MethodName: "ListBackupSinks",
+			Handler:    _Lightning_ListBackupSinks_Handler,
+		},
 		{
 			MethodName: "BakeMacaroon",
 			Handler:    _Lightning_BakeMacaroon_Handler,
 		},
+		{
+			MethodName: "ListMacaroonIDs",
+			Handler:    _Lightning_ListMacaroonIDs_Handler,
+		},
+		{
+			MethodName: "DeleteMacaroonID",
+			Handler:    _Lightning_DeleteMacaroonID_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _Lightning_ListPermissions_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3,6 +3,7 @@
 package signrpc
 
 import (
+	"github.com/Actinium-project/acmwallet/waddrmgr"
 	"github.com/Actinium-project/lnd/input"
 	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/macaroons"
@@ -35,4 +36,19 @@ type Config struct {
 	// KeyRing is an interface that the signer will use to derive any keys
 	// for signing messages.
 	KeyRing keychain.SecretKeyRing
+
+	// AddressDeriver is used to make sure the wallet knows the addresses
+	// of outputs it is asked to sign for by their derivation path.
+	AddressDeriver AddressDeriver
+}
+
+// AddressDeriver is implemented by wallets that can derive the address of an
+// arbitrary derivation path, so they can sign for outputs of addresses that
+// were handed out by a watch-only copy of them.
+type AddressDeriver interface {
+	// DeriveAddress makes sure the address of the key at the given
+	// derivation path within the key scope is known to the wallet. Paths
+	// too far past the last used address of their branch are rejected.
+	DeriveAddress(scope waddrmgr.KeyScope,
+		path waddrmgr.DerivationPath) error
 }
@@ -74,7 +74,8 @@ func (m *KeyLocator) GetKeyIndex() int32 {
 type KeyDescriptor struct {
 	//*
 	//The raw bytes of the key being identified. Either this or the KeyLocator
-	//must be specified.
+	//must be specified. If both are specified, the key locator is used to derive
+	//the key.
 	RawKeyBytes []byte `protobuf:"bytes,1,opt,name=raw_key_bytes,json=rawKeyBytes,proto3" json:"raw_key_bytes,omitempty"`
 	//*
 	//The key locator that identifies which key to use for signing. Either this
@@ -210,7 +211,16 @@ type SignDescriptor struct {
 	Sighash uint32 `protobuf:"varint,7,opt,name=sighash,proto3" json:"sighash,omitempty"`
 	//*
 	//The target input within the transaction that should be signed.
-	InputIndex           int32    `protobuf:"varint,8,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+	InputIndex int32 `protobuf:"varint,8,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+	//*
+	//The BIP32 derivation path of the wallet key that controls the output, made
+	//up of the purpose, coin type, account, branch and index without the
+	//hardened offsets. This is only used by ComputeInputScript, and allows the
+	//signer to sign for outputs of addresses it hasn't handed out itself, such
+	//as the addresses of a watch-only node that uses it as its remote signer.
+	//The index may lie at most 1000 addresses past the last used address of
+	//its branch.
+	WalletKeyPath        []uint32 `protobuf:"varint,9,rep,packed,name=wallet_key_path,json=walletKeyPath,proto3" json:"wallet_key_path,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -290,6 +300,13 @@ func (m *SignDescriptor) GetInputIndex() int32 {
 	return 0
 }
 
+func (m *SignDescriptor) GetWalletKeyPath() []uint32 {
+	if m != nil {
+		return m.WalletKeyPath
+	}
+	return nil
+}
+
 type SignReq struct {
 	/// The raw bytes of the transaction to be signed.
 	RawTxBytes []byte `protobuf:"bytes,1,opt,name=raw_tx_bytes,json=rawTxBytes,proto3" json:"raw_tx_bytes,omitempty"`
@@ -476,10 +493,16 @@ type SignMessageReq struct {
 	/// The message to be signed.
 	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
 	/// The key locator that identifies which key to use for signing.
-	KeyLoc               *KeyLocator `protobuf:"bytes,2,opt,name=key_loc,json=keyLoc,proto3" json:"key_loc,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	KeyLoc *KeyLocator `protobuf:"bytes,2,opt,name=key_loc,json=keyLoc,proto3" json:"key_loc,omitempty"`
+	/// Double-SHA256 hash instead of just the default single round.
+	DoubleHash bool `protobuf:"varint,3,opt,name=double_hash,json=doubleHash,proto3" json:"double_hash,omitempty"`
+	//*
+	//Use the compact (pubkey recoverable) format instead of the raw lnwire
+	//format.
+	CompactSig           bool     `protobuf:"varint,4,opt,name=compact_sig,json=compactSig,proto3" json:"compact_sig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SignMessageReq) Reset()         { *m = SignMessageReq{} }
@@ -521,9 +544,24 @@ func (m *SignMessageReq) GetKeyLoc() *KeyLocator {
 	return nil
 }
 
+func (m *SignMessageReq) GetDoubleHash() bool {
+	if m != nil {
+		return m.DoubleHash
+	}
+	return false
+}
+
+func (m *SignMessageReq) GetCompactSig() bool {
+	if m != nil {
+		return m.CompactSig
+	}
+	return false
+}
+
 type SignMessageResp struct {
 	//*
-	//The signature for the given message in the fixed-size LN wire format.
+	//The signature for the given message in the fixed-size LN wire format, or
+	//in the compact format if compact_sig was set.
 	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -773,55 +811,59 @@ func init() {
 func init() { proto.RegisterFile("signrpc/signer.proto", fileDescriptor_4ecd772f6c7ffacf) }
 
 var fileDescriptor_4ecd772f6c7ffacf = []byte{
-	// 756 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5d, 0x8f, 0xdb, 0x44,
-	0x14, 0xd5, 0x26, 0x6c, 0x92, 0xbd, 0x4e, 0x76, 0xb3, 0xc3, 0xaa, 0xb8, 0x0b, 0x88, 0x60, 0xa9,
-	0x28, 0x95, 0x20, 0x11, 0x01, 0x21, 0xc1, 0x13, 0x2a, 0xd5, 0xaa, 0x55, 0x8a, 0x5a, 0x39, 0x2b,
-	0x1e, 0xfa, 0x62, 0x39, 0xce, 0xad, 0x33, 0xb2, 0x63, 0xcf, 0xce, 0x8c, 0xeb, 0xf8, 0x77, 0xf0,
-	0xd7, 0xf8, 0x41, 0x68, 0x3e, 0xe2, 0xd8, 0x29, 0x54, 0xea, 0xd3, 0xfa, 0x9e, 0xb9, 0x73, 0xee,
-	0xd9, 0x73, 0xae, 0x63, 0xb8, 0x11, 0x34, 0xce, 0x38, 0x8b, 0xe6, 0xea, 0x2f, 0xf2, 0x19, 0xe3,
-	0xb9, 0xcc, 0x49, 0xdf, 0xa2, 0xde, 0x0b, 0x80, 0x25, 0x56, 0xaf, 0xf2, 0x28, 0x94, 0x39, 0x27,
-	0x5f, 0x03, 0x24, 0x58, 0x05, 0xef, 0xc2, 0x1d, 0x4d, 0x2b, 0xf7, 0x6c, 0x72, 0x36, 0x3d, 0xf7,
-	0x2f, 0x12, 0xac, 0xee, 0x34, 0x40, 0xbe, 0x04, 0x55, 0x04, 0x34, 0xdb, 0xe0, 0xde, 0xed, 0xe8,
-	0xd3, 0x41, 0x82, 0xd5, 0x4b, 0x55, 0x7b, 0x21, 0x8c, 0x96, 0x58, 0x3d, 0x47, 0x11, 0x71, 0xca,
-	0x14, 0x99, 0x07, 0x23, 0x1e, 0x96, 0x81, 0xba, 0xb1, 0xae, 0x24, 0x0a, 0xcd, 0x37, 0xf4, 0x1d,
-	0x1e, 0x96, 0x4b, 0xac, 0x9e, 0x29, 0x88, 0x7c, 0x0f, 0x7d, 0x75, 0x9e, 0xe6, 0x91, 0xe6, 0x73,
-	0x16, 0x9f, 0xcf, 0xac, 0xb2, 0xd9, 0x51, 0x96, 0xdf, 0x4b, 0xf4, 0xb3, 0xf7, 0x1b, 0x9c, 0xdf,
-	0xef, 0x5f, 0x17, 0x92, 0xdc, 0xc0, 0xf9, 0xfb, 0x30, 0x2d, 0x50, 0x53, 0x76, 0x7d, 0x53, 0x28,
-	0x79, 0x2c, 0x09, 0xcc, 0x7c, 0x4d, 0x37, 0xf4, 0x07, 0x2c, 0x59, 0xe9, 0xda, 0xfb, 0xbb, 0x03,
-	0x97, 0x2b, 0x1a, 0x67, 0x0d, 0x81, 0x3f, 0x82, 0x52, 0x1f, 0x6c, 0x50, 0x44, 0x9a, 0xc8, 0x59,
-	0x3c, 0x6a, 0x4e, 0x3f, 0x76, 0xfa, 0x4a, 0xa4, 0x2a, 0xc9, 0xb7, 0x30, 0x14, 0x34, 0x8b, 0x53,
-	0x0c, 0x64, 0x89, 0x61, 0x62, 0xa7, 0x38, 0x06, 0xbb, 0x57, 0x90, 0x6a, 0xd9, 0xe4, 0xc5, 0xba,
-	0x6e, 0xe9, 0x9a, 0x16, 0x83, 0x99, 0x96, 0x27, 0x70, 0x59, 0x52, 0x99, 0xa1, 0x10, 0x07, 0xb5,
-	0x9f, 0xe9, 0xa6, 0x91, 0x45, 0x8d, 0x64, 0xf2, 0x1d, 0xf4, 0xf2, 0x42, 0xb2, 0x42, 0xba, 0xe7,
-	0x5a, 0xdd, 0x65, 0xad, 0x4e, 0xbb, 0xe0, 0xdb, 0x53, 0xe2, 0x82, 0x8a, 0x73, 0x1b, 0x8a, 0xad,
-	0xdb, 0x9f, 0x9c, 0x4d, 0x47, 0xfe, 0xa1, 0x24, 0xdf, 0x80, 0x43, 0x33, 0x56, 0x48, 0x1b, 0xd9,
-	0x40, 0x47, 0x06, 0x1a, 0x32, 0xa1, 0x45, 0xd0, 0x57, 0xa6, 0xf8, 0xf8, 0x40, 0x26, 0x30, 0x54,
-	0x71, 0xc9, 0x7d, 0x2b, 0x2d, 0xe0, 0x61, 0x79, 0xbf, 0x37, 0x61, 0xfd, 0x02, 0xa0, 0x04, 0x68,
-	0xc3, 0x84, 0xdb, 0x99, 0x74, 0xa7, 0xce, 0xe2, 0x8b, 0x5a, 0x53, 0xdb, 0x5c, 0xff, 0x42, 0xd8,
-	0x5a, 0x78, 0x4f, 0x60, 0x60, 0x86, 0x08, 0x46, 0x1e, 0xc3, 0x40, 0x4d, 0x11, 0x34, 0x56, 0x13,
-	0xba, 0xd3, 0xa1, 0xdf, 0xe7, 0x61, 0xb9, 0xa2, 0xb1, 0xf0, 0xee, 0xc0, 0x79, 0xa9, 0x94, 0xd9,
-	0xff, 0xde, 0x85, 0xbe, 0xb5, 0xe3, 0xd0, 0x68, 0x4b, 0xb5, 0xa5, 0x82, 0xc6, 0xed, 0xa0, 0xd5,
-	0x38, 0x9b, 0xf4, 0x2b, 0xb8, 0x6a, 0xf0, 0xe8, 0xa9, 0xbf, 0xc2, 0xc8, 0xf8, 0x60, 0xee, 0x18,
-	0x46, 0x67, 0x71, 0x53, 0x8b, 0x6f, 0x5e, 0x18, 0xd2, 0x63, 0x21, 0xbc, 0x37, 0x66, 0x6d, 0xfe,
-	0x44, 0x21, 0xc2, 0x18, 0x95, 0x51, 0x63, 0xe8, 0xee, 0x44, 0x6c, 0xfd, 0x51, 0x8f, 0x9f, 0xb8,
-	0xc5, 0x73, 0xb8, 0x6a, 0x31, 0x0a, 0x46, 0xbe, 0x02, 0x6d, 0x57, 0x28, 0x0b, 0x8e, 0x96, 0xf8,
-	0x08, 0x78, 0x6f, 0x61, 0xfc, 0x17, 0x72, 0xfa, 0xae, 0xfa, 0xa8, 0x88, 0x16, 0x47, 0xe7, 0x84,
-	0x83, 0x3c, 0x82, 0x1e, 0x2b, 0xd6, 0x09, 0x56, 0x76, 0x1f, 0x6d, 0xe5, 0x3d, 0x85, 0xeb, 0x13,
-	0x6e, 0xc1, 0xec, 0xeb, 0x45, 0x37, 0x9a, 0x7e, 0xe0, 0x9b, 0xc2, 0x4b, 0x60, 0xbc, 0xda, 0x86,
-	0x1c, 0x37, 0x4b, 0xac, 0x7c, 0x7c, 0x28, 0x50, 0x48, 0xf2, 0x14, 0xc6, 0xc8, 0xb6, 0xb8, 0x43,
-	0x1e, 0xa6, 0x81, 0x1d, 0x60, 0x34, 0x5d, 0xd5, 0xf8, 0x1b, 0x0d, 0x7f, 0xa2, 0x49, 0x0b, 0xb8,
-	0x6e, 0x0c, 0x13, 0x2c, 0xcf, 0x04, 0xea, 0xe0, 0x35, 0x18, 0x1c, 0xe7, 0x5c, 0x88, 0x43, 0xdb,
-	0xe2, 0x9f, 0x0e, 0xf4, 0x56, 0xfa, 0x57, 0x8e, 0xfc, 0x0c, 0x23, 0xf5, 0xf4, 0x5a, 0xbf, 0x20,
-	0x7e, 0x58, 0x92, 0x71, 0x6b, 0x4f, 0x7d, 0x7c, 0xb8, 0xbd, 0x3e, 0x41, 0x04, 0x23, 0xbf, 0x03,
-	0xf9, 0x23, 0xdf, 0xb1, 0x42, 0x62, 0x73, 0x11, 0x3f, 0xbc, 0xea, 0xfe, 0xe7, 0xde, 0x18, 0x06,
-	0xa7, 0x91, 0x2d, 0x69, 0xbf, 0x1d, 0xc7, 0xf8, 0x1a, 0x0c, 0xa7, 0xab, 0x70, 0x07, 0xa3, 0x56,
-	0x20, 0xe4, 0x71, 0xdd, 0x7a, 0xba, 0x04, 0xb7, 0xb7, 0xff, 0x77, 0x24, 0x18, 0x79, 0x01, 0x57,
-	0xcf, 0x91, 0xd3, 0xf7, 0x58, 0xdb, 0xd8, 0x60, 0x3a, 0xcd, 0xb1, 0xc1, 0xf4, 0x81, 0xeb, 0xcf,
-	0xe6, 0x6f, 0x7f, 0x88, 0xa9, 0xdc, 0x16, 0xeb, 0x59, 0x94, 0xef, 0xe6, 0x29, 0x8d, 0xb7, 0x32,
-	0xa3, 0x59, 0x9c, 0xa1, 0x2c, 0x73, 0x9e, 0xcc, 0xd3, 0x6c, 0x33, 0x4f, 0xeb, 0x2f, 0x0c, 0x67,
-	0xd1, 0xba, 0xa7, 0xbf, 0x31, 0x3f, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x73, 0xb0, 0xe9, 0x51,
-	0x7b, 0x06, 0x00, 0x00,
+	// 819 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xed, 0x8e, 0xdb, 0x44,
+	0x14, 0xd5, 0x26, 0x6c, 0x92, 0xbd, 0x8e, 0x37, 0xd9, 0x61, 0x55, 0xdc, 0x00, 0x22, 0x58, 0x6a,
+	0x95, 0x4a, 0x74, 0x23, 0x02, 0x42, 0x82, 0x5f, 0x50, 0xaa, 0xd5, 0x56, 0x29, 0x6a, 0x35, 0x59,
+	0xf1, 0xa3, 0x7f, 0xa2, 0x89, 0x73, 0xeb, 0x0c, 0x76, 0xec, 0x59, 0xcf, 0xb8, 0x59, 0xbf, 0x06,
+	0xaf, 0xc3, 0x6b, 0xf0, 0x40, 0x68, 0x3e, 0xf2, 0xe1, 0xb0, 0x20, 0xf5, 0x57, 0x7c, 0xcf, 0xdc,
+	0x39, 0xf7, 0xe4, 0x9e, 0xe3, 0x04, 0x2e, 0x25, 0x8f, 0xb3, 0x42, 0x44, 0x63, 0xfd, 0x89, 0xc5,
+	0x95, 0x28, 0x72, 0x95, 0x93, 0xb6, 0x43, 0xc3, 0x1b, 0x80, 0x29, 0x56, 0xaf, 0xf3, 0x88, 0xa9,
+	0xbc, 0x20, 0x5f, 0x02, 0x24, 0x58, 0xcd, 0xdf, 0xb3, 0x35, 0x4f, 0xab, 0xe0, 0x64, 0x78, 0x32,
+	0x3a, 0xa5, 0x67, 0x09, 0x56, 0xd7, 0x06, 0x20, 0x9f, 0x83, 0x2e, 0xe6, 0x3c, 0x5b, 0xe2, 0x7d,
+	0xd0, 0x30, 0xa7, 0x9d, 0x04, 0xab, 0x57, 0xba, 0x0e, 0x19, 0xf8, 0x53, 0xac, 0x5e, 0xa2, 0x8c,
+	0x0a, 0x2e, 0x34, 0x59, 0x08, 0x7e, 0xc1, 0x36, 0x73, 0x7d, 0x63, 0x51, 0x29, 0x94, 0x86, 0xaf,
+	0x4b, 0xbd, 0x82, 0x6d, 0xa6, 0x58, 0xbd, 0xd0, 0x10, 0xf9, 0x06, 0xda, 0xfa, 0x3c, 0xcd, 0x23,
+	0xc3, 0xe7, 0x4d, 0x3e, 0xbd, 0x72, 0xca, 0xae, 0xf6, 0xb2, 0x68, 0x2b, 0x31, 0xcf, 0xe1, 0x4f,
+	0x70, 0x7a, 0x7b, 0xff, 0xa6, 0x54, 0xe4, 0x12, 0x4e, 0x3f, 0xb0, 0xb4, 0x44, 0x43, 0xd9, 0xa4,
+	0xb6, 0xd0, 0xf2, 0x44, 0x32, 0xb7, 0xf3, 0x0d, 0x5d, 0x97, 0x76, 0x44, 0x32, 0x33, 0x75, 0xf8,
+	0x57, 0x03, 0xce, 0x67, 0x3c, 0xce, 0x0e, 0x04, 0x7e, 0x0b, 0x5a, 0xfd, 0x7c, 0x89, 0x32, 0x32,
+	0x44, 0xde, 0xe4, 0xd1, 0xe1, 0xf4, 0x7d, 0x27, 0x6d, 0x27, 0xb6, 0x24, 0x5f, 0x43, 0x57, 0xf2,
+	0x2c, 0x4e, 0x71, 0xae, 0x36, 0xc8, 0x12, 0x37, 0xc5, 0xb3, 0xd8, 0xad, 0x86, 0x74, 0xcb, 0x32,
+	0x2f, 0x17, 0xbb, 0x96, 0xa6, 0x6d, 0xb1, 0x98, 0x6d, 0x79, 0x02, 0xe7, 0x1b, 0xae, 0x32, 0x94,
+	0x72, 0xab, 0xf6, 0x13, 0xd3, 0xe4, 0x3b, 0xd4, 0x4a, 0x26, 0x4f, 0xa1, 0x95, 0x97, 0x4a, 0x94,
+	0x2a, 0x38, 0x35, 0xea, 0xce, 0x77, 0xea, 0xcc, 0x16, 0xa8, 0x3b, 0x25, 0x01, 0x68, 0x3b, 0x57,
+	0x4c, 0xae, 0x82, 0xf6, 0xf0, 0x64, 0xe4, 0xd3, 0x6d, 0x49, 0xbe, 0x02, 0x8f, 0x67, 0xa2, 0x54,
+	0xce, 0xb2, 0x8e, 0xb1, 0x0c, 0x0c, 0x64, 0x4c, 0x23, 0x4f, 0xa1, 0xb7, 0x61, 0x69, 0x8a, 0xca,
+	0xd8, 0x24, 0x98, 0x5a, 0x05, 0x67, 0xc3, 0xe6, 0xc8, 0xa7, 0xbe, 0x85, 0xa7, 0x58, 0xbd, 0x65,
+	0x6a, 0x15, 0x46, 0xd0, 0xd6, 0xcb, 0xa3, 0x78, 0x47, 0x86, 0xd0, 0xd5, 0xb6, 0xaa, 0xfb, 0x9a,
+	0xab, 0x50, 0xb0, 0xcd, 0xed, 0xbd, 0x35, 0xf5, 0x07, 0x00, 0x2d, 0xd4, 0x2c, 0x56, 0x06, 0x8d,
+	0x61, 0x73, 0xe4, 0x4d, 0x3e, 0xdb, 0x69, 0xaf, 0x9b, 0x40, 0xcf, 0xa4, 0xab, 0x65, 0xf8, 0x04,
+	0x3a, 0x76, 0x88, 0x14, 0xe4, 0x31, 0x74, 0xf4, 0x14, 0xc9, 0x63, 0x3d, 0xa1, 0x39, 0xea, 0xd2,
+	0x76, 0xc1, 0x36, 0x33, 0x1e, 0xcb, 0xf0, 0x1a, 0xbc, 0x57, 0xfa, 0x1b, 0xb8, 0x2d, 0x05, 0xd0,
+	0x76, 0x6b, 0xdb, 0x36, 0xba, 0x52, 0xa7, 0x59, 0xf2, 0xb8, 0x1e, 0x08, 0x3d, 0xce, 0x25, 0xe2,
+	0x35, 0xf4, 0x0e, 0x78, 0xcc, 0xd4, 0x1f, 0xc1, 0xb7, 0xfb, 0xb2, 0x77, 0x2c, 0xa3, 0x37, 0xb9,
+	0xdc, 0x89, 0x3f, 0xbc, 0xd0, 0xe5, 0xfb, 0x42, 0x86, 0x7f, 0x9e, 0xd8, 0x7c, 0xfd, 0x86, 0x52,
+	0xb2, 0x18, 0xf5, 0xa6, 0xfa, 0xd0, 0x5c, 0xcb, 0xd8, 0x2d, 0x48, 0x3f, 0x7e, 0x5c, 0xdc, 0xb5,
+	0x7b, 0x2e, 0x49, 0xc6, 0x5b, 0x1d, 0xa4, 0x0e, 0x05, 0x0b, 0xdd, 0x38, 0x7b, 0xa3, 0x7c, 0x2d,
+	0x58, 0xa4, 0xf4, 0xa2, 0x4c, 0x88, 0x3a, 0x14, 0x1c, 0x34, 0xe3, 0x71, 0x38, 0x86, 0x5e, 0x4d,
+	0x93, 0x14, 0xe4, 0x0b, 0x30, 0x1b, 0x67, 0xaa, 0x2c, 0xd0, 0x49, 0xdb, 0x03, 0xe1, 0x3b, 0xe8,
+	0xff, 0x8e, 0x05, 0x7f, 0x5f, 0xfd, 0xef, 0xd7, 0xa8, 0x71, 0x34, 0x8e, 0x38, 0xc8, 0x23, 0x68,
+	0x89, 0x72, 0x91, 0x60, 0xe5, 0xa2, 0xef, 0xaa, 0xf0, 0x19, 0x5c, 0x1c, 0x71, 0x4b, 0xe1, 0xde,
+	0x64, 0xbe, 0x34, 0xf4, 0x1d, 0x6a, 0x8b, 0x30, 0x81, 0xfe, 0x6c, 0xc5, 0x0a, 0x5c, 0x4e, 0xb1,
+	0xa2, 0x78, 0x57, 0xa2, 0x54, 0xe4, 0x19, 0xf4, 0x51, 0xac, 0x70, 0x8d, 0x05, 0x4b, 0xe7, 0x6e,
+	0x80, 0xd5, 0xd4, 0xdb, 0xe1, 0x6f, 0x0d, 0xfc, 0x91, 0xbf, 0x2a, 0x13, 0xb8, 0x38, 0x18, 0x26,
+	0x45, 0x9e, 0x49, 0x34, 0xd9, 0x31, 0xe0, 0x7c, 0x3f, 0xe7, 0x4c, 0x6e, 0xdb, 0x26, 0x7f, 0x37,
+	0xa0, 0x35, 0x33, 0x3f, 0xa8, 0xe4, 0x7b, 0xf0, 0xf5, 0xd3, 0x1b, 0xf3, 0x2e, 0x52, 0xb6, 0x21,
+	0xfd, 0x5a, 0xd4, 0x29, 0xde, 0x0d, 0x2e, 0x8e, 0x10, 0x29, 0xc8, 0xcf, 0x40, 0x7e, 0xcd, 0xd7,
+	0xa2, 0x54, 0x78, 0x98, 0xe5, 0x7f, 0x5f, 0x0d, 0x1e, 0x8c, 0x9e, 0x65, 0xf0, 0x0e, 0xbc, 0x25,
+	0xf5, 0x17, 0x6c, 0x6f, 0xdf, 0x20, 0x78, 0xf8, 0x40, 0x0a, 0x72, 0x0d, 0x7e, 0xcd, 0x10, 0xf2,
+	0x78, 0xd7, 0x7a, 0x1c, 0x82, 0xc1, 0xe0, 0xbf, 0x8e, 0xa4, 0x20, 0x37, 0xd0, 0x7b, 0x89, 0x05,
+	0xff, 0x80, 0xbb, 0x35, 0x1e, 0x30, 0x1d, 0xfb, 0x38, 0x18, 0x3c, 0x74, 0x64, 0xb7, 0xfe, 0x62,
+	0xfc, 0xee, 0x79, 0xcc, 0xd5, 0xaa, 0x5c, 0x5c, 0x45, 0xf9, 0x7a, 0xfc, 0x4b, 0xa4, 0x78, 0xc6,
+	0xcb, 0xf5, 0x73, 0x51, 0xe4, 0x7f, 0x60, 0xa4, 0xc6, 0x69, 0xb6, 0x1c, 0xa7, 0xbb, 0x3f, 0xb3,
+	0x42, 0x44, 0x8b, 0x96, 0xf9, 0x3b, 0xfb, 0xee, 0x9f, 0x01, 0x00, 0xd1, 0x6c, 0x75, 0x9d, 0xe6,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
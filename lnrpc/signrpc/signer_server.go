@@ -5,7 +5,6 @@ package signrpc
 import (
 	"bytes"
 	"context"
-	"crypto/sha256"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -15,6 +14,7 @@ import (
 	"github.com/Actinium-project/acmd/chaincfg/chainhash"
 	"github.com/Actinium-project/acmd/txscript"
 	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/acmwallet/waddrmgr"
 	"github.com/Actinium-project/lnd/input"
 	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/lnrpc"
@@ -219,20 +219,17 @@ func (s *Server) SignOutputRaw(ctx context.Context, in *SignReq) (*SignResp, err
 	for _, signDesc := range in.SignDescs {
 		keyDesc := signDesc.KeyDesc
 
-		// The caller can either specify the key using the raw pubkey,
-		// or the description of the key. Below we'll feel out the
-		// oneof field to decide which one we will attempt to parse.
+		// The caller can specify the key using the raw pubkey, the
+		// description of the key, or both. If the description is set,
+		// the signer will use it to derive the key.
 		var (
 			targetPubKey *btcec.PublicKey
 			keyLoc       keychain.KeyLocator
 		)
-		switch {
 
 		// If this method doesn't return nil, then we know that user is
 		// attempting to include a raw serialized pub key.
-		case keyDesc.GetRawKeyBytes() != nil:
-			rawKeyBytes := keyDesc.GetRawKeyBytes()
-
+		if rawKeyBytes := keyDesc.GetRawKeyBytes(); rawKeyBytes != nil {
 			switch {
 			// If the user provided a raw key, but it's of the
 			// wrong length, then we'll return with an error.
@@ -253,11 +250,11 @@ func (s *Server) SignOutputRaw(ctx context.Context, in *SignReq) (*SignResp, err
 						"parse pubkey: %v", err)
 				}
 			}
+		}
 
 		// Similarly, if they specified a key locator, then we'll use
-		// that instead.
-		case keyDesc.GetKeyLoc() != nil:
-			protoLoc := keyDesc.GetKeyLoc()
+		// that as well.
+		if protoLoc := keyDesc.GetKeyLoc(); protoLoc != nil {
 			keyLoc = keychain.KeyLocator{
 				Family: keychain.KeyFamily(
 					protoLoc.KeyFamily,
@@ -364,6 +361,17 @@ func (s *Server) ComputeInputScript(ctx context.Context,
 
 	signDescs := make([]*input.SignDescriptor, 0, len(in.SignDescs))
 	for _, signDesc := range in.SignDescs {
+		// If the caller told us the derivation path of the wallet key
+		// that controls the output, we'll make sure the wallet knows
+		// about its address, as it might have been handed out by a
+		// watch-only copy of our wallet.
+		if len(signDesc.WalletKeyPath) != 0 {
+			err := s.deriveWalletAddress(signDesc.WalletKeyPath)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		// For this method, the only fields that we care about are the
 		// hash type, and the information concerning the output as we
 		// only know how to provide full witnesses for outputs that we
@@ -404,7 +412,8 @@ func (s *Server) ComputeInputScript(ctx context.Context,
 }
 
 // SignMessage signs a message with the key specified in the key locator. The
-// returned signature is fixed-size LN wire format encoded.
+// returned signature is fixed-size LN wire format encoded, or in the compact
+// format if requested.
 func (s *Server) SignMessage(ctx context.Context,
 	in *SignMessageReq) (*SignMessageResp, error) {
 
@@ -415,24 +424,31 @@ func (s *Server) SignMessage(ctx context.Context,
 		return nil, fmt.Errorf("a key locator MUST be passed in")
 	}
 
-	// Derive the private key we'll be using for signing.
 	keyLocator := keychain.KeyLocator{
 		Family: keychain.KeyFamily(in.KeyLoc.KeyFamily),
 		Index:  uint32(in.KeyLoc.KeyIndex),
 	}
-	privKey, err := s.cfg.KeyRing.DerivePrivKey(keychain.KeyDescriptor{
-		KeyLocator: keyLocator,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("can't derive private key: %v", err)
-	}
 
-	// The signature is over the sha256 hash of the message.
-	digest := chainhash.HashB(in.Msg)
+	// The signature is over the sha256 hash of the message, or its double
+	// sha256 if requested. The private key never leaves the key ring.
+	if in.CompactSig {
+		sig, err := s.cfg.KeyRing.SignMessageCompact(
+			keyLocator, in.Msg, in.DoubleHash,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("can't sign the hash: %v", err)
+		}
+
+		return &SignMessageResp{
+			Signature: sig,
+		}, nil
+	}
 
 	// Create the raw ECDSA signature first and convert it to the final wire
 	// format after.
-	sig, err := privKey.Sign(digest)
+	sig, err := s.cfg.KeyRing.SignMessage(
+		keyLocator, in.Msg, in.DoubleHash,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("can't sign the hash: %v", err)
 	}
@@ -515,31 +531,39 @@ func (s *Server) DeriveSharedKey(_ context.Context, in *SharedKeyRequest) (
 		locator.Index = uint32(in.KeyLoc.KeyIndex)
 	}
 
-	// Derive our node's private key from the key ring.
-	idPrivKey, err := s.cfg.KeyRing.DerivePrivKey(keychain.KeyDescriptor{
-		KeyLocator: locator,
-	})
+	// Derive the shared key using ECDH and hashing the serialized
+	// compressed shared point.
+	sharedKeyHash, err := s.cfg.KeyRing.ScalarMult(
+		keychain.KeyDescriptor{KeyLocator: locator}, ephemeralPubkey,
+	)
 	if err != nil {
-		err := fmt.Errorf("unable to derive node private key: %v", err)
+		err := fmt.Errorf("unable to derive shared key: %v", err)
 		log.Error(err)
 		return nil, err
 	}
-	idPrivKey.Curve = btcec.S256()
 
-	// Derive the shared key using ECDH and hashing the serialized
-	// compressed shared point.
-	sharedKeyHash := ecdh(ephemeralPubkey, idPrivKey)
 	return &SharedKeyResponse{SharedKey: sharedKeyHash}, nil
 }
 
-// ecdh performs an ECDH operation between pub and priv. The returned value is
-// the sha256 of the compressed shared point.
-func ecdh(pub *btcec.PublicKey, priv *btcec.PrivateKey) []byte {
-	s := &btcec.PublicKey{}
-	x, y := btcec.S256().ScalarMult(pub.X, pub.Y, priv.D.Bytes())
-	s.X = x
-	s.Y = y
+// deriveWalletAddress makes sure the wallet knows the address of the wallet
+// key at the given BIP32 derivation path.
+func (s *Server) deriveWalletAddress(path []uint32) error {
+	if len(path) != 5 {
+		return fmt.Errorf("wallet key path must consist of purpose, " +
+			"coin type, account, branch and index")
+	}
+	if s.cfg.AddressDeriver == nil {
+		return fmt.Errorf("wallet doesn't support signing for " +
+			"addresses by their derivation path")
+	}
 
-	h := sha256.Sum256(s.SerializeCompressed())
-	return h[:]
+	scope := waddrmgr.KeyScope{
+		Purpose: path[0],
+		Coin:    path[1],
+	}
+	return s.cfg.AddressDeriver.DeriveAddress(scope, waddrmgr.DerivationPath{
+		Account: path[2],
+		Branch:  path[3],
+		Index:   path[4],
+	})
 }
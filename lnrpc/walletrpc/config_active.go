@@ -3,6 +3,10 @@
 package walletrpc
 
 import (
+	"io"
+
+	"github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/lnd/input"
 	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/lnwallet"
 	"github.com/Actinium-project/lnd/lnwallet/chainfee"
@@ -48,4 +52,24 @@ type Config struct {
 	// Chain is an interface that the WalletKit will use to determine state
 	// about the backing chain of the wallet.
 	Chain lnwallet.BlockChainIO
+
+	// Signer is used to sign the inputs of PSBTs that spend outputs of
+	// the wallet.
+	Signer input.Signer
+
+	// ChainParams are required to decode the addresses of PSBT templates
+	// that are passed over RPC.
+	ChainParams *chaincfg.Params
+
+	// WatchOnlyExporter is used to export a watch-only copy of the wallet
+	// for a node that uses this node as its remote signer.
+	WatchOnlyExporter WatchOnlyExporter
+}
+
+// WatchOnlyExporter is implemented by wallets that can export a watch-only
+// copy of themselves.
+type WatchOnlyExporter interface {
+	// ExportWatchOnly writes a watch-only copy of the wallet database to
+	// the writer.
+	ExportWatchOnly(w io.Writer) error
 }
@@ -0,0 +1,332 @@
+// +build walletrpc
+
+package walletrpc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/Actinium-project/acmd/txscript"
+	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/acmutil"
+	"github.com/Actinium-project/acmwallet/wallet/txauthor"
+	"github.com/Actinium-project/acmwallet/wallet/txrules"
+	"github.com/Actinium-project/lnd/input"
+	"github.com/Actinium-project/lnd/lnrpc"
+	"github.com/Actinium-project/lnd/lnwallet"
+	"github.com/Actinium-project/lnd/lnwallet/chainfee"
+	"github.com/Actinium-project/lnd/psbt"
+)
+
+// PsbtLeaseID is the ID that the inputs of PSBTs funded through FundPsbt are
+// leased with. It can be used to release them through ReleaseOutput.
+var PsbtLeaseID = lnwallet.LeaseID(sha256.Sum256([]byte("walletrpc-psbt")))
+
+// fundPsbt adds inputs of the wallet to the template packet that are
+// sufficient to pay for its outputs at the given fee rate, along with a change
+// output if any value is left over. If the template already contains inputs,
+// they are used as is and must all belong to the wallet. All inputs of the
+// returned packet are leased, and the index of the change output is returned,
+// or -1 if there is none.
+func (w *WalletKit) fundPsbt(template *psbt.Packet,
+	feeRate chainfee.SatPerKWeight, minConfs int32) (*psbt.Packet, int32,
+	[]*UtxoLease, error) {
+
+	if err := template.SanityCheck(); err != nil {
+		return nil, 0, nil, err
+	}
+
+	templateTx := template.UnsignedTx
+	if len(templateTx.TxOut) == 0 {
+		return nil, 0, nil, fmt.Errorf("PSBT has no outputs")
+	}
+	for _, output := range templateTx.TxOut {
+		err := txrules.CheckOutput(output, txrules.DefaultRelayFeePerKb)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+	}
+
+	// Coin selection and leasing must not be interleaved with other calls,
+	// as they could otherwise select the same outputs.
+	w.fundMtx.Lock()
+	defer w.fundMtx.Unlock()
+
+	var (
+		utxos      []*lnwallet.Utxo
+		coinSelect = len(templateTx.TxIn) == 0
+	)
+	if coinSelect {
+		var err error
+		utxos, err = w.cfg.Wallet.ListUnspentWitness(
+			minConfs, math.MaxInt32,
+		)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		sort.Slice(utxos, func(i, j int) bool {
+			return utxos[i].Value > utxos[j].Value
+		})
+	} else {
+		for _, txIn := range templateTx.TxIn {
+			utxo, err := w.cfg.Wallet.FetchInputInfo(
+				&txIn.PreviousOutPoint,
+			)
+			if err != nil {
+				return nil, 0, nil, fmt.Errorf("input %v is not "+
+					"known to the wallet: %v",
+					txIn.PreviousOutPoint, err)
+			}
+			if utxo.Confirmations < int64(minConfs) {
+				return nil, 0, nil, fmt.Errorf("input %v has %d "+
+					"confirmations, %d required",
+					txIn.PreviousOutPoint,
+					utxo.Confirmations, minConfs)
+			}
+
+			utxos = append(utxos, utxo)
+		}
+	}
+
+	// Our input source hands out the largest outputs of the wallet until
+	// their value covers the target amount if we're selecting the coins,
+	// or all inputs of the template otherwise.
+	inputSource := func(target acmutil.Amount) (acmutil.Amount,
+		[]*wire.TxIn, []acmutil.Amount, [][]byte, error) {
+
+		var (
+			total       acmutil.Amount
+			txIns       []*wire.TxIn
+			inputValues []acmutil.Amount
+			scripts     [][]byte
+		)
+		for _, utxo := range utxos {
+			if coinSelect && total >= target {
+				break
+			}
+
+			total += utxo.Value
+			txIns = append(txIns, wire.NewTxIn(&utxo.OutPoint, nil, nil))
+			inputValues = append(inputValues, utxo.Value)
+			scripts = append(scripts, utxo.PkScript)
+		}
+
+		return total, txIns, inputValues, scripts, nil
+	}
+
+	changeSource := func() ([]byte, error) {
+		changeAddr, err := w.cfg.Wallet.NewAddress(
+			lnwallet.WitnessPubKey, true,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return txscript.PayToAddrScript(changeAddr)
+	}
+
+	feeSatPerKB := acmutil.Amount(feeRate.FeePerKVByte())
+	authoredTx, err := txauthor.NewUnsignedTransaction(
+		templateTx.TxOut, feeSatPerKB, inputSource, changeSource,
+	)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	// The funded transaction keeps the version and lock time of the
+	// template, as well as the sequences of its inputs.
+	fundedTx := authoredTx.Tx
+	fundedTx.Version = templateTx.Version
+	fundedTx.LockTime = templateTx.LockTime
+	for i, txIn := range templateTx.TxIn {
+		fundedTx.TxIn[i].Sequence = txIn.Sequence
+	}
+
+	packet, err := psbt.New(fundedTx)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	packet.Unknowns = template.Unknowns
+	copy(packet.Inputs, template.Inputs)
+	copy(packet.Outputs, template.Outputs)
+
+	// Signers need to know the outputs being spent, so we'll add them to
+	// all inputs that don't carry them already.
+	for i := range packet.Inputs {
+		pInput := &packet.Inputs[i]
+		if pInput.WitnessUtxo != nil || pInput.NonWitnessUtxo != nil {
+			continue
+		}
+
+		pInput.WitnessUtxo = wire.NewTxOut(
+			int64(authoredTx.PrevInputValues[i]),
+			authoredTx.PrevScripts[i],
+		)
+	}
+
+	// Finally, we'll lease all inputs, so they aren't used by any other
+	// transaction until the packet is either published or abandoned.
+	leases := make([]*UtxoLease, 0, len(fundedTx.TxIn))
+	for _, txIn := range fundedTx.TxIn {
+		op := txIn.PreviousOutPoint
+		expiration, err := w.cfg.Wallet.LeaseOutput(
+			PsbtLeaseID, op, DefaultLockDuration,
+		)
+		if err != nil {
+			w.releaseLeases(leases)
+			return nil, 0, nil, fmt.Errorf("unable to lease input "+
+				"%v: %v", op, err)
+		}
+
+		leases = append(leases, &UtxoLease{
+			Id: PsbtLeaseID[:],
+			Outpoint: &lnrpc.OutPoint{
+				TxidBytes:   op.Hash[:],
+				TxidStr:     op.Hash.String(),
+				OutputIndex: op.Index,
+			},
+			Expiration: uint64(expiration.Unix()),
+		})
+	}
+
+	return packet, int32(authoredTx.ChangeIndex), leases, nil
+}
+
+// releaseLeases releases the given leases, taken out with the PSBT lease ID.
+func (w *WalletKit) releaseLeases(leases []*UtxoLease) {
+	for _, lease := range leases {
+		op, err := lnrpc.UnmarshallOutPoint(lease.Outpoint)
+		if err != nil {
+			continue
+		}
+
+		err = w.cfg.Wallet.ReleaseOutput(PsbtLeaseID, *op)
+		if err != nil {
+			log.Errorf("Unable to release lease of %v: %v", op, err)
+		}
+	}
+}
+
+// signPsbt adds a signature of the wallet to each of the packet's inputs that
+// spends an output of the wallet. All other inputs are left untouched, so they
+// can be signed by their owners independently. The indexes of the signed
+// inputs are returned.
+func (w *WalletKit) signPsbt(packet *psbt.Packet) ([]uint32, error) {
+	if err := packet.SanityCheck(); err != nil {
+		return nil, err
+	}
+
+	tx := packet.UnsignedTx
+	sigHashes := txscript.NewTxSigHashes(tx)
+
+	var signedInputs []uint32
+	for i := range packet.Inputs {
+		pInput := &packet.Inputs[i]
+		if pInput.IsFinalized() {
+			continue
+		}
+
+		// We can only sign inputs that spend witness outputs of the
+		// wallet, any other input is left for its owner to sign.
+		utxo, err := w.cfg.Wallet.FetchInputInfo(
+			&tx.TxIn[i].PreviousOutPoint,
+		)
+		if err != nil {
+			continue
+		}
+		if utxo.AddressType != lnwallet.WitnessPubKey &&
+			utxo.AddressType != lnwallet.NestedWitnessPubKey {
+
+			continue
+		}
+
+		// If the packet declares the output being spent, it must match
+		// the one of the wallet, as we'd otherwise sign a different
+		// amount than the other signers.
+		prevOut := wire.NewTxOut(int64(utxo.Value), utxo.PkScript)
+		if declared, err := packet.InputUtxo(i); err == nil {
+			if declared.Value != prevOut.Value ||
+				!bytes.Equal(declared.PkScript, prevOut.PkScript) {
+
+				return nil, fmt.Errorf("utxo of input %d "+
+					"doesn't match wallet output %v", i,
+					utxo.OutPoint)
+			}
+		} else {
+			pInput.WitnessUtxo = prevOut
+		}
+
+		hashType := txscript.SigHashAll
+		if pInput.SighashType != 0 {
+			hashType = pInput.SighashType
+		}
+
+		inputScript, err := w.cfg.Signer.ComputeInputScript(
+			tx, &input.SignDescriptor{
+				Output:     prevOut,
+				HashType:   hashType,
+				SigHashes:  sigHashes,
+				InputIndex: i,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign input %d: %v", i,
+				err)
+		}
+
+		// The witness of a key spend consists of the signature,
+		// followed by the public key.
+		if len(inputScript.Witness) != 2 {
+			return nil, fmt.Errorf("unexpected witness for input "+
+				"%d", i)
+		}
+		sig := &psbt.PartialSig{
+			Signature: inputScript.Witness[0],
+			PubKey:    inputScript.Witness[1],
+		}
+
+		// For nested outputs, the signature script pushes the redeem
+		// script, which we'll need to finalize the input.
+		if len(inputScript.SigScript) != 0 {
+			pushes, err := txscript.PushedData(inputScript.SigScript)
+			if err != nil || len(pushes) != 1 {
+				return nil, fmt.Errorf("unexpected signature "+
+					"script for input %d", i)
+			}
+			pInput.RedeemScript = pushes[0]
+		}
+
+		pInput.PartialSigs = replacePartialSig(pInput.PartialSigs, sig)
+		signedInputs = append(signedInputs, uint32(i))
+	}
+
+	return signedInputs, nil
+}
+
+// replacePartialSig adds the signature to the list, replacing any previous
+// signature of the same public key.
+func replacePartialSig(sigs []*psbt.PartialSig,
+	sig *psbt.PartialSig) []*psbt.PartialSig {
+
+	for i, existing := range sigs {
+		if bytes.Equal(existing.PubKey, sig.PubKey) {
+			sigs[i] = sig
+			return sigs
+		}
+	}
+
+	return append(sigs, sig)
+}
+
+// serializePsbt returns the binary serialization of the packet.
+func serializePsbt(packet *psbt.Packet) ([]byte, error) {
+	var b bytes.Buffer
+	if err := packet.Serialize(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
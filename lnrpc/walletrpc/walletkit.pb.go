@@ -6,9 +6,9 @@ package walletrpc
 import (
 	context "context"
 	fmt "fmt"
-	proto "github.com/golang/protobuf/proto"
 	lnrpc "github.com/Actinium-project/lnd/lnrpc"
 	signrpc "github.com/Actinium-project/lnd/lnrpc/signrpc"
+	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	math "math"
 )
@@ -255,7 +255,10 @@ func (m *AddrResponse) GetAddr() string {
 type Transaction struct {
 	//*
 	//The raw serialized transaction.
-	TxHex                []byte   `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	TxHex []byte `protobuf:"bytes,1,opt,name=tx_hex,json=txHex,proto3" json:"tx_hex,omitempty"`
+	//*
+	//An optional label to add to the transaction, limited to 500 characters.
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -293,6 +296,13 @@ func (m *Transaction) GetTxHex() []byte {
 	return nil
 }
 
+func (m *Transaction) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type PublishResponse struct {
 	//*
 	//If blank, then no error occurred and the transaction was successfully
@@ -345,10 +355,13 @@ type SendOutputsRequest struct {
 	SatPerKw int64 `protobuf:"varint,1,opt,name=sat_per_kw,json=satPerKw,proto3" json:"sat_per_kw,omitempty"`
 	//*
 	//A slice of the outputs that should be created in the transaction produced.
-	Outputs              []*signrpc.TxOut `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Outputs []*signrpc.TxOut `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	//*
+	//An optional label for the transaction, limited to 500 characters.
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SendOutputsRequest) Reset()         { *m = SendOutputsRequest{} }
@@ -390,6 +403,13 @@ func (m *SendOutputsRequest) GetOutputs() []*signrpc.TxOut {
 	return nil
 }
 
+func (m *SendOutputsRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type SendOutputsResponse struct {
 	//*
 	//The serialized transaction sent out on the network.
@@ -717,7 +737,11 @@ type BumpFeeRequest struct {
 	//*
 	//Whether this input must be force-swept. This means that it is swept even
 	//if it has a negative yield.
-	Force                bool     `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	Force bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	//*
+	//An optional label for the sweep transaction spending the input, limited
+	//to 500 characters.
+	Label                string   `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -776,6 +800,13 @@ func (m *BumpFeeRequest) GetForce() bool {
 	return false
 }
 
+func (m *BumpFeeRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type BumpFeeResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -807,255 +838,1576 @@ func (m *BumpFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BumpFeeResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("walletrpc.WitnessType", WitnessType_name, WitnessType_value)
-	proto.RegisterType((*KeyReq)(nil), "walletrpc.KeyReq")
-	proto.RegisterType((*AddrRequest)(nil), "walletrpc.AddrRequest")
-	proto.RegisterType((*AddrResponse)(nil), "walletrpc.AddrResponse")
-	proto.RegisterType((*Transaction)(nil), "walletrpc.Transaction")
-	proto.RegisterType((*PublishResponse)(nil), "walletrpc.PublishResponse")
-	proto.RegisterType((*SendOutputsRequest)(nil), "walletrpc.SendOutputsRequest")
-	proto.RegisterType((*SendOutputsResponse)(nil), "walletrpc.SendOutputsResponse")
-	proto.RegisterType((*EstimateFeeRequest)(nil), "walletrpc.EstimateFeeRequest")
-	proto.RegisterType((*EstimateFeeResponse)(nil), "walletrpc.EstimateFeeResponse")
-	proto.RegisterType((*PendingSweep)(nil), "walletrpc.PendingSweep")
-	proto.RegisterType((*PendingSweepsRequest)(nil), "walletrpc.PendingSweepsRequest")
-	proto.RegisterType((*PendingSweepsResponse)(nil), "walletrpc.PendingSweepsResponse")
-	proto.RegisterType((*BumpFeeRequest)(nil), "walletrpc.BumpFeeRequest")
-	proto.RegisterType((*BumpFeeResponse)(nil), "walletrpc.BumpFeeResponse")
+type LeaseOutputRequest struct {
+	//
+	//An ID of 32 random bytes that must be unique for each distinct application
+	//using this RPC which will be used to bound the output lease to.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The identifying outpoint of the output being leased.
+	Outpoint *lnrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	//
+	//The time in seconds before the lease expires. If zero, the default lease
+	//duration of 10 minutes is used.
+	ExpirationSeconds    uint64   `protobuf:"varint,3,opt,name=expiration_seconds,proto3" json:"expiration_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("walletrpc/walletkit.proto", fileDescriptor_6cc6942ac78249e5) }
+func (m *LeaseOutputRequest) Reset()         { *m = LeaseOutputRequest{} }
+func (m *LeaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaseOutputRequest) ProtoMessage()    {}
+func (*LeaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{14}
+}
 
-var fileDescriptor_6cc6942ac78249e5 = []byte{
-	// 1026 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x6d, 0x6f, 0xe2, 0xc6,
-	0x13, 0xff, 0x13, 0x12, 0x02, 0x03, 0x24, 0xce, 0xe6, 0xc9, 0xc7, 0xe5, 0x2e, 0xfc, 0xdd, 0x07,
-	0xa1, 0xb6, 0x02, 0x35, 0xd7, 0x9e, 0xaa, 0xf6, 0x45, 0x9b, 0x23, 0x8e, 0x12, 0x41, 0x30, 0xb5,
-	0x9d, 0x4b, 0xaf, 0xaa, 0xb4, 0x72, 0x60, 0x03, 0x16, 0x60, 0xfb, 0xd6, 0x4b, 0x81, 0xb7, 0xfd,
-	0x16, 0xfd, 0x00, 0xfd, 0x12, 0xfd, 0x74, 0x95, 0xd7, 0x0f, 0x59, 0xf3, 0x50, 0xa9, 0xaf, 0x82,
-	0x7f, 0xbf, 0xdf, 0xcc, 0xce, 0xce, 0xcc, 0xce, 0x04, 0x5e, 0xcc, 0xac, 0xf1, 0x98, 0x30, 0xea,
-	0xf5, 0x1a, 0xe1, 0xaf, 0x91, 0xcd, 0xea, 0x1e, 0x75, 0x99, 0x8b, 0x0a, 0x09, 0x55, 0x29, 0x50,
-	0xaf, 0x17, 0xa2, 0x95, 0x23, 0xdf, 0x1e, 0x38, 0x81, 0x3c, 0xf8, 0x4b, 0x68, 0x88, 0x2a, 0x3f,
-	0x43, 0xae, 0x45, 0x16, 0x3a, 0xf9, 0x88, 0x6a, 0x20, 0x8d, 0xc8, 0x02, 0x3f, 0xd9, 0xce, 0x80,
-	0x50, 0xec, 0x51, 0xdb, 0x61, 0x72, 0xa6, 0x9a, 0xa9, 0xed, 0xe8, 0x7b, 0x23, 0xb2, 0xb8, 0xe6,
-	0x70, 0x37, 0x40, 0xd1, 0x2b, 0x00, 0xae, 0xb4, 0x26, 0xf6, 0x78, 0x21, 0x6f, 0x71, 0x4d, 0x21,
-	0xd0, 0x70, 0x40, 0x29, 0x43, 0xf1, 0xb2, 0xdf, 0xa7, 0x3a, 0xf9, 0x38, 0x25, 0x3e, 0x53, 0x14,
-	0x28, 0x85, 0x9f, 0xbe, 0xe7, 0x3a, 0x3e, 0x41, 0x08, 0xb6, 0xad, 0x7e, 0x9f, 0x72, 0xdf, 0x05,
-	0x9d, 0xff, 0x56, 0x3e, 0x85, 0xa2, 0x49, 0x2d, 0xc7, 0xb7, 0x7a, 0xcc, 0x76, 0x1d, 0x74, 0x0c,
-	0x39, 0x36, 0xc7, 0x43, 0x32, 0xe7, 0xa2, 0x92, 0xbe, 0xc3, 0xe6, 0x37, 0x64, 0xae, 0xbc, 0x85,
-	0xfd, 0xee, 0xf4, 0x71, 0x6c, 0xfb, 0xc3, 0xc4, 0xd9, 0x27, 0x50, 0xf6, 0x42, 0x08, 0x13, 0x4a,
-	0xdd, 0xd8, 0x6b, 0x29, 0x02, 0xd5, 0x00, 0x53, 0x7e, 0x03, 0x64, 0x10, 0xa7, 0xaf, 0x4d, 0x99,
-	0x37, 0x65, 0x7e, 0x14, 0x17, 0x3a, 0x03, 0xf0, 0x2d, 0x86, 0x3d, 0x42, 0xf1, 0x68, 0xc6, 0xed,
-	0xb2, 0x7a, 0xde, 0xb7, 0x58, 0x97, 0xd0, 0xd6, 0x0c, 0xd5, 0x60, 0xd7, 0x0d, 0xf5, 0xf2, 0x56,
-	0x35, 0x5b, 0x2b, 0x5e, 0xec, 0xd5, 0xa3, 0xfc, 0xd5, 0xcd, 0xb9, 0x36, 0x65, 0x7a, 0x4c, 0x2b,
-	0x5f, 0xc1, 0x61, 0xca, 0x7b, 0x14, 0xd9, 0x31, 0xe4, 0xa8, 0x35, 0xc3, 0x2c, 0xb9, 0x03, 0xb5,
-	0x66, 0xe6, 0x5c, 0xf9, 0x16, 0x90, 0xea, 0x33, 0x7b, 0x62, 0x31, 0x72, 0x4d, 0x48, 0x1c, 0xcb,
-	0x39, 0x14, 0x7b, 0xae, 0xf3, 0x84, 0x99, 0x45, 0x07, 0x24, 0x4e, 0x3b, 0x04, 0x90, 0xc9, 0x11,
-	0xe5, 0x0d, 0x1c, 0xa6, 0xcc, 0xa2, 0x43, 0xfe, 0xf5, 0x0e, 0xca, 0x5f, 0x59, 0x28, 0x75, 0x89,
-	0xd3, 0xb7, 0x9d, 0x81, 0x31, 0x23, 0xc4, 0x43, 0x5f, 0x42, 0x3e, 0x88, 0xda, 0x8d, 0x4b, 0x5b,
-	0xbc, 0xd8, 0xaf, 0x8f, 0xf9, 0x9d, 0xb4, 0x29, 0xeb, 0x06, 0xb0, 0x9e, 0x08, 0xd0, 0xf7, 0x50,
-	0x9a, 0xd9, 0xcc, 0x21, 0xbe, 0x8f, 0xd9, 0xc2, 0x23, 0xbc, 0xce, 0x7b, 0x17, 0x27, 0xf5, 0xa4,
-	0xb9, 0xea, 0x0f, 0x21, 0x6d, 0x2e, 0x3c, 0xa2, 0xa7, 0xb4, 0xe8, 0x35, 0x80, 0x35, 0x71, 0xa7,
-	0x0e, 0xc3, 0xbe, 0xc5, 0xe4, 0x6c, 0x35, 0x53, 0x2b, 0xeb, 0x02, 0x82, 0x14, 0x28, 0xc5, 0x71,
-	0x3f, 0x2e, 0x18, 0x91, 0xb7, 0xb9, 0x22, 0x85, 0xa1, 0x3a, 0xa0, 0x47, 0xea, 0x5a, 0xfd, 0x9e,
-	0xe5, 0x33, 0x6c, 0x31, 0x46, 0x26, 0x1e, 0xf3, 0xe5, 0x1d, 0xae, 0x5c, 0xc3, 0xa0, 0x6f, 0xe0,
-	0xd8, 0x21, 0x73, 0x86, 0x9f, 0xa9, 0x21, 0xb1, 0x07, 0x43, 0x26, 0xe7, 0xb8, 0xc9, 0x7a, 0x32,
-	0xb0, 0xa2, 0x61, 0x11, 0x48, 0x1f, 0x8b, 0x35, 0xc8, 0x87, 0x56, 0x6b, 0x49, 0xf4, 0x16, 0x4e,
-	0x9e, 0x89, 0xd4, 0x4d, 0x0a, 0xdc, 0x6c, 0x03, 0x8b, 0x8e, 0x60, 0xe7, 0xc9, 0xa5, 0x3d, 0x22,
-	0xef, 0x56, 0x33, 0xb5, 0xbc, 0x1e, 0x7e, 0x28, 0x27, 0x70, 0x24, 0x96, 0x29, 0xee, 0x50, 0xe5,
-	0x17, 0x38, 0x5e, 0xc2, 0xa3, 0xb2, 0xff, 0x08, 0x7b, 0x5e, 0x48, 0x60, 0x9f, 0x33, 0x72, 0x86,
-	0xf7, 0xe8, 0xa9, 0x50, 0x1c, 0xd1, 0x52, 0x5f, 0x92, 0x2b, 0x7f, 0x66, 0x60, 0xef, 0xdd, 0x74,
-	0xe2, 0x09, 0x2d, 0xf8, 0x9f, 0x7a, 0xa3, 0x0a, 0xc5, 0x30, 0x13, 0x3c, 0x2b, 0xbc, 0x35, 0xca,
-	0xba, 0x08, 0xad, 0x54, 0x38, 0xbb, 0xa6, 0xc2, 0x49, 0x36, 0xb6, 0xc5, 0x6c, 0x1c, 0xc0, 0x7e,
-	0x12, 0x5a, 0x78, 0xdf, 0x2f, 0xfe, 0xc8, 0x42, 0x51, 0x68, 0x36, 0x74, 0x08, 0xfb, 0xf7, 0x9d,
-	0x56, 0x47, 0x7b, 0xe8, 0xe0, 0x87, 0x5b, 0xb3, 0xa3, 0x1a, 0x86, 0xf4, 0x3f, 0x24, 0xc3, 0x51,
-	0x53, 0xbb, 0xbb, 0xbb, 0x35, 0xef, 0xd4, 0x8e, 0x89, 0xcd, 0xdb, 0x3b, 0x15, 0xb7, 0xb5, 0x66,
-	0x4b, 0xca, 0xa0, 0x53, 0x38, 0x14, 0x98, 0x8e, 0x86, 0xaf, 0xd4, 0xf6, 0xe5, 0x07, 0x69, 0x0b,
-	0x1d, 0xc3, 0x81, 0x40, 0xe8, 0xea, 0x7b, 0xad, 0xa5, 0x4a, 0xd9, 0x40, 0x7f, 0x63, 0xb6, 0x9b,
-	0x58, 0xbb, 0xbe, 0x56, 0x75, 0xf5, 0x2a, 0x26, 0xb6, 0x83, 0x23, 0x38, 0x71, 0xd9, 0x6c, 0xaa,
-	0x5d, 0xf3, 0x99, 0xd9, 0x41, 0x9f, 0xc1, 0xff, 0x53, 0x26, 0xc1, 0xf1, 0xda, 0xbd, 0x89, 0x0d,
-	0xb5, 0xa9, 0x75, 0xae, 0x70, 0x5b, 0x7d, 0xaf, 0xb6, 0xa5, 0x1c, 0xfa, 0x1c, 0x94, 0xb4, 0x03,
-	0xe3, 0xbe, 0xd9, 0x54, 0x0d, 0x23, 0xad, 0xdb, 0x45, 0xe7, 0xf0, 0x72, 0x29, 0x82, 0x3b, 0xcd,
-	0x54, 0x63, 0xaf, 0x52, 0x1e, 0x55, 0xe1, 0x6c, 0x39, 0x12, 0xae, 0x88, 0xfc, 0x49, 0x05, 0x74,
-	0x06, 0x32, 0x57, 0x88, 0x9e, 0xe3, 0x78, 0x01, 0x1d, 0x81, 0x14, 0x65, 0x0e, 0xb7, 0xd4, 0x0f,
-	0xf8, 0xe6, 0xd2, 0xb8, 0x91, 0x8a, 0xe8, 0x25, 0x9c, 0x76, 0x54, 0x23, 0x70, 0xb7, 0x42, 0x96,
-	0x2e, 0xfe, 0xde, 0x86, 0xc2, 0x03, 0x6f, 0xaf, 0x96, 0x1d, 0x4c, 0x87, 0xf2, 0x15, 0xa1, 0xf6,
-	0xef, 0xa4, 0x43, 0xe6, 0xac, 0x45, 0x16, 0xe8, 0x40, 0xe8, 0xbd, 0x70, 0xa3, 0x54, 0x4e, 0x92,
-	0x91, 0xd9, 0x22, 0x8b, 0x2b, 0xe2, 0xf7, 0xa8, 0xed, 0x31, 0x97, 0xa2, 0xef, 0xa0, 0x10, 0xda,
-	0x06, 0x76, 0x87, 0xa2, 0xa8, 0xed, 0xf6, 0x2c, 0xe6, 0xd2, 0x8d, 0x96, 0x3f, 0x40, 0x3e, 0x38,
-	0x2f, 0xd8, 0x27, 0x48, 0x9c, 0x44, 0xc2, 0xbe, 0xa9, 0x9c, 0xae, 0xe0, 0xd1, 0xab, 0xb9, 0x01,
-	0x14, 0xad, 0x0f, 0x71, 0xd7, 0x88, 0x6e, 0x04, 0xbc, 0x52, 0x11, 0xdf, 0xd2, 0xd2, 0xd6, 0x69,
-	0x43, 0x51, 0x18, 0xf9, 0xe8, 0x95, 0x20, 0x5d, 0x5d, 0x34, 0x95, 0xd7, 0x9b, 0xe8, 0x67, 0x6f,
-	0xc2, 0x6c, 0x4f, 0x79, 0x5b, 0x5d, 0x15, 0x29, 0x6f, 0xeb, 0x56, 0x82, 0x0e, 0xe5, 0xd4, 0xd0,
-	0x40, 0xe7, 0x1b, 0x86, 0x42, 0x12, 0x5f, 0x75, 0xb3, 0x20, 0xf2, 0xf9, 0x13, 0xec, 0x46, 0x4f,
-	0x12, 0xbd, 0x10, 0xc4, 0xe9, 0x09, 0x92, 0xca, 0xd8, 0xd2, 0x0b, 0x7e, 0xf7, 0xf5, 0xaf, 0x8d,
-	0x81, 0xcd, 0x86, 0xd3, 0xc7, 0x7a, 0xcf, 0x9d, 0x34, 0xc6, 0xc1, 0xe8, 0x75, 0x6c, 0x67, 0xe0,
-	0x10, 0x36, 0x73, 0xe9, 0xa8, 0x31, 0x76, 0xfa, 0x0d, 0x3e, 0x6c, 0x1a, 0x89, 0x8b, 0xc7, 0x1c,
-	0xff, 0x07, 0xe5, 0xcd, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x4e, 0xaf, 0xaf, 0xe9, 0x08,
-	0x00, 0x00,
+func (m *LeaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseOutputRequest.Unmarshal(m, b)
+}
+func (m *LeaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (m *LeaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseOutputRequest.Merge(m, src)
+}
+func (m *LeaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_LeaseOutputRequest.Size(m)
+}
+func (m *LeaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseOutputRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_LeaseOutputRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *LeaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
 
-// WalletKitClient is the client API for WalletKit service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WalletKitClient interface {
-	//*
-	//DeriveNextKey attempts to derive the *next* key within the key family
-	//(account in BIP43) specified. This method should return the next external
-	//child within this branch.
-	DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error)
-	//*
-	//DeriveKey attempts to derive an arbitrary key specified by the passed
-	//KeyLocator.
-	DeriveKey(ctx context.Context, in *signrpc.KeyLocator, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error)
-	//*
-	//NextAddr returns the next unused address within the wallet.
-	NextAddr(ctx context.Context, in *AddrRequest, opts ...grpc.CallOption) (*AddrResponse, error)
-	//*
-	//PublishTransaction attempts to publish the passed transaction to the
-	//network. Once this returns without an error, the wallet will continually
-	//attempt to re-broadcast the transaction on start up, until it enters the
-	//chain.
-	PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error)
-	//*
-	//SendOutputs is similar to the existing sendmany call in Bitcoind, and
-	//allows the caller to create a transaction that sends to several outputs at
-	//once. This is ideal when wanting to batch create a set of transactions.
-	SendOutputs(ctx context.Context, in *SendOutputsRequest, opts ...grpc.CallOption) (*SendOutputsResponse, error)
-	//*
-	//EstimateFee attempts to query the internal fee estimator of the wallet to
-	//determine the fee (in sat/kw) to attach to a transaction in order to
-	//achieve the confirmation target.
-	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
-	//
-	//PendingSweeps returns lists of on-chain outputs that lnd is currently
-	//attempting to sweep within its central batching engine. Outputs with similar
-	//fee rates are batched together in order to sweep them within a single
-	//transaction.
-	//
-	//NOTE: Some of the fields within PendingSweepsRequest are not guaranteed to
-	//remain supported. This is an advanced API that depends on the internals of
-	//the UtxoSweeper, so things may change.
-	PendingSweeps(ctx context.Context, in *PendingSweepsRequest, opts ...grpc.CallOption) (*PendingSweepsResponse, error)
-	//
-	//BumpFee bumps the fee of an arbitrary input within a transaction. This RPC
-	//takes a different approach than bitcoind's bumpfee command. lnd has a
-	//central batching engine in which inputs with similar fee rates are batched
-	//together to save on transaction fees. Due to this, we cannot rely on
-	//bumping the fee on a specific transaction, since transactions can change at
-	//any point with the addition of new inputs. The list of inputs that
-	//currently exist within lnd's central batching engine can be retrieved
-	//through the PendingSweeps RPC.
-	//
-	//When bumping the fee of an input that currently exists within lnd's central
-	//batching engine, a higher fee transaction will be created that replaces the
-	//lower fee transaction through the Replace-By-Fee (RBF) policy. If it
-	//
-	//This RPC also serves useful when wanting to perform a Child-Pays-For-Parent
-	//(CPFP), where the child transaction pays for its parent's fee. This can be
-	//done by specifying an outpoint within the low fee transaction that is under
-	//the control of the wallet.
-	//
-	//The fee preference can be expressed either as a specific fee rate or a delta
-	//of blocks in which the output should be swept on-chain within. If a fee
-	//preference is not explicitly specified, then an error is returned.
+func (m *LeaseOutputRequest) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *LeaseOutputRequest) GetExpirationSeconds() uint64 {
+	if m != nil {
+		return m.ExpirationSeconds
+	}
+	return 0
+}
+
+type LeaseOutputResponse struct {
 	//
-	//Note that this RPC currently doesn't perform any validation checks on the
-	//fee preference being provided. For now, the responsibility of ensuring that
-	//the new fee preference is sufficient is delegated to the user.
-	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	//The absolute expiration of the output lease represented as a unix
+	//timestamp.
+	Expiration           uint64   `protobuf:"varint,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type walletKitClient struct {
-	cc *grpc.ClientConn
+func (m *LeaseOutputResponse) Reset()         { *m = LeaseOutputResponse{} }
+func (m *LeaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*LeaseOutputResponse) ProtoMessage()    {}
+func (*LeaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{15}
 }
 
-func NewWalletKitClient(cc *grpc.ClientConn) WalletKitClient {
-	return &walletKitClient{cc}
+func (m *LeaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseOutputResponse.Unmarshal(m, b)
+}
+func (m *LeaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (m *LeaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseOutputResponse.Merge(m, src)
+}
+func (m *LeaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_LeaseOutputResponse.Size(m)
+}
+func (m *LeaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseOutputResponse.DiscardUnknown(m)
 }
 
-func (c *walletKitClient) DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
-	out := new(signrpc.KeyDescriptor)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveNextKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LeaseOutputResponse proto.InternalMessageInfo
+
+func (m *LeaseOutputResponse) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
 	}
-	return out, nil
+	return 0
 }
 
-func (c *walletKitClient) DeriveKey(ctx context.Context, in *signrpc.KeyLocator, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
-	out := new(signrpc.KeyDescriptor)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveKey", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ReleaseOutputRequest struct {
+	// The unique ID that was used to lease the output.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The identifying outpoint of the output being released.
+	Outpoint             *lnrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (c *walletKitClient) NextAddr(ctx context.Context, in *AddrRequest, opts ...grpc.CallOption) (*AddrResponse, error) {
-	out := new(AddrResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/NextAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ReleaseOutputRequest) Reset()         { *m = ReleaseOutputRequest{} }
+func (m *ReleaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputRequest) ProtoMessage()    {}
+func (*ReleaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{16}
 }
 
-func (c *walletKitClient) PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error) {
-	out := new(PublishResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/PublishTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ReleaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputRequest.Unmarshal(m, b)
+}
+func (m *ReleaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputRequest.Merge(m, src)
+}
+func (m *ReleaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputRequest.Size(m)
+}
+func (m *ReleaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputRequest proto.InternalMessageInfo
+
+func (m *ReleaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletKitClient) SendOutputs(ctx context.Context, in *SendOutputsRequest, opts ...grpc.CallOption) (*SendOutputsResponse, error) {
-	out := new(SendOutputsResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/SendOutputs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ReleaseOutputRequest) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletKitClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
-	out := new(EstimateFeeResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/EstimateFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ReleaseOutputResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseOutputResponse) Reset()         { *m = ReleaseOutputResponse{} }
+func (m *ReleaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputResponse) ProtoMessage()    {}
+func (*ReleaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{17}
+}
+
+func (m *ReleaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputResponse.Unmarshal(m, b)
+}
+func (m *ReleaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (m *ReleaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputResponse.Merge(m, src)
+}
+func (m *ReleaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputResponse.Size(m)
+}
+func (m *ReleaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputResponse proto.InternalMessageInfo
+
+type UtxoLease struct {
+	// A 32 byte random ID that the output was leased with.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The identifying outpoint of the leased output.
+	Outpoint *lnrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	//
+	//The absolute expiration of the output lease represented as a unix
+	//timestamp.
+	Expiration           uint64   `protobuf:"varint,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UtxoLease) Reset()         { *m = UtxoLease{} }
+func (m *UtxoLease) String() string { return proto.CompactTextString(m) }
+func (*UtxoLease) ProtoMessage()    {}
+func (*UtxoLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{18}
+}
+
+func (m *UtxoLease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoLease.Unmarshal(m, b)
+}
+func (m *UtxoLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoLease.Marshal(b, m, deterministic)
+}
+func (m *UtxoLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoLease.Merge(m, src)
+}
+func (m *UtxoLease) XXX_Size() int {
+	return xxx_messageInfo_UtxoLease.Size(m)
+}
+func (m *UtxoLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UtxoLease proto.InternalMessageInfo
+
+func (m *UtxoLease) GetId() []byte {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletKitClient) PendingSweeps(ctx context.Context, in *PendingSweepsRequest, opts ...grpc.CallOption) (*PendingSweepsResponse, error) {
-	out := new(PendingSweepsResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/PendingSweeps", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UtxoLease) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletKitClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
-	out := new(BumpFeeResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/BumpFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UtxoLease) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
 	}
-	return out, nil
+	return 0
 }
 
-// WalletKitServer is the server API for WalletKit service.
+type ListLeasesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLeasesRequest) Reset()         { *m = ListLeasesRequest{} }
+func (m *ListLeasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLeasesRequest) ProtoMessage()    {}
+func (*ListLeasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{19}
+}
+
+func (m *ListLeasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLeasesRequest.Unmarshal(m, b)
+}
+func (m *ListLeasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLeasesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListLeasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLeasesRequest.Merge(m, src)
+}
+func (m *ListLeasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLeasesRequest.Size(m)
+}
+func (m *ListLeasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLeasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLeasesRequest proto.InternalMessageInfo
+
+type ListLeasesResponse struct {
+	// The list of currently leased utxos.
+	LockedUtxos          []*UtxoLease `protobuf:"bytes,1,rep,name=locked_utxos,proto3" json:"locked_utxos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListLeasesResponse) Reset()         { *m = ListLeasesResponse{} }
+func (m *ListLeasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListLeasesResponse) ProtoMessage()    {}
+func (*ListLeasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{20}
+}
+
+func (m *ListLeasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLeasesResponse.Unmarshal(m, b)
+}
+func (m *ListLeasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLeasesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListLeasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLeasesResponse.Merge(m, src)
+}
+func (m *ListLeasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListLeasesResponse.Size(m)
+}
+func (m *ListLeasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLeasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLeasesResponse proto.InternalMessageInfo
+
+func (m *ListLeasesResponse) GetLockedUtxos() []*UtxoLease {
+	if m != nil {
+		return m.LockedUtxos
+	}
+	return nil
+}
+
+type LabelTransactionRequest struct {
+	// The txid of the transaction to label.
+	Txid []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// The label to add to the transaction, limited to 500 characters.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Whether to overwrite the existing label, if it is present.
+	Overwrite            bool     `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabelTransactionRequest) Reset()         { *m = LabelTransactionRequest{} }
+func (m *LabelTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*LabelTransactionRequest) ProtoMessage()    {}
+func (*LabelTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{21}
+}
+
+func (m *LabelTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelTransactionRequest.Unmarshal(m, b)
+}
+func (m *LabelTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *LabelTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelTransactionRequest.Merge(m, src)
+}
+func (m *LabelTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_LabelTransactionRequest.Size(m)
+}
+func (m *LabelTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelTransactionRequest proto.InternalMessageInfo
+
+func (m *LabelTransactionRequest) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *LabelTransactionRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *LabelTransactionRequest) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
+
+type LabelTransactionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabelTransactionResponse) Reset()         { *m = LabelTransactionResponse{} }
+func (m *LabelTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*LabelTransactionResponse) ProtoMessage()    {}
+func (*LabelTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{22}
+}
+
+func (m *LabelTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelTransactionResponse.Unmarshal(m, b)
+}
+func (m *LabelTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *LabelTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelTransactionResponse.Merge(m, src)
+}
+func (m *LabelTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_LabelTransactionResponse.Size(m)
+}
+func (m *LabelTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelTransactionResponse proto.InternalMessageInfo
+
+type ExportWatchOnlyWalletRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportWatchOnlyWalletRequest) Reset()         { *m = ExportWatchOnlyWalletRequest{} }
+func (m *ExportWatchOnlyWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportWatchOnlyWalletRequest) ProtoMessage()    {}
+func (*ExportWatchOnlyWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{23}
+}
+
+func (m *ExportWatchOnlyWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportWatchOnlyWalletRequest.Unmarshal(m, b)
+}
+func (m *ExportWatchOnlyWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportWatchOnlyWalletRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportWatchOnlyWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportWatchOnlyWalletRequest.Merge(m, src)
+}
+func (m *ExportWatchOnlyWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportWatchOnlyWalletRequest.Size(m)
+}
+func (m *ExportWatchOnlyWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportWatchOnlyWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportWatchOnlyWalletRequest proto.InternalMessageInfo
+
+type ExportWatchOnlyWalletResponse struct {
+	//
+	//The raw bytes of a watch-only copy of the wallet database. It holds the
+	//account extended public keys of the wallet, but none of its private keys.
+	WalletDb             []byte   `protobuf:"bytes,1,opt,name=wallet_db,proto3" json:"wallet_db,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportWatchOnlyWalletResponse) Reset()         { *m = ExportWatchOnlyWalletResponse{} }
+func (m *ExportWatchOnlyWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportWatchOnlyWalletResponse) ProtoMessage()    {}
+func (*ExportWatchOnlyWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{24}
+}
+
+func (m *ExportWatchOnlyWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportWatchOnlyWalletResponse.Unmarshal(m, b)
+}
+func (m *ExportWatchOnlyWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportWatchOnlyWalletResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportWatchOnlyWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportWatchOnlyWalletResponse.Merge(m, src)
+}
+func (m *ExportWatchOnlyWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportWatchOnlyWalletResponse.Size(m)
+}
+func (m *ExportWatchOnlyWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportWatchOnlyWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportWatchOnlyWalletResponse proto.InternalMessageInfo
+
+func (m *ExportWatchOnlyWalletResponse) GetWalletDb() []byte {
+	if m != nil {
+		return m.WalletDb
+	}
+	return nil
+}
+
+type TxTemplate struct {
+	//
+	//An optional list of inputs to use. Every input must be an UTXO known to the
+	//wallet that has not been locked before. The sum of all inputs must be
+	//sufficiently large to pay for the given outputs (including fees). If no
+	//inputs are specified, coin selection will be performed instead and inputs
+	//of sufficient value will be added to the resulting PSBT.
+	Inputs []*lnrpc.OutPoint `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// A map of all addresses and the amounts to send to in the funded PSBT.
+	Outputs              map[string]uint64 `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TxTemplate) Reset()         { *m = TxTemplate{} }
+func (m *TxTemplate) String() string { return proto.CompactTextString(m) }
+func (*TxTemplate) ProtoMessage()    {}
+func (*TxTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{25}
+}
+
+func (m *TxTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxTemplate.Unmarshal(m, b)
+}
+func (m *TxTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxTemplate.Marshal(b, m, deterministic)
+}
+func (m *TxTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxTemplate.Merge(m, src)
+}
+func (m *TxTemplate) XXX_Size() int {
+	return xxx_messageInfo_TxTemplate.Size(m)
+}
+func (m *TxTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxTemplate proto.InternalMessageInfo
+
+func (m *TxTemplate) GetInputs() []*lnrpc.OutPoint {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *TxTemplate) GetOutputs() map[string]uint64 {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+type FundPsbtRequest struct {
+	// Types that are valid to be assigned to Template:
+	//	*FundPsbtRequest_Psbt
+	//	*FundPsbtRequest_Raw
+	Template isFundPsbtRequest_Template `protobuf_oneof:"template"`
+	// Types that are valid to be assigned to Fees:
+	//	*FundPsbtRequest_TargetConf
+	//	*FundPsbtRequest_SatPerVbyte
+	Fees isFundPsbtRequest_Fees `protobuf_oneof:"fees"`
+	//
+	//The minimum number of confirmations each one of the selected inputs must
+	//have. If zero, a minimum of one confirmation is required.
+	MinConfs             int32    `protobuf:"varint,5,opt,name=min_confs,proto3" json:"min_confs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundPsbtRequest) Reset()         { *m = FundPsbtRequest{} }
+func (m *FundPsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*FundPsbtRequest) ProtoMessage()    {}
+func (*FundPsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{26}
+}
+
+func (m *FundPsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundPsbtRequest.Unmarshal(m, b)
+}
+func (m *FundPsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundPsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *FundPsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundPsbtRequest.Merge(m, src)
+}
+func (m *FundPsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_FundPsbtRequest.Size(m)
+}
+func (m *FundPsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundPsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundPsbtRequest proto.InternalMessageInfo
+
+type isFundPsbtRequest_Template interface {
+	isFundPsbtRequest_Template()
+}
+
+type FundPsbtRequest_Psbt struct {
+	Psbt []byte `protobuf:"bytes,1,opt,name=psbt,proto3,oneof"`
+}
+
+type FundPsbtRequest_Raw struct {
+	Raw *TxTemplate `protobuf:"bytes,2,opt,name=raw,proto3,oneof"`
+}
+
+func (*FundPsbtRequest_Psbt) isFundPsbtRequest_Template() {}
+
+func (*FundPsbtRequest_Raw) isFundPsbtRequest_Template() {}
+
+func (m *FundPsbtRequest) GetTemplate() isFundPsbtRequest_Template {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+func (m *FundPsbtRequest) GetPsbt() []byte {
+	if x, ok := m.GetTemplate().(*FundPsbtRequest_Psbt); ok {
+		return x.Psbt
+	}
+	return nil
+}
+
+func (m *FundPsbtRequest) GetRaw() *TxTemplate {
+	if x, ok := m.GetTemplate().(*FundPsbtRequest_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+type isFundPsbtRequest_Fees interface {
+	isFundPsbtRequest_Fees()
+}
+
+type FundPsbtRequest_TargetConf struct {
+	TargetConf uint32 `protobuf:"varint,3,opt,name=target_conf,proto3,oneof"`
+}
+
+type FundPsbtRequest_SatPerVbyte struct {
+	SatPerVbyte uint64 `protobuf:"varint,4,opt,name=sat_per_vbyte,proto3,oneof"`
+}
+
+func (*FundPsbtRequest_TargetConf) isFundPsbtRequest_Fees() {}
+
+func (*FundPsbtRequest_SatPerVbyte) isFundPsbtRequest_Fees() {}
+
+func (m *FundPsbtRequest) GetFees() isFundPsbtRequest_Fees {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *FundPsbtRequest) GetTargetConf() uint32 {
+	if x, ok := m.GetFees().(*FundPsbtRequest_TargetConf); ok {
+		return x.TargetConf
+	}
+	return 0
+}
+
+func (m *FundPsbtRequest) GetSatPerVbyte() uint64 {
+	if x, ok := m.GetFees().(*FundPsbtRequest_SatPerVbyte); ok {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+func (m *FundPsbtRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*FundPsbtRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*FundPsbtRequest_Psbt)(nil),
+		(*FundPsbtRequest_Raw)(nil),
+		(*FundPsbtRequest_TargetConf)(nil),
+		(*FundPsbtRequest_SatPerVbyte)(nil),
+	}
+}
+
+type FundPsbtResponse struct {
+	// The funded but not yet signed PSBT packet.
+	FundedPsbt []byte `protobuf:"bytes,1,opt,name=funded_psbt,proto3" json:"funded_psbt,omitempty"`
+	//
+	//The index of the added change output or -1 if no change was left over.
+	ChangeOutputIndex int32 `protobuf:"varint,2,opt,name=change_output_index,proto3" json:"change_output_index,omitempty"`
+	//
+	//The list of lock leases that were acquired for the inputs in the funded
+	//PSBT packet.
+	LockedUtxos          []*UtxoLease `protobuf:"bytes,3,rep,name=locked_utxos,proto3" json:"locked_utxos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FundPsbtResponse) Reset()         { *m = FundPsbtResponse{} }
+func (m *FundPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*FundPsbtResponse) ProtoMessage()    {}
+func (*FundPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{27}
+}
+
+func (m *FundPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundPsbtResponse.Unmarshal(m, b)
+}
+func (m *FundPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundPsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *FundPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundPsbtResponse.Merge(m, src)
+}
+func (m *FundPsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_FundPsbtResponse.Size(m)
+}
+func (m *FundPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundPsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundPsbtResponse proto.InternalMessageInfo
+
+func (m *FundPsbtResponse) GetFundedPsbt() []byte {
+	if m != nil {
+		return m.FundedPsbt
+	}
+	return nil
+}
+
+func (m *FundPsbtResponse) GetChangeOutputIndex() int32 {
+	if m != nil {
+		return m.ChangeOutputIndex
+	}
+	return 0
+}
+
+func (m *FundPsbtResponse) GetLockedUtxos() []*UtxoLease {
+	if m != nil {
+		return m.LockedUtxos
+	}
+	return nil
+}
+
+type SignPsbtRequest struct {
+	//
+	//The PSBT that should be signed. The PSBT must contain all required inputs,
+	//outputs, UTXO data and derivation paths for the inputs owned by the
+	//wallet.
+	FundedPsbt           []byte   `protobuf:"bytes,1,opt,name=funded_psbt,proto3" json:"funded_psbt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignPsbtRequest) Reset()         { *m = SignPsbtRequest{} }
+func (m *SignPsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*SignPsbtRequest) ProtoMessage()    {}
+func (*SignPsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{28}
+}
+
+func (m *SignPsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignPsbtRequest.Unmarshal(m, b)
+}
+func (m *SignPsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignPsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *SignPsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPsbtRequest.Merge(m, src)
+}
+func (m *SignPsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_SignPsbtRequest.Size(m)
+}
+func (m *SignPsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignPsbtRequest proto.InternalMessageInfo
+
+func (m *SignPsbtRequest) GetFundedPsbt() []byte {
+	if m != nil {
+		return m.FundedPsbt
+	}
+	return nil
+}
+
+type SignPsbtResponse struct {
+	// The signed transaction in PSBT format.
+	SignedPsbt []byte `protobuf:"bytes,1,opt,name=signed_psbt,proto3" json:"signed_psbt,omitempty"`
+	// The indices of signed inputs.
+	SignedInputs         []uint32 `protobuf:"varint,2,rep,packed,name=signed_inputs,proto3" json:"signed_inputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignPsbtResponse) Reset()         { *m = SignPsbtResponse{} }
+func (m *SignPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*SignPsbtResponse) ProtoMessage()    {}
+func (*SignPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{29}
+}
+
+func (m *SignPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignPsbtResponse.Unmarshal(m, b)
+}
+func (m *SignPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignPsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *SignPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPsbtResponse.Merge(m, src)
+}
+func (m *SignPsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_SignPsbtResponse.Size(m)
+}
+func (m *SignPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignPsbtResponse proto.InternalMessageInfo
+
+func (m *SignPsbtResponse) GetSignedPsbt() []byte {
+	if m != nil {
+		return m.SignedPsbt
+	}
+	return nil
+}
+
+func (m *SignPsbtResponse) GetSignedInputs() []uint32 {
+	if m != nil {
+		return m.SignedInputs
+	}
+	return nil
+}
+
+type FinalizePsbtRequest struct {
+	//
+	//A PSBT that should be signed and finalized. The PSBT must contain all
+	//required inputs, outputs, UTXO data and partial signatures of all other
+	//signers.
+	FundedPsbt           []byte   `protobuf:"bytes,1,opt,name=funded_psbt,proto3" json:"funded_psbt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalizePsbtRequest) Reset()         { *m = FinalizePsbtRequest{} }
+func (m *FinalizePsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*FinalizePsbtRequest) ProtoMessage()    {}
+func (*FinalizePsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{30}
+}
+
+func (m *FinalizePsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizePsbtRequest.Unmarshal(m, b)
+}
+func (m *FinalizePsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizePsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *FinalizePsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePsbtRequest.Merge(m, src)
+}
+func (m *FinalizePsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_FinalizePsbtRequest.Size(m)
+}
+func (m *FinalizePsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePsbtRequest proto.InternalMessageInfo
+
+func (m *FinalizePsbtRequest) GetFundedPsbt() []byte {
+	if m != nil {
+		return m.FundedPsbt
+	}
+	return nil
+}
+
+type FinalizePsbtResponse struct {
+	// The fully signed and finalized transaction in PSBT format.
+	SignedPsbt []byte `protobuf:"bytes,1,opt,name=signed_psbt,proto3" json:"signed_psbt,omitempty"`
+	// The fully signed and finalized transaction in the raw wire format.
+	RawFinalTx           []byte   `protobuf:"bytes,2,opt,name=raw_final_tx,proto3" json:"raw_final_tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalizePsbtResponse) Reset()         { *m = FinalizePsbtResponse{} }
+func (m *FinalizePsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalizePsbtResponse) ProtoMessage()    {}
+func (*FinalizePsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{31}
+}
+
+func (m *FinalizePsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizePsbtResponse.Unmarshal(m, b)
+}
+func (m *FinalizePsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizePsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *FinalizePsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePsbtResponse.Merge(m, src)
+}
+func (m *FinalizePsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_FinalizePsbtResponse.Size(m)
+}
+func (m *FinalizePsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePsbtResponse proto.InternalMessageInfo
+
+func (m *FinalizePsbtResponse) GetSignedPsbt() []byte {
+	if m != nil {
+		return m.SignedPsbt
+	}
+	return nil
+}
+
+func (m *FinalizePsbtResponse) GetRawFinalTx() []byte {
+	if m != nil {
+		return m.RawFinalTx
+	}
+	return nil
+}
+
+type Account struct {
+	// The name used to identify the account.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The type of addresses the account supports.
+	AddressType lnrpc.AddressType `protobuf:"varint,2,opt,name=address_type,proto3,enum=lnrpc.AddressType" json:"address_type,omitempty"`
+	// The number of the account within its key scope.
+	AccountNumber uint32 `protobuf:"varint,3,opt,name=account_number,proto3" json:"account_number,omitempty"`
+	// The number of external addresses derived from the account.
+	ExternalKeyCount uint32 `protobuf:"varint,4,opt,name=external_key_count,proto3" json:"external_key_count,omitempty"`
+	// The number of change addresses derived from the account.
+	InternalKeyCount uint32 `protobuf:"varint,5,opt,name=internal_key_count,proto3" json:"internal_key_count,omitempty"`
+	// The number of keys imported into the account.
+	ImportedKeyCount uint32 `protobuf:"varint,6,opt,name=imported_key_count,proto3" json:"imported_key_count,omitempty"`
+	//
+	//The confirmed balance of the account (with >= 1 confirmations), excluding
+	//leased outputs.
+	ConfirmedBalance int64 `protobuf:"varint,7,opt,name=confirmed_balance,proto3" json:"confirmed_balance,omitempty"`
+	//
+	//The unconfirmed balance of the account (with 0 confirmations), excluding
+	//leased outputs.
+	UnconfirmedBalance   int64    `protobuf:"varint,8,opt,name=unconfirmed_balance,proto3" json:"unconfirmed_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{32}
+}
+
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account.Unmarshal(m, b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return xxx_messageInfo_Account.Size(m)
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Account) GetAddressType() lnrpc.AddressType {
+	if m != nil {
+		return m.AddressType
+	}
+	return lnrpc.AddressType_WITNESS_PUBKEY_HASH
+}
+
+func (m *Account) GetAccountNumber() uint32 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *Account) GetExternalKeyCount() uint32 {
+	if m != nil {
+		return m.ExternalKeyCount
+	}
+	return 0
+}
+
+func (m *Account) GetInternalKeyCount() uint32 {
+	if m != nil {
+		return m.InternalKeyCount
+	}
+	return 0
+}
+
+func (m *Account) GetImportedKeyCount() uint32 {
+	if m != nil {
+		return m.ImportedKeyCount
+	}
+	return 0
+}
+
+func (m *Account) GetConfirmedBalance() int64 {
+	if m != nil {
+		return m.ConfirmedBalance
+	}
+	return 0
+}
+
+func (m *Account) GetUnconfirmedBalance() int64 {
+	if m != nil {
+		return m.UnconfirmedBalance
+	}
+	return 0
+}
+
+type ListAccountsRequest struct {
+	// An optional filter to only return accounts matching this name.
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAccountsRequest) Reset()         { *m = ListAccountsRequest{} }
+func (m *ListAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsRequest) ProtoMessage()    {}
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{33}
+}
+
+func (m *ListAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsRequest.Unmarshal(m, b)
+}
+func (m *ListAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsRequest.Merge(m, src)
+}
+func (m *ListAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsRequest.Size(m)
+}
+func (m *ListAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsRequest proto.InternalMessageInfo
+
+func (m *ListAccountsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ListAccountsResponse struct {
+	// The accounts of the wallet.
+	Accounts             []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListAccountsResponse) Reset()         { *m = ListAccountsResponse{} }
+func (m *ListAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsResponse) ProtoMessage()    {}
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc6942ac78249e5, []int{34}
+}
+
+func (m *ListAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsResponse.Unmarshal(m, b)
+}
+func (m *ListAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsResponse.Merge(m, src)
+}
+func (m *ListAccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsResponse.Size(m)
+}
+func (m *ListAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+
+func (m *ListAccountsResponse) GetAccounts() []*Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("walletrpc.WitnessType", WitnessType_name, WitnessType_value)
+	proto.RegisterType((*KeyReq)(nil), "walletrpc.KeyReq")
+	proto.RegisterType((*AddrRequest)(nil), "walletrpc.AddrRequest")
+	proto.RegisterType((*AddrResponse)(nil), "walletrpc.AddrResponse")
+	proto.RegisterType((*Transaction)(nil), "walletrpc.Transaction")
+	proto.RegisterType((*PublishResponse)(nil), "walletrpc.PublishResponse")
+	proto.RegisterType((*SendOutputsRequest)(nil), "walletrpc.SendOutputsRequest")
+	proto.RegisterType((*SendOutputsResponse)(nil), "walletrpc.SendOutputsResponse")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "walletrpc.EstimateFeeRequest")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "walletrpc.EstimateFeeResponse")
+	proto.RegisterType((*PendingSweep)(nil), "walletrpc.PendingSweep")
+	proto.RegisterType((*PendingSweepsRequest)(nil), "walletrpc.PendingSweepsRequest")
+	proto.RegisterType((*PendingSweepsResponse)(nil), "walletrpc.PendingSweepsResponse")
+	proto.RegisterType((*BumpFeeRequest)(nil), "walletrpc.BumpFeeRequest")
+	proto.RegisterType((*BumpFeeResponse)(nil), "walletrpc.BumpFeeResponse")
+	proto.RegisterType((*LeaseOutputRequest)(nil), "walletrpc.LeaseOutputRequest")
+	proto.RegisterType((*LeaseOutputResponse)(nil), "walletrpc.LeaseOutputResponse")
+	proto.RegisterType((*ReleaseOutputRequest)(nil), "walletrpc.ReleaseOutputRequest")
+	proto.RegisterType((*ReleaseOutputResponse)(nil), "walletrpc.ReleaseOutputResponse")
+	proto.RegisterType((*UtxoLease)(nil), "walletrpc.UtxoLease")
+	proto.RegisterType((*ListLeasesRequest)(nil), "walletrpc.ListLeasesRequest")
+	proto.RegisterType((*ListLeasesResponse)(nil), "walletrpc.ListLeasesResponse")
+	proto.RegisterType((*LabelTransactionRequest)(nil), "walletrpc.LabelTransactionRequest")
+	proto.RegisterType((*LabelTransactionResponse)(nil), "walletrpc.LabelTransactionResponse")
+	proto.RegisterType((*ExportWatchOnlyWalletRequest)(nil), "walletrpc.ExportWatchOnlyWalletRequest")
+	proto.RegisterType((*ExportWatchOnlyWalletResponse)(nil), "walletrpc.ExportWatchOnlyWalletResponse")
+	proto.RegisterType((*TxTemplate)(nil), "walletrpc.TxTemplate")
+	proto.RegisterMapType((map[string]uint64)(nil), "walletrpc.TxTemplate.OutputsEntry")
+	proto.RegisterType((*FundPsbtRequest)(nil), "walletrpc.FundPsbtRequest")
+	proto.RegisterType((*FundPsbtResponse)(nil), "walletrpc.FundPsbtResponse")
+	proto.RegisterType((*SignPsbtRequest)(nil), "walletrpc.SignPsbtRequest")
+	proto.RegisterType((*SignPsbtResponse)(nil), "walletrpc.SignPsbtResponse")
+	proto.RegisterType((*FinalizePsbtRequest)(nil), "walletrpc.FinalizePsbtRequest")
+	proto.RegisterType((*FinalizePsbtResponse)(nil), "walletrpc.FinalizePsbtResponse")
+	proto.RegisterType((*Account)(nil), "walletrpc.Account")
+	proto.RegisterType((*ListAccountsRequest)(nil), "walletrpc.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "walletrpc.ListAccountsResponse")
+}
+
+func init() { proto.RegisterFile("walletrpc/walletkit.proto", fileDescriptor_6cc6942ac78249e5) }
+
+var fileDescriptor_6cc6942ac78249e5 = []byte{
+	// 1839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x51, 0x77, 0xda, 0xc8,
+	0x15, 0x0e, 0x06, 0x63, 0xb8, 0x80, 0x8d, 0x07, 0x1c, 0xb3, 0x8a, 0x63, 0xbb, 0xda, 0x36, 0xeb,
+	0x6d, 0xb7, 0xb8, 0x4d, 0xba, 0x69, 0x4e, 0xda, 0x9e, 0xd6, 0xc1, 0xf2, 0x21, 0x07, 0x0c, 0xae,
+	0x20, 0xeb, 0xee, 0xb6, 0xe7, 0xe8, 0x08, 0x69, 0x6c, 0x6b, 0x0d, 0x92, 0x56, 0x1a, 0x0c, 0xf4,
+	0xad, 0xfd, 0x1d, 0x7d, 0xe8, 0x4b, 0x9f, 0xfb, 0x57, 0xfa, 0x47, 0xfa, 0x1f, 0x7a, 0x66, 0x34,
+	0x92, 0x46, 0x20, 0x92, 0xcd, 0xe9, 0x3e, 0x19, 0xdd, 0xef, 0x9b, 0x3b, 0xf7, 0xde, 0xb9, 0x33,
+	0xf3, 0x8d, 0xe1, 0x93, 0x99, 0x3e, 0x1e, 0x63, 0xe2, 0xb9, 0xc6, 0x69, 0xf0, 0xeb, 0xde, 0x22,
+	0x4d, 0xd7, 0x73, 0x88, 0x83, 0x8a, 0x11, 0x24, 0x15, 0x3d, 0xd7, 0x08, 0xac, 0x52, 0xdd, 0xb7,
+	0x6e, 0x6d, 0x4a, 0xa7, 0x7f, 0xb1, 0x17, 0x58, 0xe5, 0x3f, 0x42, 0xbe, 0x83, 0x17, 0x2a, 0xfe,
+	0x0e, 0x9d, 0x40, 0xf5, 0x1e, 0x2f, 0xb4, 0x1b, 0xcb, 0xbe, 0xc5, 0x9e, 0xe6, 0x7a, 0x96, 0x4d,
+	0x1a, 0x99, 0xe3, 0xcc, 0xc9, 0xa6, 0xba, 0x7d, 0x8f, 0x17, 0x17, 0xcc, 0x7c, 0x45, 0xad, 0xe8,
+	0x29, 0x00, 0x63, 0xea, 0x13, 0x6b, 0xbc, 0x68, 0x6c, 0x30, 0x4e, 0x91, 0x72, 0x98, 0x41, 0xae,
+	0x40, 0xe9, 0xcc, 0x34, 0x3d, 0x15, 0x7f, 0x37, 0xc5, 0x3e, 0x91, 0x65, 0x28, 0x07, 0x9f, 0xbe,
+	0xeb, 0xd8, 0x3e, 0x46, 0x08, 0x72, 0xba, 0x69, 0x7a, 0xcc, 0x77, 0x51, 0x65, 0xbf, 0xe5, 0xd7,
+	0x50, 0x1a, 0x7a, 0xba, 0xed, 0xeb, 0x06, 0xb1, 0x1c, 0x1b, 0xed, 0x41, 0x9e, 0xcc, 0xb5, 0x3b,
+	0x3c, 0x67, 0xa4, 0xb2, 0xba, 0x49, 0xe6, 0x6d, 0x3c, 0x47, 0x75, 0xd8, 0x1c, 0xeb, 0x23, 0x3c,
+	0x66, 0x53, 0x16, 0xd5, 0xe0, 0x43, 0x7e, 0x09, 0x3b, 0x57, 0xd3, 0xd1, 0xd8, 0xf2, 0xef, 0xa2,
+	0x29, 0x3e, 0x85, 0x8a, 0x1b, 0x98, 0x34, 0xec, 0x79, 0x4e, 0x38, 0x57, 0x99, 0x1b, 0x15, 0x6a,
+	0x93, 0x3d, 0x40, 0x03, 0x6c, 0x9b, 0xfd, 0x29, 0x71, 0xa7, 0xc4, 0xe7, 0xd1, 0xa2, 0x03, 0x00,
+	0x5f, 0x27, 0x9a, 0x8b, 0x3d, 0xed, 0x7e, 0xc6, 0xc6, 0x65, 0xd5, 0x82, 0xaf, 0x93, 0x2b, 0xec,
+	0x75, 0x66, 0xe8, 0x04, 0xb6, 0x9c, 0x80, 0xdf, 0xd8, 0x38, 0xce, 0x9e, 0x94, 0x9e, 0x6f, 0x37,
+	0x79, 0x55, 0x9b, 0xc3, 0x79, 0x7f, 0x4a, 0xd4, 0x10, 0x8e, 0x63, 0xcd, 0x8a, 0xb1, 0x7e, 0x01,
+	0xb5, 0xc4, 0x9c, 0x3c, 0xde, 0x3d, 0xc8, 0x7b, 0xfa, 0x4c, 0x23, 0x51, 0xbe, 0x9e, 0x3e, 0x1b,
+	0xce, 0xe5, 0x2f, 0x01, 0x29, 0x3e, 0xb1, 0x26, 0x3a, 0xc1, 0x17, 0x18, 0x87, 0x11, 0x1e, 0x41,
+	0xc9, 0x70, 0xec, 0x1b, 0x8d, 0xe8, 0xde, 0x2d, 0x0e, 0x97, 0x08, 0xa8, 0x69, 0xc8, 0x2c, 0xf2,
+	0x0b, 0xa8, 0x25, 0x86, 0xf1, 0x49, 0xde, 0x9b, 0x99, 0xfc, 0xaf, 0x2c, 0x94, 0xaf, 0xb0, 0x6d,
+	0x5a, 0xf6, 0xed, 0x60, 0x86, 0xb1, 0x8b, 0x7e, 0x06, 0x05, 0x9a, 0x8b, 0x13, 0xb6, 0x41, 0xe9,
+	0xf9, 0x4e, 0x73, 0xcc, 0x32, 0xed, 0x4f, 0xc9, 0x15, 0x35, 0xab, 0x11, 0x01, 0xbd, 0x86, 0xf2,
+	0xcc, 0x22, 0x36, 0xf6, 0x7d, 0x8d, 0x2c, 0x5c, 0xcc, 0x16, 0x68, 0xfb, 0xf9, 0xe3, 0x66, 0xd4,
+	0x88, 0xcd, 0xeb, 0x00, 0x1e, 0x2e, 0x5c, 0xac, 0x26, 0xb8, 0xe8, 0x10, 0x40, 0x9f, 0x38, 0x53,
+	0x9b, 0x68, 0xbe, 0x4e, 0x58, 0xb9, 0x2a, 0xaa, 0x60, 0x41, 0x32, 0x94, 0xc3, 0xb8, 0x47, 0x0b,
+	0x82, 0x1b, 0x39, 0xc6, 0x48, 0xd8, 0x50, 0x13, 0xd0, 0xc8, 0x73, 0x74, 0xd3, 0xd0, 0x7d, 0xa2,
+	0xe9, 0x84, 0xe0, 0x89, 0x4b, 0xfc, 0xc6, 0x26, 0x63, 0xa6, 0x20, 0xe8, 0x57, 0xb0, 0x67, 0xe3,
+	0x39, 0xd1, 0x62, 0xe8, 0x0e, 0x5b, 0xb7, 0x77, 0xa4, 0x91, 0x67, 0x43, 0xd2, 0x41, 0x3a, 0xca,
+	0x0b, 0x16, 0x01, 0x9b, 0x9a, 0xb8, 0x06, 0x85, 0x60, 0x54, 0x2a, 0x88, 0x5e, 0xc2, 0xe3, 0x18,
+	0x48, 0x64, 0x52, 0x64, 0xc3, 0xd6, 0xa0, 0xb4, 0x83, 0x6e, 0x1c, 0xcf, 0xc0, 0x8d, 0xad, 0xe3,
+	0xcc, 0x49, 0x41, 0x0d, 0x3e, 0xe4, 0xc7, 0x50, 0x17, 0x97, 0x29, 0xec, 0x5b, 0xf9, 0x4f, 0xb0,
+	0xb7, 0x64, 0xe7, 0xcb, 0xfe, 0x7b, 0xd8, 0x76, 0x03, 0x40, 0xf3, 0x19, 0xd2, 0xc8, 0xb0, 0xce,
+	0xdd, 0x17, 0x16, 0x47, 0x1c, 0xa9, 0x2e, 0xd1, 0xe5, 0x7f, 0x67, 0x60, 0xfb, 0xcd, 0x74, 0xe2,
+	0x0a, 0x2d, 0xf8, 0x51, 0xbd, 0x71, 0x0c, 0xa5, 0xa0, 0x12, 0xac, 0x2a, 0xac, 0x35, 0x2a, 0xaa,
+	0x68, 0x5a, 0x59, 0xe1, 0x6c, 0xca, 0x0a, 0x47, 0xd5, 0xc8, 0x09, 0xd5, 0x88, 0x77, 0xd9, 0xa6,
+	0xb8, 0xcb, 0x76, 0x61, 0x27, 0x0a, 0x38, 0xa8, 0x82, 0xfc, 0xb7, 0x0c, 0xa0, 0x2e, 0xd6, 0x7d,
+	0x1c, 0x6c, 0xbd, 0x30, 0x91, 0x6d, 0xd8, 0xb0, 0x4c, 0xbe, 0xe9, 0x36, 0x2c, 0x33, 0x91, 0xd8,
+	0xc6, 0x87, 0x12, 0x6b, 0x02, 0xc2, 0x73, 0xd7, 0xf2, 0x74, 0x7a, 0x66, 0x69, 0x3e, 0x36, 0x1c,
+	0xdb, 0xf4, 0x59, 0xf0, 0x39, 0x35, 0x05, 0x91, 0xbf, 0x84, 0x5a, 0x22, 0x04, 0xbe, 0x40, 0x87,
+	0x00, 0x31, 0x99, 0xc5, 0x92, 0x53, 0x05, 0x8b, 0x3c, 0x80, 0xba, 0x8a, 0xc7, 0x3f, 0x6c, 0xec,
+	0xf2, 0x3e, 0xec, 0x2d, 0x39, 0xe5, 0x85, 0xba, 0x83, 0xe2, 0x3b, 0x32, 0x77, 0x58, 0xa0, 0xff,
+	0x5f, 0x79, 0x92, 0x79, 0x65, 0x57, 0xf2, 0xaa, 0xc1, 0x6e, 0xd7, 0xf2, 0x09, 0x9b, 0x29, 0x6a,
+	0xe3, 0x1e, 0x20, 0xd1, 0xc8, 0x4b, 0xf4, 0x0a, 0xca, 0x63, 0xc7, 0xb8, 0xc7, 0xa6, 0x36, 0x25,
+	0x73, 0x27, 0xec, 0xe0, 0xba, 0xd0, 0xc1, 0x51, 0xcc, 0x6a, 0x82, 0x29, 0xeb, 0xb0, 0xdf, 0xa5,
+	0x3d, 0x21, 0xdc, 0x2e, 0x61, 0xfd, 0x10, 0xe4, 0xc8, 0x3c, 0x4a, 0x8f, 0xfd, 0x4e, 0xbf, 0x61,
+	0xd0, 0x01, 0x14, 0x9d, 0x07, 0xec, 0xcd, 0x3c, 0x8b, 0x37, 0x67, 0x41, 0x8d, 0x0d, 0xb2, 0x04,
+	0x8d, 0xd5, 0x29, 0x78, 0x35, 0x0f, 0xe1, 0x40, 0x99, 0xbb, 0x8e, 0x47, 0xae, 0x75, 0x62, 0xdc,
+	0xf5, 0xed, 0xf1, 0xe2, 0x9a, 0x85, 0x1c, 0xa6, 0xfb, 0x3b, 0x78, 0xba, 0x06, 0x8f, 0x0e, 0x6d,
+	0x7e, 0x99, 0x6b, 0xe6, 0x88, 0x47, 0x1a, 0x1b, 0xe8, 0xd6, 0x84, 0xe1, 0x7c, 0x88, 0x27, 0xee,
+	0x58, 0x27, 0x18, 0x7d, 0x06, 0x79, 0xcb, 0x66, 0x97, 0x53, 0x50, 0xa0, 0x95, 0xc5, 0xe1, 0x30,
+	0xfa, 0xed, 0xf2, 0x35, 0x26, 0x0b, 0xa5, 0x8c, 0x1d, 0x36, 0xf9, 0x3d, 0xa5, 0xd8, 0xc4, 0x5b,
+	0x44, 0x57, 0x9b, 0xf4, 0x1a, 0xca, 0x22, 0x80, 0xaa, 0x90, 0xbd, 0xc7, 0x0b, 0x7e, 0xc7, 0xd2,
+	0x9f, 0xb4, 0x8c, 0x0f, 0xfa, 0x78, 0x1a, 0xdc, 0x03, 0x39, 0x35, 0xf8, 0x78, 0xbd, 0xf1, 0x2a,
+	0x23, 0xff, 0x27, 0x03, 0x3b, 0x17, 0x53, 0xdb, 0xbc, 0xf2, 0x47, 0x51, 0x23, 0xd7, 0x21, 0xe7,
+	0xfa, 0xa3, 0xe0, 0x24, 0x29, 0xb7, 0x1f, 0xa9, 0xec, 0x0b, 0x7d, 0x0e, 0x59, 0x4f, 0x9f, 0xf1,
+	0x36, 0xdb, 0x4b, 0x8d, 0xaf, 0xfd, 0x48, 0xa5, 0x1c, 0x24, 0x27, 0x4f, 0x18, 0x76, 0x7c, 0xb4,
+	0x33, 0xc9, 0x33, 0xe6, 0x19, 0x54, 0xc2, 0xf3, 0xe4, 0x21, 0xba, 0x46, 0x72, 0xed, 0x8c, 0x9a,
+	0x34, 0xd3, 0x82, 0x4f, 0x2c, 0x9b, 0x8d, 0x09, 0x2e, 0x90, 0x4d, 0x35, 0x36, 0xbc, 0x01, 0x28,
+	0x10, 0x3e, 0xf9, 0x9b, 0x3c, 0xe4, 0x6e, 0x30, 0xf6, 0xe5, 0x7f, 0x66, 0xa0, 0x1a, 0xa7, 0xc4,
+	0xd7, 0xed, 0x18, 0x4a, 0x37, 0x53, 0xdb, 0xc4, 0xa6, 0x16, 0xa7, 0xa6, 0x8a, 0x26, 0xf4, 0x0b,
+	0xa8, 0x19, 0x77, 0xba, 0x7d, 0x8b, 0xb5, 0xa0, 0xae, 0x9a, 0x65, 0x9b, 0x78, 0xce, 0xd5, 0x54,
+	0x1a, 0xb4, 0xb2, 0x0b, 0xb2, 0xdf, 0x7b, 0x17, 0xbc, 0x80, 0x9d, 0x81, 0x75, 0x6b, 0x8b, 0x45,
+	0xff, 0x60, 0x80, 0xf2, 0x37, 0x50, 0x8d, 0x07, 0xc5, 0x69, 0x31, 0xf5, 0x98, 0x1c, 0x25, 0x98,
+	0xd0, 0x8f, 0xa1, 0xc2, 0x3f, 0x2d, 0x3b, 0x6a, 0xb0, 0x8a, 0x9a, 0x34, 0xca, 0xbf, 0x86, 0xda,
+	0x85, 0x65, 0xeb, 0x63, 0xeb, 0xaf, 0xf8, 0xe3, 0x82, 0xfa, 0x0b, 0xd4, 0x93, 0x03, 0xbf, 0x77,
+	0x60, 0x32, 0x94, 0xa9, 0xc6, 0xba, 0xa1, 0xa3, 0x35, 0x12, 0x14, 0xba, 0xac, 0x26, 0x6c, 0xf2,
+	0x7f, 0x37, 0x60, 0xeb, 0xcc, 0x30, 0xa8, 0xf4, 0xa0, 0xc7, 0x83, 0xad, 0x4f, 0x70, 0x28, 0x53,
+	0xe9, 0x6f, 0xf4, 0x12, 0xca, 0x54, 0xae, 0x2e, 0xc9, 0x1c, 0xc4, 0xb7, 0xd9, 0x59, 0x00, 0x05,
+	0x12, 0x47, 0xe4, 0xa1, 0x67, 0xb0, 0xad, 0x07, 0x6e, 0x35, 0x7b, 0x3a, 0x19, 0x61, 0x8f, 0x5f,
+	0x71, 0x4b, 0xd6, 0xe0, 0x46, 0x21, 0xd8, 0xa3, 0xe1, 0x50, 0x85, 0xcd, 0x40, 0x2e, 0x78, 0x52,
+	0x10, 0xca, 0xb7, 0xec, 0x15, 0x3e, 0x97, 0x3d, 0x96, 0x9d, 0xca, 0x9f, 0xd0, 0xe3, 0x06, 0x9b,
+	0x02, 0x3f, 0xcf, 0xf9, 0x2b, 0x08, 0xfa, 0x02, 0x76, 0x69, 0xdf, 0x5b, 0xde, 0x04, 0x9b, 0xda,
+	0x48, 0x1f, 0xeb, 0x36, 0x97, 0x23, 0x59, 0x75, 0x15, 0xa0, 0x1d, 0x3d, 0xb5, 0x57, 0xf9, 0x05,
+	0xc6, 0x4f, 0x83, 0xe4, 0xcf, 0xa1, 0x46, 0x4f, 0x7b, 0x5e, 0x72, 0x5f, 0x38, 0x99, 0x97, 0x4b,
+	0x2f, 0x5f, 0x40, 0x3d, 0x49, 0xe5, 0x0b, 0xdf, 0x84, 0x02, 0x2f, 0x62, 0x78, 0xea, 0x21, 0x61,
+	0x43, 0x70, 0xba, 0x1a, 0x71, 0x7e, 0xfa, 0xf7, 0x2c, 0x94, 0x04, 0x2d, 0x8a, 0x6a, 0xb0, 0xf3,
+	0xae, 0xd7, 0xe9, 0xf5, 0xaf, 0x7b, 0xda, 0xf5, 0xdb, 0x61, 0x4f, 0x19, 0x0c, 0xaa, 0x8f, 0x50,
+	0x03, 0xea, 0xad, 0xfe, 0xe5, 0xe5, 0xdb, 0xe1, 0xa5, 0xd2, 0x1b, 0x6a, 0xc3, 0xb7, 0x97, 0x8a,
+	0xd6, 0xed, 0xb7, 0x3a, 0xd5, 0x0c, 0xda, 0x87, 0x9a, 0x80, 0xf4, 0xfa, 0xda, 0xb9, 0xd2, 0x3d,
+	0xfb, 0xba, 0xba, 0x81, 0xf6, 0x60, 0x57, 0x00, 0x54, 0xe5, 0xab, 0x7e, 0x47, 0xa9, 0x66, 0x29,
+	0xbf, 0x3d, 0xec, 0xb6, 0xb4, 0xfe, 0xc5, 0x85, 0xa2, 0x2a, 0xe7, 0x21, 0x90, 0xa3, 0x53, 0x30,
+	0xe0, 0xac, 0xd5, 0x52, 0xae, 0x86, 0x31, 0xb2, 0x89, 0x7e, 0x02, 0x3f, 0x4a, 0x0c, 0xa1, 0xd3,
+	0xf7, 0xdf, 0x0d, 0xb5, 0x81, 0xd2, 0xea, 0xf7, 0xce, 0xb5, 0xae, 0xf2, 0x95, 0xd2, 0xad, 0xe6,
+	0xd1, 0x33, 0x90, 0x93, 0x0e, 0x06, 0xef, 0x5a, 0x2d, 0x65, 0x30, 0x48, 0xf2, 0xb6, 0xd0, 0x11,
+	0x3c, 0x59, 0x8a, 0xe0, 0xb2, 0x3f, 0x54, 0x42, 0xaf, 0xd5, 0x02, 0x3a, 0x86, 0x83, 0xe5, 0x48,
+	0x18, 0x83, 0xfb, 0xab, 0x16, 0xd1, 0x01, 0x34, 0x18, 0x43, 0xf4, 0x1c, 0xc6, 0x0b, 0xa8, 0x0e,
+	0x55, 0x5e, 0x39, 0xad, 0xa3, 0x7c, 0xad, 0xb5, 0xcf, 0x06, 0xed, 0x6a, 0x09, 0x3d, 0x81, 0xfd,
+	0x9e, 0x32, 0xa0, 0xee, 0x56, 0xc0, 0xf2, 0xf3, 0x7f, 0x00, 0x14, 0x83, 0x8b, 0xae, 0x63, 0xd1,
+	0xc7, 0x43, 0xe5, 0x1c, 0x7b, 0xd6, 0x03, 0xee, 0xe1, 0x39, 0xe9, 0xe0, 0x05, 0xda, 0x15, 0x56,
+	0x30, 0x78, 0x9c, 0x4a, 0x8f, 0xa3, 0x77, 0x56, 0x07, 0x2f, 0xce, 0xb1, 0x6f, 0x78, 0x96, 0x4b,
+	0x1c, 0x0f, 0xbd, 0x82, 0x62, 0x30, 0x96, 0x8e, 0xab, 0x89, 0xa4, 0xae, 0x63, 0xe8, 0xc4, 0xf1,
+	0xd6, 0x8e, 0xfc, 0x0d, 0x14, 0xe8, 0x7c, 0x74, 0xd3, 0x22, 0xf1, 0xa1, 0x22, 0x3c, 0x5d, 0xa5,
+	0xfd, 0x15, 0x3b, 0xef, 0xba, 0x36, 0x20, 0xfe, 0xe6, 0x14, 0x9f, 0xad, 0xa2, 0x1b, 0xc1, 0x2e,
+	0x49, 0x82, 0x7d, 0xf9, 0xa9, 0xda, 0x85, 0x92, 0xf0, 0x22, 0x44, 0x4f, 0x05, 0xea, 0xea, 0xeb,
+	0x54, 0x3a, 0x5c, 0x07, 0xc7, 0xde, 0x84, 0xa7, 0x5f, 0xc2, 0xdb, 0xea, 0x4b, 0x52, 0x3a, 0x5c,
+	0x07, 0x73, 0x6f, 0x2a, 0x54, 0x12, 0x6f, 0x0a, 0x74, 0xb4, 0xe6, 0xcd, 0x10, 0xc5, 0x77, 0xbc,
+	0x9e, 0xc0, 0x7d, 0xfe, 0x01, 0xb6, 0xb8, 0x36, 0x47, 0x9f, 0x08, 0xe4, 0xe4, 0x03, 0x43, 0x92,
+	0xd2, 0xa0, 0x38, 0x47, 0x41, 0x46, 0x27, 0x72, 0x5c, 0x55, 0xf8, 0xd2, 0xe1, 0x3a, 0x38, 0xce,
+	0x31, 0x21, 0x84, 0x13, 0x39, 0xa6, 0xe9, 0x6e, 0xe9, 0x78, 0x3d, 0x81, 0xfb, 0x7c, 0x0b, 0x10,
+	0x8b, 0x58, 0x74, 0x20, 0x46, 0xb0, 0x2c, 0x78, 0xa5, 0xa7, 0x6b, 0x50, 0xee, 0xea, 0xcf, 0x50,
+	0x5d, 0x16, 0x97, 0x48, 0x14, 0x6b, 0x6b, 0xc4, 0xad, 0xf4, 0xe9, 0x7b, 0x39, 0xdc, 0xf9, 0xb7,
+	0xb0, 0x97, 0xaa, 0x3e, 0xd1, 0x67, 0x62, 0x63, 0xbc, 0x47, 0xbf, 0x4a, 0x27, 0x1f, 0x26, 0xf2,
+	0xb9, 0x5a, 0x50, 0x08, 0x45, 0x12, 0x12, 0x57, 0x77, 0x49, 0x0c, 0x4a, 0x4f, 0x52, 0xb1, 0xd8,
+	0x49, 0x28, 0x49, 0x12, 0x4e, 0x96, 0xc4, 0x8d, 0xf4, 0x24, 0x15, 0xe3, 0x4e, 0xfa, 0x50, 0x16,
+	0x25, 0x04, 0x12, 0x3b, 0x24, 0x45, 0x94, 0x48, 0x47, 0x6b, 0xf1, 0xd8, 0xa1, 0x78, 0x35, 0x25,
+	0x1c, 0xa6, 0x5c, 0x6f, 0xd2, 0xd1, 0x5a, 0x3c, 0x70, 0xf8, 0xe6, 0x97, 0xdf, 0x9c, 0xde, 0x5a,
+	0xe4, 0x6e, 0x3a, 0x6a, 0x1a, 0xce, 0xe4, 0xf4, 0xcc, 0x20, 0x96, 0x6d, 0x4d, 0x27, 0x3f, 0x77,
+	0x3d, 0xe7, 0x5b, 0x6c, 0x90, 0xd3, 0xb1, 0x6d, 0x9e, 0x32, 0xc5, 0x71, 0x1a, 0xf9, 0x19, 0xe5,
+	0xd9, 0x7f, 0xf3, 0x5e, 0xfc, 0x6f, 0x00, 0x27, 0x57, 0x01, 0xdc, 0x16, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WalletKitClient is the client API for WalletKit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WalletKitClient interface {
+	//*
+	//DeriveNextKey attempts to derive the *next* key within the key family
+	//(account in BIP43) specified. This method should return the next external
+	//child within this branch.
+	DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error)
+	//*
+	//DeriveKey attempts to derive an arbitrary key specified by the passed
+	//KeyLocator.
+	DeriveKey(ctx context.Context, in *signrpc.KeyLocator, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error)
+	//*
+	//NextAddr returns the next unused address within the wallet.
+	NextAddr(ctx context.Context, in *AddrRequest, opts ...grpc.CallOption) (*AddrResponse, error)
+	//*
+	//PublishTransaction attempts to publish the passed transaction to the
+	//network. Once this returns without an error, the wallet will continually
+	//attempt to re-broadcast the transaction on start up, until it enters the
+	//chain.
+	PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error)
+	//*
+	//SendOutputs is similar to the existing sendmany call in Bitcoind, and
+	//allows the caller to create a transaction that sends to several outputs at
+	//once. This is ideal when wanting to batch create a set of transactions.
+	SendOutputs(ctx context.Context, in *SendOutputsRequest, opts ...grpc.CallOption) (*SendOutputsResponse, error)
+	//*
+	//EstimateFee attempts to query the internal fee estimator of the wallet to
+	//determine the fee (in sat/kw) to attach to a transaction in order to
+	//achieve the confirmation target.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	//
+	//PendingSweeps returns lists of on-chain outputs that lnd is currently
+	//attempting to sweep within its central batching engine. Outputs with similar
+	//fee rates are batched together in order to sweep them within a single
+	//transaction.
+	//
+	//NOTE: Some of the fields within PendingSweepsRequest are not guaranteed to
+	//remain supported. This is an advanced API that depends on the internals of
+	//the UtxoSweeper, so things may change.
+	PendingSweeps(ctx context.Context, in *PendingSweepsRequest, opts ...grpc.CallOption) (*PendingSweepsResponse, error)
+	//
+	//BumpFee bumps the fee of an arbitrary input within a transaction. This RPC
+	//takes a different approach than bitcoind's bumpfee command. lnd has a
+	//central batching engine in which inputs with similar fee rates are batched
+	//together to save on transaction fees. Due to this, we cannot rely on
+	//bumping the fee on a specific transaction, since transactions can change at
+	//any point with the addition of new inputs. The list of inputs that
+	//currently exist within lnd's central batching engine can be retrieved
+	//through the PendingSweeps RPC.
+	//
+	//When bumping the fee of an input that currently exists within lnd's central
+	//batching engine, a higher fee transaction will be created that replaces the
+	//lower fee transaction through the Replace-By-Fee (RBF) policy. If it
+	//
+	//This RPC also serves useful when wanting to perform a Child-Pays-For-Parent
+	//(CPFP), where the child transaction pays for its parent's fee. This can be
+	//done by specifying an outpoint within the low fee transaction that is under
+	//the control of the wallet.
+	//
+	//The fee preference can be expressed either as a specific fee rate or a delta
+	//of blocks in which the output should be swept on-chain within. If a fee
+	//preference is not explicitly specified, then an error is returned.
+	//
+	//Note that this RPC currently doesn't perform any validation checks on the
+	//fee preference being provided. For now, the responsibility of ensuring that
+	//the new fee preference is sufficient is delegated to the user.
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	//
+	//LeaseOutput locks an output to the given ID, preventing it from being
+	//available for any future coin selection attempts, including the ones of
+	//the funding manager and the sweeper. The absolute time of the lock's
+	//expiration is returned. The expiration of the lock can be extended by
+	//successive invocations of this RPC with the same ID. Leases are persisted,
+	//so they survive restarts. Outputs can be unlocked before their expiration
+	//through `ReleaseOutput`.
+	LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error)
+	//
+	//ReleaseOutput unlocks an output, allowing it to be available for coin
+	//selection if it remains unspent. The ID should match the one used to
+	//originally lock the output.
+	ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error)
+	//
+	//ListLeases lists all currently leased utxos along with the ID and the
+	//expiration of their lease.
+	ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesResponse, error)
+	//
+	//LabelTransaction adds a label to a transaction. If the transaction already
+	//has a label the call will fail unless the overwrite bool is set. This will
+	//overwrite the existing transaction label. Labels must not be empty, and
+	//cannot exceed 500 characters.
+	LabelTransaction(ctx context.Context, in *LabelTransactionRequest, opts ...grpc.CallOption) (*LabelTransactionResponse, error)
+	//
+	//ExportWatchOnlyWallet returns a watch-only copy of the wallet database. A
+	//node that is started with this copy as its wallet and this node configured
+	//as its remote signer can operate without ever holding the seed, as it
+	//forwards all signing operations to this node.
+	ExportWatchOnlyWallet(ctx context.Context, in *ExportWatchOnlyWalletRequest, opts ...grpc.CallOption) (*ExportWatchOnlyWalletResponse, error)
+	//
+	//FundPsbt creates a fully populated PSBT that contains enough inputs to fund
+	//the outputs specified in the template. There are two ways of specifying a
+	//template: Either by passing in a PSBT with at least one output declared or
+	//by passing in a raw TxTemplate message.
+	//
+	//If there are no inputs specified in the template, coin selection is
+	//performed automatically. If the template does contain any inputs, it is
+	//assumed that full coin selection happened externally and no additional
+	//inputs are added. If the specified inputs aren't enough to fund the
+	//outputs with the given fee rate, an error is returned.
+	//
+	//After either selecting or verifying the inputs, all input UTXOs are locked
+	//with an internal app ID.
+	//
+	//NOTE: If this method returns without an error, it is the caller's
+	//responsibility to either spend the locked UTXOs (by finalizing and then
+	//publishing the transaction) or to unlock/release the locked UTXOs in case of
+	//an error on the caller's side.
+	FundPsbt(ctx context.Context, in *FundPsbtRequest, opts ...grpc.CallOption) (*FundPsbtResponse, error)
+	//
+	//SignPsbt expects a partial transaction with all inputs and outputs fully
+	//declared and tries to sign the inputs that belong to the wallet. Inputs
+	//that are controlled by other parties, for example a hardware wallet, are
+	//left untouched, so their signatures can be added independently.
+	//
+	//NOTE: This RPC only signs inputs (and only those it can sign), it does not
+	//perform any other tasks (such as coin selection, UTXO locking or
+	//finalizing).
+	SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*SignPsbtResponse, error)
+	//
+	//FinalizePsbt expects a partial transaction with all inputs and outputs
+	//fully declared and tries to sign all inputs that belong to the wallet.
+	//All other inputs must already carry the signatures of their owners. Every
+	//input is then finalized and the final, signed transaction is returned in
+	//both the PSBT and raw wire format. The transaction is not published.
+	FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error)
+	//
+	//ListAccounts retrieves all accounts of the wallet that addresses are
+	//derived from, along with their balances. Accounts can be filtered by name.
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+}
+
+type walletKitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletKitClient(cc *grpc.ClientConn) WalletKitClient {
+	return &walletKitClient{cc}
+}
+
+func (c *walletKitClient) DeriveNextKey(ctx context.Context, in *KeyReq, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
+	out := new(signrpc.KeyDescriptor)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveNextKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) DeriveKey(ctx context.Context, in *signrpc.KeyLocator, opts ...grpc.CallOption) (*signrpc.KeyDescriptor, error) {
+	out := new(signrpc.KeyDescriptor)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/DeriveKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) NextAddr(ctx context.Context, in *AddrRequest, opts ...grpc.CallOption) (*AddrResponse, error) {
+	out := new(AddrResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/NextAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) PublishTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*PublishResponse, error) {
+	out := new(PublishResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/PublishTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) SendOutputs(ctx context.Context, in *SendOutputsRequest, opts ...grpc.CallOption) (*SendOutputsResponse, error) {
+	out := new(SendOutputsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/SendOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) PendingSweeps(ctx context.Context, in *PendingSweepsRequest, opts ...grpc.CallOption) (*PendingSweepsResponse, error) {
+	out := new(PendingSweepsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/PendingSweeps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/BumpFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error) {
+	out := new(LeaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/LeaseOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error) {
+	out := new(ReleaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ReleaseOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesResponse, error) {
+	out := new(ListLeasesResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ListLeases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) LabelTransaction(ctx context.Context, in *LabelTransactionRequest, opts ...grpc.CallOption) (*LabelTransactionResponse, error) {
+	out := new(LabelTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/LabelTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ExportWatchOnlyWallet(ctx context.Context, in *ExportWatchOnlyWalletRequest, opts ...grpc.CallOption) (*ExportWatchOnlyWalletResponse, error) {
+	out := new(ExportWatchOnlyWalletResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ExportWatchOnlyWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) FundPsbt(ctx context.Context, in *FundPsbtRequest, opts ...grpc.CallOption) (*FundPsbtResponse, error) {
+	out := new(FundPsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/FundPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*SignPsbtResponse, error) {
+	out := new(SignPsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/SignPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error) {
+	out := new(FinalizePsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/FinalizePsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletKitServer is the server API for WalletKit service.
 type WalletKitServer interface {
 	//*
 	//DeriveNextKey attempts to derive the *next* key within the key family
@@ -1122,6 +2474,77 @@ type WalletKitServer interface {
 	//fee preference being provided. For now, the responsibility of ensuring that
 	//the new fee preference is sufficient is delegated to the user.
 	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	//
+	//LeaseOutput locks an output to the given ID, preventing it from being
+	//available for any future coin selection attempts, including the ones of
+	//the funding manager and the sweeper. The absolute time of the lock's
+	//expiration is returned. The expiration of the lock can be extended by
+	//successive invocations of this RPC with the same ID. Leases are persisted,
+	//so they survive restarts. Outputs can be unlocked before their expiration
+	//through `ReleaseOutput`.
+	LeaseOutput(context.Context, *LeaseOutputRequest) (*LeaseOutputResponse, error)
+	//
+	//ReleaseOutput unlocks an output, allowing it to be available for coin
+	//selection if it remains unspent. The ID should match the one used to
+	//originally lock the output.
+	ReleaseOutput(context.Context, *ReleaseOutputRequest) (*ReleaseOutputResponse, error)
+	//
+	//ListLeases lists all currently leased utxos along with the ID and the
+	//expiration of their lease.
+	ListLeases(context.Context, *ListLeasesRequest) (*ListLeasesResponse, error)
+	//
+	//LabelTransaction adds a label to a transaction. If the transaction already
+	//has a label the call will fail unless the overwrite bool is set. This will
+	//overwrite the existing transaction label. Labels must not be empty, and
+	//cannot exceed 500 characters.
+	LabelTransaction(context.Context, *LabelTransactionRequest) (*LabelTransactionResponse, error)
+	//
+	//ExportWatchOnlyWallet returns a watch-only copy of the wallet database. A
+	//node that is started with this copy as its wallet and this node configured
+	//as its remote signer can operate without ever holding the seed, as it
+	//forwards all signing operations to this node.
+	ExportWatchOnlyWallet(context.Context, *ExportWatchOnlyWalletRequest) (*ExportWatchOnlyWalletResponse, error)
+	//
+	//FundPsbt creates a fully populated PSBT that contains enough inputs to fund
+	//the outputs specified in the template. There are two ways of specifying a
+	//template: Either by passing in a PSBT with at least one output declared or
+	//by passing in a raw TxTemplate message.
+	//
+	//If there are no inputs specified in the template, coin selection is
+	//performed automatically. If the template does contain any inputs, it is
+	//assumed that full coin selection happened externally and no additional
+	//inputs are added. If the specified inputs aren't enough to fund the
+	//outputs with the given fee rate, an error is returned.
+	//
+	//After either selecting or verifying the inputs, all input UTXOs are locked
+	//with an internal app ID.
+	//
+	//NOTE: If this method returns without an error, it is the caller's
+	//responsibility to either spend the locked UTXOs (by finalizing and then
+	//publishing the transaction) or to unlock/release the locked UTXOs in case of
+	//an error on the caller's side.
+	FundPsbt(context.Context, *FundPsbtRequest) (*FundPsbtResponse, error)
+	//
+	//SignPsbt expects a partial transaction with all inputs and outputs fully
+	//declared and tries to sign the inputs that belong to the wallet. Inputs
+	//that are controlled by other parties, for example a hardware wallet, are
+	//left untouched, so their signatures can be added independently.
+	//
+	//NOTE: This RPC only signs inputs (and only those it can sign), it does not
+	//perform any other tasks (such as coin selection, UTXO locking or
+	//finalizing).
+	SignPsbt(context.Context, *SignPsbtRequest) (*SignPsbtResponse, error)
+	//
+	//FinalizePsbt expects a partial transaction with all inputs and outputs
+	//fully declared and tries to sign all inputs that belong to the wallet.
+	//All other inputs must already carry the signatures of their owners. Every
+	//input is then finalized and the final, signed transaction is returned in
+	//both the PSBT and raw wire format. The transaction is not published.
+	FinalizePsbt(context.Context, *FinalizePsbtRequest) (*FinalizePsbtResponse, error)
+	//
+	//ListAccounts retrieves all accounts of the wallet that addresses are
+	//derived from, along with their balances. Accounts can be filtered by name.
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
 }
 
 func RegisterWalletKitServer(s *grpc.Server, srv WalletKitServer) {
@@ -1272,6 +2695,168 @@ func _WalletKit_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletKit_LeaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).LeaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/LeaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).LeaseOutput(ctx, req.(*LeaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ReleaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ReleaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ReleaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ReleaseOutput(ctx, req.(*ReleaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ListLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ListLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ListLeases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ListLeases(ctx, req.(*ListLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_LabelTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).LabelTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/LabelTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).LabelTransaction(ctx, req.(*LabelTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ExportWatchOnlyWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWatchOnlyWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ExportWatchOnlyWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ExportWatchOnlyWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ExportWatchOnlyWallet(ctx, req.(*ExportWatchOnlyWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_FundPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).FundPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/FundPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).FundPsbt(ctx, req.(*FundPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_SignPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).SignPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/SignPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).SignPsbt(ctx, req.(*SignPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_FinalizePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizePsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).FinalizePsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/FinalizePsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).FinalizePsbt(ctx, req.(*FinalizePsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WalletKit_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "walletrpc.WalletKit",
 	HandlerType: (*WalletKitServer)(nil),
@@ -1308,6 +2893,42 @@ var _WalletKit_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BumpFee",
 			Handler:    _WalletKit_BumpFee_Handler,
 		},
+		{
+			MethodName: "LeaseOutput",
+			Handler:    _WalletKit_LeaseOutput_Handler,
+		},
+		{
+			MethodName: "ReleaseOutput",
+			Handler:    _WalletKit_ReleaseOutput_Handler,
+		},
+		{
+			MethodName: "ListLeases",
+			Handler:    _WalletKit_ListLeases_Handler,
+		},
+		{
+			MethodName: "LabelTransaction",
+			Handler:    _WalletKit_LabelTransaction_Handler,
+		},
+		{
+			MethodName: "ExportWatchOnlyWallet",
+			Handler:    _WalletKit_ExportWatchOnlyWallet_Handler,
+		},
+		{
+			MethodName: "FundPsbt",
+			Handler:    _WalletKit_FundPsbt_Handler,
+		},
+		{
+			MethodName: "SignPsbt",
+			Handler:    _WalletKit_SignPsbt_Handler,
+		},
+		{
+			MethodName: "FinalizePsbt",
+			Handler:    _WalletKit_FinalizePsbt_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _WalletKit_ListAccounts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "walletrpc/walletkit.proto",
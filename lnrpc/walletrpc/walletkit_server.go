@@ -8,18 +8,24 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/Actinium-project/acmd/chaincfg/chainhash"
 	"github.com/Actinium-project/acmd/txscript"
 	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/acmutil"
 	"github.com/Actinium-project/lnd/input"
 	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/labels"
 	"github.com/Actinium-project/lnd/lnrpc"
 	"github.com/Actinium-project/lnd/lnrpc/signrpc"
 	"github.com/Actinium-project/lnd/lnwallet"
 	"github.com/Actinium-project/lnd/lnwallet/chainfee"
+	"github.com/Actinium-project/lnd/psbt"
 	"github.com/Actinium-project/lnd/sweep"
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -88,8 +94,48 @@ var (
 			Entity: "onchain",
 			Action: "write",
 		}},
+		"/walletrpc.WalletKit/LeaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ReleaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ListLeases": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/walletrpc.WalletKit/LabelTransaction": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ExportWatchOnlyWallet": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/FundPsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/SignPsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/FinalizePsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ListAccounts": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 	}
 
+	// DefaultLockDuration is the default duration used to lease outputs
+	// if the caller doesn't specify one.
+	DefaultLockDuration = 10 * time.Minute
+
 	// DefaultWalletKitMacFilename is the default name of the wallet kit
 	// macaroon that we expect to find via a file handle within the main
 	// configuration file in this package.
@@ -101,6 +147,9 @@ var (
 // keys (for contracts!), and publishing transactions.
 type WalletKit struct {
 	cfg *Config
+
+	// fundMtx serializes the coin selection of FundPsbt calls.
+	fundMtx sync.Mutex
 }
 
 // A compile time check to ensure that WalletKit fully implements the
@@ -262,13 +311,18 @@ func (w *WalletKit) PublishTransaction(ctx context.Context,
 			"publish")
 	}
 
+	// Make sure the label provided, if any, is a valid one.
+	if err := labels.ValidateAPI(req.Label); err != nil {
+		return nil, err
+	}
+
 	tx := &wire.MsgTx{}
 	txReader := bytes.NewReader(req.TxHex)
 	if err := tx.Deserialize(txReader); err != nil {
 		return nil, err
 	}
 
-	err := w.cfg.Wallet.PublishTransaction(tx)
+	err := w.cfg.Wallet.PublishTransaction(tx, req.Label)
 	if err != nil {
 		return nil, err
 	}
@@ -290,6 +344,11 @@ func (w *WalletKit) SendOutputs(ctx context.Context,
 			"to create")
 	}
 
+	// Make sure the label provided, if any, is a valid one.
+	if err := labels.ValidateAPI(req.Label); err != nil {
+		return nil, err
+	}
+
 	// Before we can request this transaction to be created, we'll need to
 	// amp the protos back into the format that the internal wallet will
 	// recognize.
@@ -304,7 +363,8 @@ func (w *WalletKit) SendOutputs(ctx context.Context,
 	// Now that we have the outputs mapped, we can request that the wallet
 	// attempt to create this transaction.
 	tx, err := w.cfg.Wallet.SendOutputs(
-		outputsToCreate, chainfee.SatPerKWeight(req.SatPerKw),
+		nil, outputsToCreate, chainfee.SatPerKWeight(req.SatPerKw), 1,
+		req.Label,
 	)
 	if err != nil {
 		return nil, err
@@ -425,41 +485,6 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 	}, nil
 }
 
-// unmarshallOutPoint converts an outpoint from its lnrpc type to its canonical
-// type.
-func unmarshallOutPoint(op *lnrpc.OutPoint) (*wire.OutPoint, error) {
-	if op == nil {
-		return nil, fmt.Errorf("empty outpoint provided")
-	}
-
-	var hash chainhash.Hash
-	switch {
-	case len(op.TxidBytes) == 0 && len(op.TxidStr) == 0:
-		fallthrough
-
-	case len(op.TxidBytes) != 0 && len(op.TxidStr) != 0:
-		return nil, fmt.Errorf("either TxidBytes or TxidStr must be " +
-			"specified, but not both")
-
-	// The hash was provided as raw bytes.
-	case len(op.TxidBytes) != 0:
-		copy(hash[:], op.TxidBytes)
-
-	// The hash was provided as a hex-encoded string.
-	case len(op.TxidStr) != 0:
-		h, err := chainhash.NewHashFromStr(op.TxidStr)
-		if err != nil {
-			return nil, err
-		}
-		hash = *h
-	}
-
-	return &wire.OutPoint{
-		Hash:  hash,
-		Index: op.OutputIndex,
-	}, nil
-}
-
 // BumpFee allows bumping the fee rate of an arbitrary input. A fee preference
 // can be expressed either as a specific fee rate or a delta of blocks in which
 // the output should be swept on-chain within. If a fee preference is not
@@ -469,11 +494,16 @@ func (w *WalletKit) BumpFee(ctx context.Context,
 	in *BumpFeeRequest) (*BumpFeeResponse, error) {
 
 	// Parse the outpoint from the request.
-	op, err := unmarshallOutPoint(in.Outpoint)
+	op, err := lnrpc.UnmarshallOutPoint(in.Outpoint)
 	if err != nil {
 		return nil, err
 	}
 
+	// Make sure the label provided, if any, is a valid one.
+	if err := labels.ValidateAPI(in.Label); err != nil {
+		return nil, err
+	}
+
 	// Construct the request's fee preference.
 	satPerKw := chainfee.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
 	feePreference := sweep.FeePreference{
@@ -489,6 +519,7 @@ func (w *WalletKit) BumpFee(ctx context.Context,
 	params := sweep.Params{
 		Fee:   feePreference,
 		Force: in.Force,
+		Label: in.Label,
 	}
 
 	_, err = w.cfg.Sweeper.UpdateParams(*op, params)
@@ -547,9 +578,412 @@ func (w *WalletKit) BumpFee(ctx context.Context,
 	}
 
 	input := input.NewBaseInput(op, witnessType, signDesc, uint32(currentHeight))
-	if _, err = w.cfg.Sweeper.SweepInput(input, sweep.Params{Fee: feePreference}); err != nil {
+	sweepParams := sweep.Params{
+		Fee:         feePreference,
+		Label:       in.Label,
+		WalletInput: true,
+	}
+	if _, err = w.cfg.Sweeper.SweepInput(input, sweepParams); err != nil {
 		return nil, err
 	}
 
 	return &BumpFeeResponse{}, nil
 }
+
+// LeaseOutput locks an output to the given ID, preventing it from being
+// available for any future coin selection attempts. The absolute time of the
+// lock's expiration is returned. The expiration of the lock can be extended by
+// successive invocations of this call. Outputs can be unlocked before their
+// expiration through `ReleaseOutput`.
+func (w *WalletKit) LeaseOutput(ctx context.Context,
+	req *LeaseOutputRequest) (*LeaseOutputResponse, error) {
+
+	lockID, err := unmarshallLeaseID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	op, err := lnrpc.UnmarshallOutPoint(req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := DefaultLockDuration
+	if req.ExpirationSeconds != 0 {
+		duration = time.Duration(req.ExpirationSeconds) * time.Second
+	}
+
+	expiration, err := w.cfg.Wallet.LeaseOutput(lockID, *op, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaseOutputResponse{
+		Expiration: uint64(expiration.Unix()),
+	}, nil
+}
+
+// ReleaseOutput unlocks an output, allowing it to be available for coin
+// selection if it remains unspent. The ID should match the one used to
+// originally lock the output.
+func (w *WalletKit) ReleaseOutput(ctx context.Context,
+	req *ReleaseOutputRequest) (*ReleaseOutputResponse, error) {
+
+	lockID, err := unmarshallLeaseID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	op, err := lnrpc.UnmarshallOutPoint(req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.cfg.Wallet.ReleaseOutput(lockID, *op); err != nil {
+		return nil, err
+	}
+
+	return &ReleaseOutputResponse{}, nil
+}
+
+// ListLeases returns all currently leased outputs along with the ID and the
+// expiration of their lease.
+func (w *WalletKit) ListLeases(ctx context.Context,
+	req *ListLeasesRequest) (*ListLeasesResponse, error) {
+
+	leases, err := w.cfg.Wallet.ListLeasedOutputs()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcLeases := make([]*UtxoLease, 0, len(leases))
+	for _, lease := range leases {
+		rpcLeases = append(rpcLeases, &UtxoLease{
+			Id: lease.ID[:],
+			Outpoint: &lnrpc.OutPoint{
+				TxidBytes:   lease.OutPoint.Hash[:],
+				TxidStr:     lease.OutPoint.Hash.String(),
+				OutputIndex: lease.OutPoint.Index,
+			},
+			Expiration: uint64(lease.Expiration.Unix()),
+		})
+	}
+
+	return &ListLeasesResponse{
+		LockedUtxos: rpcLeases,
+	}, nil
+}
+
+// unmarshallLeaseID converts a raw lease ID into its canonical type.
+func unmarshallLeaseID(id []byte) (lnwallet.LeaseID, error) {
+	var lockID lnwallet.LeaseID
+	if len(id) != len(lockID) {
+		return lockID, fmt.Errorf("invalid lease id length %v, "+
+			"expected %v", len(id), len(lockID))
+	}
+	copy(lockID[:], id)
+
+	return lockID, nil
+}
+
+// LabelTransaction adds a label to a transaction of the wallet. If the
+// transaction already has a label, the call fails unless overwrite is set.
+func (w *WalletKit) LabelTransaction(ctx context.Context,
+	req *LabelTransactionRequest) (*LabelTransactionResponse, error) {
+
+	// Check that the label provided is non-zero.
+	if len(req.Label) == 0 {
+		return nil, errors.New("cannot label transaction with empty " +
+			"label")
+	}
+
+	// Make sure the label provided is a valid one.
+	if err := labels.ValidateAPI(req.Label); err != nil {
+		return nil, err
+	}
+
+	hash, err := chainhash.NewHash(req.Txid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.cfg.Wallet.LabelTransaction(*hash, req.Label, req.Overwrite)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LabelTransactionResponse{}, nil
+}
+
+// ExportWatchOnlyWallet returns a watch-only copy of the wallet database, which
+// can be used by a node that uses this node as its remote signer.
+func (w *WalletKit) ExportWatchOnlyWallet(ctx context.Context,
+	req *ExportWatchOnlyWalletRequest) (*ExportWatchOnlyWalletResponse,
+	error) {
+
+	if w.cfg.WatchOnlyExporter == nil {
+		return nil, fmt.Errorf("wallet doesn't support exporting a " +
+			"watch-only copy")
+	}
+
+	// A watch-only wallet can't create the accounts of key families that
+	// haven't been used yet, so we'll make sure the accounts of all key
+	// families exist before exporting the wallet.
+	lastKeyFam := keychain.KeyFamilyTowerID
+	for keyFam := keychain.KeyFamilyMultiSig; keyFam <= lastKeyFam; keyFam++ {
+		_, err := w.cfg.KeyRing.DeriveKey(keychain.KeyLocator{
+			Family: keyFam,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to create account of "+
+				"key family %v: %v", keyFam, err)
+		}
+	}
+
+	var walletDB bytes.Buffer
+	err := w.cfg.WatchOnlyExporter.ExportWatchOnly(&walletDB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportWatchOnlyWalletResponse{
+		WalletDb: walletDB.Bytes(),
+	}, nil
+}
+
+// FundPsbt creates a fully populated PSBT that contains enough inputs to fund
+// the outputs specified in the template. If the template doesn't contain any
+// inputs, coin selection is performed, otherwise the inputs of the template
+// are used as is. All inputs of the funded PSBT are leased.
+func (w *WalletKit) FundPsbt(ctx context.Context,
+	req *FundPsbtRequest) (*FundPsbtResponse, error) {
+
+	var (
+		template *psbt.Packet
+		err      error
+	)
+	switch t := req.Template.(type) {
+	case *FundPsbtRequest_Psbt:
+		template, err = psbt.NewFromRawBytes(
+			bytes.NewReader(t.Psbt), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse PSBT: %v", err)
+		}
+
+	case *FundPsbtRequest_Raw:
+		tx := wire.NewMsgTx(2)
+		for _, rpcOutPoint := range t.Raw.Inputs {
+			op, err := lnrpc.UnmarshallOutPoint(rpcOutPoint)
+			if err != nil {
+				return nil, err
+			}
+			tx.AddTxIn(wire.NewTxIn(op, nil, nil))
+		}
+		for addrStr, amt := range t.Raw.Outputs {
+			addr, err := acmutil.DecodeAddress(
+				addrStr, w.cfg.ChainParams,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("invalid address %v: %v",
+					addrStr, err)
+			}
+			pkScript, err := txscript.PayToAddrScript(addr)
+			if err != nil {
+				return nil, err
+			}
+			tx.AddTxOut(wire.NewTxOut(int64(amt), pkScript))
+		}
+
+		template, err = psbt.New(tx)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("transaction template missing, need " +
+			"to specify either PSBT or raw TX template")
+	}
+
+	var feeRate chainfee.SatPerKWeight
+	switch {
+	case req.GetSatPerVbyte() != 0:
+		feeRate = chainfee.SatPerKVByte(
+			req.GetSatPerVbyte() * 1000,
+		).FeePerKWeight()
+
+	case req.GetTargetConf() != 0:
+		feeRate, err = w.cfg.FeeEstimator.EstimateFeePerKW(
+			req.GetTargetConf(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to estimate fee: %v",
+				err)
+		}
+
+	default:
+		return nil, fmt.Errorf("fee definition missing, need to " +
+			"specify either target_conf or sat_per_vbyte")
+	}
+
+	// The fee rate must at least meet the relay fee of the backend.
+	if feeRate < chainfee.FeePerKwFloor {
+		feeRate = chainfee.FeePerKwFloor
+	}
+
+	minConfs := req.MinConfs
+	if minConfs <= 0 {
+		minConfs = 1
+	}
+
+	packet, changeIndex, leases, err := w.fundPsbt(
+		template, feeRate, minConfs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fund PSBT: %v", err)
+	}
+
+	fundedPsbt, err := serializePsbt(packet)
+	if err != nil {
+		w.releaseLeases(leases)
+		return nil, err
+	}
+
+	return &FundPsbtResponse{
+		FundedPsbt:        fundedPsbt,
+		ChangeOutputIndex: changeIndex,
+		LockedUtxos:       leases,
+	}, nil
+}
+
+// SignPsbt signs all inputs of the PSBT that spend outputs of the wallet.
+// Inputs owned by other parties are left untouched.
+func (w *WalletKit) SignPsbt(ctx context.Context,
+	req *SignPsbtRequest) (*SignPsbtResponse, error) {
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse PSBT: %v", err)
+	}
+
+	signedInputs, err := w.signPsbt(packet)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign PSBT: %v", err)
+	}
+
+	signedPsbt, err := serializePsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignPsbtResponse{
+		SignedPsbt:   signedPsbt,
+		SignedInputs: signedInputs,
+	}, nil
+}
+
+// FinalizePsbt signs all inputs of the PSBT that spend outputs of the wallet
+// and finalizes every input, using the partial signatures of the other signers
+// for the inputs the wallet doesn't own. The final transaction is returned,
+// but not published.
+func (w *WalletKit) FinalizePsbt(ctx context.Context,
+	req *FinalizePsbtRequest) (*FinalizePsbtResponse, error) {
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse PSBT: %v", err)
+	}
+
+	if _, err := w.signPsbt(packet); err != nil {
+		return nil, fmt.Errorf("unable to sign PSBT: %v", err)
+	}
+	if err := psbt.MaybeFinalizeAll(packet); err != nil {
+		return nil, fmt.Errorf("unable to finalize PSBT: %v", err)
+	}
+
+	finalTx, err := psbt.Extract(packet)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract final tx: %v", err)
+	}
+
+	signedPsbt, err := serializePsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	var finalTxBuf bytes.Buffer
+	if err := finalTx.Serialize(&finalTxBuf); err != nil {
+		return nil, err
+	}
+
+	return &FinalizePsbtResponse{
+		SignedPsbt: signedPsbt,
+		RawFinalTx: finalTxBuf.Bytes(),
+	}, nil
+}
+
+// ListAccounts retrieves all accounts of the wallet that addresses are derived
+// from, along with their balances.
+func (w *WalletKit) ListAccounts(ctx context.Context,
+	req *ListAccountsRequest) (*ListAccountsResponse, error) {
+
+	accounts, err := w.cfg.Wallet.ListAccounts(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos, err := w.cfg.Wallet.ListUnspentWitness(0, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcAccounts := make([]*Account, 0, len(accounts))
+	for _, account := range accounts {
+		var addrType lnrpc.AddressType
+		switch account.AddressType {
+		case lnwallet.WitnessPubKey:
+			addrType = lnrpc.AddressType_WITNESS_PUBKEY_HASH
+
+		case lnwallet.NestedWitnessPubKey:
+			addrType = lnrpc.AddressType_NESTED_PUBKEY_HASH
+
+		default:
+			return nil, fmt.Errorf("account %v has unknown address "+
+				"type %v", account.Name, account.AddressType)
+		}
+
+		rpcAccount := &Account{
+			Name:             account.Name,
+			AddressType:      addrType,
+			AccountNumber:    account.Number,
+			ExternalKeyCount: account.ExternalKeyCount,
+			InternalKeyCount: account.InternalKeyCount,
+			ImportedKeyCount: account.ImportedKeyCount,
+		}
+
+		// The outputs only carry the name of their account, so we'll
+		// match them by their address type too, as accounts of
+		// different types can share a name.
+		for _, utxo := range utxos {
+			if utxo.Account != account.Name ||
+				utxo.AddressType != account.AddressType {
+
+				continue
+			}
+
+			if utxo.Confirmations > 0 {
+				rpcAccount.ConfirmedBalance += int64(utxo.Value)
+			} else {
+				rpcAccount.UnconfirmedBalance += int64(utxo.Value)
+			}
+		}
+
+		rpcAccounts = append(rpcAccounts, rpcAccount)
+	}
+
+	return &ListAccountsResponse{
+		Accounts: rpcAccounts,
+	}, nil
+}
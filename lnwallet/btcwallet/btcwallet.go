@@ -19,6 +19,7 @@ import (
 	"github.com/Actinium-project/acmwallet/wallet/txauthor"
 	"github.com/Actinium-project/acmwallet/wallet/txrules"
 	"github.com/Actinium-project/acmwallet/walletdb"
+	"github.com/Actinium-project/lnd/clock"
 	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/lnwallet"
 	"github.com/Actinium-project/lnd/lnwallet/chainfee"
@@ -59,6 +60,14 @@ type BtcWallet struct {
 	netParams *chaincfg.Params
 
 	chainKeyScope waddrmgr.KeyScope
+
+	// leases is the set of active output leases, keyed by the leased
+	// output. It mirrors the leases persisted within the wallet database.
+	leases   map[wire.OutPoint]*lnwallet.LeasedOutput
+	leaseMtx sync.Mutex
+
+	// clock is used to determine the expiration of output leases.
+	clock clock.Clock
 }
 
 // A compile time check to ensure that BtcWallet implements the
@@ -127,6 +136,8 @@ func New(cfg Config) (*BtcWallet, error) {
 		chain:         cfg.ChainSource,
 		netParams:     cfg.NetParams,
 		chainKeyScope: chainKeyScope,
+		leases:        make(map[wire.OutPoint]*lnwallet.LeasedOutput),
+		clock:         clock.NewDefaultClock(),
 	}, nil
 }
 
@@ -155,9 +166,13 @@ func (b *BtcWallet) Start() error {
 	// We'll start by unlocking the wallet and ensuring that the KeyScope:
 	// (1017, 1) exists within the internal waddrmgr. We'll need this in
 	// order to properly generate the keys required for signing various
-	// contracts.
-	if err := b.wallet.Unlock(b.cfg.PrivatePass, nil); err != nil {
-		return err
+	// contracts. A watch-only wallet holds no private keys, so there's
+	// nothing to unlock in that case.
+	if !b.wallet.Manager.WatchOnly() {
+		err := b.wallet.Unlock(b.cfg.PrivatePass, nil)
+		if err != nil {
+			return err
+		}
 	}
 	_, err := b.wallet.Manager.FetchScopedKeyManager(b.chainKeyScope)
 	if err != nil {
@@ -177,6 +192,13 @@ func (b *BtcWallet) Start() error {
 		}
 	}
 
+	// The outputs locked by the underlying wallet only live in memory, so
+	// we'll re-apply all persisted leases before any coin selection can
+	// take place.
+	if err := b.loadLeases(); err != nil {
+		return err
+	}
+
 	// Establish an RPC connection in addition to starting the goroutines
 	// in the underlying wallet.
 	if err := b.chain.Start(); err != nil {
@@ -289,8 +311,9 @@ func (b *BtcWallet) IsOurAddress(a acmutil.Address) bool {
 // outputs are non-standard, a non-nil error will be returned.
 //
 // This is a part of the WalletController interface.
-func (b *BtcWallet) SendOutputs(outputs []*wire.TxOut,
-	feeRate chainfee.SatPerKWeight) (*wire.MsgTx, error) {
+func (b *BtcWallet) SendOutputs(inputs []wire.OutPoint, outputs []*wire.TxOut,
+	feeRate chainfee.SatPerKWeight, minConfs int32,
+	label string) (*wire.MsgTx, error) {
 
 	// Convert our fee rate from sat/kw to sat/kb since it's required by
 	// SendOutputs.
@@ -300,7 +323,64 @@ func (b *BtcWallet) SendOutputs(outputs []*wire.TxOut,
 	if len(outputs) < 1 {
 		return nil, lnwallet.ErrNoOutputs
 	}
-	return b.wallet.SendOutputs(outputs, defaultAccount, 1, feeSatPerKB)
+
+	// Ensure outputs of expired leases are available to coin selection.
+	if err := b.releaseExpiredLeases(); err != nil {
+		return nil, err
+	}
+
+	// If no inputs were specified, we'll let the wallet's coin selection
+	// pick them for us. A watch-only wallet can't sign the transactions it
+	// creates though, so we'll have to select the coins ourselves in that
+	// case.
+	if len(inputs) == 0 && !b.wallet.Manager.WatchOnly() {
+		tx, err := b.wallet.SendOutputs(
+			outputs, defaultAccount, minConfs, feeSatPerKB,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// The transaction has been published by now, so we can attach
+		// the label to it.
+		if err := b.storePublishLabel(tx.TxHash(), label); err != nil {
+			return nil, err
+		}
+
+		return tx, nil
+	}
+
+	// Otherwise, we'll create and sign the transaction, spending exactly
+	// the given inputs if any, and publish it ourselves.
+	var (
+		tx  *wire.MsgTx
+		err error
+	)
+	if len(inputs) == 0 {
+		tx, err = b.sendOutputsWithCoinSelection(
+			outputs, feeSatPerKB, minConfs,
+		)
+	} else {
+		tx, err = b.sendOutputsFromInputs(
+			inputs, outputs, feeSatPerKB, minConfs,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// The inputs are locked until we're done publishing. Once the
+		// transaction is published, the wallet considers them spent,
+		// so they're no longer eligible for coin selection anyway.
+		defer b.releaseInputs(inputs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := b.PublishTransaction(tx, label); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
 }
 
 // CreateSimpleTx creates a Bitcoin transaction paying to the specified
@@ -339,6 +419,11 @@ func (b *BtcWallet) CreateSimpleTx(outputs []*wire.TxOut,
 		}
 	}
 
+	// Ensure outputs of expired leases are available to coin selection.
+	if err := b.releaseExpiredLeases(); err != nil {
+		return nil, err
+	}
+
 	return b.wallet.CreateSimpleTx(defaultAccount, outputs, 1, feeSatPerKB, dryRun)
 }
 
@@ -366,6 +451,14 @@ func (b *BtcWallet) UnlockOutpoint(o wire.OutPoint) {
 // This is a part of the WalletController interface.
 func (b *BtcWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 	[]*lnwallet.Utxo, error) {
+
+	// Outputs of expired leases should be returned again, so we'll release
+	// them first. Outputs that are still leased are excluded by the
+	// underlying wallet.
+	if err := b.releaseExpiredLeases(); err != nil {
+		return nil, err
+	}
+
 	// First, grab all the unfiltered currently unspent outputs.
 	unspentOutputs, err := b.wallet.ListUnspent(minConfs, maxConfs, nil)
 	if err != nil {
@@ -415,6 +508,7 @@ func (b *BtcWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 					Index: output.Vout,
 				},
 				Confirmations: output.Confirmations,
+				Account:       output.Account,
 			}
 			witnessOutputs = append(witnessOutputs, utxo)
 		}
@@ -424,12 +518,149 @@ func (b *BtcWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 	return witnessOutputs, nil
 }
 
+// ListAccounts returns all accounts of the wallet that addresses are derived
+// from, which are the accounts of the nested and native witness key scopes. If
+// name is not empty, only the accounts with that name are returned.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ListAccounts(name string) ([]*lnwallet.AccountInfo,
+	error) {
+
+	scopes := []struct {
+		scope    waddrmgr.KeyScope
+		addrType lnwallet.AddressType
+	}{
+		{waddrmgr.KeyScopeBIP0049Plus, lnwallet.NestedWitnessPubKey},
+		{waddrmgr.KeyScopeBIP0084, lnwallet.WitnessPubKey},
+	}
+
+	var accounts []*lnwallet.AccountInfo
+	for _, s := range scopes {
+		result, err := b.wallet.Accounts(s.scope)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, account := range result.Accounts {
+			if name != "" && account.AccountName != name {
+				continue
+			}
+
+			accounts = append(accounts, &lnwallet.AccountInfo{
+				Name:             account.AccountName,
+				Number:           account.AccountNumber,
+				AddressType:      s.addrType,
+				ExternalKeyCount: account.ExternalKeyCount,
+				InternalKeyCount: account.InternalKeyCount,
+				ImportedKeyCount: account.ImportedKeyCount,
+			})
+		}
+	}
+
+	return accounts, nil
+}
+
+// GetRecoveryInfo returns the progress of the recovery of the wallet from its
+// seed, if the wallet was started in recovery mode. The progress is the share
+// of the blocks between the birthday block of the wallet and the best block
+// that have been rescanned.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) GetRecoveryInfo() (*lnwallet.RecoveryInfo, error) {
+	info := &lnwallet.RecoveryInfo{
+		RecoveryWindow: b.cfg.RecoveryWindow,
+		KeyCounts:      make(map[keychain.KeyFamily]uint32),
+	}
+
+	// A zero recovery window means the wallet wasn't started in recovery
+	// mode.
+	if b.cfg.RecoveryWindow == 0 {
+		return info, nil
+	}
+	info.RecoveryMode = true
+
+	_, bestHeight, err := b.cfg.ChainSource.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	err = walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		birthdayBlock, _, err := b.wallet.Manager.BirthdayBlock(
+			addrmgrNs,
+		)
+		switch {
+		// The birthday block is only set once the wallet has found it
+		// during its initial sync, until then nothing was rescanned.
+		case waddrmgr.IsError(err, waddrmgr.ErrBirthdayBlockNotSet):
+			info.BirthdayHeight = bestHeight
+
+		case err != nil:
+			return err
+
+		default:
+			info.BirthdayHeight = birthdayBlock.Height
+		}
+
+		// The keys of every key family are derived from an account of
+		// our own key scope, which may not exist yet if no key of the
+		// family was ever needed.
+		scope, err := b.wallet.Manager.FetchScopedKeyManager(
+			b.chainKeyScope,
+		)
+		if err != nil {
+			return err
+		}
+
+		family := keychain.KeyFamilyMultiSig
+		for ; family <= keychain.KeyFamilyTowerID; family++ {
+			props, err := scope.AccountProperties(
+				addrmgrNs, uint32(family),
+			)
+			if waddrmgr.IsError(err, waddrmgr.ErrAccountNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			info.KeyCounts[family] = props.ExternalKeyCount
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	syncedTo := b.wallet.Manager.SyncedTo()
+	switch {
+	case bestHeight <= info.BirthdayHeight:
+		info.Progress = 1
+
+	case syncedTo.Height > info.BirthdayHeight:
+		info.Progress = float64(syncedTo.Height-info.BirthdayHeight) /
+			float64(bestHeight-info.BirthdayHeight)
+	}
+	if info.Progress > 1 {
+		info.Progress = 1
+	}
+
+	info.RecoveryFinished = b.wallet.ChainSynced() &&
+		syncedTo.Height >= bestHeight
+
+	return info, nil
+}
+
 // PublishTransaction performs cursory validation (dust checks, etc), then
 // finally broadcasts the passed transaction to the Bitcoin network. If
 // publishing the transaction fails, an error describing the reason is returned
 // (currently ErrDoubleSpend). If the transaction is already published to the
-// network (either in the mempool or chain) no error will be returned.
-func (b *BtcWallet) PublishTransaction(tx *wire.MsgTx) error {
+// network (either in the mempool or chain) no error will be returned. A
+// non-empty label is attached to the transaction, unless the transaction is
+// already labelled.
+func (b *BtcWallet) PublishTransaction(tx *wire.MsgTx, label string) error {
 	if err := b.wallet.PublishTransaction(tx); err != nil {
 
 		// If we failed to publish the transaction, check whether we
@@ -451,7 +682,8 @@ func (b *BtcWallet) PublishTransaction(tx *wire.MsgTx) error {
 			return err
 		}
 	}
-	return nil
+
+	return b.storePublishLabel(tx.TxHash(), label)
 }
 
 // extractBalanceDelta extracts the net balance delta from the PoV of the
@@ -613,6 +845,15 @@ func (b *BtcWallet) ListTransactionDetails() ([]*lnwallet.TransactionDetail, err
 		txDetails = append(txDetails, detail)
 	}
 
+	// Finally, we'll attach the labels of all labelled transactions.
+	labels, err := b.fetchLabels()
+	if err != nil {
+		return nil, err
+	}
+	for _, txDetail := range txDetails {
+		txDetail.Label = labels[txDetail.Hash]
+	}
+
 	return txDetails, nil
 }
 
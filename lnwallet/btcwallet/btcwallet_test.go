@@ -0,0 +1,110 @@
+package btcwallet
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/Actinium-project/acmwallet/waddrmgr"
+	"github.com/Actinium-project/acmwallet/walletdb"
+	"github.com/Actinium-project/lnd/lnwallet"
+)
+
+// TestListAccounts tests that the accounts of the nested and native witness
+// key scopes are listed, optionally filtered by name, and that the unspent
+// outputs of the wallet carry the name of their account.
+func TestListAccounts(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "btcwallet-accounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := newTestWallet(t, dir)
+	defer closeTestWallet(t, w)
+
+	// Creating an account requires the private keys of the wallet, so
+	// we'll unlock its address manager first.
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		return w.wallet.Manager.Unlock(addrmgrNs, testPrivPass)
+	})
+	if err != nil {
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+	_, err = w.wallet.NextAccount(waddrmgr.KeyScopeBIP0084, "savings")
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+
+	accounts, err := w.ListAccounts("")
+	if err != nil {
+		t.Fatalf("unable to list accounts: %v", err)
+	}
+
+	type accountKey struct {
+		name     string
+		addrType lnwallet.AddressType
+	}
+	listed := make(map[accountKey]*lnwallet.AccountInfo)
+	for _, account := range accounts {
+		key := accountKey{account.Name, account.AddressType}
+		listed[key] = account
+	}
+
+	expected := []accountKey{
+		{"default", lnwallet.NestedWitnessPubKey},
+		{"default", lnwallet.WitnessPubKey},
+		{"savings", lnwallet.WitnessPubKey},
+	}
+	for _, key := range expected {
+		if _, ok := listed[key]; !ok {
+			t.Fatalf("account %v of type %v not listed", key.name,
+				key.addrType)
+		}
+	}
+	savings := listed[accountKey{"savings", lnwallet.WitnessPubKey}]
+	if savings.Number != 1 {
+		t.Fatalf("expected account number 1, got %v", savings.Number)
+	}
+
+	// Filtering by name only returns the matching account.
+	accounts, err = w.ListAccounts("savings")
+	if err != nil {
+		t.Fatalf("unable to list accounts: %v", err)
+	}
+	if len(accounts) != 1 || accounts[0].Name != "savings" {
+		t.Fatalf("expected only the savings account, got %v", accounts)
+	}
+
+	// Deriving an address counts towards the external keys of its
+	// account, and its outputs are attributed to the account.
+	addTestOutput(t, w)
+
+	accounts, err = w.ListAccounts("default")
+	if err != nil {
+		t.Fatalf("unable to list accounts: %v", err)
+	}
+	for _, account := range accounts {
+		expectedKeys := uint32(0)
+		if account.AddressType == lnwallet.WitnessPubKey {
+			expectedKeys = 1
+		}
+		if account.ExternalKeyCount != expectedKeys {
+			t.Fatalf("expected %v external keys for account of "+
+				"type %v, got %v", expectedKeys,
+				account.AddressType, account.ExternalKeyCount)
+		}
+	}
+
+	utxos, err := w.ListUnspentWitness(0, 0)
+	if err != nil {
+		t.Fatalf("unable to list unspent outputs: %v", err)
+	}
+	if len(utxos) != 1 || utxos[0].Account != "default" {
+		t.Fatalf("expected a single output of the default account, "+
+			"got %v", utxos)
+	}
+}
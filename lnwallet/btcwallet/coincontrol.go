@@ -0,0 +1,243 @@
+package btcwallet
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/Actinium-project/acmd/txscript"
+	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/acmutil"
+	"github.com/Actinium-project/acmwallet/waddrmgr"
+	"github.com/Actinium-project/acmwallet/wallet/txauthor"
+	"github.com/Actinium-project/acmwallet/wallet/txrules"
+	"github.com/Actinium-project/lnd/input"
+	"github.com/Actinium-project/lnd/lnwallet"
+)
+
+// selectInputs returns the wallet outputs referenced by the given outpoints,
+// and locks them so that they can't be leased or selected by another coin
+// selection until they're released through releaseInputs. An error is returned
+// if any of the outpoints is leased, or isn't an unspent output of the wallet
+// with at least minConfs confirmations.
+func (b *BtcWallet) selectInputs(inputs []wire.OutPoint,
+	minConfs int32) ([]*lnwallet.Utxo, error) {
+
+	utxos, err := b.ListUnspentWitness(minConfs, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	eligible := make(map[wire.OutPoint]*lnwallet.Utxo, len(utxos))
+	for _, utxo := range utxos {
+		eligible[utxo.OutPoint] = utxo
+	}
+
+	// We'll hold the lease mutex until the selected outputs are locked,
+	// so that they can't be leased in the meantime.
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	selected := make([]*lnwallet.Utxo, 0, len(inputs))
+	for _, op := range inputs {
+		// Outputs that are leased or locked by another coin selection
+		// aren't eligible, but we'll check for those first to be able
+		// to return a meaningful error.
+		_, leased := b.leases[op]
+		if leased || b.wallet.LockedOutpoint(op) {
+			return nil, fmt.Errorf("%v: %v", op,
+				lnwallet.ErrOutputAlreadyLeased)
+		}
+
+		utxo, ok := eligible[op]
+		if !ok {
+			return nil, fmt.Errorf("outpoint %v is not an unspent "+
+				"output of the wallet with at least %d "+
+				"confirmations", op, minConfs)
+		}
+
+		// Remove the output from the eligible set, so it can't be
+		// selected twice.
+		delete(eligible, op)
+		selected = append(selected, utxo)
+	}
+
+	for _, utxo := range selected {
+		b.wallet.LockOutpoint(utxo.OutPoint)
+	}
+
+	return selected, nil
+}
+
+// releaseInputs unlocks the given outputs previously locked by selectInputs.
+func (b *BtcWallet) releaseInputs(inputs []wire.OutPoint) {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	for _, op := range inputs {
+		b.wallet.UnlockOutpoint(op)
+	}
+}
+
+// sendOutputsFromInputs funds a transaction paying out to the specified
+// outputs by spending exactly the given inputs, sending any remaining value
+// back to a change address of the wallet. The transaction is signed, but not
+// published.
+//
+// NOTE: If no error is returned, the inputs remain locked, and the caller
+// MUST release them through releaseInputs once the transaction has been
+// published or abandoned.
+func (b *BtcWallet) sendOutputsFromInputs(inputs []wire.OutPoint,
+	outputs []*wire.TxOut, feeSatPerKB acmutil.Amount,
+	minConfs int32) (*wire.MsgTx, error) {
+
+	utxos, err := b.selectInputs(inputs, minConfs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Our input source will always hand out all selected inputs, as the
+	// caller asked for exactly those to be spent.
+	inputSource := func(target acmutil.Amount) (acmutil.Amount,
+		[]*wire.TxIn, []acmutil.Amount, [][]byte, error) {
+
+		total, txIns, inputValues, scripts := utxosToInputs(utxos)
+		return total, txIns, inputValues, scripts, nil
+	}
+
+	tx, err := b.authorSignedTx(outputs, feeSatPerKB, inputSource)
+	if err != nil {
+		b.releaseInputs(inputs)
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// sendOutputsWithCoinSelection funds a transaction paying out to the
+// specified outputs by selecting from the unspent outputs of the wallet,
+// largest first, sending any remaining value back to a change address of the
+// wallet. The transaction is signed, but not published.
+//
+// NOTE: This is used instead of the coin selection of the underlying wallet
+// if the wallet is watch-only, as the underlying wallet can't sign the
+// transactions it creates in that case.
+func (b *BtcWallet) sendOutputsWithCoinSelection(outputs []*wire.TxOut,
+	feeSatPerKB acmutil.Amount, minConfs int32) (*wire.MsgTx, error) {
+
+	utxos, err := b.ListUnspentWitness(minConfs, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(utxos, func(i, j int) bool {
+		return utxos[i].Value > utxos[j].Value
+	})
+
+	// Our input source will hand out the largest outputs until their total
+	// value covers the target amount.
+	inputSource := func(target acmutil.Amount) (acmutil.Amount,
+		[]*wire.TxIn, []acmutil.Amount, [][]byte, error) {
+
+		var total acmutil.Amount
+		numSelected := 0
+		for _, utxo := range utxos {
+			if total >= target {
+				break
+			}
+
+			total += utxo.Value
+			numSelected++
+		}
+
+		total, txIns, inputValues, scripts := utxosToInputs(
+			utxos[:numSelected],
+		)
+		return total, txIns, inputValues, scripts, nil
+	}
+
+	return b.authorSignedTx(outputs, feeSatPerKB, inputSource)
+}
+
+// utxosToInputs returns the total value of the given outputs, along with the
+// inputs spending them and their values and scripts.
+func utxosToInputs(utxos []*lnwallet.Utxo) (acmutil.Amount, []*wire.TxIn,
+	[]acmutil.Amount, [][]byte) {
+
+	var (
+		total       acmutil.Amount
+		txIns       = make([]*wire.TxIn, 0, len(utxos))
+		inputValues = make([]acmutil.Amount, 0, len(utxos))
+		scripts     = make([][]byte, 0, len(utxos))
+	)
+	for _, utxo := range utxos {
+		total += utxo.Value
+		txIns = append(txIns, wire.NewTxIn(&utxo.OutPoint, nil, nil))
+		inputValues = append(inputValues, utxo.Value)
+		scripts = append(scripts, utxo.PkScript)
+	}
+
+	return total, txIns, inputValues, scripts
+}
+
+// authorSignedTx creates a transaction paying out to the specified outputs,
+// funded by the inputs handed out by the input source, and sending any
+// remaining value back to a change address of the wallet. The transaction is
+// signed, but not published.
+func (b *BtcWallet) authorSignedTx(outputs []*wire.TxOut,
+	feeSatPerKB acmutil.Amount,
+	inputSource txauthor.InputSource) (*wire.MsgTx, error) {
+
+	// Ensure the outputs to be created adhere to the network's consensus
+	// rules.
+	for _, output := range outputs {
+		err := txrules.CheckOutput(output, txrules.DefaultRelayFeePerKb)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changeSource := func() ([]byte, error) {
+		changeAddr, err := b.wallet.NewChangeAddress(
+			defaultAccount, waddrmgr.KeyScopeBIP0084,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return txscript.PayToAddrScript(changeAddr)
+	}
+
+	authoredTx, err := txauthor.NewUnsignedTransaction(
+		outputs, feeSatPerKB, inputSource, changeSource,
+	)
+	if err != nil {
+		return nil, err
+	}
+	authoredTx.RandomizeChangePosition()
+
+	// With the transaction assembled, we'll now sign each of its inputs.
+	tx := authoredTx.Tx
+	sigHashes := txscript.NewTxSigHashes(tx)
+	for i, txIn := range tx.TxIn {
+		signDesc := &input.SignDescriptor{
+			Output: &wire.TxOut{
+				PkScript: authoredTx.PrevScripts[i],
+				Value:    int64(authoredTx.PrevInputValues[i]),
+			},
+			HashType:   txscript.SigHashAll,
+			SigHashes:  sigHashes,
+			InputIndex: i,
+		}
+
+		inputScript, err := b.inputSigner().ComputeInputScript(
+			tx, signDesc,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		txIn.SignatureScript = inputScript.SigScript
+		txIn.Witness = inputScript.Witness
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,62 @@
+package btcwallet
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/lnd/lnwallet"
+)
+
+// TestSelectInputsLock tests that inputs selected for a transaction are locked
+// until they're released, and that they're released if the transaction can't
+// be funded.
+func TestSelectInputsLock(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "btcwallet-coincontrol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := newTestWallet(t, dir)
+	defer closeTestWallet(t, w)
+
+	op := addTestOutput(t, w)
+	inputs := []wire.OutPoint{op}
+
+	if _, err := w.selectInputs(inputs, 0); err != nil {
+		t.Fatalf("unable to select inputs: %v", err)
+	}
+	if !w.wallet.LockedOutpoint(op) {
+		t.Fatalf("selected input not locked")
+	}
+
+	// While selected, the input can neither be leased nor selected again.
+	_, err = w.LeaseOutput(testLeaseID, op, time.Hour)
+	if err != lnwallet.ErrOutputAlreadyLeased {
+		t.Fatalf("expected ErrOutputAlreadyLeased, got %v", err)
+	}
+	if _, err := w.selectInputs(inputs, 0); err == nil {
+		t.Fatalf("expected selection of locked input to fail")
+	}
+
+	w.releaseInputs(inputs)
+	if w.wallet.LockedOutpoint(op) {
+		t.Fatalf("released input still locked")
+	}
+
+	// A transaction that the input can't fund should fail, and release
+	// the input again.
+	outputs := []*wire.TxOut{wire.NewTxOut(200000, []byte{0x00, 0x14})}
+	_, err = w.sendOutputsFromInputs(inputs, outputs, 1000, 0)
+	if err == nil {
+		t.Fatalf("expected transaction funding to fail")
+	}
+	if w.wallet.LockedOutpoint(op) {
+		t.Fatalf("input still locked after failed funding")
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Actinium-project/acmwallet/chain"
 	"github.com/Actinium-project/acmwallet/wallet"
+	"github.com/Actinium-project/lnd/input"
 
 	// This is required to register bdb as a valid walletdb driver. In the
 	// init function of the package, it registers itself. The import is used
@@ -80,6 +81,11 @@ type Config struct {
 	// freelist to disk, resulting in improved performance at the expense of
 	// increased startup time.
 	NoFreelistSync bool
+
+	// RemoteSigner is the signer that holds the private keys of a
+	// watch-only wallet. If set, the inputs of all transactions created by
+	// the wallet are signed by it.
+	RemoteSigner input.Signer
 }
 
 // NetworkDir returns the directory name of a network directory to hold wallet
@@ -0,0 +1,99 @@
+package btcwallet
+
+import (
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/acmwallet/walletdb"
+	"github.com/Actinium-project/lnd/lnwallet"
+)
+
+var (
+	// wtxmgrNamespaceKey is the namespace key that the wtxmgr state is
+	// stored within the top-level walletdb buckets of btcwallet.
+	wtxmgrNamespaceKey = []byte("wtxmgr")
+
+	// labelBucketKey is the key of the top-level bucket within the wallet
+	// database that stores the labels of transactions, keyed by their
+	// hash.
+	labelBucketKey = []byte("lnd-tx-labels")
+)
+
+// LabelTransaction attaches a label to a transaction of the wallet. If the
+// transaction is already labelled, lnwallet.ErrTxLabelExists is returned
+// unless overwrite is true.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) LabelTransaction(hash chainhash.Hash, label string,
+	overwrite bool) error {
+
+	return walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		// We'll only allow transactions known to the wallet to be
+		// labelled.
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+		details, err := b.wallet.TxStore.TxDetails(txmgrNs, &hash)
+		if err != nil {
+			return err
+		}
+		if details == nil {
+			return lnwallet.ErrUnknownTx
+		}
+
+		return putLabel(tx, hash, label, overwrite)
+	})
+}
+
+// storePublishLabel attaches the label to the transaction, unless the label is
+// empty or the transaction is already labelled.
+func (b *BtcWallet) storePublishLabel(hash chainhash.Hash, label string) error {
+	if label == "" {
+		return nil
+	}
+
+	err := walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		return putLabel(tx, hash, label, false)
+	})
+	if err == lnwallet.ErrTxLabelExists {
+		return nil
+	}
+
+	return err
+}
+
+// fetchLabels returns the labels of all labelled transactions.
+func (b *BtcWallet) fetchLabels() (map[chainhash.Hash]string, error) {
+	labels := make(map[chainhash.Hash]string)
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		labelBucket := tx.ReadBucket(labelBucketKey)
+		if labelBucket == nil {
+			return nil
+		}
+
+		return labelBucket.ForEach(func(k, v []byte) error {
+			var hash chainhash.Hash
+			copy(hash[:], k)
+			labels[hash] = string(v)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+// putLabel writes the label of the transaction with the given hash.
+func putLabel(tx walletdb.ReadWriteTx, hash chainhash.Hash, label string,
+	overwrite bool) error {
+
+	labelBucket, err := tx.CreateTopLevelBucket(labelBucketKey)
+	if err != nil {
+		return err
+	}
+
+	if labelBucket.Get(hash[:]) != nil && !overwrite {
+		return lnwallet.ErrTxLabelExists
+	}
+
+	return labelBucket.Put(hash[:], []byte(label))
+}
@@ -0,0 +1,269 @@
+package btcwallet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/acmwallet/walletdb"
+	"github.com/Actinium-project/lnd/lnwallet"
+)
+
+var (
+	// leaseBucketKey is the key of the top-level bucket within the wallet
+	// database that stores all active output leases. Each lease is keyed
+	// by the leased outpoint, and stores the lease ID followed by the
+	// expiration of the lease as a unix timestamp.
+	leaseBucketKey = []byte("lnd-output-leases")
+)
+
+// LeaseOutput locks an output of the wallet under the given lease ID for the
+// given duration, preventing it from being used by any coin selection, and
+// returns the expiration of the lease. The lease is persisted, so it survives
+// restarts.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) LeaseOutput(id lnwallet.LeaseID, op wire.OutPoint,
+	duration time.Duration) (time.Time, error) {
+
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	if err := b.pruneExpiredLeases(); err != nil {
+		return time.Time{}, err
+	}
+
+	// An output that's leased under a different ID, or locked by one of
+	// our own coin selections, can't be leased.
+	lease, ok := b.leases[op]
+	switch {
+	case ok && lease.ID != id:
+		return time.Time{}, lnwallet.ErrOutputAlreadyLeased
+
+	case !ok && b.wallet.LockedOutpoint(op):
+		return time.Time{}, lnwallet.ErrOutputAlreadyLeased
+	}
+
+	// We'll only allow outputs of our own wallet to be leased.
+	if _, err := b.FetchInputInfo(&op); err != nil {
+		return time.Time{}, err
+	}
+
+	newLease := &lnwallet.LeasedOutput{
+		ID:         id,
+		OutPoint:   op,
+		Expiration: b.clock.Now().Add(duration),
+	}
+	err := walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		leaseBucket, err := tx.CreateTopLevelBucket(leaseBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return putLease(leaseBucket, newLease)
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	b.leases[op] = newLease
+	b.wallet.LockOutpoint(op)
+
+	return newLease.Expiration, nil
+}
+
+// ReleaseOutput releases the lease of an output, rendering it eligible for
+// coin selection again.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ReleaseOutput(id lnwallet.LeaseID, op wire.OutPoint) error {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	if err := b.pruneExpiredLeases(); err != nil {
+		return err
+	}
+
+	lease, ok := b.leases[op]
+	switch {
+	case !ok:
+		return lnwallet.ErrOutputUnlocked
+
+	case lease.ID != id:
+		return lnwallet.ErrLeaseIDMismatch
+	}
+
+	return b.removeLease(op)
+}
+
+// ListLeasedOutputs returns all outputs that are currently leased.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ListLeasedOutputs() ([]*lnwallet.LeasedOutput, error) {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	if err := b.pruneExpiredLeases(); err != nil {
+		return nil, err
+	}
+
+	leases := make([]*lnwallet.LeasedOutput, 0, len(b.leases))
+	for _, lease := range b.leases {
+		leaseCopy := *lease
+		leases = append(leases, &leaseCopy)
+	}
+
+	return leases, nil
+}
+
+// loadLeases reads all persisted leases from the database, and locks the
+// leased outputs within the wallet. Leases that expired while we were
+// offline are removed.
+func (b *BtcWallet) loadLeases() error {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	leases := make(map[wire.OutPoint]*lnwallet.LeasedOutput)
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		leaseBucket := tx.ReadBucket(leaseBucketKey)
+		if leaseBucket == nil {
+			return nil
+		}
+
+		return leaseBucket.ForEach(func(k, v []byte) error {
+			lease, err := fetchLease(k, v)
+			if err != nil {
+				return err
+			}
+
+			leases[lease.OutPoint] = lease
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	b.leases = leases
+	for op := range leases {
+		b.wallet.LockOutpoint(op)
+	}
+
+	return b.pruneExpiredLeases()
+}
+
+// releaseExpiredLeases removes all leases that have expired. It should be
+// called before any coin selection takes place.
+func (b *BtcWallet) releaseExpiredLeases() error {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	return b.pruneExpiredLeases()
+}
+
+// pruneExpiredLeases removes all leases that have expired, rendering their
+// outputs eligible for coin selection again.
+//
+// NOTE: The leaseMtx MUST be held when calling this method.
+func (b *BtcWallet) pruneExpiredLeases() error {
+	now := b.clock.Now()
+	for op, lease := range b.leases {
+		if lease.Expiration.After(now) {
+			continue
+		}
+
+		if err := b.removeLease(op); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeLease deletes the lease of the given output and unlocks it.
+//
+// NOTE: The leaseMtx MUST be held when calling this method.
+func (b *BtcWallet) removeLease(op wire.OutPoint) error {
+	err := walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		leaseBucket := tx.ReadWriteBucket(leaseBucketKey)
+		if leaseBucket == nil {
+			return nil
+		}
+
+		var k bytes.Buffer
+		if err := writeOutPoint(&k, &op); err != nil {
+			return err
+		}
+
+		return leaseBucket.Delete(k.Bytes())
+	})
+	if err != nil {
+		return err
+	}
+
+	delete(b.leases, op)
+	b.wallet.UnlockOutpoint(op)
+
+	return nil
+}
+
+// putLease writes the lease to the given bucket, keyed by its outpoint.
+func putLease(leaseBucket walletdb.ReadWriteBucket,
+	lease *lnwallet.LeasedOutput) error {
+
+	var k bytes.Buffer
+	if err := writeOutPoint(&k, &lease.OutPoint); err != nil {
+		return err
+	}
+
+	var v bytes.Buffer
+	if _, err := v.Write(lease.ID[:]); err != nil {
+		return err
+	}
+	err := binary.Write(&v, binary.BigEndian, lease.Expiration.Unix())
+	if err != nil {
+		return err
+	}
+
+	return leaseBucket.Put(k.Bytes(), v.Bytes())
+}
+
+// fetchLease parses a lease from the given key and value of the lease bucket.
+func fetchLease(k, v []byte) (*lnwallet.LeasedOutput, error) {
+	var lease lnwallet.LeasedOutput
+	if err := readOutPoint(bytes.NewReader(k), &lease.OutPoint); err != nil {
+		return nil, err
+	}
+
+	r := bytes.NewReader(v)
+	if _, err := io.ReadFull(r, lease.ID[:]); err != nil {
+		return nil, err
+	}
+	var expiration int64
+	if err := binary.Read(r, binary.BigEndian, &expiration); err != nil {
+		return nil, err
+	}
+	lease.Expiration = time.Unix(expiration, 0)
+
+	return &lease, nil
+}
+
+// writeOutPoint serializes the outpoint as its hash followed by its index.
+func writeOutPoint(w io.Writer, op *wire.OutPoint) error {
+	if _, err := w.Write(op.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, op.Index)
+}
+
+// readOutPoint deserializes an outpoint written by writeOutPoint.
+func readOutPoint(r io.Reader, op *wire.OutPoint) error {
+	if _, err := io.ReadFull(r, op.Hash[:]); err != nil {
+		return err
+	}
+
+	return binary.Read(r, binary.BigEndian, &op.Index)
+}
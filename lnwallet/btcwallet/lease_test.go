@@ -0,0 +1,335 @@
+package btcwallet
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/acmd/txscript"
+	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/acmwallet/chain"
+	"github.com/Actinium-project/acmwallet/waddrmgr"
+	"github.com/Actinium-project/acmwallet/walletdb"
+	"github.com/Actinium-project/acmwallet/wtxmgr"
+	"github.com/Actinium-project/lnd/clock"
+	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/lnwallet"
+)
+
+var (
+	testLeaseID = lnwallet.LeaseID{1}
+
+	testStartTime = time.Unix(1000000, 0)
+
+	testPrivPass = []byte("test-private-pass")
+)
+
+// mockChain is a chain backend that only knows about the best block. It
+// panics if any other method is called.
+type mockChain struct {
+	chain.Interface
+}
+
+// GetBestBlock returns a fixed best block.
+func (m *mockChain) GetBestBlock() (*chainhash.Hash, int32, error) {
+	return &chainhash.Hash{}, 100, nil
+}
+
+// newTestWallet opens the wallet within the given directory, creating it if
+// it doesn't exist yet.
+func newTestWallet(t *testing.T, dir string) *BtcWallet {
+	t.Helper()
+
+	w, err := New(Config{
+		DataDir:     dir,
+		PrivatePass: testPrivPass,
+		HdSeed:      make([]byte, 32),
+		Birthday:    testStartTime,
+		ChainSource: &mockChain{},
+		NetParams:   &chaincfg.RegressionNetParams,
+		CoinType:    keychain.CoinTypeTestnet,
+	})
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	w.clock = clock.NewTestClock(testStartTime)
+
+	if err := w.loadLeases(); err != nil {
+		t.Fatalf("unable to load leases: %v", err)
+	}
+
+	return w
+}
+
+// closeTestWallet closes the database of the wallet, so it can be reopened.
+func closeTestWallet(t *testing.T, w *BtcWallet) {
+	t.Helper()
+
+	if err := w.db.Close(); err != nil {
+		t.Fatalf("unable to close wallet db: %v", err)
+	}
+}
+
+// addTestOutput adds an unconfirmed transaction paying to an address of the
+// wallet, and returns its output.
+func addTestOutput(t *testing.T, w *BtcWallet) wire.OutPoint {
+	t.Helper()
+
+	// We derive the address from the address manager directly, as the
+	// wallet would otherwise ask the chain backend to watch it.
+	scopedMgr, err := w.wallet.Manager.FetchScopedKeyManager(
+		waddrmgr.KeyScopeBIP0084,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{})
+
+	err = walletdb.Update(w.db, func(dbTx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbTx.ReadWriteBucket(waddrmgrNamespaceKey)
+		addrs, err := scopedMgr.NextExternalAddresses(
+			addrmgrNs, waddrmgr.DefaultAccountNum, 1,
+		)
+		if err != nil {
+			return err
+		}
+		pkScript, err := txscript.PayToAddrScript(addrs[0].Address())
+		if err != nil {
+			return err
+		}
+		tx.AddTxOut(wire.NewTxOut(100000, pkScript))
+
+		rec, err := wtxmgr.NewTxRecordFromMsgTx(tx, testStartTime)
+		if err != nil {
+			return err
+		}
+
+		ns := dbTx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if err := w.wallet.TxStore.InsertTx(ns, rec, nil); err != nil {
+			return err
+		}
+
+		return w.wallet.TxStore.AddCredit(ns, rec, nil, 0, false)
+	})
+	if err != nil {
+		t.Fatalf("unable to add transaction: %v", err)
+	}
+
+	return wire.OutPoint{Hash: tx.TxHash()}
+}
+
+// assertLeases asserts that exactly the given outputs are leased and locked
+// within the wallet.
+func assertLeases(t *testing.T, w *BtcWallet, ops ...wire.OutPoint) {
+	t.Helper()
+
+	leases, err := w.ListLeasedOutputs()
+	if err != nil {
+		t.Fatalf("unable to list leases: %v", err)
+	}
+	if len(leases) != len(ops) {
+		t.Fatalf("expected %v leases, got %v", len(ops), len(leases))
+	}
+
+	for _, op := range ops {
+		if _, ok := w.leases[op]; !ok {
+			t.Fatalf("output %v not leased", op)
+		}
+		if !w.wallet.LockedOutpoint(op) {
+			t.Fatalf("leased output %v not locked", op)
+		}
+	}
+}
+
+// TestLeaseOutput tests that outputs can only be leased and released under
+// the lease ID they were leased with, and that only outputs of the wallet can
+// be leased.
+func TestLeaseOutput(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "btcwallet-lease")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := newTestWallet(t, dir)
+	defer closeTestWallet(t, w)
+
+	op := addTestOutput(t, w)
+
+	expiration, err := w.LeaseOutput(testLeaseID, op, time.Hour)
+	if err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+	if !expiration.Equal(testStartTime.Add(time.Hour)) {
+		t.Fatalf("unexpected expiration %v", expiration)
+	}
+	assertLeases(t, w, op)
+
+	// The output can't be leased under another ID, but the lease can be
+	// extended under its own ID.
+	otherID := lnwallet.LeaseID{2}
+	_, err = w.LeaseOutput(otherID, op, time.Hour)
+	if err != lnwallet.ErrOutputAlreadyLeased {
+		t.Fatalf("expected ErrOutputAlreadyLeased, got %v", err)
+	}
+	expiration, err = w.LeaseOutput(testLeaseID, op, 2*time.Hour)
+	if err != nil {
+		t.Fatalf("unable to extend lease: %v", err)
+	}
+	if !expiration.Equal(testStartTime.Add(2 * time.Hour)) {
+		t.Fatalf("unexpected expiration %v", expiration)
+	}
+
+	// Only the owner of the lease can release it.
+	err = w.ReleaseOutput(otherID, op)
+	if err != lnwallet.ErrLeaseIDMismatch {
+		t.Fatalf("expected ErrLeaseIDMismatch, got %v", err)
+	}
+	if err := w.ReleaseOutput(testLeaseID, op); err != nil {
+		t.Fatalf("unable to release output: %v", err)
+	}
+	assertLeases(t, w)
+	if w.wallet.LockedOutpoint(op) {
+		t.Fatalf("released output still locked")
+	}
+
+	err = w.ReleaseOutput(testLeaseID, op)
+	if err != lnwallet.ErrOutputUnlocked {
+		t.Fatalf("expected ErrOutputUnlocked, got %v", err)
+	}
+
+	// Once released, the output can be leased by another ID.
+	if _, err := w.LeaseOutput(otherID, op, time.Hour); err != nil {
+		t.Fatalf("unable to lease released output: %v", err)
+	}
+	assertLeases(t, w, op)
+
+	// Outputs that don't belong to the wallet can't be leased.
+	foreignOp := wire.OutPoint{Hash: chainhash.Hash{1}}
+	_, err = w.LeaseOutput(testLeaseID, foreignOp, time.Hour)
+	if err == nil {
+		t.Fatalf("expected lease of foreign output to fail")
+	}
+}
+
+// TestLeaseExpiry tests that leases are released once they expire, after
+// which the output can be leased by another ID.
+func TestLeaseExpiry(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "btcwallet-lease")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := newTestWallet(t, dir)
+	defer closeTestWallet(t, w)
+
+	op := addTestOutput(t, w)
+	if _, err := w.LeaseOutput(testLeaseID, op, time.Minute); err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+
+	testClock := w.clock.(*clock.TestClock)
+	testClock.SetTime(testStartTime.Add(time.Minute - time.Second))
+	assertLeases(t, w, op)
+
+	testClock.SetTime(testStartTime.Add(time.Minute))
+	assertLeases(t, w)
+	if w.wallet.LockedOutpoint(op) {
+		t.Fatalf("expired output still locked")
+	}
+
+	otherID := lnwallet.LeaseID{2}
+	if _, err := w.LeaseOutput(otherID, op, time.Minute); err != nil {
+		t.Fatalf("unable to lease expired output: %v", err)
+	}
+	assertLeases(t, w, op)
+}
+
+// TestLeaseRestart tests that leases are persisted across restarts, and that
+// leases which expired while the wallet was offline are removed on startup.
+func TestLeaseRestart(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "btcwallet-lease")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := newTestWallet(t, dir)
+	shortOp := addTestOutput(t, w)
+	longOp := addTestOutput(t, w)
+	releasedOp := addTestOutput(t, w)
+
+	leases := []struct {
+		op       wire.OutPoint
+		duration time.Duration
+	}{
+		{shortOp, time.Minute},
+		{longOp, time.Hour},
+		{releasedOp, time.Hour},
+	}
+	for _, lease := range leases {
+		_, err := w.LeaseOutput(testLeaseID, lease.op, lease.duration)
+		if err != nil {
+			t.Fatalf("unable to lease output: %v", err)
+		}
+	}
+	if err := w.ReleaseOutput(testLeaseID, releasedOp); err != nil {
+		t.Fatalf("unable to release output: %v", err)
+	}
+	closeTestWallet(t, w)
+
+	// After a restart, the leases are restored and the outputs locked
+	// again.
+	w = newTestWallet(t, dir)
+	assertLeases(t, w, shortOp, longOp)
+	if w.leases[longOp].ID != testLeaseID {
+		t.Fatalf("unexpected lease id %v", w.leases[longOp].ID)
+	}
+	if !w.leases[longOp].Expiration.Equal(testStartTime.Add(time.Hour)) {
+		t.Fatalf("unexpected expiration %v",
+			w.leases[longOp].Expiration)
+	}
+	closeTestWallet(t, w)
+
+	// A lease that expired while the wallet was offline is removed when
+	// the leases are loaded.
+	w, err = New(Config{
+		DataDir:     dir,
+		PrivatePass: testPrivPass,
+		ChainSource: &mockChain{},
+		NetParams:   &chaincfg.RegressionNetParams,
+		CoinType:    keychain.CoinTypeTestnet,
+	})
+	if err != nil {
+		t.Fatalf("unable to open wallet: %v", err)
+	}
+
+	w.clock = clock.NewTestClock(testStartTime.Add(30 * time.Minute))
+	if err := w.loadLeases(); err != nil {
+		t.Fatalf("unable to load leases: %v", err)
+	}
+	assertLeases(t, w, longOp)
+	if w.wallet.LockedOutpoint(shortOp) {
+		t.Fatalf("expired output still locked")
+	}
+
+	// The expired lease is also gone from the database.
+	closeTestWallet(t, w)
+	w = newTestWallet(t, dir)
+	defer closeTestWallet(t, w)
+
+	w.clock = clock.NewTestClock(testStartTime.Add(30 * time.Minute))
+	assertLeases(t, w, longOp)
+}
@@ -0,0 +1,194 @@
+package btcwallet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/Actinium-project/acmwallet/waddrmgr"
+	"github.com/Actinium-project/acmwallet/walletdb"
+	"github.com/Actinium-project/lnd/input"
+)
+
+const (
+	// addressGapLimit is the maximum number of addresses past the last
+	// used address of a branch that DeriveAddress derives. It limits the
+	// number of addresses a caller can make the wallet derive and watch.
+	addressGapLimit = 1000
+)
+
+// ErrAddressGapLimit is returned by DeriveAddress if the requested address lies
+// beyond the gap limit of the last used address of its branch.
+var ErrAddressGapLimit = errors.New("address index exceeds gap limit")
+
+// inputSigner returns the signer used to sign the inputs of the transactions
+// created by the wallet. For a watch-only wallet, this is the remote signer
+// that holds its private keys.
+func (b *BtcWallet) inputSigner() input.Signer {
+	if b.cfg.RemoteSigner != nil {
+		return b.cfg.RemoteSigner
+	}
+
+	return b
+}
+
+// DeriveAddress makes sure the address of the key at the given derivation
+// path within the key scope is known to the wallet, by extending the addresses
+// of its branch up to the key if needed. This allows the wallet to sign for
+// outputs of addresses that were handed out by a watch-only copy of it. The
+// branch is only extended up to addressGapLimit addresses past its last used
+// address.
+func (b *BtcWallet) DeriveAddress(scope waddrmgr.KeyScope,
+	path waddrmgr.DerivationPath) error {
+
+	scopedMgr, err := b.wallet.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return err
+	}
+
+	return walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		props, err := scopedMgr.AccountProperties(
+			addrmgrNs, path.Account,
+		)
+		if err != nil {
+			return err
+		}
+
+		var (
+			keyCount uint32
+			extend   func(walletdb.ReadWriteBucket, uint32,
+				uint32) error
+		)
+		switch path.Branch {
+		case waddrmgr.ExternalBranch:
+			keyCount = props.ExternalKeyCount
+			extend = scopedMgr.ExtendExternalAddresses
+
+		case waddrmgr.InternalBranch:
+			keyCount = props.InternalKeyCount
+			extend = scopedMgr.ExtendInternalAddresses
+
+		default:
+			return fmt.Errorf("unknown branch %d", path.Branch)
+		}
+
+		// Addresses that have been derived already are known to the
+		// wallet.
+		if path.Index < keyCount {
+			return nil
+		}
+
+		usedCount, err := usedAddressCount(scopedMgr, addrmgrNs, path)
+		if err != nil {
+			return err
+		}
+		if path.Index >= usedCount+addressGapLimit {
+			return ErrAddressGapLimit
+		}
+
+		return extend(addrmgrNs, path.Account, path.Index)
+	})
+}
+
+// usedAddressCount returns the index following the last used address of the
+// branch of the given derivation path, or zero if none of its addresses has
+// been used.
+func usedAddressCount(scopedMgr *waddrmgr.ScopedKeyManager,
+	addrmgrNs walletdb.ReadBucket, path waddrmgr.DerivationPath) (uint32,
+	error) {
+
+	var usedCount uint32
+	err := scopedMgr.ForEachAccountAddress(
+		addrmgrNs, path.Account,
+		func(maddr waddrmgr.ManagedAddress) error {
+			pubKeyAddr, ok := maddr.(waddrmgr.ManagedPubKeyAddress)
+			if !ok {
+				return nil
+			}
+
+			_, addrPath, ok := pubKeyAddr.DerivationInfo()
+			if !ok || addrPath.Branch != path.Branch {
+				return nil
+			}
+
+			if addrPath.Index >= usedCount &&
+				maddr.Used(addrmgrNs) {
+
+				usedCount = addrPath.Index + 1
+			}
+
+			return nil
+		},
+	)
+
+	return usedCount, err
+}
+
+// ExportWatchOnly writes a watch-only copy of the wallet database to the
+// writer. The copy holds the extended public keys of all accounts of the
+// wallet, but none of its private keys, and can be opened with the public
+// passphrase of the wallet.
+//
+// NOTE: A watch-only wallet can't create new accounts, so the caller should
+// make sure that all accounts that will be needed exist before exporting.
+func (b *BtcWallet) ExportWatchOnly(w io.Writer) error {
+	if b.wallet.Manager.WatchOnly() {
+		return fmt.Errorf("wallet is already watch-only")
+	}
+
+	// We'll first write a copy of the wallet database to a temporary file,
+	// as the private keys have to be removed from the copy in place.
+	tempFile, err := ioutil.TempFile("", "watchonly-wallet")
+	if err != nil {
+		return err
+	}
+	tempPath := tempFile.Name()
+	defer os.Remove(tempPath)
+
+	err = b.db.Copy(tempFile)
+	tempFile.Close()
+	if err != nil {
+		return err
+	}
+
+	db, err := walletdb.Open("bdb", tempPath, b.cfg.NoFreelistSync)
+	if err != nil {
+		return err
+	}
+
+	pubPass := b.cfg.PublicPass
+	if pubPass == nil {
+		pubPass = defaultPubPassphrase
+	}
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		mgr, err := waddrmgr.Open(addrmgrNs, pubPass, b.netParams)
+		if err != nil {
+			return err
+		}
+		defer mgr.Close()
+
+		return mgr.ConvertToWatchingOnly(addrmgrNs)
+	})
+	if closeErr := db.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	// With the private keys removed, we can hand out the copy.
+	watchOnlyFile, err := os.Open(tempPath)
+	if err != nil {
+		return err
+	}
+	defer watchOnlyFile.Close()
+
+	_, err = io.Copy(w, watchOnlyFile)
+	return err
+}
@@ -0,0 +1,90 @@
+package btcwallet
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/Actinium-project/acmwallet/waddrmgr"
+	"github.com/Actinium-project/acmwallet/walletdb"
+)
+
+// TestDeriveAddressGapLimit tests that DeriveAddress only derives addresses up
+// to the gap limit past the last used address of a branch.
+func TestDeriveAddressGapLimit(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "btcwallet-watchonly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := newTestWallet(t, dir)
+	defer closeTestWallet(t, w)
+
+	scope := waddrmgr.KeyScopeBIP0084
+	scopedMgr, err := w.wallet.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	externalPath := func(index uint32) waddrmgr.DerivationPath {
+		return waddrmgr.DerivationPath{
+			Account: waddrmgr.DefaultAccountNum,
+			Branch:  waddrmgr.ExternalBranch,
+			Index:   index,
+		}
+	}
+
+	// Without any used address, only the first addressGapLimit addresses
+	// can be derived.
+	err = w.DeriveAddress(scope, externalPath(addressGapLimit))
+	if err != ErrAddressGapLimit {
+		t.Fatalf("expected ErrAddressGapLimit, got %v", err)
+	}
+	if err := w.DeriveAddress(scope, externalPath(5)); err != nil {
+		t.Fatalf("unable to derive address: %v", err)
+	}
+
+	// Addresses that are derived already are always accepted.
+	if err := w.DeriveAddress(scope, externalPath(3)); err != nil {
+		t.Fatalf("unable to derive address: %v", err)
+	}
+
+	// Once an address is used, the gap limit applies from there on.
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		addr, err := scopedMgr.DeriveFromKeyPath(
+			addrmgrNs, externalPath(5),
+		)
+		if err != nil {
+			return err
+		}
+
+		return scopedMgr.MarkUsed(addrmgrNs, addr.Address())
+	})
+	if err != nil {
+		t.Fatalf("unable to mark address used: %v", err)
+	}
+
+	err = w.DeriveAddress(scope, externalPath(6+addressGapLimit))
+	if err != ErrAddressGapLimit {
+		t.Fatalf("expected ErrAddressGapLimit, got %v", err)
+	}
+	err = w.DeriveAddress(scope, externalPath(5+addressGapLimit))
+	if err != nil {
+		t.Fatalf("unable to derive address: %v", err)
+	}
+
+	// The internal branch has its own gap.
+	err = w.DeriveAddress(scope, waddrmgr.DerivationPath{
+		Account: waddrmgr.DefaultAccountNum,
+		Branch:  waddrmgr.InternalBranch,
+		Index:   addressGapLimit,
+	})
+	if err != ErrAddressGapLimit {
+		t.Fatalf("expected ErrAddressGapLimit, got %v", err)
+	}
+}
@@ -64,6 +64,11 @@ type Request struct {
 	// set to zero, then zero conf outputs may be spent.
 	MinConfs int32
 
+	// Outpoints optionally restricts the coins that are eligible to be
+	// used as inputs to the funding transaction to the given set. Each of
+	// them must be an available coin satisfying MinConfs.
+	Outpoints []wire.OutPoint
+
 	// SubtractFees should be set if we intend to spend exactly LocalAmt
 	// when opening the channel, subtracting the fees from the funding
 	// output. This can be used for instance to use all our remaining funds
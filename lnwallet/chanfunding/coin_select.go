@@ -35,6 +35,43 @@ type Coin struct {
 	wire.OutPoint
 }
 
+// ErrCoinUnavailable is returned when one of the coins a caller asked to fund
+// a channel with isn't available for coin selection.
+type ErrCoinUnavailable struct {
+	outPoint wire.OutPoint
+}
+
+// Error returns a human readable string describing the error.
+func (e *ErrCoinUnavailable) Error() string {
+	return fmt.Sprintf("outpoint %v is not an unspent, unleased output "+
+		"of the wallet with enough confirmations", e.outPoint)
+}
+
+// FilterCoins returns the coins matching the given outpoints, in the order of
+// the outpoints. An ErrCoinUnavailable error is returned if any of the
+// outpoints isn't part of the passed coins.
+func FilterCoins(coins []Coin, outPoints []wire.OutPoint) ([]Coin, error) {
+	available := make(map[wire.OutPoint]Coin, len(coins))
+	for _, coin := range coins {
+		available[coin.OutPoint] = coin
+	}
+
+	filtered := make([]Coin, 0, len(outPoints))
+	for _, op := range outPoints {
+		coin, ok := available[op]
+		if !ok {
+			return nil, &ErrCoinUnavailable{op}
+		}
+
+		// Remove the coin from the available set, so it can't be
+		// selected twice.
+		delete(available, op)
+		filtered = append(filtered, coin)
+	}
+
+	return filtered, nil
+}
+
 // selectInputs selects a slice of inputs necessary to meet the specified
 // selection amount. If input selection is unable to succeed due to insufficient
 // funds, a non-nil error is returned. Additionally, the total amount of the
@@ -2,6 +2,7 @@ package chanfunding
 
 import (
 	"encoding/hex"
+	"reflect"
 	"testing"
 
 	"github.com/Actinium-project/acmd/wire"
@@ -381,3 +382,72 @@ func TestCoinSelectSubtractFees(t *testing.T) {
 		})
 	}
 }
+
+// TestFilterCoins tests that coins can be restricted to a set of outpoints,
+// and that asking for an unavailable coin fails.
+func TestFilterCoins(t *testing.T) {
+	t.Parallel()
+
+	coins := make([]Coin, 3)
+	for i := range coins {
+		coins[i] = Coin{
+			TxOut: wire.TxOut{
+				PkScript: p2wkhScript,
+				Value:    int64(i+1) * acmutil.SatoshiPerBitcoin,
+			},
+			OutPoint: wire.OutPoint{Index: uint32(i)},
+		}
+	}
+
+	type testCase struct {
+		name          string
+		outPoints     []wire.OutPoint
+		expectedCoins []Coin
+		expectErr     bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "subset in requested order",
+			outPoints: []wire.OutPoint{
+				coins[2].OutPoint, coins[0].OutPoint,
+			},
+			expectedCoins: []Coin{coins[2], coins[0]},
+		},
+		{
+			name:      "unknown outpoint",
+			outPoints: []wire.OutPoint{{Index: 5}},
+			expectErr: true,
+		},
+		{
+			name: "duplicate outpoint",
+			outPoints: []wire.OutPoint{
+				coins[1].OutPoint, coins[1].OutPoint,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			filtered, err := FilterCoins(coins, test.outPoints)
+			if test.expectErr {
+				if _, ok := err.(*ErrCoinUnavailable); !ok {
+					t.Fatalf("expected ErrCoinUnavailable, "+
+						"got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unable to filter coins: %v", err)
+			}
+
+			if !reflect.DeepEqual(filtered, test.expectedCoins) {
+				t.Fatalf("expected coins %v, got %v",
+					test.expectedCoins, filtered)
+			}
+		})
+	}
+}
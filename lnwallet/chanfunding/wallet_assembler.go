@@ -235,6 +235,15 @@ func (w *WalletAssembler) ProvisionChannel(r *Request) (Intent, error) {
 			return err
 		}
 
+		// If the caller asked for specific coins to be used, we'll
+		// restrict coin selection to them.
+		if len(r.Outpoints) != 0 {
+			coins, err = FilterCoins(coins, r.Outpoints)
+			if err != nil {
+				return err
+			}
+		}
+
 		var (
 			selectedCoins        []Coin
 			localContributionAmt acmutil.Amount
@@ -56,4 +56,10 @@ type Config struct {
 	// NetParams is the set of parameters that tells the wallet which chain
 	// it will be operating on.
 	NetParams chaincfg.Params
+
+	// RemoteSigner indicates that the SecretKeyRing forwards all operations
+	// with private keys to a remote signer. The shachain root of new
+	// channels is then derived through ECDH, as the revocation root
+	// private key never leaves the remote signer.
+	RemoteSigner bool
 }
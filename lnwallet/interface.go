@@ -4,12 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/Actinium-project/acmd/btcec"
 	"github.com/Actinium-project/acmd/chaincfg/chainhash"
 	"github.com/Actinium-project/acmd/wire"
 	"github.com/Actinium-project/acmutil"
 	"github.com/Actinium-project/acmwallet/wallet/txauthor"
+	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/lnwallet/chainfee"
 )
 
@@ -47,6 +49,27 @@ var (
 	// ErrNotMine is an error denoting that a WalletController instance is
 	// unable to spend a specified output.
 	ErrNotMine = errors.New("the passed output doesn't belong to the wallet")
+
+	// ErrOutputAlreadyLeased is returned when attempting to lease an
+	// output that's already leased under a different lease ID, or locked
+	// by an internal coin selection.
+	ErrOutputAlreadyLeased = errors.New("output already leased")
+
+	// ErrOutputUnlocked is returned when attempting to release an output
+	// that isn't leased.
+	ErrOutputUnlocked = errors.New("output is not leased")
+
+	// ErrTxLabelExists is returned when attempting to label a transaction
+	// that's already labelled without requesting to overwrite the label.
+	ErrTxLabelExists = errors.New("transaction already labelled")
+
+	// ErrUnknownTx is returned when attempting to label a transaction that
+	// isn't known to the wallet.
+	ErrUnknownTx = errors.New("transaction not known to the wallet")
+
+	// ErrLeaseIDMismatch is returned when attempting to release an output
+	// with a lease ID different from the one it was leased with.
+	ErrLeaseIDMismatch = errors.New("output leased with a different ID")
 )
 
 // ErrNoOutputs is returned if we try to create a transaction with no outputs
@@ -60,9 +83,79 @@ type Utxo struct {
 	Value         acmutil.Amount
 	Confirmations int64
 	PkScript      []byte
+	Account       string
 	wire.OutPoint
 }
 
+// AccountInfo describes an account of the wallet that addresses are derived
+// from.
+type AccountInfo struct {
+	// Name is the name of the account.
+	Name string
+
+	// Number is the number of the account within its key scope.
+	Number uint32
+
+	// AddressType is the type of the addresses derived from the account.
+	AddressType AddressType
+
+	// ExternalKeyCount is the number of external addresses that have been
+	// derived from the account.
+	ExternalKeyCount uint32
+
+	// InternalKeyCount is the number of change addresses that have been
+	// derived from the account.
+	InternalKeyCount uint32
+
+	// ImportedKeyCount is the number of keys that have been imported into
+	// the account.
+	ImportedKeyCount uint32
+}
+
+// RecoveryInfo describes the progress of the recovery of the wallet from its
+// seed.
+type RecoveryInfo struct {
+	// RecoveryMode is true if the wallet was started in recovery mode.
+	RecoveryMode bool
+
+	// RecoveryFinished is true if the rescan of the wallet has caught up
+	// with the best block of the chain.
+	RecoveryFinished bool
+
+	// Progress is the share of the blocks since the birthday of the
+	// wallet that have been rescanned, between 0 and 1.
+	Progress float64
+
+	// RecoveryWindow is the address look-ahead used during the recovery.
+	RecoveryWindow uint32
+
+	// BirthdayHeight is the height of the first block the wallet could
+	// have funds in.
+	BirthdayHeight int32
+
+	// KeyCounts is the number of keys that have been derived for each key
+	// family.
+	KeyCounts map[keychain.KeyFamily]uint32
+}
+
+// LeaseID is an application defined identifier of an output lease. Only the
+// holder of the ID is able to release the lease before it expires.
+type LeaseID [32]byte
+
+// LeasedOutput is an output of the wallet that's been leased, and is
+// therefore not eligible for coin selection until it's released or its lease
+// expires.
+type LeasedOutput struct {
+	// ID is the ID the output was leased with.
+	ID LeaseID
+
+	// OutPoint is the leased output.
+	OutPoint wire.OutPoint
+
+	// Expiration is the time at which the lease expires.
+	Expiration time.Time
+}
+
 // TransactionDetail describes a transaction with either inputs which belong to
 // the wallet, or has outputs that pay to the wallet.
 type TransactionDetail struct {
@@ -102,6 +195,9 @@ type TransactionDetail struct {
 
 	// RawTx returns the raw serialized transaction.
 	RawTx []byte
+
+	// Label is the label attached to the transaction, if any.
+	Label string
 }
 
 // TransactionSubscription is an interface which describes an object capable of
@@ -171,9 +267,18 @@ type WalletController interface {
 	// out to the specified outputs. In the case the wallet has insufficient
 	// funds, or the outputs are non-standard, an error should be returned.
 	// This method also takes the target fee expressed in sat/kw that should
-	// be used when crafting the transaction.
-	SendOutputs(outputs []*wire.TxOut,
-		feeRate chainfee.SatPerKWeight) (*wire.MsgTx, error)
+	// be used when crafting the transaction, the minimum number of
+	// confirmations each spent output must have, and an optional label
+	// that is attached to the transaction.
+	//
+	// If a set of inputs is given, exactly those outputs of the wallet are
+	// spent, with any remaining value being sent to a change output.
+	// Otherwise, the inputs are picked by the wallet's coin selection. An
+	// error is returned if any of the given inputs is leased, or isn't an
+	// unspent output of the wallet with at least minConfs confirmations.
+	SendOutputs(inputs []wire.OutPoint, outputs []*wire.TxOut,
+		feeRate chainfee.SatPerKWeight, minConfs int32,
+		label string) (*wire.MsgTx, error)
 
 	// CreateSimpleTx creates a Bitcoin transaction paying to the specified
 	// outputs. The transaction is not broadcasted to the network. In the
@@ -197,6 +302,15 @@ type WalletController interface {
 	// outputs with at least 'minconfirms'.
 	ListUnspentWitness(minconfirms, maxconfirms int32) ([]*Utxo, error)
 
+	// ListAccounts returns all accounts of the wallet that addresses are
+	// derived from. If name is not empty, only the accounts with that name
+	// are returned.
+	ListAccounts(name string) ([]*AccountInfo, error)
+
+	// GetRecoveryInfo returns the progress of the recovery of the wallet
+	// from its seed, if the wallet was started in recovery mode.
+	GetRecoveryInfo() (*RecoveryInfo, error)
+
 	// ListTransactionDetails returns a list of all transactions which are
 	// relevant to the wallet.
 	ListTransactionDetails() ([]*TransactionDetail, error)
@@ -211,14 +325,39 @@ type WalletController interface {
 	// eligible for coin selection.
 	UnlockOutpoint(o wire.OutPoint)
 
+	// LeaseOutput locks an output of the wallet under the given lease ID
+	// for the given duration, preventing it from being used by any coin
+	// selection, and returns the expiration of the lease. Unlike
+	// LockOutpoint, leases persist across restarts. Leasing an output
+	// that's already leased under the same ID extends the lease, while
+	// ErrOutputAlreadyLeased is returned if the output is leased under a
+	// different ID.
+	LeaseOutput(id LeaseID, op wire.OutPoint,
+		duration time.Duration) (time.Time, error)
+
+	// ReleaseOutput releases the lease of an output, rendering it eligible
+	// for coin selection again. The ID must match the one the output was
+	// leased with.
+	ReleaseOutput(id LeaseID, op wire.OutPoint) error
+
+	// ListLeasedOutputs returns all outputs that are currently leased.
+	ListLeasedOutputs() ([]*LeasedOutput, error)
+
 	// PublishTransaction performs cursory validation (dust checks, etc),
 	// then finally broadcasts the passed transaction to the Bitcoin network.
 	// If the transaction is rejected because it is conflicting with an
 	// already known transaction, ErrDoubleSpend is returned. If the
 	// transaction is already known (published already), no error will be
 	// returned. Other error returned depends on the currently active chain
-	// backend.
-	PublishTransaction(tx *wire.MsgTx) error
+	// backend. If a non-empty label is passed, it's attached to the
+	// transaction, unless the transaction is already labelled.
+	PublishTransaction(tx *wire.MsgTx, label string) error
+
+	// LabelTransaction attaches a label to a transaction of the wallet. If
+	// the transaction is already labelled, ErrTxLabelExists is returned
+	// unless overwrite is true.
+	LabelTransaction(hash chainhash.Hash, label string,
+		overwrite bool) error
 
 	// SubscribeTransactions returns a TransactionSubscription client which
 	// is capable of receiving async notifications as new transactions
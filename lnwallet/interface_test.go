@@ -172,7 +172,7 @@ func sendCoins(t *testing.T, miner *rpctest.Harness,
 
 	t.Helper()
 
-	tx, err := sender.SendOutputs([]*wire.TxOut{output}, 2500)
+	tx, err := sender.SendOutputs(nil, []*wire.TxOut{output}, 2500, 1, "")
 	if err != nil {
 		t.Fatalf("unable to send transaction: %v", err)
 	}
@@ -528,7 +528,7 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 	}
 
 	// Let Alice publish the funding transaction.
-	if err := alice.PublishTransaction(fundingTx); err != nil {
+	if err := alice.PublishTransaction(fundingTx, ""); err != nil {
 		t.Fatalf("unable to publish funding tx: %v", err)
 	}
 
@@ -1016,7 +1016,7 @@ func testSingleFunderReservationWorkflow(miner *rpctest.Harness,
 	}
 
 	// Let Alice publish the funding transaction.
-	if err := alice.PublishTransaction(fundingTx); err != nil {
+	if err := alice.PublishTransaction(fundingTx, ""); err != nil {
 		t.Fatalf("unable to publish funding tx: %v", err)
 	}
 
@@ -1175,7 +1175,9 @@ func testListTransactionDetails(miner *rpctest.Harness,
 		t.Fatalf("unable to make output script: %v", err)
 	}
 	burnOutput := wire.NewTxOut(outputAmt, outputScript)
-	burnTX, err := alice.SendOutputs([]*wire.TxOut{burnOutput}, 2500)
+	burnTX, err := alice.SendOutputs(
+		nil, []*wire.TxOut{burnOutput}, 2500, 1, "",
+	)
 	if err != nil {
 		t.Fatalf("unable to create burn tx: %v", err)
 	}
@@ -1412,7 +1414,9 @@ func testTransactionSubscriptions(miner *rpctest.Harness,
 		t.Fatalf("unable to make output script: %v", err)
 	}
 	burnOutput := wire.NewTxOut(outputAmt, outputScript)
-	tx, err := alice.SendOutputs([]*wire.TxOut{burnOutput}, 2500)
+	tx, err := alice.SendOutputs(
+		nil, []*wire.TxOut{burnOutput}, 2500, 1, "",
+	)
 	if err != nil {
 		t.Fatalf("unable to create burn tx: %v", err)
 	}
@@ -1603,7 +1607,7 @@ func newTx(t *testing.T, r *rpctest.Harness, pubKey *btcec.PublicKey,
 		Value:    acmutil.SatoshiPerBitcoin,
 		PkScript: keyScript,
 	}
-	tx, err := alice.SendOutputs([]*wire.TxOut{newOutput}, 2500)
+	tx, err := alice.SendOutputs(nil, []*wire.TxOut{newOutput}, 2500, 1, "")
 	if err != nil {
 		t.Fatalf("unable to create output: %v", err)
 	}
@@ -1643,7 +1647,7 @@ func testPublishTransaction(r *rpctest.Harness,
 	tx1 := newTx(t, r, keyDesc.PubKey, alice, false)
 
 	// Publish the transaction.
-	if err := alice.PublishTransaction(tx1); err != nil {
+	if err := alice.PublishTransaction(tx1, ""); err != nil {
 		t.Fatalf("unable to publish: %v", err)
 	}
 
@@ -1655,7 +1659,7 @@ func testPublishTransaction(r *rpctest.Harness,
 
 	// Publish the exact same transaction again. This should not return an
 	// error, even though the transaction is already in the mempool.
-	if err := alice.PublishTransaction(tx1); err != nil {
+	if err := alice.PublishTransaction(tx1, ""); err != nil {
 		t.Fatalf("unable to publish: %v", err)
 	}
 
@@ -1674,7 +1678,7 @@ func testPublishTransaction(r *rpctest.Harness,
 	tx2 := newTx(t, r, keyDesc.PubKey, alice, false)
 
 	// Publish this tx.
-	if err := alice.PublishTransaction(tx2); err != nil {
+	if err := alice.PublishTransaction(tx2, ""); err != nil {
 		t.Fatalf("unable to publish: %v", err)
 	}
 
@@ -1685,7 +1689,7 @@ func testPublishTransaction(r *rpctest.Harness,
 
 	// Publish the transaction again. It is already mined, and we don't
 	// expect this to return an error.
-	if err := alice.PublishTransaction(tx2); err != nil {
+	if err := alice.PublishTransaction(tx2, ""); err != nil {
 		t.Fatalf("unable to publish: %v", err)
 	}
 
@@ -1701,7 +1705,7 @@ func testPublishTransaction(r *rpctest.Harness,
 		// transaction. Create a new tx and publish it. This is the
 		// output we'll try to double spend.
 		tx3 = newTx(t, r, keyDesc.PubKey, alice, false)
-		if err := alice.PublishTransaction(tx3); err != nil {
+		if err := alice.PublishTransaction(tx3, ""); err != nil {
 			t.Fatalf("unable to publish: %v", err)
 		}
 
@@ -1721,7 +1725,7 @@ func testPublishTransaction(r *rpctest.Harness,
 		}
 
 		// This should be accepted into the mempool.
-		if err := alice.PublishTransaction(tx4); err != nil {
+		if err := alice.PublishTransaction(tx4, ""); err != nil {
 			t.Fatalf("unable to publish: %v", err)
 		}
 
@@ -1755,7 +1759,7 @@ func testPublishTransaction(r *rpctest.Harness,
 			t.Fatal(err)
 		}
 
-		err = alice.PublishTransaction(tx5)
+		err = alice.PublishTransaction(tx5, "")
 		if err != lnwallet.ErrDoubleSpend {
 			t.Fatalf("expected ErrDoubleSpend, got: %v", err)
 		}
@@ -1783,7 +1787,7 @@ func testPublishTransaction(r *rpctest.Harness,
 			expErr = nil
 			tx3Spend = tx6
 		}
-		err = alice.PublishTransaction(tx6)
+		err = alice.PublishTransaction(tx6, "")
 		if err != expErr {
 			t.Fatalf("expected ErrDoubleSpend, got: %v", err)
 		}
@@ -1818,7 +1822,7 @@ func testPublishTransaction(r *rpctest.Harness,
 		}
 
 		// Expect rejection.
-		err = alice.PublishTransaction(tx7)
+		err = alice.PublishTransaction(tx7, "")
 		if err != lnwallet.ErrDoubleSpend {
 			t.Fatalf("expected ErrDoubleSpend, got: %v", err)
 		}
@@ -1886,7 +1890,9 @@ func testSignOutputUsingTweaks(r *rpctest.Harness,
 			Value:    acmutil.SatoshiPerBitcoin,
 			PkScript: keyScript,
 		}
-		tx, err := alice.SendOutputs([]*wire.TxOut{newOutput}, 2500)
+		tx, err := alice.SendOutputs(
+			nil, []*wire.TxOut{newOutput}, 2500, 1, "",
+		)
 		if err != nil {
 			t.Fatalf("unable to create output: %v", err)
 		}
@@ -2008,7 +2014,7 @@ func testReorgWalletBalance(r *rpctest.Harness, w *lnwallet.LightningWallet,
 		Value:    1e8,
 		PkScript: script,
 	}
-	tx, err := w.SendOutputs([]*wire.TxOut{output}, 2500)
+	tx, err := w.SendOutputs(nil, []*wire.TxOut{output}, 2500, 1, "")
 	if err != nil {
 		t.Fatalf("unable to send outputs: %v", err)
 	}
@@ -2389,7 +2395,7 @@ func testCreateSimpleTx(r *rpctest.Harness, w *lnwallet.LightningWallet,
 		// _very_ similar to the one we just created being sent. The
 		// only difference is that the dry run tx is not signed, and
 		// that the change output position might be different.
-		tx, sendErr := w.SendOutputs(outputs, feeRate)
+		tx, sendErr := w.SendOutputs(nil, outputs, feeRate, 1, "")
 		switch {
 		case test.valid && sendErr != nil:
 			t.Fatalf("got unexpected error when sending tx: %v",
@@ -0,0 +1,374 @@
+package rpcwallet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/acmd/txscript"
+	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/acmwallet/waddrmgr"
+	base "github.com/Actinium-project/acmwallet/wallet"
+	"github.com/Actinium-project/lnd/input"
+	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/lncfg"
+	"github.com/Actinium-project/lnd/lnrpc/signrpc"
+	"github.com/Actinium-project/lnd/lnwallet"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/macaroons"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+// ErrRemoteSigningPrivKeyUnavailable is returned when a private key is
+// requested from a key ring whose keys are held by a remote signer.
+var ErrRemoteSigningPrivKeyUnavailable = errors.New("private keys are not " +
+	"available in remote signing mode")
+
+// RPCKeyRing is an implementation of the keychain.SecretKeyRing and
+// input.Signer interfaces for a node running with a watch-only wallet. Public
+// keys are derived locally from the account extended public keys of the
+// watch-only wallet, while all operations that require a private key are
+// forwarded to a remote signer holding the seed, through its signer RPC.
+type RPCKeyRing struct {
+	// watchOnlyKeyRing is the key ring of the watch-only wallet, used to
+	// derive all public keys.
+	watchOnlyKeyRing keychain.KeyRing
+
+	// wallet is the watch-only wallet, used to look up the derivation
+	// paths of the keys controlling its outputs.
+	wallet *base.Wallet
+
+	netParams *chaincfg.Params
+
+	rpcTimeout time.Duration
+
+	signerClient signrpc.SignerClient
+}
+
+// A compile time check to ensure that RPCKeyRing implements the
+// keychain.SecretKeyRing and input.Signer interfaces.
+var _ keychain.SecretKeyRing = (*RPCKeyRing)(nil)
+var _ input.Signer = (*RPCKeyRing)(nil)
+
+// NewRPCKeyRing creates a new RPC based key ring for the given watch-only
+// wallet, connected to the remote signer described by the config.
+func NewRPCKeyRing(w *base.Wallet, coinType uint32, netParams *chaincfg.Params,
+	cfg *lncfg.RemoteSigner) (*RPCKeyRing, error) {
+
+	if !w.Manager.WatchOnly() {
+		return nil, fmt.Errorf("remote signing requires a watch-only " +
+			"wallet")
+	}
+
+	conn, err := connectRPC(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to remote signer: "+
+			"%v", err)
+	}
+
+	return &RPCKeyRing{
+		watchOnlyKeyRing: keychain.NewBtcWalletKeyRing(w, coinType),
+		wallet:           w,
+		netParams:        netParams,
+		rpcTimeout:       cfg.Timeout,
+		signerClient:     signrpc.NewSignerClient(conn),
+	}, nil
+}
+
+// connectRPC establishes an authenticated gRPC connection to the remote
+// signer.
+func connectRPC(cfg *lncfg.RemoteSigner) (*grpc.ClientConn, error) {
+	tlsCreds, err := credentials.NewClientTLSFromFile(cfg.TLSCertPath, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to read TLS cert: %v", err)
+	}
+
+	macBytes, err := ioutil.ReadFile(cfg.MacaroonPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read macaroon: %v", err)
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, fmt.Errorf("unable to decode macaroon: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancel()
+
+	return grpc.DialContext(
+		ctx, cfg.RPCHost, grpc.WithBlock(),
+		grpc.WithTransportCredentials(tlsCreds),
+		grpc.WithPerRPCCredentials(macaroons.NewMacaroonCredential(mac)),
+	)
+}
+
+// DeriveNextKey attempts to derive the *next* key within the key family
+// (account in BIP43) specified. This method should return the next external
+// child within this branch.
+//
+// NOTE: This is part of the keychain.KeyRing interface.
+func (r *RPCKeyRing) DeriveNextKey(
+	keyFam keychain.KeyFamily) (keychain.KeyDescriptor, error) {
+
+	return r.watchOnlyKeyRing.DeriveNextKey(keyFam)
+}
+
+// DeriveKey attempts to derive an arbitrary key specified by the passed
+// KeyLocator.
+//
+// NOTE: This is part of the keychain.KeyRing interface.
+func (r *RPCKeyRing) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	return r.watchOnlyKeyRing.DeriveKey(keyLoc)
+}
+
+// DerivePrivKey always fails, as the private keys never leave the remote
+// signer. All operations that need a private key must go through the signing
+// and ECDH methods of the key ring instead.
+//
+// NOTE: This is part of the keychain.SecretKeyRing interface.
+func (r *RPCKeyRing) DerivePrivKey(
+	keyDesc keychain.KeyDescriptor) (*btcec.PrivateKey, error) {
+
+	return nil, ErrRemoteSigningPrivKeyUnavailable
+}
+
+// ScalarMult performs a scalar multiplication (ECDH-like operation) between
+// the target key descriptor and remote public key on the remote signer.
+//
+// NOTE: This is part of the keychain.SecretKeyRing interface.
+func (r *RPCKeyRing) ScalarMult(keyDesc keychain.KeyDescriptor,
+	pubKey *btcec.PublicKey) ([]byte, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.rpcTimeout)
+	defer cancel()
+
+	resp, err := r.signerClient.DeriveSharedKey(
+		ctx, &signrpc.SharedKeyRequest{
+			EphemeralPubkey: pubKey.SerializeCompressed(),
+			KeyLoc:          marshallKeyLocator(keyDesc.KeyLocator),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer unable to derive "+
+			"shared key: %v", err)
+	}
+
+	return resp.SharedKey, nil
+}
+
+// SignMessage signs the sha256 of the message, or its double sha256 if
+// doubleHash is true, with the key of the key locator on the remote signer.
+//
+// NOTE: This is part of the keychain.MessageSignerRing interface.
+func (r *RPCKeyRing) SignMessage(keyLoc keychain.KeyLocator, msg []byte,
+	doubleHash bool) (*btcec.Signature, error) {
+
+	sigBytes, err := r.signMessage(keyLoc, msg, doubleHash, false)
+	if err != nil {
+		return nil, err
+	}
+
+	wireSig, err := lnwire.NewSigFromRawSignature(sigBytes)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer returned invalid "+
+			"signature: %v", err)
+	}
+
+	return wireSig.ToSignature()
+}
+
+// SignMessageCompact signs the sha256 of the message, or its double sha256 if
+// doubleHash is true, with the key of the key locator on the remote signer.
+// The returned signature is a pubkey-recoverable signature.
+//
+// NOTE: This is part of the keychain.MessageSignerRing interface.
+func (r *RPCKeyRing) SignMessageCompact(keyLoc keychain.KeyLocator,
+	msg []byte, doubleHash bool) ([]byte, error) {
+
+	return r.signMessage(keyLoc, msg, doubleHash, true)
+}
+
+// signMessage signs the message with the key of the key locator on the remote
+// signer, and returns the raw signature bytes.
+func (r *RPCKeyRing) signMessage(keyLoc keychain.KeyLocator, msg []byte,
+	doubleHash, compact bool) ([]byte, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.rpcTimeout)
+	defer cancel()
+
+	resp, err := r.signerClient.SignMessage(ctx, &signrpc.SignMessageReq{
+		Msg:        msg,
+		KeyLoc:     marshallKeyLocator(keyLoc),
+		DoubleHash: doubleHash,
+		CompactSig: compact,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("remote signer unable to sign "+
+			"message: %v", err)
+	}
+
+	return resp.Signature, nil
+}
+
+// SignOutputRaw generates a signature for the passed transaction according to
+// the data within the passed SignDescriptor on the remote signer.
+//
+// NOTE: This is part of the input.Signer interface.
+func (r *RPCKeyRing) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *input.SignDescriptor) ([]byte, error) {
+
+	var txBuf bytes.Buffer
+	if err := tx.Serialize(&txBuf); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.rpcTimeout)
+	defer cancel()
+
+	resp, err := r.signerClient.SignOutputRaw(ctx, &signrpc.SignReq{
+		RawTxBytes: txBuf.Bytes(),
+		SignDescs: []*signrpc.SignDescriptor{
+			marshallSignDescriptor(signDesc),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("remote signer unable to sign input "+
+			"%d: %v", signDesc.InputIndex, err)
+	}
+	if len(resp.RawSigs) != 1 {
+		return nil, fmt.Errorf("remote signer returned %d signatures, "+
+			"expected 1", len(resp.RawSigs))
+	}
+
+	return resp.RawSigs[0], nil
+}
+
+// ComputeInputScript generates a complete InputScript for the passed
+// transaction with the signature as defined within the passed SignDescriptor
+// on the remote signer. The output being spent must belong to the watch-only
+// wallet, as the remote signer is told which key to sign with by the
+// derivation path of the output's address.
+//
+// NOTE: This is part of the input.Signer interface.
+func (r *RPCKeyRing) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *input.SignDescriptor) (*input.Script, error) {
+
+	keyPath, err := r.walletKeyPath(signDesc.Output.PkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	var txBuf bytes.Buffer
+	if err := tx.Serialize(&txBuf); err != nil {
+		return nil, err
+	}
+
+	rpcSignDesc := marshallSignDescriptor(signDesc)
+	rpcSignDesc.WalletKeyPath = keyPath
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.rpcTimeout)
+	defer cancel()
+
+	resp, err := r.signerClient.ComputeInputScript(ctx, &signrpc.SignReq{
+		RawTxBytes: txBuf.Bytes(),
+		SignDescs:  []*signrpc.SignDescriptor{rpcSignDesc},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("remote signer unable to compute input "+
+			"script for input %d: %v", signDesc.InputIndex, err)
+	}
+	if len(resp.InputScripts) != 1 {
+		return nil, fmt.Errorf("remote signer returned %d input "+
+			"scripts, expected 1", len(resp.InputScripts))
+	}
+
+	return &input.Script{
+		Witness:   resp.InputScripts[0].Witness,
+		SigScript: resp.InputScripts[0].SigScript,
+	}, nil
+}
+
+// walletKeyPath returns the BIP32 derivation path of the wallet key that
+// controls the output with the given script, in the format expected by the
+// signer RPC.
+func (r *RPCKeyRing) walletKeyPath(pkScript []byte) ([]uint32, error) {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript, r.netParams)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, addr := range addrs {
+		walletAddr, err := r.wallet.AddressInfo(addr)
+		if err != nil {
+			continue
+		}
+
+		pka, ok := walletAddr.(waddrmgr.ManagedPubKeyAddress)
+		if !ok {
+			continue
+		}
+
+		scope, path, ok := pka.DerivationInfo()
+		if !ok {
+			return nil, fmt.Errorf("address %v has no derivation "+
+				"path, imported keys can't be signed for "+
+				"remotely", addr)
+		}
+
+		return []uint32{
+			scope.Purpose, scope.Coin, path.Account, path.Branch,
+			path.Index,
+		}, nil
+	}
+
+	return nil, lnwallet.ErrNotMine
+}
+
+// marshallKeyLocator converts a key locator to its signer RPC representation.
+func marshallKeyLocator(keyLoc keychain.KeyLocator) *signrpc.KeyLocator {
+	return &signrpc.KeyLocator{
+		KeyFamily: int32(keyLoc.Family),
+		KeyIndex:  int32(keyLoc.Index),
+	}
+}
+
+// marshallSignDescriptor converts a sign descriptor to its signer RPC
+// representation. Both the public key and the key locator are passed along,
+// so the remote signer can derive the key from the locator.
+func marshallSignDescriptor(
+	signDesc *input.SignDescriptor) *signrpc.SignDescriptor {
+
+	keyDesc := &signrpc.KeyDescriptor{
+		KeyLoc: marshallKeyLocator(signDesc.KeyDesc.KeyLocator),
+	}
+	if signDesc.KeyDesc.PubKey != nil {
+		keyDesc.RawKeyBytes = signDesc.KeyDesc.PubKey.SerializeCompressed()
+	}
+
+	var doubleTweak []byte
+	if signDesc.DoubleTweak != nil {
+		doubleTweak = signDesc.DoubleTweak.Serialize()
+	}
+
+	return &signrpc.SignDescriptor{
+		KeyDesc:       keyDesc,
+		SingleTweak:   signDesc.SingleTweak,
+		DoubleTweak:   doubleTweak,
+		WitnessScript: signDesc.WitnessScript,
+		Output: &signrpc.TxOut{
+			Value:    signDesc.Output.Value,
+			PkScript: signDesc.Output.PkScript,
+		},
+		Sighash:    uint32(signDesc.HashType),
+		InputIndex: int32(signDesc.InputIndex),
+	}
+}
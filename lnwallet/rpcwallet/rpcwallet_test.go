@@ -0,0 +1,79 @@
+package rpcwallet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/txscript"
+	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/lnd/input"
+	"github.com/Actinium-project/lnd/keychain"
+)
+
+// TestMarshallSignDescriptor asserts that sign descriptors are converted to
+// their signer RPC representation without losing any of the information the
+// remote signer needs.
+func TestMarshallSignDescriptor(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	tweakKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	signDesc := &input.SignDescriptor{
+		KeyDesc: keychain.KeyDescriptor{
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamilyDelayBase,
+				Index:  7,
+			},
+			PubKey: privKey.PubKey(),
+		},
+		DoubleTweak:   tweakKey,
+		WitnessScript: []byte{txscript.OP_TRUE},
+		Output: &wire.TxOut{
+			Value:    1000,
+			PkScript: []byte{txscript.OP_0},
+		},
+		HashType:   txscript.SigHashAll,
+		InputIndex: 3,
+	}
+
+	rpcDesc := marshallSignDescriptor(signDesc)
+
+	keyLoc := rpcDesc.KeyDesc.KeyLoc
+	if keyLoc.KeyFamily != int32(keychain.KeyFamilyDelayBase) ||
+		keyLoc.KeyIndex != 7 {
+
+		t.Fatalf("unexpected key locator: %v", keyLoc)
+	}
+	if !bytes.Equal(
+		rpcDesc.KeyDesc.RawKeyBytes,
+		privKey.PubKey().SerializeCompressed(),
+	) {
+		t.Fatalf("unexpected raw key bytes")
+	}
+	if !bytes.Equal(rpcDesc.DoubleTweak, tweakKey.Serialize()) {
+		t.Fatalf("unexpected double tweak")
+	}
+	if rpcDesc.SingleTweak != nil {
+		t.Fatalf("unexpected single tweak")
+	}
+	if !bytes.Equal(rpcDesc.WitnessScript, signDesc.WitnessScript) {
+		t.Fatalf("unexpected witness script")
+	}
+	if rpcDesc.Output.Value != 1000 ||
+		!bytes.Equal(rpcDesc.Output.PkScript, signDesc.Output.PkScript) {
+
+		t.Fatalf("unexpected output: %v", rpcDesc.Output)
+	}
+	if rpcDesc.Sighash != uint32(txscript.SigHashAll) {
+		t.Fatalf("unexpected sighash: %v", rpcDesc.Sighash)
+	}
+	if rpcDesc.InputIndex != 3 {
+		t.Fatalf("unexpected input index: %v", rpcDesc.InputIndex)
+	}
+}
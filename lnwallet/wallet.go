@@ -98,6 +98,10 @@ type InitFundingReserveMsg struct {
 	// output selected to fund the channel should satisfy.
 	MinConfs int32
 
+	// Outpoints optionally restricts the outputs that can be selected to
+	// fund the channel to the given set.
+	Outpoints []wire.OutPoint
+
 	// Tweakless indicates if the channel should use the new tweakless
 	// commitment format or not.
 	Tweakless bool
@@ -541,6 +545,7 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 			RemoteAmt:    req.RemoteFundingAmt,
 			LocalAmt:     req.LocalFundingAmt,
 			MinConfs:     req.MinConfs,
+			Outpoints:    req.Outpoints,
 			SubtractFees: req.SubtractFees,
 			FeeRate:      req.FundingFeePerKw,
 			ChangeAddr: func() (acmutil.Address, error) {
@@ -691,14 +696,13 @@ func (l *LightningWallet) initOurContribution(reservation *ChannelReservation,
 	if err != nil {
 		return err
 	}
-	revocationRoot, err := l.DerivePrivKey(nextRevocationKeyDesc)
-	if err != nil {
-		return err
-	}
 
 	// Once we have the root, we can then generate our shachain producer
 	// and from that generate the per-commitment point.
-	revRoot, err := chainhash.NewHash(revocationRoot.Serialize())
+	revRoot, err := l.revocationRoot(
+		nextRevocationKeyDesc,
+		reservation.ourContribution.MultiSigKey.PubKey,
+	)
 	if err != nil {
 		return err
 	}
@@ -712,11 +716,40 @@ func (l *LightningWallet) initOurContribution(reservation *ChannelReservation,
 	)
 
 	reservation.partialState.RevocationProducer = producer
+	if l.Cfg.RemoteSigner {
+		reservation.partialState.RevocationKeyLocator =
+			nextRevocationKeyDesc.KeyLocator
+	}
 	reservation.ourContribution.ChannelConstraints = l.Cfg.DefaultConstraints
 
 	return nil
 }
 
+// revocationRoot returns the root of the revocation tree of a new channel,
+// derived from the given revocation root key. If the wallet uses a remote
+// signer, the root is the ECDH of the revocation root key with our multi-sig
+// key, so that the private key never has to leave the signer. Otherwise, it's
+// the revocation root private key itself.
+func (l *LightningWallet) revocationRoot(revocationKeyDesc keychain.KeyDescriptor,
+	multiSigKey *btcec.PublicKey) (*chainhash.Hash, error) {
+
+	if l.Cfg.RemoteSigner {
+		sharedSecret, err := l.ScalarMult(revocationKeyDesc, multiSigKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return chainhash.NewHash(sharedSecret)
+	}
+
+	revocationRoot, err := l.DerivePrivKey(revocationKeyDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	return chainhash.NewHash(revocationRoot.Serialize())
+}
+
 // handleFundingReserveCancel cancels an existing channel reservation. As part
 // of the cancellation, outputs previously selected as inputs for the funding
 // transaction via coin selection are freed allowing future reservations to
@@ -101,6 +101,25 @@ const (
 	// HTLC.
 	MPPOptional FeatureBit = 17
 
+	// AMPRequired is a required feature bit that signals that the receiver
+	// of a payment requires it to be sent as an atomic multi-path payment,
+	// whose shards carry secret shares of the preimages.
+	AMPRequired FeatureBit = 30
+
+	// AMPOptional is an optional feature bit that signals that the
+	// receiver of a payment supports atomic multi-path payments, whose
+	// shards carry secret shares of the preimages.
+	AMPOptional FeatureBit = 31
+
+	// OnionMessagesRequired is a required feature bit that signals that a
+	// node requires its peers to relay onion messages.
+	OnionMessagesRequired FeatureBit = 38
+
+	// OnionMessagesOptional is an optional feature bit that signals that a
+	// node relays onion messages, and accepts those that are destined for
+	// itself.
+	OnionMessagesOptional FeatureBit = 39
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -138,6 +157,10 @@ var Features = map[FeatureBit]string{
 	PaymentAddrRequired:           "payment-addr",
 	MPPOptional:                   "multi-path-payments",
 	MPPRequired:                   "multi-path-payments",
+	AMPOptional:                   "amp",
+	AMPRequired:                   "amp",
+	OnionMessagesOptional:         "onion-messages",
+	OnionMessagesRequired:         "onion-messages",
 }
 
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
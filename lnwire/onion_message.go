@@ -0,0 +1,62 @@
+package lnwire
+
+import (
+	"io"
+)
+
+// OnionMessage is a message that is routed through the network within a Sphinx
+// onion packet, without being attached to an HTLC. Every hop peels a layer off
+// the onion, learning either the next peer to pass the message on to, or the
+// payload destined for itself. Onion messages are only sent to peers that
+// signal the OnionMessagesOptional feature bit.
+type OnionMessage struct {
+	// OnionBlob is the raw serialized onion packet. Its layout is the
+	// same as the onion packet of an UpdateAddHTLC message, but it
+	// carries no associated data.
+	OnionBlob [OnionPacketSize]byte
+}
+
+// NewOnionMessage returns a new empty OnionMessage message.
+func NewOnionMessage() *OnionMessage {
+	return &OnionMessage{}
+}
+
+// A compile time check to ensure OnionMessage implements the lnwire.Message
+// interface.
+var _ Message = (*OnionMessage)(nil)
+
+// Decode deserializes a serialized OnionMessage message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *OnionMessage) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r,
+		c.OnionBlob[:],
+	)
+}
+
+// Encode serializes the target OnionMessage into the passed io.Writer observing
+// the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (c *OnionMessage) Encode(w io.Writer, pver uint32) error {
+	return WriteElements(w,
+		c.OnionBlob[:],
+	)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (c *OnionMessage) MsgType() MessageType {
+	return MsgOnionMessage
+}
+
+// MaxPayloadLength returns the maximum allowed payload size for an
+// OnionMessage complete message observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *OnionMessage) MaxPayloadLength(uint32) uint32 {
+	return OnionPacketSize
+}
@@ -29,11 +29,13 @@ import (
 	"github.com/Actinium-project/lnd/lnwallet/chanfunding"
 	"github.com/Actinium-project/lnd/monitoring"
 	"github.com/Actinium-project/lnd/netann"
+	"github.com/Actinium-project/lnd/offers"
 	"github.com/Actinium-project/lnd/peernotifier"
 	"github.com/Actinium-project/lnd/routing"
 	"github.com/Actinium-project/lnd/routing/localchans"
 	"github.com/Actinium-project/lnd/signal"
 	"github.com/Actinium-project/lnd/sweep"
+	"github.com/Actinium-project/lnd/trampoline"
 	"github.com/Actinium-project/lnd/watchtower"
 	"github.com/Actinium-project/lnd/watchtower/wtclient"
 	"google.golang.org/grpc"
@@ -98,6 +100,8 @@ func init() {
 	addSubLogger("WTCL", wtclient.UseLogger)
 	addSubLogger("PRNF", peernotifier.UseLogger)
 	addSubLogger("CHFD", chanfunding.UseLogger)
+	addSubLogger("OFFR", offers.UseLogger)
+	addSubLogger("TRMP", trampoline.UseLogger)
 
 	addSubLogger(routing.Subsystem, routing.UseLogger, localchans.UseLogger)
 	addSubLogger(routerrpc.Subsystem, routerrpc.UseLogger)
@@ -20,6 +20,15 @@ var (
 	// DBFilename is the filename within the data directory which contains
 	// the macaroon stores.
 	DBFilename = "macaroons.db"
+
+	// PermissionEntityCustomURI is a special entity name for a permission
+	// that does not describe an entity:action pair but instead specifies a
+	// specific URI that needs to be granted access to. This can be used
+	// for more fine-grained permissions where a macaroon only grants
+	// access to certain methods instead of a whole list of methods that
+	// define the same entity:action pairs. For example: uri:/lnrpc.Lightning/
+	// GetInfo only gives access to the GetInfo call.
+	PermissionEntityCustomURI = "uri"
 )
 
 // Service encapsulates bakery.Bakery and adds a Close() method that zeroes the
@@ -29,6 +38,10 @@ type Service struct {
 	bakery.Bakery
 
 	rks *RootKeyStorage
+
+	// budgets tracks the amounts spent from the payment budgets of
+	// macaroons.
+	budgets *budgetStore
 }
 
 // NewService returns a service backed by the macaroon Bolt DB stored in the
@@ -69,10 +82,21 @@ func NewService(dir string, checks ...Checker) (*Service, error) {
 		Key:     nil,
 	}
 
+	budgets, err := newBudgetStore(macaroonDB)
+	if err != nil {
+		return nil, err
+	}
+
 	svc := bakery.New(macaroonParams)
 
-	// Register all custom caveat checkers with the bakery's checker.
+	// Register all custom caveat checkers with the bakery's checker. The
+	// spending limit checkers are always registered, as the payment
+	// budgets are tracked within the macaroon DB.
 	// TODO(aakselrod): Add more checks as required.
+	checks = append(
+		checks, MaxPaymentAmtChecker, AllowedDestChecker,
+		budgets.checker,
+	)
 	checker := svc.Checker.FirstPartyCaveatChecker.(*checkers.Checker)
 	for _, check := range checks {
 		cond, fun := check()
@@ -81,7 +105,11 @@ func NewService(dir string, checks ...Checker) (*Service, error) {
 		}
 	}
 
-	return &Service{*svc, rootKeyStore}, nil
+	return &Service{
+		Bakery:  *svc,
+		rks:     rootKeyStore,
+		budgets: budgets,
+	}, nil
 }
 
 // isRegistered checks to see if the required checker has already been
@@ -116,7 +144,9 @@ func (svc *Service) UnaryServerInterceptor(
 				"required for method", info.FullMethod)
 		}
 
-		err := svc.ValidateMacaroon(ctx, permissionMap[info.FullMethod])
+		err := svc.ValidateMacaroon(
+			ctx, permissionMap[info.FullMethod], info.FullMethod,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -140,6 +170,7 @@ func (svc *Service) StreamServerInterceptor(
 
 		err := svc.ValidateMacaroon(
 			ss.Context(), permissionMap[info.FullMethod],
+			info.FullMethod,
 		)
 		if err != nil {
 			return err
@@ -152,17 +183,46 @@ func (svc *Service) StreamServerInterceptor(
 // ValidateMacaroon validates the capabilities of a given request given a
 // bakery service, context, and uri. Within the passed context.Context, we
 // expect a macaroon to be encoded as request metadata using the key
-// "macaroon".
+// "macaroon". Besides the required permissions, a macaroon that explicitly
+// grants access to the full method URI is accepted as well.
 func (svc *Service) ValidateMacaroon(ctx context.Context,
-	requiredPermissions []bakery.Op) error {
+	requiredPermissions []bakery.Op, fullMethod string) error {
+
+	mac, err := macaroonFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check the method being called against the permitted operation and
+	// the expiration time and IP address and return the result.
+	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
+	_, err = authChecker.Allow(ctx, requiredPermissions...)
 
+	// If the macaroon grants the broader entity/action permissions, we're
+	// done.
+	if err == nil {
+		return nil
+	}
+
+	// Otherwise, the macaroon may still grant the special permission of
+	// "uri:<FullMethod>", which we'll need to check manually.
+	_, err = authChecker.Allow(ctx, bakery.Op{
+		Entity: PermissionEntityCustomURI,
+		Action: fullMethod,
+	})
+	return err
+}
+
+// macaroonFromContext extracts the macaroon from the request metadata of the
+// context.
+func macaroonFromContext(ctx context.Context) (*macaroon.Macaroon, error) {
 	// Get macaroon bytes from context and unmarshal into macaroon.
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return fmt.Errorf("unable to get metadata from context")
+		return nil, fmt.Errorf("unable to get metadata from context")
 	}
 	if len(md["macaroon"]) != 1 {
-		return fmt.Errorf("expected 1 macaroon, got %d",
+		return nil, fmt.Errorf("expected 1 macaroon, got %d",
 			len(md["macaroon"]))
 	}
 
@@ -171,19 +231,45 @@ func (svc *Service) ValidateMacaroon(ctx context.Context,
 	// representation.
 	macBytes, err := hex.DecodeString(md["macaroon"][0])
 	if err != nil {
-		return err
+		return nil, err
 	}
 	mac := &macaroon.Macaroon{}
 	err = mac.UnmarshalBinary(macBytes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Check the method being called against the permitted operation and
-	// the expiration time and IP address and return the result.
-	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
-	_, err = authChecker.Allow(ctx, requiredPermissions...)
-	return err
+	return mac, nil
+}
+
+// NewMacaroon bakes a new macaroon without any caveats that grants the given
+// permissions. The macaroon is derived from the root key with the given ID,
+// which is created if it doesn't exist yet.
+func (svc *Service) NewMacaroon(ctx context.Context, rootKeyID []byte,
+	ops ...bakery.Op) (*bakery.Macaroon, error) {
+
+	// Check that the root key ID is not empty.
+	if len(rootKeyID) == 0 {
+		return nil, fmt.Errorf("root key ID must not be empty")
+	}
+
+	ctx = ContextWithRootKeyID(ctx, rootKeyID)
+
+	return svc.Oven.NewMacaroon(ctx, bakery.LatestVersion, nil, ops...)
+}
+
+// ListMacaroonIDs returns all the root key IDs that are in use.
+func (svc *Service) ListMacaroonIDs(ctx context.Context) ([][]byte, error) {
+	return svc.rks.ListMacaroonIDs(ctx)
+}
+
+// DeleteMacaroonID removes the root key with the given ID, invalidating all
+// macaroons derived from it. The deleted ID is returned, or nil if no root key
+// with that ID existed.
+func (svc *Service) DeleteMacaroonID(ctx context.Context,
+	rootKeyID []byte) ([]byte, error) {
+
+	return svc.rks.DeleteMacaroonID(ctx, rootKeyID)
 }
 
 // Close closes the database that underlies the RootKeyStore and zeroes the
@@ -197,3 +283,15 @@ func (svc *Service) Close() error {
 func (svc *Service) CreateUnlock(password *[]byte) error {
 	return svc.rks.CreateUnlock(password)
 }
+
+// ChangePassword calls the underlying root key store's ChangePassword and
+// returns the result.
+func (svc *Service) ChangePassword(oldPw, newPw []byte) error {
+	return svc.rks.ChangePassword(oldPw, newPw)
+}
+
+// GenerateNewRootKey calls the underlying root key store's GenerateNewRootKey
+// and returns the result.
+func (svc *Service) GenerateNewRootKey() error {
+	return svc.rks.GenerateNewRootKey()
+}
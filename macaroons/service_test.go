@@ -135,8 +135,43 @@ func TestValidateMacaroon(t *testing.T) {
 	mockContext := metadata.NewIncomingContext(context.Background(), md)
 
 	// Finally, validate the macaroon against the required permissions.
-	err = service.ValidateMacaroon(mockContext, []bakery.Op{testOperation})
+	err = service.ValidateMacaroon(
+		mockContext, []bakery.Op{testOperation}, "FooMethod",
+	)
+	if err != nil {
+		t.Fatalf("Error validating the macaroon: %v", err)
+	}
+
+	// A macaroon that only grants access to a specific method URI should
+	// be valid for that method, but not for any other one.
+	uriMacaroon, err := service.Oven.NewMacaroon(
+		context.TODO(), bakery.LatestVersion, nil, bakery.Op{
+			Entity: macaroons.PermissionEntityCustomURI,
+			Action: "SomeMethod",
+		},
+	)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+	macaroonBinary, err = uriMacaroon.M().MarshalBinary()
+	if err != nil {
+		t.Fatalf("Error serializing macaroon: %v", err)
+	}
+	md = metadata.New(map[string]string{
+		"macaroon": hex.EncodeToString(macaroonBinary),
+	})
+	mockContext = metadata.NewIncomingContext(context.Background(), md)
+
+	err = service.ValidateMacaroon(
+		mockContext, []bakery.Op{testOperation}, "SomeMethod",
+	)
 	if err != nil {
 		t.Fatalf("Error validating the macaroon: %v", err)
 	}
+	err = service.ValidateMacaroon(
+		mockContext, []bakery.Op{testOperation}, "OtherMethod",
+	)
+	if err == nil {
+		t.Fatalf("Expected macaroon to be invalid for other method")
+	}
 }
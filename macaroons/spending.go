@@ -0,0 +1,436 @@
+package macaroons
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/routing/route"
+	"github.com/coreos/bbolt"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+const (
+	// CondMaxPaymentAmt is the name of the caveat that limits the amount
+	// of a single payment. Its argument is the maximum amount in
+	// milli-satoshis.
+	CondMaxPaymentAmt = "max-payment-amt"
+
+	// CondPaymentBudget is the name of the caveat that limits the total
+	// amount of all payments within a time window. Its arguments are the
+	// ID of the budget, the budget in milli-satoshis and the length of
+	// the window in seconds. A window of zero seconds never resets.
+	CondPaymentBudget = "payment-budget"
+
+	// CondAllowedDest is the name of the caveat that restricts the
+	// destinations payments can be sent to. Its argument is a list of
+	// hex encoded node public keys.
+	CondAllowedDest = "allowed-dest"
+
+	// budgetIDLen is the length of the random budget ID in bytes.
+	budgetIDLen = 8
+)
+
+var (
+	// budgetBucketName is the name of the bucket within the macaroon DB
+	// that stores the amounts spent from each payment budget.
+	budgetBucketName = []byte("macaroon-budgets")
+)
+
+// Payment describes a payment that is about to be sent using a macaroon that
+// may carry spending limit caveats.
+type Payment struct {
+	// Amount is the maximum amount the payment can take from our
+	// channels, which is the amount paid to the destination plus the
+	// maximum routing fees.
+	Amount lnwire.MilliSatoshi
+
+	// Dest is the final destination of the payment.
+	Dest route.Vertex
+
+	// Hops are the nodes of the route the payment is sent along, if the
+	// route was chosen by the caller. As the caller can set the fee of any
+	// hop, every one of them must be an allowed destination.
+	Hops []route.Vertex
+}
+
+// PaymentFromRoute returns the payment that is checked against the spending
+// limits of a macaroon for a payment along the given route. As the caller chose
+// the route, the payment includes the fees of all hops, and every hop is
+// subject to the allowed destinations of the macaroon.
+func PaymentFromRoute(rt *route.Route) (*Payment, error) {
+	if len(rt.Hops) == 0 {
+		return nil, fmt.Errorf("route has no hops")
+	}
+
+	hops := make([]route.Vertex, 0, len(rt.Hops)-1)
+	for _, hop := range rt.Hops[:len(rt.Hops)-1] {
+		hops = append(hops, hop.PubKeyBytes)
+	}
+
+	return &Payment{
+		Amount: rt.TotalAmount,
+		Dest:   rt.Hops[len(rt.Hops)-1].PubKeyBytes,
+		Hops:   hops,
+	}, nil
+}
+
+// paymentContextKey is the key of the payment within a context.
+type paymentContextKey struct{}
+
+// contextWithPayment returns a copy of the context that carries the payment
+// the spending limit checkers validate.
+func contextWithPayment(ctx context.Context, p *Payment) context.Context {
+	return context.WithValue(ctx, paymentContextKey{}, p)
+}
+
+// paymentFromContext returns the payment carried by the context, if any.
+func paymentFromContext(ctx context.Context) *Payment {
+	p, _ := ctx.Value(paymentContextKey{}).(*Payment)
+	return p
+}
+
+// MaxPaymentAmtConstraint restricts the macaroon to payments that send at
+// most the given amount to their destination.
+func MaxPaymentAmtConstraint(amt lnwire.MilliSatoshi) func(
+	*macaroon.Macaroon) error {
+
+	return func(mac *macaroon.Macaroon) error {
+		caveat := checkers.Condition(
+			CondMaxPaymentAmt, strconv.FormatUint(uint64(amt), 10),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// BudgetConstraint restricts the total amount that can be paid using the
+// macaroon, and all macaroons derived from it, within each window of the given
+// duration. If the window is zero, the budget never resets.
+func BudgetConstraint(amt lnwire.MilliSatoshi,
+	window time.Duration) func(*macaroon.Macaroon) error {
+
+	return func(mac *macaroon.Macaroon) error {
+		if window < 0 {
+			return fmt.Errorf("budget window must not be negative")
+		}
+
+		var budgetID [budgetIDLen]byte
+		if _, err := rand.Read(budgetID[:]); err != nil {
+			return err
+		}
+
+		caveat := checkers.Condition(CondPaymentBudget, fmt.Sprintf(
+			"%x %d %d", budgetID[:], uint64(amt),
+			int64(window/time.Second),
+		))
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// AllowedDestConstraint restricts the macaroon to payments that are sent to
+// one of the given destinations.
+func AllowedDestConstraint(dests ...route.Vertex) func(
+	*macaroon.Macaroon) error {
+
+	return func(mac *macaroon.Macaroon) error {
+		if len(dests) == 0 {
+			return fmt.Errorf("at least one destination must be " +
+				"allowed")
+		}
+
+		hexDests := make([]string, len(dests))
+		for i, dest := range dests {
+			hexDests[i] = dest.String()
+		}
+
+		caveat := checkers.Condition(
+			CondAllowedDest, strings.Join(hexDests, " "),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// MaxPaymentAmtChecker checks that the payment carried by the validation
+// context doesn't exceed the maximum amount of the macaroon. Requests that
+// don't send a payment are not restricted. It is of the `Checker` type.
+func MaxPaymentAmtChecker() (string, checkers.Func) {
+	return CondMaxPaymentAmt, func(ctx context.Context, cond,
+		arg string) error {
+
+		maxAmt, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %v caveat: %v", cond, err)
+		}
+
+		p := paymentFromContext(ctx)
+		if p == nil {
+			return nil
+		}
+
+		if uint64(p.Amount) > maxAmt {
+			return fmt.Errorf("payment amount of %v exceeds the "+
+				"maximum of %v", p.Amount,
+				lnwire.MilliSatoshi(maxAmt))
+		}
+
+		return nil
+	}
+}
+
+// AllowedDestChecker checks that the payment carried by the validation
+// context is sent to one of the destinations allowed by the macaroon. If the
+// route of the payment was chosen by the caller, all of its hops must be
+// allowed destinations as well. Requests that don't send a payment are not restricted. It is of the
+// `Checker` type.
+func AllowedDestChecker() (string, checkers.Func) {
+	return CondAllowedDest, func(ctx context.Context, cond,
+		arg string) error {
+
+		p := paymentFromContext(ctx)
+		if p == nil {
+			return nil
+		}
+
+		allowed := make(map[string]struct{})
+		for _, dest := range strings.Fields(arg) {
+			allowed[dest] = struct{}{}
+		}
+
+		for _, node := range append([]route.Vertex{p.Dest}, p.Hops...) {
+			if _, ok := allowed[node.String()]; !ok {
+				return fmt.Errorf("payments to %v are not "+
+					"allowed", node)
+			}
+		}
+
+		return nil
+	}
+}
+
+// budgetCaveat is a parsed payment budget caveat.
+type budgetCaveat struct {
+	id     [budgetIDLen]byte
+	amt    lnwire.MilliSatoshi
+	window time.Duration
+}
+
+// parseBudgetCaveat parses the argument of a payment budget caveat.
+func parseBudgetCaveat(arg string) (*budgetCaveat, error) {
+	fields := strings.Fields(arg)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("invalid %v caveat", CondPaymentBudget)
+	}
+
+	var c budgetCaveat
+	id, err := hex.DecodeString(fields[0])
+	if err != nil || len(id) != budgetIDLen {
+		return nil, fmt.Errorf("invalid %v caveat budget ID",
+			CondPaymentBudget)
+	}
+	copy(c.id[:], id)
+
+	amt, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v caveat amount: %v",
+			CondPaymentBudget, err)
+	}
+	c.amt = lnwire.MilliSatoshi(amt)
+
+	window, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil || window < 0 {
+		return nil, fmt.Errorf("invalid %v caveat window",
+			CondPaymentBudget)
+	}
+	c.window = time.Duration(window) * time.Second
+
+	return &c, nil
+}
+
+// budgetStore keeps track of the amounts spent from the payment budgets of
+// macaroons within the macaroon DB.
+type budgetStore struct {
+	db *bbolt.DB
+
+	// mtx serializes the validation of payments, so concurrent payments
+	// can't exceed a budget together.
+	mtx sync.Mutex
+}
+
+// newBudgetStore creates the budget bucket within the given database if it
+// doesn't exist yet, and returns a store backed by it.
+func newBudgetStore(db *bbolt.DB) (*budgetStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(budgetBucketName)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &budgetStore{db: db}, nil
+}
+
+// spent returns the amount already spent from the budget within its current
+// window, along with the start of that window.
+func (s *budgetStore) spent(c *budgetCaveat) (lnwire.MilliSatoshi,
+	time.Time, error) {
+
+	now := time.Now()
+
+	var (
+		windowStart = now
+		spent       lnwire.MilliSatoshi
+	)
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(budgetBucketName).Get(c.id[:])
+		if v == nil {
+			return nil
+		}
+		if len(v) != 16 {
+			return fmt.Errorf("invalid budget record for ID %x",
+				c.id[:])
+		}
+
+		start := time.Unix(int64(binary.BigEndian.Uint64(v[:8])), 0)
+
+		// If the window of the recorded spending has passed, the
+		// budget is available in full again.
+		if c.window != 0 && !now.Before(start.Add(c.window)) {
+			return nil
+		}
+
+		windowStart = start
+		spent = lnwire.MilliSatoshi(binary.BigEndian.Uint64(v[8:]))
+		return nil
+	})
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return spent, windowStart, nil
+}
+
+// check returns an error if the payment doesn't fit into the remaining
+// budget.
+func (s *budgetStore) check(c *budgetCaveat, amt lnwire.MilliSatoshi) error {
+	spent, _, err := s.spent(c)
+	if err != nil {
+		return err
+	}
+
+	if spent+amt > c.amt {
+		return fmt.Errorf("payment amount of %v exceeds the remaining "+
+			"budget of %v", amt, c.amt-spent)
+	}
+
+	return nil
+}
+
+// record adds the amount to the spending of the budget within its current
+// window.
+func (s *budgetStore) record(c *budgetCaveat, amt lnwire.MilliSatoshi) error {
+	spent, windowStart, err := s.spent(c)
+	if err != nil {
+		return err
+	}
+
+	var v [16]byte
+	binary.BigEndian.PutUint64(v[:8], uint64(windowStart.Unix()))
+	binary.BigEndian.PutUint64(v[8:], uint64(spent+amt))
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(budgetBucketName).Put(c.id[:], v[:])
+	})
+}
+
+// checker returns the checker of the payment budget caveat. The payment
+// carried by the validation context must fit into the remaining budget.
+// Requests that don't send a payment are not restricted. It is of the
+// `Checker` type.
+func (s *budgetStore) checker() (string, checkers.Func) {
+	return CondPaymentBudget, func(ctx context.Context, cond,
+		arg string) error {
+
+		c, err := parseBudgetCaveat(arg)
+		if err != nil {
+			return err
+		}
+
+		p := paymentFromContext(ctx)
+		if p == nil {
+			return nil
+		}
+
+		return s.check(c, p.Amount)
+	}
+}
+
+// ValidatePayment checks the payment against the spending limit caveats of
+// the macaroon within the request metadata of the context. If the payment is
+// allowed, its amount is deducted from all payment budgets of the macaroon.
+// The budgets are consumed regardless of whether the payment succeeds.
+func (svc *Service) ValidatePayment(ctx context.Context, p *Payment) error {
+	mac, err := macaroonFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc.budgets.mtx.Lock()
+	defer svc.budgets.mtx.Unlock()
+
+	// First, we'll check all spending limit caveats of the macaroon with
+	// the payment added to the context. The other caveats have already
+	// been checked when the request was authorized.
+	paymentCtx := contextWithPayment(ctx, p)
+	var budgets []*budgetCaveat
+	for _, caveat := range mac.Caveats() {
+		if caveat.Location != "" || caveat.VerificationId != nil {
+			continue
+		}
+
+		cond, arg, err := checkers.ParseCaveat(string(caveat.Id))
+		if err != nil {
+			return err
+		}
+
+		switch cond {
+		case CondMaxPaymentAmt, CondAllowedDest:
+
+		case CondPaymentBudget:
+			c, err := parseBudgetCaveat(arg)
+			if err != nil {
+				return err
+			}
+			budgets = append(budgets, c)
+
+		default:
+			continue
+		}
+
+		err = svc.Checker.FirstPartyCaveatChecker.CheckFirstPartyCaveat(
+			paymentCtx, string(caveat.Id),
+		)
+		if err != nil {
+			return fmt.Errorf("caveat %q not satisfied: %v", cond,
+				err)
+		}
+	}
+
+	// With the payment allowed by all caveats, we'll deduct it from each
+	// of the budgets.
+	for _, c := range budgets {
+		if err := svc.budgets.record(c, p.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package macaroons_test
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/macaroons"
+	"github.com/Actinium-project/lnd/routing/route"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// TestValidatePayment tests that payments are checked against the spending
+// limit caveats of a macaroon, and that the budget is tracked across
+// payments.
+func TestValidatePayment(t *testing.T) {
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+	service, err := macaroons.NewService(tempDir)
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	defer service.Close()
+
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	macaroon, err := service.Oven.NewMacaroon(
+		context.TODO(), bakery.LatestVersion, nil, testOperation,
+	)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+
+	allowedDest := route.Vertex{1}
+	otherDest := route.Vertex{2}
+	limitedMac, err := macaroons.AddConstraints(
+		macaroon.M(),
+		macaroons.MaxPaymentAmtConstraint(1000),
+		macaroons.BudgetConstraint(1500, time.Hour),
+		macaroons.AllowedDestConstraint(allowedDest),
+	)
+	if err != nil {
+		t.Fatalf("Error adding constraints: %v", err)
+	}
+	macaroonBinary, err := limitedMac.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Error serializing macaroon: %v", err)
+	}
+
+	md := metadata.New(map[string]string{
+		"macaroon": hex.EncodeToString(macaroonBinary),
+	})
+	mockContext := metadata.NewIncomingContext(context.Background(), md)
+
+	// Requests that don't send a payment should not be restricted by the
+	// spending limits.
+	err = service.ValidateMacaroon(
+		mockContext, []bakery.Op{testOperation}, "FooMethod",
+	)
+	if err != nil {
+		t.Fatalf("Error validating the macaroon: %v", err)
+	}
+
+	testCases := []struct {
+		name    string
+		amt     lnwire.MilliSatoshi
+		dest    route.Vertex
+		hops    []route.Vertex
+		allowed bool
+	}{
+		{
+			name:    "exceeds max amount",
+			amt:     1001,
+			dest:    allowedDest,
+			allowed: false,
+		},
+		{
+			name:    "disallowed destination",
+			amt:     100,
+			dest:    otherDest,
+			allowed: false,
+		},
+		{
+			name:    "route through disallowed hop",
+			amt:     100,
+			dest:    allowedDest,
+			hops:    []route.Vertex{otherDest, allowedDest},
+			allowed: false,
+		},
+		{
+			name:    "within limits",
+			amt:     1000,
+			dest:    allowedDest,
+			allowed: true,
+		},
+		{
+			name:    "exceeds remaining budget",
+			amt:     501,
+			dest:    allowedDest,
+			allowed: false,
+		},
+		{
+			name:    "exhausts budget",
+			amt:     500,
+			dest:    allowedDest,
+			hops:    []route.Vertex{allowedDest},
+			allowed: true,
+		},
+		{
+			name:    "budget exhausted",
+			amt:     1,
+			dest:    allowedDest,
+			allowed: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := service.ValidatePayment(mockContext, &macaroons.Payment{
+			Amount: testCase.amt,
+			Dest:   testCase.dest,
+			Hops:   testCase.hops,
+		})
+		if testCase.allowed && err != nil {
+			t.Fatalf("%v: expected payment to be allowed: %v",
+				testCase.name, err)
+		}
+		if !testCase.allowed && err == nil {
+			t.Fatalf("%v: expected payment to be rejected",
+				testCase.name)
+		}
+	}
+}
+
+// TestPaymentFromRoute tests that a payment along a route built by the caller
+// includes the fees of all hops, and that every hop is checked against the
+// allowed destinations.
+func TestPaymentFromRoute(t *testing.T) {
+	t.Parallel()
+
+	if _, err := macaroons.PaymentFromRoute(&route.Route{}); err == nil {
+		t.Fatalf("expected route without hops to be rejected")
+	}
+
+	rt := &route.Route{
+		TotalAmount: 1500,
+		Hops: []*route.Hop{
+			{PubKeyBytes: route.Vertex{1}, AmtToForward: 1200},
+			{PubKeyBytes: route.Vertex{2}, AmtToForward: 1000},
+			{PubKeyBytes: route.Vertex{3}, AmtToForward: 1000},
+		},
+	}
+	payment, err := macaroons.PaymentFromRoute(rt)
+	if err != nil {
+		t.Fatalf("unable to create payment: %v", err)
+	}
+
+	if payment.Amount != 1500 {
+		t.Fatalf("expected amount including fees, got %v",
+			payment.Amount)
+	}
+	if payment.Dest != (route.Vertex{3}) {
+		t.Fatalf("unexpected destination %v", payment.Dest)
+	}
+	if len(payment.Hops) != 2 || payment.Hops[0] != (route.Vertex{1}) ||
+		payment.Hops[1] != (route.Vertex{2}) {
+
+		t.Fatalf("unexpected hops %v", payment.Hops)
+	}
+}
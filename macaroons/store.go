@@ -1,6 +1,7 @@
 package macaroons
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"fmt"
@@ -21,11 +22,9 @@ var (
 	// rootKeyBucketName is the name of the root key store bucket.
 	rootKeyBucketName = []byte("macrootkeys")
 
-	// defaultRootKeyID is the ID of the default root key. The first is
+	// DefaultRootKeyID is the ID of the default root key. The first is
 	// just 0, to emulate the memory storage that comes with bakery.
-	//
-	// TODO(aakselrod): Add support for key rotation.
-	defaultRootKeyID = []byte("0")
+	DefaultRootKeyID = []byte("0")
 
 	// encryptedKeyID is the name of the database key that stores the
 	// encryption key, encrypted with a salted + hashed password. The
@@ -42,8 +41,40 @@ var (
 
 	// ErrPasswordRequired specifies that a nil password has been passed.
 	ErrPasswordRequired = fmt.Errorf("a non-nil password is required")
+
+	// ErrKeyValueForbidden is used when the root key ID uses the same
+	// value as the encrypted key ID.
+	ErrKeyValueForbidden = fmt.Errorf("root key ID value is not allowed")
+
+	// ErrDeletionForbidden is used when attempting to delete the default
+	// root key ID or the encrypted key ID.
+	ErrDeletionForbidden = fmt.Errorf("the specified ID cannot be deleted")
 )
 
+// rootKeyIDContextKey is the key under which the root key ID is stored within
+// a context.
+type rootKeyIDContextKey struct{}
+
+// ContextWithRootKeyID returns a copy of the context that instructs the root
+// key store to create macaroons using the root key with the given ID.
+func ContextWithRootKeyID(ctx context.Context,
+	rootKeyID []byte) context.Context {
+
+	return context.WithValue(ctx, rootKeyIDContextKey{}, rootKeyID)
+}
+
+// RootKeyIDFromContext returns the root key ID stored within the context. If
+// the context doesn't carry a root key ID, the default root key ID is
+// returned.
+func RootKeyIDFromContext(ctx context.Context) []byte {
+	rootKeyID, ok := ctx.Value(rootKeyIDContextKey{}).([]byte)
+	if !ok || len(rootKeyID) == 0 {
+		return DefaultRootKeyID
+	}
+
+	return rootKeyID
+}
+
 // RootKeyStorage implements the bakery.RootKeyStorage interface.
 type RootKeyStorage struct {
 	*bbolt.DB
@@ -155,17 +186,25 @@ func (r *RootKeyStorage) Get(_ context.Context, id []byte) ([]byte, error) {
 }
 
 // RootKey implements the RootKey method for the bakery.RootKeyStorage
-// interface.
-// TODO(aakselrod): Add support for key rotation.
-func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
+// interface. The root key ID is taken from the context, falling back to the
+// default root key ID if the context doesn't carry one. If no root key with
+// that ID exists yet, a new one is created.
+func (r *RootKeyStorage) RootKey(ctx context.Context) ([]byte, []byte, error) {
 	r.encKeyMtx.RLock()
 	defer r.encKeyMtx.RUnlock()
 
 	if r.encKey == nil {
 		return nil, nil, ErrStoreLocked
 	}
+
+	// The root key ID must not collide with the ID of the encryption key
+	// stored within the same bucket.
+	id := RootKeyIDFromContext(ctx)
+	if bytes.Equal(id, encryptedKeyID) {
+		return nil, nil, ErrKeyValueForbidden
+	}
+
 	var rootKey []byte
-	id := defaultRootKeyID
 	err := r.Update(func(tx *bbolt.Tx) error {
 		ns := tx.Bucket(rootKeyBucketName)
 		dbKey := ns.Get(id)
@@ -203,6 +242,213 @@ func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
 	return rootKey, id, nil
 }
 
+// ListMacaroonIDs returns all the root key IDs that are in use.
+func (r *RootKeyStorage) ListMacaroonIDs(_ context.Context) ([][]byte, error) {
+	r.encKeyMtx.RLock()
+	defer r.encKeyMtx.RUnlock()
+
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	var rootKeyIDs [][]byte
+	err := r.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+		return bucket.ForEach(func(k, v []byte) error {
+			// The encryption key is stored within the same bucket,
+			// but isn't a root key.
+			if bytes.Equal(k, encryptedKeyID) {
+				return nil
+			}
+
+			rootKeyID := make([]byte, len(k))
+			copy(rootKeyID, k)
+			rootKeyIDs = append(rootKeyIDs, rootKeyID)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rootKeyIDs, nil
+}
+
+// DeleteMacaroonID removes the root key with the given ID, invalidating all
+// macaroons derived from it. The deleted ID is returned, or nil if no root key
+// with that ID existed. The default root key can't be deleted.
+func (r *RootKeyStorage) DeleteMacaroonID(_ context.Context,
+	rootKeyID []byte) ([]byte, error) {
+
+	r.encKeyMtx.RLock()
+	defer r.encKeyMtx.RUnlock()
+
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	// The default root key and the encryption key must never be deleted.
+	if len(rootKeyID) == 0 || bytes.Equal(rootKeyID, DefaultRootKeyID) ||
+		bytes.Equal(rootKeyID, encryptedKeyID) {
+
+		return nil, ErrDeletionForbidden
+	}
+
+	var deleted []byte
+	err := r.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+		if bucket.Get(rootKeyID) == nil {
+			return nil
+		}
+
+		if err := bucket.Delete(rootKeyID); err != nil {
+			return err
+		}
+		deleted = rootKeyID
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+// ChangePassword decrypts all root keys with the old password and encrypts
+// them again with the new password. The store must already be unlocked.
+func (r *RootKeyStorage) ChangePassword(oldPw, newPw []byte) error {
+	r.encKeyMtx.Lock()
+	defer r.encKeyMtx.Unlock()
+
+	if r.encKey == nil {
+		return ErrStoreLocked
+	}
+
+	// Check that neither of the passwords is nil.
+	if oldPw == nil || newPw == nil {
+		return ErrPasswordRequired
+	}
+
+	var newEncKey *snacl.SecretKey
+	err := r.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+
+		// First, we'll make sure the old password is correct by
+		// deriving the stored encryption key from it.
+		oldEncKey := &snacl.SecretKey{}
+		err := oldEncKey.Unmarshal(bucket.Get(encryptedKeyID))
+		if err != nil {
+			return err
+		}
+		if err := oldEncKey.DeriveKey(&oldPw); err != nil {
+			return err
+		}
+
+		// Then we'll create the new encryption key and re-encrypt
+		// all root keys with it.
+		newEncKey, err = snacl.NewSecretKey(
+			&newPw, snacl.DefaultN, snacl.DefaultR, snacl.DefaultP,
+		)
+		if err != nil {
+			return err
+		}
+
+		reEncrypted := make(map[string][]byte)
+		err = bucket.ForEach(func(k, v []byte) error {
+			if bytes.Equal(k, encryptedKeyID) {
+				return nil
+			}
+
+			rootKey, err := oldEncKey.Decrypt(v)
+			if err != nil {
+				return err
+			}
+			encRootKey, err := newEncKey.Encrypt(rootKey)
+			if err != nil {
+				return err
+			}
+			reEncrypted[string(k)] = encRootKey
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for id, encRootKey := range reEncrypted {
+			if err := bucket.Put([]byte(id), encRootKey); err != nil {
+				return err
+			}
+		}
+
+		return bucket.Put(encryptedKeyID, newEncKey.Marshal())
+	})
+	if err != nil {
+		return err
+	}
+
+	// The store is now unlocked with the new encryption key.
+	r.encKey.Zero()
+	r.encKey = newEncKey
+
+	return nil
+}
+
+// GenerateNewRootKey replaces the default root key with a newly generated
+// one and deletes all other root keys, invalidating all macaroons that were
+// created before. The store must already be unlocked.
+func (r *RootKeyStorage) GenerateNewRootKey() error {
+	r.encKeyMtx.RLock()
+	defer r.encKeyMtx.RUnlock()
+
+	if r.encKey == nil {
+		return ErrStoreLocked
+	}
+
+	rootKey := make([]byte, RootKeyLen)
+	if _, err := io.ReadFull(rand.Reader, rootKey[:]); err != nil {
+		return err
+	}
+
+	encKey, err := r.encKey.Encrypt(rootKey)
+	if err != nil {
+		return err
+	}
+
+	return r.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+
+		// Macaroons can be derived from any root key ID, so we'll
+		// delete all of them except for the encryption key. Root keys
+		// for other IDs are created again once they're used.
+		var rootKeyIDs [][]byte
+		err := bucket.ForEach(func(k, v []byte) error {
+			if bytes.Equal(k, encryptedKeyID) {
+				return nil
+			}
+
+			rootKeyID := make([]byte, len(k))
+			copy(rootKeyID, k)
+			rootKeyIDs = append(rootKeyIDs, rootKeyID)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, rootKeyID := range rootKeyIDs {
+			if err := bucket.Delete(rootKeyID); err != nil {
+				return err
+			}
+		}
+
+		return bucket.Put(DefaultRootKeyID, encKey)
+	})
+}
+
 // Close closes the underlying database and zeroes the encryption key stored
 // in memory.
 func (r *RootKeyStorage) Close() error {
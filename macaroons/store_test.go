@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"reflect"
 	"testing"
 
 	"github.com/coreos/bbolt"
@@ -136,3 +137,201 @@ func TestStore(t *testing.T) {
 			rootID, id)
 	}
 }
+
+// TestStoreRootKeyIDs tests that root keys are created per root key ID, and
+// that they can be listed and deleted.
+func TestStoreRootKeyIDs(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "macaroonstore-")
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := bbolt.Open(path.Join(tempDir, "weks.db"), 0600,
+		bbolt.DefaultOptions)
+	if err != nil {
+		t.Fatalf("Error opening store DB: %v", err)
+	}
+
+	store, err := macaroons.NewRootKeyStorage(db)
+	if err != nil {
+		db.Close()
+		t.Fatalf("Error creating root key store: %v", err)
+	}
+	defer store.Close()
+
+	pw := []byte("weks")
+	if err := store.CreateUnlock(&pw); err != nil {
+		t.Fatalf("Error creating store encryption key: %v", err)
+	}
+
+	// A context without a root key ID should use the default root key.
+	defaultKey, id, err := store.RootKey(context.TODO())
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+	if !bytes.Equal(id, macaroons.DefaultRootKeyID) {
+		t.Fatalf("Expected default root key ID, got %s", id)
+	}
+
+	// A different root key ID should result in a different root key.
+	rootKeyID := []byte("1")
+	ctx := macaroons.ContextWithRootKeyID(context.TODO(), rootKeyID)
+	key, id, err := store.RootKey(ctx)
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+	if !bytes.Equal(id, rootKeyID) {
+		t.Fatalf("Expected root key ID %s, got %s", rootKeyID, id)
+	}
+	if bytes.Equal(key, defaultKey) {
+		t.Fatalf("Expected a new root key for ID %s", rootKeyID)
+	}
+
+	// The root key ID must not collide with the encryption key.
+	ctx = macaroons.ContextWithRootKeyID(context.TODO(), []byte("enckey"))
+	_, _, err = store.RootKey(ctx)
+	if err != macaroons.ErrKeyValueForbidden {
+		t.Fatalf("Received %v instead of ErrKeyValueForbidden", err)
+	}
+
+	ids, err := store.ListMacaroonIDs(context.TODO())
+	if err != nil {
+		t.Fatalf("Error listing root key IDs: %v", err)
+	}
+	expectedIDs := [][]byte{macaroons.DefaultRootKeyID, rootKeyID}
+	if !reflect.DeepEqual(ids, expectedIDs) {
+		t.Fatalf("Expected root key IDs %s, got %s", expectedIDs, ids)
+	}
+
+	// The default root key can't be deleted.
+	_, err = store.DeleteMacaroonID(
+		context.TODO(), macaroons.DefaultRootKeyID,
+	)
+	if err != macaroons.ErrDeletionForbidden {
+		t.Fatalf("Received %v instead of ErrDeletionForbidden", err)
+	}
+
+	// Deleting the new root key should render it unavailable.
+	deleted, err := store.DeleteMacaroonID(context.TODO(), rootKeyID)
+	if err != nil {
+		t.Fatalf("Error deleting root key ID: %v", err)
+	}
+	if !bytes.Equal(deleted, rootKeyID) {
+		t.Fatalf("Expected deleted ID %s, got %s", rootKeyID, deleted)
+	}
+	if _, err := store.Get(context.TODO(), rootKeyID); err == nil {
+		t.Fatalf("Expected deleted root key to be unavailable")
+	}
+
+	// Deleting an unknown root key ID isn't an error, but nothing is
+	// deleted.
+	deleted, err = store.DeleteMacaroonID(context.TODO(), []byte("2"))
+	if err != nil {
+		t.Fatalf("Error deleting root key ID: %v", err)
+	}
+	if deleted != nil {
+		t.Fatalf("Expected nothing to be deleted, got %s", deleted)
+	}
+}
+
+// TestStoreChangePassword tests that the root keys are preserved when the
+// password of the store is changed, and that the default root key can be
+// rotated.
+func TestStoreChangePassword(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "macaroonstore-")
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := bbolt.Open(path.Join(tempDir, "weks.db"), 0600,
+		bbolt.DefaultOptions)
+	if err != nil {
+		t.Fatalf("Error opening store DB: %v", err)
+	}
+
+	store, err := macaroons.NewRootKeyStorage(db)
+	if err != nil {
+		db.Close()
+		t.Fatalf("Error creating root key store: %v", err)
+	}
+
+	pw := []byte("weks")
+	if err := store.CreateUnlock(&pw); err != nil {
+		t.Fatalf("Error creating store encryption key: %v", err)
+	}
+	rootKey, _, err := store.RootKey(context.TODO())
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+
+	// Changing the password with the wrong old password should fail.
+	newPw := []byte("newweks")
+	if err := store.ChangePassword([]byte("wrong"), newPw); err == nil {
+		t.Fatalf("Expected password change with wrong password to fail")
+	}
+	if err := store.ChangePassword(pw, newPw); err != nil {
+		t.Fatalf("Error changing password: %v", err)
+	}
+
+	// After re-opening the store, it should only be unlocked with the new
+	// password, and the root key should be preserved.
+	store.Close()
+	db, err = bbolt.Open(path.Join(tempDir, "weks.db"), 0600,
+		bbolt.DefaultOptions)
+	if err != nil {
+		t.Fatalf("Error opening store DB: %v", err)
+	}
+	store, err = macaroons.NewRootKeyStorage(db)
+	if err != nil {
+		db.Close()
+		t.Fatalf("Error creating root key store: %v", err)
+	}
+	defer store.Close()
+
+	if err := store.CreateUnlock(&pw); err != snacl.ErrInvalidPassword {
+		t.Fatalf("Received %v instead of ErrInvalidPassword", err)
+	}
+	if err := store.CreateUnlock(&newPw); err != nil {
+		t.Fatalf("Error unlocking store with new password: %v", err)
+	}
+	key, _, err := store.RootKey(context.TODO())
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+	if !bytes.Equal(key, rootKey) {
+		t.Fatalf("Root key changed after password change")
+	}
+
+	// Finally, rotating the root keys should replace the default root key
+	// and delete all others.
+	otherCtx := macaroons.ContextWithRootKeyID(
+		context.TODO(), []byte("1"),
+	)
+	if _, _, err := store.RootKey(otherCtx); err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+
+	if err := store.GenerateNewRootKey(); err != nil {
+		t.Fatalf("Error generating new root key: %v", err)
+	}
+	key, _, err = store.RootKey(context.TODO())
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+	if bytes.Equal(key, rootKey) {
+		t.Fatalf("Root key not replaced")
+	}
+
+	ids, err := store.ListMacaroonIDs(context.TODO())
+	if err != nil {
+		t.Fatalf("Error listing root key IDs: %v", err)
+	}
+	if len(ids) != 1 || !bytes.Equal(ids[0], macaroons.DefaultRootKeyID) {
+		t.Fatalf("Expected only the default root key ID, got %s", ids)
+	}
+	if _, err := store.Get(context.TODO(), []byte("1")); err == nil {
+		t.Fatalf("Root key of other ID not deleted")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/Actinium-project/acmd/btcec"
 	"github.com/Actinium-project/acmd/chaincfg"
@@ -276,8 +277,9 @@ func (*mockWalletController) IsOurAddress(a acmutil.Address) bool {
 	return false
 }
 
-func (*mockWalletController) SendOutputs(outputs []*wire.TxOut,
-	_ chainfee.SatPerKWeight) (*wire.MsgTx, error) {
+func (*mockWalletController) SendOutputs(inputs []wire.OutPoint,
+	outputs []*wire.TxOut, _ chainfee.SatPerKWeight, _ int32,
+	_ string) (*wire.MsgTx, error) {
 
 	return nil, nil
 }
@@ -313,15 +315,47 @@ func (m *mockWalletController) ListUnspentWitness(minconfirms,
 	ret = append(ret, utxo)
 	return ret, nil
 }
+func (*mockWalletController) ListAccounts(string) ([]*lnwallet.AccountInfo,
+	error) {
+
+	return nil, nil
+}
+func (*mockWalletController) GetRecoveryInfo() (*lnwallet.RecoveryInfo,
+	error) {
+
+	return &lnwallet.RecoveryInfo{}, nil
+}
 func (*mockWalletController) ListTransactionDetails() ([]*lnwallet.TransactionDetail, error) {
 	return nil, nil
 }
 func (*mockWalletController) LockOutpoint(o wire.OutPoint)   {}
 func (*mockWalletController) UnlockOutpoint(o wire.OutPoint) {}
-func (m *mockWalletController) PublishTransaction(tx *wire.MsgTx) error {
+func (*mockWalletController) LeaseOutput(lnwallet.LeaseID, wire.OutPoint,
+	time.Duration) (time.Time, error) {
+
+	return time.Now(), nil
+}
+func (*mockWalletController) ReleaseOutput(lnwallet.LeaseID,
+	wire.OutPoint) error {
+
+	return nil
+}
+func (*mockWalletController) ListLeasedOutputs() ([]*lnwallet.LeasedOutput,
+	error) {
+
+	return nil, nil
+}
+func (m *mockWalletController) PublishTransaction(tx *wire.MsgTx,
+	_ string) error {
+
 	m.publishedTransactions <- tx
 	return nil
 }
+func (*mockWalletController) LabelTransaction(chainhash.Hash, string,
+	bool) error {
+
+	return nil
+}
 func (*mockWalletController) SubscribeTransactions() (lnwallet.TransactionSubscription, error) {
 	return nil, nil
 }
@@ -357,5 +391,25 @@ func (m *mockSecretKeyRing) DerivePrivKey(keyDesc keychain.KeyDescriptor) (*btce
 
 func (m *mockSecretKeyRing) ScalarMult(keyDesc keychain.KeyDescriptor,
 	pubKey *btcec.PublicKey) ([]byte, error) {
-	return nil, nil
+
+	secret, err := (&keychain.PrivKeyECDH{PrivKey: m.rootKey}).ECDH(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret[:], nil
+}
+
+func (m *mockSecretKeyRing) SignMessage(keyLoc keychain.KeyLocator,
+	msg []byte, doubleHash bool) (*btcec.Signature, error) {
+
+	signer := &keychain.PrivKeyMessageSigner{PrivKey: m.rootKey}
+	return signer.SignMessage(msg, doubleHash)
+}
+
+func (m *mockSecretKeyRing) SignMessageCompact(keyLoc keychain.KeyLocator,
+	msg []byte, doubleHash bool) ([]byte, error) {
+
+	signer := &keychain.PrivKeyMessageSigner{PrivKey: m.rootKey}
+	return signer.SignMessageCompact(msg, doubleHash)
 }
@@ -4,26 +4,22 @@ import (
 	"fmt"
 
 	"github.com/Actinium-project/acmd/btcec"
-	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/lnwallet"
 )
 
 // NodeSigner is an implementation of the MessageSigner interface backed by the
 // identity private key of running lnd node.
 type NodeSigner struct {
-	privKey *btcec.PrivateKey
+	keySigner keychain.SingleKeyMessageSigner
 }
 
 // NewNodeSigner creates a new instance of the NodeSigner backed by the target
-// private key.
-func NewNodeSigner(key *btcec.PrivateKey) *NodeSigner {
-	priv := &btcec.PrivateKey{}
-	priv.Curve = btcec.S256()
-	priv.PublicKey.X = key.X
-	priv.PublicKey.Y = key.Y
-	priv.D = key.D
+// message signer. The identity private key itself may be held by a remote
+// signer.
+func NewNodeSigner(keySigner keychain.SingleKeyMessageSigner) *NodeSigner {
 	return &NodeSigner{
-		privKey: priv,
+		keySigner: keySigner,
 	}
 }
 
@@ -35,13 +31,12 @@ func (n *NodeSigner) SignMessage(pubKey *btcec.PublicKey,
 
 	// If this isn't our identity public key, then we'll exit early with an
 	// error as we can't sign with this key.
-	if !pubKey.IsEqual(n.privKey.PubKey()) {
+	if !pubKey.IsEqual(n.keySigner.PubKey()) {
 		return nil, fmt.Errorf("unknown public key")
 	}
 
 	// Otherwise, we'll sign the dsha256 of the target message.
-	digest := chainhash.DoubleHashB(msg)
-	sign, err := n.privKey.Sign(digest)
+	sign, err := n.keySigner.SignMessage(msg, true)
 	if err != nil {
 		return nil, fmt.Errorf("can't sign the message: %v", err)
 	}
@@ -53,25 +48,18 @@ func (n *NodeSigner) SignMessage(pubKey *btcec.PublicKey,
 // resident node's private key. The returned signature is a pubkey-recoverable
 // signature.
 func (n *NodeSigner) SignCompact(msg []byte) ([]byte, error) {
-	// We'll sign the dsha256 of the target message.
-	digest := chainhash.DoubleHashB(msg)
-
-	return n.SignDigestCompact(digest)
+	return n.SignMessageCompact(msg, true)
 }
 
-// SignDigestCompact signs the provided message digest under the resident
-// node's private key. The returned signature is a pubkey-recoverable signature.
-func (n *NodeSigner) SignDigestCompact(hash []byte) ([]byte, error) {
-
-	// Should the signature reference a compressed public key or not.
-	isCompressedKey := true
+// SignMessageCompact signs the sha256 of the msg parameter under the resident
+// node's private key, or its double sha256 if doubleHash is true. The returned
+// signature is a pubkey-recoverable signature.
+func (n *NodeSigner) SignMessageCompact(msg []byte,
+	doubleHash bool) ([]byte, error) {
 
-	// btcec.SignCompact returns a pubkey-recoverable signature
-	sig, err := btcec.SignCompact(
-		btcec.S256(), n.privKey, hash, isCompressedKey,
-	)
+	sig, err := n.keySigner.SignMessageCompact(msg, doubleHash)
 	if err != nil {
-		return nil, fmt.Errorf("can't sign the hash: %v", err)
+		return nil, fmt.Errorf("can't sign the message: %v", err)
 	}
 
 	return sig, nil
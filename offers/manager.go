@@ -0,0 +1,673 @@
+package offers
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/chaincfg"
+	sphinx "github.com/Actinium-project/lightning-onion"
+	"github.com/Actinium-project/lnd/htlcswitch/hop"
+	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/routing/route"
+	"github.com/Actinium-project/lnd/zpay32"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// maxPathLength is the maximum number of hops of the paths onion
+	// messages are sent along. The payload of the final hop has to share
+	// the onion with the payloads of all other hops, so an invoice only
+	// fits if the path is short.
+	maxPathLength = 6
+
+	// DefaultPeerMessageInterval is the default interval at which the
+	// onion messages of a single peer are processed once its burst is
+	// used up.
+	DefaultPeerMessageInterval = 100 * time.Millisecond
+
+	// DefaultPeerMessageBurst is the default number of onion messages of
+	// a single peer that are processed at once.
+	DefaultPeerMessageBurst = 20
+
+	// DefaultRelayInterval is the default interval at which onion messages
+	// are relayed to other nodes once the relay burst is used up. It
+	// applies to the messages of all peers together.
+	DefaultRelayInterval = 10 * time.Millisecond
+
+	// DefaultRelayBurst is the default number of onion messages that are
+	// relayed at once.
+	DefaultRelayBurst = 100
+
+	// DefaultNumWorkers is the default number of invoice requests that are
+	// handled concurrently.
+	DefaultNumWorkers = 4
+
+	// DefaultMaxQueuedRequests is the default number of invoice requests
+	// that wait for a worker. Invoice requests that arrive while the queue
+	// is full are dropped.
+	DefaultMaxQueuedRequests = 50
+
+	// replayLogCapacity is the number of onion messages per generation of
+	// the replay log.
+	replayLogCapacity = 100000
+)
+
+var (
+	// ErrNoPath is returned when there's no path to the issuer of an offer
+	// along which onion messages are relayed.
+	ErrNoPath = errors.New("no onion message path to offer node")
+
+	// ErrInvoiceTimeout is returned when the issuer of an offer doesn't
+	// reply to an invoice request in time.
+	ErrInvoiceTimeout = errors.New("timed out waiting for invoice")
+
+	// ErrManagerShuttingDown is returned when an invoice is requested
+	// while the manager is shutting down.
+	ErrManagerShuttingDown = errors.New("offer manager shutting down")
+
+	// ErrRateLimited is returned when an onion message is dropped because
+	// its peer sends onion messages too fast, or too many onion messages
+	// are relayed.
+	ErrRateLimited = errors.New("onion message rate limit exceeded")
+
+	// ErrRequestQueueFull is returned when an invoice request is dropped
+	// because too many invoice requests wait to be handled.
+	ErrRequestQueueFull = errors.New("invoice request queue full")
+)
+
+// InvoiceIssuer issues invoices for the offers of this node.
+type InvoiceIssuer interface {
+	// IssueInvoice issues an invoice for the invoice request, returning
+	// its encoded payment request.
+	IssueInvoice(req *InvoiceRequest) (string, error)
+}
+
+// Config holds the dependencies of the offer manager.
+type Config struct {
+	// NodeKey is the key of this node, which is used to peel the onion
+	// messages it receives.
+	NodeKey keychain.SingleKeyECDH
+
+	// ChainParams are the parameters of the chain this node operates on.
+	ChainParams *chaincfg.Params
+
+	// SendOnionMessage sends an onion message to a connected peer. It
+	// fails if the peer doesn't relay onion messages.
+	SendOnionMessage func(peer route.Vertex, msg *lnwire.OnionMessage) error
+
+	// OnionMessagePeers returns the nodes that relay onion messages to and
+	// from the given node.
+	OnionMessagePeers func(node route.Vertex) ([]route.Vertex, error)
+
+	// Issuer issues invoices for the invoice requests this node receives.
+	Issuer InvoiceIssuer
+
+	// PeerMessageInterval is the interval at which the onion messages of
+	// a single peer are processed once its burst is used up.
+	PeerMessageInterval time.Duration
+
+	// PeerMessageBurst is the number of onion messages of a single peer
+	// that are processed at once.
+	PeerMessageBurst int
+
+	// RelayInterval is the interval at which onion messages are relayed
+	// to other nodes once the relay burst is used up.
+	RelayInterval time.Duration
+
+	// RelayBurst is the number of onion messages that are relayed at
+	// once.
+	RelayBurst int
+
+	// NumWorkers is the number of invoice requests that are handled
+	// concurrently.
+	NumWorkers int
+
+	// MaxQueuedRequests is the number of invoice requests that wait for a
+	// worker.
+	MaxQueuedRequests int
+}
+
+// invoiceRequestJob is an invoice request that waits to be handled by a
+// worker.
+type invoiceRequestJob struct {
+	// req is the invoice request.
+	req *InvoiceRequest
+
+	// replyPath is the path along which the invoice is sent.
+	replyPath []*btcec.PublicKey
+}
+
+// Manager sends, relays and receives the onion messages of offers. Payers use
+// it to fetch invoices for offers, while issuers use it to reply to the
+// invoice requests for their offers.
+type Manager struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// selfNode is the public key of this node.
+	selfNode route.Vertex
+
+	// router peels the onion messages this node receives.
+	router *hop.OnionRouter
+
+	// pending maps the ids of the invoice requests this node sent to the
+	// channels their replies are delivered on.
+	pending    map[[32]byte]chan interface{}
+	pendingMtx sync.Mutex
+
+	// peerLimiters limit the rate at which the onion messages of each
+	// connected peer are processed.
+	// The limiters are kept across reconnects, and only forgotten once
+	// they've been idle long enough to have refilled their burst.
+	peerLimiters map[route.Vertex]*peerLimiter
+	lastPrune    time.Time
+	limiterMtx   sync.Mutex
+
+	// relayLimiter limits the rate at which onion messages are relayed.
+	relayLimiter *rate.Limiter
+
+	// requests queues the invoice requests for the workers.
+	requests chan *invoiceRequestJob
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewManager creates a new offer manager. Limits that aren't set in the config
+// are set to their defaults.
+func NewManager(cfg *Config) *Manager {
+	if cfg.PeerMessageInterval <= 0 {
+		cfg.PeerMessageInterval = DefaultPeerMessageInterval
+	}
+	if cfg.PeerMessageBurst <= 0 {
+		cfg.PeerMessageBurst = DefaultPeerMessageBurst
+	}
+	if cfg.RelayInterval <= 0 {
+		cfg.RelayInterval = DefaultRelayInterval
+	}
+	if cfg.RelayBurst <= 0 {
+		cfg.RelayBurst = DefaultRelayBurst
+	}
+	if cfg.NumWorkers <= 0 {
+		cfg.NumWorkers = DefaultNumWorkers
+	}
+	if cfg.MaxQueuedRequests <= 0 {
+		cfg.MaxQueuedRequests = DefaultMaxQueuedRequests
+	}
+
+	return &Manager{
+		cfg:      cfg,
+		selfNode: route.NewVertex(cfg.NodeKey.PubKey()),
+		router: hop.NewOnionRouter(
+			cfg.NodeKey, newReplayLog(replayLogCapacity),
+		),
+		pending:      make(map[[32]byte]chan interface{}),
+		peerLimiters: make(map[route.Vertex]*peerLimiter),
+		relayLimiter: rate.NewLimiter(
+			rate.Every(cfg.RelayInterval), cfg.RelayBurst,
+		),
+		requests: make(chan *invoiceRequestJob, cfg.MaxQueuedRequests),
+		quit:     make(chan struct{}),
+	}
+}
+
+// Start starts the offer manager and the workers that handle invoice
+// requests.
+func (m *Manager) Start() error {
+	var err error
+	m.started.Do(func() {
+		log.Info("Offer manager starting")
+		if err = m.router.Start(); err != nil {
+			return
+		}
+
+		for i := 0; i < m.cfg.NumWorkers; i++ {
+			m.wg.Add(1)
+			go m.invoiceRequestWorker()
+		}
+	})
+
+	return err
+}
+
+// Stop stops the offer manager, waiting for the invoice requests that are
+// being handled. Queued invoice requests are dropped.
+func (m *Manager) Stop() error {
+	m.stopped.Do(func() {
+		log.Info("Offer manager shutting down")
+
+		close(m.quit)
+		m.wg.Wait()
+		m.router.Stop()
+	})
+
+	return nil
+}
+
+// HandleOnionMessage processes an onion message received from a peer. If this
+// node isn't the final hop of the message, it's passed on to the next hop.
+// Otherwise the offers message it carries is handled. Messages of peers that
+// exceed their rate limit, and replayed messages are rejected.
+func (m *Manager) HandleOnionMessage(peer route.Vertex,
+	msg *lnwire.OnionMessage) error {
+
+	if !m.allowPeerMessage(peer) {
+		return ErrRateLimited
+	}
+
+	var packet sphinx.OnionPacket
+	err := packet.Decode(bytes.NewReader(msg.OnionBlob[:]))
+	if err != nil {
+		return err
+	}
+
+	processed, err := m.router.ProcessOnionPacket(&packet, nil, 0)
+	if err != nil {
+		return err
+	}
+
+	payload, err := decodeOnionPayload(processed.Payload.Payload)
+	if err != nil {
+		return err
+	}
+
+	if processed.Action == sphinx.MoreHops {
+		return m.relay(payload, processed.NextPacket)
+	}
+
+	switch {
+	case payload.invoiceRequest != nil:
+		req, err := DecodeInvoiceRequest(payload.invoiceRequest)
+		if err != nil {
+			return err
+		}
+
+		// Issuing an invoice hits the database, so we don't block the
+		// peer while doing so. The request is handed to a worker
+		// instead, or dropped if too many requests are queued already.
+		job := &invoiceRequestJob{
+			req:       req,
+			replyPath: payload.replyPath,
+		}
+		select {
+		case m.requests <- job:
+
+		default:
+			return ErrRequestQueueFull
+		}
+
+	case payload.invoice != nil:
+		invoice, err := DecodeInvoice(payload.invoice)
+		if err != nil {
+			return err
+		}
+		m.deliverReply(invoice.RequestID, invoice)
+
+	case payload.invoiceError != nil:
+		invoiceErr, err := DecodeInvoiceError(payload.invoiceError)
+		if err != nil {
+			return err
+		}
+		m.deliverReply(invoiceErr.RequestID, invoiceErr)
+
+	default:
+		log.Debugf("Ignoring onion message without offers payload")
+	}
+
+	return nil
+}
+
+// relay passes an onion message on to the next hop.
+func (m *Manager) relay(payload *onionPayload,
+	next *sphinx.OnionPacket) error {
+
+	if payload.nextNode == nil {
+		return errors.New("onion message has no next hop")
+	}
+
+	if !m.relayLimiter.Allow() {
+		return ErrRateLimited
+	}
+
+	var b bytes.Buffer
+	if err := next.Encode(&b); err != nil {
+		return err
+	}
+
+	msg := lnwire.NewOnionMessage()
+	copy(msg.OnionBlob[:], b.Bytes())
+
+	nextNode := route.NewVertex(payload.nextNode)
+	log.Tracef("Relaying onion message to %v", nextNode)
+
+	return m.cfg.SendOnionMessage(nextNode, msg)
+}
+
+// peerLimiter is the rate limiter of the onion messages of a single peer.
+type peerLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// refillPeriod returns the time after which an idle peer limiter has refilled
+// its burst, and is thus no different from a new one.
+func (m *Manager) refillPeriod() time.Duration {
+	return m.cfg.PeerMessageInterval * time.Duration(m.cfg.PeerMessageBurst)
+}
+
+// allowPeerMessage returns true if an onion message of the peer is within its
+// rate limit.
+func (m *Manager) allowPeerMessage(peer route.Vertex) bool {
+	m.limiterMtx.Lock()
+	defer m.limiterMtx.Unlock()
+
+	now := time.Now()
+	if now.Sub(m.lastPrune) >= m.refillPeriod() {
+		m.pruneLimiters(now)
+	}
+
+	entry, ok := m.peerLimiters[peer]
+	if !ok {
+		entry = &peerLimiter{
+			limiter: rate.NewLimiter(
+				rate.Every(m.cfg.PeerMessageInterval),
+				m.cfg.PeerMessageBurst,
+			),
+		}
+		m.peerLimiters[peer] = entry
+	}
+	entry.lastSeen = now
+
+	return entry.limiter.AllowN(now, 1)
+}
+
+// pruneLimiters forgets the limiters of the peers that have been idle long
+// enough to have refilled their burst. A disconnected peer therefore can't
+// reset its rate limit by reconnecting.
+//
+// NOTE: The limiterMtx MUST be held when calling this method.
+func (m *Manager) pruneLimiters(now time.Time) {
+	for peer, entry := range m.peerLimiters {
+		if now.Sub(entry.lastSeen) >= m.refillPeriod() {
+			delete(m.peerLimiters, peer)
+		}
+	}
+	m.lastPrune = now
+}
+
+// invoiceRequestWorker handles the queued invoice requests until the manager
+// is stopped.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) invoiceRequestWorker() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case job := <-m.requests:
+			m.replyToInvoiceRequest(job.req, job.replyPath)
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// replyToInvoiceRequest issues an invoice for an invoice request and sends it
+// along the reply path of the request. If no invoice can be issued, an
+// invoice error is sent instead.
+func (m *Manager) replyToInvoiceRequest(req *InvoiceRequest,
+	replyPath []*btcec.PublicKey) {
+
+	if len(replyPath) == 0 {
+		log.Debugf("Ignoring invoice request %x without reply path",
+			req.RequestID[:])
+		return
+	}
+
+	var (
+		payload = &onionPayload{}
+		err     error
+	)
+	payReq, issueErr := m.cfg.Issuer.IssueInvoice(req)
+	if issueErr != nil {
+		log.Debugf("Unable to issue invoice for offer %x: %v",
+			req.OfferID[:], issueErr)
+
+		invoiceErr := &InvoiceError{
+			RequestID: req.RequestID,
+			Message:   issueErr.Error(),
+		}
+		payload.invoiceError, err = invoiceErr.Encode()
+	} else {
+		invoice := &Invoice{
+			RequestID:      req.RequestID,
+			PaymentRequest: payReq,
+		}
+		payload.invoice, err = invoice.Encode()
+	}
+	if err != nil {
+		log.Errorf("Unable to encode reply to invoice request: %v",
+			err)
+		return
+	}
+
+	if err := m.send(replyPath, payload); err != nil {
+		log.Errorf("Unable to reply to invoice request %x: %v",
+			req.RequestID[:], err)
+	}
+}
+
+// deliverReply passes the reply to an invoice request to the caller waiting
+// for it. Replies to unknown requests are dropped.
+func (m *Manager) deliverReply(requestID [32]byte, reply interface{}) {
+	m.pendingMtx.Lock()
+	replyChan, ok := m.pending[requestID]
+	delete(m.pending, requestID)
+	m.pendingMtx.Unlock()
+
+	if !ok {
+		log.Debugf("Ignoring reply to unknown invoice request %x",
+			requestID[:])
+		return
+	}
+
+	// The channel is buffered and only ever receives a single reply, so
+	// this never blocks.
+	replyChan <- reply
+}
+
+// send sends an onion message along the path, which starts at a peer of this
+// node.
+func (m *Manager) send(path []*btcec.PublicKey, payload *onionPayload) error {
+	msg, err := buildOnionMessage(path, payload)
+	if err != nil {
+		return err
+	}
+
+	return m.cfg.SendOnionMessage(route.NewVertex(path[0]), msg)
+}
+
+// FetchInvoice requests an invoice for the offer from its issuer, and waits
+// for the reply until the timeout expires. The amount must be set if the
+// offer doesn't specify one. The returned payment request is checked to
+// match the offer.
+func (m *Manager) FetchInvoice(offer *Offer, amt lnwire.MilliSatoshi,
+	payerNote string, timeout time.Duration) (string, error) {
+
+	if offer.Chain != *m.cfg.ChainParams.GenesisHash {
+		return "", ErrWrongChain
+	}
+	if offer.IsExpired(time.Now()) {
+		return "", ErrOfferExpired
+	}
+	if len(payerNote) > MaxPayerNoteSize {
+		return "", fmt.Errorf("payer note of %v bytes exceeds "+
+			"maximum of %v bytes", len(payerNote), MaxPayerNoteSize)
+	}
+
+	amt, err := offer.InvoiceAmount(amt)
+	if err != nil {
+		return "", err
+	}
+
+	offerID, err := offer.ID()
+	if err != nil {
+		return "", err
+	}
+
+	path, err := m.findPath(route.NewVertex(offer.NodeID))
+	if err != nil {
+		return "", err
+	}
+
+	// The issuer replies along the reverse of the path, which ends at
+	// this node.
+	replyPath := make([]*btcec.PublicKey, 0, len(path))
+	for i := len(path) - 2; i >= 0; i-- {
+		replyPath = append(replyPath, path[i])
+	}
+	replyPath = append(replyPath, m.cfg.NodeKey.PubKey())
+
+	req := &InvoiceRequest{
+		OfferID:   offerID,
+		Amount:    amt,
+		PayerNote: payerNote,
+	}
+	if _, err := rand.Read(req.RequestID[:]); err != nil {
+		return "", err
+	}
+
+	payload := &onionPayload{replyPath: replyPath}
+	payload.invoiceRequest, err = req.Encode()
+	if err != nil {
+		return "", err
+	}
+
+	replyChan := make(chan interface{}, 1)
+	m.pendingMtx.Lock()
+	m.pending[req.RequestID] = replyChan
+	m.pendingMtx.Unlock()
+
+	defer func() {
+		m.pendingMtx.Lock()
+		delete(m.pending, req.RequestID)
+		m.pendingMtx.Unlock()
+	}()
+
+	if err := m.send(path, payload); err != nil {
+		return "", err
+	}
+
+	select {
+	case reply := <-replyChan:
+		switch reply := reply.(type) {
+		case *Invoice:
+			err := m.checkInvoice(offer, amt, reply.PaymentRequest)
+			if err != nil {
+				return "", err
+			}
+
+			return reply.PaymentRequest, nil
+
+		case *InvoiceError:
+			return "", reply
+
+		default:
+			return "", fmt.Errorf("unexpected reply %T", reply)
+		}
+
+	case <-time.After(timeout):
+		return "", ErrInvoiceTimeout
+
+	case <-m.quit:
+		return "", ErrManagerShuttingDown
+	}
+}
+
+// checkInvoice checks that the payment request issued for an offer pays the
+// node of the offer the expected amount.
+func (m *Manager) checkInvoice(offer *Offer, amt lnwire.MilliSatoshi,
+	payReq string) error {
+
+	invoice, err := zpay32.Decode(payReq, m.cfg.ChainParams)
+	if err != nil {
+		return fmt.Errorf("invalid payment request: %v", err)
+	}
+
+	if !invoice.Destination.IsEqual(offer.NodeID) {
+		return errors.New("invoice is not issued by offer node")
+	}
+	if invoice.MilliSat == nil || *invoice.MilliSat != amt {
+		return fmt.Errorf("invoice amount doesn't match requested "+
+			"amount %v", amt)
+	}
+
+	return nil
+}
+
+// findPath returns the shortest path of nodes that relay onion messages from
+// this node to the target. The path excludes this node, and ends at the
+// target.
+func (m *Manager) findPath(target route.Vertex) ([]*btcec.PublicKey, error) {
+	if target == m.selfNode {
+		return nil, errors.New("cannot send onion message to self")
+	}
+
+	// We'll run a breadth-first search, remembering the node each node
+	// was first reached from.
+	prev := map[route.Vertex]route.Vertex{
+		m.selfNode: m.selfNode,
+	}
+	frontier := []route.Vertex{m.selfNode}
+
+	for depth := 0; depth < maxPathLength && len(frontier) > 0; depth++ {
+		var next []route.Vertex
+		for _, node := range frontier {
+			peers, err := m.cfg.OnionMessagePeers(node)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, peer := range peers {
+				if _, ok := prev[peer]; ok {
+					continue
+				}
+				prev[peer] = node
+
+				if peer == target {
+					return m.buildPath(prev, target)
+				}
+				next = append(next, peer)
+			}
+		}
+		frontier = next
+	}
+
+	return nil, ErrNoPath
+}
+
+// buildPath walks the nodes found by findPath back from the target to this
+// node, returning the path in forward order.
+func (m *Manager) buildPath(prev map[route.Vertex]route.Vertex,
+	target route.Vertex) ([]*btcec.PublicKey, error) {
+
+	var path []*btcec.PublicKey
+	for node := target; node != m.selfNode; node = prev[node] {
+		pubKey, err := btcec.ParsePubKey(node[:], btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+		path = append([]*btcec.PublicKey{pubKey}, path...)
+	}
+
+	return path, nil
+}
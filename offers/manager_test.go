@@ -0,0 +1,391 @@
+package offers
+
+import (
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	sphinx "github.com/Actinium-project/lightning-onion"
+	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/routing/route"
+	"github.com/Actinium-project/lnd/zpay32"
+)
+
+// mockIssuer issues invoices signed by its node key for the offers it knows.
+type mockIssuer struct {
+	nodeKey *btcec.PrivateKey
+	offers  map[[32]byte]*Offer
+}
+
+func (i *mockIssuer) IssueInvoice(req *InvoiceRequest) (string, error) {
+	offer, ok := i.offers[req.OfferID]
+	if !ok {
+		return "", errors.New("unknown offer")
+	}
+
+	amt, err := offer.InvoiceAmount(req.Amount)
+	if err != nil {
+		return "", err
+	}
+
+	invoice, err := zpay32.NewInvoice(
+		&chaincfg.MainNetParams, [32]byte{1}, time.Now(),
+		zpay32.Amount(amt), zpay32.Description(offer.Description),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(msg []byte) ([]byte, error) {
+			return btcec.SignCompact(
+				btcec.S256(), i.nodeKey, chainhash.HashB(msg),
+				true,
+			)
+		},
+	})
+}
+
+// testNetwork is a line of offer managers that pass onion messages to each
+// other.
+type testNetwork struct {
+	t        *testing.T
+	managers map[route.Vertex]*Manager
+	peers    map[route.Vertex][]route.Vertex
+}
+
+// newTestNetwork creates a line of managers, where each is a peer of the ones
+// next to it. Every manager issues invoices for the offers of its issuer.
+func newTestNetwork(t *testing.T, numNodes int) (*testNetwork,
+	[]*Manager, []*mockIssuer) {
+
+	network := &testNetwork{
+		t:        t,
+		managers: make(map[route.Vertex]*Manager),
+		peers:    make(map[route.Vertex][]route.Vertex),
+	}
+
+	var (
+		managers []*Manager
+		issuers  []*mockIssuer
+		nodes    []route.Vertex
+	)
+	for i := 0; i < numNodes; i++ {
+		nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatalf("unable to generate key: %v", err)
+		}
+
+		node := route.NewVertex(nodeKey.PubKey())
+		send := func(peer route.Vertex, msg *lnwire.OnionMessage) error {
+			return network.send(node, peer, msg)
+		}
+
+		issuer := &mockIssuer{
+			nodeKey: nodeKey,
+			offers:  make(map[[32]byte]*Offer),
+		}
+		manager := NewManager(&Config{
+			NodeKey:           &keychain.PrivKeyECDH{PrivKey: nodeKey},
+			ChainParams:       &chaincfg.MainNetParams,
+			SendOnionMessage:  send,
+			OnionMessagePeers: network.onionMessagePeers,
+			Issuer:            issuer,
+		})
+		if err := manager.Start(); err != nil {
+			t.Fatalf("unable to start manager: %v", err)
+		}
+
+		network.managers[node] = manager
+		if i > 0 {
+			prev := nodes[i-1]
+			network.peers[prev] = append(network.peers[prev], node)
+			network.peers[node] = append(network.peers[node], prev)
+		}
+
+		managers = append(managers, manager)
+		issuers = append(issuers, issuer)
+		nodes = append(nodes, node)
+	}
+
+	return network, managers, issuers
+}
+
+// send delivers an onion message from a node to the manager of its peer, as a
+// peer connection would.
+func (n *testNetwork) send(from, peer route.Vertex,
+	msg *lnwire.OnionMessage) error {
+
+	manager, ok := n.managers[peer]
+	if !ok {
+		return errors.New("unknown peer")
+	}
+
+	go func() {
+		if err := manager.HandleOnionMessage(from, msg); err != nil {
+			n.t.Errorf("unable to handle onion message: %v", err)
+		}
+	}()
+
+	return nil
+}
+
+func (n *testNetwork) onionMessagePeers(node route.Vertex) ([]route.Vertex,
+	error) {
+
+	return n.peers[node], nil
+}
+
+func (n *testNetwork) stop() {
+	for _, manager := range n.managers {
+		manager.Stop()
+	}
+}
+
+// TestFetchInvoice tests that a payer fetches invoices for offers from their
+// issuer over onion messages relayed by an intermediate node.
+func TestFetchInvoice(t *testing.T) {
+	t.Parallel()
+
+	network, managers, issuers := newTestNetwork(t, 3)
+	defer network.stop()
+
+	payer, carol := managers[0], issuers[2]
+
+	// addOffer adds an offer to the issuer at the end of the line.
+	addOffer := func(amt lnwire.MilliSatoshi) *Offer {
+		offer, err := NewOffer(
+			*chaincfg.MainNetParams.GenesisHash,
+			carol.nodeKey.PubKey(), amt, "coffee", time.Time{},
+		)
+		if err != nil {
+			t.Fatalf("unable to create offer: %v", err)
+		}
+
+		id, err := offer.ID()
+		if err != nil {
+			t.Fatalf("unable to compute offer id: %v", err)
+		}
+		carol.offers[id] = offer
+
+		return offer
+	}
+
+	// The amount of the invoice of an offer with amount is fixed.
+	fixedOffer := addOffer(2000)
+	payReq, err := payer.FetchInvoice(fixedOffer, 0, "", time.Minute)
+	if err != nil {
+		t.Fatalf("unable to fetch invoice: %v", err)
+	}
+	invoice, err := zpay32.Decode(payReq, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to decode invoice: %v", err)
+	}
+	if *invoice.MilliSat != 2000 {
+		t.Fatalf("expected amount 2000, got %v", *invoice.MilliSat)
+	}
+
+	_, err = payer.FetchInvoice(fixedOffer, 1000, "", time.Minute)
+	if err == nil {
+		t.Fatalf("expected mismatching amount to be rejected")
+	}
+
+	// The payer chooses the amount of an offer without amount.
+	openOffer := addOffer(0)
+	payReq, err = payer.FetchInvoice(openOffer, 3000, "", time.Minute)
+	if err != nil {
+		t.Fatalf("unable to fetch invoice: %v", err)
+	}
+	invoice, err = zpay32.Decode(payReq, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to decode invoice: %v", err)
+	}
+	if *invoice.MilliSat != 3000 {
+		t.Fatalf("expected amount 3000, got %v", *invoice.MilliSat)
+	}
+
+	// Requests for offers the issuer doesn't know are answered with an
+	// invoice error.
+	unknownOffer, err := NewOffer(
+		*chaincfg.MainNetParams.GenesisHash, carol.nodeKey.PubKey(),
+		1000, "", time.Time{},
+	)
+	if err != nil {
+		t.Fatalf("unable to create offer: %v", err)
+	}
+	_, err = payer.FetchInvoice(unknownOffer, 0, "", time.Minute)
+	if _, ok := err.(*InvoiceError); !ok {
+		t.Fatalf("expected invoice error, got %v", err)
+	}
+
+	// Offers of nodes without onion message path can't be fetched.
+	strangerKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	strangerOffer, err := NewOffer(
+		*chaincfg.MainNetParams.GenesisHash, strangerKey.PubKey(),
+		1000, "", time.Time{},
+	)
+	if err != nil {
+		t.Fatalf("unable to create offer: %v", err)
+	}
+	_, err = payer.FetchInvoice(strangerOffer, 0, "", time.Minute)
+	if err != ErrNoPath {
+		t.Fatalf("expected ErrNoPath, got %v", err)
+	}
+}
+
+// blockingIssuer signals every invoice request it receives, and blocks until
+// it's released.
+type blockingIssuer struct {
+	requests chan *InvoiceRequest
+	release  chan struct{}
+}
+
+func (i *blockingIssuer) IssueInvoice(req *InvoiceRequest) (string, error) {
+	i.requests <- req
+	<-i.release
+
+	return "", errors.New("no invoice")
+}
+
+// newTestManager creates and starts a manager that drops the onion messages
+// it sends.
+func newTestManager(t *testing.T, cfg *Config) (*Manager, *btcec.PublicKey) {
+	nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	cfg.NodeKey = &keychain.PrivKeyECDH{PrivKey: nodeKey}
+	cfg.ChainParams = &chaincfg.MainNetParams
+	cfg.SendOnionMessage = func(route.Vertex, *lnwire.OnionMessage) error {
+		return nil
+	}
+	cfg.OnionMessagePeers = func(route.Vertex) ([]route.Vertex, error) {
+		return nil, nil
+	}
+
+	manager := NewManager(cfg)
+	if err := manager.Start(); err != nil {
+		t.Fatalf("unable to start manager: %v", err)
+	}
+
+	return manager, nodeKey.PubKey()
+}
+
+// newTestInvoiceRequest creates an onion message that carries an invoice
+// request to the node.
+func newTestInvoiceRequest(t *testing.T,
+	node *btcec.PublicKey) *lnwire.OnionMessage {
+
+	req := &InvoiceRequest{OfferID: [32]byte{1}}
+	if _, err := rand.Read(req.RequestID[:]); err != nil {
+		t.Fatalf("unable to generate request id: %v", err)
+	}
+
+	payload := &onionPayload{replyPath: []*btcec.PublicKey{node}}
+	var err error
+	payload.invoiceRequest, err = req.Encode()
+	if err != nil {
+		t.Fatalf("unable to encode invoice request: %v", err)
+	}
+
+	msg, err := buildOnionMessage([]*btcec.PublicKey{node}, payload)
+	if err != nil {
+		t.Fatalf("unable to build onion message: %v", err)
+	}
+
+	return msg
+}
+
+// TestOnionMessageReplay tests that a replayed onion message is rejected.
+func TestOnionMessageReplay(t *testing.T) {
+	t.Parallel()
+
+	manager, node := newTestManager(t, &Config{
+		Issuer: &mockIssuer{offers: make(map[[32]byte]*Offer)},
+	})
+	defer manager.Stop()
+
+	msg := newTestInvoiceRequest(t, node)
+	if err := manager.HandleOnionMessage(route.Vertex{1}, msg); err != nil {
+		t.Fatalf("unable to handle onion message: %v", err)
+	}
+
+	err := manager.HandleOnionMessage(route.Vertex{2}, msg)
+	if err != sphinx.ErrReplayedPacket {
+		t.Fatalf("expected ErrReplayedPacket, got %v", err)
+	}
+}
+
+// TestOnionMessageLimits tests that the onion messages of peers that exceed
+// their rate limit, and invoice requests that arrive while the request queue
+// is full are dropped.
+func TestOnionMessageLimits(t *testing.T) {
+	t.Parallel()
+
+	issuer := &blockingIssuer{
+		requests: make(chan *InvoiceRequest, 2),
+		release:  make(chan struct{}),
+	}
+	manager, node := newTestManager(t, &Config{
+		Issuer:              issuer,
+		PeerMessageInterval: time.Hour,
+		PeerMessageBurst:    2,
+		NumWorkers:          1,
+		MaxQueuedRequests:   1,
+	})
+	defer manager.Stop()
+	defer close(issuer.release)
+
+	alice, bob := route.Vertex{1}, route.Vertex{2}
+	handle := func(peer route.Vertex) error {
+		return manager.HandleOnionMessage(
+			peer, newTestInvoiceRequest(t, node),
+		)
+	}
+
+	// The first request is handled by the only worker, which blocks on
+	// the issuer. The second one is queued.
+	if err := handle(alice); err != nil {
+		t.Fatalf("unable to handle onion message: %v", err)
+	}
+	<-issuer.requests
+	if err := handle(alice); err != nil {
+		t.Fatalf("unable to handle onion message: %v", err)
+	}
+
+	// Alice used up her burst, while the requests of Bob are dropped
+	// because the queue is full.
+	if err := handle(alice); err != ErrRateLimited {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+	if err := handle(bob); err != ErrRequestQueueFull {
+		t.Fatalf("expected ErrRequestQueueFull, got %v", err)
+	}
+
+	// Disconnecting and reconnecting doesn't reset the rate limit of
+	// Alice, as her limiter is kept until it has refilled its burst.
+	manager.limiterMtx.Lock()
+	manager.pruneLimiters(time.Now())
+	manager.limiterMtx.Unlock()
+	if err := handle(alice); err != ErrRateLimited {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+
+	// Only once it had the time to refill, it's forgotten.
+	manager.limiterMtx.Lock()
+	manager.pruneLimiters(time.Now().Add(2 * time.Hour))
+	_, ok := manager.peerLimiters[alice]
+	manager.limiterMtx.Unlock()
+	if ok {
+		t.Fatalf("expected limiter of alice to be pruned")
+	}
+}
@@ -0,0 +1,239 @@
+package offers
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/tlv"
+)
+
+const (
+	// MaxPayerNoteSize is the maximum size of the note a payer attaches to
+	// an invoice request, in bytes.
+	MaxPayerNoteSize = 256
+
+	// requestIDType is the record type of the id that a payer assigns to
+	// an invoice request, and that the issuer echoes in its reply.
+	requestIDType tlv.Type = 0
+
+	// invReqOfferIDType is the record type of the id of the offer an
+	// invoice is requested for.
+	invReqOfferIDType tlv.Type = 2
+
+	// invReqAmountType is the record type of the amount an invoice is
+	// requested for.
+	invReqAmountType tlv.Type = 4
+
+	// invReqPayerNoteType is the record type of the note of the payer.
+	invReqPayerNoteType tlv.Type = 7
+
+	// invoicePayReqType is the record type of the payment request of an
+	// invoice.
+	invoicePayReqType tlv.Type = 2
+
+	// invoiceErrorType is the record type of the error message of an
+	// invoice error.
+	invoiceErrorType tlv.Type = 2
+)
+
+// InvoiceRequest is sent by a payer to the issuer of an offer to request an
+// invoice for it.
+type InvoiceRequest struct {
+	// RequestID is a random id assigned by the payer, which the issuer
+	// echoes in its reply.
+	RequestID [32]byte
+
+	// OfferID is the id of the offer the invoice is requested for.
+	OfferID [32]byte
+
+	// Amount is the amount the invoice is requested for. It must be set if
+	// the offer doesn't specify an amount, and otherwise either be zero or
+	// match the amount of the offer.
+	Amount lnwire.MilliSatoshi
+
+	// PayerNote is an optional note of the payer, which is included in the
+	// memo of the issued invoice.
+	PayerNote string
+}
+
+// Encode serializes the invoice request.
+func (r *InvoiceRequest) Encode() ([]byte, error) {
+	var (
+		amount    = uint64(r.Amount)
+		payerNote = []byte(r.PayerNote)
+	)
+
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(requestIDType, &r.RequestID),
+		tlv.MakePrimitiveRecord(invReqOfferIDType, &r.OfferID),
+	}
+	if r.Amount != 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			invReqAmountType, &amount,
+		))
+	}
+	if len(payerNote) != 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			invReqPayerNoteType, &payerNote,
+		))
+	}
+
+	return encodeRecords(records...)
+}
+
+// DecodeInvoiceRequest deserializes an invoice request.
+func DecodeInvoiceRequest(b []byte) (*InvoiceRequest, error) {
+	var (
+		r         InvoiceRequest
+		amount    uint64
+		payerNote []byte
+	)
+
+	err := decodeRecords(
+		b, []tlv.Type{requestIDType, invReqOfferIDType},
+		tlv.MakePrimitiveRecord(requestIDType, &r.RequestID),
+		tlv.MakePrimitiveRecord(invReqOfferIDType, &r.OfferID),
+		tlv.MakePrimitiveRecord(invReqAmountType, &amount),
+		tlv.MakePrimitiveRecord(invReqPayerNoteType, &payerNote),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(payerNote) > MaxPayerNoteSize {
+		return nil, fmt.Errorf("payer note of %v bytes exceeds "+
+			"maximum of %v bytes", len(payerNote), MaxPayerNoteSize)
+	}
+
+	r.Amount = lnwire.MilliSatoshi(amount)
+	r.PayerNote = string(payerNote)
+
+	return &r, nil
+}
+
+// Invoice is the reply of the issuer of an offer to an invoice request.
+type Invoice struct {
+	// RequestID is the id of the invoice request this invoice replies to.
+	RequestID [32]byte
+
+	// PaymentRequest is the encoded payment request of the invoice.
+	PaymentRequest string
+}
+
+// Encode serializes the invoice.
+func (i *Invoice) Encode() ([]byte, error) {
+	payReq := []byte(i.PaymentRequest)
+
+	return encodeRecords(
+		tlv.MakePrimitiveRecord(requestIDType, &i.RequestID),
+		tlv.MakePrimitiveRecord(invoicePayReqType, &payReq),
+	)
+}
+
+// DecodeInvoice deserializes an invoice.
+func DecodeInvoice(b []byte) (*Invoice, error) {
+	var (
+		i      Invoice
+		payReq []byte
+	)
+
+	err := decodeRecords(
+		b, []tlv.Type{requestIDType, invoicePayReqType},
+		tlv.MakePrimitiveRecord(requestIDType, &i.RequestID),
+		tlv.MakePrimitiveRecord(invoicePayReqType, &payReq),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	i.PaymentRequest = string(payReq)
+
+	return &i, nil
+}
+
+// InvoiceError is the reply of the issuer of an offer to an invoice request
+// that it can't issue an invoice for.
+type InvoiceError struct {
+	// RequestID is the id of the invoice request this error replies to.
+	RequestID [32]byte
+
+	// Message describes why no invoice was issued.
+	Message string
+}
+
+// Error returns the message of the invoice error.
+//
+// NOTE: This is part of the error interface.
+func (e *InvoiceError) Error() string {
+	return fmt.Sprintf("invoice request rejected: %v", e.Message)
+}
+
+// Encode serializes the invoice error.
+func (e *InvoiceError) Encode() ([]byte, error) {
+	message := []byte(e.Message)
+
+	return encodeRecords(
+		tlv.MakePrimitiveRecord(requestIDType, &e.RequestID),
+		tlv.MakePrimitiveRecord(invoiceErrorType, &message),
+	)
+}
+
+// DecodeInvoiceError deserializes an invoice error.
+func DecodeInvoiceError(b []byte) (*InvoiceError, error) {
+	var (
+		e       InvoiceError
+		message []byte
+	)
+
+	err := decodeRecords(
+		b, []tlv.Type{requestIDType},
+		tlv.MakePrimitiveRecord(requestIDType, &e.RequestID),
+		tlv.MakePrimitiveRecord(invoiceErrorType, &message),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Message = string(message)
+
+	return &e, nil
+}
+
+// encodeRecords serializes the records as a tlv stream.
+func encodeRecords(records ...tlv.Record) ([]byte, error) {
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeRecords deserializes a tlv stream into the records, and checks that
+// all required records are present.
+func decodeRecords(b []byte, required []tlv.Type,
+	records ...tlv.Record) error {
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+
+	for _, typ := range required {
+		if _, ok := parsedTypes[typ]; !ok {
+			return fmt.Errorf("missing record %v", typ)
+		}
+	}
+
+	return nil
+}
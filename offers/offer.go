@@ -0,0 +1,280 @@
+package offers
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/acmutil/bech32"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/tlv"
+)
+
+const (
+	// OfferHRP is the human-readable part of an encoded offer.
+	OfferHRP = "lno"
+
+	// MaxDescriptionSize is the maximum size of the description of an
+	// offer, in bytes.
+	MaxDescriptionSize = 639
+
+	// offerChainType is the record type of the genesis hash of the chain
+	// an offer is valid on.
+	offerChainType tlv.Type = 2
+
+	// offerNonceType is the record type of the nonce of an offer, which
+	// makes the ids of offers with the same terms distinct.
+	offerNonceType tlv.Type = 4
+
+	// offerAmountType is the record type of the amount of an offer.
+	offerAmountType tlv.Type = 8
+
+	// offerDescriptionType is the record type of the description of an
+	// offer.
+	offerDescriptionType tlv.Type = 10
+
+	// offerExpiryType is the record type of the absolute expiry of an
+	// offer, in seconds since the unix epoch.
+	offerExpiryType tlv.Type = 14
+
+	// offerNodeIDType is the record type of the node id of the issuer of
+	// an offer.
+	offerNodeIDType tlv.Type = 22
+)
+
+var (
+	// ErrOfferExpired is returned when an invoice is requested for an
+	// offer whose expiry has passed.
+	ErrOfferExpired = errors.New("offer expired")
+
+	// ErrWrongChain is returned when an offer is valid on another chain
+	// than the one of this node.
+	ErrWrongChain = errors.New("offer is for another chain")
+)
+
+// Offer is a long-lived payment code published by a node. Unlike a payment
+// request, an offer can be paid many times: for every payment, the payer
+// fetches a fresh invoice from the issuer of the offer over onion messages.
+type Offer struct {
+	// Chain is the genesis hash of the chain the offer is valid on.
+	Chain chainhash.Hash
+
+	// Nonce is a random value that makes the id of the offer unique.
+	Nonce [16]byte
+
+	// Amount is the amount to be paid for every invoice of the offer. If
+	// zero, the payer chooses the amount.
+	Amount lnwire.MilliSatoshi
+
+	// Description describes the purpose of a payment to the offer.
+	Description string
+
+	// Expiry is the time after which no more invoices are issued for the
+	// offer. If zero, the offer doesn't expire.
+	Expiry time.Time
+
+	// NodeID is the public key of the node that issues invoices for the
+	// offer.
+	NodeID *btcec.PublicKey
+}
+
+// NewOffer creates a new offer of the given node with a random nonce.
+func NewOffer(chain chainhash.Hash, nodeID *btcec.PublicKey,
+	amt lnwire.MilliSatoshi, description string,
+	expiry time.Time) (*Offer, error) {
+
+	if len(description) > MaxDescriptionSize {
+		return nil, fmt.Errorf("description of %v bytes exceeds "+
+			"maximum of %v bytes", len(description),
+			MaxDescriptionSize)
+	}
+
+	offer := &Offer{
+		Chain:       chain,
+		Amount:      amt,
+		Description: description,
+		Expiry:      expiry,
+		NodeID:      nodeID,
+	}
+	if _, err := rand.Read(offer.Nonce[:]); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// ID returns the id of the offer, which is the sha256 hash of its
+// serialization.
+func (o *Offer) ID() ([32]byte, error) {
+	var b bytes.Buffer
+	if err := o.encodeTLV(&b); err != nil {
+		return [32]byte{}, err
+	}
+
+	return sha256.Sum256(b.Bytes()), nil
+}
+
+// IsExpired returns whether the expiry of the offer has passed at the given
+// time.
+func (o *Offer) IsExpired(now time.Time) bool {
+	return !o.Expiry.IsZero() && !now.Before(o.Expiry)
+}
+
+// InvoiceAmount returns the amount of an invoice requested for the offer with
+// the given amount. Offers without amount are paid whatever the payer
+// requests, while the amount of other offers is fixed.
+func (o *Offer) InvoiceAmount(
+	requested lnwire.MilliSatoshi) (lnwire.MilliSatoshi, error) {
+
+	switch {
+	case o.Amount == 0 && requested == 0:
+		return 0, errors.New("amount must be specified for offer " +
+			"without amount")
+
+	case o.Amount != 0 && requested != 0 && requested != o.Amount:
+		return 0, fmt.Errorf("amount %v doesn't match amount %v of "+
+			"offer", requested, o.Amount)
+
+	case o.Amount != 0:
+		return o.Amount, nil
+
+	default:
+		return requested, nil
+	}
+}
+
+// Encode returns the bech32 encoding of the offer.
+func (o *Offer) Encode() (string, error) {
+	var b bytes.Buffer
+	if err := o.encodeTLV(&b); err != nil {
+		return "", err
+	}
+
+	data, err := bech32.ConvertBits(b.Bytes(), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	return bech32.Encode(OfferHRP, data)
+}
+
+// encodeTLV writes the records of the offer to w. Optional records are only
+// written when set.
+func (o *Offer) encodeTLV(w io.Writer) error {
+	var (
+		chain       = [32]byte(o.Chain)
+		amount      = uint64(o.Amount)
+		description = []byte(o.Description)
+		expiry      = uint64(o.Expiry.Unix())
+	)
+
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(offerChainType, &chain),
+		tlv.MakeStaticRecord(
+			offerNonceType, &o.Nonce, 16, encodeNonce, decodeNonce,
+		),
+	}
+	if o.Amount != 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			offerAmountType, &amount,
+		))
+	}
+	if len(description) != 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			offerDescriptionType, &description,
+		))
+	}
+	if !o.Expiry.IsZero() {
+		records = append(records, tlv.MakePrimitiveRecord(
+			offerExpiryType, &expiry,
+		))
+	}
+	records = append(records, tlv.MakePrimitiveRecord(
+		offerNodeIDType, &o.NodeID,
+	))
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// DecodeOffer decodes a bech32 encoded offer.
+func DecodeOffer(offer string) (*Offer, error) {
+	hrp, data, err := decodeBech32(offer)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != OfferHRP {
+		return nil, fmt.Errorf("invalid offer prefix %v", hrp)
+	}
+
+	raw, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		o           Offer
+		chain       [32]byte
+		amount      uint64
+		description []byte
+		expiry      uint64
+	)
+	err = decodeRecords(
+		raw, []tlv.Type{offerChainType, offerNonceType, offerNodeIDType},
+		tlv.MakePrimitiveRecord(offerChainType, &chain),
+		tlv.MakeStaticRecord(
+			offerNonceType, &o.Nonce, 16, encodeNonce, decodeNonce,
+		),
+		tlv.MakePrimitiveRecord(offerAmountType, &amount),
+		tlv.MakePrimitiveRecord(offerDescriptionType, &description),
+		tlv.MakePrimitiveRecord(offerExpiryType, &expiry),
+		tlv.MakePrimitiveRecord(offerNodeIDType, &o.NodeID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offer: %v", err)
+	}
+	if len(description) > MaxDescriptionSize {
+		return nil, fmt.Errorf("description of %v bytes exceeds "+
+			"maximum of %v bytes", len(description),
+			MaxDescriptionSize)
+	}
+
+	o.Chain = chainhash.Hash(chain)
+	o.Amount = lnwire.MilliSatoshi(amount)
+	o.Description = string(description)
+	if expiry != 0 {
+		o.Expiry = time.Unix(int64(expiry), 0)
+	}
+
+	return &o, nil
+}
+
+// encodeNonce is a tlv encoder for the 16 byte nonce of an offer.
+func encodeNonce(w io.Writer, val interface{}, _ *[8]byte) error {
+	if v, ok := val.(*[16]byte); ok {
+		_, err := w.Write(v[:])
+		return err
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "[16]byte")
+}
+
+// decodeNonce is a tlv decoder for the 16 byte nonce of an offer.
+func decodeNonce(r io.Reader, val interface{}, _ *[8]byte, l uint64) error {
+	if v, ok := val.(*[16]byte); ok && l == 16 {
+		_, err := io.ReadFull(r, v[:])
+		return err
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "[16]byte", l, 16)
+}
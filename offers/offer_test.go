@@ -0,0 +1,106 @@
+package offers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/lnd/lnwire"
+)
+
+// TestOfferEncoding tests that offers survive an encoding round trip, and
+// that their id commits to their terms.
+func TestOfferEncoding(t *testing.T) {
+	t.Parallel()
+
+	nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	testCases := []struct {
+		name        string
+		amt         lnwire.MilliSatoshi
+		description string
+		expiry      time.Time
+	}{
+		{
+			name:        "all fields",
+			amt:         1000,
+			description: "coffee",
+			expiry:      time.Unix(1700000000, 0),
+		},
+		{
+			name: "no optional fields",
+		},
+	}
+
+	for _, test := range testCases {
+		offer, err := NewOffer(
+			*chaincfg.MainNetParams.GenesisHash, nodeKey.PubKey(),
+			test.amt, test.description, test.expiry,
+		)
+		if err != nil {
+			t.Fatalf("%v: unable to create offer: %v", test.name,
+				err)
+		}
+
+		encoded, err := offer.Encode()
+		if err != nil {
+			t.Fatalf("%v: unable to encode offer: %v", test.name,
+				err)
+		}
+
+		decoded, err := DecodeOffer(encoded)
+		if err != nil {
+			t.Fatalf("%v: unable to decode offer: %v", test.name,
+				err)
+		}
+		if !reflect.DeepEqual(offer, decoded) {
+			t.Fatalf("%v: expected offer %v, got %v", test.name,
+				offer, decoded)
+		}
+
+		id, err := offer.ID()
+		if err != nil {
+			t.Fatalf("%v: unable to compute id: %v", test.name, err)
+		}
+		decoded.Amount++
+		decodedID, err := decoded.ID()
+		if err != nil {
+			t.Fatalf("%v: unable to compute id: %v", test.name, err)
+		}
+		if id == decodedID {
+			t.Fatalf("%v: expected id to change with amount",
+				test.name)
+		}
+	}
+}
+
+// TestInvoiceRequestEncoding tests that invoice requests survive an encoding
+// round trip.
+func TestInvoiceRequestEncoding(t *testing.T) {
+	t.Parallel()
+
+	req := &InvoiceRequest{
+		RequestID: [32]byte{1},
+		OfferID:   [32]byte{2},
+		Amount:    5000,
+		PayerNote: "thanks",
+	}
+
+	b, err := req.Encode()
+	if err != nil {
+		t.Fatalf("unable to encode invoice request: %v", err)
+	}
+
+	decoded, err := DecodeInvoiceRequest(b)
+	if err != nil {
+		t.Fatalf("unable to decode invoice request: %v", err)
+	}
+	if !reflect.DeepEqual(req, decoded) {
+		t.Fatalf("expected invoice request %v, got %v", req, decoded)
+	}
+}
@@ -0,0 +1,332 @@
+package offers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Actinium-project/acmd/btcec"
+	sphinx "github.com/Actinium-project/lightning-onion"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/tlv"
+)
+
+const (
+	// replyPathType is the record type of the path along which the final
+	// hop of an onion message can reply to its sender.
+	replyPathType tlv.Type = 2
+
+	// nextNodeType is the record type of the node an intermediate hop
+	// passes an onion message on to.
+	nextNodeType tlv.Type = 4
+
+	// onionInvoiceRequestType is the record type of an invoice request
+	// delivered to the final hop of an onion message.
+	onionInvoiceRequestType tlv.Type = 64
+
+	// onionInvoiceType is the record type of an invoice delivered to the
+	// final hop of an onion message.
+	onionInvoiceType tlv.Type = 66
+
+	// onionInvoiceErrorType is the record type of an invoice error
+	// delivered to the final hop of an onion message.
+	onionInvoiceErrorType tlv.Type = 68
+)
+
+var (
+	// ErrEmptyPath is returned when an onion message is to be sent along
+	// a path without any hops.
+	ErrEmptyPath = errors.New("onion message path is empty")
+)
+
+// onionPayload is the payload of an onion message for a single hop.
+// Intermediate hops only learn the next node, while the final hop receives an
+// offers message and optionally a path to reply along.
+type onionPayload struct {
+	// nextNode is the node an intermediate hop passes the message on to.
+	nextNode *btcec.PublicKey
+
+	// replyPath is the path along which the final hop can reply to the
+	// sender. Its last hop is the sender.
+	replyPath []*btcec.PublicKey
+
+	// invoiceRequest is a serialized invoice request.
+	invoiceRequest []byte
+
+	// invoice is a serialized invoice.
+	invoice []byte
+
+	// invoiceError is a serialized invoice error.
+	invoiceError []byte
+}
+
+// encode serializes the payload as a tlv stream.
+func (p *onionPayload) encode() ([]byte, error) {
+	var records []tlv.Record
+
+	if len(p.replyPath) != 0 {
+		var replyPath []byte
+		for _, hop := range p.replyPath {
+			replyPath = append(
+				replyPath, hop.SerializeCompressed()...,
+			)
+		}
+		records = append(records, tlv.MakePrimitiveRecord(
+			replyPathType, &replyPath,
+		))
+	}
+	if p.nextNode != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			nextNodeType, &p.nextNode,
+		))
+	}
+	if p.invoiceRequest != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			onionInvoiceRequestType, &p.invoiceRequest,
+		))
+	}
+	if p.invoice != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			onionInvoiceType, &p.invoice,
+		))
+	}
+	if p.invoiceError != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			onionInvoiceErrorType, &p.invoiceError,
+		))
+	}
+
+	return encodeRecords(records...)
+}
+
+// decodeOnionPayload deserializes the payload of an onion message.
+func decodeOnionPayload(b []byte) (*onionPayload, error) {
+	var (
+		p         onionPayload
+		replyPath []byte
+	)
+
+	err := decodeRecords(
+		b, nil,
+		tlv.MakePrimitiveRecord(replyPathType, &replyPath),
+		tlv.MakePrimitiveRecord(nextNodeType, &p.nextNode),
+		tlv.MakePrimitiveRecord(
+			onionInvoiceRequestType, &p.invoiceRequest,
+		),
+		tlv.MakePrimitiveRecord(onionInvoiceType, &p.invoice),
+		tlv.MakePrimitiveRecord(onionInvoiceErrorType, &p.invoiceError),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(replyPath)%33 != 0 {
+		return nil, fmt.Errorf("invalid reply path length %v",
+			len(replyPath))
+	}
+	for i := 0; i < len(replyPath); i += 33 {
+		hop, err := btcec.ParsePubKey(replyPath[i:i+33], btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+		p.replyPath = append(p.replyPath, hop)
+	}
+
+	return &p, nil
+}
+
+// buildOnionMessage creates an onion message that is routed along the path,
+// delivering the payload to its last hop. The first hop of the path is the
+// peer the message is to be sent to.
+func buildOnionMessage(path []*btcec.PublicKey,
+	payload *onionPayload) (*lnwire.OnionMessage, error) {
+
+	if len(path) == 0 {
+		return nil, ErrEmptyPath
+	}
+	if len(path) > sphinx.NumMaxHops {
+		return nil, fmt.Errorf("onion message path of %v hops "+
+			"exceeds maximum of %v hops", len(path),
+			sphinx.NumMaxHops)
+	}
+
+	var sphinxPath sphinx.PaymentPath
+	for i, hop := range path {
+		hopPayload := payload
+		if i != len(path)-1 {
+			hopPayload = &onionPayload{nextNode: path[i+1]}
+		}
+
+		b, err := hopPayload.encode()
+		if err != nil {
+			return nil, err
+		}
+
+		sphinxPayload, err := sphinx.NewHopPayload(nil, b)
+		if err != nil {
+			return nil, err
+		}
+
+		sphinxPath[i] = sphinx.OnionHop{
+			NodePub:    *hop,
+			HopPayload: sphinxPayload,
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	// Onion messages aren't bound to an HTLC, so there's no associated
+	// data to commit to.
+	packet, err := sphinx.NewOnionPacket(
+		&sphinxPath, sessionKey, nil, sphinx.DeterministicPacketFiller,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := packet.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	msg := lnwire.NewOnionMessage()
+	copy(msg.OnionBlob[:], b.Bytes())
+
+	return msg, nil
+}
+
+// replayLog is an in-memory sphinx.ReplayLog that rejects onion messages
+// whose shared secret has been seen before. Onion messages don't expire like
+// the onion packets of HTLCs do, so the log remembers the most recent
+// messages instead. It keeps two generations of entries, and drops the older
+// one once the current one is full, which bounds its memory use to twice the
+// capacity.
+type replayLog struct {
+	mu sync.Mutex
+
+	// capacity is the number of entries per generation.
+	capacity int
+
+	// current and previous are the hash prefixes of the messages seen in
+	// the current and previous generation.
+	current  map[sphinx.HashPrefix]struct{}
+	previous map[sphinx.HashPrefix]struct{}
+}
+
+// A compile time check to ensure replayLog implements the sphinx.ReplayLog
+// interface.
+var _ sphinx.ReplayLog = (*replayLog)(nil)
+
+// newReplayLog creates a replay log that remembers at least the given number
+// of messages.
+func newReplayLog(capacity int) *replayLog {
+	return &replayLog{
+		capacity: capacity,
+		current:  make(map[sphinx.HashPrefix]struct{}),
+		previous: make(map[sphinx.HashPrefix]struct{}),
+	}
+}
+
+// Start is a no-op.
+func (l *replayLog) Start() error {
+	return nil
+}
+
+// Stop is a no-op.
+func (l *replayLog) Stop() error {
+	return nil
+}
+
+// Get returns sphinx.ErrLogEntryNotFound if the hash prefix hasn't been seen
+// before.
+func (l *replayLog) Get(hash *sphinx.HashPrefix) (uint32, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.contains(hash) {
+		return 0, sphinx.ErrLogEntryNotFound
+	}
+
+	return 0, nil
+}
+
+// Put records the hash prefix, returning sphinx.ErrReplayedPacket if it has
+// been seen before.
+func (l *replayLog) Put(hash *sphinx.HashPrefix, _ uint32) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.put(hash)
+}
+
+// Delete removes the hash prefix from the log.
+func (l *replayLog) Delete(hash *sphinx.HashPrefix) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.current, *hash)
+	delete(l.previous, *hash)
+
+	return nil
+}
+
+// PutBatch records the hash prefixes of the batch, returning the set of
+// entries that are replays.
+func (l *replayLog) PutBatch(batch *sphinx.Batch) (*sphinx.ReplaySet, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	replays := sphinx.NewReplaySet()
+	err := batch.ForEach(func(seqNum uint16, hash *sphinx.HashPrefix,
+		_ uint32) error {
+
+		if l.put(hash) == sphinx.ErrReplayedPacket {
+			replays.Add(seqNum)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	replays.Merge(batch.ReplaySet)
+	batch.ReplaySet = replays
+	batch.IsCommitted = true
+
+	return replays, nil
+}
+
+// contains returns true if the hash prefix is in either generation.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (l *replayLog) contains(hash *sphinx.HashPrefix) bool {
+	if _, ok := l.current[*hash]; ok {
+		return true
+	}
+	_, ok := l.previous[*hash]
+
+	return ok
+}
+
+// put records the hash prefix in the current generation, starting a new
+// generation if the current one is full.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (l *replayLog) put(hash *sphinx.HashPrefix) error {
+	if l.contains(hash) {
+		return sphinx.ErrReplayedPacket
+	}
+
+	if len(l.current) >= l.capacity {
+		l.previous = l.current
+		l.current = make(map[sphinx.HashPrefix]struct{})
+	}
+	l.current[*hash] = struct{}{}
+
+	return nil
+}
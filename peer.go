@@ -13,7 +13,6 @@ import (
 	"github.com/Actinium-project/acmd/btcec"
 	"github.com/Actinium-project/acmd/chaincfg/chainhash"
 	"github.com/Actinium-project/acmd/connmgr"
-	"github.com/Actinium-project/acmd/txscript"
 	"github.com/Actinium-project/acmd/wire"
 	"github.com/davecgh/go-spew/spew"
 
@@ -28,6 +27,7 @@ import (
 	"github.com/Actinium-project/lnd/lnwallet"
 	"github.com/Actinium-project/lnd/lnwire"
 	"github.com/Actinium-project/lnd/pool"
+	"github.com/Actinium-project/lnd/routing/route"
 	"github.com/Actinium-project/lnd/ticker"
 )
 
@@ -519,7 +519,7 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) (
 		// particular channel.
 		var selfPolicy *channeldb.ChannelEdgePolicy
 		if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
-			p.server.identityPriv.PubKey().SerializeCompressed()) {
+			p.server.identityECDH.PubKey().SerializeCompressed()) {
 
 			selfPolicy = p1
 		} else {
@@ -1150,6 +1150,15 @@ out:
 
 			discStream.AddMsg(msg)
 
+		case *lnwire.OnionMessage:
+			err := p.server.offerManager.HandleOnionMessage(
+				route.Vertex(p.PubKey()), msg,
+			)
+			if err != nil {
+				peerLog.Debugf("Unable to handle onion message "+
+					"from %v: %v", p, err)
+			}
+
 		default:
 			peerLog.Errorf("unknown message %v received from peer "+
 				"%v", uint16(msg.MsgType()), p)
@@ -1739,18 +1748,20 @@ func (p *peer) ChannelSnapshots() []*channeldb.ChannelSnapshot {
 }
 
 // genDeliveryScript returns a new script to be used to send our funds to in
-// the case of a cooperative channel close negotiation.
-func (p *peer) genDeliveryScript() ([]byte, error) {
-	deliveryAddr, err := p.server.cc.wallet.NewAddress(
-		lnwallet.WitnessPubKey, false,
+// the case of a cooperative close negotiation of the channel with the given
+// channel point. This is either the configured delivery destination, or a
+// fresh address of the wallet.
+func (p *peer) genDeliveryScript(chanPoint wire.OutPoint) ([]byte, error) {
+	deliveryScript, err := p.server.deliveryScripts.channelScript(
+		chanPoint,
 	)
 	if err != nil {
 		return nil, err
 	}
-	peerLog.Infof("Delivery addr for channel close: %v",
-		deliveryAddr)
+	peerLog.Infof("Delivery script for channel close: %x",
+		deliveryScript)
 
-	return txscript.PayToAddrScript(deliveryAddr)
+	return deliveryScript, nil
 }
 
 // channelManager is goroutine dedicated to handling all requests/signals
@@ -2081,7 +2092,9 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 		deliveryScript := channel.LocalUpfrontShutdownScript()
 		if len(deliveryScript) == 0 {
 			var err error
-			deliveryScript, err = p.genDeliveryScript()
+			deliveryScript, err = p.genDeliveryScript(
+				*channel.ChannelPoint(),
+			)
 			if err != nil {
 				peerLog.Errorf("unable to gen delivery script: %v", err)
 				return nil, fmt.Errorf("close addr unavailable")
@@ -2200,7 +2213,7 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 		// If neither an upfront address or a user set address was
 		// provided, generate a fresh script.
 		if len(deliveryScript) == 0 {
-			deliveryScript, err = p.genDeliveryScript()
+			deliveryScript, err = p.genDeliveryScript(*req.ChanPoint)
 			if err != nil {
 				peerLog.Errorf(err.Error())
 				req.Err <- err
@@ -19,10 +19,10 @@ import (
 
 var (
 	// p2SHAddress is a valid pay to script hash address.
-	p2SHAddress = "2NBFNJTktNa7GZusGbDbGKRZTxdK9VVez3n"
+	p2SHAddress = "bWjR1QfuZYg1noPesectVWM96diSc89uPB"
 
 	// p2wshAddress is a valid pay to witness script hash address.
-	p2wshAddress = "bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3"
+	p2wshAddress = "acm1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qa8dcsw"
 
 	// timeout is a timeout value to use for tests which need ot wait for
 	// a return value on a channel.
@@ -0,0 +1,279 @@
+package psbt
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/Actinium-project/acmd/txscript"
+	"github.com/Actinium-project/acmd/wire"
+)
+
+// InputUtxo returns the output spent by the input with the given index, taken
+// from either its witness or non-witness UTXO field.
+func (p *Packet) InputUtxo(index int) (*wire.TxOut, error) {
+	if index < 0 || index >= len(p.Inputs) {
+		return nil, fmt.Errorf("input index %d out of range", index)
+	}
+
+	pInput := &p.Inputs[index]
+	switch {
+	case pInput.WitnessUtxo != nil:
+		return pInput.WitnessUtxo, nil
+
+	case pInput.NonWitnessUtxo != nil:
+		prevOut := p.UnsignedTx.TxIn[index].PreviousOutPoint
+		if pInput.NonWitnessUtxo.TxHash() != prevOut.Hash ||
+			int(prevOut.Index) >= len(pInput.NonWitnessUtxo.TxOut) {
+
+			return nil, fmt.Errorf("non-witness utxo of input %d "+
+				"doesn't match its outpoint", index)
+		}
+
+		return pInput.NonWitnessUtxo.TxOut[prevOut.Index], nil
+
+	default:
+		return nil, fmt.Errorf("input %d has no utxo information",
+			index)
+	}
+}
+
+// Finalize constructs the final script sig and witness of the input with the
+// given index from its partial signatures. Pay-to-pubkey-hash,
+// pay-to-witness-pubkey-hash and multisig scripts are supported, both native
+// and nested in pay-to-script-hash. Once finalized, the partial signatures and
+// all other fields only needed for signing are removed from the input.
+func Finalize(p *Packet, index int) error {
+	if err := p.SanityCheck(); err != nil {
+		return err
+	}
+
+	utxo, err := p.InputUtxo(index)
+	if err != nil {
+		return err
+	}
+
+	pInput := &p.Inputs[index]
+	if pInput.IsFinalized() {
+		return nil
+	}
+
+	pkScript := utxo.PkScript
+	class := txscript.GetScriptClass(pkScript)
+
+	// For nested outputs, the script sig pushes the redeem script, which
+	// is what is actually being spent.
+	var scriptSig []byte
+	if class == txscript.ScriptHashTy {
+		if pInput.RedeemScript == nil {
+			return fmt.Errorf("input %d is missing its redeem "+
+				"script", index)
+		}
+
+		scriptSig, err = txscript.NewScriptBuilder().
+			AddData(pInput.RedeemScript).Script()
+		if err != nil {
+			return err
+		}
+
+		pkScript = pInput.RedeemScript
+		class = txscript.GetScriptClass(pkScript)
+	}
+
+	var witness wire.TxWitness
+	switch class {
+	case txscript.WitnessV0PubKeyHashTy:
+		if len(pInput.PartialSigs) != 1 {
+			return fmt.Errorf("input %d needs exactly one "+
+				"signature, has %d", index,
+				len(pInput.PartialSigs))
+		}
+
+		sig := pInput.PartialSigs[0]
+		witness = wire.TxWitness{sig.Signature, sig.PubKey}
+
+	case txscript.PubKeyHashTy:
+		if len(pInput.PartialSigs) != 1 {
+			return fmt.Errorf("input %d needs exactly one "+
+				"signature, has %d", index,
+				len(pInput.PartialSigs))
+		}
+
+		sig := pInput.PartialSigs[0]
+		scriptSig, err = txscript.NewScriptBuilder().
+			AddData(sig.Signature).AddData(sig.PubKey).Script()
+		if err != nil {
+			return err
+		}
+
+	case txscript.MultiSigTy:
+		sigs, err := multiSigSignatures(pInput, pkScript)
+		if err != nil {
+			return fmt.Errorf("input %d: %v", index, err)
+		}
+
+		// The multisig script sig pushes a dummy element first,
+		// followed by the signatures and the redeem script.
+		builder := txscript.NewScriptBuilder().AddOp(txscript.OP_0)
+		for _, sig := range sigs {
+			builder.AddData(sig)
+		}
+		scriptSig, err = builder.AddData(pkScript).Script()
+		if err != nil {
+			return err
+		}
+
+	case txscript.WitnessV0ScriptHashTy:
+		if pInput.WitnessScript == nil {
+			return fmt.Errorf("input %d is missing its witness "+
+				"script", index)
+		}
+		if txscript.GetScriptClass(pInput.WitnessScript) !=
+			txscript.MultiSigTy {
+
+			return fmt.Errorf("input %d has unsupported witness "+
+				"script", index)
+		}
+
+		sigs, err := multiSigSignatures(pInput, pInput.WitnessScript)
+		if err != nil {
+			return fmt.Errorf("input %d: %v", index, err)
+		}
+
+		witness = append(witness, nil)
+		witness = append(witness, sigs...)
+		witness = append(witness, pInput.WitnessScript)
+
+	default:
+		return fmt.Errorf("input %d has unsupported script type %v",
+			index, class)
+	}
+
+	if witness != nil {
+		var witnessBuf bytes.Buffer
+		if err := writeWitness(&witnessBuf, witness); err != nil {
+			return err
+		}
+		pInput.FinalScriptWitness = witnessBuf.Bytes()
+	}
+	pInput.FinalScriptSig = scriptSig
+
+	pInput.PartialSigs = nil
+	pInput.SighashType = 0
+	pInput.RedeemScript = nil
+	pInput.WitnessScript = nil
+	pInput.Bip32Derivation = nil
+
+	return nil
+}
+
+// MaybeFinalizeAll attempts to finalize all inputs of the packet.
+func MaybeFinalizeAll(p *Packet) error {
+	for i := range p.Inputs {
+		if err := Finalize(p, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Extract returns the final, fully signed transaction of a packet whose
+// inputs are all finalized.
+func Extract(p *Packet) (*wire.MsgTx, error) {
+	if err := p.SanityCheck(); err != nil {
+		return nil, err
+	}
+
+	finalTx := p.UnsignedTx.Copy()
+	for i, pInput := range p.Inputs {
+		if !pInput.IsFinalized() {
+			return nil, fmt.Errorf("%w: input %d", ErrNotFinalized, i)
+		}
+
+		finalTx.TxIn[i].SignatureScript = pInput.FinalScriptSig
+		if pInput.FinalScriptWitness == nil {
+			continue
+		}
+
+		witness, err := readWitness(pInput.FinalScriptWitness)
+		if err != nil {
+			return nil, err
+		}
+		finalTx.TxIn[i].Witness = witness
+	}
+
+	return finalTx, nil
+}
+
+// multiSigSignatures returns the partial signatures of the input in the order
+// of the public keys of the given multisig script. Exactly the number of
+// signatures the script requires are returned.
+func multiSigSignatures(pInput *PInput, script []byte) ([][]byte, error) {
+	_, numSigs, err := txscript.CalcMultiSigStats(script)
+	if err != nil {
+		return nil, err
+	}
+
+	pushes, err := txscript.PushedData(script)
+	if err != nil {
+		return nil, err
+	}
+
+	var sigs [][]byte
+	for _, pubKey := range pushes {
+		for _, sig := range pInput.PartialSigs {
+			if !bytes.Equal(sig.PubKey, pubKey) {
+				continue
+			}
+
+			sigs = append(sigs, sig.Signature)
+			break
+		}
+
+		if len(sigs) == numSigs {
+			return sigs, nil
+		}
+	}
+
+	return nil, fmt.Errorf("multisig needs %d signatures, has %d",
+		numSigs, len(sigs))
+}
+
+// writeWitness writes the serialization of a witness stack to buf.
+func writeWitness(buf *bytes.Buffer, witness wire.TxWitness) error {
+	err := wire.WriteVarInt(buf, 0, uint64(len(witness)))
+	if err != nil {
+		return err
+	}
+	for _, item := range witness {
+		if err := wire.WriteVarBytes(buf, 0, item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readWitness parses a serialized witness stack.
+func readWitness(b []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(b)
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	if count > maxPsbtKeyValueLength {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	witness := make(wire.TxWitness, count)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(
+			r, 0, maxPsbtKeyValueLength, "witness item",
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return witness, nil
+}
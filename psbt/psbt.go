@@ -0,0 +1,694 @@
+// Package psbt implements the Partially Signed Bitcoin Transaction format as
+// defined in BIP 174, for the transactions of the acmd wire package.
+package psbt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Actinium-project/acmd/txscript"
+	"github.com/Actinium-project/acmd/wire"
+)
+
+// psbtMagic is the magic sequence every serialized packet starts with.
+var psbtMagic = [5]byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+// maxPsbtKeyValueLength is the maximum length of a single key or value within
+// a packet.
+const maxPsbtKeyValueLength = 4000000
+
+// The key types of the global map.
+const (
+	globalUnsignedTxType = 0x00
+)
+
+// The key types of the input maps.
+const (
+	inputNonWitnessUtxoType     = 0x00
+	inputWitnessUtxoType        = 0x01
+	inputPartialSigType         = 0x02
+	inputSighashType            = 0x03
+	inputRedeemScriptType       = 0x04
+	inputWitnessScriptType      = 0x05
+	inputBip32DerivationType    = 0x06
+	inputFinalScriptSigType     = 0x07
+	inputFinalScriptWitnessType = 0x08
+)
+
+// The key types of the output maps.
+const (
+	outputRedeemScriptType    = 0x00
+	outputWitnessScriptType   = 0x01
+	outputBip32DerivationType = 0x02
+)
+
+var (
+	// ErrInvalidMagic is returned if a packet doesn't start with the PSBT
+	// magic bytes.
+	ErrInvalidMagic = errors.New("invalid psbt magic bytes")
+
+	// ErrDuplicateKey is returned if a key appears more than once within
+	// the same map.
+	ErrDuplicateKey = errors.New("duplicate key in psbt map")
+
+	// ErrInvalidPsbtFormat is returned if a packet is malformed.
+	ErrInvalidPsbtFormat = errors.New("invalid psbt format")
+
+	// ErrNotFinalized is returned when extracting the final transaction of
+	// a packet whose inputs aren't all finalized.
+	ErrNotFinalized = errors.New("psbt input not finalized")
+)
+
+// Unknown is a key-value pair of a type this package doesn't interpret. It is
+// kept so it survives a round trip through the package.
+type Unknown struct {
+	Key   []byte
+	Value []byte
+}
+
+// PartialSig is a signature for an input, along with the public key it can be
+// verified with.
+type PartialSig struct {
+	// PubKey is the serialized public key of the signature.
+	PubKey []byte
+
+	// Signature is the DER encoded signature, with the sighash type
+	// appended.
+	Signature []byte
+}
+
+// Bip32Derivation describes how the private key of a public key is derived
+// from a master key.
+type Bip32Derivation struct {
+	// PubKey is the serialized public key.
+	PubKey []byte
+
+	// MasterKeyFingerprint is the fingerprint of the master key.
+	MasterKeyFingerprint uint32
+
+	// Bip32Path is the derivation path of the key, with the hardened
+	// offset applied to hardened indexes.
+	Bip32Path []uint32
+}
+
+// PInput holds the information about an input of a packet.
+type PInput struct {
+	NonWitnessUtxo     *wire.MsgTx
+	WitnessUtxo        *wire.TxOut
+	PartialSigs        []*PartialSig
+	SighashType        txscript.SigHashType
+	RedeemScript       []byte
+	WitnessScript      []byte
+	Bip32Derivation    []*Bip32Derivation
+	FinalScriptSig     []byte
+	FinalScriptWitness []byte
+	Unknowns           []*Unknown
+}
+
+// IsFinalized returns true if the final script sig or witness of the input has
+// been set.
+func (pi *PInput) IsFinalized() bool {
+	return pi.FinalScriptSig != nil || pi.FinalScriptWitness != nil
+}
+
+// POutput holds the information about an output of a packet.
+type POutput struct {
+	RedeemScript    []byte
+	WitnessScript   []byte
+	Bip32Derivation []*Bip32Derivation
+	Unknowns        []*Unknown
+}
+
+// Packet is a partially signed transaction.
+type Packet struct {
+	// UnsignedTx is the transaction being signed. Its inputs must not
+	// carry any signature scripts or witnesses.
+	UnsignedTx *wire.MsgTx
+
+	// Inputs holds the information about each input of the transaction.
+	Inputs []PInput
+
+	// Outputs holds the information about each output of the transaction.
+	Outputs []POutput
+
+	// Unknowns are the global key-value pairs of unknown type.
+	Unknowns []*Unknown
+}
+
+// New creates a packet for the given unsigned transaction, with empty input
+// and output information.
+func New(tx *wire.MsgTx) (*Packet, error) {
+	for _, txIn := range tx.TxIn {
+		if len(txIn.SignatureScript) != 0 || len(txIn.Witness) != 0 {
+			return nil, fmt.Errorf("%w: unsigned transaction has "+
+				"signed inputs", ErrInvalidPsbtFormat)
+		}
+	}
+
+	return &Packet{
+		UnsignedTx: tx,
+		Inputs:     make([]PInput, len(tx.TxIn)),
+		Outputs:    make([]POutput, len(tx.TxOut)),
+	}, nil
+}
+
+// NewFromRawBytes parses a packet from its binary serialization, or from its
+// base64 encoding if b64 is true.
+func NewFromRawBytes(r io.Reader, b64 bool) (*Packet, error) {
+	if b64 {
+		r = base64.NewDecoder(base64.StdEncoding, r)
+	}
+
+	var magic [5]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return nil, err
+	}
+	if magic != psbtMagic {
+		return nil, ErrInvalidMagic
+	}
+
+	// The global map must contain the unsigned transaction, which tells
+	// us how many input and output maps follow.
+	var (
+		unsignedTx *wire.MsgTx
+		unknowns   []*Unknown
+	)
+	err := readMap(r, func(keyType byte, keyData, value []byte) error {
+		switch keyType {
+		case globalUnsignedTxType:
+			if len(keyData) != 0 || unsignedTx != nil {
+				return ErrInvalidPsbtFormat
+			}
+
+			unsignedTx = wire.NewMsgTx(wire.TxVersion)
+			err := unsignedTx.DeserializeNoWitness(
+				bytes.NewReader(value),
+			)
+			if err != nil {
+				return err
+			}
+
+		default:
+			unknowns = append(unknowns, newUnknown(keyType, keyData, value))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if unsignedTx == nil {
+		return nil, fmt.Errorf("%w: missing unsigned transaction",
+			ErrInvalidPsbtFormat)
+	}
+
+	p, err := New(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	p.Unknowns = unknowns
+
+	for i := range p.Inputs {
+		if err := p.Inputs[i].deserialize(r); err != nil {
+			return nil, err
+		}
+	}
+	for i := range p.Outputs {
+		if err := p.Outputs[i].deserialize(r); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// Serialize writes the binary serialization of the packet to w.
+func (p *Packet) Serialize(w io.Writer) error {
+	if _, err := w.Write(psbtMagic[:]); err != nil {
+		return err
+	}
+
+	var txBuf bytes.Buffer
+	if err := p.UnsignedTx.SerializeNoWitness(&txBuf); err != nil {
+		return err
+	}
+	err := writeKeyValue(w, globalUnsignedTxType, nil, txBuf.Bytes())
+	if err != nil {
+		return err
+	}
+	if err := writeUnknowns(w, p.Unknowns); err != nil {
+		return err
+	}
+	if err := writeSeparator(w); err != nil {
+		return err
+	}
+
+	for i := range p.Inputs {
+		if err := p.Inputs[i].serialize(w); err != nil {
+			return err
+		}
+	}
+	for i := range p.Outputs {
+		if err := p.Outputs[i].serialize(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// B64Encode returns the base64 encoding of the packet's serialization.
+func (p *Packet) B64Encode() (string, error) {
+	var b bytes.Buffer
+	if err := p.Serialize(&b); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(b.Bytes()), nil
+}
+
+// SanityCheck makes sure the packet has an input and output map for each
+// input and output of its unsigned transaction.
+func (p *Packet) SanityCheck() error {
+	if p.UnsignedTx == nil {
+		return fmt.Errorf("%w: missing unsigned transaction",
+			ErrInvalidPsbtFormat)
+	}
+	if len(p.Inputs) != len(p.UnsignedTx.TxIn) ||
+		len(p.Outputs) != len(p.UnsignedTx.TxOut) {
+
+		return fmt.Errorf("%w: number of input or output maps doesn't "+
+			"match transaction", ErrInvalidPsbtFormat)
+	}
+
+	return nil
+}
+
+// deserialize reads the input map from r.
+func (pi *PInput) deserialize(r io.Reader) error {
+	return readMap(r, func(keyType byte, keyData, value []byte) error {
+		switch keyType {
+		case inputNonWitnessUtxoType:
+			if len(keyData) != 0 || pi.NonWitnessUtxo != nil {
+				return ErrInvalidPsbtFormat
+			}
+
+			tx := wire.NewMsgTx(wire.TxVersion)
+			if err := tx.Deserialize(bytes.NewReader(value)); err != nil {
+				return err
+			}
+			pi.NonWitnessUtxo = tx
+
+		case inputWitnessUtxoType:
+			if len(keyData) != 0 || pi.WitnessUtxo != nil {
+				return ErrInvalidPsbtFormat
+			}
+
+			txOut, err := readTxOut(value)
+			if err != nil {
+				return err
+			}
+			pi.WitnessUtxo = txOut
+
+		case inputPartialSigType:
+			if !validPubKeyLength(keyData) {
+				return ErrInvalidPsbtFormat
+			}
+
+			pi.PartialSigs = append(pi.PartialSigs, &PartialSig{
+				PubKey:    keyData,
+				Signature: value,
+			})
+
+		case inputSighashType:
+			if len(keyData) != 0 || len(value) != 4 ||
+				pi.SighashType != 0 {
+
+				return ErrInvalidPsbtFormat
+			}
+
+			pi.SighashType = txscript.SigHashType(
+				binary.LittleEndian.Uint32(value),
+			)
+
+		case inputRedeemScriptType:
+			if len(keyData) != 0 || pi.RedeemScript != nil {
+				return ErrInvalidPsbtFormat
+			}
+			pi.RedeemScript = value
+
+		case inputWitnessScriptType:
+			if len(keyData) != 0 || pi.WitnessScript != nil {
+				return ErrInvalidPsbtFormat
+			}
+			pi.WitnessScript = value
+
+		case inputBip32DerivationType:
+			derivation, err := readBip32Derivation(keyData, value)
+			if err != nil {
+				return err
+			}
+			pi.Bip32Derivation = append(
+				pi.Bip32Derivation, derivation,
+			)
+
+		case inputFinalScriptSigType:
+			if len(keyData) != 0 || pi.FinalScriptSig != nil {
+				return ErrInvalidPsbtFormat
+			}
+			pi.FinalScriptSig = value
+
+		case inputFinalScriptWitnessType:
+			if len(keyData) != 0 || pi.FinalScriptWitness != nil {
+				return ErrInvalidPsbtFormat
+			}
+			pi.FinalScriptWitness = value
+
+		default:
+			pi.Unknowns = append(
+				pi.Unknowns, newUnknown(keyType, keyData, value),
+			)
+		}
+
+		return nil
+	})
+}
+
+// serialize writes the input map to w.
+func (pi *PInput) serialize(w io.Writer) error {
+	if pi.NonWitnessUtxo != nil {
+		var txBuf bytes.Buffer
+		if err := pi.NonWitnessUtxo.Serialize(&txBuf); err != nil {
+			return err
+		}
+		err := writeKeyValue(
+			w, inputNonWitnessUtxoType, nil, txBuf.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pi.WitnessUtxo != nil {
+		var txOutBuf bytes.Buffer
+		err := wire.WriteTxOut(&txOutBuf, 0, 0, pi.WitnessUtxo)
+		if err != nil {
+			return err
+		}
+		err = writeKeyValue(
+			w, inputWitnessUtxoType, nil, txOutBuf.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pi.FinalScriptSig == nil && pi.FinalScriptWitness == nil {
+		for _, sig := range pi.PartialSigs {
+			err := writeKeyValue(
+				w, inputPartialSigType, sig.PubKey,
+				sig.Signature,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		if pi.SighashType != 0 {
+			var sighash [4]byte
+			binary.LittleEndian.PutUint32(
+				sighash[:], uint32(pi.SighashType),
+			)
+			err := writeKeyValue(
+				w, inputSighashType, nil, sighash[:],
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		if pi.RedeemScript != nil {
+			err := writeKeyValue(
+				w, inputRedeemScriptType, nil, pi.RedeemScript,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		if pi.WitnessScript != nil {
+			err := writeKeyValue(
+				w, inputWitnessScriptType, nil,
+				pi.WitnessScript,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := writeBip32Derivations(
+			w, inputBip32DerivationType, pi.Bip32Derivation,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pi.FinalScriptSig != nil {
+		err := writeKeyValue(
+			w, inputFinalScriptSigType, nil, pi.FinalScriptSig,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pi.FinalScriptWitness != nil {
+		err := writeKeyValue(
+			w, inputFinalScriptWitnessType, nil,
+			pi.FinalScriptWitness,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := writeUnknowns(w, pi.Unknowns); err != nil {
+		return err
+	}
+
+	return writeSeparator(w)
+}
+
+// deserialize reads the output map from r.
+func (po *POutput) deserialize(r io.Reader) error {
+	return readMap(r, func(keyType byte, keyData, value []byte) error {
+		switch keyType {
+		case outputRedeemScriptType:
+			if len(keyData) != 0 || po.RedeemScript != nil {
+				return ErrInvalidPsbtFormat
+			}
+			po.RedeemScript = value
+
+		case outputWitnessScriptType:
+			if len(keyData) != 0 || po.WitnessScript != nil {
+				return ErrInvalidPsbtFormat
+			}
+			po.WitnessScript = value
+
+		case outputBip32DerivationType:
+			derivation, err := readBip32Derivation(keyData, value)
+			if err != nil {
+				return err
+			}
+			po.Bip32Derivation = append(
+				po.Bip32Derivation, derivation,
+			)
+
+		default:
+			po.Unknowns = append(
+				po.Unknowns, newUnknown(keyType, keyData, value),
+			)
+		}
+
+		return nil
+	})
+}
+
+// serialize writes the output map to w.
+func (po *POutput) serialize(w io.Writer) error {
+	if po.RedeemScript != nil {
+		err := writeKeyValue(
+			w, outputRedeemScriptType, nil, po.RedeemScript,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if po.WitnessScript != nil {
+		err := writeKeyValue(
+			w, outputWitnessScriptType, nil, po.WitnessScript,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := writeBip32Derivations(
+		w, outputBip32DerivationType, po.Bip32Derivation,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := writeUnknowns(w, po.Unknowns); err != nil {
+		return err
+	}
+
+	return writeSeparator(w)
+}
+
+// readMap reads the key-value pairs of a map until its separator, and hands
+// each of them to the given function. Duplicate keys are rejected.
+func readMap(r io.Reader,
+	handle func(keyType byte, keyData, value []byte) error) error {
+
+	seen := make(map[string]struct{})
+	for {
+		key, err := wire.ReadVarBytes(
+			r, 0, maxPsbtKeyValueLength, "psbt key",
+		)
+		if err != nil {
+			return err
+		}
+
+		// A zero length key marks the end of the map.
+		if len(key) == 0 {
+			return nil
+		}
+
+		if _, ok := seen[string(key)]; ok {
+			return ErrDuplicateKey
+		}
+		seen[string(key)] = struct{}{}
+
+		value, err := wire.ReadVarBytes(
+			r, 0, maxPsbtKeyValueLength, "psbt value",
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := handle(key[0], key[1:], value); err != nil {
+			return err
+		}
+	}
+}
+
+// writeKeyValue writes a single key-value pair to w.
+func writeKeyValue(w io.Writer, keyType byte, keyData, value []byte) error {
+	key := append([]byte{keyType}, keyData...)
+	if err := wire.WriteVarBytes(w, 0, key); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, value)
+}
+
+// writeSeparator writes the separator that terminates a map to w.
+func writeSeparator(w io.Writer) error {
+	_, err := w.Write([]byte{0x00})
+	return err
+}
+
+// writeUnknowns writes the key-value pairs of unknown type to w.
+func writeUnknowns(w io.Writer, unknowns []*Unknown) error {
+	for _, u := range unknowns {
+		if err := wire.WriteVarBytes(w, 0, u.Key); err != nil {
+			return err
+		}
+		if err := wire.WriteVarBytes(w, 0, u.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newUnknown creates a key-value pair of unknown type.
+func newUnknown(keyType byte, keyData, value []byte) *Unknown {
+	return &Unknown{
+		Key:   append([]byte{keyType}, keyData...),
+		Value: value,
+	}
+}
+
+// validPubKeyLength returns true if the key has the length of a compressed or
+// uncompressed public key.
+func validPubKeyLength(pubKey []byte) bool {
+	return len(pubKey) == 33 || len(pubKey) == 65
+}
+
+// readTxOut parses a serialized transaction output.
+func readTxOut(b []byte) (*wire.TxOut, error) {
+	if len(b) < 9 {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	value := int64(binary.LittleEndian.Uint64(b[:8]))
+	pkScript, err := wire.ReadVarBytes(
+		bytes.NewReader(b[8:]), 0, maxPsbtKeyValueLength, "pkScript",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewTxOut(value, pkScript), nil
+}
+
+// readBip32Derivation parses a BIP32 derivation key-value pair.
+func readBip32Derivation(pubKey, value []byte) (*Bip32Derivation, error) {
+	if !validPubKeyLength(pubKey) || len(value) < 4 || len(value)%4 != 0 {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	derivation := &Bip32Derivation{
+		PubKey:               pubKey,
+		MasterKeyFingerprint: binary.LittleEndian.Uint32(value[:4]),
+	}
+	for i := 4; i < len(value); i += 4 {
+		derivation.Bip32Path = append(
+			derivation.Bip32Path,
+			binary.LittleEndian.Uint32(value[i:i+4]),
+		)
+	}
+
+	return derivation, nil
+}
+
+// writeBip32Derivations writes the BIP32 derivation key-value pairs to w.
+func writeBip32Derivations(w io.Writer, keyType byte,
+	derivations []*Bip32Derivation) error {
+
+	for _, derivation := range derivations {
+		value := make([]byte, 4*(len(derivation.Bip32Path)+1))
+		binary.LittleEndian.PutUint32(
+			value[:4], derivation.MasterKeyFingerprint,
+		)
+		for i, index := range derivation.Bip32Path {
+			binary.LittleEndian.PutUint32(value[4*(i+1):], index)
+		}
+
+		err := writeKeyValue(w, keyType, derivation.PubKey, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
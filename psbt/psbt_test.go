@@ -0,0 +1,198 @@
+package psbt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
+	"github.com/Actinium-project/acmd/txscript"
+	"github.com/Actinium-project/acmd/wire"
+	"github.com/Actinium-project/acmutil"
+)
+
+// newTestTx creates a transaction spending a single output to the given
+// script.
+func newTestTx(pkScript []byte) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{1, 2, 3},
+			Index: 1,
+		},
+		Sequence: wire.MaxTxInSequenceNum,
+	})
+	tx.AddTxOut(wire.NewTxOut(90000, pkScript))
+
+	return tx
+}
+
+// TestPacketRoundTrip asserts that a packet survives a serialization round
+// trip, both in binary and base64 encoding.
+func TestPacketRoundTrip(t *testing.T) {
+	pubKey := bytes.Repeat([]byte{0x02}, 33)
+
+	p, err := New(newTestTx([]byte{txscript.OP_TRUE}))
+	if err != nil {
+		t.Fatalf("unable to create packet: %v", err)
+	}
+	p.Inputs[0] = PInput{
+		WitnessUtxo: wire.NewTxOut(100000, []byte{txscript.OP_0}),
+		PartialSigs: []*PartialSig{{
+			PubKey:    pubKey,
+			Signature: []byte{0x30, 0x01},
+		}},
+		SighashType:  txscript.SigHashAll,
+		RedeemScript: []byte{txscript.OP_1},
+		Bip32Derivation: []*Bip32Derivation{{
+			PubKey:               pubKey,
+			MasterKeyFingerprint: 0xdeadbeef,
+			Bip32Path:            []uint32{84 + 0x80000000, 1, 2},
+		}},
+		Unknowns: []*Unknown{{Key: []byte{0xf0}, Value: []byte{1}}},
+	}
+	p.Outputs[0] = POutput{
+		WitnessScript: []byte{txscript.OP_2},
+	}
+
+	var b bytes.Buffer
+	if err := p.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+	rawPacket := b.Bytes()
+
+	parsed, err := NewFromRawBytes(bytes.NewReader(rawPacket), false)
+	if err != nil {
+		t.Fatalf("unable to parse packet: %v", err)
+	}
+
+	var b2 bytes.Buffer
+	if err := parsed.Serialize(&b2); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+	if !bytes.Equal(rawPacket, b2.Bytes()) {
+		t.Fatalf("packet changed in round trip")
+	}
+
+	if parsed.Inputs[0].Bip32Derivation[0].Bip32Path[0] != 84+0x80000000 {
+		t.Fatalf("unexpected derivation path: %v",
+			parsed.Inputs[0].Bip32Derivation[0].Bip32Path)
+	}
+
+	b64, err := p.B64Encode()
+	if err != nil {
+		t.Fatalf("unable to encode packet: %v", err)
+	}
+	parsed, err = NewFromRawBytes(strings.NewReader(b64), true)
+	if err != nil {
+		t.Fatalf("unable to parse base64 packet: %v", err)
+	}
+	if parsed.Inputs[0].SighashType != txscript.SigHashAll {
+		t.Fatalf("unexpected sighash type: %v",
+			parsed.Inputs[0].SighashType)
+	}
+
+	// A packet that contains the same key twice must be rejected.
+	var txBuf bytes.Buffer
+	if err := p.UnsignedTx.SerializeNoWitness(&txBuf); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	var dupBuf bytes.Buffer
+	dupBuf.Write(psbtMagic[:])
+	for i := 0; i < 2; i++ {
+		err := writeKeyValue(
+			&dupBuf, globalUnsignedTxType, nil, txBuf.Bytes(),
+		)
+		if err != nil {
+			t.Fatalf("unable to write key: %v", err)
+		}
+	}
+	_, err = NewFromRawBytes(&dupBuf, false)
+	if err != ErrDuplicateKey {
+		t.Fatalf("expected duplicate key error, got: %v", err)
+	}
+}
+
+// TestFinalizeP2WKH asserts that a signed pay-to-witness-pubkey-hash input is
+// finalized into a witness that passes script validation.
+func TestFinalizeP2WKH(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	pubKey := privKey.PubKey().SerializeCompressed()
+
+	addr, err := acmutil.NewAddressWitnessPubKeyHash(
+		acmutil.Hash160(pubKey), &chaincfg.MainNetParams,
+	)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+	utxo := wire.NewTxOut(100000, pkScript)
+
+	p, err := New(newTestTx(pkScript))
+	if err != nil {
+		t.Fatalf("unable to create packet: %v", err)
+	}
+	p.Inputs[0].WitnessUtxo = utxo
+
+	// Finalizing an input without signatures must fail.
+	if err := Finalize(p, 0); err == nil {
+		t.Fatalf("expected finalizing unsigned input to fail")
+	}
+	if _, err := Extract(p); err == nil {
+		t.Fatalf("expected extracting unsigned packet to fail")
+	}
+
+	sigHashes := txscript.NewTxSigHashes(p.UnsignedTx)
+	sig, err := txscript.RawTxInWitnessSignature(
+		p.UnsignedTx, sigHashes, 0, utxo.Value, pkScript,
+		txscript.SigHashAll, privKey,
+	)
+	if err != nil {
+		t.Fatalf("unable to sign: %v", err)
+	}
+	p.Inputs[0].PartialSigs = []*PartialSig{{
+		PubKey:    pubKey,
+		Signature: sig,
+	}}
+
+	if err := MaybeFinalizeAll(p); err != nil {
+		t.Fatalf("unable to finalize: %v", err)
+	}
+	if p.Inputs[0].PartialSigs != nil {
+		t.Fatalf("partial signatures not removed")
+	}
+
+	// The finalized packet must survive a round trip too.
+	var b bytes.Buffer
+	if err := p.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+	p, err = NewFromRawBytes(&b, false)
+	if err != nil {
+		t.Fatalf("unable to parse packet: %v", err)
+	}
+
+	finalTx, err := Extract(p)
+	if err != nil {
+		t.Fatalf("unable to extract tx: %v", err)
+	}
+
+	vm, err := txscript.NewEngine(
+		pkScript, finalTx, 0, txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(finalTx), utxo.Value,
+	)
+	if err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("final tx invalid: %v", err)
+	}
+}
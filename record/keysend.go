@@ -0,0 +1,47 @@
+package record
+
+import (
+	"bytes"
+	"crypto/sha256"
+)
+
+const (
+	// KeySendMessageType is the custom record identifier for a message to
+	// the receiver of a keysend payment.
+	KeySendMessageType uint64 = 34349334
+
+	// KeySendSignatureType is the custom record identifier for the
+	// signature of the sender of a keysend payment over the data returned
+	// by KeySendSignedData. The signature is a 65 byte compact signature,
+	// as created by the SignMessage RPC.
+	KeySendSignatureType uint64 = 34349337
+
+	// KeySendSenderType is the custom record identifier for the 33 byte
+	// compressed public key of the sender of a keysend payment.
+	KeySendSenderType uint64 = 34349339
+
+	// KeySendReplyToType is the custom record identifier for a payment
+	// request the receiver of a keysend payment can use to reply to the
+	// sender.
+	KeySendReplyToType uint64 = 34349345
+)
+
+// KeySendSignedData returns the data the sender of a keysend payment signs to
+// identify itself to the receiver. It commits to both parties, the payment
+// hash and the message and reply-to records of the payment, so that the
+// signature can't be replayed on another payment.
+func KeySendSignedData(sender, receiver [33]byte, paymentHash [32]byte,
+	records CustomSet) []byte {
+
+	message := sha256.Sum256(records[KeySendMessageType])
+	replyTo := sha256.Sum256(records[KeySendReplyToType])
+
+	var b bytes.Buffer
+	b.Write(sender[:])
+	b.Write(receiver[:])
+	b.Write(paymentHash[:])
+	b.Write(message[:])
+	b.Write(replyTo[:])
+
+	return b.Bytes()
+}
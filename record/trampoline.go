@@ -0,0 +1,29 @@
+package record
+
+import (
+	"github.com/Actinium-project/lnd/tlv"
+)
+
+const (
+	// OutgoingNodeIDOnionType is the type used in a trampoline onion
+	// payload to reference the node the trampoline forwards the payment
+	// to.
+	OutgoingNodeIDOnionType tlv.Type = 66098
+
+	// TrampolineOnionOnionType is the type used in the final hop payload
+	// of the outer onion to carry the trampoline onion for the receiving
+	// trampoline node.
+	TrampolineOnionOnionType tlv.Type = 66100
+)
+
+// NewOutgoingNodeIDRecord creates a tlv.Record that encodes the
+// outgoing_node_id (type 66098) for a trampoline onion payload.
+func NewOutgoingNodeIDRecord(nodeID *[33]byte) tlv.Record {
+	return tlv.MakePrimitiveRecord(OutgoingNodeIDOnionType, nodeID)
+}
+
+// NewTrampolineOnionRecord creates a tlv.Record that encodes the
+// trampoline_onion (type 66100) for an onion payload.
+func NewTrampolineOnionRecord(onion *[]byte) tlv.Record {
+	return tlv.MakePrimitiveRecord(TrampolineOnionOnionType, onion)
+}
@@ -0,0 +1,137 @@
+package routing
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/Actinium-project/lnd/amp"
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwire"
+)
+
+// newAMPShards splits the payment into the given number of shards of an atomic
+// multi-path payment. Every shard is a payment of its own, with a payment hash
+// derived from the root of the payment. The receiver can only derive the
+// preimages of the shards once it has received all of them.
+func newAMPShards(payment *LightningPayment,
+	numShards int) ([]*LightningPayment, error) {
+
+	if numShards < 1 || numShards > amp.MaxChildren {
+		return nil, fmt.Errorf("number of amp shards must be between "+
+			"1 and %v", amp.MaxChildren)
+	}
+	if payment.Amount < lnwire.MilliSatoshi(numShards) {
+		return nil, fmt.Errorf("amount %v too small to be split into "+
+			"%v shards", payment.Amount, numShards)
+	}
+	if payment.MPPTotalAmt != 0 || payment.AMP != nil {
+		return nil, errors.New("payment is already a shard")
+	}
+
+	// Without an invoice, the payment address merely identifies the set of
+	// shards, so we'll use a random one.
+	var payAddr [32]byte
+	if payment.PaymentAddr != nil {
+		payAddr = *payment.PaymentAddr
+	} else if _, err := rand.Read(payAddr[:]); err != nil {
+		return nil, err
+	}
+
+	children, err := amp.NewChildren(numShards)
+	if err != nil {
+		return nil, err
+	}
+
+	// Split the amount and the fee limit evenly, leaving any remainder to
+	// the last shard.
+	var (
+		shards    = make([]*LightningPayment, 0, numShards)
+		shardAmt  = payment.Amount / lnwire.MilliSatoshi(numShards)
+		shardFee  = payment.FeeLimit / lnwire.MilliSatoshi(numShards)
+		remainAmt = payment.Amount
+		remainFee = payment.FeeLimit
+	)
+	for i, child := range children {
+		shard := *payment
+		shard.PaymentHash = child.Hash
+		shard.Amount = shardAmt
+		shard.FeeLimit = shardFee
+		shard.MPPTotalAmt = payment.Amount
+		shard.PaymentAddr = &payAddr
+		shard.AMP = child.Record
+
+		if i == numShards-1 {
+			shard.Amount = remainAmt
+			shard.FeeLimit = remainFee
+		}
+		remainAmt -= shard.Amount
+		remainFee -= shard.FeeLimit
+
+		shards = append(shards, &shard)
+	}
+
+	return shards, nil
+}
+
+// SendAMPPaymentAsync splits the payment into the given number of shards of an
+// atomic multi-path payment, and sends them concurrently. Every shard is
+// tracked as a payment of its own by the control tower, keyed by the payment
+// hash of the shard. The hashes of all shards are returned, so that their
+// results can be retrieved via the control tower. The receiver either settles
+// all of the shards, or none of them.
+func (r *ChannelRouter) SendAMPPaymentAsync(payment *LightningPayment,
+	numShards int) ([]lntypes.Hash, error) {
+
+	shards, err := newAMPShards(payment, numShards)
+	if err != nil {
+		return nil, err
+	}
+
+	// Register all shards with the control tower before sending any of
+	// them, so that we don't send a partial set.
+	var (
+		hashes   = make([]lntypes.Hash, 0, len(shards))
+		sessions = make([]PaymentSession, 0, len(shards))
+	)
+	for _, shard := range shards {
+		paySession, err := r.preparePayment(shard)
+		if err != nil {
+			for _, hash := range hashes {
+				failErr := r.cfg.Control.Fail(
+					hash, channeldb.FailureReasonError,
+				)
+				if failErr != nil {
+					log.Errorf("Unable to fail amp shard "+
+						"%v: %v", hash, failErr)
+				}
+			}
+
+			return nil, err
+		}
+
+		hashes = append(hashes, shard.PaymentHash)
+		sessions = append(sessions, paySession)
+	}
+
+	log.Infof("Sending amp payment of %v to %x in %v shards",
+		payment.Amount, payment.Target, len(shards))
+
+	for i := range shards {
+		shard, paySession := shards[i], sessions[i]
+
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+
+			_, _, err := r.sendPayment(nil, shard, paySession)
+			if err != nil {
+				log.Errorf("Amp shard with hash %x failed: %v",
+					shard.PaymentHash, err)
+			}
+		}()
+	}
+
+	return hashes, nil
+}
@@ -0,0 +1,78 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/Actinium-project/lnd/amp"
+	"github.com/Actinium-project/lnd/lnwire"
+)
+
+// TestNewAMPShards asserts that a payment is split into shards that add up to
+// the full amount and fee limit, share the payment address and carry the
+// shares of a single root.
+func TestNewAMPShards(t *testing.T) {
+	t.Parallel()
+
+	payment := &LightningPayment{
+		Amount:   1000,
+		FeeLimit: 100,
+	}
+
+	shards, err := newAMPShards(payment, 3)
+	if err != nil {
+		t.Fatalf("unable to split payment: %v", err)
+	}
+	if len(shards) != 3 {
+		t.Fatalf("expected 3 shards, got %v", len(shards))
+	}
+
+	var (
+		totalAmt, totalFee lnwire.MilliSatoshi
+		shares             [][32]byte
+	)
+	for _, shard := range shards {
+		if shard.MPPTotalAmt != payment.Amount {
+			t.Fatalf("expected mpp total %v, got %v",
+				payment.Amount, shard.MPPTotalAmt)
+		}
+		if *shard.PaymentAddr != *shards[0].PaymentAddr {
+			t.Fatalf("payment addr mismatch")
+		}
+		if shard.PaymentHash == shards[0].PaymentHash &&
+			shard != shards[0] {
+
+			t.Fatalf("shards share payment hash")
+		}
+
+		totalAmt += shard.Amount
+		totalFee += shard.FeeLimit
+		shares = append(shares, shard.AMP.RootShare())
+	}
+	if totalAmt != payment.Amount {
+		t.Fatalf("expected total amount %v, got %v", payment.Amount,
+			totalAmt)
+	}
+	if totalFee != payment.FeeLimit {
+		t.Fatalf("expected total fee limit %v, got %v",
+			payment.FeeLimit, totalFee)
+	}
+
+	// The receiver must be able to derive the payment hashes of all shards
+	// from the full set of shares.
+	root, err := amp.ReconstructRoot(shares)
+	if err != nil {
+		t.Fatalf("unable to reconstruct root: %v", err)
+	}
+	for _, shard := range shards {
+		preimage := amp.ChildPreimage(root, shard.AMP.ChildIndex())
+		if preimage.Hash() != shard.PaymentHash {
+			t.Fatalf("unable to derive preimage of shard %v",
+				shard.AMP.ChildIndex())
+		}
+	}
+
+	// A payment can't be split into more shards than its amount in msat.
+	if _, err := newAMPShards(&LightningPayment{Amount: 2}, 3); err == nil {
+		t.Fatalf("expected error for too small amount")
+	}
+}
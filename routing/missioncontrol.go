@@ -161,6 +161,30 @@ type MissionControlPairSnapshot struct {
 	TimedPairResult
 }
 
+// resultSource describes what kind of htlc produced a payment result.
+type resultSource uint8
+
+const (
+	// resultSourcePayment is the result of an htlc of a payment.
+	resultSourcePayment resultSource = iota
+
+	// resultSourceProbe is the result of a probe htlc with a payment hash
+	// nobody knows the preimage of.
+	resultSourceProbe
+)
+
+// String returns a human readable identifier for the resultSource type.
+func (s resultSource) String() string {
+	switch s {
+	case resultSourcePayment:
+		return "payment"
+	case resultSourceProbe:
+		return "probe"
+	}
+
+	return "unknown"
+}
+
 // paymentResult is the information that becomes available when a payment
 // attempt completes.
 type paymentResult struct {
@@ -170,6 +194,7 @@ type paymentResult struct {
 	success            bool
 	failureSourceIdx   *int
 	failure            lnwire.FailureMessage
+	source             resultSource
 }
 
 // NewMissionControl returns a new instance of missionControl.
@@ -455,6 +480,31 @@ func (m *MissionControl) ReportPaymentFail(paymentID uint64, rt *route.Route,
 	return m.processPaymentResult(result)
 }
 
+// ReportProbeFail reports the failure of a probe to mission control as input
+// for future probability estimates. Probes are sent with a payment hash that
+// nobody knows the preimage of, so they always fail. A probe that failed at
+// its final hop because of the unknown payment hash is interpreted as a
+// success of every pair of its route.
+func (m *MissionControl) ReportProbeFail(probeID uint64, rt *route.Route,
+	failureSourceIdx *int, failure lnwire.FailureMessage) (
+	*channeldb.FailureReason, error) {
+
+	timestamp := m.now()
+
+	result := &paymentResult{
+		success:          false,
+		timeFwd:          timestamp,
+		timeReply:        timestamp,
+		id:               probeID,
+		failureSourceIdx: failureSourceIdx,
+		failure:          failure,
+		route:            rt,
+		source:           resultSourceProbe,
+	}
+
+	return m.processPaymentResult(result)
+}
+
 // ReportPaymentSuccess reports a successful payment to mission control as input
 // for future probability estimates.
 func (m *MissionControl) ReportPaymentSuccess(paymentID uint64,
@@ -496,6 +546,9 @@ func (m *MissionControl) processPaymentResult(result *paymentResult) (
 func (m *MissionControl) applyPaymentResult(
 	result *paymentResult) *channeldb.FailureReason {
 
+	log.Debugf("Applying %v result to Mission Control: id=%v",
+		result.source, result.id)
+
 	// Interpret result.
 	i := interpretResult(
 		result.route, result.success, result.failureSourceIdx,
@@ -152,6 +152,12 @@ func serializeResult(rp *paymentResult) ([]byte, []byte, error) {
 		return nil, nil, err
 	}
 
+	// Write the source of the result. It is appended last, so that
+	// results stored before it was added can still be read.
+	if err := channeldb.WriteElements(&b, uint8(rp.source)); err != nil {
+		return nil, nil, err
+	}
+
 	// Compose key that identifies this result.
 	key := getResultKey(rp)
 
@@ -213,6 +219,16 @@ func deserializeResult(k, v []byte) (*paymentResult, error) {
 		}
 	}
 
+	// Read the source of the result. Results stored before the source
+	// was added are payment results.
+	if r.Len() > 0 {
+		var source uint8
+		if err := channeldb.ReadElements(r, &source); err != nil {
+			return nil, err
+		}
+		result.source = resultSource(source)
+	}
+
 	return &result, nil
 }
 
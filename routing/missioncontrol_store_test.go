@@ -76,6 +76,7 @@ func TestMissionControlStore(t *testing.T) {
 	result2.timeReply = result1.timeReply.Add(time.Hour)
 	result2.timeFwd = result1.timeReply.Add(time.Hour)
 	result2.id = 2
+	result2.source = resultSourceProbe
 
 	// Store result.
 	err = store.AddResult(&result2)
@@ -105,6 +105,13 @@ func (m *mockMissionControl) ReportPaymentFail(paymentID uint64, rt *route.Route
 	return nil, nil
 }
 
+func (m *mockMissionControl) ReportProbeFail(probeID uint64, rt *route.Route,
+	failureSourceIdx *int, failure lnwire.FailureMessage) (
+	*channeldb.FailureReason, error) {
+
+	return nil, nil
+}
+
 func (m *mockMissionControl) ReportPaymentSuccess(paymentID uint64,
 	rt *route.Route) error {
 
@@ -69,6 +69,11 @@ var (
 	errNoPaymentAddr = errors.New("destination hop doesn't " +
 		"understand payment addresses")
 
+	// errNoAMP is returned when the destination hop does not support
+	// atomic multi-path payments.
+	errNoAMP = errors.New("destination hop doesn't understand atomic " +
+		"multi-path payments")
+
 	// errNoPathFound is returned when a path to the target destination does
 	// not exist in the graph.
 	errNoPathFound = errors.New("unable to find a path to destination")
@@ -92,9 +97,11 @@ type edgePolicyWithSource struct {
 // custom records and payment address.
 type finalHopParams struct {
 	amt         lnwire.MilliSatoshi
+	totalAmt    lnwire.MilliSatoshi
 	cltvDelta   uint16
 	records     record.CustomSet
 	paymentAddr *[32]byte
+	amp         *record.AMP
 }
 
 // newRoute constructs a route using the provided path and final hop constraints.
@@ -144,6 +151,7 @@ func newRoute(sourceVertex route.Vertex,
 			tlvPayload       bool
 			customRecords    record.CustomSet
 			mpp              *record.MPP
+			amp              *record.AMP
 		)
 
 		// Define a helper function that checks this edge's feature
@@ -192,12 +200,30 @@ func newRoute(sourceVertex route.Vertex,
 					"payment addr")
 			}
 
-			// Otherwise attach the mpp record if it exists.
+			// Otherwise attach the mpp record if it exists. If
+			// this is a shard of a larger payment, the record
+			// carries the total amount of all shards.
 			if finalHop.paymentAddr != nil {
+				totalAmt := finalHop.amt
+				if finalHop.totalAmt != 0 {
+					totalAmt = finalHop.totalAmt
+				}
+
 				mpp = record.NewMPP(
-					finalHop.amt, *finalHop.paymentAddr,
+					totalAmt, *finalHop.paymentAddr,
 				)
 			}
+
+			// Attach the AMP record if this is a shard of an atomic
+			// multi-path payment, which requires the receiver to
+			// support it.
+			if finalHop.amp != nil {
+				if !supports(lnwire.AMPOptional) {
+					return nil, errNoAMP
+				}
+
+				amp = finalHop.amp
+			}
 		} else {
 			// The amount that the current hop needs to forward is
 			// equal to the incoming amount of the next hop.
@@ -228,6 +254,7 @@ func newRoute(sourceVertex route.Vertex,
 			LegacyPayload:    !tlvPayload,
 			CustomRecords:    customRecords,
 			MPP:              mpp,
+			AMP:              amp,
 		}
 
 		hops = append([]*route.Hop{currentHop}, hops...)
@@ -326,6 +353,10 @@ type RestrictParams struct {
 	// mitigate probing vectors and payment sniping attacks on overpaid
 	// invoices.
 	PaymentAddr *[32]byte
+
+	// AMP is the AMP record to attach to the final hop, if the payment is
+	// a shard of an atomic multi-path payment.
+	AMP *record.AMP
 }
 
 // PathFindingConfig defines global parameters that control the trade-off in
@@ -484,6 +515,12 @@ func findPathInternal(
 		return nil, errNoPaymentAddr
 	}
 
+	// If the caller sends a shard of an atomic multi-path payment, check
+	// that our destination feature vector supports them.
+	if r.AMP != nil && !features.HasFeature(lnwire.AMPOptional) {
+		return nil, errNoAMP
+	}
+
 	// If we are routing from ourselves, check that we have enough local
 	// balance available.
 	self := graph.sourceNode()
@@ -540,6 +577,7 @@ func findPathInternal(
 			lnwire.TLVOnionPayloadOptional,
 		),
 		MPP: mpp,
+		AMP: r.AMP,
 	}
 
 	// We can't always assume that the end destination is publicly
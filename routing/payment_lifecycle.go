@@ -195,6 +195,7 @@ func errorToPaymentFailure(err error) channeldb.FailureReason {
 	case
 		errNoTlvPayload,
 		errNoPaymentAddr,
+		errNoAMP,
 		errNoPathFound,
 		errPrebuiltRouteTried:
 
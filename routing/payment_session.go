@@ -100,6 +100,7 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 		DestCustomRecords: payment.DestCustomRecords,
 		DestFeatures:      payment.DestFeatures,
 		PaymentAddr:       payment.PaymentAddr,
+		AMP:               payment.AMP,
 	}
 
 	// We'll also obtain a set of bandwidthHints from the lower layer for
@@ -136,9 +137,11 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 		sourceVertex, path, height,
 		finalHopParams{
 			amt:         payment.Amount,
+			totalAmt:    payment.MPPTotalAmt,
 			cltvDelta:   finalCltvDelta,
 			records:     payment.DestCustomRecords,
 			paymentAddr: payment.PaymentAddr,
+			amp:         payment.AMP,
 		},
 	)
 	if err != nil {
@@ -0,0 +1,180 @@
+package routing
+
+import (
+	"crypto/rand"
+	"errors"
+
+	sphinx "github.com/Actinium-project/lightning-onion"
+	"github.com/Actinium-project/lnd/htlcswitch"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/routing/route"
+)
+
+var (
+	// ErrProbeSettled is returned when a probe htlc is settled. As the
+	// payment hash of a probe is random, this should never happen.
+	ErrProbeSettled = errors.New("probe htlc was settled")
+)
+
+// ProbeResult is the outcome of a probe sent along a route.
+type ProbeResult struct {
+	// Route is the route the probe was sent along.
+	Route *route.Route
+
+	// FailureSourceIdx is the index of the node that failed the probe,
+	// where zero is our own node. It is nil if the failure couldn't be
+	// attributed to a node.
+	FailureSourceIdx *int
+
+	// Failure is the failure message returned by the failing node. It is
+	// nil if the failure message couldn't be decoded.
+	Failure lnwire.FailureMessage
+
+	// Err is the error the switch returned for the probe htlc.
+	Err error
+}
+
+// ReachedHops returns the number of hops of the route whose node received
+// the probe htlc.
+func (p *ProbeResult) ReachedHops() int {
+	if p.FailureSourceIdx == nil {
+		return 0
+	}
+
+	return *p.FailureSourceIdx
+}
+
+// ReachedDestination returns true if the probe htlc made it to the final hop
+// of the route. This means that the route can carry the amount of the probe.
+func (p *ProbeResult) ReachedDestination() bool {
+	return p.ReachedHops() == len(p.Route.Hops)
+}
+
+// HopAmount returns the amount of the htlc that the node of the given hop
+// receives.
+func (p *ProbeResult) HopAmount(hop int) lnwire.MilliSatoshi {
+	if hop == 0 {
+		return p.Route.TotalAmount
+	}
+
+	return p.Route.Hops[hop-1].AmtToForward
+}
+
+// ProbeRoute sends an htlc along the given route to test whether it can carry
+// the amount of the route. The htlc pays to a random payment hash that nobody
+// knows the preimage of, so it can't be settled and no funds are at risk. The
+// probe isn't recorded as a payment. Its outcome is reported to mission
+// control as a probe result. This function is blocking until the htlc is
+// failed back.
+func (r *ChannelRouter) ProbeRoute(rt *route.Route) (*ProbeResult, error) {
+	if len(rt.Hops) == 0 {
+		return nil, errors.New("route has no hops")
+	}
+
+	var paymentHash lntypes.Hash
+	if _, err := rand.Read(paymentHash[:]); err != nil {
+		return nil, err
+	}
+
+	sessionKey, err := generateNewSessionKey()
+	if err != nil {
+		return nil, err
+	}
+
+	onionBlob, circuit, err := generateSphinxPacket(
+		rt, paymentHash[:], sessionKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	htlcAdd := &lnwire.UpdateAddHTLC{
+		Amount:      rt.TotalAmount,
+		Expiry:      rt.TotalTimeLock,
+		PaymentHash: paymentHash,
+	}
+	copy(htlcAdd.OnionBlob[:], onionBlob)
+
+	firstHop := lnwire.NewShortChanIDFromInt(rt.Hops[0].ChannelID)
+
+	probeID, err := r.cfg.NextPaymentID()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Sending probe %v (pid=%v) along route: %v", paymentHash,
+		probeID, rt)
+
+	sendErr := r.cfg.Payer.SendHTLC(firstHop, probeID, htlcAdd)
+	if sendErr == nil {
+		errorDecryptor := &htlcswitch.SphinxErrorDecrypter{
+			OnionErrorDecrypter: sphinx.NewOnionErrorDecrypter(circuit),
+		}
+
+		resultChan, err := r.cfg.Payer.GetPaymentResult(
+			probeID, paymentHash, errorDecryptor,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case result, ok := <-resultChan:
+			if !ok {
+				return nil, htlcswitch.ErrSwitchExiting
+			}
+			if result.Error == nil {
+				return nil, ErrProbeSettled
+			}
+
+			sendErr = result.Error
+
+		case <-r.quit:
+			return nil, ErrRouterShuttingDown
+		}
+	}
+
+	log.Debugf("Probe %v (pid=%v) failed: %v", paymentHash, probeID,
+		sendErr)
+
+	return r.processProbeFailure(probeID, rt, sendErr)
+}
+
+// processProbeFailure interprets the error a probe htlc failed with, and
+// reports it to mission control.
+func (r *ChannelRouter) processProbeFailure(probeID uint64, rt *route.Route,
+	sendErr error) (*ProbeResult, error) {
+
+	result := &ProbeResult{
+		Route: rt,
+		Err:   sendErr,
+	}
+
+	switch err := sendErr.(type) {
+	case htlcswitch.ClearTextError:
+		// If the error isn't a ForwardingError, the probe failed at
+		// our own node.
+		failureSourceIdx := 0
+		if fErr, ok := err.(*htlcswitch.ForwardingError); ok {
+			failureSourceIdx = fErr.FailureSourceIdx
+		}
+
+		result.FailureSourceIdx = &failureSourceIdx
+		result.Failure = err.WireMessage()
+
+	default:
+		// A failure that couldn't be decrypted is still reported to
+		// mission control. Any other error isn't related to the
+		// propagation of the probe.
+		if sendErr != htlcswitch.ErrUnreadableFailureMessage {
+			return nil, sendErr
+		}
+	}
+
+	r.processFailure(
+		probeID, rt, sendErr, r.cfg.MissionControl.ReportProbeFail,
+	)
+
+	return result, nil
+}
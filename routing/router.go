@@ -188,6 +188,13 @@ type MissionController interface {
 	// for future probability estimates.
 	ReportPaymentSuccess(paymentID uint64, rt *route.Route) error
 
+	// ReportProbeFail reports the failure of a probe to mission control
+	// as input for future probability estimates. Probe results are kept
+	// apart from payment results.
+	ReportProbeFail(probeID uint64, rt *route.Route,
+		failureSourceIdx *int, failure lnwire.FailureMessage) (
+		*channeldb.FailureReason, error)
+
 	// GetProbability is expected to return the success probability of a
 	// payment from fromNode along edge.
 	GetProbability(fromNode, toNode route.Vertex,
@@ -1603,6 +1610,15 @@ type LightningPayment struct {
 	// invoice to prevent probing of the destination.
 	PaymentAddr *[32]byte
 
+	// MPPTotalAmt is the total amount of the multi-path payment this
+	// payment is a shard of. If zero, Amount is the total amount.
+	MPPTotalAmt lnwire.MilliSatoshi
+
+	// AMP is the AMP record to attach to the final hop if this payment is
+	// a shard of an atomic multi-path payment. It requires PaymentAddr to
+	// be set.
+	AMP *record.AMP
+
 	// PaymentRequest is an optional payment request that this payment is
 	// attempting to complete.
 	PaymentRequest []byte
@@ -1612,6 +1628,11 @@ type LightningPayment struct {
 	// understand this new onion payload format, then the payment will
 	// fail.
 	DestCustomRecords record.CustomSet
+
+	// Trampoline is the trampoline node the payment is routed through. If
+	// set, we only need a route to the trampoline node, which finds the
+	// route to the destination.
+	Trampoline *Trampoline
 }
 
 // SendPayment attempts to send a payment as described within the passed
@@ -1624,6 +1645,14 @@ type LightningPayment struct {
 func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte,
 	*route.Route, error) {
 
+	if payment.Trampoline != nil {
+		var err error
+		payment, err = r.newTrampolinePayment(payment)
+		if err != nil {
+			return [32]byte{}, nil, err
+		}
+	}
+
 	paySession, err := r.preparePayment(payment)
 	if err != nil {
 		return [32]byte{}, nil, err
@@ -1637,6 +1666,14 @@ func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte,
 // SendPaymentAsync is the non-blocking version of SendPayment. The payment
 // result needs to be retrieved via the control tower.
 func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) error {
+	if payment.Trampoline != nil {
+		var err error
+		payment, err = r.newTrampolinePayment(payment)
+		if err != nil {
+			return err
+		}
+	}
+
 	paySession, err := r.preparePayment(payment)
 	if err != nil {
 		return err
@@ -1872,15 +1909,29 @@ func (r *ChannelRouter) tryApplyChannelUpdate(rt *route.Route,
 func (r *ChannelRouter) processSendError(paymentID uint64, rt *route.Route,
 	sendErr error) *channeldb.FailureReason {
 
+	return r.processFailure(
+		paymentID, rt, sendErr, r.cfg.MissionControl.ReportPaymentFail,
+	)
+}
+
+// failureReporter reports the failure of an htlc to mission control.
+type failureReporter func(id uint64, rt *route.Route, failureSourceIdx *int,
+	failure lnwire.FailureMessage) (*channeldb.FailureReason, error)
+
+// processFailure analyzes the error for an htlc received from the switch,
+// applies the channel update it may contain and reports it to mission control
+// using the given reporter. It returns the final failure reason if no further
+// attempts need to be made.
+func (r *ChannelRouter) processFailure(id uint64, rt *route.Route,
+	sendErr error, report failureReporter) *channeldb.FailureReason {
+
 	internalErrorReason := channeldb.FailureReasonError
 
 	reportFail := func(srcIdx *int,
 		msg lnwire.FailureMessage) *channeldb.FailureReason {
 
 		// Report outcome to mission control.
-		reason, err := r.cfg.MissionControl.ReportPaymentFail(
-			paymentID, rt, srcIdx, msg,
-		)
+		reason, err := report(id, rt, srcIdx, msg)
 		if err != nil {
 			log.Errorf("Error reporting payment result to mc: %v",
 				err)
@@ -3403,6 +3403,135 @@ func TestSendToRouteMaxHops(t *testing.T) {
 	}
 }
 
+// TestProbeRoute asserts that probes are sent without initiating a payment,
+// and that their outcome is interpreted and reported to mission control.
+func TestProbeRoute(t *testing.T) {
+	t.Parallel()
+
+	// Setup a three node network.
+	chanCapSat := acmutil.Amount(100000)
+	testChannels := []*testChannel{
+		symmetricTestChannel("a", "b", chanCapSat, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+			MaxHTLC: lnwire.NewMSatFromSatoshis(chanCapSat),
+		}, 1),
+		symmetricTestChannel("b", "c", chanCapSat, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+			MaxHTLC: lnwire.NewMSatFromSatoshis(chanCapSat),
+		}, 2),
+	}
+
+	testGraph, err := createTestGraphFromChannels(testChannels, "a")
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraph.cleanUp()
+
+	const startingBlockHeight = 101
+
+	ctx, cleanUp, err := createTestCtxFromGraphInstance(
+		startingBlockHeight, testGraph,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	// Probes must not be recorded as payments.
+	init := make(chan initArgs, 1)
+	ctx.router.cfg.Control.(*mockControlTower).init = init
+
+	const payAmt = lnwire.MilliSatoshi(10000)
+	nodeA := ctx.aliases["a"]
+	nodeB := ctx.aliases["b"]
+	nodeC := ctx.aliases["c"]
+	hops := []*route.Hop{
+		{
+			ChannelID:     1,
+			PubKeyBytes:   nodeB,
+			AmtToForward:  payAmt,
+			LegacyPayload: true,
+		},
+		{
+			ChannelID:     2,
+			PubKeyBytes:   nodeC,
+			AmtToForward:  payAmt,
+			LegacyPayload: true,
+		},
+	}
+
+	rt, err := route.NewRouteFromHops(payAmt+10, 100, nodeA, hops)
+	if err != nil {
+		t.Fatalf("unable to create route: %v", err)
+	}
+
+	mc := ctx.router.cfg.MissionControl.(*MissionControl)
+	payer := ctx.router.cfg.Payer.(*mockPaymentAttemptDispatcher)
+
+	// The destination doesn't know the payment hash of the probe, so a
+	// probe that made it to the destination is failed by it.
+	payer.setPaymentResult(
+		func(firstHop lnwire.ShortChannelID) ([32]byte, error) {
+			return [32]byte{}, htlcswitch.NewForwardingError(
+				lnwire.NewFailIncorrectDetails(payAmt, 0), 2,
+			)
+		})
+
+	result, err := ctx.router.ProbeRoute(rt)
+	if err != nil {
+		t.Fatalf("unable to probe route: %v", err)
+	}
+	if !result.ReachedDestination() {
+		t.Fatalf("expected probe to reach destination")
+	}
+	if result.HopAmount(0) != payAmt+10 || result.HopAmount(1) != payAmt {
+		t.Fatalf("unexpected hop amounts %v, %v",
+			result.HopAmount(0), result.HopAmount(1))
+	}
+
+	history := mc.GetPairHistorySnapshot(nodeB, nodeC)
+	if history.SuccessTime.IsZero() {
+		t.Fatalf("expected success of pair b->c to be reported")
+	}
+
+	// A probe that is failed by an intermediate node reached the hops up
+	// to the failing node.
+	payer.setPaymentResult(
+		func(firstHop lnwire.ShortChannelID) ([32]byte, error) {
+			return [32]byte{}, htlcswitch.NewForwardingError(
+				&lnwire.FailTemporaryChannelFailure{}, 1,
+			)
+		})
+
+	result, err = ctx.router.ProbeRoute(rt)
+	if err != nil {
+		t.Fatalf("unable to probe route: %v", err)
+	}
+	if result.ReachedDestination() || result.ReachedHops() != 1 {
+		t.Fatalf("expected probe to reach one hop, reached %v",
+			result.ReachedHops())
+	}
+	if _, ok := result.Failure.(*lnwire.FailTemporaryChannelFailure); !ok {
+		t.Fatalf("expected temporary channel failure, got %v",
+			result.Failure)
+	}
+
+	history = mc.GetPairHistorySnapshot(nodeB, nodeC)
+	if history.FailTime.IsZero() {
+		t.Fatalf("expected failure of pair b->c to be reported")
+	}
+
+	select {
+	case <-init:
+		t.Fatalf("expected no payment to be initiated")
+	default:
+	}
+}
+
 // TestBuildRoute tests whether correct routes are built.
 func TestBuildRoute(t *testing.T) {
 	// Setup a three node network.
@@ -0,0 +1,144 @@
+package routing
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/Actinium-project/acmd/btcec"
+	htlchop "github.com/Actinium-project/lnd/htlcswitch/hop"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/record"
+	"github.com/Actinium-project/lnd/routing/route"
+)
+
+var (
+	// ErrTrampolineFeeLimit is returned when the fee limit of a payment
+	// doesn't cover the fee of the trampoline node.
+	ErrTrampolineFeeLimit = errors.New("fee limit doesn't cover " +
+		"trampoline fee")
+
+	// ErrTrampolineUnsupported is returned when a payment that should be
+	// routed through a trampoline node requires information the
+	// trampoline onion can't carry.
+	ErrTrampolineUnsupported = errors.New("route hints, custom records " +
+		"and amp aren't supported for trampoline payments")
+)
+
+// Trampoline describes the trampoline node a payment is routed through. Only
+// a route to the trampoline node is needed, which finds the route to the
+// destination on our behalf. This allows nodes that don't know the full graph
+// to send payments.
+type Trampoline struct {
+	// Node is the trampoline node the payment is routed to.
+	Node route.Vertex
+
+	// Fee is the fee that is paid to the trampoline node on top of the
+	// amount of the payment. The trampoline node pays the fees of the
+	// route to the destination from it.
+	Fee lnwire.MilliSatoshi
+
+	// CltvDelta is the number of blocks that are granted to the
+	// trampoline node on top of the final cltv delta of the payment.
+	CltvDelta uint16
+}
+
+// newTrampolinePayment wraps the payment in a payment to its trampoline node.
+// The trampoline node receives a trampoline onion that instructs it to forward
+// the payment to the destination.
+func (r *ChannelRouter) newTrampolinePayment(payment *LightningPayment) (
+	*LightningPayment, error) {
+
+	trampoline := payment.Trampoline
+
+	// If the trampoline node is the destination, we can pay it directly.
+	if payment.Target == trampoline.Node {
+		directPayment := *payment
+		directPayment.Trampoline = nil
+
+		return &directPayment, nil
+	}
+
+	if len(payment.RouteHints) > 0 || len(payment.DestCustomRecords) > 0 ||
+		payment.AMP != nil {
+
+		return nil, ErrTrampolineUnsupported
+	}
+
+	if payment.FeeLimit < trampoline.Fee {
+		return nil, ErrTrampolineFeeLimit
+	}
+
+	nodeKey, err := btcec.ParsePubKey(trampoline.Node[:], btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	// The trampoline node forwards the payment as it was described to us,
+	// so it delivers the payment address if we have one.
+	target := [33]byte(payment.Target)
+	height := atomic.LoadUint32(&r.bestHeight)
+	trampolinePayload := &htlchop.TrampolinePayload{
+		AmtToForward:   payment.Amount,
+		OutgoingCltv:   height + uint32(payment.FinalCLTVDelta),
+		OutgoingNodeID: &target,
+	}
+	if payment.PaymentAddr != nil {
+		totalAmt := payment.MPPTotalAmt
+		if totalAmt == 0 {
+			totalAmt = payment.Amount
+		}
+		trampolinePayload.MPP = record.NewMPP(
+			totalAmt, *payment.PaymentAddr,
+		)
+	}
+
+	payload, err := trampolinePayload.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	sessionKey, err := generateNewSessionKey()
+	if err != nil {
+		return nil, err
+	}
+
+	onion, err := htlchop.NewTrampolineOnion(
+		[]htlchop.TrampolineHop{{
+			NodeID:  nodeKey,
+			Payload: payload,
+		}}, sessionKey, payment.PaymentHash[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Routing payment %x to %v through trampoline %v",
+		payment.PaymentHash, payment.Target, trampoline.Node)
+
+	// The trampoline node needs to understand tlv payloads to receive
+	// the trampoline onion.
+	features := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.TLVOnionPayloadOptional),
+		lnwire.Features,
+	)
+
+	// The trampoline node needs blocks to route the payment on top of the
+	// final cltv delta of the destination.
+	finalCltvDelta := payment.FinalCLTVDelta + trampoline.CltvDelta
+
+	return &LightningPayment{
+		Target:            trampoline.Node,
+		Amount:            payment.Amount + trampoline.Fee,
+		FeeLimit:          payment.FeeLimit - trampoline.Fee,
+		CltvLimit:         payment.CltvLimit,
+		PaymentHash:       payment.PaymentHash,
+		FinalCLTVDelta:    finalCltvDelta,
+		PayAttemptTimeout: payment.PayAttemptTimeout,
+		OutgoingChannelID: payment.OutgoingChannelID,
+		DestFeatures:      features,
+		PaymentRequest:    payment.PaymentRequest,
+		DestCustomRecords: record.CustomSet{
+			uint64(record.TrampolineOnionOnionType): onion,
+		},
+	}, nil
+}
@@ -0,0 +1,114 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/Actinium-project/acmd/btcec"
+	htlchop "github.com/Actinium-project/lnd/htlcswitch/hop"
+	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/record"
+	"github.com/Actinium-project/lnd/routing/route"
+)
+
+// TestNewTrampolinePayment asserts that a payment that is routed through a
+// trampoline node is wrapped in a payment to the trampoline node, which
+// carries the instructions to reach the destination in a trampoline onion.
+func TestNewTrampolinePayment(t *testing.T) {
+	t.Parallel()
+
+	const height = 100
+
+	trampolineKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	trampolineNode := route.NewVertex(trampolineKey.PubKey())
+
+	r := &ChannelRouter{
+		bestHeight: height,
+	}
+
+	paymentAddr := [32]byte{1}
+	payment := &LightningPayment{
+		Target:         route.Vertex{2},
+		Amount:         10000,
+		FeeLimit:       500,
+		PaymentHash:    [32]byte{3},
+		FinalCLTVDelta: 40,
+		PaymentAddr:    &paymentAddr,
+		Trampoline: &Trampoline{
+			Node:      trampolineNode,
+			Fee:       200,
+			CltvDelta: 144,
+		},
+	}
+
+	outer, err := r.newTrampolinePayment(payment)
+	if err != nil {
+		t.Fatalf("unable to create trampoline payment: %v", err)
+	}
+
+	if outer.Target != trampolineNode {
+		t.Fatalf("expected payment to trampoline node")
+	}
+	if outer.Amount != 10200 || outer.FeeLimit != 300 {
+		t.Fatalf("unexpected amount %v and fee limit %v",
+			outer.Amount, outer.FeeLimit)
+	}
+	if outer.FinalCLTVDelta != 184 {
+		t.Fatalf("unexpected final cltv delta %v",
+			outer.FinalCLTVDelta)
+	}
+	if outer.PaymentAddr != nil {
+		t.Fatalf("payment address leaked to trampoline hop")
+	}
+
+	// The trampoline node peels the onion and learns where to forward the
+	// payment to.
+	onion := outer.DestCustomRecords[uint64(record.TrampolineOnionOnionType)]
+	peeled, err := htlchop.PeelTrampolineOnion(
+		onion, &keychain.PrivKeyECDH{PrivKey: trampolineKey},
+		payment.PaymentHash[:],
+	)
+	if err != nil {
+		t.Fatalf("unable to peel trampoline onion: %v", err)
+	}
+	if peeled.NextOnion != nil {
+		t.Fatalf("expected final trampoline hop")
+	}
+
+	payload, err := htlchop.DecodeTrampolinePayload(peeled.Payload)
+	if err != nil {
+		t.Fatalf("unable to decode trampoline payload: %v", err)
+	}
+	if payload.AmtToForward != payment.Amount ||
+		payload.OutgoingCltv != height+40 ||
+		*payload.OutgoingNodeID != [33]byte(payment.Target) {
+
+		t.Fatalf("unexpected trampoline payload %v", payload)
+	}
+	if payload.MPP == nil || payload.MPP.PaymentAddr() != paymentAddr ||
+		payload.MPP.TotalMsat() != payment.Amount {
+
+		t.Fatalf("unexpected mpp record %v", payload.MPP)
+	}
+
+	// The fee limit must cover the fee of the trampoline node.
+	payment.FeeLimit = 100
+	_, err = r.newTrampolinePayment(payment)
+	if err != ErrTrampolineFeeLimit {
+		t.Fatalf("expected fee limit error, got %v", err)
+	}
+
+	// A payment to the trampoline node itself is sent directly.
+	payment.Target = trampolineNode
+	direct, err := r.newTrampolinePayment(payment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if direct.Trampoline != nil || direct.Amount != payment.Amount ||
+		len(direct.DestCustomRecords) != 0 {
+
+		t.Fatalf("expected direct payment")
+	}
+}
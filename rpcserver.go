@@ -11,6 +11,7 @@ import (
 	"math"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -23,6 +24,7 @@ import (
 	"github.com/Actinium-project/acmd/wire"
 	"github.com/Actinium-project/acmutil"
 	"github.com/Actinium-project/acmwallet/wallet/txauthor"
+	"github.com/Actinium-project/lnd/accounting"
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -41,6 +43,7 @@ import (
 	"github.com/Actinium-project/lnd/input"
 	"github.com/Actinium-project/lnd/invoices"
 	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/labels"
 	"github.com/Actinium-project/lnd/lncfg"
 	"github.com/Actinium-project/lnd/lnrpc"
 	"github.com/Actinium-project/lnd/lnrpc/invoicesrpc"
@@ -52,6 +55,7 @@ import (
 	"github.com/Actinium-project/lnd/lnwire"
 	"github.com/Actinium-project/lnd/macaroons"
 	"github.com/Actinium-project/lnd/monitoring"
+	"github.com/Actinium-project/lnd/offers"
 	"github.com/Actinium-project/lnd/peernotifier"
 	"github.com/Actinium-project/lnd/record"
 	"github.com/Actinium-project/lnd/routing"
@@ -167,6 +171,14 @@ var (
 			Entity: "macaroon",
 			Action: "generate",
 		},
+		{
+			Entity: "macaroon",
+			Action: "read",
+		},
+		{
+			Entity: "macaroon",
+			Action: "write",
+		},
 	}
 
 	// invoicePermissions is a slice of all the entities that allows a user
@@ -196,9 +208,7 @@ var (
 	}
 
 	// TODO(guggero): Refactor into constants that are used for all
-	// permissions in this file. Also expose the list of possible
-	// permissions in an RPC when per RPC permissions are
-	// implemented.
+	// permissions in this file.
 	validActions  = []string{"read", "write", "generate"}
 	validEntities = []string{
 		"onchain", "offchain", "address", "message",
@@ -281,6 +291,10 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "info",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/GetRecoveryInfo": {{
+			Entity: "info",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ListPeers": {{
 			Entity: "peers",
 			Action: "read",
@@ -397,6 +411,18 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/AddOffer": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListOffers": {{
+			Entity: "invoices",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/FetchInvoice": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/FeeReport": {{
 			Entity: "offchain",
 			Action: "read",
@@ -409,6 +435,16 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/AccountingReport": {{
+			Entity: "onchain",
+			Action: "read",
+		}, {
+			Entity: "offchain",
+			Action: "read",
+		}, {
+			Entity: "invoices",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/RestoreChannelBackups": {{
 			Entity: "offchain",
 			Action: "write",
@@ -429,6 +465,10 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ListBackupSinks": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ChannelAcceptor": {{
 			Entity: "onchain",
 			Action: "write",
@@ -440,6 +480,18 @@ func mainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "macaroon",
 			Action: "generate",
 		}},
+		"/lnrpc.Lightning/ListMacaroonIDs": {{
+			Entity: "macaroon",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/DeleteMacaroonID": {{
+			Entity: "macaroon",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListPermissions": {{
+			Entity: "info",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SubscribePeerEvents": {{
 			Entity: "peers",
 			Action: "read",
@@ -509,6 +561,10 @@ type rpcServer struct {
 	// macaroons.
 	macService *macaroons.Service
 
+	// allPermissions is a map of all registered gRPC URIs (including
+	// internal and external subservers) to the permissions they require.
+	allPermissions map[string][]bakery.Op
+
 	// selfNode is our own pubkey.
 	selfNode route.Vertex
 }
@@ -537,6 +593,28 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		return nil, err
 	}
 	graph := s.chanDB.ChannelGraph()
+
+	// If a trampoline node is configured, payments can be routed through
+	// it on request. The fee of the trampoline node depends on the amount
+	// of the payment.
+	var trampolineFor func(lnwire.MilliSatoshi) *routing.Trampoline
+	if cfg.Trampoline.Node != "" {
+		trampolineNode, err := route.NewVertexFromStr(cfg.Trampoline.Node)
+		if err != nil {
+			return nil, err
+		}
+
+		feeBase := lnwire.MilliSatoshi(cfg.Trampoline.FeeBaseMSat)
+		feeRate := lnwire.MilliSatoshi(cfg.Trampoline.FeeRate)
+		trampolineFor = func(amt lnwire.MilliSatoshi) *routing.Trampoline {
+			return &routing.Trampoline{
+				Node:      trampolineNode,
+				Fee:       feeBase + amt*feeRate/1000000,
+				CltvDelta: cfg.Trampoline.CltvDelta,
+			}
+		}
+	}
+
 	routerBackend := &routerrpc.RouterBackend{
 		MaxPaymentMSat: MaxPaymentMSat,
 		SelfNode:       selfNode.PubKeyBytes,
@@ -570,6 +648,7 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		Tower:                 s.controlTower,
 		MaxTotalTimelock:      cfg.MaxOutgoingCltvExpiry,
 		DefaultFinalCltvDelta: uint16(cfg.Bitcoin.TimeLockDelta),
+		Trampoline:            trampolineFor,
 	}
 
 	genInvoiceFeatures := func() *lnwire.FeatureVector {
@@ -589,6 +668,7 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		s.htlcSwitch, activeNetParams.Params, s.chanRouter,
 		routerBackend, s.nodeSigner, s.chanDB, s.sweeper, tower,
 		s.towerClient, cfg.net.ResolveTCPAddr, genInvoiceFeatures,
+		s.channelUptime,
 	)
 	if err != nil {
 		return nil, err
@@ -692,6 +772,7 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		chanPredicate:   chanPredicate,
 		quit:            make(chan struct{}, 1),
 		macService:      macService,
+		allPermissions:  permissions,
 		selfNode:        selfNode.PubKeyBytes,
 	}
 	lnrpc.RegisterLightningServer(grpcServer, rootRPCServer)
@@ -860,16 +941,20 @@ func addrPairsToOutputs(addrPairs map[string]int64) ([]*wire.TxOut, error) {
 
 // sendCoinsOnChain makes an on-chain transaction in or to send coins to one or
 // more addresses specified in the passed payment map. The payment map maps an
-// address to a specified output value to be sent to that address.
-func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
-	feeRate chainfee.SatPerKWeight) (*chainhash.Hash, error) {
+// address to a specified output value to be sent to that address. If a set of
+// inputs is given, exactly those outputs of the wallet are spent.
+func (r *rpcServer) sendCoinsOnChain(inputs []wire.OutPoint,
+	paymentMap map[string]int64, feeRate chainfee.SatPerKWeight,
+	minConfs int32, label string) (*chainhash.Hash, error) {
 
 	outputs, err := addrPairsToOutputs(paymentMap)
 	if err != nil {
 		return nil, err
 	}
 
-	tx, err := r.server.cc.wallet.SendOutputs(outputs, feeRate)
+	tx, err := r.server.cc.wallet.SendOutputs(
+		inputs, outputs, feeRate, minConfs, label,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1045,6 +1130,24 @@ func (r *rpcServer) EstimateFee(ctx context.Context,
 func (r *rpcServer) SendCoins(ctx context.Context,
 	in *lnrpc.SendCoinsRequest) (*lnrpc.SendCoinsResponse, error) {
 
+	// Make sure the label provided, if any, is a valid one before we
+	// construct the transaction.
+	if err := labels.ValidateAPI(in.Label); err != nil {
+		return nil, err
+	}
+
+	// Then, we'll extract the minimum number of confirmations that each
+	// output we use to fund the transaction should satisfy, along with the
+	// outputs the caller wants to spend, if any.
+	minConfs, err := extractMinConfs(in.MinConfs, in.SpendUnconfirmed)
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := lnrpc.UnmarshallOutPoints(in.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+
 	// Based on the passed fee related parameters, we'll determine an
 	// appropriate fee rate for this transaction.
 	satPerKw := chainfee.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
@@ -1113,7 +1216,8 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		sweepTxPkg, err := sweep.CraftSweepAllTx(
 			feePerKw, uint32(bestHeight), targetAddr, wallet,
 			wallet.WalletController, wallet.WalletController,
-			r.server.cc.feeEstimator, r.server.cc.signer,
+			r.server.cc.feeEstimator, r.server.cc.signer, minConfs,
+			inputs,
 		)
 		if err != nil {
 			return nil, err
@@ -1125,7 +1229,7 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		// As our sweep transaction was created, successfully, we'll
 		// now attempt to publish it, cancelling the sweep pkg to
 		// return all outputs if it fails.
-		err = wallet.PublishTransaction(sweepTxPkg.SweepTx)
+		err = wallet.PublishTransaction(sweepTxPkg.SweepTx, in.Label)
 		if err != nil {
 			sweepTxPkg.CancelSweepAttempt()
 
@@ -1143,7 +1247,10 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		// while we instruct the wallet to send this transaction.
 		paymentMap := map[string]int64{targetAddr.String(): in.Amount}
 		err := wallet.WithCoinSelectLock(func() error {
-			newTXID, err := r.sendCoinsOnChain(paymentMap, feePerKw)
+			newTXID, err := r.sendCoinsOnChain(
+				inputs, paymentMap, feePerKw, minConfs,
+				in.Label,
+			)
 			if err != nil {
 				return err
 			}
@@ -1167,6 +1274,24 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 func (r *rpcServer) SendMany(ctx context.Context,
 	in *lnrpc.SendManyRequest) (*lnrpc.SendManyResponse, error) {
 
+	// Make sure the label provided, if any, is a valid one before we
+	// construct the transaction.
+	if err := labels.ValidateAPI(in.Label); err != nil {
+		return nil, err
+	}
+
+	// Then, we'll extract the minimum number of confirmations that each
+	// output we use to fund the transaction should satisfy, along with the
+	// outputs the caller wants to spend, if any.
+	minConfs, err := extractMinConfs(in.MinConfs, in.SpendUnconfirmed)
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := lnrpc.UnmarshallOutPoints(in.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+
 	// Based on the passed fee related parameters, we'll determine an
 	// appropriate fee rate for this transaction.
 	satPerKw := chainfee.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
@@ -1191,7 +1316,7 @@ func (r *rpcServer) SendMany(ctx context.Context,
 	wallet := r.server.cc.wallet
 	err = wallet.WithCoinSelectLock(func() error {
 		sendManyTXID, err := r.sendCoinsOnChain(
-			in.AddrToAmount, feePerKw,
+			inputs, in.AddrToAmount, feePerKw, minConfs, in.Label,
 		)
 		if err != nil {
 			return err
@@ -1358,7 +1483,7 @@ func (r *rpcServer) ConnectPeer(ctx context.Context,
 	}
 
 	// Connections to ourselves are disallowed for obvious reasons.
-	if pubKey.IsEqual(r.server.identityPriv.PubKey()) {
+	if pubKey.IsEqual(r.server.identityECDH.PubKey()) {
 		return nil, fmt.Errorf("cannot make connection to self")
 	}
 
@@ -1434,39 +1559,38 @@ func (r *rpcServer) DisconnectPeer(ctx context.Context,
 	return &lnrpc.DisconnectPeerResponse{}, nil
 }
 
-// extractOpenChannelMinConfs extracts the minimum number of confirmations from
-// the OpenChannelRequest that each output used to fund the channel's funding
-// transaction should satisfy.
-func extractOpenChannelMinConfs(in *lnrpc.OpenChannelRequest) (int32, error) {
+// extractMinConfs extracts the minimum number of confirmations that each
+// output used to fund a transaction should satisfy from the min_confs and
+// spend_unconfirmed fields of a request.
+func extractMinConfs(minConfs int32, spendUnconfirmed bool) (int32, error) {
 	switch {
 	// Ensure that the MinConfs parameter is non-negative.
-	case in.MinConfs < 0:
+	case minConfs < 0:
 		return 0, errors.New("minimum number of confirmations must " +
 			"be a non-negative number")
 
-	// The funding transaction should not be funded with unconfirmed outputs
-	// unless explicitly specified by SpendUnconfirmed. We do this to
-	// provide sane defaults to the OpenChannel RPC, as otherwise, if the
-	// MinConfs field isn't explicitly set by the caller, we'll use
-	// unconfirmed outputs without the caller being aware.
-	case in.MinConfs == 0 && !in.SpendUnconfirmed:
+	// The transaction should not be funded with unconfirmed outputs unless
+	// explicitly specified by SpendUnconfirmed. We do this to provide sane
+	// defaults to the RPCs, as otherwise, if the MinConfs field isn't
+	// explicitly set by the caller, we'll use unconfirmed outputs without
+	// the caller being aware.
+	case minConfs == 0 && !spendUnconfirmed:
 		return 1, nil
 
 	// In the event that the caller set MinConfs > 0 and SpendUnconfirmed to
 	// true, we'll return an error to indicate the conflict.
-	case in.MinConfs > 0 && in.SpendUnconfirmed:
+	case minConfs > 0 && spendUnconfirmed:
 		return 0, errors.New("SpendUnconfirmed set to true with " +
 			"MinConfs > 0")
 
-	// The funding transaction of the new channel to be created can be
-	// funded with unconfirmed outputs.
-	case in.SpendUnconfirmed:
+	// The transaction can be funded with unconfirmed outputs.
+	case spendUnconfirmed:
 		return 0, nil
 
 	// If none of the above cases matched, we'll return the value set
 	// explicitly by the caller.
 	default:
-		return in.MinConfs, nil
+		return minConfs, nil
 	}
 }
 
@@ -1599,7 +1723,14 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	// Then, we'll extract the minimum number of confirmations that each
 	// output we use to fund the channel's funding transaction should
 	// satisfy.
-	minConfs, err := extractOpenChannelMinConfs(in)
+	minConfs, err := extractMinConfs(in.MinConfs, in.SpendUnconfirmed)
+	if err != nil {
+		return err
+	}
+
+	// If the caller wants specific outputs to fund the channel, we'll
+	// restrict coin selection to them.
+	outpoints, err := lnrpc.UnmarshallOutPoints(in.Outpoints)
 	if err != nil {
 		return err
 	}
@@ -1625,7 +1756,7 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 
 	// Making a channel to ourselves wouldn't be of any use, so we
 	// explicitly disallow them.
-	if nodePubKey.IsEqual(r.server.identityPriv.PubKey()) {
+	if nodePubKey.IsEqual(r.server.identityECDH.PubKey()) {
 		return fmt.Errorf("cannot open channel to self")
 	}
 
@@ -1652,6 +1783,12 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		return fmt.Errorf("error parsing upfront shutdown: %v", err)
 	}
 
+	// Make sure the label provided for the funding transaction, if any, is
+	// a valid one.
+	if err := labels.ValidateAPI(in.Label); err != nil {
+		return err
+	}
+
 	// Instruct the server to trigger the necessary events to attempt to
 	// open a new channel. A stream is returned in place, this stream will
 	// be used to consume updates of the state of the pending channel.
@@ -1665,12 +1802,21 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		outpoints:       outpoints,
 		shutdownScript:  script,
+		label:           in.Label,
 	}
 
 	// If the user has provided a shim, then we'll now augment the based
 	// open channel request with this additional logic.
 	if in.FundingShim != nil {
+		// A funding shim takes over the funding of the channel, so
+		// restricting the wallet's coin selection makes no sense.
+		if len(outpoints) != 0 {
+			return fmt.Errorf("outpoints cannot be set when using " +
+				"a funding shim")
+		}
+
 		// If we have a chan point shim, then this means the funding
 		// transaction was crafted externally. In this case we only
 		// need to hand a channel point down into the wallet.
@@ -1799,7 +1945,14 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	// Then, we'll extract the minimum number of confirmations that each
 	// output we use to fund the channel's funding transaction should
 	// satisfy.
-	minConfs, err := extractOpenChannelMinConfs(in)
+	minConfs, err := extractMinConfs(in.MinConfs, in.SpendUnconfirmed)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the caller wants specific outputs to fund the channel, we'll
+	// restrict coin selection to them.
+	outpoints, err := lnrpc.UnmarshallOutPoints(in.Outpoints)
 	if err != nil {
 		return nil, err
 	}
@@ -1825,6 +1978,12 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 		return nil, fmt.Errorf("error parsing upfront shutdown: %v", err)
 	}
 
+	// Make sure the label provided for the funding transaction, if any, is
+	// a valid one.
+	if err := labels.ValidateAPI(in.Label); err != nil {
+		return nil, err
+	}
+
 	req := &openChanReq{
 		targetPubkey:    nodepubKey,
 		chainHash:       *activeNetParams.GenesisHash,
@@ -1835,7 +1994,9 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		outpoints:       outpoints,
 		shutdownScript:  script,
+		label:           in.Label,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -2308,7 +2469,7 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 	}
 	nPendingChannels := uint32(len(pendingChannels))
 
-	idPub := r.server.identityPriv.PubKey().SerializeCompressed()
+	idPub := r.server.identityECDH.PubKey().SerializeCompressed()
 	encodedIDPub := hex.EncodeToString(idPub)
 
 	bestHash, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
@@ -2385,6 +2546,64 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 	}, nil
 }
 
+// GetRecoveryInfo returns a boolean indicating whether the wallet is started
+// in recovery mode, whether the recovery is finished, and the progress made
+// so far, including the rescan for commitment outputs of closed channels.
+func (r *rpcServer) GetRecoveryInfo(ctx context.Context,
+	in *lnrpc.GetRecoveryInfoRequest) (*lnrpc.GetRecoveryInfoResponse, error) {
+
+	info, err := r.server.cc.wallet.GetRecoveryInfo()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get wallet recovery info: %v",
+			err)
+	}
+
+	resp := &lnrpc.GetRecoveryInfoResponse{
+		RecoveryMode:     info.RecoveryMode,
+		RecoveryFinished: info.RecoveryFinished,
+		Progress:         info.Progress,
+		BirthdayHeight:   info.BirthdayHeight,
+	}
+
+	for family, count := range info.KeyCounts {
+		resp.KeyFamilies = append(
+			resp.KeyFamilies, &lnrpc.KeyFamilyRecovery{
+				KeyFamily: uint32(family),
+				KeyCount:  count,
+			},
+		)
+	}
+	sort.Slice(resp.KeyFamilies, func(i, j int) bool {
+		return resp.KeyFamilies[i].KeyFamily <
+			resp.KeyFamilies[j].KeyFamily
+	})
+
+	if r.server.commitRecovery == nil {
+		return resp, nil
+	}
+
+	status := r.server.commitRecovery.Status()
+	resp.CommitOutputs = &lnrpc.CommitOutputRecovery{
+		Finished: status.Finished,
+		Progress: status.Progress,
+	}
+	for _, output := range status.Outputs {
+		resp.CommitOutputs.Outputs = append(
+			resp.CommitOutputs.Outputs,
+			&lnrpc.RecoveredCommitOutput{
+				Outpoint:   output.OutPoint.String(),
+				AmountSat:  int64(output.Value),
+				KeyFamily:  uint32(output.KeyDesc.Family),
+				KeyIndex:   output.KeyDesc.Index,
+				ConfHeight: output.ConfHeight,
+				Swept:      output.Swept,
+			},
+		)
+	}
+
+	return resp, nil
+}
+
 // ListPeers returns a verbose listing of all currently active peers.
 func (r *rpcServer) ListPeers(ctx context.Context,
 	in *lnrpc.ListPeersRequest) (*lnrpc.ListPeersResponse, error) {
@@ -2539,13 +2758,54 @@ func (r *rpcServer) WalletBalance(ctx context.Context,
 	rpcsLog.Debugf("[walletbalance] Total balance=%v (confirmed=%v, "+
 		"unconfirmed=%v)", totalBal, confirmedBal, unconfirmedBal)
 
+	// Finally, we'll break the balance down by the accounts of the
+	// wallet.
+	accounts, err := r.server.cc.wallet.ListAccounts("")
+	if err != nil {
+		return nil, err
+	}
+	utxos, err := r.server.cc.wallet.ListUnspentWitness(0, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+
 	return &lnrpc.WalletBalanceResponse{
 		TotalBalance:       int64(totalBal),
 		ConfirmedBalance:   int64(confirmedBal),
 		UnconfirmedBalance: int64(unconfirmedBal),
+		AccountBalance:     walletAccountBalances(accounts, utxos),
 	}, nil
 }
 
+// walletAccountBalances sums up the confirmed and unconfirmed balance of each
+// account from the unspent outputs of the wallet. Every account shows up, even
+// if it's empty. Accounts of different address types that share a name are
+// reported together.
+func walletAccountBalances(accounts []*lnwallet.AccountInfo,
+	utxos []*lnwallet.Utxo) map[string]*lnrpc.WalletAccountBalance {
+
+	accountBalances := make(map[string]*lnrpc.WalletAccountBalance)
+	for _, account := range accounts {
+		accountBalances[account.Name] = &lnrpc.WalletAccountBalance{}
+	}
+
+	for _, utxo := range utxos {
+		balance, ok := accountBalances[utxo.Account]
+		if !ok {
+			balance = &lnrpc.WalletAccountBalance{}
+			accountBalances[utxo.Account] = balance
+		}
+
+		if utxo.Confirmations > 0 {
+			balance.ConfirmedBalance += int64(utxo.Value)
+		} else {
+			balance.UnconfirmedBalance += int64(utxo.Value)
+		}
+	}
+
+	return accountBalances
+}
+
 // ChannelBalance returns the total available channel flow across all open
 // channels in satoshis.
 func (r *rpcServer) ChannelBalance(ctx context.Context,
@@ -3389,7 +3649,7 @@ type rpcPaymentRequest struct {
 func (r *rpcServer) SendPayment(stream lnrpc.Lightning_SendPaymentServer) error {
 	var lock sync.Mutex
 
-	return r.sendPayment(&paymentStream{
+	return r.sendPayment(stream.Context(), &paymentStream{
 		recv: func() (*rpcPaymentRequest, error) {
 			req, err := stream.Recv()
 			if err != nil {
@@ -3417,7 +3677,7 @@ func (r *rpcServer) SendPayment(stream lnrpc.Lightning_SendPaymentServer) error
 func (r *rpcServer) SendToRoute(stream lnrpc.Lightning_SendToRouteServer) error {
 	var lock sync.Mutex
 
-	return r.sendPayment(&paymentStream{
+	return r.sendPayment(stream.Context(), &paymentStream{
 		recv: func() (*rpcPaymentRequest, error) {
 			req, err := stream.Recv()
 			if err != nil {
@@ -3606,6 +3866,14 @@ func (r *rpcServer) extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPayme
 		payIntent.destFeatures = payReq.Features
 		payIntent.paymentAddr = payReq.PaymentAddr
 
+		// Invoices that require AMP can only be paid through the
+		// router's SendPayment, which splits the payment into shards.
+		if payReq.Features.HasFeature(lnwire.AMPRequired) {
+			return payIntent, errors.New("invoice requires an amp " +
+				"payment, which is only supported by " +
+				"routerrpc.SendPayment")
+		}
+
 		if err := validateDest(payIntent.dest); err != nil {
 			return payIntent, err
 		}
@@ -3706,6 +3974,37 @@ type paymentIntentResponse struct {
 	Err      error
 }
 
+// validatePaymentCaveats checks the payment intent against the spending limit
+// caveats of the macaroon within the context, and deducts the payment from its
+// budgets.
+func (r *rpcServer) validatePaymentCaveats(ctx context.Context,
+	payIntent *rpcPaymentIntent) error {
+
+	// If macaroons are disabled, there are no spending limits to enforce.
+	if r.macService == nil {
+		return nil
+	}
+
+	// The payment may take up to its amount plus the fee limit from our
+	// channels.
+	payment := &macaroons.Payment{
+		Amount: payIntent.msat + payIntent.feeLimit,
+		Dest:   payIntent.dest,
+	}
+
+	// For payments along a pre-built route, the amount including all
+	// fees is given by the route, and the caller chose every hop.
+	if payIntent.route != nil {
+		var err error
+		payment, err = macaroons.PaymentFromRoute(payIntent.route)
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.macService.ValidatePayment(ctx, payment)
+}
+
 // dispatchPaymentIntent attempts to fully dispatch an RPC payment intent.
 // We'll either pass the payment as a whole to the channel router, or give it a
 // pre-built route. The first error this method returns denotes if we were
@@ -3775,7 +4074,9 @@ func (r *rpcServer) dispatchPaymentIntent(
 // the write end of the stream. Responses will also be streamed back to the
 // client via the write end of the stream. This method is by both SendToRoute
 // and SendPayment as the logic is virtually identical.
-func (r *rpcServer) sendPayment(stream *paymentStream) error {
+func (r *rpcServer) sendPayment(ctx context.Context,
+	stream *paymentStream) error {
+
 	payChan := make(chan *rpcPaymentIntent)
 	errChan := make(chan error, 1)
 
@@ -3847,6 +4148,11 @@ func (r *rpcServer) sendPayment(stream *paymentStream) error {
 				payIntent, err := r.extractPaymentIntent(
 					nextPayment,
 				)
+				if err == nil {
+					err = r.validatePaymentCaveats(
+						ctx, &payIntent,
+					)
+				}
 				if err != nil {
 					if err := stream.send(&lnrpc.SendResponse{
 						PaymentError: err.Error(),
@@ -4038,6 +4344,12 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 		return nil, err
 	}
 
+	// Make sure the payment is allowed by the spending limits of the
+	// macaroon used to authenticate the request.
+	if err := r.validatePaymentCaveats(ctx, &payIntent); err != nil {
+		return nil, err
+	}
+
 	// With the payment validated, we'll now attempt to dispatch the
 	// payment.
 	resp, saveErr := r.dispatchPaymentIntent(&payIntent)
@@ -4086,6 +4398,7 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		GenInvoiceFeatures: func() *lnwire.FeatureVector {
 			return r.server.featureMgr.Get(feature.SetInvoice)
 		},
+		GetChannelUptime: r.server.channelUptime,
 	}
 
 	value, err := lnrpc.UnmarshallAmt(invoice.Value, invoice.ValueMsat)
@@ -4101,6 +4414,9 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		FallbackAddr:    invoice.FallbackAddr,
 		CltvExpiry:      invoice.CltvExpiry,
 		Private:         invoice.Private,
+		Amp:             invoice.IsAmp,
+		Reusable:        invoice.Reusable,
+		HintPolicy:      invoicesrpc.HintPolicy(invoice.HintPolicy),
 	}
 
 	if invoice.RPreimage != nil {
@@ -4356,6 +4672,7 @@ func (r *rpcServer) GetTransactions(ctx context.Context,
 			TotalFees:        tx.TotalFees,
 			DestAddresses:    destAddresses,
 			RawTxHex:         hex.EncodeToString(tx.RawTx),
+			Label:            tx.Label,
 		}
 	}
 
@@ -5091,6 +5408,124 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 	}, nil
 }
 
+// defaultFetchInvoiceTimeout is the time FetchInvoice waits for the issuer of
+// an offer to reply, if the request doesn't specify a timeout.
+const defaultFetchInvoiceTimeout = time.Minute
+
+// AddOffer creates a new offer of this node, which payers can fetch invoices
+// for over onion messages.
+func (r *rpcServer) AddOffer(ctx context.Context,
+	req *lnrpc.AddOfferRequest) (*lnrpc.AddOfferResponse, error) {
+
+	if req.AmtMsat < 0 {
+		return nil, fmt.Errorf("amount cannot be negative")
+	}
+	if req.Expiry < 0 {
+		return nil, fmt.Errorf("expiry cannot be negative")
+	}
+
+	var expiry time.Time
+	if req.Expiry != 0 {
+		expiry = time.Now().Add(time.Duration(req.Expiry) * time.Second)
+	}
+
+	offer, err := offers.NewOffer(
+		*activeNetParams.GenesisHash, r.server.identityECDH.PubKey(),
+		lnwire.MilliSatoshi(req.AmtMsat), req.Description, expiry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := offer.Encode()
+	if err != nil {
+		return nil, err
+	}
+	offerID, err := offer.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.server.chanDB.AddOffer(offerID, encoded); err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[addoffer] added offer %x", offerID[:])
+
+	return &lnrpc.AddOfferResponse{
+		Offer:   encoded,
+		OfferId: offerID[:],
+	}, nil
+}
+
+// ListOffers returns all offers this node created.
+func (r *rpcServer) ListOffers(ctx context.Context,
+	req *lnrpc.ListOffersRequest) (*lnrpc.ListOffersResponse, error) {
+
+	encodedOffers, err := r.server.chanDB.FetchOffers()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ListOffersResponse{}
+	for _, encoded := range encodedOffers {
+		offer, err := offers.DecodeOffer(encoded)
+		if err != nil {
+			return nil, err
+		}
+		offerID, err := offer.ID()
+		if err != nil {
+			return nil, err
+		}
+
+		rpcOffer := &lnrpc.Offer{
+			Offer:       encoded,
+			OfferId:     offerID[:],
+			Description: offer.Description,
+			AmtMsat:     int64(offer.Amount),
+		}
+		if !offer.Expiry.IsZero() {
+			rpcOffer.Expiry = offer.Expiry.Unix()
+		}
+
+		resp.Offers = append(resp.Offers, rpcOffer)
+	}
+
+	return resp, nil
+}
+
+// FetchInvoice requests an invoice for an offer from its issuer over onion
+// messages.
+func (r *rpcServer) FetchInvoice(ctx context.Context,
+	req *lnrpc.FetchInvoiceRequest) (*lnrpc.FetchInvoiceResponse, error) {
+
+	offer, err := offers.DecodeOffer(req.Offer)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.AmtMsat < 0 {
+		return nil, fmt.Errorf("amount cannot be negative")
+	}
+
+	timeout := defaultFetchInvoiceTimeout
+	if req.TimeoutSeconds > 0 {
+		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	}
+
+	payReq, err := r.server.offerManager.FetchInvoice(
+		offer, lnwire.MilliSatoshi(req.AmtMsat), req.PayerNote,
+		timeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.FetchInvoiceResponse{
+		PaymentRequest: payReq,
+	}, nil
+}
+
 // feeBase is the fixed point that fee rate computation are performed over.
 // Nodes on the network advertise their fee rate using this point as a base.
 // This means that the minimal possible fee rate if 1e-6, or 0.000001, or
@@ -5419,6 +5854,167 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 	return resp, nil
 }
 
+// AccountingReport returns a ledger of every event that changed the balance of
+// the node within the target time range, along with its totals.
+func (r *rpcServer) AccountingReport(ctx context.Context,
+	req *lnrpc.AccountingReportRequest) (*lnrpc.AccountingReportResponse,
+	error) {
+
+	rpcsLog.Debugf("[accountingreport]")
+
+	// Flush the pending forwarding events to disk first, so that the
+	// report includes all forwards up to now.
+	if err := r.server.htlcSwitch.FlushForwardingEvents(); err != nil {
+		return nil, fmt.Errorf("unable to flush forwarding "+
+			"events: %v", err)
+	}
+
+	startTime := time.Unix(int64(req.StartTime), 0)
+	endTime := time.Now()
+	if req.EndTime != 0 {
+		endTime = time.Unix(int64(req.EndTime), 0)
+	}
+
+	chanDB := r.server.chanDB
+	ledger, err := accounting.NewLedger(&accounting.Config{
+		FetchInvoices: func() ([]channeldb.InvoiceWithPaymentHash,
+			error) {
+
+			invoices, err := chanDB.FetchAllInvoicesWithPaymentHash(
+				false,
+			)
+			if err == channeldb.ErrNoInvoicesCreated {
+				return nil, nil
+			}
+			return invoices, err
+		},
+		FetchPayments:           chanDB.FetchPayments,
+		FetchTrampolineForwards: chanDB.FetchTrampolineForwards,
+		QueryForwards: func(startTime, endTime time.Time) (
+			[]channeldb.ForwardingEvent, error) {
+
+			return queryAllForwards(chanDB, startTime, endTime)
+		},
+		FetchTransactions: r.server.cc.wallet.ListTransactionDetails,
+		FetchChannelTxids: func() (map[chainhash.Hash]struct{},
+			map[chainhash.Hash]struct{}, error) {
+
+			return fetchChannelTxids(chanDB)
+		},
+		IsSweepTx: r.server.sweeper.IsSweepTx,
+	}, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.AccountingReportResponse{
+		Entries: make([]*lnrpc.LedgerEntry, 0, len(ledger.Entries)),
+		Totals: &lnrpc.AccountingTotals{
+			ReceivedMsat:        ledger.Totals.Received,
+			SentMsat:            ledger.Totals.Sent,
+			PaymentFeesMsat:     ledger.Totals.PaymentFees,
+			ForwardFeesMsat:     ledger.Totals.ForwardFees,
+			OnChainReceivedMsat: ledger.Totals.OnChainReceived,
+			OnChainSentMsat:     ledger.Totals.OnChainSent,
+			OnChainFeesMsat:     ledger.Totals.OnChainFees,
+			NetMsat:             ledger.Totals.Net,
+		},
+	}
+	for _, entry := range ledger.Entries {
+		var entryType lnrpc.LedgerEntry_EntryType
+		switch entry.Type {
+		case accounting.EntryReceipt:
+			entryType = lnrpc.LedgerEntry_RECEIPT
+		case accounting.EntryPayment:
+			entryType = lnrpc.LedgerEntry_PAYMENT
+		case accounting.EntryForward:
+			entryType = lnrpc.LedgerEntry_FORWARD
+		case accounting.EntryOnChain:
+			entryType = lnrpc.LedgerEntry_ON_CHAIN
+		case accounting.EntryChannelOpen:
+			entryType = lnrpc.LedgerEntry_CHANNEL_OPEN
+		case accounting.EntryChannelClose:
+			entryType = lnrpc.LedgerEntry_CHANNEL_CLOSE
+		default:
+			return nil, fmt.Errorf("unknown ledger entry type %v",
+				entry.Type)
+		}
+
+		resp.Entries = append(resp.Entries, &lnrpc.LedgerEntry{
+			Timestamp:      entry.Timestamp.Unix(),
+			Type:           entryType,
+			AmountMsat:     entry.Amount,
+			FeeMsat:        entry.Fee,
+			ChanId:         entry.ChannelID,
+			OutgoingChanId: entry.OutgoingChannelID,
+			Reference:      entry.Reference,
+			Note:           entry.Note,
+		})
+	}
+
+	return resp, nil
+}
+
+// queryAllForwards returns all forwarding events in the given time range,
+// querying the forwarding log page by page.
+func queryAllForwards(chanDB *channeldb.DB, startTime,
+	endTime time.Time) ([]channeldb.ForwardingEvent, error) {
+
+	var (
+		events []channeldb.ForwardingEvent
+		offset uint32
+	)
+	for {
+		timeSlice, err := chanDB.ForwardingLog().Query(
+			channeldb.ForwardingEventQuery{
+				StartTime:    startTime,
+				EndTime:      endTime,
+				IndexOffset:  offset,
+				NumMaxEvents: channeldb.MaxResponseEvents,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query forwarding "+
+				"log: %v", err)
+		}
+
+		events = append(events, timeSlice.ForwardingEvents...)
+		if len(timeSlice.ForwardingEvents) < channeldb.MaxResponseEvents {
+			return events, nil
+		}
+
+		offset = timeSlice.LastIndexOffset
+	}
+}
+
+// fetchChannelTxids returns the ids of the transactions that funded and
+// closed the channels of the node.
+func fetchChannelTxids(chanDB *channeldb.DB) (map[chainhash.Hash]struct{},
+	map[chainhash.Hash]struct{}, error) {
+
+	funding := make(map[chainhash.Hash]struct{})
+	closing := make(map[chainhash.Hash]struct{})
+
+	channels, err := chanDB.FetchAllChannels()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, channel := range channels {
+		funding[channel.FundingOutpoint.Hash] = struct{}{}
+	}
+
+	closed, err := chanDB.FetchClosedChannels(false)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, summary := range closed {
+		funding[summary.ChanPoint.Hash] = struct{}{}
+		closing[summary.ClosingTXID] = struct{}{}
+	}
+
+	return funding, closing, nil
+}
+
 // ExportChannelBackup attempts to return an encrypted static channel backup
 // for the target channel identified by it channel point. The backup is
 // encrypted with a key generated from the aezeed seed of the user. The
@@ -5757,6 +6353,42 @@ func (r *rpcServer) SubscribeChannelBackups(req *lnrpc.ChannelBackupSubscription
 	}
 }
 
+// ListBackupSinks returns the status of every configured sink that the
+// encrypted multi-channel backup is shipped to each time it is updated.
+func (r *rpcServer) ListBackupSinks(ctx context.Context,
+	in *lnrpc.ListBackupSinksRequest) (*lnrpc.ListBackupSinksResponse,
+	error) {
+
+	resp := &lnrpc.ListBackupSinksResponse{}
+
+	// If no sinks are configured, there's nothing to report.
+	if r.server.backupSinks == nil {
+		return resp, nil
+	}
+
+	for _, status := range r.server.backupSinks.SinkStatuses() {
+		rpcStatus := &lnrpc.BackupSinkStatus{
+			Name:        status.Name,
+			LastVersion: status.LastVersion,
+			NumPushes:   status.NumPushes,
+			NumFailures: status.NumFailures,
+		}
+		if !status.LastAttempt.IsZero() {
+			rpcStatus.LastAttempt = status.LastAttempt.Unix()
+		}
+		if !status.LastSuccess.IsZero() {
+			rpcStatus.LastSuccess = status.LastSuccess.Unix()
+		}
+		if status.LastErr != nil {
+			rpcStatus.LastError = status.LastErr.Error()
+		}
+
+		resp.Sinks = append(resp.Sinks, rpcStatus)
+	}
+
+	return resp, nil
+}
+
 // chanAcceptInfo is used in the ChannelAcceptor bidirectional stream and
 // encapsulates the request information sent from the RPCAcceptor to the
 // RPCServer.
@@ -5937,7 +6569,8 @@ func (r *rpcServer) BakeMacaroon(ctx context.Context,
 	}
 
 	helpMsg := fmt.Sprintf("supported actions are %v, supported entities "+
-		"are %v", validActions, validEntities)
+		"are %v, or the entity %q with a full method URI as action",
+		validActions, validEntities, macaroons.PermissionEntityCustomURI)
 
 	// Don't allow empty permission list as it doesn't make sense to have
 	// a macaroon that is not allowed to access any RPC.
@@ -5950,6 +6583,22 @@ func (r *rpcServer) BakeMacaroon(ctx context.Context,
 	// the bakery.
 	requestedPermissions := make([]bakery.Op, len(req.Permissions))
 	for idx, op := range req.Permissions {
+		// A custom URI permission must reference a method that we
+		// actually know the permissions of.
+		if op.Entity == macaroons.PermissionEntityCustomURI {
+			if _, ok := r.allPermissions[op.Action]; !ok {
+				return nil, fmt.Errorf("invalid permission "+
+					"action, %s is not a known method URI",
+					op.Action)
+			}
+
+			requestedPermissions[idx] = bakery.Op{
+				Entity: op.Entity,
+				Action: op.Action,
+			}
+			continue
+		}
+
 		if !stringInSlice(op.Action, validActions) {
 			return nil, fmt.Errorf("invalid permission action. %s",
 				helpMsg)
@@ -5965,10 +6614,16 @@ func (r *rpcServer) BakeMacaroon(ctx context.Context,
 		}
 	}
 
+	// Convert root key id from uint64 to bytes. Because the
+	// DefaultRootKeyID is a digit 0 expressed in a byte slice of a string
+	// "0", we will keep the IDs in the same format - all must be numeric,
+	// and must be a byte slice of string value of the digit.
+	rootKeyID := []byte(strconv.FormatUint(req.RootKeyId, 10))
+
 	// Bake new macaroon with the given permissions and send it binary
 	// serialized and hex encoded to the client.
-	newMac, err := r.macService.Oven.NewMacaroon(
-		ctx, bakery.LatestVersion, nil, requestedPermissions...,
+	newMac, err := r.macService.NewMacaroon(
+		ctx, rootKeyID, requestedPermissions...,
 	)
 	if err != nil {
 		return nil, err
@@ -5983,6 +6638,96 @@ func (r *rpcServer) BakeMacaroon(ctx context.Context,
 	return resp, nil
 }
 
+// ListPermissions lists all RPC method URIs and their required macaroon
+// permissions to access them.
+func (r *rpcServer) ListPermissions(_ context.Context,
+	_ *lnrpc.ListPermissionsRequest) (*lnrpc.ListPermissionsResponse,
+	error) {
+
+	rpcsLog.Debugf("[listpermissions]")
+
+	permissionMap := make(map[string]*lnrpc.MacaroonPermissionList)
+	for uri, perms := range r.allPermissions {
+		rpcPerms := make([]*lnrpc.MacaroonPermission, len(perms))
+		for idx, perm := range perms {
+			rpcPerms[idx] = &lnrpc.MacaroonPermission{
+				Entity: perm.Entity,
+				Action: perm.Action,
+			}
+		}
+		permissionMap[uri] = &lnrpc.MacaroonPermissionList{
+			Permissions: rpcPerms,
+		}
+	}
+
+	return &lnrpc.ListPermissionsResponse{
+		MethodPermissions: permissionMap,
+	}, nil
+}
+
+// ListMacaroonIDs returns a list of macaroon root key IDs in use.
+func (r *rpcServer) ListMacaroonIDs(ctx context.Context,
+	req *lnrpc.ListMacaroonIDsRequest) (
+	*lnrpc.ListMacaroonIDsResponse, error) {
+
+	rpcsLog.Debugf("[listmacaroonids]")
+
+	// If the --no-macaroons flag is used to start lnd, the macaroon service
+	// is not initialized. Therefore we can't show any IDs.
+	if r.macService == nil {
+		return nil, fmt.Errorf("macaroon authentication disabled, " +
+			"remove --no-macaroons flag to enable")
+	}
+
+	rootKeyIDByteSlice, err := r.macService.ListMacaroonIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rootKeyIDs []uint64
+	for _, value := range rootKeyIDByteSlice {
+		// Convert bytes into uint64.
+		id, err := strconv.ParseUint(string(value), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		rootKeyIDs = append(rootKeyIDs, id)
+	}
+
+	return &lnrpc.ListMacaroonIDsResponse{RootKeyIds: rootKeyIDs}, nil
+}
+
+// DeleteMacaroonID removes a specific macaroon ID, invalidating all
+// macaroons derived from it.
+func (r *rpcServer) DeleteMacaroonID(ctx context.Context,
+	req *lnrpc.DeleteMacaroonIDRequest) (
+	*lnrpc.DeleteMacaroonIDResponse, error) {
+
+	rpcsLog.Debugf("[deletemacaroonid]")
+
+	// If the --no-macaroons flag is used to start lnd, the macaroon service
+	// is not initialized. Therefore we can't delete any IDs.
+	if r.macService == nil {
+		return nil, fmt.Errorf("macaroon authentication disabled, " +
+			"remove --no-macaroons flag to enable")
+	}
+
+	// Root key IDs are stored as the string value of the digit, just like
+	// the ones created by BakeMacaroon.
+	rootKeyID := []byte(strconv.FormatUint(req.RootKeyId, 10))
+	deletedIDBytes, err := r.macService.DeleteMacaroonID(ctx, rootKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DeleteMacaroonIDResponse{
+		// If the root key ID doesn't exist, it won't be deleted. We
+		// will return a response with deleted = false, otherwise true.
+		Deleted: deletedIDBytes != nil,
+	}, nil
+}
+
 // FundingStateStep is an advanced funding related call that allows the caller
 // to either execute some preparatory steps for a funding workflow, or manually
 // progress a funding workflow. The primary way a funding flow is identified is
@@ -0,0 +1,62 @@
+package lnd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Actinium-project/lnd/lnrpc"
+	"github.com/Actinium-project/lnd/lnwallet"
+)
+
+// TestWalletAccountBalances tests that the balance of the wallet is broken
+// down by account, including the accounts without any outputs.
+func TestWalletAccountBalances(t *testing.T) {
+	t.Parallel()
+
+	accounts := []*lnwallet.AccountInfo{
+		{Name: "default", AddressType: lnwallet.NestedWitnessPubKey},
+		{Name: "default", AddressType: lnwallet.WitnessPubKey},
+		{Name: "savings", AddressType: lnwallet.WitnessPubKey},
+		{Name: "empty", AddressType: lnwallet.WitnessPubKey},
+	}
+	utxos := []*lnwallet.Utxo{
+		{
+			AddressType:   lnwallet.NestedWitnessPubKey,
+			Value:         1000,
+			Confirmations: 1,
+			Account:       "default",
+		},
+		{
+			AddressType:   lnwallet.WitnessPubKey,
+			Value:         2000,
+			Confirmations: 6,
+			Account:       "default",
+		},
+		{
+			AddressType: lnwallet.WitnessPubKey,
+			Value:       4000,
+			Account:     "default",
+		},
+		{
+			AddressType:   lnwallet.WitnessPubKey,
+			Value:         8000,
+			Confirmations: 3,
+			Account:       "savings",
+		},
+	}
+
+	balances := walletAccountBalances(accounts, utxos)
+	expected := map[string]*lnrpc.WalletAccountBalance{
+		"default": {
+			ConfirmedBalance:   3000,
+			UnconfirmedBalance: 4000,
+		},
+		"savings": {
+			ConfirmedBalance: 8000,
+		},
+		"empty": {},
+	}
+	if !reflect.DeepEqual(balances, expected) {
+		t.Fatalf("expected balances %v, got %v", expected, balances)
+	}
+}
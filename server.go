@@ -25,7 +25,6 @@ import (
 	"github.com/Actinium-project/acmutil"
 	"github.com/coreos/bbolt"
 	"github.com/go-errors/errors"
-	sphinx "github.com/Actinium-project/lightning-onion"
 	"github.com/Actinium-project/lnd/autopilot"
 	"github.com/Actinium-project/lnd/brontide"
 	"github.com/Actinium-project/lnd/chanacceptor"
@@ -41,9 +40,11 @@ import (
 	"github.com/Actinium-project/lnd/htlcswitch/hop"
 	"github.com/Actinium-project/lnd/input"
 	"github.com/Actinium-project/lnd/invoices"
+	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/lncfg"
 	"github.com/Actinium-project/lnd/lnpeer"
 	"github.com/Actinium-project/lnd/lnrpc"
+	"github.com/Actinium-project/lnd/lnrpc/invoicesrpc"
 	"github.com/Actinium-project/lnd/lnrpc/routerrpc"
 	"github.com/Actinium-project/lnd/lnwallet"
 	"github.com/Actinium-project/lnd/lnwallet/chainfee"
@@ -51,6 +52,7 @@ import (
 	"github.com/Actinium-project/lnd/lnwire"
 	"github.com/Actinium-project/lnd/nat"
 	"github.com/Actinium-project/lnd/netann"
+	"github.com/Actinium-project/lnd/offers"
 	"github.com/Actinium-project/lnd/peernotifier"
 	"github.com/Actinium-project/lnd/pool"
 	"github.com/Actinium-project/lnd/routing"
@@ -59,6 +61,7 @@ import (
 	"github.com/Actinium-project/lnd/sweep"
 	"github.com/Actinium-project/lnd/ticker"
 	"github.com/Actinium-project/lnd/tor"
+	"github.com/Actinium-project/lnd/trampoline"
 	"github.com/Actinium-project/lnd/walletunlocker"
 	"github.com/Actinium-project/lnd/watchtower/wtclient"
 	"github.com/Actinium-project/lnd/watchtower/wtdb"
@@ -132,9 +135,9 @@ type server struct {
 	start sync.Once
 	stop  sync.Once
 
-	// identityPriv is the private key used to authenticate any incoming
-	// connections.
-	identityPriv *btcec.PrivateKey
+	// identityECDH is an ECDH capable wrapper for the private key used
+	// to authenticate any incoming connections.
+	identityECDH keychain.SingleKeyECDH
 
 	// nodeSigner is an implementation of the MessageSigner implementation
 	// that's backed by the identity private key of the running lnd node.
@@ -223,6 +226,15 @@ type server struct {
 
 	sweeper *sweep.UtxoSweeper
 
+	// commitRecovery rescans the chain for commitment outputs of closed
+	// channels if the wallet was restored from its seed. It's nil if the
+	// wallet isn't in recovery mode.
+	commitRecovery *commitRecovery
+
+	// deliveryScripts hands out the scripts that the funds of closed
+	// channels and swept channel outputs are sent to.
+	deliveryScripts *deliveryScriptSource
+
 	chainArb *contractcourt.ChainArbitrator
 
 	sphinx *hop.OnionProcessor
@@ -255,10 +267,22 @@ type server struct {
 	// channelNotifier to be notified of newly opened and closed channels.
 	chanSubSwapper *chanbackup.SubSwapper
 
+	// backupSinks ships every updated channel backup to the set of
+	// configured backup sinks. It is nil if no sinks are configured.
+	backupSinks *chanbackup.SinkSwapper
+
 	// chanEventStore tracks the behaviour of channels and their remote peers to
 	// provide insights into their health and performance.
 	chanEventStore *chanfitness.ChannelEventStore
 
+	// offerManager relays onion messages, fetches invoices for offers and
+	// issues invoices for the offers of this node.
+	offerManager *offers.Manager
+
+	// trampolineForwarder forwards the payments of htlcs that carry a
+	// trampoline onion. It is nil if we don't act as a trampoline node.
+	trampolineForwarder *trampoline.Forwarder
+
 	quit chan struct{}
 
 	wg sync.WaitGroup
@@ -302,10 +326,10 @@ func parseAddr(address string) (net.Addr, error) {
 
 // noiseDial is a factory function which creates a connmgr compliant dialing
 // function by returning a closure which includes the server's identity key.
-func noiseDial(idPriv *btcec.PrivateKey) func(net.Addr) (net.Conn, error) {
+func noiseDial(idKey keychain.SingleKeyECDH) func(net.Addr) (net.Conn, error) {
 	return func(a net.Addr) (net.Conn, error) {
 		lnAddr := a.(*lnwire.NetAddress)
-		return brontide.Dial(idPriv, lnAddr, cfg.net.Dial)
+		return brontide.Dial(idKey, lnAddr, cfg.net.Dial)
 	}
 }
 
@@ -313,11 +337,20 @@ func noiseDial(idPriv *btcec.PrivateKey) func(net.Addr) (net.Conn, error) {
 // passed listener address.
 func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 	towerClientDB *wtdb.ClientDB, cc *chainControl,
-	privKey *btcec.PrivateKey,
+	nodeKeyDesc *keychain.KeyDescriptor,
 	chansToRestore walletunlocker.ChannelsToRecover,
 	chanPredicate chanacceptor.ChannelAcceptor) (*server, error) {
 
-	var err error
+	var (
+		err         error
+		nodeKeyECDH = keychain.NewPubKeyECDH(*nodeKeyDesc, cc.keyRing)
+
+		// We just derived the full descriptor, so we know the public
+		// key is set on it.
+		nodeKeySigner = keychain.NewPubKeyMessageSigner(
+			*nodeKeyDesc, cc.keyRing,
+		)
+	)
 
 	listeners := make([]net.Listener, len(listenAddrs))
 	for i, listenAddr := range listenAddrs {
@@ -325,7 +358,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		// doesn't need to call the general lndResolveTCP function
 		// since we are resolving a local address.
 		listeners[i], err = brontide.NewListener(
-			privKey, listenAddr.String(),
+			nodeKeyECDH, listenAddr.String(),
 		)
 		if err != nil {
 			return nil, err
@@ -347,14 +380,14 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 	}
 
 	var serializedPubKey [33]byte
-	copy(serializedPubKey[:], privKey.PubKey().SerializeCompressed())
+	copy(serializedPubKey[:], nodeKeyDesc.PubKey.SerializeCompressed())
 
 	// Initialize the sphinx router, placing it's persistent replay log in
 	// the same directory as the channel graph database.
 	graphDir := chanDB.Path()
 	sharedSecretPath := filepath.Join(graphDir, "sphinxreplay.db")
 	replayLog := htlcswitch.NewDecayedLog(sharedSecretPath, cc.chainNotifier)
-	sphinxRouter := sphinx.NewRouter(privKey, activeNetParams.Params, replayLog)
+	sphinxRouter := hop.NewOnionRouter(nodeKeyECDH, replayLog)
 
 	writeBufferPool := pool.NewWriteBuffer(
 		pool.DefaultWriteBufferGCInterval,
@@ -377,6 +410,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 	featureMgr, err := feature.NewManager(feature.Config{
 		NoTLVOnion:        cfg.LegacyProtocol.LegacyOnion(),
 		NoStaticRemoteKey: cfg.LegacyProtocol.LegacyCommitment(),
+		NoAMP:             !cfg.AcceptAMP,
 	})
 	if err != nil {
 		return nil, err
@@ -387,6 +421,12 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		HtlcHoldDuration:     invoices.DefaultHtlcHoldDuration,
 		Clock:                clock.NewDefaultClock(),
 		AcceptKeySend:        cfg.AcceptKeySend,
+		NodePubKey:           serializedPubKey,
+		AcceptAMP:            cfg.AcceptAMP,
+		HtlcAcceptorTimeout:  invoices.DefaultHtlcAcceptorTimeout,
+		MaxHoldDuration:      cfg.MaxHoldDuration,
+		HoldExpiryDelta:      cfg.HoldExpiryDelta,
+		EpochRegistrar:       cc.chainNotifier,
 	}
 
 	s := &server{
@@ -404,8 +444,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 
 		channelNotifier: channelnotifier.New(chanDB),
 
-		identityPriv: privKey,
-		nodeSigner:   netann.NewNodeSigner(privKey),
+		identityECDH: nodeKeyECDH,
+		nodeSigner:   netann.NewNodeSigner(nodeKeySigner),
 
 		listenAddrs: listenAddrs,
 
@@ -486,7 +526,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		ChanStatusSampleInterval: cfg.ChanStatusSampleInterval,
 		ChanEnableTimeout:        cfg.ChanEnableTimeout,
 		ChanDisableTimeout:       cfg.ChanDisableTimeout,
-		OurPubKey:                privKey.PubKey(),
+		OurPubKey:                nodeKeyDesc.PubKey,
 		MessageSigner:            s.nodeSigner,
 		IsChannelActive:          s.htlcSwitch.HasActiveLink,
 		ApplyChannelUpdate:       s.applyChannelUpdate,
@@ -620,7 +660,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		Features:             s.featureMgr.Get(feature.SetNodeAnn),
 		Color:                color,
 	}
-	copy(selfNode.PubKeyBytes[:], privKey.PubKey().SerializeCompressed())
+	copy(selfNode.PubKeyBytes[:], nodeKeyDesc.PubKey.SerializeCompressed())
 
 	// Based on the disk representation of the node announcement generated
 	// above, we'll generate a node announcement that can go out on the
@@ -633,7 +673,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 	// With the announcement generated, we'll sign it to properly
 	// authenticate the message on the network.
 	authSig, err := discovery.SignAnnouncement(
-		s.nodeSigner, s.identityPriv.PubKey(), nodeAnn,
+		s.nodeSigner, s.identityECDH.PubKey(), nodeAnn,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to generate signature for "+
@@ -780,7 +820,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		SubBatchDelay:           time.Second * 5,
 		IgnoreHistoricalFilters: cfg.IgnoreHistoricalGossipFilters,
 	},
-		s.identityPriv.PubKey(),
+		s.identityECDH.PubKey(),
 	)
 
 	s.localChanMgr = &localchans.Manager{
@@ -807,11 +847,22 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		return nil, err
 	}
 
+	// The funds of closed channels and swept channel outputs are sent to
+	// the configured delivery destination, or to the wallet otherwise.
+	// Swept outputs of the wallet itself always go back to the wallet.
+	s.deliveryScripts, err = newDeliveryScriptSource(
+		cfg.Delivery, activeNetParams.Params, chanDB, cc.wallet,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	s.sweeper = sweep.New(&sweep.UtxoSweeperConfig{
-		FeeEstimator:   cc.feeEstimator,
-		GenSweepScript: newSweepPkScriptGen(cc.wallet),
-		Signer:         cc.wallet.Cfg.Signer,
-		Wallet:         cc.wallet,
+		FeeEstimator:         cc.feeEstimator,
+		GenSweepScript:       s.deliveryScripts.nextScript,
+		GenWalletSweepScript: newSweepPkScriptGen(cc.wallet),
+		Signer:               cc.wallet.Cfg.Signer,
+		Wallet:               cc.wallet,
 		NewBatchTimer: func() <-chan time.Time {
 			return time.NewTimer(sweep.DefaultBatchWindowDuration).C
 		},
@@ -824,15 +875,40 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		FeeRateBucketSize:    sweep.DefaultFeeRateBucketSize,
 	})
 
+	// If the wallet is being restored from its seed, the funds of
+	// channels that were closed before need to be recovered as well.
+	recoveryInfo, err := cc.wallet.GetRecoveryInfo()
+	if err != nil {
+		return nil, err
+	}
+	if recoveryInfo.RecoveryMode {
+		s.commitRecovery = newCommitRecovery(&commitRecoveryConfig{
+			ChainIO:    cc.chainIO,
+			KeyRing:    cc.keyRing,
+			SweepInput: s.sweeper.SweepInput,
+			StartHeight: func() (int32, error) {
+				info, err := cc.wallet.GetRecoveryInfo()
+				if err != nil {
+					return 0, err
+				}
+
+				return info.BirthdayHeight, nil
+			},
+			LookAhead: recoveryInfo.RecoveryWindow,
+		})
+	}
+
 	s.utxoNursery = newUtxoNursery(&NurseryConfig{
 		ChainIO:             cc.chainIO,
 		ConfDepth:           1,
 		FetchClosedChannels: chanDB.FetchClosedChannels,
 		FetchClosedChannel:  chanDB.FetchClosedChannel,
 		Notifier:            cc.chainNotifier,
-		PublishTransaction:  cc.wallet.PublishTransaction,
-		Store:               utxnStore,
-		SweepInput:          s.sweeper.SweepInput,
+		PublishTransaction: func(tx *wire.MsgTx) error {
+			return cc.wallet.PublishTransaction(tx, "")
+		},
+		Store:      utxnStore,
+		SweepInput: s.sweeper.SweepInput,
 	})
 
 	// Construct a closure that wraps the htlcswitch's CloseLink method.
@@ -855,8 +931,10 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		ChainHash:              *activeNetParams.GenesisHash,
 		IncomingBroadcastDelta: DefaultIncomingBroadcastDelta,
 		OutgoingBroadcastDelta: DefaultOutgoingBroadcastDelta,
-		NewSweepAddr:           newSweepPkScriptGen(cc.wallet),
-		PublishTx:              cc.wallet.PublishTransaction,
+		NewSweepAddr:           s.deliveryScripts.nextScript,
+		PublishTx: func(tx *wire.MsgTx) error {
+			return cc.wallet.PublishTransaction(tx, "")
+		},
 		DeliverResolutionMsg: func(msgs ...contractcourt.ResolutionMsg) error {
 			for _, msg := range msgs {
 				err := s.htlcSwitch.ProcessContractResolution(msg)
@@ -935,7 +1013,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		CloseLink:          closeLink,
 		DB:                 chanDB,
 		Estimator:          s.cc.feeEstimator,
-		GenSweepScript:     newSweepPkScriptGen(cc.wallet),
+		GenSweepScript:     s.deliveryScripts.nextScript,
 		Notifier:           cc.chainNotifier,
 		PublishTransaction: cc.wallet.PublishTransaction,
 		ContractBreaches:   contractBreaches,
@@ -961,15 +1039,16 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 	}
 
 	s.fundingMgr, err = newFundingManager(fundingConfig{
-		IDKey:              privKey.PubKey(),
+		IDKey:              nodeKeyDesc.PubKey,
 		Wallet:             cc.wallet,
+		GenDeliveryScript:  s.deliveryScripts.nextDeliveryAddress,
 		PublishTransaction: cc.wallet.PublishTransaction,
 		Notifier:           cc.chainNotifier,
 		FeeEstimator:       cc.feeEstimator,
 		SignMessage: func(pubKey *btcec.PublicKey,
 			msg []byte) (*btcec.Signature, error) {
 
-			if pubKey.IsEqual(privKey.PubKey()) {
+			if pubKey.IsEqual(nodeKeyDesc.PubKey) {
 				return s.nodeSigner.SignMessage(pubKey, msg)
 			}
 
@@ -982,7 +1061,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 			optionalFields ...discovery.OptionalMsgField) chan error {
 
 			return s.authGossiper.ProcessLocalAnnouncement(
-				msg, privKey.PubKey(), optionalFields...,
+				msg, nodeKeyDesc.PubKey, optionalFields...,
 			)
 		},
 		NotifyWhenOnline: s.NotifyWhenOnline,
@@ -1138,16 +1217,42 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 	// Next, we'll assemble the sub-system that will maintain an on-disk
 	// static backup of the latest channel state.
 	chanNotifier := &channelNotifier{
-		chanNotifier: s.channelNotifier,
-		addrs:        s.chanDB,
+		chanNotifier:      s.channelNotifier,
+		addrs:             s.chanDB,
+		subscribeTopology: s.chanRouter.SubscribeTopology,
 	}
 	backupFile := chanbackup.NewMultiFile(cfg.BackupFilePath)
 	startingChans, err := chanbackup.FetchStaticChanBackups(s.chanDB)
 	if err != nil {
 		return nil, err
 	}
+
+	// If any backup sinks are configured, we'll wrap the backup file so
+	// that each update is also shipped off-box.
+	var backupSwapper chanbackup.Swapper = backupFile
+	if cfg.BackupSinks.Active() {
+		var sinks []chanbackup.BackupSink
+		for _, dir := range cfg.BackupSinks.Dirs {
+			sinks = append(sinks, chanbackup.NewDirSink(
+				dir, cfg.BackupSinks.MaxVersions,
+			))
+		}
+		for _, url := range cfg.BackupSinks.URLs {
+			sinks = append(sinks, chanbackup.NewHTTPSink(
+				url, cfg.BackupSinks.MaxVersions,
+				cfg.BackupSinks.Timeout,
+			))
+		}
+
+		s.backupSinks = chanbackup.NewSinkSwapper(
+			backupFile, clock.NewDefaultClock(),
+			cfg.BackupSinks.RetryInterval, sinks...,
+		)
+		backupSwapper = s.backupSinks
+	}
+
 	s.chanSubSwapper, err = chanbackup.NewSubSwapper(
-		startingChans, chanNotifier, s.cc.keyRing, backupFile,
+		startingChans, chanNotifier, s.cc.keyRing, backupSwapper,
 	)
 	if err != nil {
 		return nil, err
@@ -1164,6 +1269,40 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		GetOpenChannels:        s.chanDB.FetchAllOpenChannels,
 	})
 
+	// Create the offer manager, which issues invoices for the offers of
+	// this node through the invoice registry.
+	s.offerManager = offers.NewManager(&offers.Config{
+		NodeKey:           nodeKeyECDH,
+		ChainParams:       activeNetParams.Params,
+		SendOnionMessage:  s.sendOnionMessage,
+		OnionMessagePeers: s.onionMessagePeers,
+		Issuer: invoices.NewOfferIssuer(&invoices.OfferIssuerConfig{
+			LookupOffer: s.chanDB.LookupOffer,
+			AddInvoice:  s.addOfferInvoice,
+			Clock:       clock.NewDefaultClock(),
+		}),
+	})
+
+	// If we act as a trampoline node, the invoice registry hands the
+	// htlcs that carry a trampoline onion to the trampoline forwarder,
+	// which pays the next hop with a route it finds itself.
+	if cfg.Trampoline.Active {
+		cltvDelta := cfg.Bitcoin.TimeLockDelta
+		if registeredChains.PrimaryChain() == actiniumChain {
+			cltvDelta = cfg.Actinium.TimeLockDelta
+		}
+
+		s.trampolineForwarder = trampoline.NewForwarder(&trampoline.Config{
+			NodeKey:           nodeKeyECDH,
+			CltvDelta:         cltvDelta,
+			PayAttemptTimeout: routing.DefaultPayAttemptTimeout,
+			SendPayment:       s.chanRouter.SendPayment,
+			SubscribePayment:  s.controlTower.SubscribePayment,
+			AddForward:        chanDB.AddTrampolineForward,
+		})
+		registryConfig.TrampolineForwarder = s.trampolineForwarder
+	}
+
 	if cfg.WtClient.Active {
 		policy := wtpolicy.DefaultPolicy()
 
@@ -1182,7 +1321,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 
 		s.towerClient, err = wtclient.New(&wtclient.Config{
 			Signer:         cc.wallet.Cfg.Signer,
-			NewAddress:     newSweepPkScriptGen(cc.wallet),
+			NewAddress:     s.deliveryScripts.nextScript,
 			SecretKeyRing:  s.cc.keyRing,
 			Dial:           cfg.net.Dial,
 			AuthDial:       wtclient.AuthDial,
@@ -1206,7 +1345,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		OnAccept:       s.InboundPeerConnected,
 		RetryDuration:  time.Second * 5,
 		TargetOutbound: 100,
-		Dial:           noiseDial(s.identityPriv),
+		Dial:           noiseDial(s.identityECDH),
 		OnConnection:   s.OutboundPeerConnected,
 	})
 	if err != nil {
@@ -1296,6 +1435,12 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
+		if s.commitRecovery != nil {
+			if err := s.commitRecovery.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
 		if err := s.chainArb.Start(); err != nil {
 			startErr = err
 			return
@@ -1330,6 +1475,18 @@ func (s *server) Start() error {
 			return
 		}
 
+		if err := s.offerManager.Start(); err != nil {
+			startErr = err
+			return
+		}
+
+		if s.trampolineForwarder != nil {
+			if err := s.trampolineForwarder.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		// Before we start the connMgr, we'll check to see if we have
 		// any backups to recover. We do this now as we want to ensure
 		// that have all the information we need to handle channel
@@ -1362,6 +1519,12 @@ func (s *server) Start() error {
 			}
 		}
 
+		if s.backupSinks != nil {
+			if err := s.backupSinks.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
 		if err := s.chanSubSwapper.Start(); err != nil {
 			startErr = err
 			return
@@ -1435,6 +1598,9 @@ func (s *server) Stop() error {
 		s.breachArbiter.Stop()
 		s.authGossiper.Stop()
 		s.chainArb.Stop()
+		if s.commitRecovery != nil {
+			s.commitRecovery.Stop()
+		}
 		s.sweeper.Stop()
 		s.channelNotifier.Stop()
 		s.peerNotifier.Stop()
@@ -1443,10 +1609,17 @@ func (s *server) Stop() error {
 		s.cc.chainView.Stop()
 		s.connMgr.Stop()
 		s.cc.feeEstimator.Stop()
+		if s.trampolineForwarder != nil {
+			s.trampolineForwarder.Stop()
+		}
 		s.invoices.Stop()
 		s.fundingMgr.Stop()
 		s.chanSubSwapper.Stop()
+		if s.backupSinks != nil {
+			s.backupSinks.Stop()
+		}
 		s.chanEventStore.Stop()
+		s.offerManager.Stop()
 
 		// Disconnect from each active peers to ensure that
 		// peerTerminationWatchers signal completion to each peer.
@@ -2007,7 +2180,7 @@ func (s *server) initTorController() error {
 		Color:        newNodeAnn.RGBColor,
 		AuthSigBytes: newNodeAnn.Signature.ToSignatureBytes(),
 	}
-	copy(selfNode.PubKeyBytes[:], s.identityPriv.PubKey().SerializeCompressed())
+	copy(selfNode.PubKeyBytes[:], s.identityECDH.PubKey().SerializeCompressed())
 	if err := s.chanDB.ChannelGraph().SetSourceNode(selfNode); err != nil {
 		return fmt.Errorf("can't set self node: %v", err)
 	}
@@ -2049,7 +2222,7 @@ func (s *server) genNodeAnnouncement(refresh bool,
 	// signature over the announcement to ensure nodes on the network
 	// accepted the new authenticated announcement.
 	sig, err := discovery.SignAnnouncement(
-		s.nodeSigner, s.identityPriv.PubKey(), s.currentNodeAnn,
+		s.nodeSigner, s.identityECDH.PubKey(), s.currentNodeAnn,
 	)
 	if err != nil {
 		return lnwire.NodeAnnouncement{}, err
@@ -2104,7 +2277,7 @@ func (s *server) establishPersistentConnections() error {
 
 	// TODO(roasbeef): instead iterate over link nodes and query graph for
 	// each of the nodes.
-	selfPub := s.identityPriv.PubKey().SerializeCompressed()
+	selfPub := s.identityECDH.PubKey().SerializeCompressed()
 	err = sourceNode.ForEachChannel(nil, func(
 		tx *bbolt.Tx,
 		chanInfo *channeldb.ChannelEdgeInfo,
@@ -2554,7 +2727,7 @@ func (s *server) InboundPeerConnected(conn net.Conn) {
 		// not of the same type of the new connection (inbound), then
 		// we'll close out the new connection s.t there's only a single
 		// connection between us.
-		localPub := s.identityPriv.PubKey()
+		localPub := s.identityECDH.PubKey()
 		if !connectedPeer.inbound &&
 			!shouldDropLocalConnection(localPub, nodePub) {
 
@@ -2665,7 +2838,7 @@ func (s *server) OutboundPeerConnected(connReq *connmgr.ConnReq, conn net.Conn)
 		// not of the same type of the new connection (outbound), then
 		// we'll close out the new connection s.t there's only a single
 		// connection between us.
-		localPub := s.identityPriv.PubKey()
+		localPub := s.identityECDH.PubKey()
 		if connectedPeer.inbound &&
 			shouldDropLocalConnection(localPub, nodePub) {
 
@@ -3119,6 +3292,10 @@ type openChanReq struct {
 	// output selected to fund the channel should satisfy.
 	minConfs int32
 
+	// outpoints optionally restricts the outputs that can be selected to
+	// fund the channel to the given set.
+	outpoints []wire.OutPoint
+
 	// shutdownScript is an optional upfront shutdown script for the channel.
 	// This value is optional, so may be nil.
 	shutdownScript lnwire.DeliveryAddress
@@ -3136,6 +3313,10 @@ type openChanReq struct {
 	// protocol.
 	pendingChanID [32]byte
 
+	// label is an optional label that is attached to the funding
+	// transaction.
+	label string
+
 	updates chan *lnrpc.OpenStatusUpdate
 	err     chan error
 }
@@ -3220,7 +3401,7 @@ func (s *server) ConnectToPeer(addr *lnwire.NetAddress, perm bool) error {
 // notify the caller if the connection attempt has failed. Otherwise, it will be
 // closed.
 func (s *server) connectToPeer(addr *lnwire.NetAddress, errChan chan<- error) {
-	conn, err := brontide.Dial(s.identityPriv, addr, cfg.net.Dial)
+	conn, err := brontide.Dial(s.identityECDH, addr, cfg.net.Dial)
 	if err != nil {
 		srvrLog.Errorf("Unable to connect to %v: %v", addr, err)
 		select {
@@ -3419,7 +3600,7 @@ func (s *server) fetchNodeAdvertisedAddr(pub *btcec.PublicKey) (net.Addr, error)
 func (s *server) fetchLastChanUpdate() func(lnwire.ShortChannelID) (
 	*lnwire.ChannelUpdate, error) {
 
-	ourPubKey := s.identityPriv.PubKey().SerializeCompressed()
+	ourPubKey := s.identityECDH.PubKey().SerializeCompressed()
 	return func(cid lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error) {
 		info, edge1, edge2, err := s.chanRouter.GetChannelByID(cid)
 		if err != nil {
@@ -3432,10 +3613,156 @@ func (s *server) fetchLastChanUpdate() func(lnwire.ShortChannelID) (
 	}
 }
 
+// channelUptime returns the lifetime of the channel with the given funding
+// outpoint, as observed by the channel event store, and the time the remote
+// peer was online during it.
+func (s *server) channelUptime(chanPoint wire.OutPoint) (time.Duration,
+	time.Duration, error) {
+
+	startTime, endTime, err := s.chanEventStore.GetLifespan(chanPoint)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// If endTime is zero, the channel is still open, so its lifetime
+	// extends to the present.
+	if endTime.IsZero() {
+		endTime = time.Now()
+	}
+
+	uptime, err := s.chanEventStore.GetUptime(chanPoint, startTime, endTime)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return endTime.Sub(startTime), uptime, nil
+}
+
+// sendOnionMessage sends an onion message to a connected peer, if the peer
+// relays onion messages.
+func (s *server) sendOnionMessage(peerPub route.Vertex,
+	msg *lnwire.OnionMessage) error {
+
+	targetPeer, err := s.FindPeerByPubStr(string(peerPub[:]))
+	if err != nil {
+		return err
+	}
+
+	features := targetPeer.RemoteFeatures()
+	if features == nil ||
+		!features.HasFeature(lnwire.OnionMessagesOptional) {
+
+		return fmt.Errorf("peer %v doesn't relay onion messages",
+			peerPub)
+	}
+
+	return targetPeer.SendMessageLazy(false, msg)
+}
+
+// onionMessagePeers returns the nodes that relay onion messages to and from
+// the given node. For this node, these are the connected peers that signal
+// support for onion messages. For other nodes, these are their channel peers
+// in the graph that announce support for them.
+func (s *server) onionMessagePeers(node route.Vertex) ([]route.Vertex, error) {
+	var peers []route.Vertex
+
+	if node == route.NewVertex(s.identityECDH.PubKey()) {
+		for _, p := range s.Peers() {
+			features := p.RemoteFeatures()
+			if features == nil ||
+				!features.HasFeature(lnwire.OnionMessagesOptional) {
+
+				continue
+			}
+			peers = append(peers, route.NewVertex(p.IdentityKey()))
+		}
+
+		return peers, nil
+	}
+
+	graph := s.chanDB.ChannelGraph()
+	err := graph.ForEachNodeChannel(nil, node[:],
+		func(tx *bbolt.Tx, info *channeldb.ChannelEdgeInfo,
+			_, _ *channeldb.ChannelEdgePolicy) error {
+
+			peer := info.NodeKey1Bytes
+			if peer == node {
+				peer = info.NodeKey2Bytes
+			}
+
+			// Nodes that haven't announced themselves don't
+			// signal any features.
+			peerNode, err := graph.FetchLightningNode(tx, peer)
+			switch {
+			case err == channeldb.ErrGraphNodeNotFound:
+				return nil
+
+			case err != nil:
+				return err
+			}
+
+			if peerNode.Features.HasFeature(
+				lnwire.OnionMessagesOptional,
+			) {
+				peers = append(peers, peer)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return peers, nil
+}
+
+// addOfferInvoice adds an invoice that is issued for an offer of this node,
+// returning its payment request.
+func (s *server) addOfferInvoice(amt lnwire.MilliSatoshi, memo string,
+	expiry time.Duration) (string, error) {
+
+	defaultDelta := cfg.Bitcoin.TimeLockDelta
+	if registeredChains.PrimaryChain() == actiniumChain {
+		defaultDelta = cfg.Actinium.TimeLockDelta
+	}
+
+	addInvoiceCfg := &invoicesrpc.AddInvoiceConfig{
+		AddInvoice:        s.invoices.AddInvoice,
+		IsChannelActive:   s.htlcSwitch.HasActiveLink,
+		ChainParams:       activeNetParams.Params,
+		NodeSigner:        s.nodeSigner,
+		MaxPaymentMSat:    MaxPaymentMSat,
+		DefaultCLTVExpiry: defaultDelta,
+		ChanDB:            s.chanDB,
+		GenInvoiceFeatures: func() *lnwire.FeatureVector {
+			return s.featureMgr.Get(feature.SetInvoice)
+		},
+		GetChannelUptime: s.channelUptime,
+	}
+
+	// Payers of offers may not know any route to this node, so the
+	// invoice includes hints for private channels.
+	_, invoice, err := invoicesrpc.AddInvoice(
+		context.Background(), addInvoiceCfg,
+		&invoicesrpc.AddInvoiceData{
+			Memo:    memo,
+			Value:   amt,
+			Expiry:  int64(expiry.Seconds()),
+			Private: true,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return string(invoice.PaymentRequest), nil
+}
+
 // applyChannelUpdate applies the channel update to the different sub-systems of
 // the server.
 func (s *server) applyChannelUpdate(update *lnwire.ChannelUpdate) error {
-	pubKey := s.identityPriv.PubKey()
+	pubKey := s.identityECDH.PubKey()
 	errChan := s.authGossiper.ProcessLocalAnnouncement(update, pubKey)
 	select {
 	case err := <-errChan:
@@ -3,8 +3,10 @@ package lnd
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/Actinium-project/acmd/chaincfg"
+	"github.com/Actinium-project/acmd/wire"
 	"github.com/Actinium-project/lnd/autopilot"
 	"github.com/Actinium-project/lnd/channeldb"
 	"github.com/Actinium-project/lnd/htlcswitch"
@@ -94,7 +96,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 	tower *watchtower.Standalone,
 	towerClient wtclient.Client,
 	tcpResolver lncfg.TCPResolver,
-	genInvoiceFeatures func() *lnwire.FeatureVector) error {
+	genInvoiceFeatures func() *lnwire.FeatureVector,
+	getChannelUptime func(wire.OutPoint) (time.Duration, time.Duration,
+		error)) error {
 
 	// First, we'll use reflect to obtain a version of the config struct
 	// that allows us to programmatically inspect its fields.
@@ -135,6 +139,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("KeyRing").Set(
 				reflect.ValueOf(cc.keyRing),
 			)
+			subCfgValue.FieldByName("AddressDeriver").Set(
+				reflect.ValueOf(cc.wc),
+			)
 
 		case *walletrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -160,6 +167,15 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("Chain").Set(
 				reflect.ValueOf(cc.chainIO),
 			)
+			subCfgValue.FieldByName("Signer").Set(
+				reflect.ValueOf(cc.signer),
+			)
+			subCfgValue.FieldByName("ChainParams").Set(
+				reflect.ValueOf(activeNetParams),
+			)
+			subCfgValue.FieldByName("WatchOnlyExporter").Set(
+				reflect.ValueOf(cc.wc),
+			)
 
 		case *autopilotrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
@@ -218,6 +234,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("GenInvoiceFeatures").Set(
 				reflect.ValueOf(genInvoiceFeatures),
 			)
+			subCfgValue.FieldByName("GetChannelUptime").Set(
+				reflect.ValueOf(getChannelUptime),
+			)
 
 		case *routerrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
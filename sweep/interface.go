@@ -8,8 +8,9 @@ import (
 // Wallet contains all wallet related functionality required by sweeper.
 type Wallet interface {
 	// PublishTransaction performs cursory validation (dust checks, etc) and
-	// broadcasts the passed transaction to the Bitcoin network.
-	PublishTransaction(tx *wire.MsgTx) error
+	// broadcasts the passed transaction to the Bitcoin network. The label
+	// is attached to the transaction.
+	PublishTransaction(tx *wire.MsgTx, label string) error
 
 	// ListUnspentWitness returns all unspent outputs which are version 0
 	// witness programs. The 'minconfirms' and 'maxconfirms' parameters
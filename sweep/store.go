@@ -50,6 +50,10 @@ type SweeperStore interface {
 	// NotifyPublishTx signals that we are about to publish a tx.
 	NotifyPublishTx(*wire.MsgTx) error
 
+	// NotifyCreateTx signals that we created a tx that is published by
+	// the caller instead.
+	NotifyCreateTx(*wire.MsgTx) error
+
 	// GetLastPublishedTx returns the last tx that we called NotifyPublishTx
 	// for.
 	GetLastPublishedTx() (*wire.MsgTx, error)
@@ -189,6 +193,21 @@ func (s *sweeperStore) NotifyPublishTx(sweepTx *wire.MsgTx) error {
 	})
 }
 
+// NotifyCreateTx signals that we created a tx that is published by the caller
+// instead. Unlike NotifyPublishTx, it doesn't replace the last published tx.
+func (s *sweeperStore) NotifyCreateTx(sweepTx *wire.MsgTx) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		txHashesBucket := tx.Bucket(txHashesBucketKey)
+		if txHashesBucket == nil {
+			return errors.New("tx hashes bucket does not exist")
+		}
+
+		hash := sweepTx.TxHash()
+
+		return txHashesBucket.Put(hash[:], []byte{})
+	})
+}
+
 // GetLastPublishedTx returns the last tx that we called NotifyPublishTx
 // for.
 func (s *sweeperStore) GetLastPublishedTx() (*wire.MsgTx, error) {
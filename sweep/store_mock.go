@@ -35,6 +35,14 @@ func (s *MockSweeperStore) NotifyPublishTx(tx *wire.MsgTx) error {
 	return nil
 }
 
+// NotifyCreateTx signals that we created a tx that is published by the caller
+// instead.
+func (s *MockSweeperStore) NotifyCreateTx(tx *wire.MsgTx) error {
+	s.ourTxes[tx.TxHash()] = struct{}{}
+
+	return nil
+}
+
 // GetLastPublishedTx returns the last tx that we called NotifyPublishTx
 // for.
 func (s *MockSweeperStore) GetLastPublishedTx() (*wire.MsgTx, error) {
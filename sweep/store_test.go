@@ -141,6 +141,37 @@ func testStore(t *testing.T, createStore func() (SweeperStore, error)) {
 		t.Fatal("expected tx to be ours")
 	}
 
+	// Notify creation of tx3, which is published by the caller.
+	tx3 := wire.MsgTx{}
+	tx3.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Index: 3,
+		},
+	})
+
+	err = store.NotifyCreateTx(&tx3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Assert that tx3 is recognized as our own, but didn't replace the
+	// last published tx.
+	ours, err = store.IsOurTx(tx3.TxHash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ours {
+		t.Fatal("expected tx to be ours")
+	}
+
+	retrievedTx, err = store.GetLastPublishedTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx2.TxHash() != retrievedTx.TxHash() {
+		t.Fatal("expected last published tx to be unchanged")
+	}
+
 	// An different hash should be reported on as not being ours.
 	var unknownHash chainhash.Hash
 	ours, err = store.IsOurTx(unknownHash)
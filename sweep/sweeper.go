@@ -9,11 +9,13 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/Actinium-project/acmd/chaincfg/chainhash"
 	"github.com/Actinium-project/acmd/wire"
 	"github.com/Actinium-project/acmutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/Actinium-project/lnd/chainntnfs"
 	"github.com/Actinium-project/lnd/input"
+	"github.com/Actinium-project/lnd/labels"
 	"github.com/Actinium-project/lnd/lnwallet"
 	"github.com/Actinium-project/lnd/lnwallet/chainfee"
 )
@@ -80,12 +82,25 @@ type Params struct {
 	// ExclusiveGroup is an identifier that, if set, prevents other inputs
 	// with the same identifier from being batched together.
 	ExclusiveGroup *uint64
+
+	// Label is an optional label that is attached to the transaction
+	// sweeping the input. If several labelled inputs are swept within the
+	// same transaction, the label of the first one is used. Sweeps of
+	// unlabelled inputs are labelled as regular sweep transactions.
+	Label string
+
+	// WalletInput indicates that the input is owned by the wallet. Such
+	// inputs are swept back into the wallet rather than to the script
+	// generated by GenSweepScript, and are only batched with other wallet
+	// inputs.
+	WalletInput bool
 }
 
 // String returns a human readable interpretation of the sweep parameters.
 func (p Params) String() string {
-	return fmt.Sprintf("fee=%v, force=%v, exclusive_group=%v",
-		p.Fee, p.Force, p.ExclusiveGroup)
+	return fmt.Sprintf("fee=%v, force=%v, exclusive_group=%v, "+
+		"wallet_input=%v", p.Fee, p.Force, p.ExclusiveGroup,
+		p.WalletInput)
 }
 
 // pendingInput is created when an input reaches the main loop for the first
@@ -133,6 +148,17 @@ type pendingInputs = map[wire.OutPoint]*pendingInput
 type inputCluster struct {
 	sweepFeeRate chainfee.SatPerKWeight
 	inputs       pendingInputs
+
+	// walletInputs indicates that the inputs of the cluster are owned by
+	// the wallet, and are thus swept back into it.
+	walletInputs bool
+}
+
+// clusterKey identifies the cluster of inputs that share a fee rate bucket
+// and a sweep destination.
+type clusterKey struct {
+	feeGroup     int
+	walletInputs bool
 }
 
 // pendingSweepsReq is an internal message we'll use to represent an external
@@ -215,6 +241,10 @@ type UtxoSweeper struct {
 
 	currentOutputScript []byte
 
+	// currentWalletScript is the unused wallet script that wallet inputs
+	// are swept to.
+	currentWalletScript []byte
+
 	relayFeeRate chainfee.SatPerKWeight
 
 	quit chan struct{}
@@ -223,10 +253,15 @@ type UtxoSweeper struct {
 
 // UtxoSweeperConfig contains dependencies of UtxoSweeper.
 type UtxoSweeperConfig struct {
-	// GenSweepScript generates a P2WKH script belonging to the wallet where
-	// funds can be swept.
+	// GenSweepScript generates the script that the funds of swept channel
+	// outputs are sent to.
 	GenSweepScript func() ([]byte, error)
 
+	// GenWalletSweepScript generates a P2WKH script belonging to the
+	// wallet, that inputs owned by the wallet are swept back to. If not
+	// set, GenSweepScript is used.
+	GenWalletSweepScript func() ([]byte, error)
+
 	// FeeEstimator is used when crafting sweep transactions to estimate
 	// the necessary fee relative to the expected size of the sweep
 	// transaction.
@@ -305,6 +340,10 @@ type sweepInputMessage struct {
 
 // New returns a new Sweeper instance.
 func New(cfg *UtxoSweeperConfig) *UtxoSweeper {
+	if cfg.GenWalletSweepScript == nil {
+		cfg.GenWalletSweepScript = cfg.GenSweepScript
+	}
+
 	return &UtxoSweeper{
 		cfg:               cfg,
 		newInputs:         make(chan *sweepInputMessage),
@@ -342,7 +381,11 @@ func (s *UtxoSweeper) Start() error {
 
 		// Error can be ignored. Because we are starting up, there are
 		// no pending inputs to update based on the publish result.
-		err := s.cfg.Wallet.PublishTransaction(lastTx)
+		err := s.cfg.Wallet.PublishTransaction(
+			lastTx, labels.MakeLabel(
+				labels.LabelTypeSweepTransaction, nil,
+			),
+		)
 		if err != nil && err != lnwallet.ErrDoubleSpend {
 			log.Errorf("last tx publish: %v", err)
 		}
@@ -699,7 +742,10 @@ func (s *UtxoSweeper) sweepCluster(cluster inputCluster,
 
 		// Sweep selected inputs.
 		for _, inputs := range inputLists {
-			err := s.sweep(inputs, cluster.sweepFeeRate, currentHeight)
+			err := s.sweep(
+				inputs, cluster.sweepFeeRate,
+				cluster.walletInputs, currentHeight,
+			)
 			if err != nil {
 				return fmt.Errorf("unable to sweep inputs: %v", err)
 			}
@@ -720,9 +766,10 @@ func (s *UtxoSweeper) bucketForFeeRate(
 // clusterBySweepFeeRate takes the set of pending inputs within the UtxoSweeper
 // and clusters those together with similar fee rates. Each cluster contains a
 // sweep fee rate, which is determined by calculating the average fee rate of
-// all inputs within that cluster.
+// all inputs within that cluster. Wallet inputs are never clustered with
+// channel outputs, as they are swept to a different destination.
 func (s *UtxoSweeper) clusterBySweepFeeRate() []inputCluster {
-	bucketInputs := make(map[int]*bucketList)
+	bucketInputs := make(map[clusterKey]*bucketList)
 	inputFeeRates := make(map[wire.OutPoint]chainfee.SatPerKWeight)
 
 	// First, we'll group together all inputs with similar fee rates. This
@@ -733,7 +780,10 @@ func (s *UtxoSweeper) clusterBySweepFeeRate() []inputCluster {
 			log.Warnf("Skipping input %v: %v", op, err)
 			continue
 		}
-		feeGroup := s.bucketForFeeRate(feeRate)
+		feeGroup := clusterKey{
+			feeGroup:     s.bucketForFeeRate(feeRate),
+			walletInputs: input.params.WalletInput,
+		}
 
 		// Create a bucket list for this fee rate if there isn't one
 		// yet.
@@ -754,7 +804,7 @@ func (s *UtxoSweeper) clusterBySweepFeeRate() []inputCluster {
 	// We'll then determine the sweep fee rate for each set of inputs by
 	// calculating the average fee rate of the inputs within each set.
 	inputClusters := make([]inputCluster, 0, len(bucketInputs))
-	for _, buckets := range bucketInputs {
+	for key, buckets := range bucketInputs {
 		for _, inputs := range buckets.buckets {
 			var sweepFeeRate chainfee.SatPerKWeight
 			for op := range inputs {
@@ -764,6 +814,7 @@ func (s *UtxoSweeper) clusterBySweepFeeRate() []inputCluster {
 			inputClusters = append(inputClusters, inputCluster{
 				sweepFeeRate: sweepFeeRate,
 				inputs:       inputs,
+				walletInputs: key.walletInputs,
 			})
 		}
 	}
@@ -918,23 +969,45 @@ func (s *UtxoSweeper) getInputLists(cluster inputCluster,
 	return append(allSets, newSets...), nil
 }
 
+// sweepLabel returns the label of the transaction sweeping the given inputs.
+func (s *UtxoSweeper) sweepLabel(inputs inputSet) string {
+	for _, inp := range inputs {
+		pendInput, ok := s.pendingInputs[*inp.OutPoint()]
+		if !ok || pendInput.params.Label == "" {
+			continue
+		}
+
+		return pendInput.params.Label
+	}
+
+	return labels.MakeLabel(labels.LabelTypeSweepTransaction, nil)
+}
+
 // sweep takes a set of preselected inputs, creates a sweep tx and publishes the
 // tx. The output address is only marked as used if the publish succeeds.
+// Wallet inputs are swept back into the wallet, all other inputs to the script
+// generated by GenSweepScript.
 func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
-	currentHeight int32) error {
+	walletInputs bool, currentHeight int32) error {
+
+	outputScript, genScript := &s.currentOutputScript, s.cfg.GenSweepScript
+	if walletInputs {
+		outputScript = &s.currentWalletScript
+		genScript = s.cfg.GenWalletSweepScript
+	}
 
 	// Generate an output script if there isn't an unused script available.
-	if s.currentOutputScript == nil {
-		pkScript, err := s.cfg.GenSweepScript()
+	if *outputScript == nil {
+		pkScript, err := genScript()
 		if err != nil {
 			return fmt.Errorf("gen sweep script: %v", err)
 		}
-		s.currentOutputScript = pkScript
+		*outputScript = pkScript
 	}
 
 	// Create sweep tx.
 	tx, err := createSweepTx(
-		inputs, s.currentOutputScript, uint32(currentHeight), feeRate,
+		inputs, *outputScript, uint32(currentHeight), feeRate,
 		s.cfg.Signer,
 	)
 	if err != nil {
@@ -961,7 +1034,7 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 		}),
 	)
 
-	err = s.cfg.Wallet.PublishTransaction(tx)
+	err = s.cfg.Wallet.PublishTransaction(tx, s.sweepLabel(inputs))
 
 	// In case of an unexpected error, don't try to recover.
 	if err != nil && err != lnwallet.ErrDoubleSpend {
@@ -971,7 +1044,7 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.SatPerKWeight,
 	// Keep the output script in case of an error, so that it can be reused
 	// for the next transaction and causes no address inflation.
 	if err == nil {
-		s.currentOutputScript = nil
+		*outputScript = nil
 	}
 
 	// Reschedule sweep.
@@ -1164,7 +1237,16 @@ func (s *UtxoSweeper) handleUpdateReq(req *updateReq, bestHeight int32) (
 	log.Debugf("Updating sweep parameters for %v from %v to %v", req.input,
 		pendingInput.params, req.params)
 
+	// We'll retain the input's label if the update doesn't specify a new
+	// one. Whether the input is owned by the wallet is a property of the
+	// input itself, so it's always retained.
+	label := pendingInput.params.Label
+	walletInput := pendingInput.params.WalletInput
 	pendingInput.params = req.params
+	if pendingInput.params.Label == "" {
+		pendingInput.params.Label = label
+	}
+	pendingInput.params.WalletInput = walletInput
 
 	// We'll reset the input's publish height to the current so that a new
 	// transaction can be created that replaces the transaction currently
@@ -1192,8 +1274,8 @@ func (s *UtxoSweeper) handleUpdateReq(req *updateReq, bestHeight int32) (
 // spends from them. This method also makes an accurate fee estimate before
 // generating the required witnesses.
 //
-// The created transaction has a single output sending all the funds back to
-// the source wallet, after accounting for the fee estimate.
+// The created transaction has a single output sending all the funds to the
+// script generated by GenSweepScript, after accounting for the fee estimate.
 //
 // The value of currentBlockHeight argument will be set as the tx locktime.
 // This function assumes that all CLTV inputs will be unlocked after
@@ -1217,9 +1299,27 @@ func (s *UtxoSweeper) CreateSweepTx(inputs []input.Input, feePref FeePreference,
 		return nil, err
 	}
 
-	return createSweepTx(
+	sweepTx, err := createSweepTx(
 		inputs, pkScript, currentBlockHeight, feePerKw, s.cfg.Signer,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the tx as ours, even though the caller publishes it, so that
+	// it is recognized as a sweep tx later on.
+	if err := s.cfg.Store.NotifyCreateTx(sweepTx); err != nil {
+		return nil, err
+	}
+
+	return sweepTx, nil
+}
+
+// IsSweepTx returns whether the tx with the given hash was created by the
+// sweeper, either to sweep inputs offered to it or on behalf of a caller
+// through CreateSweepTx.
+func (s *UtxoSweeper) IsSweepTx(hash chainhash.Hash) (bool, error) {
+	return s.cfg.Store.IsOurTx(hash)
 }
 
 // DefaultNextAttemptDeltaFunc is the default calculation for next sweep attempt
@@ -1,6 +1,7 @@
 package sweep
 
 import (
+	"bytes"
 	"os"
 	"runtime/debug"
 	"runtime/pprof"
@@ -26,6 +27,9 @@ var (
 	testMaxInputsPerTx = 3
 
 	defaultFeePref = Params{Fee: FeePreference{ConfTarget: 1}}
+
+	// testWalletScript is the script that wallet inputs are swept to.
+	testWalletScript = []byte{0xff}
 )
 
 type sweeperTestContext struct {
@@ -134,6 +138,9 @@ func createSweeperTestContext(t *testing.T) *sweeperTestContext {
 			outputScriptCount++
 			return script, nil
 		},
+		GenWalletSweepScript: func() ([]byte, error) {
+			return testWalletScript, nil
+		},
 		FeeEstimator:     estimator,
 		MaxInputsPerTx:   testMaxInputsPerTx,
 		MaxSweepAttempts: testMaxSweepAttempts,
@@ -1292,3 +1299,51 @@ func TestExclusiveGroup(t *testing.T) {
 		t.Fatal("expected third input to be canceled")
 	}
 }
+
+// TestWalletInputs asserts that wallet inputs are swept back into the wallet,
+// separately from channel outputs that are swept to the sweep script.
+func TestWalletInputs(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	channelInput := spendableInputs[0]
+	resultChan1, err := ctx.sweeper.SweepInput(channelInput, defaultFeePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	walletInput := spendableInputs[1]
+	resultChan2, err := ctx.sweeper.SweepInput(walletInput, Params{
+		Fee:         defaultFeePref.Fee,
+		WalletInput: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Although both inputs share the same fee preference, they're swept
+	// in separate transactions to their own destination.
+	ctx.tick()
+	for i := 0; i < 2; i++ {
+		sweepTx := ctx.receiveTx()
+		if len(sweepTx.TxIn) != 1 || len(sweepTx.TxOut) != 1 {
+			t.Fatalf("expected a single input and output")
+		}
+
+		isWalletTx := bytes.Equal(
+			sweepTx.TxOut[0].PkScript, testWalletScript,
+		)
+		isWalletInput := sweepTx.TxIn[0].PreviousOutPoint ==
+			*walletInput.OutPoint()
+		if isWalletTx != isWalletInput {
+			t.Fatalf("input %v swept to script %x",
+				sweepTx.TxIn[0].PreviousOutPoint,
+				sweepTx.TxOut[0].PkScript)
+		}
+	}
+
+	ctx.backend.mine()
+	ctx.expectResult(resultChan1, nil)
+	ctx.expectResult(resultChan2, nil)
+
+	ctx.finish(1)
+}
@@ -131,6 +131,32 @@ type OutpointLocker interface {
 	UnlockOutpoint(o wire.OutPoint)
 }
 
+// filterUtxos returns the utxos matching the given outpoints. An error is
+// returned if any of the outpoints isn't part of the passed utxos.
+func filterUtxos(utxos []*lnwallet.Utxo,
+	outPoints []wire.OutPoint) ([]*lnwallet.Utxo, error) {
+
+	available := make(map[wire.OutPoint]*lnwallet.Utxo, len(utxos))
+	for _, utxo := range utxos {
+		available[utxo.OutPoint] = utxo
+	}
+
+	filtered := make([]*lnwallet.Utxo, 0, len(outPoints))
+	for _, op := range outPoints {
+		utxo, ok := available[op]
+		if !ok {
+			return nil, fmt.Errorf("outpoint %v is not an "+
+				"unspent, unleased output of the wallet with "+
+				"enough confirmations", op)
+		}
+
+		delete(available, op)
+		filtered = append(filtered, utxo)
+	}
+
+	return filtered, nil
+}
+
 // WalletSweepPackage is a package that gives the caller the ability to sweep
 // ALL funds from a wallet in a single transaction. We also package a function
 // closure that allows one to abort the operation.
@@ -152,12 +178,14 @@ type WalletSweepPackage struct {
 // caller to sweep ALL outputs within the wallet to a single UTXO, as specified
 // by the delivery address. The sweep transaction will be crafted with the
 // target fee rate, and will use the utxoSource and outpointLocker as sources
-// for wallet funds.
+// for wallet funds. Only outputs with at least minConfs confirmations are
+// swept. If selectUtxos is non-empty, exactly those outputs are swept
+// instead, and an error is returned if any of them isn't eligible.
 func CraftSweepAllTx(feeRate chainfee.SatPerKWeight, blockHeight uint32,
 	deliveryAddr acmutil.Address, coinSelectLocker CoinSelectionLocker,
 	utxoSource UtxoSource, outpointLocker OutpointLocker,
-	feeEstimator chainfee.Estimator,
-	signer input.Signer) (*WalletSweepPackage, error) {
+	feeEstimator chainfee.Estimator, signer input.Signer, minConfs int32,
+	selectUtxos []wire.OutPoint) (*WalletSweepPackage, error) {
 
 	// TODO(roasbeef): turn off ATPL as well when available?
 
@@ -182,12 +210,21 @@ func CraftSweepAllTx(feeRate chainfee.SatPerKWeight, blockHeight uint32,
 		// operations are going on, we can grab a clean snapshot of the
 		// current UTXO state of the wallet.
 		utxos, err := utxoSource.ListUnspentWitness(
-			1, math.MaxInt32,
+			minConfs, math.MaxInt32,
 		)
 		if err != nil {
 			return err
 		}
 
+		// If the caller asked for specific outputs to be swept, we'll
+		// only sweep those.
+		if len(selectUtxos) != 0 {
+			utxos, err = filterUtxos(utxos, selectUtxos)
+			if err != nil {
+				return err
+			}
+		}
+
 		// We'll now lock each UTXO to ensure that other callers don't
 		// attempt to use these UTXOs in transactions while we're
 		// crafting out sweep all transaction.
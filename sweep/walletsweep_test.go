@@ -288,6 +288,7 @@ func TestCraftSweepAllTxCoinSelectFail(t *testing.T) {
 
 	_, err := CraftSweepAllTx(
 		0, 100, nil, coinSelectLocker, utxoSource, utxoLocker, nil, nil,
+		1, nil,
 	)
 
 	// Since we instructed the coin select locker to fail above, we should
@@ -313,6 +314,7 @@ func TestCraftSweepAllTxUnknownWitnessType(t *testing.T) {
 
 	_, err := CraftSweepAllTx(
 		0, 100, nil, coinSelectLocker, utxoSource, utxoLocker, nil, nil,
+		1, nil,
 	)
 
 	// Since passed in a p2wsh output, which is unknown, we should fail to
@@ -347,7 +349,7 @@ func TestCraftSweepAllTx(t *testing.T) {
 
 	sweepPkg, err := CraftSweepAllTx(
 		0, 100, deliveryAddr, coinSelectLocker, utxoSource, utxoLocker,
-		feeEstimator, signer,
+		feeEstimator, signer, 1, nil,
 	)
 	if err != nil {
 		t.Fatalf("unable to make sweep tx: %v", err)
@@ -390,3 +392,49 @@ func TestCraftSweepAllTx(t *testing.T) {
 	sweepPkg.CancelSweepAttempt()
 	assertUtxosUnlocked(t, utxoLocker, testUtxos[:2])
 }
+
+// TestCraftSweepAllTxSelectUtxos tests that only the selected outputs are
+// swept if the caller asks for specific outputs, and that selecting an output
+// that isn't available fails.
+func TestCraftSweepAllTxSelectUtxos(t *testing.T) {
+	t.Parallel()
+
+	signer := &mockSigner{}
+	feeEstimator := newMockFeeEstimator(0, 0)
+	utxoSource := newMockUtxoSource(testUtxos)
+	coinSelectLocker := &mockCoinSelectionLocker{}
+	utxoLocker := newMockOutpointLocker()
+
+	// We'll only select the np2wkh output, so the sweep should succeed
+	// even though the source also holds an output of an unknown type.
+	selected := []wire.OutPoint{testUtxos[1].OutPoint}
+	sweepPkg, err := CraftSweepAllTx(
+		0, 100, deliveryAddr, coinSelectLocker, utxoSource, utxoLocker,
+		feeEstimator, signer, 1, selected,
+	)
+	if err != nil {
+		t.Fatalf("unable to make sweep tx: %v", err)
+	}
+
+	sweepTx := sweepPkg.SweepTx
+	if len(sweepTx.TxIn) != 1 {
+		t.Fatalf("expected 1 input, got %v", len(sweepTx.TxIn))
+	}
+	if sweepTx.TxIn[0].PreviousOutPoint != selected[0] {
+		t.Fatalf("expected input %v, got %v", selected[0],
+			sweepTx.TxIn[0].PreviousOutPoint)
+	}
+	assertUtxosLocked(t, utxoLocker, testUtxos[1:2])
+
+	sweepPkg.CancelSweepAttempt()
+	assertUtxosUnlocked(t, utxoLocker, testUtxos[1:2])
+
+	// Selecting an output the source doesn't know of should fail.
+	_, err = CraftSweepAllTx(
+		0, 100, deliveryAddr, coinSelectLocker, utxoSource, utxoLocker,
+		feeEstimator, signer, 1, []wire.OutPoint{{Index: 99}},
+	)
+	if err == nil {
+		t.Fatalf("sweep of unknown output should have failed")
+	}
+}
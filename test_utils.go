@@ -22,6 +22,7 @@ import (
 	"github.com/Actinium-project/lnd/htlcswitch"
 	"github.com/Actinium-project/lnd/input"
 	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/lncfg"
 	"github.com/Actinium-project/lnd/lnwallet"
 	"github.com/Actinium-project/lnd/lnwallet/chainfee"
 	"github.com/Actinium-project/lnd/lnwire"
@@ -371,11 +372,19 @@ func createTestPeer(notifier chainntnfs.ChainNotifier, publTx chan *wire.MsgTx,
 	)
 	chainArb.WatchNewChannel(aliceChannelState)
 
+	deliveryScripts, err := newDeliveryScriptSource(
+		&lncfg.Delivery{}, activeNetParams.Params, dbAlice, wallet,
+	)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
 	s := &server{
-		chanDB:        dbAlice,
-		cc:            cc,
-		breachArbiter: breachArbiter,
-		chainArb:      chainArb,
+		chanDB:          dbAlice,
+		cc:              cc,
+		breachArbiter:   breachArbiter,
+		chainArb:        chainArb,
+		deliveryScripts: deliveryScripts,
 	}
 
 	_, currentHeight, err := s.cc.chainIO.GetBestBlock()
@@ -402,7 +411,9 @@ func createTestPeer(notifier chainntnfs.ChainNotifier, publTx chan *wire.MsgTx,
 	}
 	s.htlcSwitch = htlcSwitch
 
-	nodeSignerAlice := netann.NewNodeSigner(aliceKeyPriv)
+	nodeSignerAlice := netann.NewNodeSigner(
+		&keychain.PrivKeyMessageSigner{PrivKey: aliceKeyPriv},
+	)
 
 	const chanActiveTimeout = time.Minute
 
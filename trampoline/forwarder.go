@@ -0,0 +1,288 @@
+package trampoline
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/htlcswitch/hop"
+	"github.com/Actinium-project/lnd/invoices"
+	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/record"
+	"github.com/Actinium-project/lnd/routing"
+	"github.com/Actinium-project/lnd/routing/route"
+)
+
+var (
+	// errMultiPart is returned when a trampoline htlc is part of a
+	// multi-part payment.
+	errMultiPart = errors.New("multi-part trampoline payments aren't " +
+		"supported")
+
+	// errNoOutgoingNode is returned when a trampoline payload doesn't
+	// name the node to forward the payment to.
+	errNoOutgoingNode = errors.New("trampoline payload without outgoing " +
+		"node")
+
+	// errAmountTooHigh is returned when the amount to forward exceeds the
+	// amount of the trampoline htlc.
+	errAmountTooHigh = errors.New("amount to forward exceeds htlc amount")
+
+	// errInvalidCltv is returned when the outgoing cltv of a trampoline
+	// payload isn't in the near future.
+	errInvalidCltv = errors.New("invalid outgoing cltv")
+
+	// errExpiryTooSoon is returned when the trampoline htlc doesn't leave
+	// enough blocks between its expiry and the outgoing cltv.
+	errExpiryTooSoon = errors.New("htlc expiry too soon")
+)
+
+// Config holds the dependencies of the trampoline forwarder.
+type Config struct {
+	// NodeKey is the identity key of this node, which is used to peel the
+	// trampoline onions this node receives.
+	NodeKey keychain.SingleKeyECDH
+
+	// CltvDelta is the minimum number of blocks between the expiry of an
+	// incoming trampoline htlc and the time lock of the route the payment
+	// is forwarded along.
+	CltvDelta uint32
+
+	// PayAttemptTimeout is the time after which no new attempts are made
+	// to forward a payment.
+	PayAttemptTimeout time.Duration
+
+	// SendPayment sends a payment and blocks until it is resolved.
+	SendPayment func(*routing.LightningPayment) ([32]byte, *route.Route,
+		error)
+
+	// SubscribePayment subscribes to the outcome of a payment that was
+	// sent before.
+	SubscribePayment func(lntypes.Hash) (bool, chan routing.PaymentResult,
+		error)
+
+	// AddForward records the incoming htlc of a payment before it is
+	// forwarded, so that the payment can be told apart from the payments
+	// of the node itself.
+	AddForward func(lntypes.Hash, *channeldb.TrampolineForward) error
+}
+
+// Forwarder forwards the payments of htlcs that carry a trampoline onion. It
+// peels its layer of the trampoline onion, and pays the next trampoline node
+// or the destination with a route it finds itself. The incoming htlc is
+// settled once the outgoing payment succeeded.
+type Forwarder struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	quit chan struct{}
+}
+
+// A compile time check to ensure Forwarder implements the
+// invoices.TrampolineForwarder interface.
+var _ invoices.TrampolineForwarder = (*Forwarder)(nil)
+
+// NewForwarder creates a new trampoline forwarder.
+func NewForwarder(cfg *Config) *Forwarder {
+	return &Forwarder{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the trampoline forwarder.
+func (f *Forwarder) Start() error {
+	f.started.Do(func() {
+		log.Info("Trampoline forwarder starting")
+	})
+
+	return nil
+}
+
+// Stop stops the trampoline forwarder. Payments that are still being forwarded
+// are left unresolved.
+func (f *Forwarder) Stop() error {
+	f.stopped.Do(func() {
+		log.Info("Trampoline forwarder shutting down")
+
+		close(f.quit)
+	})
+
+	return nil
+}
+
+// ForwardTrampoline forwards the payment described by the trampoline onion of
+// the htlc, and blocks until the payment is resolved.
+//
+// NOTE: This is part of the invoices.TrampolineForwarder interface.
+func (f *Forwarder) ForwardTrampoline(
+	req *invoices.TrampolineRequest) *invoices.TrampolineResolution {
+
+	payment, err := f.newPayment(req)
+	if err != nil {
+		log.Debugf("Rejecting trampoline htlc %v: %v", req.CircuitKey,
+			err)
+
+		// The sender may retry with a later expiry, all other
+		// failures are permanent.
+		if err == errExpiryTooSoon {
+			return fail(lnwire.CodeTemporaryNodeFailure)
+		}
+
+		return fail(lnwire.CodePermanentNodeFailure)
+	}
+
+	err = f.cfg.AddForward(req.Hash, &channeldb.TrampolineForward{
+		IncomingChanID: req.CircuitKey.ChanID,
+		AmtIn:          req.Amount,
+	})
+	if err != nil {
+		log.Errorf("Unable to record trampoline forward %v: %v",
+			req.Hash, err)
+
+		return fail(lnwire.CodeTemporaryNodeFailure)
+	}
+
+	log.Debugf("Forwarding trampoline htlc %v with hash %v to %v: "+
+		"amount=%v, fee_limit=%v", req.CircuitKey, req.Hash,
+		payment.Target, payment.Amount, payment.FeeLimit)
+
+	preimage, _, err := f.cfg.SendPayment(payment)
+	switch err {
+	case nil:
+		return settle(preimage)
+
+	// If we already forwarded the payment, for example because the htlc
+	// was replayed after a restart, we'll resolve the htlc with the
+	// outcome of that payment.
+	case channeldb.ErrPaymentInFlight, channeldb.ErrAlreadyPaid:
+		return f.awaitPayment(req.Hash)
+
+	case routing.ErrRouterShuttingDown:
+		return nil
+
+	default:
+		log.Debugf("Unable to forward trampoline htlc %v: %v",
+			req.CircuitKey, err)
+
+		return fail(lnwire.CodeTemporaryNodeFailure)
+	}
+}
+
+// newPayment peels the trampoline onion of the htlc and returns the payment
+// that forwards it.
+func (f *Forwarder) newPayment(req *invoices.TrampolineRequest) (
+	*routing.LightningPayment, error) {
+
+	// Multi-part payments would have to be collected before they can be
+	// forwarded, which we don't support.
+	if req.MPP != nil && req.MPP.TotalMsat() != req.Amount {
+		return nil, errMultiPart
+	}
+
+	peeled, err := hop.PeelTrampolineOnion(
+		req.Onion, f.cfg.NodeKey, req.Hash[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := hop.DecodeTrampolinePayload(peeled.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	height := uint32(req.CurrentHeight)
+
+	switch {
+	// We don't receive payments through trampoline onions, so there must
+	// be a node to forward the payment to.
+	case payload.OutgoingNodeID == nil:
+		return nil, errNoOutgoingNode
+
+	case payload.AmtToForward > req.Amount:
+		return nil, errAmountTooHigh
+
+	case payload.OutgoingCltv <= height ||
+		payload.OutgoingCltv-height > math.MaxUint16:
+
+		return nil, errInvalidCltv
+
+	// We need enough blocks to find a route, and to settle the incoming
+	// htlc after the outgoing one was settled.
+	case req.Expiry < payload.OutgoingCltv+f.cfg.CltvDelta:
+		return nil, errExpiryTooSoon
+	}
+
+	payment := &routing.LightningPayment{
+		Target:            route.Vertex(*payload.OutgoingNodeID),
+		Amount:            payload.AmtToForward,
+		FeeLimit:          req.Amount - payload.AmtToForward,
+		CltvLimit:         req.Expiry - f.cfg.CltvDelta,
+		FinalCLTVDelta:    uint16(payload.OutgoingCltv - height),
+		PaymentHash:       req.Hash,
+		PayAttemptTimeout: f.cfg.PayAttemptTimeout,
+	}
+
+	// If there's another trampoline node, we pass the remainder of the
+	// onion on to it. Otherwise we pay the destination directly, passing
+	// on its payment address.
+	switch {
+	case peeled.NextOnion != nil:
+		onionType := uint64(record.TrampolineOnionOnionType)
+		payment.DestCustomRecords = record.CustomSet{
+			onionType: peeled.NextOnion,
+		}
+
+	case payload.MPP != nil:
+		addr := payload.MPP.PaymentAddr()
+		payment.PaymentAddr = &addr
+		payment.MPPTotalAmt = payload.MPP.TotalMsat()
+	}
+
+	return payment, nil
+}
+
+// awaitPayment waits for the outcome of a payment that was sent before.
+func (f *Forwarder) awaitPayment(
+	hash lntypes.Hash) *invoices.TrampolineResolution {
+
+	_, resultChan, err := f.cfg.SubscribePayment(hash)
+	if err != nil {
+		log.Errorf("Unable to subscribe to payment %v: %v", hash, err)
+
+		return fail(lnwire.CodeTemporaryNodeFailure)
+	}
+
+	select {
+	case result := <-resultChan:
+		if result.Success {
+			return settle(result.Preimage)
+		}
+
+		return fail(lnwire.CodeTemporaryNodeFailure)
+
+	case <-f.quit:
+		return nil
+	}
+}
+
+// settle returns the resolution that settles an htlc with the preimage.
+func settle(preimage lntypes.Preimage) *invoices.TrampolineResolution {
+	return &invoices.TrampolineResolution{
+		Preimage: &preimage,
+	}
+}
+
+// fail returns the resolution that fails an htlc with the failure code.
+func fail(code lnwire.FailCode) *invoices.TrampolineResolution {
+	return &invoices.TrampolineResolution{
+		FailureCode: code,
+	}
+}
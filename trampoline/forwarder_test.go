@@ -0,0 +1,306 @@
+package trampoline
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Actinium-project/acmd/btcec"
+	"github.com/Actinium-project/lnd/channeldb"
+	"github.com/Actinium-project/lnd/htlcswitch/hop"
+	"github.com/Actinium-project/lnd/invoices"
+	"github.com/Actinium-project/lnd/keychain"
+	"github.com/Actinium-project/lnd/lntypes"
+	"github.com/Actinium-project/lnd/lnwire"
+	"github.com/Actinium-project/lnd/record"
+	"github.com/Actinium-project/lnd/routing"
+	"github.com/Actinium-project/lnd/routing/route"
+)
+
+const (
+	testHeight    = 100
+	testCltvDelta = 40
+	testChanID    = 7
+)
+
+var (
+	testPreimage = lntypes.Preimage{1}
+	testHash     = testPreimage.Hash()
+)
+
+// forwarderHarness holds a forwarder whose payments are captured by the test.
+type forwarderHarness struct {
+	t *testing.T
+
+	forwarder *Forwarder
+	nodeKeys  []*btcec.PrivateKey
+
+	payments   []*routing.LightningPayment
+	forwards   map[lntypes.Hash]channeldb.TrampolineForward
+	sendErr    error
+	subscribed bool
+}
+
+func newForwarderHarness(t *testing.T) *forwarderHarness {
+	h := &forwarderHarness{
+		t:        t,
+		forwards: make(map[lntypes.Hash]channeldb.TrampolineForward),
+	}
+
+	for i := 0; i < 2; i++ {
+		nodeKey, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		h.nodeKeys = append(h.nodeKeys, nodeKey)
+	}
+
+	h.forwarder = NewForwarder(&Config{
+		NodeKey:   &keychain.PrivKeyECDH{PrivKey: h.nodeKeys[0]},
+		CltvDelta: testCltvDelta,
+		SendPayment: func(p *routing.LightningPayment) ([32]byte,
+			*route.Route, error) {
+
+			h.payments = append(h.payments, p)
+			if h.sendErr != nil {
+				return [32]byte{}, nil, h.sendErr
+			}
+
+			return testPreimage, nil, nil
+		},
+		SubscribePayment: func(lntypes.Hash) (bool,
+			chan routing.PaymentResult, error) {
+
+			h.subscribed = true
+
+			c := make(chan routing.PaymentResult, 1)
+			c <- routing.PaymentResult{
+				Success:  true,
+				Preimage: testPreimage,
+			}
+
+			return false, c, nil
+		},
+		AddForward: func(hash lntypes.Hash,
+			fwd *channeldb.TrampolineForward) error {
+
+			h.forwards[hash] = *fwd
+			return nil
+		},
+	})
+
+	return h
+}
+
+// onion creates a trampoline onion with the given payloads for the nodes of
+// the harness.
+func (h *forwarderHarness) onion(payloads ...*hop.TrampolinePayload) []byte {
+	var hops []hop.TrampolineHop
+	for i, payload := range payloads {
+		b, err := payload.Encode()
+		if err != nil {
+			h.t.Fatal(err)
+		}
+
+		hops = append(hops, hop.TrampolineHop{
+			NodeID:  h.nodeKeys[i].PubKey(),
+			Payload: b,
+		})
+	}
+
+	sessionKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		h.t.Fatal(err)
+	}
+
+	onion, err := hop.NewTrampolineOnion(hops, sessionKey, testHash[:])
+	if err != nil {
+		h.t.Fatal(err)
+	}
+
+	return onion
+}
+
+// forward forwards an htlc with the given onion and returns the resolution.
+func (h *forwarderHarness) forward(
+	onion []byte) *invoices.TrampolineResolution {
+
+	return h.forwarder.ForwardTrampoline(&invoices.TrampolineRequest{
+		Hash: testHash,
+		CircuitKey: channeldb.CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(testChanID),
+		},
+		Amount:        11000,
+		Expiry:        testHeight + 200,
+		CurrentHeight: testHeight,
+		Onion:         onion,
+	})
+}
+
+func assertSettled(t *testing.T, res *invoices.TrampolineResolution) {
+	t.Helper()
+
+	if res == nil || res.Preimage == nil || *res.Preimage != testPreimage {
+		t.Fatalf("expected settle resolution, got %v", res)
+	}
+}
+
+func assertFailed(t *testing.T, res *invoices.TrampolineResolution,
+	code lnwire.FailCode) {
+
+	t.Helper()
+
+	if res == nil || res.Preimage != nil || res.FailureCode != code {
+		t.Fatalf("expected failure %v, got %v", code, res)
+	}
+}
+
+// TestForwardTrampolineDestination asserts that a trampoline payment for a
+// destination that doesn't support trampoline onions is delivered to it with
+// its payment address.
+func TestForwardTrampolineDestination(t *testing.T) {
+	h := newForwarderHarness(t)
+
+	dest := [33]byte{2, 1}
+	onion := h.onion(&hop.TrampolinePayload{
+		AmtToForward:   10000,
+		OutgoingCltv:   testHeight + 144,
+		OutgoingNodeID: &dest,
+		MPP:            record.NewMPP(10000, [32]byte{9}),
+	})
+
+	assertSettled(t, h.forward(onion))
+
+	// The incoming htlc must have been recorded, so that the payment can
+	// be told apart from our own payments.
+	fwd, ok := h.forwards[testHash]
+	if !ok || fwd.IncomingChanID.ToUint64() != testChanID ||
+		fwd.AmtIn != 11000 {
+
+		t.Fatalf("unexpected trampoline forward %v", fwd)
+	}
+
+	p := h.payments[0]
+	switch {
+	case p.Target != route.Vertex(dest):
+		t.Fatalf("unexpected target %v", p.Target)
+
+	case p.Amount != 10000 || p.FeeLimit != 1000:
+		t.Fatalf("unexpected amount %v and fee limit %v", p.Amount,
+			p.FeeLimit)
+
+	case p.FinalCLTVDelta != 144 || p.CltvLimit != testHeight+160:
+		t.Fatalf("unexpected final cltv delta %v and cltv limit %v",
+			p.FinalCLTVDelta, p.CltvLimit)
+
+	case p.PaymentAddr == nil || *p.PaymentAddr != [32]byte{9} ||
+		p.MPPTotalAmt != 10000:
+
+		t.Fatalf("unexpected payment address")
+
+	case len(p.DestCustomRecords) != 0:
+		t.Fatalf("unexpected custom records")
+	}
+}
+
+// TestForwardTrampolineNextTrampoline asserts that a trampoline payment is
+// forwarded to the next trampoline node along with the remainder of the onion.
+func TestForwardTrampolineNextTrampoline(t *testing.T) {
+	h := newForwarderHarness(t)
+
+	next := route.NewVertex(h.nodeKeys[1].PubKey())
+	nextNode := [33]byte(next)
+	dest := [33]byte{2, 1}
+	onion := h.onion(
+		&hop.TrampolinePayload{
+			AmtToForward:   10500,
+			OutgoingCltv:   testHeight + 144,
+			OutgoingNodeID: &nextNode,
+		},
+		&hop.TrampolinePayload{
+			AmtToForward:   10000,
+			OutgoingCltv:   testHeight + 40,
+			OutgoingNodeID: &dest,
+		},
+	)
+
+	assertSettled(t, h.forward(onion))
+
+	p := h.payments[0]
+	if p.Target != next {
+		t.Fatalf("unexpected target %v", p.Target)
+	}
+
+	// The next trampoline node can peel the onion we passed on.
+	nextOnion := p.DestCustomRecords[uint64(record.TrampolineOnionOnionType)]
+	peeled, err := hop.PeelTrampolineOnion(
+		nextOnion, &keychain.PrivKeyECDH{PrivKey: h.nodeKeys[1]},
+		testHash[:],
+	)
+	if err != nil {
+		t.Fatalf("unable to peel next onion: %v", err)
+	}
+	payload, err := hop.DecodeTrampolinePayload(peeled.Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *payload.OutgoingNodeID != dest {
+		t.Fatalf("unexpected outgoing node")
+	}
+}
+
+// TestForwardTrampolineFailures asserts that trampoline htlcs that can't be
+// forwarded are failed, and that payments that were forwarded before are
+// awaited.
+func TestForwardTrampolineFailures(t *testing.T) {
+	dest := [33]byte{2, 1}
+	payload := func(amt lnwire.MilliSatoshi,
+		cltv uint32) *hop.TrampolinePayload {
+
+		return &hop.TrampolinePayload{
+			AmtToForward:   amt,
+			OutgoingCltv:   cltv,
+			OutgoingNodeID: &dest,
+		}
+	}
+
+	// An onion that isn't encrypted for us is rejected.
+	h := newForwarderHarness(t)
+	h.forwarder.cfg.NodeKey = &keychain.PrivKeyECDH{
+		PrivKey: h.nodeKeys[1],
+	}
+	res := h.forward(h.onion(payload(10000, testHeight+144)))
+	assertFailed(t, res, lnwire.CodePermanentNodeFailure)
+
+	// The htlc must cover the amount to forward.
+	h = newForwarderHarness(t)
+	res = h.forward(h.onion(payload(12000, testHeight+144)))
+	assertFailed(t, res, lnwire.CodePermanentNodeFailure)
+
+	// The htlc must leave us enough blocks.
+	res = h.forward(h.onion(payload(10000, testHeight+170)))
+	assertFailed(t, res, lnwire.CodeTemporaryNodeFailure)
+
+	if len(h.payments) != 0 {
+		t.Fatalf("expected no payments")
+	}
+
+	// A failed payment fails the htlc.
+	h.sendErr = errors.New("no route")
+	res = h.forward(h.onion(payload(10000, testHeight+144)))
+	assertFailed(t, res, lnwire.CodeTemporaryNodeFailure)
+
+	// If the payment was already forwarded, its outcome is awaited.
+	h.sendErr = channeldb.ErrPaymentInFlight
+	res = h.forward(h.onion(payload(10000, testHeight+144)))
+	assertSettled(t, res)
+	if !h.subscribed {
+		t.Fatalf("expected subscription to payment")
+	}
+
+	// The htlc is left unresolved if the router shuts down.
+	h.sendErr = routing.ErrRouterShuttingDown
+	res = h.forward(h.onion(payload(10000, testHeight+144)))
+	if res != nil {
+		t.Fatalf("expected no resolution, got %v", res)
+	}
+}
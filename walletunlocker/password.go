@@ -0,0 +1,103 @@
+package walletunlocker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrEmptyPassword is returned if the configured password source
+	// doesn't provide a password.
+	ErrEmptyPassword = errors.New("wallet password is empty")
+)
+
+// ReadPasswordFile reads the wallet password from the file at the given path.
+// As the password grants full access to the funds of the node, the file must
+// only be accessible by its owner.
+func ReadPasswordFile(path string) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// File permissions don't apply on Windows, access is controlled
+	// through ACLs there instead.
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0077 != 0 {
+		return nil, fmt.Errorf("password file %v must only be "+
+			"accessible by its owner, but has permissions %v",
+			path, info.Mode().Perm())
+	}
+
+	password, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return trimPassword(password)
+}
+
+// PasswordFromCommand runs the given command and reads the wallet password
+// from its standard output. The command is split on whitespace and run
+// without a shell. As the command is trusted with the password, its
+// executable must not be writable by anyone but its owner.
+func PasswordFromCommand(command string,
+	timeout time.Duration) ([]byte, error) {
+
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil, fmt.Errorf("password command is empty")
+	}
+
+	executable, err := exec.LookPath(args[0])
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(executable)
+	if err != nil {
+		return nil, err
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0022 != 0 {
+		return nil, fmt.Errorf("password command %v must not be "+
+			"writable by group or others, but has permissions %v",
+			executable, info.Mode().Perm())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, executable, args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("password command timed out "+
+				"after %v", timeout)
+		}
+
+		return nil, fmt.Errorf("password command failed: %v: %s", err,
+			strings.TrimSpace(stderr.String()))
+	}
+
+	return trimPassword(stdout.Bytes())
+}
+
+// trimPassword removes the trailing newlines from the given password, and
+// ensures that a password remains.
+func trimPassword(password []byte) ([]byte, error) {
+	password = bytes.TrimRight(password, "\r\n")
+	if len(password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+
+	return password, nil
+}
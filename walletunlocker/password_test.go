@@ -0,0 +1,155 @@
+package walletunlocker
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// TestReadPasswordFile tests that the wallet password is read from a file
+// that's only accessible by its owner, and that other files are refused.
+func TestReadPasswordFile(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permissions aren't enforced on windows")
+	}
+
+	tempDir, err := ioutil.TempDir("", "password")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	testCases := []struct {
+		name     string
+		content  string
+		mode     os.FileMode
+		password []byte
+	}{
+		{
+			name:     "owner only",
+			content:  "hunter22 \n",
+			mode:     0600,
+			password: []byte("hunter22 "),
+		},
+		{
+			name:    "world readable",
+			content: "hunter22",
+			mode:    0644,
+		},
+		{
+			name:    "empty",
+			content: "\r\n",
+			mode:    0400,
+		},
+	}
+
+	for i, test := range testCases {
+		path := filepath.Join(tempDir, test.name)
+		err := ioutil.WriteFile(path, []byte(test.content), test.mode)
+		if err != nil {
+			t.Fatalf("unable to write password file: %v", err)
+		}
+
+		password, err := ReadPasswordFile(path)
+		switch {
+		case test.password == nil && err == nil:
+			t.Fatalf("test #%d (%v): expected error", i, test.name)
+
+		case test.password != nil && err != nil:
+			t.Fatalf("test #%d (%v): unable to read password: %v",
+				i, test.name, err)
+
+		case !bytes.Equal(password, test.password):
+			t.Fatalf("test #%d (%v): expected password %q, got %q",
+				i, test.name, test.password, password)
+		}
+	}
+}
+
+// TestPasswordFromCommand tests that the wallet password is read from the
+// output of a command, and that commands that can be modified by others,
+// fail or don't complete in time are refused.
+func TestPasswordFromCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test commands are shell scripts")
+	}
+
+	tempDir, err := ioutil.TempDir("", "password")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	testCases := []struct {
+		name     string
+		script   string
+		mode     os.FileMode
+		args     string
+		password []byte
+	}{
+		{
+			name:     "success",
+			script:   "echo \"$1\"",
+			mode:     0700,
+			args:     " hunter22",
+			password: []byte("hunter22"),
+		},
+		{
+			name:   "group writable",
+			script: "echo hunter22",
+			mode:   0770,
+		},
+		{
+			name:   "failure",
+			script: "echo wrong vault >&2; exit 1",
+			mode:   0700,
+		},
+		{
+			name:   "no output",
+			script: "true",
+			mode:   0700,
+		},
+		{
+			name:   "timeout",
+			script: "exec sleep 5",
+			mode:   0700,
+		},
+	}
+
+	for i, test := range testCases {
+		// The command is split on whitespace, so the script path
+		// mustn't contain any.
+		path := filepath.Join(tempDir, fmt.Sprintf("cmd%d", i))
+		content := []byte("#!/bin/sh\n" + test.script + "\n")
+		if err := ioutil.WriteFile(path, content, test.mode); err != nil {
+			t.Fatalf("unable to write script: %v", err)
+		}
+
+		// WriteFile applies the umask, so we'll set the intended mode
+		// explicitly.
+		if err := os.Chmod(path, test.mode); err != nil {
+			t.Fatalf("unable to set script mode: %v", err)
+		}
+
+		password, err := PasswordFromCommand(
+			path+test.args, 500*time.Millisecond,
+		)
+		switch {
+		case test.password == nil && err == nil:
+			t.Fatalf("test #%d (%v): expected error", i, test.name)
+
+		case test.password != nil && err != nil:
+			t.Fatalf("test #%d (%v): unable to read password: %v",
+				i, test.name, err)
+
+		case !bytes.Equal(password, test.password):
+			t.Fatalf("test #%d (%v): expected password %q, got %q",
+				i, test.name, test.password, password)
+		}
+	}
+}
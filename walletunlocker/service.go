@@ -16,6 +16,22 @@ import (
 	"github.com/Actinium-project/lnd/lnrpc"
 	"github.com/Actinium-project/lnd/lnwallet"
 	"github.com/Actinium-project/lnd/lnwallet/btcwallet"
+	"github.com/Actinium-project/lnd/macaroons"
+)
+
+var (
+	// ErrUnlockTimeout signals that we did not get the expected unlock
+	// message before the timeout occurred.
+	ErrUnlockTimeout = errors.New("got no unlock message before timeout")
+
+	// ErrNoAdminMacaroon signals that the daemon shut down the unlocker
+	// service before it handed over the admin macaroon.
+	ErrNoAdminMacaroon = errors.New("daemon did not create an admin " +
+		"macaroon")
+
+	// ErrWalletNotFound signals that the wallet to be opened has not been
+	// created yet.
+	ErrWalletNotFound = errors.New("wallet not found")
 )
 
 // ChannelsToRecover wraps any set of packed (serialized+encrypted) channel
@@ -54,6 +70,11 @@ type WalletInitMsg struct {
 	// ChanBackups a set of static channel backups that should be received
 	// after the wallet has been initialized.
 	ChanBackups ChannelsToRecover
+
+	// StatelessInit signals that the user requested the daemon to be
+	// initialized stateless, which means no unencrypted macaroons should
+	// be written to disk.
+	StatelessInit bool
 }
 
 // WalletUnlockMsg is a message sent by the UnlockerService when a user wishes
@@ -81,6 +102,11 @@ type WalletUnlockMsg struct {
 	// ChanBackups a set of static channel backups that should be received
 	// after the wallet has been unlocked.
 	ChanBackups ChannelsToRecover
+
+	// StatelessInit signals that the user requested the daemon to be
+	// initialized stateless, which means no unencrypted macaroons should
+	// be written to disk.
+	StatelessInit bool
 }
 
 // UnlockerService implements the WalletUnlocker service used to provide lnd
@@ -96,22 +122,38 @@ type UnlockerService struct {
 	// sent.
 	UnlockMsgs chan *WalletUnlockMsg
 
+	// MacResponseChan is the channel over which the daemon hands the
+	// admin macaroon back to the unlocker service, so it can be returned
+	// to the caller of InitWallet or ChangePassword. The daemon closes
+	// the channel once it no longer needs the unlocker service.
+	MacResponseChan chan []byte
+
 	chainDir       string
 	noFreelistSync bool
 	netParams      *chaincfg.Params
-	macaroonFiles  []string
+
+	// macaroonDir is the directory of the macaroon database, which is
+	// encrypted with the wallet's password as well.
+	macaroonDir string
+
+	// macaroonFiles are the macaroon files that are deleted when the
+	// macaroon root key is rotated or the daemon is switched to a
+	// stateless initialization.
+	macaroonFiles []string
 }
 
 // New creates and returns a new UnlockerService.
 func New(chainDir string, params *chaincfg.Params, noFreelistSync bool,
-	macaroonFiles []string) *UnlockerService {
+	macaroonDir string, macaroonFiles []string) *UnlockerService {
 
 	return &UnlockerService{
-		InitMsgs:      make(chan *WalletInitMsg, 1),
-		UnlockMsgs:    make(chan *WalletUnlockMsg, 1),
-		chainDir:      chainDir,
-		netParams:     params,
-		macaroonFiles: macaroonFiles,
+		InitMsgs:        make(chan *WalletInitMsg, 1),
+		UnlockMsgs:      make(chan *WalletUnlockMsg, 1),
+		MacResponseChan: make(chan []byte, 1),
+		chainDir:        chainDir,
+		netParams:       params,
+		macaroonDir:     macaroonDir,
+		macaroonFiles:   macaroonFiles,
 	}
 }
 
@@ -293,6 +335,7 @@ func (u *UnlockerService) InitWallet(ctx context.Context,
 		Passphrase:     password,
 		WalletSeed:     cipherSeed,
 		RecoveryWindow: uint32(recoveryWindow),
+		StatelessInit:  in.StatelessInit,
 	}
 
 	// Before we return the unlock payload, we'll check if we can extract
@@ -304,7 +347,17 @@ func (u *UnlockerService) InitWallet(ctx context.Context,
 
 	u.InitMsgs <- initMsg
 
-	return &lnrpc.InitWalletResponse{}, nil
+	// We'll now wait for the daemon to hand us the admin macaroon, which
+	// we return to the caller. In case of a stateless initialization,
+	// this is the only copy of it.
+	adminMac, err := u.waitForAdminMacaroon(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.InitWalletResponse{
+		AdminMacaroon: adminMac,
+	}, nil
 }
 
 // UnlockWallet sends the password provided by the incoming UnlockWalletRequest
@@ -316,36 +369,18 @@ func (u *UnlockerService) UnlockWallet(ctx context.Context,
 	password := in.WalletPassword
 	recoveryWindow := uint32(in.RecoveryWindow)
 
-	netDir := btcwallet.NetworkDir(u.chainDir, u.netParams)
-	loader := wallet.NewLoader(
-		u.netParams, netDir, u.noFreelistSync, recoveryWindow,
-	)
-
-	// Check if wallet already exists.
-	walletExists, err := loader.WalletExists()
+	unlockedWallet, err := u.LoadAndUnlock(password, recoveryWindow)
 	if err != nil {
 		return nil, err
 	}
 
-	if !walletExists {
-		// Cannot unlock a wallet that does not exist!
-		return nil, fmt.Errorf("wallet not found")
-	}
-
-	// Try opening the existing wallet with the provided password.
-	unlockedWallet, err := loader.OpenExistingWallet(password, false)
-	if err != nil {
-		// Could not open wallet, most likely this means that provided
-		// password was incorrect.
-		return nil, err
-	}
-
 	// We successfully opened the wallet and pass the instance back to
 	// avoid it needing to be unlocked again.
 	walletUnlockMsg := &WalletUnlockMsg{
 		Passphrase:     password,
 		RecoveryWindow: recoveryWindow,
 		Wallet:         unlockedWallet,
+		StatelessInit:  in.StatelessInit,
 	}
 
 	// Before we return the unlock payload, we'll check if we can extract
@@ -363,6 +398,48 @@ func (u *UnlockerService) UnlockWallet(ctx context.Context,
 	return &lnrpc.UnlockWalletResponse{}, nil
 }
 
+// WalletExists returns whether the wallet has already been created for the
+// configured chain and network.
+func (u *UnlockerService) WalletExists() (bool, error) {
+	netDir := btcwallet.NetworkDir(u.chainDir, u.netParams)
+	loader := wallet.NewLoader(u.netParams, netDir, u.noFreelistSync, 0)
+
+	return loader.WalletExists()
+}
+
+// LoadAndUnlock opens the existing wallet with the given password, using the
+// given recovery window to resume an interrupted rescan. ErrWalletNotFound is
+// returned if the wallet has not been created yet.
+func (u *UnlockerService) LoadAndUnlock(password []byte,
+	recoveryWindow uint32) (*wallet.Wallet, error) {
+
+	netDir := btcwallet.NetworkDir(u.chainDir, u.netParams)
+	loader := wallet.NewLoader(
+		u.netParams, netDir, u.noFreelistSync, recoveryWindow,
+	)
+
+	// Check if wallet already exists.
+	walletExists, err := loader.WalletExists()
+	if err != nil {
+		return nil, err
+	}
+
+	if !walletExists {
+		// Cannot unlock a wallet that does not exist!
+		return nil, ErrWalletNotFound
+	}
+
+	// Try opening the existing wallet with the provided password.
+	unlockedWallet, err := loader.OpenExistingWallet(password, false)
+	if err != nil {
+		// Could not open wallet, most likely this means that provided
+		// password was incorrect.
+		return nil, err
+	}
+
+	return unlockedWallet, nil
+}
+
 // ChangePassword changes the password of the wallet and sends the new password
 // across the UnlockPasswords channel to automatically unlock the wallet if
 // successful.
@@ -406,18 +483,6 @@ func (u *UnlockerService) ChangePassword(ctx context.Context,
 	// Unload the wallet to allow lnd to open it later on.
 	defer loader.UnloadWallet()
 
-	// Since the macaroon database is also encrypted with the wallet's
-	// password, we'll remove all of the macaroon files so that they're
-	// re-generated at startup using the new password. We'll make sure to do
-	// this after unlocking the wallet to ensure macaroon files don't get
-	// deleted with incorrect password attempts.
-	for _, file := range u.macaroonFiles {
-		err := os.Remove(file)
-		if err != nil && !os.IsNotExist(err) {
-			return nil, err
-		}
-	}
-
 	// Attempt to change both the public and private passphrases for the
 	// wallet. This will be done atomically in order to prevent one
 	// passphrase change from being successful and not the other.
@@ -429,11 +494,97 @@ func (u *UnlockerService) ChangePassword(ctx context.Context,
 			"%v", err)
 	}
 
+	// Since the macaroon database is also encrypted with the wallet's
+	// password, we'll re-encrypt it with the new password as well, and
+	// rotate its root key if requested.
+	err = u.changeMacaroonPassword(
+		privatePw, in.NewPassword, in.NewMacaroonRootKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the macaroon root key is rotated, the existing macaroon files
+	// become invalid, and in case of a stateless initialization, they
+	// shouldn't exist at all. We'll remove them so that they're
+	// re-generated at startup if needed. We'll make sure to do this only
+	// after both passwords were changed, so that the macaroon files don't
+	// get deleted by incorrect password attempts or failed changes.
+	if in.NewMacaroonRootKey || in.StatelessInit {
+		for _, file := range u.macaroonFiles {
+			err := os.Remove(file)
+			if err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+	}
+
 	// Finally, send the new password across the UnlockPasswords channel to
 	// automatically unlock the wallet.
-	u.UnlockMsgs <- &WalletUnlockMsg{Passphrase: in.NewPassword}
+	u.UnlockMsgs <- &WalletUnlockMsg{
+		Passphrase:    in.NewPassword,
+		StatelessInit: in.StatelessInit,
+	}
 
-	return &lnrpc.ChangePasswordResponse{}, nil
+	// We'll now wait for the daemon to hand us the admin macaroon, which
+	// we return to the caller.
+	adminMac, err := u.waitForAdminMacaroon(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ChangePasswordResponse{
+		AdminMacaroon: adminMac,
+	}, nil
+}
+
+// changeMacaroonPassword re-encrypts the macaroon database with the new
+// password, and replaces its default root key if requested.
+func (u *UnlockerService) changeMacaroonPassword(oldPw, newPw []byte,
+	newRootKey bool) error {
+
+	macaroonService, err := macaroons.NewService(u.macaroonDir)
+	if err != nil {
+		return err
+	}
+	defer macaroonService.Close()
+
+	err = macaroonService.CreateUnlock(&oldPw)
+	if err != nil {
+		return fmt.Errorf("unable to unlock macaroon db: %v", err)
+	}
+
+	err = macaroonService.ChangePassword(oldPw, newPw)
+	if err != nil {
+		return fmt.Errorf("unable to change macaroon db password: %v",
+			err)
+	}
+
+	if newRootKey {
+		if err := macaroonService.GenerateNewRootKey(); err != nil {
+			return fmt.Errorf("unable to rotate macaroon root "+
+				"key: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// waitForAdminMacaroon waits for the daemon to hand over the admin macaroon
+// after the wallet has been initialized or unlocked.
+func (u *UnlockerService) waitForAdminMacaroon(
+	ctx context.Context) ([]byte, error) {
+
+	select {
+	case adminMac, ok := <-u.MacResponseChan:
+		if !ok {
+			return nil, ErrNoAdminMacaroon
+		}
+		return adminMac, nil
+
+	case <-ctx.Done():
+		return nil, ErrUnlockTimeout
+	}
 }
 
 // ValidatePassword assures the password meets all of our constraints.
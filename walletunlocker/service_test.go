@@ -17,6 +17,7 @@ import (
 	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/lnrpc"
 	"github.com/Actinium-project/lnd/lnwallet/btcwallet"
+	"github.com/Actinium-project/lnd/macaroons"
 	"github.com/Actinium-project/lnd/walletunlocker"
 )
 
@@ -34,6 +35,8 @@ var (
 	testNetParams = &chaincfg.MainNetParams
 
 	testRecoveryWindow uint32 = 150
+
+	testMac = []byte("fakemacaroon")
 )
 
 func createTestWallet(t *testing.T, dir string, netParams *chaincfg.Params) {
@@ -77,7 +80,9 @@ func TestGenSeed(t *testing.T) {
 	}
 	defer os.RemoveAll(testDir)
 
-	service := walletunlocker.New(testDir, testNetParams, true, nil)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, testDir, nil,
+	)
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase.
@@ -118,7 +123,9 @@ func TestGenSeedGenerateEntropy(t *testing.T) {
 	defer func() {
 		os.RemoveAll(testDir)
 	}()
-	service := walletunlocker.New(testDir, testNetParams, true, nil)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, testDir, nil,
+	)
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase. Note that we don't actually
@@ -158,7 +165,9 @@ func TestGenSeedInvalidEntropy(t *testing.T) {
 	defer func() {
 		os.RemoveAll(testDir)
 	}()
-	service := walletunlocker.New(testDir, testNetParams, true, nil)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, testDir, nil,
+	)
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase. However, we'll be using an
@@ -196,7 +205,9 @@ func TestInitWallet(t *testing.T) {
 	}()
 
 	// Create new UnlockerService.
-	service := walletunlocker.New(testDir, testNetParams, true, nil)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, testDir, nil,
+	)
 
 	// Once we have the unlocker service created, we'll now instantiate a
 	// new cipher seed instance.
@@ -225,11 +236,20 @@ func TestInitWallet(t *testing.T) {
 		CipherSeedMnemonic: []string(mnemonic[:]),
 		AezeedPassphrase:   pass,
 		RecoveryWindow:     int32(testRecoveryWindow),
+		StatelessInit:      true,
 	}
-	_, err = service.InitWallet(ctx, req)
+
+	// The daemon hands the admin macaroon back to the service once the
+	// wallet has been created, which we'll mock by queueing it up.
+	service.MacResponseChan <- testMac
+	resp, err := service.InitWallet(ctx, req)
 	if err != nil {
 		t.Fatalf("InitWallet call failed: %v", err)
 	}
+	if !bytes.Equal(resp.AdminMacaroon, testMac) {
+		t.Fatalf("expected admin macaroon %x, got %x", testMac,
+			resp.AdminMacaroon)
+	}
 
 	// The same user passphrase, and also the plaintext cipher seed
 	// should be sent over and match exactly.
@@ -259,6 +279,9 @@ func TestInitWallet(t *testing.T) {
 				"got %v", testRecoveryWindow,
 				msg.RecoveryWindow)
 		}
+		if !msg.StatelessInit {
+			t.Fatalf("expected stateless init to be requested")
+		}
 
 	case <-time.After(3 * time.Second):
 		t.Fatalf("password not received")
@@ -297,7 +320,9 @@ func TestCreateWalletInvalidEntropy(t *testing.T) {
 	}()
 
 	// Create new UnlockerService.
-	service := walletunlocker.New(testDir, testNetParams, true, nil)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, testDir, nil,
+	)
 
 	// We'll attempt to init the wallet with an invalid cipher seed and
 	// passphrase.
@@ -330,7 +355,9 @@ func TestUnlockWallet(t *testing.T) {
 	}()
 
 	// Create new UnlockerService.
-	service := walletunlocker.New(testDir, testNetParams, true, nil)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, testDir, nil,
+	)
 
 	ctx := context.Background()
 	req := &lnrpc.UnlockWalletRequest{
@@ -379,6 +406,53 @@ func TestUnlockWallet(t *testing.T) {
 	}
 }
 
+// TestLoadAndUnlockWallet checks that a wallet that wasn't created yet is
+// reported as not found, so the caller can wait for it to be created, and that
+// an existing wallet is loaded and unlocked with its password.
+func TestLoadAndUnlockWallet(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "testloadandunlock")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(testDir)
+
+	service := walletunlocker.New(
+		testDir, testNetParams, true, testDir, nil,
+	)
+
+	exists, err := service.WalletExists()
+	if err != nil {
+		t.Fatalf("unable to check wallet existence: %v", err)
+	}
+	if exists {
+		t.Fatalf("expected wallet to not exist")
+	}
+
+	_, err = service.LoadAndUnlock(testPassword, 0)
+	if err != walletunlocker.ErrWalletNotFound {
+		t.Fatalf("expected ErrWalletNotFound, got %v", err)
+	}
+
+	createTestWallet(t, testDir, testNetParams)
+
+	exists, err = service.WalletExists()
+	if err != nil {
+		t.Fatalf("unable to check wallet existence: %v", err)
+	}
+	if !exists {
+		t.Fatalf("expected wallet to exist")
+	}
+
+	w, err := service.LoadAndUnlock(testPassword, 0)
+	if err != nil {
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+	w.Lock()
+	w.Database().Close()
+}
+
 // TestChangeWalletPassword tests that we can successfully change the wallet's
 // password needed to unlock it.
 func TestChangeWalletPassword(t *testing.T) {
@@ -404,14 +478,17 @@ func TestChangeWalletPassword(t *testing.T) {
 	}
 
 	// Create a new UnlockerService with our temp files.
-	service := walletunlocker.New(testDir, testNetParams, true, tempFiles)
+	service := walletunlocker.New(
+		testDir, testNetParams, true, testDir, tempFiles,
+	)
 
 	ctx := context.Background()
 	newPassword := []byte("hunter2???")
 
 	req := &lnrpc.ChangePasswordRequest{
-		CurrentPassword: testPassword,
-		NewPassword:     newPassword,
+		CurrentPassword:    testPassword,
+		NewPassword:        newPassword,
+		NewMacaroonRootKey: true,
 	}
 
 	// Changing the password to a non-existing wallet should fail.
@@ -452,13 +529,32 @@ func TestChangeWalletPassword(t *testing.T) {
 
 	// When providing the correct wallet's current password and a new
 	// password that meets the length requirement, the password change
-	// should succeed.
-	_, err = service.ChangePassword(ctx, req)
+	// should succeed. The admin macaroon the daemon hands back should be
+	// returned.
+	service.MacResponseChan <- testMac
+	resp, err := service.ChangePassword(ctx, req)
 	if err != nil {
 		t.Fatalf("unable to change wallet's password: %v", err)
 	}
+	if !bytes.Equal(resp.AdminMacaroon, testMac) {
+		t.Fatalf("expected admin macaroon %x, got %x", testMac,
+			resp.AdminMacaroon)
+	}
 
-	// The files should no longer exist.
+	// The macaroon database should now be encrypted with the new
+	// password.
+	macService, err := macaroons.NewService(testDir)
+	if err != nil {
+		t.Fatalf("unable to create macaroon service: %v", err)
+	}
+	defer macService.Close()
+	if err := macService.CreateUnlock(&newPassword); err != nil {
+		t.Fatalf("unable to unlock macaroon db with new password: %v",
+			err)
+	}
+
+	// The files should no longer exist, as the macaroon root key was
+	// rotated.
 	for _, tempFile := range tempFiles {
 		if _, err := os.Open(tempFile); err == nil {
 			t.Fatal("file exists but it shouldn't")
@@ -476,3 +572,62 @@ func TestChangeWalletPassword(t *testing.T) {
 		t.Fatalf("password not received")
 	}
 }
+
+// TestChangeWalletPasswordMacaroonFailure tests that the macaroon files aren't
+// deleted if the password of the macaroon database can't be changed.
+func TestChangeWalletPasswordMacaroonFailure(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "testchangepasswordmacaroon")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(testDir)
+
+	// Create some files that will act as macaroon files that should only
+	// be deleted after a password change is successful.
+	var tempFiles []string
+	for i := 0; i < 3; i++ {
+		file, err := ioutil.TempFile(testDir, "")
+		if err != nil {
+			t.Fatalf("unable to create temp file: %v", err)
+		}
+		tempFiles = append(tempFiles, file.Name())
+		file.Close()
+	}
+
+	createTestWallet(t, testDir, testNetParams)
+
+	// We'll encrypt the macaroon database with a different password than
+	// the wallet's, so that changing its password fails.
+	macService, err := macaroons.NewService(testDir)
+	if err != nil {
+		t.Fatalf("unable to create macaroon service: %v", err)
+	}
+	otherPassword := []byte("other-password")
+	if err := macService.CreateUnlock(&otherPassword); err != nil {
+		t.Fatalf("unable to unlock macaroon db: %v", err)
+	}
+	macService.Close()
+
+	service := walletunlocker.New(
+		testDir, testNetParams, true, testDir, tempFiles,
+	)
+
+	req := &lnrpc.ChangePasswordRequest{
+		CurrentPassword:    testPassword,
+		NewPassword:        []byte("hunter2???"),
+		NewMacaroonRootKey: true,
+	}
+	_, err = service.ChangePassword(context.Background(), req)
+	if err == nil {
+		t.Fatal("expected call to ChangePassword to fail")
+	}
+
+	// The files should still exist, as the password change failed.
+	for _, tempFile := range tempFiles {
+		if _, err := os.Stat(tempFile); os.IsNotExist(err) {
+			t.Fatal("file does not exist but it should")
+		}
+	}
+}
@@ -5,10 +5,10 @@ import (
 	"net"
 	"time"
 
-	"github.com/Actinium-project/acmd/btcec"
 	"github.com/Actinium-project/acmd/chaincfg/chainhash"
 	"github.com/Actinium-project/acmd/wire"
 	"github.com/Actinium-project/acmutil"
+	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/tor"
 	"github.com/Actinium-project/lnd/watchtower/lookout"
 )
@@ -63,9 +63,9 @@ type Config struct {
 	// successfully sent funds can be received.
 	NewAddress func() (acmutil.Address, error)
 
-	// NodePrivKey is private key to be used in accepting new brontide
-	// connections.
-	NodePrivKey *btcec.PrivateKey
+	// NodeKeyECDH is the ECDH capable wrapper of the key to be used in
+	// accepting new brontide connections.
+	NodeKeyECDH keychain.SingleKeyECDH
 
 	// PublishTx provides the ability to send a signed transaction to the
 	// network.
@@ -1,24 +1,27 @@
 package wtclient
 
 import (
-	"github.com/Actinium-project/acmd/btcec"
 	"github.com/Actinium-project/lnd/keychain"
 )
 
 // DeriveSessionKey accepts an session key index for an existing session and
-// derives the HD private key to be used to authenticate the brontide transport
-// and authenticate requests sent to the tower. The key will use the
+// derives the HD key to be used to authenticate the brontide transport and
+// authenticate requests sent to the tower. The private key never leaves the key
+// ring, it is only used through ECDH. The key will use the
 // keychain.KeyFamilyTowerSession and the provided index, giving a BIP43
 // derivation path of:
 //
 //  * m/1017'/coinType'/8/0/index
-func DeriveSessionKey(keyRing SecretKeyRing,
-	index uint32) (*btcec.PrivateKey, error) {
+func DeriveSessionKey(keyRing ECDHKeyRing,
+	index uint32) (keychain.SingleKeyECDH, error) {
 
-	return keyRing.DerivePrivKey(keychain.KeyDescriptor{
-		KeyLocator: keychain.KeyLocator{
-			Family: keychain.KeyFamilyTowerSession,
-			Index:  index,
-		},
+	sessionKeyDesc, err := keyRing.DeriveKey(keychain.KeyLocator{
+		Family: keychain.KeyFamilyTowerSession,
+		Index:  index,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keychain.NewPubKeyECDH(sessionKeyDesc, keyRing), nil
 }
@@ -6,9 +6,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/Actinium-project/acmd/btcec"
 	"github.com/Actinium-project/acmd/chaincfg/chainhash"
 	"github.com/Actinium-project/lnd/input"
+	"github.com/Actinium-project/lnd/keychain"
 	"github.com/Actinium-project/lnd/lnwire"
 	"github.com/Actinium-project/lnd/watchtower/wtdb"
 	"github.com/Actinium-project/lnd/watchtower/wtserver"
@@ -41,7 +41,7 @@ type sessionQueueConfig struct {
 
 	// Dial allows the client to dial the tower using it's public key and
 	// net address.
-	Dial func(*btcec.PrivateKey,
+	Dial func(keychain.SingleKeyECDH,
 		*lnwire.NetAddress) (wtserver.Peer, error)
 
 	// SendMessage encodes, encrypts, and writes a message to the given peer.
@@ -285,7 +285,7 @@ func (q *sessionQueue) sessionManager() {
 // drainBackups attempts to send all pending updates in the queue to the tower.
 func (q *sessionQueue) drainBackups() {
 	// First, check that we are able to dial this session's tower.
-	conn, err := q.cfg.Dial(q.cfg.ClientSession.SessionPrivKey, q.towerAddr)
+	conn, err := q.cfg.Dial(q.cfg.ClientSession.SessionKeyECDH, q.towerAddr)
 	if err != nil {
 		log.Errorf("SessionQueue(%s) unable to dial tower at %v: %v",
 			q.ID(), q.towerAddr, err)
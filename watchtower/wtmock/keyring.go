@@ -1,6 +1,7 @@
 package wtmock
 
 import (
+	"crypto/sha256"
 	"sync"
 
 	"github.com/Actinium-project/acmd/btcec"
@@ -20,16 +21,61 @@ func NewSecretKeyRing() *SecretKeyRing {
 	}
 }
 
+// DeriveKey attempts to derive an arbitrary key specified by the passed
+// KeyLocator. If this method is called twice with the same argument, it will
+// return the same key.
+func (m *SecretKeyRing) DeriveKey(
+	keyLoc keychain.KeyLocator) (keychain.KeyDescriptor, error) {
+
+	privKey, err := m.privKey(keyLoc)
+	if err != nil {
+		return keychain.KeyDescriptor{}, err
+	}
+
+	return keychain.KeyDescriptor{
+		KeyLocator: keyLoc,
+		PubKey:     privKey.PubKey(),
+	}, nil
+}
+
 // DerivePrivKey derives the private key for a given key descriptor. If
 // this method is called twice with the same argument, it will return the same
 // private key.
 func (m *SecretKeyRing) DerivePrivKey(
 	desc keychain.KeyDescriptor) (*btcec.PrivateKey, error) {
 
+	return m.privKey(desc.KeyLocator)
+}
+
+// ScalarMult performs a scalar multiplication (ECDH-like operation) between
+// the private key of the key descriptor and the remote public key. The output
+// returned will be the sha256 of the resulting shared point serialized in
+// compressed format.
+func (m *SecretKeyRing) ScalarMult(keyDesc keychain.KeyDescriptor,
+	pub *btcec.PublicKey) ([]byte, error) {
+
+	privKey, err := m.privKey(keyDesc.KeyLocator)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &btcec.PublicKey{}
+	s.X, s.Y = btcec.S256().ScalarMult(pub.X, pub.Y, privKey.D.Bytes())
+
+	h := sha256.Sum256(s.SerializeCompressed())
+
+	return h[:], nil
+}
+
+// privKey returns the private key of the key locator, creating a new one if
+// the key locator is unknown.
+func (m *SecretKeyRing) privKey(
+	keyLoc keychain.KeyLocator) (*btcec.PrivateKey, error) {
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if key, ok := m.keys[desc.KeyLocator]; ok {
+	if key, ok := m.keys[keyLoc]; ok {
 		return key, nil
 	}
 
@@ -38,7 +84,7 @@ func (m *SecretKeyRing) DerivePrivKey(
 		return nil, err
 	}
 
-	m.keys[desc.KeyLocator] = privKey
+	m.keys[keyLoc] = privKey
 
 	return privKey, nil
 }
@@ -101,7 +101,8 @@ var (
 	}
 
 	testMessageSigner = MessageSigner{
-		SignCompact: func(hash []byte) ([]byte, error) {
+		SignCompact: func(msg []byte) ([]byte, error) {
+			hash := chainhash.HashB(msg)
 			sig, err := btcec.SignCompact(btcec.S256(),
 				testPrivKey, hash, true)
 			if err != nil {
@@ -915,7 +916,8 @@ func TestInvoiceChecksumMalleability(t *testing.T) {
 
 	privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), privKeyBytes)
 	msgSigner := MessageSigner{
-		SignCompact: func(hash []byte) ([]byte, error) {
+		SignCompact: func(msg []byte) ([]byte, error) {
+			hash := chainhash.HashB(msg)
 			return btcec.SignCompact(btcec.S256(), privKey, hash, true)
 		},
 	}